@@ -66,11 +66,110 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*Evidence
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Evidence)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Evidence)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(Evidence)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(Evidence)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field ExcludedMembers as it is not of Message kind"))
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState               protoreflect.MessageDescriptor
-	fd_GenesisState_params        protoreflect.FieldDescriptor
-	fd_GenesisState_members       protoreflect.FieldDescriptor
-	fd_GenesisState_current_group protoreflect.FieldDescriptor
+	md_GenesisState                  protoreflect.MessageDescriptor
+	fd_GenesisState_params           protoreflect.FieldDescriptor
+	fd_GenesisState_members          protoreflect.FieldDescriptor
+	fd_GenesisState_current_group    protoreflect.FieldDescriptor
+	fd_GenesisState_evidences        protoreflect.FieldDescriptor
+	fd_GenesisState_excluded_members protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +178,8 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_members = md_GenesisState.Fields().ByName("members")
 	fd_GenesisState_current_group = md_GenesisState.Fields().ByName("current_group")
+	fd_GenesisState_evidences = md_GenesisState.Fields().ByName("evidences")
+	fd_GenesisState_excluded_members = md_GenesisState.Fields().ByName("excluded_members")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -164,6 +265,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Evidences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Evidences})
+		if !f(fd_GenesisState_evidences, value) {
+			return
+		}
+	}
+	if len(x.ExcludedMembers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ExcludedMembers})
+		if !f(fd_GenesisState_excluded_members, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -185,6 +298,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Members) != 0
 	case "band.bandtss.v1beta1.GenesisState.current_group":
 		return x.CurrentGroup != nil
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		return len(x.Evidences) != 0
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		return len(x.ExcludedMembers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -207,6 +324,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Members = nil
 	case "band.bandtss.v1beta1.GenesisState.current_group":
 		x.CurrentGroup = nil
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		x.Evidences = nil
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		x.ExcludedMembers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -235,6 +356,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "band.bandtss.v1beta1.GenesisState.current_group":
 		value := x.CurrentGroup
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		if len(x.Evidences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.Evidences}
+		return protoreflect.ValueOfList(listValue)
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		if len(x.ExcludedMembers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ExcludedMembers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -263,6 +396,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Members = *clv.list
 	case "band.bandtss.v1beta1.GenesisState.current_group":
 		x.CurrentGroup = value.Message().Interface().(*CurrentGroup)
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.Evidences = *clv.list
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ExcludedMembers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -299,6 +440,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.CurrentGroup = new(CurrentGroup)
 		}
 		return protoreflect.ValueOfMessage(x.CurrentGroup.ProtoReflect())
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		if x.Evidences == nil {
+			x.Evidences = []*Evidence{}
+		}
+		value := &_GenesisState_4_list{list: &x.Evidences}
+		return protoreflect.ValueOfList(value)
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		if x.ExcludedMembers == nil {
+			x.ExcludedMembers = []string{}
+		}
+		value := &_GenesisState_5_list{list: &x.ExcludedMembers}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -321,6 +474,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.bandtss.v1beta1.GenesisState.current_group":
 		m := new(CurrentGroup)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.bandtss.v1beta1.GenesisState.evidences":
+		list := []*Evidence{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "band.bandtss.v1beta1.GenesisState.excluded_members":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.GenesisState"))
@@ -404,6 +563,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.CurrentGroup)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Evidences) > 0 {
+			for _, e := range x.Evidences {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExcludedMembers) > 0 {
+			for _, s := range x.ExcludedMembers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -433,6 +604,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExcludedMembers) > 0 {
+			for iNdEx := len(x.ExcludedMembers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExcludedMembers[iNdEx])
+				copy(dAtA[i:], x.ExcludedMembers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedMembers[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Evidences) > 0 {
+			for iNdEx := len(x.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Evidences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.CurrentGroup != nil {
 			encoded, err := options.Marshal(x.CurrentGroup)
 			if err != nil {
@@ -632,6 +828,72 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidences = append(x.Evidences, &Evidence{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Evidences[len(x.Evidences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedMembers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedMembers = append(x.ExcludedMembers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -719,12 +981,16 @@ func (x *_Params_5_list) IsValid() bool {
 }
 
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_reward_percentage         protoreflect.FieldDescriptor
-	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
-	fd_Params_min_transition_duration   protoreflect.FieldDescriptor
-	fd_Params_max_transition_duration   protoreflect.FieldDescriptor
-	fd_Params_fee_per_signer            protoreflect.FieldDescriptor
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_reward_percentage                  protoreflect.FieldDescriptor
+	fd_Params_inactive_penalty_duration          protoreflect.FieldDescriptor
+	fd_Params_min_transition_duration            protoreflect.FieldDescriptor
+	fd_Params_max_transition_duration            protoreflect.FieldDescriptor
+	fd_Params_fee_per_signer                     protoreflect.FieldDescriptor
+	fd_Params_misbehavior_slash_percentage       protoreflect.FieldDescriptor
+	fd_Params_non_participation_slash_percentage protoreflect.FieldDescriptor
+	fd_Params_max_missed_signings                protoreflect.FieldDescriptor
+	fd_Params_beacon_interval                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -735,6 +1001,10 @@ func init() {
 	fd_Params_min_transition_duration = md_Params.Fields().ByName("min_transition_duration")
 	fd_Params_max_transition_duration = md_Params.Fields().ByName("max_transition_duration")
 	fd_Params_fee_per_signer = md_Params.Fields().ByName("fee_per_signer")
+	fd_Params_misbehavior_slash_percentage = md_Params.Fields().ByName("misbehavior_slash_percentage")
+	fd_Params_non_participation_slash_percentage = md_Params.Fields().ByName("non_participation_slash_percentage")
+	fd_Params_max_missed_signings = md_Params.Fields().ByName("max_missed_signings")
+	fd_Params_beacon_interval = md_Params.Fields().ByName("beacon_interval")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -832,6 +1102,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MisbehaviorSlashPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MisbehaviorSlashPercentage)
+		if !f(fd_Params_misbehavior_slash_percentage, value) {
+			return
+		}
+	}
+	if x.NonParticipationSlashPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NonParticipationSlashPercentage)
+		if !f(fd_Params_non_participation_slash_percentage, value) {
+			return
+		}
+	}
+	if x.MaxMissedSignings != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedSignings)
+		if !f(fd_Params_max_missed_signings, value) {
+			return
+		}
+	}
+	if x.BeaconInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeaconInterval)
+		if !f(fd_Params_beacon_interval, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -857,6 +1151,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxTransitionDuration != nil
 	case "band.bandtss.v1beta1.Params.fee_per_signer":
 		return len(x.FeePerSigner) != 0
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		return x.MisbehaviorSlashPercentage != uint64(0)
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		return x.NonParticipationSlashPercentage != uint64(0)
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		return x.MaxMissedSignings != uint64(0)
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		return x.BeaconInterval != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -883,6 +1185,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxTransitionDuration = nil
 	case "band.bandtss.v1beta1.Params.fee_per_signer":
 		x.FeePerSigner = nil
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		x.MisbehaviorSlashPercentage = uint64(0)
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		x.NonParticipationSlashPercentage = uint64(0)
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		x.MaxMissedSignings = uint64(0)
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		x.BeaconInterval = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -917,6 +1227,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_5_list{list: &x.FeePerSigner}
 		return protoreflect.ValueOfList(listValue)
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		value := x.MisbehaviorSlashPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		value := x.NonParticipationSlashPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		value := x.MaxMissedSignings
+		return protoreflect.ValueOfUint64(value)
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		value := x.BeaconInterval
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -949,6 +1271,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_5_list)
 		x.FeePerSigner = *clv.list
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		x.MisbehaviorSlashPercentage = value.Uint()
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		x.NonParticipationSlashPercentage = value.Uint()
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		x.MaxMissedSignings = value.Uint()
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		x.BeaconInterval = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -992,6 +1322,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "band.bandtss.v1beta1.Params.reward_percentage":
 		panic(fmt.Errorf("field reward_percentage of message band.bandtss.v1beta1.Params is not mutable"))
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		panic(fmt.Errorf("field misbehavior_slash_percentage of message band.bandtss.v1beta1.Params is not mutable"))
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		panic(fmt.Errorf("field non_participation_slash_percentage of message band.bandtss.v1beta1.Params is not mutable"))
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		panic(fmt.Errorf("field max_missed_signings of message band.bandtss.v1beta1.Params is not mutable"))
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		panic(fmt.Errorf("field beacon_interval of message band.bandtss.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -1019,6 +1357,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "band.bandtss.v1beta1.Params.fee_per_signer":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "band.bandtss.v1beta1.Params.misbehavior_slash_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.bandtss.v1beta1.Params.non_participation_slash_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.bandtss.v1beta1.Params.max_missed_signings":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.bandtss.v1beta1.Params.beacon_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.bandtss.v1beta1.Params"))
@@ -1109,6 +1455,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.MisbehaviorSlashPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MisbehaviorSlashPercentage))
+		}
+		if x.NonParticipationSlashPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonParticipationSlashPercentage))
+		}
+		if x.MaxMissedSignings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissedSignings))
+		}
+		if x.BeaconInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeaconInterval))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1138,6 +1496,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BeaconInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeaconInterval))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxMissedSignings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedSignings))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.NonParticipationSlashPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonParticipationSlashPercentage))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MisbehaviorSlashPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MisbehaviorSlashPercentage))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.FeePerSigner) > 0 {
 			for iNdEx := len(x.FeePerSigner) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeePerSigner[iNdEx])
@@ -1411,6 +1789,82 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MisbehaviorSlashPercentage", wireType)
+				}
+				x.MisbehaviorSlashPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MisbehaviorSlashPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonParticipationSlashPercentage", wireType)
+				}
+				x.NonParticipationSlashPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonParticipationSlashPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedSignings", wireType)
+				}
+				x.MaxMissedSignings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedSignings |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeaconInterval", wireType)
+				}
+				x.BeaconInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeaconInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1471,6 +1925,11 @@ type GenesisState struct {
 	Members []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	// current_group is the current group information.
 	CurrentGroup *CurrentGroup `protobuf:"bytes,3,opt,name=current_group,json=currentGroup,proto3" json:"current_group,omitempty"`
+	// evidences is an array containing recorded evidences of misbehaving members.
+	Evidences []*Evidence `protobuf:"bytes,4,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// excluded_members is a list of addresses that are excluded from signing assignments until
+	// being readmitted by governance.
+	ExcludedMembers []string `protobuf:"bytes,5,rep,name=excluded_members,json=excludedMembers,proto3" json:"excluded_members,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1514,6 +1973,20 @@ func (x *GenesisState) GetCurrentGroup() *CurrentGroup {
 	return nil
 }
 
+func (x *GenesisState) GetEvidences() []*Evidence {
+	if x != nil {
+		return x.Evidences
+	}
+	return nil
+}
+
+func (x *GenesisState) GetExcludedMembers() []string {
+	if x != nil {
+		return x.ExcludedMembers
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1531,6 +2004,18 @@ type Params struct {
 	MaxTransitionDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=max_transition_duration,json=maxTransitionDuration,proto3" json:"max_transition_duration,omitempty"`
 	// fee_per_signer is the tokens that will be paid per signer.
 	FeePerSigner []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee_per_signer,json=feePerSigner,proto3" json:"fee_per_signer,omitempty"`
+	// misbehavior_slash_percentage is the percentage of the validator stake that is slashed when a member
+	// is proven to send an invalid complaint, an invalid secret share or an invalid partial signature.
+	MisbehaviorSlashPercentage uint64 `protobuf:"varint,6,opt,name=misbehavior_slash_percentage,json=misbehaviorSlashPercentage,proto3" json:"misbehavior_slash_percentage,omitempty"`
+	// non_participation_slash_percentage is the percentage of the validator stake that is slashed when a
+	// member misses max_missed_signings consecutive signings.
+	NonParticipationSlashPercentage uint64 `protobuf:"varint,7,opt,name=non_participation_slash_percentage,json=nonParticipationSlashPercentage,proto3" json:"non_participation_slash_percentage,omitempty"`
+	// max_missed_signings is the number of consecutive missed signings after which a member is penalized
+	// for non-participation. Zero disables the non-participation penalty.
+	MaxMissedSignings uint64 `protobuf:"varint,8,opt,name=max_missed_signings,json=maxMissedSignings,proto3" json:"max_missed_signings,omitempty"`
+	// beacon_interval is the number of blocks between the signing requests of the randomness beacon
+	// to the current group. Zero disables the randomness beacon.
+	BeaconInterval uint64 `protobuf:"varint,9,opt,name=beacon_interval,json=beaconInterval,proto3" json:"beacon_interval,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1588,6 +2073,34 @@ func (x *Params) GetFeePerSigner() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetMisbehaviorSlashPercentage() uint64 {
+	if x != nil {
+		return x.MisbehaviorSlashPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetNonParticipationSlashPercentage() uint64 {
+	if x != nil {
+		return x.NonParticipationSlashPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMissedSignings() uint64 {
+	if x != nil {
+		return x.MaxMissedSignings
+	}
+	return 0
+}
+
+func (x *Params) GetBeaconInterval() uint64 {
+	if x != nil {
+		return x.BeaconInterval
+	}
+	return 0
+}
+
 var File_band_bandtss_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_bandtss_v1beta1_genesis_proto_rawDesc = []byte{
@@ -1602,7 +2115,7 @@ var file_band_bandtss_v1beta1_genesis_proto_rawDesc = []byte{
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x22, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72,
@@ -1615,52 +2128,74 @@ var file_band_bandtss_v1beta1_genesis_proto_rawDesc = []byte{
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x75,
 	0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xdf,
-	0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x5f, 0x0a, 0x19,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
-	0x98, 0xdf, 0x1f, 0x01, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a,
-	0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x42,
+	0x0a, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xc7, 0x05,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x5f, 0x0a, 0x19, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98,
-	0xdf, 0x1f, 0x01, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x17, 0x6d, 0x61,
-	0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
-	0x52, 0x15, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x42, 0xe4, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x14,
-	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2e, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x6e, 0x64,
-	0x74, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x42, 0x61,
+	0xdf, 0x1f, 0x01, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x17,
+	0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x15, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x1c, 0x6d, 0x69, 0x73,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1a, 0x6d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x22, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1f, 0x6e, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xe4, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
+	0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x64,
+	0x74, 0x73, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x14, 0x42, 0x61,
 	0x6e, 0x64, 0x5c, 0x42, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x31, 0xe2, 0x02, 0x20, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x6e, 0x64, 0x74, 0x73,
+	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61,
+	0x6e, 0x64, 0x74, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1681,22 +2216,24 @@ var file_band_bandtss_v1beta1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),              // 1: band.bandtss.v1beta1.Params
 	(*Member)(nil),              // 2: band.bandtss.v1beta1.Member
 	(*CurrentGroup)(nil),        // 3: band.bandtss.v1beta1.CurrentGroup
-	(*durationpb.Duration)(nil), // 4: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),        // 5: cosmos.base.v1beta1.Coin
+	(*Evidence)(nil),            // 4: band.bandtss.v1beta1.Evidence
+	(*durationpb.Duration)(nil), // 5: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),        // 6: cosmos.base.v1beta1.Coin
 }
 var file_band_bandtss_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.bandtss.v1beta1.GenesisState.params:type_name -> band.bandtss.v1beta1.Params
 	2, // 1: band.bandtss.v1beta1.GenesisState.members:type_name -> band.bandtss.v1beta1.Member
 	3, // 2: band.bandtss.v1beta1.GenesisState.current_group:type_name -> band.bandtss.v1beta1.CurrentGroup
-	4, // 3: band.bandtss.v1beta1.Params.inactive_penalty_duration:type_name -> google.protobuf.Duration
-	4, // 4: band.bandtss.v1beta1.Params.min_transition_duration:type_name -> google.protobuf.Duration
-	4, // 5: band.bandtss.v1beta1.Params.max_transition_duration:type_name -> google.protobuf.Duration
-	5, // 6: band.bandtss.v1beta1.Params.fee_per_signer:type_name -> cosmos.base.v1beta1.Coin
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	4, // 3: band.bandtss.v1beta1.GenesisState.evidences:type_name -> band.bandtss.v1beta1.Evidence
+	5, // 4: band.bandtss.v1beta1.Params.inactive_penalty_duration:type_name -> google.protobuf.Duration
+	5, // 5: band.bandtss.v1beta1.Params.min_transition_duration:type_name -> google.protobuf.Duration
+	5, // 6: band.bandtss.v1beta1.Params.max_transition_duration:type_name -> google.protobuf.Duration
+	6, // 7: band.bandtss.v1beta1.Params.fee_per_signer:type_name -> cosmos.base.v1beta1.Coin
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_band_bandtss_v1beta1_genesis_proto_init() }
@@ -26,6 +26,9 @@ const (
 	Query_IncomingGroup_FullMethodName   = "/band.bandtss.v1beta1.Query/IncomingGroup"
 	Query_Signing_FullMethodName         = "/band.bandtss.v1beta1.Query/Signing"
 	Query_GroupTransition_FullMethodName = "/band.bandtss.v1beta1.Query/GroupTransition"
+	Query_Evidences_FullMethodName       = "/band.bandtss.v1beta1.Query/Evidences"
+	Query_Evidence_FullMethodName        = "/band.bandtss.v1beta1.Query/Evidence"
+	Query_ExcludedMembers_FullMethodName = "/band.bandtss.v1beta1.Query/ExcludedMembers"
 	Query_Params_FullMethodName          = "/band.bandtss.v1beta1.Query/Params"
 )
 
@@ -47,6 +50,12 @@ type QueryClient interface {
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(ctx context.Context, in *QueryGroupTransitionRequest, opts ...grpc.CallOption) (*QueryGroupTransitionResponse, error)
+	// Evidences queries the recorded evidences of misbehaving members.
+	Evidences(ctx context.Context, in *QueryEvidencesRequest, opts ...grpc.CallOption) (*QueryEvidencesResponse, error)
+	// Evidence queries the evidence of the given ID.
+	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
+	// ExcludedMembers queries the addresses that are excluded from signing assignments.
+	ExcludedMembers(ctx context.Context, in *QueryExcludedMembersRequest, opts ...grpc.CallOption) (*QueryExcludedMembersResponse, error)
 	// Params queries parameters of bandtss module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -122,6 +131,33 @@ func (c *queryClient) GroupTransition(ctx context.Context, in *QueryGroupTransit
 	return out, nil
 }
 
+func (c *queryClient) Evidences(ctx context.Context, in *QueryEvidencesRequest, opts ...grpc.CallOption) (*QueryEvidencesResponse, error) {
+	out := new(QueryEvidencesResponse)
+	err := c.cc.Invoke(ctx, Query_Evidences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
+	out := new(QueryEvidenceResponse)
+	err := c.cc.Invoke(ctx, Query_Evidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExcludedMembers(ctx context.Context, in *QueryExcludedMembersRequest, opts ...grpc.CallOption) (*QueryExcludedMembersResponse, error) {
+	out := new(QueryExcludedMembersResponse)
+	err := c.cc.Invoke(ctx, Query_ExcludedMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -149,6 +185,12 @@ type QueryServer interface {
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error)
+	// Evidences queries the recorded evidences of misbehaving members.
+	Evidences(context.Context, *QueryEvidencesRequest) (*QueryEvidencesResponse, error)
+	// Evidence queries the evidence of the given ID.
+	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
+	// ExcludedMembers queries the addresses that are excluded from signing assignments.
+	ExcludedMembers(context.Context, *QueryExcludedMembersRequest) (*QueryExcludedMembersResponse, error)
 	// Params queries parameters of bandtss module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -179,6 +221,15 @@ func (UnimplementedQueryServer) Signing(context.Context, *QuerySigningRequest) (
 func (UnimplementedQueryServer) GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupTransition not implemented")
 }
+func (UnimplementedQueryServer) Evidences(context.Context, *QueryEvidencesRequest) (*QueryEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidences not implemented")
+}
+func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+}
+func (UnimplementedQueryServer) ExcludedMembers(context.Context, *QueryExcludedMembersRequest) (*QueryExcludedMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExcludedMembers not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -321,6 +372,60 @@ func _Query_GroupTransition_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Evidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Evidences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidences(ctx, req.(*QueryEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Evidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExcludedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExcludedMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExcludedMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExcludedMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExcludedMembers(ctx, req.(*QueryExcludedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +479,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupTransition",
 			Handler:    _Query_GroupTransition_Handler,
 		},
+		{
+			MethodName: "Evidences",
+			Handler:    _Query_Evidences_Handler,
+		},
+		{
+			MethodName: "Evidence",
+			Handler:    _Query_Evidence_Handler,
+		},
+		{
+			MethodName: "ExcludedMembers",
+			Handler:    _Query_ExcludedMembers_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -24,6 +24,7 @@ const (
 	Msg_UpdateParams_FullMethodName         = "/band.bandtss.v1beta1.Msg/UpdateParams"
 	Msg_TransitionGroup_FullMethodName      = "/band.bandtss.v1beta1.Msg/TransitionGroup"
 	Msg_ForceTransitionGroup_FullMethodName = "/band.bandtss.v1beta1.Msg/ForceTransitionGroup"
+	Msg_ReadmitMember_FullMethodName        = "/band.bandtss.v1beta1.Msg/ReadmitMember"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +42,8 @@ type MsgClient interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(ctx context.Context, in *MsgForceTransitionGroup, opts ...grpc.CallOption) (*MsgForceTransitionGroupResponse, error)
+	// ReadmitMember lifts the exclusion of a member that was penalized for misbehavior.
+	ReadmitMember(ctx context.Context, in *MsgReadmitMember, opts ...grpc.CallOption) (*MsgReadmitMemberResponse, error)
 }
 
 type msgClient struct {
@@ -96,6 +99,15 @@ func (c *msgClient) ForceTransitionGroup(ctx context.Context, in *MsgForceTransi
 	return out, nil
 }
 
+func (c *msgClient) ReadmitMember(ctx context.Context, in *MsgReadmitMember, opts ...grpc.CallOption) (*MsgReadmitMemberResponse, error) {
+	out := new(MsgReadmitMemberResponse)
+	err := c.cc.Invoke(ctx, Msg_ReadmitMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +123,8 @@ type MsgServer interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error)
+	// ReadmitMember lifts the exclusion of a member that was penalized for misbehavior.
+	ReadmitMember(context.Context, *MsgReadmitMember) (*MsgReadmitMemberResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +147,9 @@ func (UnimplementedMsgServer) TransitionGroup(context.Context, *MsgTransitionGro
 func (UnimplementedMsgServer) ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransitionGroup not implemented")
 }
+func (UnimplementedMsgServer) ReadmitMember(context.Context, *MsgReadmitMember) (*MsgReadmitMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadmitMember not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -236,6 +253,24 @@ func _Msg_ForceTransitionGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReadmitMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReadmitMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReadmitMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReadmitMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReadmitMember(ctx, req.(*MsgReadmitMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,6 +298,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransitionGroup",
 			Handler:    _Msg_ForceTransitionGroup_Handler,
 		},
+		{
+			MethodName: "ReadmitMember",
+			Handler:    _Msg_ReadmitMember_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/bandtss/v1beta1/tx.proto",
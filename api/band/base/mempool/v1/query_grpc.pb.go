@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: band/base/mempool/v1/query.proto
+
+package mempoolv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_Lanes_FullMethodName     = "/band.base.mempool.v1.Service/Lanes"
+	Service_LaneTxs_FullMethodName   = "/band.base.mempool.v1.Service/LaneTxs"
+	Service_Proposals_FullMethodName = "/band.base.mempool.v1.Service/Proposals"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Lanes queries the current state of the lanes in the mempool of this node
+	Lanes(ctx context.Context, in *LanesRequest, opts ...grpc.CallOption) (*LanesResponse, error)
+	// LaneTxs queries the hashes of the transactions in a lane of the mempool of this node
+	LaneTxs(ctx context.Context, in *LaneTxsRequest, opts ...grpc.CallOption) (*LaneTxsResponse, error)
+	// Proposals queries the block space usage of the recent proposals prepared by this node
+	Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Lanes(ctx context.Context, in *LanesRequest, opts ...grpc.CallOption) (*LanesResponse, error) {
+	out := new(LanesResponse)
+	err := c.cc.Invoke(ctx, Service_Lanes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) LaneTxs(ctx context.Context, in *LaneTxsRequest, opts ...grpc.CallOption) (*LaneTxsResponse, error) {
+	out := new(LaneTxsResponse)
+	err := c.cc.Invoke(ctx, Service_LaneTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error) {
+	out := new(ProposalsResponse)
+	err := c.cc.Invoke(ctx, Service_Proposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// Lanes queries the current state of the lanes in the mempool of this node
+	Lanes(context.Context, *LanesRequest) (*LanesResponse, error)
+	// LaneTxs queries the hashes of the transactions in a lane of the mempool of this node
+	LaneTxs(context.Context, *LaneTxsRequest) (*LaneTxsResponse, error)
+	// Proposals queries the block space usage of the recent proposals prepared by this node
+	Proposals(context.Context, *ProposalsRequest) (*ProposalsResponse, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) Lanes(context.Context, *LanesRequest) (*LanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (UnimplementedServiceServer) LaneTxs(context.Context, *LaneTxsRequest) (*LaneTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaneTxs not implemented")
+}
+func (UnimplementedServiceServer) Proposals(context.Context, *ProposalsRequest) (*ProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Lanes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Lanes(ctx, req.(*LanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_LaneTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LaneTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LaneTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_LaneTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LaneTxs(ctx, req.(*LaneTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Proposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Proposals(ctx, req.(*ProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "band.base.mempool.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lanes",
+			Handler:    _Service_Lanes_Handler,
+		},
+		{
+			MethodName: "LaneTxs",
+			Handler:    _Service_LaneTxs_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Service_Proposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/base/mempool/v1/query.proto",
+}
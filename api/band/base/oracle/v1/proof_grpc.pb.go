@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Proof_FullMethodName             = "/band.base.oracle.v1.Service/Proof"
-	Service_MultiProof_FullMethodName        = "/band.base.oracle.v1.Service/MultiProof"
-	Service_RequestCountProof_FullMethodName = "/band.base.oracle.v1.Service/RequestCountProof"
+	Service_Proof_FullMethodName                = "/band.base.oracle.v1.Service/Proof"
+	Service_MultiProof_FullMethodName           = "/band.base.oracle.v1.Service/MultiProof"
+	Service_RequestCountProof_FullMethodName    = "/band.base.oracle.v1.Service/RequestCountProof"
+	Service_FeedsPriceProof_FullMethodName      = "/band.base.oracle.v1.Service/FeedsPriceProof"
+	Service_FeedsPriceMultiProof_FullMethodName = "/band.base.oracle.v1.Service/FeedsPriceMultiProof"
+	Service_TunnelPacketProof_FullMethodName    = "/band.base.oracle.v1.Service/TunnelPacketProof"
 )
 
 // ServiceClient is the client API for Service service.
@@ -34,6 +37,12 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// FeedsPriceProof queries the proof for the price of given signal ID
+	FeedsPriceProof(ctx context.Context, in *FeedsPriceProofRequest, opts ...grpc.CallOption) (*FeedsPriceProofResponse, error)
+	// FeedsPriceMultiProof queries the proof for the prices of given list of signal IDs
+	FeedsPriceMultiProof(ctx context.Context, in *FeedsPriceMultiProofRequest, opts ...grpc.CallOption) (*FeedsPriceMultiProofResponse, error)
+	// TunnelPacketProof queries the proof for the packet of given tunnel ID and sequence
+	TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error)
 }
 
 type serviceClient struct {
@@ -71,6 +80,33 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) FeedsPriceProof(ctx context.Context, in *FeedsPriceProofRequest, opts ...grpc.CallOption) (*FeedsPriceProofResponse, error) {
+	out := new(FeedsPriceProofResponse)
+	err := c.cc.Invoke(ctx, Service_FeedsPriceProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) FeedsPriceMultiProof(ctx context.Context, in *FeedsPriceMultiProofRequest, opts ...grpc.CallOption) (*FeedsPriceMultiProofResponse, error) {
+	out := new(FeedsPriceMultiProofResponse)
+	err := c.cc.Invoke(ctx, Service_FeedsPriceMultiProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error) {
+	out := new(TunnelPacketProofResponse)
+	err := c.cc.Invoke(ctx, Service_TunnelPacketProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -81,6 +117,12 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// FeedsPriceProof queries the proof for the price of given signal ID
+	FeedsPriceProof(context.Context, *FeedsPriceProofRequest) (*FeedsPriceProofResponse, error)
+	// FeedsPriceMultiProof queries the proof for the prices of given list of signal IDs
+	FeedsPriceMultiProof(context.Context, *FeedsPriceMultiProofRequest) (*FeedsPriceMultiProofResponse, error)
+	// TunnelPacketProof queries the proof for the packet of given tunnel ID and sequence
+	TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -97,6 +139,15 @@ func (UnimplementedServiceServer) MultiProof(context.Context, *MultiProofRequest
 func (UnimplementedServiceServer) RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (UnimplementedServiceServer) FeedsPriceProof(context.Context, *FeedsPriceProofRequest) (*FeedsPriceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedsPriceProof not implemented")
+}
+func (UnimplementedServiceServer) FeedsPriceMultiProof(context.Context, *FeedsPriceMultiProofRequest) (*FeedsPriceMultiProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedsPriceMultiProof not implemented")
+}
+func (UnimplementedServiceServer) TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelPacketProof not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +215,60 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_FeedsPriceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedsPriceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).FeedsPriceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_FeedsPriceProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FeedsPriceProof(ctx, req.(*FeedsPriceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_FeedsPriceMultiProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedsPriceMultiProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).FeedsPriceMultiProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_FeedsPriceMultiProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FeedsPriceMultiProof(ctx, req.(*FeedsPriceMultiProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TunnelPacketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelPacketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TunnelPacketProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, req.(*TunnelPacketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +288,18 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "FeedsPriceProof",
+			Handler:    _Service_FeedsPriceProof_Handler,
+		},
+		{
+			MethodName: "FeedsPriceMultiProof",
+			Handler:    _Service_FeedsPriceMultiProof_Handler,
+		},
+		{
+			MethodName: "TunnelPacketProof",
+			Handler:    _Service_TunnelPacketProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: band/cylinder/signer/v1/signer.proto
+
+package signerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Signer_Round1_FullMethodName      = "/band.cylinder.signer.v1.Signer/Round1"
+	Signer_Round2_FullMethodName      = "/band.cylinder.signer.v1.Signer/Round2"
+	Signer_Round3_FullMethodName      = "/band.cylinder.signer.v1.Signer/Round3"
+	Signer_GenerateDEs_FullMethodName = "/band.cylinder.signer.v1.Signer/GenerateDEs"
+	Signer_DeleteDE_FullMethodName    = "/band.cylinder.signer.v1.Signer/DeleteDE"
+	Signer_Sign_FullMethodName        = "/band.cylinder.signer.v1.Signer/Sign"
+	Signer_Status_FullMethodName      = "/band.cylinder.signer.v1.Signer/Status"
+)
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignerClient interface {
+	// Round1 generates and keeps the secrets of round 1 and returns the public round 1 information.
+	Round1(ctx context.Context, in *Round1Request, opts ...grpc.CallOption) (*Round1Response, error)
+	// Round2 computes the encrypted secret shares of round 2 for the other members.
+	Round2(ctx context.Context, in *Round2Request, opts ...grpc.CallOption) (*Round2Response, error)
+	// Round3 computes and keeps the private key of the member, or returns complaints against
+	// members that send invalid secret shares.
+	Round3(ctx context.Context, in *Round3Request, opts ...grpc.CallOption) (*Round3Response, error)
+	// GenerateDEs generates and keeps new DE pairs and returns their public values.
+	GenerateDEs(ctx context.Context, in *GenerateDEsRequest, opts ...grpc.CallOption) (*GenerateDEsResponse, error)
+	// DeleteDE deletes the DE pair of the given public values.
+	DeleteDE(ctx context.Context, in *DeleteDERequest, opts ...grpc.CallOption) (*DeleteDEResponse, error)
+	// Sign computes the partial signature of the member for a signing.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Status returns the number of secrets kept by the signer.
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+}
+
+type signerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignerClient(cc grpc.ClientConnInterface) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) Round1(ctx context.Context, in *Round1Request, opts ...grpc.CallOption) (*Round1Response, error) {
+	out := new(Round1Response)
+	err := c.cc.Invoke(ctx, Signer_Round1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Round2(ctx context.Context, in *Round2Request, opts ...grpc.CallOption) (*Round2Response, error) {
+	out := new(Round2Response)
+	err := c.cc.Invoke(ctx, Signer_Round2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Round3(ctx context.Context, in *Round3Request, opts ...grpc.CallOption) (*Round3Response, error) {
+	out := new(Round3Response)
+	err := c.cc.Invoke(ctx, Signer_Round3_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) GenerateDEs(ctx context.Context, in *GenerateDEsRequest, opts ...grpc.CallOption) (*GenerateDEsResponse, error) {
+	out := new(GenerateDEsResponse)
+	err := c.cc.Invoke(ctx, Signer_GenerateDEs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeleteDE(ctx context.Context, in *DeleteDERequest, opts ...grpc.CallOption) (*DeleteDEResponse, error) {
+	out := new(DeleteDEResponse)
+	err := c.cc.Invoke(ctx, Signer_DeleteDE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, Signer_Sign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, Signer_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+// All implementations must embed UnimplementedSignerServer
+// for forward compatibility
+type SignerServer interface {
+	// Round1 generates and keeps the secrets of round 1 and returns the public round 1 information.
+	Round1(context.Context, *Round1Request) (*Round1Response, error)
+	// Round2 computes the encrypted secret shares of round 2 for the other members.
+	Round2(context.Context, *Round2Request) (*Round2Response, error)
+	// Round3 computes and keeps the private key of the member, or returns complaints against
+	// members that send invalid secret shares.
+	Round3(context.Context, *Round3Request) (*Round3Response, error)
+	// GenerateDEs generates and keeps new DE pairs and returns their public values.
+	GenerateDEs(context.Context, *GenerateDEsRequest) (*GenerateDEsResponse, error)
+	// DeleteDE deletes the DE pair of the given public values.
+	DeleteDE(context.Context, *DeleteDERequest) (*DeleteDEResponse, error)
+	// Sign computes the partial signature of the member for a signing.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	// Status returns the number of secrets kept by the signer.
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	mustEmbedUnimplementedSignerServer()
+}
+
+// UnimplementedSignerServer must be embedded to have forward compatible implementations.
+type UnimplementedSignerServer struct {
+}
+
+func (UnimplementedSignerServer) Round1(context.Context, *Round1Request) (*Round1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round1 not implemented")
+}
+func (UnimplementedSignerServer) Round2(context.Context, *Round2Request) (*Round2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round2 not implemented")
+}
+func (UnimplementedSignerServer) Round3(context.Context, *Round3Request) (*Round3Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round3 not implemented")
+}
+func (UnimplementedSignerServer) GenerateDEs(context.Context, *GenerateDEsRequest) (*GenerateDEsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDEs not implemented")
+}
+func (UnimplementedSignerServer) DeleteDE(context.Context, *DeleteDERequest) (*DeleteDEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDE not implemented")
+}
+func (UnimplementedSignerServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedSignerServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedSignerServer) mustEmbedUnimplementedSignerServer() {}
+
+// UnsafeSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignerServer will
+// result in compilation errors.
+type UnsafeSignerServer interface {
+	mustEmbedUnimplementedSignerServer()
+}
+
+func RegisterSignerServer(s grpc.ServiceRegistrar, srv SignerServer) {
+	s.RegisterService(&Signer_ServiceDesc, srv)
+}
+
+func _Signer_Round1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_Round1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round1(ctx, req.(*Round1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Round2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_Round2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round2(ctx, req.(*Round2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Round3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round3Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_Round3_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round3(ctx, req.(*Round3Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_GenerateDEs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDEsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).GenerateDEs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_GenerateDEs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).GenerateDEs(ctx, req.(*GenerateDEsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeleteDE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeleteDE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_DeleteDE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeleteDE(ctx, req.(*DeleteDERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Signer_ServiceDesc is the grpc.ServiceDesc for Signer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Signer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "band.cylinder.signer.v1.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Round1",
+			Handler:    _Signer_Round1_Handler,
+		},
+		{
+			MethodName: "Round2",
+			Handler:    _Signer_Round2_Handler,
+		},
+		{
+			MethodName: "Round3",
+			Handler:    _Signer_Round3_Handler,
+		},
+		{
+			MethodName: "GenerateDEs",
+			Handler:    _Signer_GenerateDEs_Handler,
+		},
+		{
+			MethodName: "DeleteDE",
+			Handler:    _Signer_DeleteDE_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Signer_Sign_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Signer_Status_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/cylinder/signer/v1/signer.proto",
+}
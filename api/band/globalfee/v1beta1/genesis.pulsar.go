@@ -503,12 +503,16 @@ func (x *_Params_1_list) IsValid() bool {
 var (
 	md_Params                    protoreflect.MessageDescriptor
 	fd_Params_minimum_gas_prices protoreflect.FieldDescriptor
+	fd_Params_free_tx_quota      protoreflect.FieldDescriptor
+	fd_Params_fee_denom_pricing  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_globalfee_v1beta1_genesis_proto_init()
 	md_Params = File_band_globalfee_v1beta1_genesis_proto.Messages().ByName("Params")
 	fd_Params_minimum_gas_prices = md_Params.Fields().ByName("minimum_gas_prices")
+	fd_Params_free_tx_quota = md_Params.Fields().ByName("free_tx_quota")
+	fd_Params_fee_denom_pricing = md_Params.Fields().ByName("fee_denom_pricing")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -582,6 +586,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.FreeTxQuota != nil {
+		value := protoreflect.ValueOfMessage(x.FreeTxQuota.ProtoReflect())
+		if !f(fd_Params_free_tx_quota, value) {
+			return
+		}
+	}
+	if x.FeeDenomPricing != nil {
+		value := protoreflect.ValueOfMessage(x.FeeDenomPricing.ProtoReflect())
+		if !f(fd_Params_fee_denom_pricing, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -599,6 +615,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
 		return len(x.MinimumGasPrices) != 0
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		return x.FreeTxQuota != nil
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		return x.FeeDenomPricing != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
@@ -617,11 +637,1818 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
 		x.MinimumGasPrices = nil
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		x.FreeTxQuota = nil
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		x.FeeDenomPricing = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
+		if len(x.MinimumGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.MinimumGasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		value := x.FreeTxQuota
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		value := x.FeeDenomPricing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.MinimumGasPrices = *clv.list
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		x.FreeTxQuota = value.Message().Interface().(*FreeTxQuota)
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		x.FeeDenomPricing = value.Message().Interface().(*FeeDenomPricing)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
+		if x.MinimumGasPrices == nil {
+			x.MinimumGasPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_1_list{list: &x.MinimumGasPrices}
+		return protoreflect.ValueOfList(value)
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		if x.FreeTxQuota == nil {
+			x.FreeTxQuota = new(FreeTxQuota)
+		}
+		return protoreflect.ValueOfMessage(x.FreeTxQuota.ProtoReflect())
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		if x.FeeDenomPricing == nil {
+			x.FeeDenomPricing = new(FeeDenomPricing)
+		}
+		return protoreflect.ValueOfMessage(x.FeeDenomPricing.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "band.globalfee.v1beta1.Params.free_tx_quota":
+		m := new(FreeTxQuota)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.globalfee.v1beta1.Params.fee_denom_pricing":
+		m := new(FeeDenomPricing)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
 		}
-		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.globalfee.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MinimumGasPrices) > 0 {
+			for _, e := range x.MinimumGasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FreeTxQuota != nil {
+			l = options.Size(x.FreeTxQuota)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDenomPricing != nil {
+			l = options.Size(x.FeeDenomPricing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FeeDenomPricing != nil {
+			encoded, err := options.Marshal(x.FeeDenomPricing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.FreeTxQuota != nil {
+			encoded, err := options.Marshal(x.FreeTxQuota)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinimumGasPrices) > 0 {
+			for iNdEx := len(x.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinimumGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumGasPrices = append(x.MinimumGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinimumGasPrices[len(x.MinimumGasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FreeTxQuota", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FreeTxQuota == nil {
+					x.FreeTxQuota = &FreeTxQuota{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FreeTxQuota); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPricing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeDenomPricing == nil {
+					x.FeeDenomPricing = &FeeDenomPricing{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDenomPricing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FreeTxQuota                      protoreflect.MessageDescriptor
+	fd_FreeTxQuota_window               protoreflect.FieldDescriptor
+	fd_FreeTxQuota_max_txs_per_operator protoreflect.FieldDescriptor
+	fd_FreeTxQuota_max_txs_per_grantee  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_globalfee_v1beta1_genesis_proto_init()
+	md_FreeTxQuota = File_band_globalfee_v1beta1_genesis_proto.Messages().ByName("FreeTxQuota")
+	fd_FreeTxQuota_window = md_FreeTxQuota.Fields().ByName("window")
+	fd_FreeTxQuota_max_txs_per_operator = md_FreeTxQuota.Fields().ByName("max_txs_per_operator")
+	fd_FreeTxQuota_max_txs_per_grantee = md_FreeTxQuota.Fields().ByName("max_txs_per_grantee")
+}
+
+var _ protoreflect.Message = (*fastReflection_FreeTxQuota)(nil)
+
+type fastReflection_FreeTxQuota FreeTxQuota
+
+func (x *FreeTxQuota) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FreeTxQuota)(x)
+}
+
+func (x *FreeTxQuota) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FreeTxQuota_messageType fastReflection_FreeTxQuota_messageType
+var _ protoreflect.MessageType = fastReflection_FreeTxQuota_messageType{}
+
+type fastReflection_FreeTxQuota_messageType struct{}
+
+func (x fastReflection_FreeTxQuota_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FreeTxQuota)(nil)
+}
+func (x fastReflection_FreeTxQuota_messageType) New() protoreflect.Message {
+	return new(fastReflection_FreeTxQuota)
+}
+func (x fastReflection_FreeTxQuota_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FreeTxQuota
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FreeTxQuota) Descriptor() protoreflect.MessageDescriptor {
+	return md_FreeTxQuota
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FreeTxQuota) Type() protoreflect.MessageType {
+	return _fastReflection_FreeTxQuota_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FreeTxQuota) New() protoreflect.Message {
+	return new(fastReflection_FreeTxQuota)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FreeTxQuota) Interface() protoreflect.ProtoMessage {
+	return (*FreeTxQuota)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FreeTxQuota) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_FreeTxQuota_window, value) {
+			return
+		}
+	}
+	if x.MaxTxsPerOperator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxsPerOperator)
+		if !f(fd_FreeTxQuota_max_txs_per_operator, value) {
+			return
+		}
+	}
+	if x.MaxTxsPerGrantee != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxsPerGrantee)
+		if !f(fd_FreeTxQuota_max_txs_per_grantee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FreeTxQuota) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		return x.Window != uint64(0)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		return x.MaxTxsPerOperator != uint64(0)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		return x.MaxTxsPerGrantee != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FreeTxQuota) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		x.Window = uint64(0)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		x.MaxTxsPerOperator = uint64(0)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		x.MaxTxsPerGrantee = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FreeTxQuota) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		value := x.MaxTxsPerOperator
+		return protoreflect.ValueOfUint64(value)
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		value := x.MaxTxsPerGrantee
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FreeTxQuota) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		x.Window = value.Uint()
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		x.MaxTxsPerOperator = value.Uint()
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		x.MaxTxsPerGrantee = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FreeTxQuota) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		panic(fmt.Errorf("field window of message band.globalfee.v1beta1.FreeTxQuota is not mutable"))
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		panic(fmt.Errorf("field max_txs_per_operator of message band.globalfee.v1beta1.FreeTxQuota is not mutable"))
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		panic(fmt.Errorf("field max_txs_per_grantee of message band.globalfee.v1beta1.FreeTxQuota is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FreeTxQuota) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FreeTxQuota.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_operator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.globalfee.v1beta1.FreeTxQuota.max_txs_per_grantee":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FreeTxQuota"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FreeTxQuota does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FreeTxQuota) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.globalfee.v1beta1.FreeTxQuota", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FreeTxQuota) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FreeTxQuota) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FreeTxQuota) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FreeTxQuota) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FreeTxQuota)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.MaxTxsPerOperator != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxsPerOperator))
+		}
+		if x.MaxTxsPerGrantee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxsPerGrantee))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FreeTxQuota)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxTxsPerGrantee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxsPerGrantee))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxTxsPerOperator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxsPerOperator))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FreeTxQuota)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FreeTxQuota: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FreeTxQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerOperator", wireType)
+				}
+				x.MaxTxsPerOperator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTxsPerOperator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerGrantee", wireType)
+				}
+				x.MaxTxsPerGrantee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTxsPerGrantee |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeDenomPricing_3_list)(nil)
+
+type _FeeDenomPricing_3_list struct {
+	list *[]*FeeDenom
+}
+
+func (x *_FeeDenomPricing_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeDenomPricing_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeDenomPricing_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeDenomPricing_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeDenomPricing_3_list) AppendMutable() protoreflect.Value {
+	v := new(FeeDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeDenomPricing_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeDenomPricing_3_list) NewElement() protoreflect.Value {
+	v := new(FeeDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeDenomPricing_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeDenomPricing                      protoreflect.MessageDescriptor
+	fd_FeeDenomPricing_bond_denom_signal_id protoreflect.FieldDescriptor
+	fd_FeeDenomPricing_bond_denom_exponent  protoreflect.FieldDescriptor
+	fd_FeeDenomPricing_fee_denoms           protoreflect.FieldDescriptor
+	fd_FeeDenomPricing_max_price_age        protoreflect.FieldDescriptor
+	fd_FeeDenomPricing_markup_bps           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_globalfee_v1beta1_genesis_proto_init()
+	md_FeeDenomPricing = File_band_globalfee_v1beta1_genesis_proto.Messages().ByName("FeeDenomPricing")
+	fd_FeeDenomPricing_bond_denom_signal_id = md_FeeDenomPricing.Fields().ByName("bond_denom_signal_id")
+	fd_FeeDenomPricing_bond_denom_exponent = md_FeeDenomPricing.Fields().ByName("bond_denom_exponent")
+	fd_FeeDenomPricing_fee_denoms = md_FeeDenomPricing.Fields().ByName("fee_denoms")
+	fd_FeeDenomPricing_max_price_age = md_FeeDenomPricing.Fields().ByName("max_price_age")
+	fd_FeeDenomPricing_markup_bps = md_FeeDenomPricing.Fields().ByName("markup_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDenomPricing)(nil)
+
+type fastReflection_FeeDenomPricing FeeDenomPricing
+
+func (x *FeeDenomPricing) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDenomPricing)(x)
+}
+
+func (x *FeeDenomPricing) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDenomPricing_messageType fastReflection_FeeDenomPricing_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDenomPricing_messageType{}
+
+type fastReflection_FeeDenomPricing_messageType struct{}
+
+func (x fastReflection_FeeDenomPricing_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDenomPricing)(nil)
+}
+func (x fastReflection_FeeDenomPricing_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomPricing)
+}
+func (x fastReflection_FeeDenomPricing_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomPricing
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDenomPricing) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomPricing
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDenomPricing) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDenomPricing_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDenomPricing) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomPricing)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDenomPricing) Interface() protoreflect.ProtoMessage {
+	return (*FeeDenomPricing)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDenomPricing) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BondDenomSignalId != "" {
+		value := protoreflect.ValueOfString(x.BondDenomSignalId)
+		if !f(fd_FeeDenomPricing_bond_denom_signal_id, value) {
+			return
+		}
+	}
+	if x.BondDenomExponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BondDenomExponent)
+		if !f(fd_FeeDenomPricing_bond_denom_exponent, value) {
+			return
+		}
+	}
+	if len(x.FeeDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_FeeDenomPricing_3_list{list: &x.FeeDenoms})
+		if !f(fd_FeeDenomPricing_fee_denoms, value) {
+			return
+		}
+	}
+	if x.MaxPriceAge != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxPriceAge)
+		if !f(fd_FeeDenomPricing_max_price_age, value) {
+			return
+		}
+	}
+	if x.MarkupBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarkupBps)
+		if !f(fd_FeeDenomPricing_markup_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDenomPricing) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		return x.BondDenomSignalId != ""
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		return x.BondDenomExponent != uint32(0)
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		return len(x.FeeDenoms) != 0
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		return x.MaxPriceAge != int64(0)
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		return x.MarkupBps != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPricing) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		x.BondDenomSignalId = ""
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		x.BondDenomExponent = uint32(0)
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		x.FeeDenoms = nil
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		x.MaxPriceAge = int64(0)
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		x.MarkupBps = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDenomPricing) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		value := x.BondDenomSignalId
+		return protoreflect.ValueOfString(value)
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		value := x.BondDenomExponent
+		return protoreflect.ValueOfUint32(value)
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		if len(x.FeeDenoms) == 0 {
+			return protoreflect.ValueOfList(&_FeeDenomPricing_3_list{})
+		}
+		listValue := &_FeeDenomPricing_3_list{list: &x.FeeDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		value := x.MaxPriceAge
+		return protoreflect.ValueOfInt64(value)
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		value := x.MarkupBps
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPricing) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		x.BondDenomSignalId = value.Interface().(string)
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		x.BondDenomExponent = uint32(value.Uint())
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		lv := value.List()
+		clv := lv.(*_FeeDenomPricing_3_list)
+		x.FeeDenoms = *clv.list
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		x.MaxPriceAge = value.Int()
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		x.MarkupBps = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPricing) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		if x.FeeDenoms == nil {
+			x.FeeDenoms = []*FeeDenom{}
+		}
+		value := &_FeeDenomPricing_3_list{list: &x.FeeDenoms}
+		return protoreflect.ValueOfList(value)
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		panic(fmt.Errorf("field bond_denom_signal_id of message band.globalfee.v1beta1.FeeDenomPricing is not mutable"))
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		panic(fmt.Errorf("field bond_denom_exponent of message band.globalfee.v1beta1.FeeDenomPricing is not mutable"))
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		panic(fmt.Errorf("field max_price_age of message band.globalfee.v1beta1.FeeDenomPricing is not mutable"))
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		panic(fmt.Errorf("field markup_bps of message band.globalfee.v1beta1.FeeDenomPricing is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDenomPricing) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.globalfee.v1beta1.FeeDenomPricing.bond_denom_exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.globalfee.v1beta1.FeeDenomPricing.fee_denoms":
+		list := []*FeeDenom{}
+		return protoreflect.ValueOfList(&_FeeDenomPricing_3_list{list: &list})
+	case "band.globalfee.v1beta1.FeeDenomPricing.max_price_age":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.globalfee.v1beta1.FeeDenomPricing.markup_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenomPricing"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenomPricing does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDenomPricing) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.globalfee.v1beta1.FeeDenomPricing", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDenomPricing) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPricing) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDenomPricing) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDenomPricing) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDenomPricing)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BondDenomSignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BondDenomExponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.BondDenomExponent))
+		}
+		if len(x.FeeDenoms) > 0 {
+			for _, e := range x.FeeDenoms {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPriceAge != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceAge))
+		}
+		if x.MarkupBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarkupBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomPricing)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MarkupBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarkupBps))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxPriceAge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceAge))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.FeeDenoms) > 0 {
+			for iNdEx := len(x.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.BondDenomExponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BondDenomExponent))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.BondDenomSignalId) > 0 {
+			i -= len(x.BondDenomSignalId)
+			copy(dAtA[i:], x.BondDenomSignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenomSignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomPricing)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomPricing: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenomSignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondDenomSignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenomExponent", wireType)
+				}
+				x.BondDenomExponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BondDenomExponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDenoms = append(x.FeeDenoms, &FeeDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDenoms[len(x.FeeDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+				}
+				x.MaxPriceAge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceAge |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarkupBps", wireType)
+				}
+				x.MarkupBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarkupBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDenom           protoreflect.MessageDescriptor
+	fd_FeeDenom_denom     protoreflect.FieldDescriptor
+	fd_FeeDenom_signal_id protoreflect.FieldDescriptor
+	fd_FeeDenom_exponent  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_globalfee_v1beta1_genesis_proto_init()
+	md_FeeDenom = File_band_globalfee_v1beta1_genesis_proto.Messages().ByName("FeeDenom")
+	fd_FeeDenom_denom = md_FeeDenom.Fields().ByName("denom")
+	fd_FeeDenom_signal_id = md_FeeDenom.Fields().ByName("signal_id")
+	fd_FeeDenom_exponent = md_FeeDenom.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDenom)(nil)
+
+type fastReflection_FeeDenom FeeDenom
+
+func (x *FeeDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDenom)(x)
+}
+
+func (x *FeeDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDenom_messageType fastReflection_FeeDenom_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDenom_messageType{}
+
+type fastReflection_FeeDenom_messageType struct{}
+
+func (x fastReflection_FeeDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDenom)(nil)
+}
+func (x fastReflection_FeeDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDenom)
+}
+func (x fastReflection_FeeDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDenom) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDenom) New() protoreflect.Message {
+	return new(fastReflection_FeeDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDenom) Interface() protoreflect.ProtoMessage {
+	return (*FeeDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeDenom_denom, value) {
+			return
+		}
+	}
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_FeeDenom_signal_id, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_FeeDenom_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		return x.Denom != ""
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		return x.SignalId != ""
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		return x.Exponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		x.Denom = ""
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		x.SignalId = ""
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		x.Exponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
+		}
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -631,19 +2458,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
-		if len(x.MinimumGasPrices) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
-		}
-		listValue := &_Params_1_list{list: &x.MinimumGasPrices}
-		return protoreflect.ValueOfList(listValue)
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
 		}
-		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -657,17 +2487,19 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.MinimumGasPrices = *clv.list
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		x.Exponent = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
 		}
-		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -681,45 +2513,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
-		if x.MinimumGasPrices == nil {
-			x.MinimumGasPrices = []*v1beta1.DecCoin{}
-		}
-		value := &_Params_1_list{list: &x.MinimumGasPrices}
-		return protoreflect.ValueOfList(value)
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		panic(fmt.Errorf("field denom of message band.globalfee.v1beta1.FeeDenom is not mutable"))
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.globalfee.v1beta1.FeeDenom is not mutable"))
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		panic(fmt.Errorf("field exponent of message band.globalfee.v1beta1.FeeDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
 		}
-		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.globalfee.v1beta1.Params.minimum_gas_prices":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "band.globalfee.v1beta1.FeeDenom.denom":
+		return protoreflect.ValueOfString("")
+	case "band.globalfee.v1beta1.FeeDenom.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.globalfee.v1beta1.FeeDenom.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.globalfee.v1beta1.FeeDenom"))
 		}
-		panic(fmt.Errorf("message band.globalfee.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.globalfee.v1beta1.FeeDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.globalfee.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.globalfee.v1beta1.FeeDenom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -727,7 +2562,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeDenom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -738,7 +2573,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeDenom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -750,7 +2585,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_FeeDenom) IsValid() bool {
 	return x != nil
 }
 
@@ -760,9 +2595,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeDenom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeDenom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -774,11 +2609,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinimumGasPrices) > 0 {
-			for _, e := range x.MinimumGasPrices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -790,7 +2630,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeDenom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -809,21 +2649,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinimumGasPrices) > 0 {
-			for iNdEx := len(x.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinimumGasPrices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -836,7 +2679,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeDenom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -868,17 +2711,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -888,26 +2731,75 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinimumGasPrices = append(x.MinimumGasPrices, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinimumGasPrices[len(x.MinimumGasPrices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1005,6 +2897,12 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices []*v1beta1.DecCoin `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3" json:"minimum_gas_prices,omitempty"`
+	// FreeTxQuota limits the number of fee-free transactions of the feeds, TSS and oracle report lanes
+	// that an account can send in a window of blocks.
+	FreeTxQuota *FreeTxQuota `protobuf:"bytes,2,opt,name=free_tx_quota,json=freeTxQuota,proto3" json:"free_tx_quota,omitempty"`
+	// FeeDenomPricing defines the alternative fee denoms whose minimum fees are derived from the bond denom
+	// gas price and the prices in x/feeds.
+	FeeDenomPricing *FeeDenomPricing `protobuf:"bytes,3,opt,name=fee_denom_pricing,json=feeDenomPricing,proto3" json:"fee_denom_pricing,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1034,6 +2932,209 @@ func (x *Params) GetMinimumGasPrices() []*v1beta1.DecCoin {
 	return nil
 }
 
+func (x *Params) GetFreeTxQuota() *FreeTxQuota {
+	if x != nil {
+		return x.FreeTxQuota
+	}
+	return nil
+}
+
+func (x *Params) GetFeeDenomPricing() *FeeDenomPricing {
+	if x != nil {
+		return x.FeeDenomPricing
+	}
+	return nil
+}
+
+// FreeTxQuota defines the limits on fee-free transactions per account. A transaction over the quota is
+// not admitted for free and requires the normal fees instead.
+type FreeTxQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Window is the number of blocks in a quota window. Zero disables the quota.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// MaxTxsPerOperator is the maximum number of free transactions in a window on behalf of an account,
+	// either signed by the account or executed by its authz grantees. Zero means unlimited.
+	MaxTxsPerOperator uint64 `protobuf:"varint,2,opt,name=max_txs_per_operator,json=maxTxsPerOperator,proto3" json:"max_txs_per_operator,omitempty"`
+	// MaxTxsPerGrantee is the maximum number of free transactions in a window executed by an authz grantee.
+	// Zero means unlimited.
+	MaxTxsPerGrantee uint64 `protobuf:"varint,3,opt,name=max_txs_per_grantee,json=maxTxsPerGrantee,proto3" json:"max_txs_per_grantee,omitempty"`
+}
+
+func (x *FreeTxQuota) Reset() {
+	*x = FreeTxQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeTxQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeTxQuota) ProtoMessage() {}
+
+// Deprecated: Use FreeTxQuota.ProtoReflect.Descriptor instead.
+func (*FreeTxQuota) Descriptor() ([]byte, []int) {
+	return file_band_globalfee_v1beta1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FreeTxQuota) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *FreeTxQuota) GetMaxTxsPerOperator() uint64 {
+	if x != nil {
+		return x.MaxTxsPerOperator
+	}
+	return 0
+}
+
+func (x *FreeTxQuota) GetMaxTxsPerGrantee() uint64 {
+	if x != nil {
+		return x.MaxTxsPerGrantee
+	}
+	return 0
+}
+
+// FeeDenomPricing defines the alternative fee denoms priced by x/feeds. The minimum fee in an alternative
+// fee denom is the minimum fee in the bond denom converted with the prices of both denoms, plus the markup.
+type FeeDenomPricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BondDenomSignalID is the signal id of the price of one display unit of the bond denom.
+	BondDenomSignalId string `protobuf:"bytes,1,opt,name=bond_denom_signal_id,json=bondDenomSignalId,proto3" json:"bond_denom_signal_id,omitempty"`
+	// BondDenomExponent is the number of decimals of the display unit of the bond denom.
+	BondDenomExponent uint32 `protobuf:"varint,2,opt,name=bond_denom_exponent,json=bondDenomExponent,proto3" json:"bond_denom_exponent,omitempty"`
+	// FeeDenoms is the list of alternative fee denoms.
+	FeeDenoms []*FeeDenom `protobuf:"bytes,3,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty"`
+	// MaxPriceAge is the maximum age in seconds of a price to be used. The alternative fee denoms are not
+	// accepted while their prices are older.
+	MaxPriceAge int64 `protobuf:"varint,4,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// MarkupBPS is the markup in basis points added to the minimum fees in the alternative fee denoms
+	// against price changes.
+	MarkupBps uint64 `protobuf:"varint,5,opt,name=markup_bps,json=markupBps,proto3" json:"markup_bps,omitempty"`
+}
+
+func (x *FeeDenomPricing) Reset() {
+	*x = FeeDenomPricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDenomPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDenomPricing) ProtoMessage() {}
+
+// Deprecated: Use FeeDenomPricing.ProtoReflect.Descriptor instead.
+func (*FeeDenomPricing) Descriptor() ([]byte, []int) {
+	return file_band_globalfee_v1beta1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeDenomPricing) GetBondDenomSignalId() string {
+	if x != nil {
+		return x.BondDenomSignalId
+	}
+	return ""
+}
+
+func (x *FeeDenomPricing) GetBondDenomExponent() uint32 {
+	if x != nil {
+		return x.BondDenomExponent
+	}
+	return 0
+}
+
+func (x *FeeDenomPricing) GetFeeDenoms() []*FeeDenom {
+	if x != nil {
+		return x.FeeDenoms
+	}
+	return nil
+}
+
+func (x *FeeDenomPricing) GetMaxPriceAge() int64 {
+	if x != nil {
+		return x.MaxPriceAge
+	}
+	return 0
+}
+
+func (x *FeeDenomPricing) GetMarkupBps() uint64 {
+	if x != nil {
+		return x.MarkupBps
+	}
+	return 0
+}
+
+// FeeDenom defines an alternative fee denom priced by x/feeds.
+type FeeDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Denom is the denom of the fee.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// SignalID is the signal id of the price of one display unit of the denom.
+	SignalId string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// Exponent is the number of decimals of the display unit of the denom.
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *FeeDenom) Reset() {
+	*x = FeeDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_globalfee_v1beta1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDenom) ProtoMessage() {}
+
+// Deprecated: Use FeeDenom.ProtoReflect.Descriptor instead.
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return file_band_globalfee_v1beta1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeDenom) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeDenom) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *FeeDenom) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
 var File_band_globalfee_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_globalfee_v1beta1_genesis_proto_rawDesc = []byte{
@@ -1050,7 +3151,7 @@ var file_band_globalfee_v1beta1_genesis_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x10, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe1, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0xbc, 0x01, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x67, 0x61,
 	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
@@ -1063,6 +3164,57 @@ var file_band_globalfee_v1beta1_genesis_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10,
 	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x80, 0x01, 0x0a, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x78, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x54, 0x78, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x94, 0x01, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x66, 0x65, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x3f, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x1b, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f,
+	0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x46,
+	0x72, 0x65, 0x65, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x14, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x42, 0x6f, 0x6e, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x11, 0x62, 0x6f, 0x6e,
+	0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x65, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x6f, 0x6e,
+	0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x66, 0x65, 0x65, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x6d, 0x61, 0x72,
+	0x6b, 0x75, 0x70, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0xe2,
+	0xde, 0x1f, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x75, 0x70, 0x42, 0x50, 0x53, 0x52, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x75, 0x70, 0x42, 0x70, 0x73, 0x22, 0x67, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde,
+	0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
 	0x42, 0xf2, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x67, 0x6c,
 	0x6f, 0x62, 0x61, 0x6c, 0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
@@ -1093,20 +3245,26 @@ func file_band_globalfee_v1beta1_genesis_proto_rawDescGZIP() []byte {
 	return file_band_globalfee_v1beta1_genesis_proto_rawDescData
 }
 
-var file_band_globalfee_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_band_globalfee_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_band_globalfee_v1beta1_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),    // 0: band.globalfee.v1beta1.GenesisState
 	(*Params)(nil),          // 1: band.globalfee.v1beta1.Params
-	(*v1beta1.DecCoin)(nil), // 2: cosmos.base.v1beta1.DecCoin
+	(*FreeTxQuota)(nil),     // 2: band.globalfee.v1beta1.FreeTxQuota
+	(*FeeDenomPricing)(nil), // 3: band.globalfee.v1beta1.FeeDenomPricing
+	(*FeeDenom)(nil),        // 4: band.globalfee.v1beta1.FeeDenom
+	(*v1beta1.DecCoin)(nil), // 5: cosmos.base.v1beta1.DecCoin
 }
 var file_band_globalfee_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.globalfee.v1beta1.GenesisState.params:type_name -> band.globalfee.v1beta1.Params
-	2, // 1: band.globalfee.v1beta1.Params.minimum_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: band.globalfee.v1beta1.Params.minimum_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	2, // 2: band.globalfee.v1beta1.Params.free_tx_quota:type_name -> band.globalfee.v1beta1.FreeTxQuota
+	3, // 3: band.globalfee.v1beta1.Params.fee_denom_pricing:type_name -> band.globalfee.v1beta1.FeeDenomPricing
+	4, // 4: band.globalfee.v1beta1.FeeDenomPricing.fee_denoms:type_name -> band.globalfee.v1beta1.FeeDenom
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_band_globalfee_v1beta1_genesis_proto_init() }
@@ -1139,6 +3297,42 @@ func file_band_globalfee_v1beta1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_band_globalfee_v1beta1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeTxQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_globalfee_v1beta1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDenomPricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_globalfee_v1beta1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1146,7 +3340,7 @@ func file_band_globalfee_v1beta1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_globalfee_v1beta1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -115,11 +115,273 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Subscription
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*DataSourceVersion
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(DataSourceVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(DataSourceVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*OracleScriptVersion
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*Result
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Result)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Result)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(Result)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(Result)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*FeeReceipt
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeReceipt)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeReceipt)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(FeeReceipt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(FeeReceipt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_data_sources   protoreflect.FieldDescriptor
-	fd_GenesisState_oracle_scripts protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_data_sources           protoreflect.FieldDescriptor
+	fd_GenesisState_oracle_scripts         protoreflect.FieldDescriptor
+	fd_GenesisState_subscription_count     protoreflect.FieldDescriptor
+	fd_GenesisState_subscriptions          protoreflect.FieldDescriptor
+	fd_GenesisState_data_source_versions   protoreflect.FieldDescriptor
+	fd_GenesisState_oracle_script_versions protoreflect.FieldDescriptor
+	fd_GenesisState_request_count          protoreflect.FieldDescriptor
+	fd_GenesisState_results                protoreflect.FieldDescriptor
+	fd_GenesisState_fee_receipts           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -128,6 +390,13 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_data_sources = md_GenesisState.Fields().ByName("data_sources")
 	fd_GenesisState_oracle_scripts = md_GenesisState.Fields().ByName("oracle_scripts")
+	fd_GenesisState_subscription_count = md_GenesisState.Fields().ByName("subscription_count")
+	fd_GenesisState_subscriptions = md_GenesisState.Fields().ByName("subscriptions")
+	fd_GenesisState_data_source_versions = md_GenesisState.Fields().ByName("data_source_versions")
+	fd_GenesisState_oracle_script_versions = md_GenesisState.Fields().ByName("oracle_script_versions")
+	fd_GenesisState_request_count = md_GenesisState.Fields().ByName("request_count")
+	fd_GenesisState_results = md_GenesisState.Fields().ByName("results")
+	fd_GenesisState_fee_receipts = md_GenesisState.Fields().ByName("fee_receipts")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -213,6 +482,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.SubscriptionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionCount)
+		if !f(fd_GenesisState_subscription_count, value) {
+			return
+		}
+	}
+	if len(x.Subscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Subscriptions})
+		if !f(fd_GenesisState_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.DataSourceVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.DataSourceVersions})
+		if !f(fd_GenesisState_data_source_versions, value) {
+			return
+		}
+	}
+	if len(x.OracleScriptVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.OracleScriptVersions})
+		if !f(fd_GenesisState_oracle_script_versions, value) {
+			return
+		}
+	}
+	if x.RequestCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestCount)
+		if !f(fd_GenesisState_request_count, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Results})
+		if !f(fd_GenesisState_results, value) {
+			return
+		}
+	}
+	if len(x.FeeReceipts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.FeeReceipts})
+		if !f(fd_GenesisState_fee_receipts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -234,6 +545,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.DataSources) != 0
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		return len(x.OracleScripts) != 0
+	case "band.oracle.v1.GenesisState.subscription_count":
+		return x.SubscriptionCount != uint64(0)
+	case "band.oracle.v1.GenesisState.subscriptions":
+		return len(x.Subscriptions) != 0
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		return len(x.DataSourceVersions) != 0
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		return len(x.OracleScriptVersions) != 0
+	case "band.oracle.v1.GenesisState.request_count":
+		return x.RequestCount != uint64(0)
+	case "band.oracle.v1.GenesisState.results":
+		return len(x.Results) != 0
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		return len(x.FeeReceipts) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -256,6 +581,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DataSources = nil
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		x.OracleScripts = nil
+	case "band.oracle.v1.GenesisState.subscription_count":
+		x.SubscriptionCount = uint64(0)
+	case "band.oracle.v1.GenesisState.subscriptions":
+		x.Subscriptions = nil
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		x.DataSourceVersions = nil
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		x.OracleScriptVersions = nil
+	case "band.oracle.v1.GenesisState.request_count":
+		x.RequestCount = uint64(0)
+	case "band.oracle.v1.GenesisState.results":
+		x.Results = nil
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		x.FeeReceipts = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -287,6 +626,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.OracleScripts}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.subscription_count":
+		value := x.SubscriptionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.GenesisState.subscriptions":
+		if len(x.Subscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		if len(x.DataSourceVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		if len(x.OracleScriptVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.request_count":
+		value := x.RequestCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.GenesisState.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		if len(x.FeeReceipts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.FeeReceipts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -317,6 +692,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.OracleScripts = *clv.list
+	case "band.oracle.v1.GenesisState.subscription_count":
+		x.SubscriptionCount = value.Uint()
+	case "band.oracle.v1.GenesisState.subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Subscriptions = *clv.list
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.DataSourceVersions = *clv.list
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.OracleScriptVersions = *clv.list
+	case "band.oracle.v1.GenesisState.request_count":
+		x.RequestCount = value.Uint()
+	case "band.oracle.v1.GenesisState.results":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Results = *clv.list
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.FeeReceipts = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -354,6 +753,40 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.OracleScripts}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.subscriptions":
+		if x.Subscriptions == nil {
+			x.Subscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_5_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		if x.DataSourceVersions == nil {
+			x.DataSourceVersions = []*DataSourceVersion{}
+		}
+		value := &_GenesisState_6_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		if x.OracleScriptVersions == nil {
+			x.OracleScriptVersions = []*OracleScriptVersion{}
+		}
+		value := &_GenesisState_7_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.results":
+		if x.Results == nil {
+			x.Results = []*Result{}
+		}
+		value := &_GenesisState_9_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		if x.FeeReceipts == nil {
+			x.FeeReceipts = []*FeeReceipt{}
+		}
+		value := &_GenesisState_10_list{list: &x.FeeReceipts}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.subscription_count":
+		panic(fmt.Errorf("field subscription_count of message band.oracle.v1.GenesisState is not mutable"))
+	case "band.oracle.v1.GenesisState.request_count":
+		panic(fmt.Errorf("field request_count of message band.oracle.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -376,6 +809,25 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		list := []*OracleScript{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "band.oracle.v1.GenesisState.subscription_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.GenesisState.subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		list := []*DataSourceVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		list := []*OracleScriptVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "band.oracle.v1.GenesisState.request_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.GenesisState.results":
+		list := []*Result{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "band.oracle.v1.GenesisState.fee_receipts":
+		list := []*FeeReceipt{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -461,6 +913,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.SubscriptionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionCount))
+		}
+		if len(x.Subscriptions) > 0 {
+			for _, e := range x.Subscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DataSourceVersions) > 0 {
+			for _, e := range x.DataSourceVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OracleScriptVersions) > 0 {
+			for _, e := range x.OracleScriptVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RequestCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestCount))
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeReceipts) > 0 {
+			for _, e := range x.FeeReceipts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -490,9 +978,9 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.OracleScripts) > 0 {
-			for iNdEx := len(x.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OracleScripts[iNdEx])
+		if len(x.FeeReceipts) > 0 {
+			for iNdEx := len(x.FeeReceipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeReceipts[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -503,12 +991,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x52
 			}
 		}
-		if len(x.DataSources) > 0 {
-			for iNdEx := len(x.DataSources) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DataSources[iNdEx])
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -519,7 +1007,97 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.RequestCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.OracleScriptVersions) > 0 {
+			for iNdEx := len(x.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleScriptVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.DataSourceVersions) > 0 {
+			for iNdEx := len(x.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DataSourceVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Subscriptions) > 0 {
+			for iNdEx := len(x.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.SubscriptionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.OracleScripts) > 0 {
+			for iNdEx := len(x.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleScripts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DataSources) > 0 {
+			for iNdEx := len(x.DataSources) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DataSources[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
 		}
 		if x.Params != nil {
@@ -689,6 +1267,214 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionCount", wireType)
+				}
+				x.SubscriptionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubscriptionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subscriptions = append(x.Subscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscriptions[len(x.Subscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSourceVersions = append(x.DataSourceVersions, &DataSourceVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataSourceVersions[len(x.DataSourceVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleScriptVersions = append(x.OracleScriptVersions, &OracleScriptVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleScriptVersions[len(x.OracleScriptVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+				}
+				x.RequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &Result{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeReceipts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeReceipts = append(x.FeeReceipts, &FeeReceipt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeReceipts[len(x.FeeReceipts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -749,6 +1535,20 @@ type GenesisState struct {
 	DataSources []*DataSource `protobuf:"bytes,2,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	// OracleScripts are list of oracle scripts to be installed during genesis phase.
 	OracleScripts []*OracleScript `protobuf:"bytes,3,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts,omitempty"`
+	// SubscriptionCount is the number of subscriptions that have been created.
+	SubscriptionCount uint64 `protobuf:"varint,4,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"`
+	// Subscriptions are the subscriptions that are not yet cancelled or completed.
+	Subscriptions []*Subscription `protobuf:"bytes,5,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// DataSourceVersions are the version histories of the data sources.
+	DataSourceVersions []*DataSourceVersion `protobuf:"bytes,6,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions,omitempty"`
+	// OracleScriptVersions are the version histories of the oracle scripts.
+	OracleScriptVersions []*OracleScriptVersion `protobuf:"bytes,7,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions,omitempty"`
+	// RequestCount is the number of requests that have been made.
+	RequestCount uint64 `protobuf:"varint,8,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Results are the results of the requests that are within the retention.
+	Results []*Result `protobuf:"bytes,9,rep,name=results,proto3" json:"results,omitempty"`
+	// FeeReceipts are the settled fee receipts of the results.
+	FeeReceipts []*FeeReceipt `protobuf:"bytes,10,rep,name=fee_receipts,json=feeReceipts,proto3" json:"fee_receipts,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -792,6 +1592,55 @@ func (x *GenesisState) GetOracleScripts() []*OracleScript {
 	return nil
 }
 
+func (x *GenesisState) GetSubscriptionCount() uint64 {
+	if x != nil {
+		return x.SubscriptionCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDataSourceVersions() []*DataSourceVersion {
+	if x != nil {
+		return x.DataSourceVersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOracleScriptVersions() []*OracleScriptVersion {
+	if x != nil {
+		return x.OracleScriptVersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRequestCount() uint64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeReceipts() []*FeeReceipt {
+	if x != nil {
+		return x.FeeReceipts
+	}
+	return nil
+}
+
 var File_band_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -801,7 +1650,7 @@ var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x6f, 0x22, 0xab, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
@@ -814,19 +1663,49 @@ var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x42, 0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x59, 0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x16,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x66, 0x65,
+	0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x42,
+	0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61,
+	0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64,
+	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -843,20 +1722,30 @@ func file_band_oracle_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_band_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_band_oracle_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: band.oracle.v1.GenesisState
-	(*Params)(nil),       // 1: band.oracle.v1.Params
-	(*DataSource)(nil),   // 2: band.oracle.v1.DataSource
-	(*OracleScript)(nil), // 3: band.oracle.v1.OracleScript
+	(*GenesisState)(nil),        // 0: band.oracle.v1.GenesisState
+	(*Params)(nil),              // 1: band.oracle.v1.Params
+	(*DataSource)(nil),          // 2: band.oracle.v1.DataSource
+	(*OracleScript)(nil),        // 3: band.oracle.v1.OracleScript
+	(*Subscription)(nil),        // 4: band.oracle.v1.Subscription
+	(*DataSourceVersion)(nil),   // 5: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil), // 6: band.oracle.v1.OracleScriptVersion
+	(*Result)(nil),              // 7: band.oracle.v1.Result
+	(*FeeReceipt)(nil),          // 8: band.oracle.v1.FeeReceipt
 }
 var file_band_oracle_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.oracle.v1.GenesisState.params:type_name -> band.oracle.v1.Params
 	2, // 1: band.oracle.v1.GenesisState.data_sources:type_name -> band.oracle.v1.DataSource
 	3, // 2: band.oracle.v1.GenesisState.oracle_scripts:type_name -> band.oracle.v1.OracleScript
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: band.oracle.v1.GenesisState.subscriptions:type_name -> band.oracle.v1.Subscription
+	5, // 4: band.oracle.v1.GenesisState.data_source_versions:type_name -> band.oracle.v1.DataSourceVersion
+	6, // 5: band.oracle.v1.GenesisState.oracle_script_versions:type_name -> band.oracle.v1.OracleScriptVersion
+	7, // 6: band.oracle.v1.GenesisState.results:type_name -> band.oracle.v1.Result
+	8, // 7: band.oracle.v1.GenesisState.fee_receipts:type_name -> band.oracle.v1.FeeReceipt
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_genesis_proto_init() }
@@ -4,6 +4,7 @@ package oraclev1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -1570,29 +1571,35 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RawRequest                protoreflect.MessageDescriptor
-	fd_RawRequest_external_id    protoreflect.FieldDescriptor
-	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
-	fd_RawRequest_calldata       protoreflect.FieldDescriptor
+	md_DataSourceVersion                protoreflect.MessageDescriptor
+	fd_DataSourceVersion_data_source_id protoreflect.FieldDescriptor
+	fd_DataSourceVersion_version        protoreflect.FieldDescriptor
+	fd_DataSourceVersion_filename       protoreflect.FieldDescriptor
+	fd_DataSourceVersion_editor         protoreflect.FieldDescriptor
+	fd_DataSourceVersion_height         protoreflect.FieldDescriptor
+	fd_DataSourceVersion_changelog      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
-	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
-	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
-	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	md_DataSourceVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("DataSourceVersion")
+	fd_DataSourceVersion_data_source_id = md_DataSourceVersion.Fields().ByName("data_source_id")
+	fd_DataSourceVersion_version = md_DataSourceVersion.Fields().ByName("version")
+	fd_DataSourceVersion_filename = md_DataSourceVersion.Fields().ByName("filename")
+	fd_DataSourceVersion_editor = md_DataSourceVersion.Fields().ByName("editor")
+	fd_DataSourceVersion_height = md_DataSourceVersion.Fields().ByName("height")
+	fd_DataSourceVersion_changelog = md_DataSourceVersion.Fields().ByName("changelog")
 }
 
-var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_DataSourceVersion)(nil)
 
-type fastReflection_RawRequest RawRequest
+type fastReflection_DataSourceVersion DataSourceVersion
 
-func (x *RawRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawRequest)(x)
+func (x *DataSourceVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(x)
 }
 
-func (x *RawRequest) slowProtoReflect() protoreflect.Message {
+func (x *DataSourceVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1604,43 +1611,43 @@ func (x *RawRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
-var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
+var _fastReflection_DataSourceVersion_messageType fastReflection_DataSourceVersion_messageType
+var _ protoreflect.MessageType = fastReflection_DataSourceVersion_messageType{}
 
-type fastReflection_RawRequest_messageType struct{}
+type fastReflection_DataSourceVersion_messageType struct{}
 
-func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawRequest)(nil)
+func (x fastReflection_DataSourceVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(nil)
 }
-func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x fastReflection_DataSourceVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
-func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x fastReflection_DataSourceVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x *fastReflection_DataSourceVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
-	return _fastReflection_RawRequest_messageType
+func (x *fastReflection_DataSourceVersion) Type() protoreflect.MessageType {
+	return _fastReflection_DataSourceVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawRequest) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x *fastReflection_DataSourceVersion) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
-	return (*RawRequest)(x)
+func (x *fastReflection_DataSourceVersion) Interface() protoreflect.ProtoMessage {
+	return (*DataSourceVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1648,22 +1655,40 @@ func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawRequest_external_id, value) {
+func (x *fastReflection_DataSourceVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_DataSourceVersion_data_source_id, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_RawRequest_data_source_id, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSourceVersion_version, value) {
 			return
 		}
 	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_RawRequest_calldata, value) {
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_DataSourceVersion_filename, value) {
+			return
+		}
+	}
+	if x.Editor != "" {
+		value := protoreflect.ValueOfString(x.Editor)
+		if !f(fd_DataSourceVersion_editor, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DataSourceVersion_height, value) {
+			return
+		}
+	}
+	if x.Changelog != "" {
+		value := protoreflect.ValueOfString(x.Changelog)
+		if !f(fd_DataSourceVersion_changelog, value) {
 			return
 		}
 	}
@@ -1680,19 +1705,25 @@ func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DataSourceVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		return len(x.Calldata) != 0
+	case "band.oracle.v1.DataSourceVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.DataSourceVersion.editor":
+		return x.Editor != ""
+	case "band.oracle.v1.DataSourceVersion.height":
+		return x.Height != int64(0)
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		return x.Changelog != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1702,19 +1733,25 @@ func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DataSourceVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = nil
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.DataSourceVersion.editor":
+		x.Editor = ""
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = int64(0)
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		x.Changelog = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1724,22 +1761,31 @@ func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		value := x.DataSourceId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.DataSourceVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.editor":
+		value := x.Editor
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		value := x.Changelog
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1753,19 +1799,25 @@ func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DataSourceVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = value.Bytes()
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.editor":
+		x.Editor = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = value.Int()
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		x.Changelog = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1779,48 +1831,60 @@ func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.editor":
+		panic(fmt.Errorf("field editor of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		panic(fmt.Errorf("field changelog of message band.oracle.v1.DataSourceVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.data_source_id":
+	case "band.oracle.v1.DataSourceVersion.version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.calldata":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.editor":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.DataSourceVersion.changelog":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DataSourceVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.DataSourceVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1828,7 +1892,7 @@ func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DataSourceVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1839,7 +1903,7 @@ func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DataSourceVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1851,7 +1915,7 @@ func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawRequest) IsValid() bool {
+func (x *fastReflection_DataSourceVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -1861,9 +1925,9 @@ func (x *fastReflection_RawRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DataSourceVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1875,13 +1939,24 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
 		if x.DataSourceId != 0 {
 			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
-		l = len(x.Calldata)
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Editor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Changelog)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1895,7 +1970,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1914,20 +1989,39 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if len(x.Changelog) > 0 {
+			i -= len(x.Changelog)
+			copy(dAtA[i:], x.Changelog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Changelog)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Editor) > 0 {
+			i -= len(x.Editor)
+			copy(dAtA[i:], x.Editor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Editor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -1942,7 +2036,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*DataSourceVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1974,17 +2068,17 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.ExternalId = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1994,16 +2088,16 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				x.DataSourceId = 0
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2013,16 +2107,16 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2032,85 +2126,174 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
+				x.Filename = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Editor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_RawReport             protoreflect.MessageDescriptor
-	fd_RawReport_external_id protoreflect.FieldDescriptor
-	fd_RawReport_exit_code   protoreflect.FieldDescriptor
-	fd_RawReport_data        protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
-	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
-	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
-	fd_RawReport_data = md_RawReport.Fields().ByName("data")
-}
-
-var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changelog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-type fastReflection_RawReport RawReport
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
 
-func (x *RawReport) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawReport)(x)
+var (
+	md_OracleScriptVersion                  protoreflect.MessageDescriptor
+	fd_OracleScriptVersion_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_version          protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_filename         protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_editor           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_height           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_changelog        protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_schema           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleScriptVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScriptVersion")
+	fd_OracleScriptVersion_oracle_script_id = md_OracleScriptVersion.Fields().ByName("oracle_script_id")
+	fd_OracleScriptVersion_version = md_OracleScriptVersion.Fields().ByName("version")
+	fd_OracleScriptVersion_filename = md_OracleScriptVersion.Fields().ByName("filename")
+	fd_OracleScriptVersion_editor = md_OracleScriptVersion.Fields().ByName("editor")
+	fd_OracleScriptVersion_height = md_OracleScriptVersion.Fields().ByName("height")
+	fd_OracleScriptVersion_changelog = md_OracleScriptVersion.Fields().ByName("changelog")
+	fd_OracleScriptVersion_schema = md_OracleScriptVersion.Fields().ByName("schema")
 }
 
-func (x *RawReport) slowProtoReflect() protoreflect.Message {
+var _ protoreflect.Message = (*fastReflection_OracleScriptVersion)(nil)
+
+type fastReflection_OracleScriptVersion OracleScriptVersion
+
+func (x *OracleScriptVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(x)
+}
+
+func (x *OracleScriptVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,43 +2305,43 @@ func (x *RawReport) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
-var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
+var _fastReflection_OracleScriptVersion_messageType fastReflection_OracleScriptVersion_messageType
+var _ protoreflect.MessageType = fastReflection_OracleScriptVersion_messageType{}
 
-type fastReflection_RawReport_messageType struct{}
+type fastReflection_OracleScriptVersion_messageType struct{}
 
-func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawReport)(nil)
+func (x fastReflection_OracleScriptVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(nil)
 }
-func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x fastReflection_OracleScriptVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
-func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x fastReflection_OracleScriptVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x *fastReflection_OracleScriptVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
-	return _fastReflection_RawReport_messageType
+func (x *fastReflection_OracleScriptVersion) Type() protoreflect.MessageType {
+	return _fastReflection_OracleScriptVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawReport) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x *fastReflection_OracleScriptVersion) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
-	return (*RawReport)(x)
+func (x *fastReflection_OracleScriptVersion) Interface() protoreflect.ProtoMessage {
+	return (*OracleScriptVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2166,22 +2349,46 @@ func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawReport_external_id, value) {
+func (x *fastReflection_OracleScriptVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_OracleScriptVersion_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.ExitCode != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ExitCode)
-		if !f(fd_RawReport_exit_code, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScriptVersion_version, value) {
 			return
 		}
 	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_RawReport_data, value) {
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_OracleScriptVersion_filename, value) {
+			return
+		}
+	}
+	if x.Editor != "" {
+		value := protoreflect.ValueOfString(x.Editor)
+		if !f(fd_OracleScriptVersion_editor, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_OracleScriptVersion_height, value) {
+			return
+		}
+	}
+	if x.Changelog != "" {
+		value := protoreflect.ValueOfString(x.Changelog)
+		if !f(fd_OracleScriptVersion_changelog, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_OracleScriptVersion_schema, value) {
 			return
 		}
 	}
@@ -2198,19 +2405,27 @@ func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleScriptVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		return x.ExitCode != uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		return len(x.Data) != 0
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		return x.Editor != ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return x.Height != int64(0)
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		return x.Changelog != ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return x.Schema != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2220,19 +2435,27 @@ func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleScriptVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		x.Data = nil
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		x.Editor = ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = int64(0)
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		x.Changelog = ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2242,22 +2465,34 @@ func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		value := x.ExternalId
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawReport.exit_code":
-		value := x.ExitCode
-		return protoreflect.ValueOfUint32(value)
-	case "band.oracle.v1.RawReport.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		value := x.Editor
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		value := x.Changelog
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		value := x.Schema
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2271,19 +2506,27 @@ func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleScriptVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(value.Uint())
-	case "band.oracle.v1.RawReport.data":
-		x.Data = value.Bytes()
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		x.Editor = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = value.Int()
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		x.Changelog = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2297,48 +2540,64 @@ func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.exit_code":
-		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.data":
-		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		panic(fmt.Errorf("field editor of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		panic(fmt.Errorf("field changelog of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScriptVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawReport.exit_code":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.oracle.v1.RawReport.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleScriptVersion.changelog":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleScriptVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScriptVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2346,7 +2605,7 @@ func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleScriptVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2357,7 +2616,7 @@ func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleScriptVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2369,7 +2628,7 @@ func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawReport) IsValid() bool {
+func (x *fastReflection_OracleScriptVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -2379,9 +2638,9 @@ func (x *fastReflection_RawReport) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleScriptVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2393,13 +2652,28 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		if x.ExitCode != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
 		}
-		l = len(x.Data)
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Editor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Changelog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Schema)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2413,7 +2687,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2432,20 +2706,46 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x3a
 		}
-		if x.ExitCode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
+		if len(x.Changelog) > 0 {
+			i -= len(x.Changelog)
+			copy(dAtA[i:], x.Changelog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Changelog)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x32
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Editor) > 0 {
+			i -= len(x.Editor)
+			copy(dAtA[i:], x.Editor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Editor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2460,7 +2760,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2492,17 +2792,17 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				x.ExternalId = 0
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2512,16 +2812,16 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				x.ExitCode = 0
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2531,16 +2831,16 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExitCode |= uint32(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2550,25 +2850,138 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Editor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changelog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schema = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2605,246 +3018,80 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Request_3_list)(nil)
+var (
+	md_RawRequest                     protoreflect.MessageDescriptor
+	fd_RawRequest_external_id         protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_id      protoreflect.FieldDescriptor
+	fd_RawRequest_calldata            protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_version protoreflect.FieldDescriptor
+)
 
-type _Request_3_list struct {
-	list *[]string
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
+	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
+	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
+	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	fd_RawRequest_data_source_version = md_RawRequest.Fields().ByName("data_source_version")
 }
 
-func (x *_Request_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
 
-func (x *_Request_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_RawRequest RawRequest
 
-func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *RawRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawRequest)(x)
 }
 
-func (x *_Request_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *RawRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Request_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
-}
+var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
 
-func (x *_Request_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_RawRequest_messageType struct{}
 
-func (x *_Request_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawRequest)(nil)
 }
-
-func (x *_Request_3_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
 }
-
-var _ protoreflect.List = (*_Request_8_list)(nil)
-
-type _Request_8_list struct {
-	list *[]*RawRequest
+func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
 }
 
-func (x *_Request_8_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
 }
 
-func (x *_Request_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RawRequest_messageType
 }
 
-func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	(*x.list)[i] = concreteValue
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RawRequest) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
 }
 
-func (x *_Request_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Request_8_list) AppendMutable() protoreflect.Value {
-	v := new(RawRequest)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_8_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Request_8_list) NewElement() protoreflect.Value {
-	v := new(RawRequest)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_8_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_Request_13_list)(nil)
-
-type _Request_13_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Request_13_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Request_13_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Request_13_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Request_13_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_13_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Request_13_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_13_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Request                      protoreflect.MessageDescriptor
-	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
-	fd_Request_calldata             protoreflect.FieldDescriptor
-	fd_Request_requested_validators protoreflect.FieldDescriptor
-	fd_Request_min_count            protoreflect.FieldDescriptor
-	fd_Request_request_height       protoreflect.FieldDescriptor
-	fd_Request_request_time         protoreflect.FieldDescriptor
-	fd_Request_client_id            protoreflect.FieldDescriptor
-	fd_Request_raw_requests         protoreflect.FieldDescriptor
-	fd_Request_ibc_channel          protoreflect.FieldDescriptor
-	fd_Request_execute_gas          protoreflect.FieldDescriptor
-	fd_Request_tss_encoder          protoreflect.FieldDescriptor
-	fd_Request_requester            protoreflect.FieldDescriptor
-	fd_Request_fee_limit            protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
-	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
-	fd_Request_calldata = md_Request.Fields().ByName("calldata")
-	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
-	fd_Request_min_count = md_Request.Fields().ByName("min_count")
-	fd_Request_request_height = md_Request.Fields().ByName("request_height")
-	fd_Request_request_time = md_Request.Fields().ByName("request_time")
-	fd_Request_client_id = md_Request.Fields().ByName("client_id")
-	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
-	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
-	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
-	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
-	fd_Request_requester = md_Request.Fields().ByName("requester")
-	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
-}
-
-var _ protoreflect.Message = (*fastReflection_Request)(nil)
-
-type fastReflection_Request Request
-
-func (x *Request) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Request)(x)
-}
-
-func (x *Request) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Request_messageType fastReflection_Request_messageType
-var _ protoreflect.MessageType = fastReflection_Request_messageType{}
-
-type fastReflection_Request_messageType struct{}
-
-func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Request)(nil)
-}
-func (x fastReflection_Request_messageType) New() protoreflect.Message {
-	return new(fastReflection_Request)
-}
-func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Request) Type() protoreflect.MessageType {
-	return _fastReflection_Request_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Request) New() protoreflect.Message {
-	return new(fastReflection_Request)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
-	return (*Request)(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
+	return (*RawRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2852,82 +3099,28 @@ func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Request_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Request_calldata, value) {
-			return
-		}
-	}
-	if len(x.RequestedValidators) != 0 {
-		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
-		if !f(fd_Request_requested_validators, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Request_min_count, value) {
-			return
-		}
-	}
-	if x.RequestHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestHeight)
-		if !f(fd_Request_request_height, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Request_request_time, value) {
-			return
-		}
-	}
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Request_client_id, value) {
-			return
-		}
-	}
-	if len(x.RawRequests) != 0 {
-		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
-		if !f(fd_Request_raw_requests, value) {
-			return
-		}
-	}
-	if x.IbcChannel != nil {
-		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-		if !f(fd_Request_ibc_channel, value) {
-			return
-		}
-	}
-	if x.ExecuteGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_Request_execute_gas, value) {
+func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawRequest_external_id, value) {
 			return
 		}
 	}
-	if x.TssEncoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_Request_tss_encoder, value) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RawRequest_data_source_id, value) {
 			return
 		}
 	}
-	if x.Requester != "" {
-		value := protoreflect.ValueOfString(x.Requester)
-		if !f(fd_Request_requester, value) {
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_RawRequest_calldata, value) {
 			return
 		}
 	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
-		if !f(fd_Request_fee_limit, value) {
+	if x.DataSourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceVersion)
+		if !f(fd_RawRequest_data_source_version, value) {
 			return
 		}
 	}
@@ -2944,39 +3137,21 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.RawRequest.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
 		return len(x.Calldata) != 0
-	case "band.oracle.v1.Request.requested_validators":
-		return len(x.RequestedValidators) != 0
-	case "band.oracle.v1.Request.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		return x.RequestHeight != int64(0)
-	case "band.oracle.v1.Request.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Request.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.Request.raw_requests":
-		return len(x.RawRequests) != 0
-	case "band.oracle.v1.Request.ibc_channel":
-		return x.IbcChannel != nil
-	case "band.oracle.v1.Request.execute_gas":
-		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
-		return x.TssEncoder != 0
-	case "band.oracle.v1.Request.requester":
-		return x.Requester != ""
-	case "band.oracle.v1.Request.fee_limit":
-		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return x.DataSourceVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2986,39 +3161,21 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.RawRequest.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = nil
-	case "band.oracle.v1.Request.requested_validators":
-		x.RequestedValidators = nil
-	case "band.oracle.v1.Request.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = int64(0)
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.Request.raw_requests":
-		x.RawRequests = nil
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = nil
-	case "band.oracle.v1.Request.execute_gas":
-		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
-		x.TssEncoder = 0
-	case "band.oracle.v1.Request.requester":
-		x.Requester = ""
-	case "band.oracle.v1.Request.fee_limit":
-		x.FeeLimit = nil
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3028,61 +3185,25 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		value := x.OracleScriptId
+	case "band.oracle.v1.RawRequest.external_id":
+		value := x.ExternalId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.RawRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequest.calldata":
 		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Request.requested_validators":
-		if len(x.RequestedValidators) == 0 {
-			return protoreflect.ValueOfList(&_Request_3_list{})
-		}
-		listValue := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.request_height":
-		value := x.RequestHeight
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if len(x.RawRequests) == 0 {
-			return protoreflect.ValueOfList(&_Request_8_list{})
-		}
-		listValue := &_Request_8_list{list: &x.RawRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.ibc_channel":
-		value := x.IbcChannel
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
-		value := x.ExecuteGas
+	case "band.oracle.v1.RawRequest.data_source_version":
+		value := x.DataSourceVersion
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.tss_encoder":
-		value := x.TssEncoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Request.requester":
-		value := x.Requester
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_Request_13_list{})
-		}
-		listValue := &_Request_13_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3096,45 +3217,21 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.RawRequest.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Request.requested_validators":
-		lv := value.List()
-		clv := lv.(*_Request_3_list)
-		x.RequestedValidators = *clv.list
-	case "band.oracle.v1.Request.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = value.Int()
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Request.raw_requests":
-		lv := value.List()
-		clv := lv.(*_Request_8_list)
-		x.RawRequests = *clv.list
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = value.Message().Interface().(*IBCChannel)
-	case "band.oracle.v1.Request.execute_gas":
-		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.Request.tss_encoder":
-		x.TssEncoder = (Encoder)(value.Enum())
-	case "band.oracle.v1.Request.requester":
-		x.Requester = value.Interface().(string)
-	case "band.oracle.v1.Request.fee_limit":
-		lv := value.List()
-		clv := lv.(*_Request_13_list)
-		x.FeeLimit = *clv.list
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3148,107 +3245,52 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.requested_validators":
-		if x.RequestedValidators == nil {
-			x.RequestedValidators = []string{}
-		}
-		value := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if x.RawRequests == nil {
-			x.RawRequests = []*RawRequest{}
-		}
-		value := &_Request_8_list{list: &x.RawRequests}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.ibc_channel":
-		if x.IbcChannel == nil {
-			x.IbcChannel = new(IBCChannel)
-		}
-		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-	case "band.oracle.v1.Request.fee_limit":
-		if x.FeeLimit == nil {
-			x.FeeLimit = []*v1beta1.Coin{}
-		}
-		value := &_Request_13_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_height":
-		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.requester":
-		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.RawRequest.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_version":
+		panic(fmt.Errorf("field data_source_version of message band.oracle.v1.RawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.calldata":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Request.requested_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
-	case "band.oracle.v1.Request.min_count":
+	case "band.oracle.v1.RawRequest.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.request_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.raw_requests":
-		list := []*RawRequest{}
-		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
-	case "band.oracle.v1.Request.ibc_channel":
-		m := new(IBCChannel)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
+	case "band.oracle.v1.RawRequest.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RawRequest.data_source_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.tss_encoder":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Request.requester":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.fee_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3256,7 +3298,7 @@ func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3267,7 +3309,7 @@ func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3279,7 +3321,7 @@ func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Request) IsValid() bool {
+func (x *fastReflection_RawRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3289,9 +3331,9 @@ func (x *fastReflection_Request) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3303,57 +3345,18 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
 		l = len(x.Calldata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.RequestedValidators) > 0 {
-			for _, s := range x.RequestedValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.RequestHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestHeight))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.RawRequests) > 0 {
-			for _, e := range x.RawRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.IbcChannel != nil {
-			l = options.Size(x.IbcChannel)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ExecuteGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
-		}
-		if x.TssEncoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.TssEncoder))
-		}
-		l = len(x.Requester)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.FeeLimit) > 0 {
-			for _, e := range x.FeeLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.DataSourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceVersion))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3365,7 +3368,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,109 +3387,25 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FeeLimit) > 0 {
-			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x6a
-			}
-		}
-		if len(x.Requester) > 0 {
-			i -= len(x.Requester)
-			copy(dAtA[i:], x.Requester)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if x.TssEncoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.ExecuteGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.IbcChannel != nil {
-			encoded, err := options.Marshal(x.IbcChannel)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.RawRequests) > 0 {
-			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.RequestHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+		if x.DataSourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceVersion))
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.RequestedValidators) > 0 {
-			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RequestedValidators[iNdEx])
-				copy(dAtA[i:], x.RequestedValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
 		if len(x.Calldata) > 0 {
 			i -= len(x.Calldata)
 			copy(dAtA[i:], x.Calldata)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3501,7 +3420,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3533,17 +3452,17 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
-				x.OracleScriptId = 0
+				x.ExternalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3553,16 +3472,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
+					x.ExternalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				var byteLen int
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3572,31 +3491,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3606,29 +3510,31 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
 				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
 				}
-				x.MinCount = 0
+				x.DataSourceVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3638,269 +3544,25 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
+					x.DataSourceVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
-				}
-				x.RequestHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RawRequests = append(x.RawRequests, &RawRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.IbcChannel == nil {
-					x.IbcChannel = &IBCChannel{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
-				}
-				x.ExecuteGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecuteGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
-				}
-				x.TssEncoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TssEncoder |= Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Requester = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3922,129 +3584,78 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Report_3_list)(nil)
+var (
+	md_RawReport             protoreflect.MessageDescriptor
+	fd_RawReport_external_id protoreflect.FieldDescriptor
+	fd_RawReport_exit_code   protoreflect.FieldDescriptor
+	fd_RawReport_data        protoreflect.FieldDescriptor
+)
 
-type _Report_3_list struct {
-	list *[]*RawReport
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
+	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
+	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
+	fd_RawReport_data = md_RawReport.Fields().ByName("data")
 }
 
-func (x *_Report_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
 
-func (x *_Report_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_RawReport RawReport
 
-func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	(*x.list)[i] = concreteValue
+func (x *RawReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawReport)(x)
 }
 
-func (x *_Report_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	*x.list = append(*x.list, concreteValue)
+func (x *RawReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Report_3_list) AppendMutable() protoreflect.Value {
-	v := new(RawReport)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Report_3_list) NewElement() protoreflect.Value {
-	v := new(RawReport)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Report                   protoreflect.MessageDescriptor
-	fd_Report_validator         protoreflect.FieldDescriptor
-	fd_Report_in_before_resolve protoreflect.FieldDescriptor
-	fd_Report_raw_reports       protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
-	fd_Report_validator = md_Report.Fields().ByName("validator")
-	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
-	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
-}
-
-var _ protoreflect.Message = (*fastReflection_Report)(nil)
-
-type fastReflection_Report Report
-
-func (x *Report) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Report)(x)
-}
-
-func (x *Report) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Report_messageType fastReflection_Report_messageType
-var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
+var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
 
-type fastReflection_Report_messageType struct{}
+type fastReflection_RawReport_messageType struct{}
 
-func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Report)(nil)
+func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawReport)(nil)
 }
-func (x fastReflection_Report_messageType) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
 }
-func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Report) Type() protoreflect.MessageType {
-	return _fastReflection_Report_messageType
+func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
+	return _fastReflection_RawReport_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Report) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x *fastReflection_RawReport) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
-	return (*Report)(x)
+func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
+	return (*RawReport)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4052,22 +3663,22 @@ func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_Report_validator, value) {
+func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawReport_external_id, value) {
 			return
 		}
 	}
-	if x.InBeforeResolve != false {
-		value := protoreflect.ValueOfBool(x.InBeforeResolve)
-		if !f(fd_Report_in_before_resolve, value) {
+	if x.ExitCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExitCode)
+		if !f(fd_RawReport_exit_code, value) {
 			return
 		}
 	}
-	if len(x.RawReports) != 0 {
-		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
-		if !f(fd_Report_raw_reports, value) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_RawReport_data, value) {
 			return
 		}
 	}
@@ -4084,19 +3695,19 @@ func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		return x.InBeforeResolve != false
-	case "band.oracle.v1.Report.raw_reports":
-		return len(x.RawReports) != 0
+	case "band.oracle.v1.RawReport.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		return x.ExitCode != uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		return len(x.Data) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4106,19 +3717,19 @@ func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = false
-	case "band.oracle.v1.Report.raw_reports":
-		x.RawReports = nil
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		x.Data = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4128,25 +3739,22 @@ func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Report.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Report.in_before_resolve":
-		value := x.InBeforeResolve
-		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.Report.raw_reports":
-		if len(x.RawReports) == 0 {
-			return protoreflect.ValueOfList(&_Report_3_list{})
-		}
-		listValue := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.RawReport.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawReport.exit_code":
+		value := x.ExitCode
+		return protoreflect.ValueOfUint32(value)
+	case "band.oracle.v1.RawReport.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4160,21 +3768,19 @@ func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = value.Bool()
-	case "band.oracle.v1.Report.raw_reports":
-		lv := value.List()
-		clv := lv.(*_Report_3_list)
-		x.RawReports = *clv.list
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(value.Uint())
+	case "band.oracle.v1.RawReport.data":
+		x.Data = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4188,53 +3794,48 @@ func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.raw_reports":
-		if x.RawReports == nil {
-			x.RawReports = []*RawReport{}
-		}
-		value := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Report.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
-	case "band.oracle.v1.Report.in_before_resolve":
-		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.RawReport.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.exit_code":
+		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.data":
+		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Report.in_before_resolve":
-		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.Report.raw_reports":
-		list := []*RawReport{}
-		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	case "band.oracle.v1.RawReport.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawReport.exit_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.oracle.v1.RawReport.data":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4242,7 +3843,7 @@ func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4253,7 +3854,7 @@ func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4265,7 +3866,7 @@ func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Report) IsValid() bool {
+func (x *fastReflection_RawReport) IsValid() bool {
 	return x != nil
 }
 
@@ -4275,9 +3876,9 @@ func (x *fastReflection_Report) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4289,19 +3890,16 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.ExitCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		}
+		l = len(x.Data)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.InBeforeResolve {
-			n += 2
-		}
-		if len(x.RawReports) > 0 {
-			for _, e := range x.RawReports {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4312,7 +3910,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4331,38 +3929,22 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RawReports) > 0 {
-			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawReports[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.InBeforeResolve {
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
 			i--
-			if x.InBeforeResolve {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x1a
+		}
+		if x.ExitCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4375,7 +3957,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4407,17 +3989,17 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
-				var stringLen uint64
+				x.ExternalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4427,29 +4009,16 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ExternalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 				}
-				var v int
+				x.ExitCode = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4459,17 +4028,16 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.ExitCode |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.InBeforeResolve = bool(v != 0)
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4479,24 +4047,24 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RawReports = append(x.RawReports, &RawReport{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -4534,93 +4102,202 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
+var _ protoreflect.List = (*_Request_3_list)(nil)
 
-type _OracleRequestPacketData_6_list struct {
+type _Request_3_list struct {
+	list *[]string
+}
+
+func (x *_Request_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
+}
+
+func (x *_Request_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Request_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_8_list)(nil)
+
+type _Request_8_list struct {
+	list *[]*RawRequest
+}
+
+func (x *_Request_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_8_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_8_list) NewElement() protoreflect.Value {
+	v := new(RawRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_13_list)(nil)
+
+type _Request_13_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_OracleRequestPacketData_6_list) Len() int {
+func (x *_Request_13_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
+func (x *_Request_13_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
+func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
+func (x *_Request_13_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
+func (x *_Request_13_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
+func (x *_Request_13_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
+func (x *_Request_13_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) IsValid() bool {
+func (x *_Request_13_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_OracleRequestPacketData                  protoreflect.MessageDescriptor
-	fd_OracleRequestPacketData_client_id        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_oracle_script_id protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_calldata         protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_ask_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_min_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_fee_limit        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_prepare_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_execute_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_tss_encoder      protoreflect.FieldDescriptor
+	md_Request                       protoreflect.MessageDescriptor
+	fd_Request_oracle_script_id      protoreflect.FieldDescriptor
+	fd_Request_calldata              protoreflect.FieldDescriptor
+	fd_Request_requested_validators  protoreflect.FieldDescriptor
+	fd_Request_min_count             protoreflect.FieldDescriptor
+	fd_Request_request_height        protoreflect.FieldDescriptor
+	fd_Request_request_time          protoreflect.FieldDescriptor
+	fd_Request_client_id             protoreflect.FieldDescriptor
+	fd_Request_raw_requests          protoreflect.FieldDescriptor
+	fd_Request_ibc_channel           protoreflect.FieldDescriptor
+	fd_Request_execute_gas           protoreflect.FieldDescriptor
+	fd_Request_tss_encoder           protoreflect.FieldDescriptor
+	fd_Request_requester             protoreflect.FieldDescriptor
+	fd_Request_fee_limit             protoreflect.FieldDescriptor
+	fd_Request_oracle_script_version protoreflect.FieldDescriptor
+	fd_Request_callback              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
-	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
-	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
-	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
-	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
-	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
-	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
-	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
-	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
-	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
+	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
+	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
+	fd_Request_calldata = md_Request.Fields().ByName("calldata")
+	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
+	fd_Request_min_count = md_Request.Fields().ByName("min_count")
+	fd_Request_request_height = md_Request.Fields().ByName("request_height")
+	fd_Request_request_time = md_Request.Fields().ByName("request_time")
+	fd_Request_client_id = md_Request.Fields().ByName("client_id")
+	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
+	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
+	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
+	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
+	fd_Request_requester = md_Request.Fields().ByName("requester")
+	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_oracle_script_version = md_Request.Fields().ByName("oracle_script_version")
+	fd_Request_callback = md_Request.Fields().ByName("callback")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
+var _ protoreflect.Message = (*fastReflection_Request)(nil)
 
-type fastReflection_OracleRequestPacketData OracleRequestPacketData
+type fastReflection_Request Request
 
-func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(x)
+func (x *Request) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Request)(x)
 }
 
-func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
+func (x *Request) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4632,43 +4309,43 @@ func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
+var _fastReflection_Request_messageType fastReflection_Request_messageType
+var _ protoreflect.MessageType = fastReflection_Request_messageType{}
 
-type fastReflection_OracleRequestPacketData_messageType struct{}
+type fastReflection_Request_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(nil)
-}
-func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Request)(nil)
 }
-func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x fastReflection_Request_messageType) New() protoreflect.Message {
+	return new(fastReflection_Request)
+}
+func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketData_messageType
+func (x *fastReflection_Request) Type() protoreflect.MessageType {
+	return _fastReflection_Request_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x *fastReflection_Request) New() protoreflect.Message {
+	return new(fastReflection_Request)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketData)(x)
+func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
+	return (*Request)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4676,58 +4353,94 @@ func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleRequestPacketData_client_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.OracleScriptId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
+		if !f(fd_Request_oracle_script_id, value) {
 			return
 		}
 	}
 	if len(x.Calldata) != 0 {
 		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_OracleRequestPacketData_calldata, value) {
+		if !f(fd_Request_calldata, value) {
 			return
 		}
 	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_OracleRequestPacketData_ask_count, value) {
+	if len(x.RequestedValidators) != 0 {
+		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
+		if !f(fd_Request_requested_validators, value) {
 			return
 		}
 	}
 	if x.MinCount != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_OracleRequestPacketData_min_count, value) {
+		if !f(fd_Request_min_count, value) {
 			return
 		}
 	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
-		if !f(fd_OracleRequestPacketData_fee_limit, value) {
+	if x.RequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestHeight)
+		if !f(fd_Request_request_height, value) {
 			return
 		}
 	}
-	if x.PrepareGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PrepareGas)
-		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Request_request_time, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Request_client_id, value) {
+			return
+		}
+	}
+	if len(x.RawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
+		if !f(fd_Request_raw_requests, value) {
+			return
+		}
+	}
+	if x.IbcChannel != nil {
+		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+		if !f(fd_Request_ibc_channel, value) {
 			return
 		}
 	}
 	if x.ExecuteGas != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_OracleRequestPacketData_execute_gas, value) {
+		if !f(fd_Request_execute_gas, value) {
 			return
 		}
 	}
 	if x.TssEncoder != 0 {
 		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
+		if !f(fd_Request_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_Request_requester, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
+		if !f(fd_Request_fee_limit, value) {
+			return
+		}
+	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_Request_oracle_script_version, value) {
+			return
+		}
+	}
+	if x.Callback != nil {
+		value := protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+		if !f(fd_Request_callback, value) {
 			return
 		}
 	}
@@ -4744,31 +4457,43 @@ func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+	case "band.oracle.v1.Request.oracle_script_id":
 		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
+	case "band.oracle.v1.Request.calldata":
 		return len(x.Calldata) != 0
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
+	case "band.oracle.v1.Request.requested_validators":
+		return len(x.RequestedValidators) != 0
+	case "band.oracle.v1.Request.min_count":
 		return x.MinCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		return len(x.FeeLimit) != 0
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		return x.PrepareGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+	case "band.oracle.v1.Request.request_height":
+		return x.RequestHeight != int64(0)
+	case "band.oracle.v1.Request.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Request.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Request.raw_requests":
+		return len(x.RawRequests) != 0
+	case "band.oracle.v1.Request.ibc_channel":
+		return x.IbcChannel != nil
+	case "band.oracle.v1.Request.execute_gas":
 		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+	case "band.oracle.v1.Request.tss_encoder":
 		return x.TssEncoder != 0
+	case "band.oracle.v1.Request.requester":
+		return x.Requester != ""
+	case "band.oracle.v1.Request.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	case "band.oracle.v1.Request.callback":
+		return x.Callback != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4778,31 +4503,43 @@ func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+	case "band.oracle.v1.Request.oracle_script_id":
 		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
+	case "band.oracle.v1.Request.calldata":
 		x.Calldata = nil
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
+	case "band.oracle.v1.Request.requested_validators":
+		x.RequestedValidators = nil
+	case "band.oracle.v1.Request.min_count":
 		x.MinCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		x.FeeLimit = nil
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = int64(0)
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Request.raw_requests":
+		x.RawRequests = nil
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = nil
+	case "band.oracle.v1.Request.execute_gas":
 		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+	case "band.oracle.v1.Request.tss_encoder":
 		x.TssEncoder = 0
+	case "band.oracle.v1.Request.requester":
+		x.Requester = ""
+	case "band.oracle.v1.Request.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	case "band.oracle.v1.Request.callback":
+		x.Callback = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4812,43 +4549,67 @@ func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+	case "band.oracle.v1.Request.oracle_script_id":
 		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
+	case "band.oracle.v1.Request.calldata":
 		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
+	case "band.oracle.v1.Request.requested_validators":
+		if len(x.RequestedValidators) == 0 {
+			return protoreflect.ValueOfList(&_Request_3_list{})
+		}
+		listValue := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.min_count":
 		value := x.MinCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
+	case "band.oracle.v1.Request.request_height":
+		value := x.RequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if len(x.RawRequests) == 0 {
+			return protoreflect.ValueOfList(&_Request_8_list{})
 		}
-		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		listValue := &_Request_8_list{list: &x.RawRequests}
 		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		value := x.PrepareGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+	case "band.oracle.v1.Request.ibc_channel":
+		value := x.IbcChannel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
 		value := x.ExecuteGas
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+	case "band.oracle.v1.Request.tss_encoder":
 		value := x.TssEncoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Request.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_Request_13_list{})
+		}
+		listValue := &_Request_13_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.callback":
+		value := x.Callback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4862,33 +4623,49 @@ func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+	case "band.oracle.v1.Request.oracle_script_id":
 		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
+	case "band.oracle.v1.Request.calldata":
 		x.Calldata = value.Bytes()
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+	case "band.oracle.v1.Request.requested_validators":
 		lv := value.List()
-		clv := lv.(*_OracleRequestPacketData_6_list)
-		x.FeeLimit = *clv.list
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		clv := lv.(*_Request_3_list)
+		x.RequestedValidators = *clv.list
+	case "band.oracle.v1.Request.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = value.Int()
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Request.raw_requests":
+		lv := value.List()
+		clv := lv.(*_Request_8_list)
+		x.RawRequests = *clv.list
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = value.Message().Interface().(*IBCChannel)
+	case "band.oracle.v1.Request.execute_gas":
 		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+	case "band.oracle.v1.Request.tss_encoder":
 		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Request.requester":
+		x.Requester = value.Interface().(string)
+	case "band.oracle.v1.Request.fee_limit":
+		lv := value.List()
+		clv := lv.(*_Request_13_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	case "band.oracle.v1.Request.callback":
+		x.Callback = value.Message().Interface().(*RequestCallback)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4902,77 +4679,119 @@ func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+	case "band.oracle.v1.Request.requested_validators":
+		if x.RequestedValidators == nil {
+			x.RequestedValidators = []string{}
+		}
+		value := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if x.RawRequests == nil {
+			x.RawRequests = []*RawRequest{}
+		}
+		value := &_Request_8_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.ibc_channel":
+		if x.IbcChannel == nil {
+			x.IbcChannel = new(IBCChannel)
+		}
+		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+	case "band.oracle.v1.Request.fee_limit":
 		if x.FeeLimit == nil {
 			x.FeeLimit = []*v1beta1.Coin{}
 		}
-		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		value := &_Request_13_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.Request.callback":
+		if x.Callback == nil {
+			x.Callback = new(RequestCallback)
+		}
+		return protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+	case "band.oracle.v1.Request.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_height":
+		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.requester":
+		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.Request is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+	case "band.oracle.v1.Request.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
+	case "band.oracle.v1.Request.calldata":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+	case "band.oracle.v1.Request.requested_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
+	case "band.oracle.v1.Request.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
+	case "band.oracle.v1.Request.request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.raw_requests":
+		list := []*RawRequest{}
+		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
+	case "band.oracle.v1.Request.ibc_channel":
+		m := new(IBCChannel)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+	case "band.oracle.v1.Request.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Request.requester":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.fee_limit":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.oracle_script_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Request.callback":
+		m := new(RequestCallback)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4980,7 +4799,7 @@ func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4991,7 +4810,7 @@ func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5003,7 +4822,7 @@ func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
+func (x *fastReflection_Request) IsValid() bool {
 	return x != nil
 }
 
@@ -5013,9 +4832,9 @@ func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*Request)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5027,10 +4846,6 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.OracleScriptId != 0 {
 			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
@@ -5038,20 +4853,34 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
+		if len(x.RequestedValidators) > 0 {
+			for _, s := range x.RequestedValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.MinCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinCount))
 		}
-		if len(x.FeeLimit) > 0 {
-			for _, e := range x.FeeLimit {
+		if x.RequestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestHeight))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RawRequests) > 0 {
+			for _, e := range x.RawRequests {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.PrepareGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		if x.IbcChannel != nil {
+			l = options.Size(x.IbcChannel)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.ExecuteGas != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
@@ -5059,6 +4888,23 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		if x.TssEncoder != 0 {
 			n += 1 + runtime.Sov(uint64(x.TssEncoder))
 		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		if x.Callback != nil {
+			l = options.Size(x.Callback)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5069,7 +4915,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*Request)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5088,20 +4934,24 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TssEncoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.ExecuteGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+		if x.Callback != nil {
+			encoded, err := options.Marshal(x.Callback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x7a
 		}
-		if x.PrepareGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
 			i--
-			dAtA[i] = 0x38
+			dAtA[i] = 0x70
 		}
 		if len(x.FeeLimit) > 0 {
 			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
@@ -5116,37 +4966,98 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x32
+				dAtA[i] = 0x6a
 			}
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x62
 		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x58
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x50
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.IbcChannel != nil {
+			encoded, err := options.Marshal(x.IbcChannel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x4a
+		}
+		if len(x.RawRequests) > 0 {
+			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
 		}
 		if len(x.ClientId) > 0 {
 			i -= len(x.ClientId)
 			copy(dAtA[i:], x.ClientId)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x3a
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RequestedValidators) > 0 {
+			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequestedValidators[iNdEx])
+				copy(dAtA[i:], x.RequestedValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5159,7 +5070,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*Request)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5191,17 +5102,17 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				var stringLen uint64
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5211,29 +5122,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				x.OracleScriptId = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5243,16 +5141,31 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5262,31 +5175,29 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
+				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 				}
-				x.AskCount = 0
+				x.MinCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5296,16 +5207,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
+					x.MinCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
 				}
-				x.MinCount = 0
+				x.RequestHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5315,14 +5226,65 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
+					x.RequestHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5349,16 +5311,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+				x.RawRequests = append(x.RawRequests, &RawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
 				}
-				x.PrepareGas = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5368,12 +5330,29 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PrepareGas |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IbcChannel == nil {
+					x.IbcChannel = &IBCChannel{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 				}
@@ -5392,7 +5371,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 						break
 					}
 				}
-			case 9:
+			case 11:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
 				}
@@ -5411,20 +5390,141 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Callback == nil {
+					x.Callback = &RequestCallback{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Callback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5447,25 +5547,29 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
-	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
+	md_RequestCallback           protoreflect.MessageDescriptor
+	fd_RequestCallback_module    protoreflect.FieldDescriptor
+	fd_RequestCallback_payload   protoreflect.FieldDescriptor
+	fd_RequestCallback_gas_limit protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
-	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
+	md_RequestCallback = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestCallback")
+	fd_RequestCallback_module = md_RequestCallback.Fields().ByName("module")
+	fd_RequestCallback_payload = md_RequestCallback.Fields().ByName("payload")
+	fd_RequestCallback_gas_limit = md_RequestCallback.Fields().ByName("gas_limit")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+var _ protoreflect.Message = (*fastReflection_RequestCallback)(nil)
 
-type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
+type fastReflection_RequestCallback RequestCallback
 
-func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
+func (x *RequestCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(x)
 }
 
-func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
+func (x *RequestCallback) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5477,43 +5581,43 @@ func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
+var _fastReflection_RequestCallback_messageType fastReflection_RequestCallback_messageType
+var _ protoreflect.MessageType = fastReflection_RequestCallback_messageType{}
 
-type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
+type fastReflection_RequestCallback_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+func (x fastReflection_RequestCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(nil)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x fastReflection_RequestCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x fastReflection_RequestCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x *fastReflection_RequestCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
+func (x *fastReflection_RequestCallback) Type() protoreflect.MessageType {
+	return _fastReflection_RequestCallback_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x *fastReflection_RequestCallback) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketAcknowledgement)(x)
+func (x *fastReflection_RequestCallback) Interface() protoreflect.ProtoMessage {
+	return (*RequestCallback)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5521,10 +5625,22 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
+func (x *fastReflection_RequestCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_RequestCallback_module, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_RequestCallback_payload, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_RequestCallback_gas_limit, value) {
 			return
 		}
 	}
@@ -5541,15 +5657,19 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RequestCallback) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		return x.RequestId != uint64(0)
+	case "band.oracle.v1.RequestCallback.module":
+		return x.Module != ""
+	case "band.oracle.v1.RequestCallback.payload":
+		return len(x.Payload) != 0
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		return x.GasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5559,15 +5679,19 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RequestCallback) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		x.RequestId = uint64(0)
+	case "band.oracle.v1.RequestCallback.module":
+		x.Module = ""
+	case "band.oracle.v1.RequestCallback.payload":
+		x.Payload = nil
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		x.GasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5577,16 +5701,22 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.RequestCallback.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestCallback.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		value := x.GasLimit
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5600,15 +5730,19 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RequestCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		x.RequestId = value.Uint()
+	case "band.oracle.v1.RequestCallback.module":
+		x.Module = value.Interface().(string)
+	case "band.oracle.v1.RequestCallback.payload":
+		x.Payload = value.Bytes()
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		x.GasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5622,40 +5756,48 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
+	case "band.oracle.v1.RequestCallback.module":
+		panic(fmt.Errorf("field module of message band.oracle.v1.RequestCallback is not mutable"))
+	case "band.oracle.v1.RequestCallback.payload":
+		panic(fmt.Errorf("field payload of message band.oracle.v1.RequestCallback is not mutable"))
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message band.oracle.v1.RequestCallback is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RequestCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.RequestCallback.module":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestCallback.payload":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RequestCallback.gas_limit":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RequestCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestCallback", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5663,7 +5805,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RequestCallback) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5674,7 +5816,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RequestCallback) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5686,7 +5828,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
+func (x *fastReflection_RequestCallback) IsValid() bool {
 	return x != nil
 }
 
@@ -5696,9 +5838,9 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RequestCallback) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RequestCallback)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5710,8 +5852,16 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5723,7 +5873,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RequestCallback)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5742,10 +5892,24 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x18
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5758,7 +5922,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RequestCallback)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5790,17 +5954,17 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 				}
-				x.RequestId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5810,78 +5974,187 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_OracleResponsePacketData                protoreflect.MessageDescriptor
-	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
-	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
-	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
-	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
-	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
-	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
-	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
-	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
+var _ protoreflect.List = (*_Report_3_list)(nil)
 
-type fastReflection_OracleResponsePacketData OracleResponsePacketData
+type _Report_3_list struct {
+	list *[]*RawReport
+}
 
-func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(x)
+func (x *_Report_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
+func (x *_Report_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Report_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Report_3_list) AppendMutable() protoreflect.Value {
+	v := new(RawReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Report_3_list) NewElement() protoreflect.Value {
+	v := new(RawReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Report                   protoreflect.MessageDescriptor
+	fd_Report_validator         protoreflect.FieldDescriptor
+	fd_Report_in_before_resolve protoreflect.FieldDescriptor
+	fd_Report_raw_reports       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
+	fd_Report_validator = md_Report.Fields().ByName("validator")
+	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
+	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+}
+
+var _ protoreflect.Message = (*fastReflection_Report)(nil)
+
+type fastReflection_Report Report
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Report)(x)
+}
+
+func (x *Report) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5893,43 +6166,43 @@ func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
+var _fastReflection_Report_messageType fastReflection_Report_messageType
+var _ protoreflect.MessageType = fastReflection_Report_messageType{}
 
-type fastReflection_OracleResponsePacketData_messageType struct{}
+type fastReflection_Report_messageType struct{}
 
-func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(nil)
+func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Report)(nil)
 }
-func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
+func (x fastReflection_Report_messageType) New() protoreflect.Message {
+	return new(fastReflection_Report)
 }
-func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
+func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
+func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleResponsePacketData_messageType
+func (x *fastReflection_Report) Type() protoreflect.MessageType {
+	return _fastReflection_Report_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
+func (x *fastReflection_Report) New() protoreflect.Message {
+	return new(fastReflection_Report)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleResponsePacketData)(x)
+func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
+	return (*Report)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5937,46 +6210,22 @@ func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleResponsePacketData_client_id, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleResponsePacketData_request_id, value) {
-			return
-		}
-	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_OracleResponsePacketData_ans_count, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_OracleResponsePacketData_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_OracleResponsePacketData_resolve_time, value) {
+func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_Report_validator, value) {
 			return
 		}
 	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_OracleResponsePacketData_resolve_status, value) {
+	if x.InBeforeResolve != false {
+		value := protoreflect.ValueOfBool(x.InBeforeResolve)
+		if !f(fd_Report_in_before_resolve, value) {
 			return
 		}
 	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_OracleResponsePacketData_result, value) {
+	if len(x.RawReports) != 0 {
+		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
+		if !f(fd_Report_raw_reports, value) {
 			return
 		}
 	}
@@ -5993,27 +6242,19 @@ func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return len(x.Result) != 0
+	case "band.oracle.v1.Report.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		return x.InBeforeResolve != false
+	case "band.oracle.v1.Report.raw_reports":
+		return len(x.RawReports) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6023,27 +6264,19 @@ func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = nil
+	case "band.oracle.v1.Report.validator":
+		x.Validator = ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = false
+	case "band.oracle.v1.Report.raw_reports":
+		x.RawReports = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6053,34 +6286,25 @@ func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		value := x.ClientId
+	case "band.oracle.v1.Report.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		value := x.AnsCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		value := x.ResolveStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		value := x.Result
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Report.in_before_resolve":
+		value := x.InBeforeResolve
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Report.raw_reports":
+		if len(x.RawReports) == 0 {
+			return protoreflect.ValueOfList(&_Report_3_list{})
+		}
+		listValue := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6094,27 +6318,21 @@ func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = value.Bytes()
+	case "band.oracle.v1.Report.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = value.Bool()
+	case "band.oracle.v1.Report.raw_reports":
+		lv := value.List()
+		clv := lv.(*_Report_3_list)
+		x.RawReports = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6128,64 +6346,53 @@ func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.Report.raw_reports":
+		if x.RawReports == nil {
+			x.RawReports = []*RawReport{}
+		}
+		value := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Report.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.Report.in_before_resolve":
+		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
+	case "band.oracle.v1.Report.validator":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Report.in_before_resolve":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Report.raw_reports":
+		list := []*RawReport{}
+		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6193,7 +6400,7 @@ func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6204,7 +6411,7 @@ func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6216,7 +6423,7 @@ func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
+func (x *fastReflection_Report) IsValid() bool {
 	return x != nil
 }
 
@@ -6226,9 +6433,9 @@ func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*Report)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6240,28 +6447,18 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
-		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.InBeforeResolve {
+			n += 2
 		}
-		l = len(x.Result)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.RawReports) > 0 {
+			for _, e := range x.RawReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6273,7 +6470,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*Report)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6292,42 +6489,36 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x20
+		if len(x.RawReports) > 0 {
+			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+		if x.InBeforeResolve {
 			i--
-			dAtA[i] = 0x18
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			if x.InBeforeResolve {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6342,7 +6533,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*Report)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6374,15 +6565,15 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6410,13 +6601,13 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
 				}
-				x.RequestId = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6426,92 +6617,17 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				x.InBeforeResolve = bool(v != 0)
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-				}
-				x.AnsCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-				}
-				x.ResolveTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-				}
-				x.ResolveStatus = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6521,24 +6637,24 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
+				x.RawReports = append(x.RawReports, &RawReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -6576,46 +6692,95 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 	}
 }
 
+var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
+
+type _OracleRequestPacketData_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_OracleRequestPacketData_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Result                  protoreflect.MessageDescriptor
-	fd_Result_client_id        protoreflect.FieldDescriptor
-	fd_Result_oracle_script_id protoreflect.FieldDescriptor
-	fd_Result_calldata         protoreflect.FieldDescriptor
-	fd_Result_ask_count        protoreflect.FieldDescriptor
-	fd_Result_min_count        protoreflect.FieldDescriptor
-	fd_Result_request_id       protoreflect.FieldDescriptor
-	fd_Result_ans_count        protoreflect.FieldDescriptor
-	fd_Result_request_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_status   protoreflect.FieldDescriptor
-	fd_Result_result           protoreflect.FieldDescriptor
+	md_OracleRequestPacketData                       protoreflect.MessageDescriptor
+	fd_OracleRequestPacketData_client_id             protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_oracle_script_id      protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_calldata              protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_ask_count             protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_min_count             protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_fee_limit             protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_prepare_gas           protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_execute_gas           protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_tss_encoder           protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_oracle_script_version protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
-	fd_Result_client_id = md_Result.Fields().ByName("client_id")
-	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
-	fd_Result_calldata = md_Result.Fields().ByName("calldata")
-	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
-	fd_Result_min_count = md_Result.Fields().ByName("min_count")
-	fd_Result_request_id = md_Result.Fields().ByName("request_id")
-	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
-	fd_Result_request_time = md_Result.Fields().ByName("request_time")
-	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
-	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
-	fd_Result_result = md_Result.Fields().ByName("result")
+	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
+	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
+	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
+	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
+	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
+	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
+	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
+	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
+	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
+	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
+	fd_OracleRequestPacketData_oracle_script_version = md_OracleRequestPacketData.Fields().ByName("oracle_script_version")
 }
 
-var _ protoreflect.Message = (*fastReflection_Result)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
 
-type fastReflection_Result Result
+type fastReflection_OracleRequestPacketData OracleRequestPacketData
 
-func (x *Result) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Result)(x)
+func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(x)
 }
 
-func (x *Result) slowProtoReflect() protoreflect.Message {
+func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6627,43 +6792,43 @@ func (x *Result) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Result_messageType fastReflection_Result_messageType
-var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
 
-type fastReflection_Result_messageType struct{}
+type fastReflection_OracleRequestPacketData_messageType struct{}
 
-func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Result)(nil)
+func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(nil)
 }
-func (x fastReflection_Result_messageType) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
 }
-func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Result) Type() protoreflect.MessageType {
-	return _fastReflection_Result_messageType
+func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Result) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
-	return (*Result)(x)
+func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6671,70 +6836,64 @@ func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ClientId != "" {
 		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Result_client_id, value) {
+		if !f(fd_OracleRequestPacketData_client_id, value) {
 			return
 		}
 	}
 	if x.OracleScriptId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Result_oracle_script_id, value) {
+		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
 			return
 		}
 	}
 	if len(x.Calldata) != 0 {
 		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Result_calldata, value) {
+		if !f(fd_OracleRequestPacketData_calldata, value) {
 			return
 		}
 	}
 	if x.AskCount != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_Result_ask_count, value) {
+		if !f(fd_OracleRequestPacketData_ask_count, value) {
 			return
 		}
 	}
 	if x.MinCount != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Result_min_count, value) {
+		if !f(fd_OracleRequestPacketData_min_count, value) {
 			return
 		}
 	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_Result_request_id, value) {
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
+		if !f(fd_OracleRequestPacketData_fee_limit, value) {
 			return
 		}
 	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_Result_ans_count, value) {
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
 			return
 		}
 	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Result_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_Result_resolve_time, value) {
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_OracleRequestPacketData_execute_gas, value) {
 			return
 		}
 	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_Result_resolve_status, value) {
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
 			return
 		}
 	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_Result_result, value) {
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_OracleRequestPacketData_oracle_script_version, value) {
 			return
 		}
 	}
@@ -6751,35 +6910,33 @@ func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		return x.ClientId != ""
-	case "band.oracle.v1.Result.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Result.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		return len(x.Calldata) != 0
-	case "band.oracle.v1.Result.ask_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		return x.AskCount != uint64(0)
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.Result.result":
-		return len(x.Result) != 0
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6789,35 +6946,33 @@ func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		x.ClientId = ""
-	case "band.oracle.v1.Result.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Result.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		x.Calldata = nil
-	case "band.oracle.v1.Result.ask_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		x.AskCount = uint64(0)
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		x.MinCount = uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.Result.result":
-		x.Result = nil
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6827,46 +6982,46 @@ func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Result.client_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Result.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Result.ask_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		value := x.AskCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		value := x.MinCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
+		}
+		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		value := x.PrepareGas
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.ans_count":
-		value := x.AnsCount
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		value := x.ExecuteGas
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_status":
-		value := x.ResolveStatus
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		value := x.TssEncoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Result.result":
-		value := x.Result
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6880,35 +7035,35 @@ func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Result.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Result.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Result.ask_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		x.AskCount = value.Uint()
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		x.MinCount = value.Uint()
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.Result.result":
-		x.Result = value.Bytes()
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		lv := value.List()
+		clv := lv.(*_OracleRequestPacketData_6_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6922,80 +7077,81 @@ func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.OracleRequestPacketData is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Result.oracle_script_id":
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.calldata":
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Result.ask_count":
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.min_count":
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_id":
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.ans_count":
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_status":
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
 		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Result.result":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7003,7 +7159,7 @@ func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7014,7 +7170,7 @@ func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7026,7 +7182,7 @@ func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Result) IsValid() bool {
+func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -7036,9 +7192,9 @@ func (x *fastReflection_Result) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7067,24 +7223,23 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		if x.MinCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinCount))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
 		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
 		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
 		}
-		l = len(x.Result)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7096,7 +7251,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7115,37 +7270,41 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
 			i--
 			dAtA[i] = 0x50
 		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
 			i--
 			dAtA[i] = 0x48
 		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
 			i--
 			dAtA[i] = 0x40
 		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
 			i--
 			dAtA[i] = 0x38
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x30
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
 		}
 		if x.MinCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
@@ -7187,7 +7346,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*OracleRequestPacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7219,10 +7378,10 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7349,10 +7508,10 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 				}
-				x.RequestId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7362,16 +7521,31 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 				}
-				x.AnsCount = 0
+				x.PrepareGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7381,16 +7555,16 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
+					x.PrepareGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 8:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 				}
-				x.RequestTime = 0
+				x.ExecuteGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7400,16 +7574,16 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
+					x.ExecuteGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 9:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
 				}
-				x.ResolveTime = 0
+				x.TssEncoder = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7419,35 +7593,16 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
+					x.TssEncoder |= Encoder(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 10:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 				}
-				x.ResolveStatus = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-				}
-				var byteLen int
+				x.OracleScriptVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7457,26 +7612,11 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7513,29 +7653,25 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SigningResult                 protoreflect.MessageDescriptor
-	fd_SigningResult_signing_id      protoreflect.FieldDescriptor
-	fd_SigningResult_error_codespace protoreflect.FieldDescriptor
-	fd_SigningResult_error_code      protoreflect.FieldDescriptor
+	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
+	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_SigningResult = File_band_oracle_v1_oracle_proto.Messages().ByName("SigningResult")
-	fd_SigningResult_signing_id = md_SigningResult.Fields().ByName("signing_id")
-	fd_SigningResult_error_codespace = md_SigningResult.Fields().ByName("error_codespace")
-	fd_SigningResult_error_code = md_SigningResult.Fields().ByName("error_code")
+	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
+	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_SigningResult)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
 
-type fastReflection_SigningResult SigningResult
+type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
 
-func (x *SigningResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SigningResult)(x)
+func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
 }
 
-func (x *SigningResult) slowProtoReflect() protoreflect.Message {
+func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7547,43 +7683,43 @@ func (x *SigningResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SigningResult_messageType fastReflection_SigningResult_messageType
-var _ protoreflect.MessageType = fastReflection_SigningResult_messageType{}
+var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
 
-type fastReflection_SigningResult_messageType struct{}
+type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
 
-func (x fastReflection_SigningResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SigningResult)(nil)
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
 }
-func (x fastReflection_SigningResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SigningResult)
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
 }
-func (x fastReflection_SigningResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SigningResult
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SigningResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SigningResult
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SigningResult) Type() protoreflect.MessageType {
-	return _fastReflection_SigningResult_messageType
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SigningResult) New() protoreflect.Message {
-	return new(fastReflection_SigningResult)
+func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
-	return (*SigningResult)(x)
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketAcknowledgement)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7591,22 +7727,10 @@ func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SigningId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SigningId)
-		if !f(fd_SigningResult_signing_id, value) {
-			return
-		}
-	}
-	if x.ErrorCodespace != "" {
-		value := protoreflect.ValueOfString(x.ErrorCodespace)
-		if !f(fd_SigningResult_error_codespace, value) {
-			return
-		}
-	}
-	if x.ErrorCode != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ErrorCode)
-		if !f(fd_SigningResult_error_code, value) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
 			return
 		}
 	}
@@ -7623,19 +7747,15 @@ func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		return x.SigningId != uint64(0)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		return x.ErrorCodespace != ""
-	case "band.oracle.v1.SigningResult.error_code":
-		return x.ErrorCode != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		return x.RequestId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7645,19 +7765,15 @@ func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		x.SigningId = uint64(0)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		x.ErrorCodespace = ""
-	case "band.oracle.v1.SigningResult.error_code":
-		x.ErrorCode = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7667,22 +7783,16 @@ func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		value := x.SigningId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		value := x.ErrorCodespace
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.SigningResult.error_code":
-		value := x.ErrorCode
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7696,19 +7806,15 @@ func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		x.SigningId = value.Uint()
-	case "band.oracle.v1.SigningResult.error_codespace":
-		x.ErrorCodespace = value.Interface().(string)
-	case "band.oracle.v1.SigningResult.error_code":
-		x.ErrorCode = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7722,48 +7828,40 @@ func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		panic(fmt.Errorf("field signing_id of message band.oracle.v1.SigningResult is not mutable"))
-	case "band.oracle.v1.SigningResult.error_codespace":
-		panic(fmt.Errorf("field error_codespace of message band.oracle.v1.SigningResult is not mutable"))
-	case "band.oracle.v1.SigningResult.error_code":
-		panic(fmt.Errorf("field error_code of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SigningResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.SigningResult.error_codespace":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.SigningResult.error_code":
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.SigningResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7771,7 +7869,7 @@ func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7782,7 +7880,7 @@ func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7794,7 +7892,7 @@ func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SigningResult) IsValid() bool {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
 	return x != nil
 }
 
@@ -7804,9 +7902,9 @@ func (x *fastReflection_SigningResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7818,15 +7916,8 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.SigningId != 0 {
-			n += 1 + runtime.Sov(uint64(x.SigningId))
-		}
-		l = len(x.ErrorCodespace)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ErrorCode != 0 {
-			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7838,7 +7929,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7857,20 +7948,8 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ErrorCode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.ErrorCodespace) > 0 {
-			i -= len(x.ErrorCodespace)
-			copy(dAtA[i:], x.ErrorCodespace)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorCodespace)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.SigningId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -7885,7 +7964,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7917,68 +7996,17 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
-				}
-				x.SigningId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SigningId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ErrorCodespace = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				x.ErrorCode = 0
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7988,7 +8016,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ErrorCode |= uint64(b&0x7F) << shift
+					x.RequestId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8029,27 +8057,37 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ValidatorStatus           protoreflect.MessageDescriptor
-	fd_ValidatorStatus_is_active protoreflect.FieldDescriptor
-	fd_ValidatorStatus_since     protoreflect.FieldDescriptor
+	md_OracleResponsePacketData                protoreflect.MessageDescriptor
+	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_ValidatorStatus = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorStatus")
-	fd_ValidatorStatus_is_active = md_ValidatorStatus.Fields().ByName("is_active")
-	fd_ValidatorStatus_since = md_ValidatorStatus.Fields().ByName("since")
+	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
+	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
+	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
+	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
+	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
+	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
+	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
+	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorStatus)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
 
-type fastReflection_ValidatorStatus ValidatorStatus
+type fastReflection_OracleResponsePacketData OracleResponsePacketData
 
-func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorStatus)(x)
+func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(x)
 }
 
-func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
+func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8061,43 +8099,43 @@ func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorStatus_messageType fastReflection_ValidatorStatus_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorStatus_messageType{}
+var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
 
-type fastReflection_ValidatorStatus_messageType struct{}
+type fastReflection_OracleResponsePacketData_messageType struct{}
 
-func (x fastReflection_ValidatorStatus_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorStatus)(nil)
+func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(nil)
 }
-func (x fastReflection_ValidatorStatus_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorStatus)
+func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
 }
-func (x fastReflection_ValidatorStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorStatus
+func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorStatus) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorStatus
+func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorStatus) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorStatus_messageType
+func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleResponsePacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorStatus) New() protoreflect.Message {
-	return new(fastReflection_ValidatorStatus)
+func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorStatus) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorStatus)(x)
+func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleResponsePacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8105,21 +8143,51 @@ func (x *fastReflection_ValidatorStatus) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsActive != false {
-		value := protoreflect.ValueOfBool(x.IsActive)
-		if !f(fd_ValidatorStatus_is_active, value) {
+func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_OracleResponsePacketData_client_id, value) {
 			return
 		}
 	}
-	if x.Since != nil {
-		value := protoreflect.ValueOfMessage(x.Since.ProtoReflect())
-		if !f(fd_ValidatorStatus_since, value) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleResponsePacketData_request_id, value) {
 			return
 		}
 	}
-}
-
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_OracleResponsePacketData_ans_count, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_OracleResponsePacketData_request_time, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_OracleResponsePacketData_resolve_time, value) {
+			return
+		}
+	}
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_OracleResponsePacketData_resolve_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_OracleResponsePacketData_result, value) {
+			return
+		}
+	}
+}
+
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
@@ -8131,17 +8199,27 @@ func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		return x.IsActive != false
-	case "band.oracle.v1.ValidatorStatus.since":
-		return x.Since != nil
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return len(x.Result) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8151,17 +8229,27 @@ func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		x.IsActive = false
-	case "band.oracle.v1.ValidatorStatus.since":
-		x.Since = nil
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8171,19 +8259,34 @@ func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		value := x.IsActive
-		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.ValidatorStatus.since":
-		value := x.Since
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		value := x.AnsCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		value := x.ResolveStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8197,17 +8300,27 @@ func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		x.IsActive = value.Bool()
-	case "band.oracle.v1.ValidatorStatus.since":
-		x.Since = value.Message().Interface().(*timestamppb.Timestamp)
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8221,48 +8334,64 @@ func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.since":
-		if x.Since == nil {
-			x.Since = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.Since.ProtoReflect())
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		panic(fmt.Errorf("field is_active of message band.oracle.v1.ValidatorStatus is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.ValidatorStatus.since":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorStatus", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8270,7 +8399,7 @@ func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8281,7 +8410,7 @@ func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8293,7 +8422,7 @@ func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorStatus) IsValid() bool {
+func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -8303,9 +8432,9 @@ func (x *fastReflection_ValidatorStatus) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8317,11 +8446,27 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.IsActive {
-			n += 2
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Since != nil {
-			l = options.Size(x.Since)
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		}
+		l = len(x.Result)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8334,7 +8479,7 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8353,29 +8498,44 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Since != nil {
-			encoded, err := options.Marshal(x.Since)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
-		if x.IsActive {
-			i--
-			if x.IsActive {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x30
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8388,7 +8548,7 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*OracleResponsePacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8420,17 +8580,17 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8440,17 +8600,124 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsActive = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				}
+				x.AnsCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnsCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				}
+				x.ResolveStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8460,26 +8727,24 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Since == nil {
-					x.Since = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Since); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -8518,27 +8783,45 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ActiveValidator         protoreflect.MessageDescriptor
-	fd_ActiveValidator_address protoreflect.FieldDescriptor
-	fd_ActiveValidator_power   protoreflect.FieldDescriptor
+	md_Result                  protoreflect.MessageDescriptor
+	fd_Result_client_id        protoreflect.FieldDescriptor
+	fd_Result_oracle_script_id protoreflect.FieldDescriptor
+	fd_Result_calldata         protoreflect.FieldDescriptor
+	fd_Result_ask_count        protoreflect.FieldDescriptor
+	fd_Result_min_count        protoreflect.FieldDescriptor
+	fd_Result_request_id       protoreflect.FieldDescriptor
+	fd_Result_ans_count        protoreflect.FieldDescriptor
+	fd_Result_request_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_status   protoreflect.FieldDescriptor
+	fd_Result_result           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
-	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
-	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
+	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
+	fd_Result_client_id = md_Result.Fields().ByName("client_id")
+	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
+	fd_Result_calldata = md_Result.Fields().ByName("calldata")
+	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
+	fd_Result_min_count = md_Result.Fields().ByName("min_count")
+	fd_Result_request_id = md_Result.Fields().ByName("request_id")
+	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
+	fd_Result_request_time = md_Result.Fields().ByName("request_time")
+	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
+	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
+	fd_Result_result = md_Result.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
+var _ protoreflect.Message = (*fastReflection_Result)(nil)
 
-type fastReflection_ActiveValidator ActiveValidator
+type fastReflection_Result Result
 
-func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(x)
+func (x *Result) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Result)(x)
 }
 
-func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
+func (x *Result) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8550,43 +8833,43 @@ func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
-var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
+var _fastReflection_Result_messageType fastReflection_Result_messageType
+var _ protoreflect.MessageType = fastReflection_Result_messageType{}
 
-type fastReflection_ActiveValidator_messageType struct{}
+type fastReflection_Result_messageType struct{}
 
-func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(nil)
+func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Result)(nil)
 }
-func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x fastReflection_Result_messageType) New() protoreflect.Message {
+	return new(fastReflection_Result)
 }
-func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
-	return _fastReflection_ActiveValidator_messageType
+func (x *fastReflection_Result) Type() protoreflect.MessageType {
+	return _fastReflection_Result_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x *fastReflection_Result) New() protoreflect.Message {
+	return new(fastReflection_Result)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
-	return (*ActiveValidator)(x)
+func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
+	return (*Result)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8594,18 +8877,72 @@ func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_ActiveValidator_address, value) {
+func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Result_client_id, value) {
 			return
 		}
 	}
-	if x.Power != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Power)
-		if !f(fd_ActiveValidator_power, value) {
-			return
-		}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Result_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Result_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_Result_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Result_min_count, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_Result_request_id, value) {
+			return
+		}
+	}
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_Result_ans_count, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Result_request_time, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_Result_resolve_time, value) {
+			return
+		}
+	}
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_Result_resolve_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_Result_result, value) {
+			return
+		}
 	}
 }
 
@@ -8620,17 +8957,35 @@ func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		return x.Address != ""
-	case "band.oracle.v1.ActiveValidator.power":
-		return x.Power != uint64(0)
+	case "band.oracle.v1.Result.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Result.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Result.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.Result.result":
+		return len(x.Result) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8640,17 +8995,35 @@ func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = ""
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = uint64(0)
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Result.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.Result.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8660,19 +9033,46 @@ func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		value := x.Address
+	case "band.oracle.v1.Result.client_id":
+		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.ActiveValidator.power":
-		value := x.Power
+	case "band.oracle.v1.Result.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Result.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.ans_count":
+		value := x.AnsCount
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_status":
+		value := x.ResolveStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Result.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8686,17 +9086,35 @@ func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = value.Interface().(string)
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = value.Uint()
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Result.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.Result.result":
+		x.Result = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8710,44 +9128,80 @@ func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
-	case "band.oracle.v1.ActiveValidator.power":
-		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.Result.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
+	case "band.oracle.v1.Result.client_id":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.ActiveValidator.power":
+	case "band.oracle.v1.Result.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Result.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.ans_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Result.result":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8755,7 +9209,7 @@ func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8766,7 +9220,7 @@ func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8778,7 +9232,7 @@ func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ActiveValidator) IsValid() bool {
+func (x *fastReflection_Result) IsValid() bool {
 	return x != nil
 }
 
@@ -8788,9 +9242,9 @@ func (x *fastReflection_ActiveValidator) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8802,12 +9256,41 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.ClientId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Power != 0 {
-			n += 1 + runtime.Sov(uint64(x.Power))
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8819,7 +9302,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8838,15 +9321,64 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8861,7 +9393,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*Result)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8893,15 +9425,15 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8929,13 +9461,13 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.ClientId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				x.Power = 0
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8945,205 +9477,527 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_raw_request_count     protoreflect.FieldDescriptor
-	fd_Params_max_ask_count             protoreflect.FieldDescriptor
-	fd_Params_max_calldata_size         protoreflect.FieldDescriptor
-	fd_Params_max_report_data_size      protoreflect.FieldDescriptor
-	fd_Params_expiration_block_count    protoreflect.FieldDescriptor
-	fd_Params_base_owasm_gas            protoreflect.FieldDescriptor
-	fd_Params_per_validator_request_gas protoreflect.FieldDescriptor
-	fd_Params_sampling_try_count        protoreflect.FieldDescriptor
-	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
-	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
-	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
-	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
-	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
-	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
-	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
-	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
-	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
-	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
-	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
-	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
-	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
-	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
-}
-
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
-
-type fastReflection_Params_messageType struct{}
-
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
-}
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MaxRawRequestCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
-		if !f(fd_Params_max_raw_request_count, value) {
-			return
-		}
-	}
-	if x.MaxAskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxAskCount)
-		if !f(fd_Params_max_ask_count, value) {
-			return
-		}
-	}
-	if x.MaxCalldataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
-		if !f(fd_Params_max_calldata_size, value) {
-			return
-		}
-	}
-	if x.MaxReportDataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
-		if !f(fd_Params_max_report_data_size, value) {
-			return
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				}
+				x.AnsCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnsCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				}
+				x.ResolveStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
-	}
-	if x.ExpirationBlockCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
-		if !f(fd_Params_expiration_block_count, value) {
-			return
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
-	if x.BaseOwasmGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
-		if !f(fd_Params_base_owasm_gas, value) {
-			return
-		}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
 	}
-	if x.PerValidatorRequestGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
-		if !f(fd_Params_per_validator_request_gas, value) {
-			return
-		}
+}
+
+var _ protoreflect.List = (*_FeeReceipt_3_list)(nil)
+
+type _FeeReceipt_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeReceipt_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.SamplingTryCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
-		if !f(fd_Params_sampling_try_count, value) {
+	return len(*x.list)
+}
+
+func (x *_FeeReceipt_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeReceipt_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeReceipt_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeReceipt_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeReceipt_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeReceipt_4_list)(nil)
+
+type _FeeReceipt_4_list struct {
+	list *[]*FeeReceiptItem
+}
+
+func (x *_FeeReceipt_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeReceipt_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeReceipt_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeReceiptItem)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeReceipt_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeReceiptItem)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeReceipt_4_list) AppendMutable() protoreflect.Value {
+	v := new(FeeReceiptItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeReceipt_4_list) NewElement() protoreflect.Value {
+	v := new(FeeReceiptItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeReceipt_5_list)(nil)
+
+type _FeeReceipt_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeReceipt_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeReceipt_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeReceipt_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeReceipt_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeReceipt_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeReceipt_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceipt_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeReceipt                protoreflect.MessageDescriptor
+	fd_FeeReceipt_request_id     protoreflect.FieldDescriptor
+	fd_FeeReceipt_payer          protoreflect.FieldDescriptor
+	fd_FeeReceipt_escrowed       protoreflect.FieldDescriptor
+	fd_FeeReceipt_items          protoreflect.FieldDescriptor
+	fd_FeeReceipt_refunded       protoreflect.FieldDescriptor
+	fd_FeeReceipt_settled        protoreflect.FieldDescriptor
+	fd_FeeReceipt_refund_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_FeeReceipt = File_band_oracle_v1_oracle_proto.Messages().ByName("FeeReceipt")
+	fd_FeeReceipt_request_id = md_FeeReceipt.Fields().ByName("request_id")
+	fd_FeeReceipt_payer = md_FeeReceipt.Fields().ByName("payer")
+	fd_FeeReceipt_escrowed = md_FeeReceipt.Fields().ByName("escrowed")
+	fd_FeeReceipt_items = md_FeeReceipt.Fields().ByName("items")
+	fd_FeeReceipt_refunded = md_FeeReceipt.Fields().ByName("refunded")
+	fd_FeeReceipt_settled = md_FeeReceipt.Fields().ByName("settled")
+	fd_FeeReceipt_refund_address = md_FeeReceipt.Fields().ByName("refund_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeReceipt)(nil)
+
+type fastReflection_FeeReceipt FeeReceipt
+
+func (x *FeeReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeReceipt)(x)
+}
+
+func (x *FeeReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeReceipt_messageType fastReflection_FeeReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_FeeReceipt_messageType{}
+
+type fastReflection_FeeReceipt_messageType struct{}
+
+func (x fastReflection_FeeReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeReceipt)(nil)
+}
+func (x fastReflection_FeeReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeReceipt)
+}
+func (x fastReflection_FeeReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_FeeReceipt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeReceipt) New() protoreflect.Message {
+	return new(fastReflection_FeeReceipt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeReceipt) Interface() protoreflect.ProtoMessage {
+	return (*FeeReceipt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_FeeReceipt_request_id, value) {
 			return
 		}
 	}
-	if x.OracleRewardPercentage != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
-		if !f(fd_Params_oracle_reward_percentage, value) {
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_FeeReceipt_payer, value) {
 			return
 		}
 	}
-	if x.InactivePenaltyDuration != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
-		if !f(fd_Params_inactive_penalty_duration, value) {
+	if len(x.Escrowed) != 0 {
+		value := protoreflect.ValueOfList(&_FeeReceipt_3_list{list: &x.Escrowed})
+		if !f(fd_FeeReceipt_escrowed, value) {
 			return
 		}
 	}
-	if x.IbcRequestEnabled != false {
-		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
-		if !f(fd_Params_ibc_request_enabled, value) {
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_FeeReceipt_4_list{list: &x.Items})
+		if !f(fd_FeeReceipt_items, value) {
+			return
+		}
+	}
+	if len(x.Refunded) != 0 {
+		value := protoreflect.ValueOfList(&_FeeReceipt_5_list{list: &x.Refunded})
+		if !f(fd_FeeReceipt_refunded, value) {
+			return
+		}
+	}
+	if x.Settled != false {
+		value := protoreflect.ValueOfBool(x.Settled)
+		if !f(fd_FeeReceipt_settled, value) {
+			return
+		}
+	}
+	if x.RefundAddress != "" {
+		value := protoreflect.ValueOfString(x.RefundAddress)
+		if !f(fd_FeeReceipt_refund_address, value) {
 			return
 		}
 	}
@@ -9160,35 +10014,27 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return x.MaxRawRequestCount != uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		return x.MaxAskCount != uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		return x.MaxCalldataSize != uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		return x.MaxReportDataSize != uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		return x.ExpirationBlockCount != uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return x.BaseOwasmGas != uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return x.PerValidatorRequestGas != uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		return x.SamplingTryCount != uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		return x.OracleRewardPercentage != uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		return x.InactivePenaltyDuration != uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.FeeReceipt.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.FeeReceipt.payer":
+		return x.Payer != ""
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		return len(x.Escrowed) != 0
+	case "band.oracle.v1.FeeReceipt.items":
+		return len(x.Items) != 0
+	case "band.oracle.v1.FeeReceipt.refunded":
+		return len(x.Refunded) != 0
+	case "band.oracle.v1.FeeReceipt.settled":
+		return x.Settled != false
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		return x.RefundAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9198,35 +10044,27 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = false
+	case "band.oracle.v1.FeeReceipt.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.FeeReceipt.payer":
+		x.Payer = ""
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		x.Escrowed = nil
+	case "band.oracle.v1.FeeReceipt.items":
+		x.Items = nil
+	case "band.oracle.v1.FeeReceipt.refunded":
+		x.Refunded = nil
+	case "band.oracle.v1.FeeReceipt.settled":
+		x.Settled = false
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		x.RefundAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9236,46 +10074,43 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		value := x.MaxRawRequestCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_ask_count":
-		value := x.MaxAskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_calldata_size":
-		value := x.MaxCalldataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_report_data_size":
-		value := x.MaxReportDataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.expiration_block_count":
-		value := x.ExpirationBlockCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		value := x.BaseOwasmGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		value := x.PerValidatorRequestGas
+	case "band.oracle.v1.FeeReceipt.request_id":
+		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.sampling_try_count":
-		value := x.SamplingTryCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		value := x.OracleRewardPercentage
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		value := x.InactivePenaltyDuration
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		value := x.IbcRequestEnabled
+	case "band.oracle.v1.FeeReceipt.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		if len(x.Escrowed) == 0 {
+			return protoreflect.ValueOfList(&_FeeReceipt_3_list{})
+		}
+		listValue := &_FeeReceipt_3_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeReceipt.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_FeeReceipt_4_list{})
+		}
+		listValue := &_FeeReceipt_4_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeReceipt.refunded":
+		if len(x.Refunded) == 0 {
+			return protoreflect.ValueOfList(&_FeeReceipt_5_list{})
+		}
+		listValue := &_FeeReceipt_5_list{list: &x.Refunded}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeReceipt.settled":
+		value := x.Settled
 		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		value := x.RefundAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9289,35 +10124,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = value.Uint()
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = value.Uint()
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = value.Uint()
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = value.Uint()
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = value.Uint()
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = value.Uint()
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = value.Uint()
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = value.Uint()
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = value.Uint()
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = value.Uint()
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.FeeReceipt.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.FeeReceipt.payer":
+		x.Payer = value.Interface().(string)
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		lv := value.List()
+		clv := lv.(*_FeeReceipt_3_list)
+		x.Escrowed = *clv.list
+	case "band.oracle.v1.FeeReceipt.items":
+		lv := value.List()
+		clv := lv.(*_FeeReceipt_4_list)
+		x.Items = *clv.list
+	case "band.oracle.v1.FeeReceipt.refunded":
+		lv := value.List()
+		clv := lv.(*_FeeReceipt_5_list)
+		x.Refunded = *clv.list
+	case "band.oracle.v1.FeeReceipt.settled":
+		x.Settled = value.Bool()
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		x.RefundAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9331,80 +10164,79 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_ask_count":
-		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_calldata_size":
-		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_report_data_size":
-		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.expiration_block_count":
-		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.sampling_try_count":
-		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		if x.Escrowed == nil {
+			x.Escrowed = []*v1beta1.Coin{}
+		}
+		value := &_FeeReceipt_3_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeReceipt.items":
+		if x.Items == nil {
+			x.Items = []*FeeReceiptItem{}
+		}
+		value := &_FeeReceipt_4_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeReceipt.refunded":
+		if x.Refunded == nil {
+			x.Refunded = []*v1beta1.Coin{}
+		}
+		value := &_FeeReceipt_5_list{list: &x.Refunded}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeReceipt.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.FeeReceipt is not mutable"))
+	case "band.oracle.v1.FeeReceipt.payer":
+		panic(fmt.Errorf("field payer of message band.oracle.v1.FeeReceipt is not mutable"))
+	case "band.oracle.v1.FeeReceipt.settled":
+		panic(fmt.Errorf("field settled of message band.oracle.v1.FeeReceipt is not mutable"))
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		panic(fmt.Errorf("field refund_address of message band.oracle.v1.FeeReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_calldata_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_report_data_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.expiration_block_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.sampling_try_count":
+	case "band.oracle.v1.FeeReceipt.request_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.ibc_request_enabled":
+	case "band.oracle.v1.FeeReceipt.payer":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.FeeReceipt.escrowed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeReceipt_3_list{list: &list})
+	case "band.oracle.v1.FeeReceipt.items":
+		list := []*FeeReceiptItem{}
+		return protoreflect.ValueOfList(&_FeeReceipt_4_list{list: &list})
+	case "band.oracle.v1.FeeReceipt.refunded":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeReceipt_5_list{list: &list})
+	case "band.oracle.v1.FeeReceipt.settled":
 		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.FeeReceipt.refund_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceipt"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.FeeReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9412,7 +10244,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9423,7 +10255,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9435,7 +10267,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_FeeReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -9445,9 +10277,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9459,39 +10291,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxRawRequestCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
-		}
-		if x.MaxAskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
-		}
-		if x.MaxCalldataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
-		}
-		if x.MaxReportDataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
-		}
-		if x.ExpirationBlockCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
-		}
-		if x.BaseOwasmGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
-		if x.PerValidatorRequestGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
+		l = len(x.Payer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SamplingTryCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
+		if len(x.Escrowed) > 0 {
+			for _, e := range x.Escrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.OracleRewardPercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.InactivePenaltyDuration != 0 {
-			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		if len(x.Refunded) > 0 {
+			for _, e := range x.Refunded {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.IbcRequestEnabled {
+		if x.Settled {
 			n += 2
 		}
+		l = len(x.RefundAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9502,7 +10333,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9521,63 +10352,80 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IbcRequestEnabled {
+		if len(x.RefundAddress) > 0 {
+			i -= len(x.RefundAddress)
+			copy(dAtA[i:], x.RefundAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RefundAddress)))
 			i--
-			if x.IbcRequestEnabled {
+			dAtA[i] = 0x3a
+		}
+		if x.Settled {
+			i--
+			if x.Settled {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x58
-		}
-		if x.InactivePenaltyDuration != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.OracleRewardPercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.SamplingTryCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.PerValidatorRequestGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.BaseOwasmGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
-			i--
 			dAtA[i] = 0x30
 		}
-		if x.ExpirationBlockCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
-			i--
-			dAtA[i] = 0x28
+		if len(x.Refunded) > 0 {
+			for iNdEx := len(x.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Refunded[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
 		}
-		if x.MaxReportDataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
-			i--
-			dAtA[i] = 0x20
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if x.MaxCalldataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
-			i--
-			dAtA[i] = 0x18
+		if len(x.Escrowed) > 0 {
+			for iNdEx := len(x.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if x.MaxAskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.MaxRawRequestCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -9592,7 +10440,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9624,17 +10472,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				x.MaxRawRequestCount = 0
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9644,16 +10492,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
+					x.RequestId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 				}
-				x.MaxAskCount = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9663,16 +10511,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxAskCount |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 				}
-				x.MaxCalldataSize = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9682,16 +10543,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxCalldataSize |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrowed = append(x.Escrowed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrowed[len(x.Escrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 				}
-				x.MaxReportDataSize = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9701,54 +10577,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxReportDataSize |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.ExpirationBlockCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseOwasmGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BaseOwasmGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Items = append(x.Items, &FeeReceiptItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 				}
-				x.PerValidatorRequestGas = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9758,54 +10611,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.SamplingTryCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SamplingTryCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OracleRewardPercentage = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Refunded = append(x.Refunded, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded[len(x.Refunded)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 10:
+				iNdEx = postIndex
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
 				}
-				x.InactivePenaltyDuration = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9815,16 +10645,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+				x.Settled = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9834,12 +10665,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IbcRequestEnabled = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9875,72 +10718,138 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_PendingResolveList_1_list)(nil)
+var _ protoreflect.List = (*_FeeReceiptItem_4_list)(nil)
 
-type _PendingResolveList_1_list struct {
-	list *[]uint64
+type _FeeReceiptItem_4_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_PendingResolveList_1_list) Len() int {
+func (x *_FeeReceiptItem_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_PendingResolveList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
+func (x *_FeeReceiptItem_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_FeeReceiptItem_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_PendingResolveList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_FeeReceiptItem_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_PendingResolveList_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message PendingResolveList at list field RequestIds as it is not of Message kind"))
+func (x *_FeeReceiptItem_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) Truncate(n int) {
+func (x *_FeeReceiptItem_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_PendingResolveList_1_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
+func (x *_FeeReceiptItem_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) IsValid() bool {
+func (x *_FeeReceiptItem_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeReceiptItem_6_list)(nil)
+
+type _FeeReceiptItem_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeReceiptItem_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeReceiptItem_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeReceiptItem_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeReceiptItem_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeReceiptItem_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceiptItem_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeReceiptItem_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeReceiptItem_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_PendingResolveList             protoreflect.MessageDescriptor
-	fd_PendingResolveList_request_ids protoreflect.FieldDescriptor
+	md_FeeReceiptItem                protoreflect.MessageDescriptor
+	fd_FeeReceiptItem_external_id    protoreflect.FieldDescriptor
+	fd_FeeReceiptItem_data_source_id protoreflect.FieldDescriptor
+	fd_FeeReceiptItem_treasury       protoreflect.FieldDescriptor
+	fd_FeeReceiptItem_fee_per_report protoreflect.FieldDescriptor
+	fd_FeeReceiptItem_report_count   protoreflect.FieldDescriptor
+	fd_FeeReceiptItem_paid           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_PendingResolveList = File_band_oracle_v1_oracle_proto.Messages().ByName("PendingResolveList")
-	fd_PendingResolveList_request_ids = md_PendingResolveList.Fields().ByName("request_ids")
+	md_FeeReceiptItem = File_band_oracle_v1_oracle_proto.Messages().ByName("FeeReceiptItem")
+	fd_FeeReceiptItem_external_id = md_FeeReceiptItem.Fields().ByName("external_id")
+	fd_FeeReceiptItem_data_source_id = md_FeeReceiptItem.Fields().ByName("data_source_id")
+	fd_FeeReceiptItem_treasury = md_FeeReceiptItem.Fields().ByName("treasury")
+	fd_FeeReceiptItem_fee_per_report = md_FeeReceiptItem.Fields().ByName("fee_per_report")
+	fd_FeeReceiptItem_report_count = md_FeeReceiptItem.Fields().ByName("report_count")
+	fd_FeeReceiptItem_paid = md_FeeReceiptItem.Fields().ByName("paid")
 }
 
-var _ protoreflect.Message = (*fastReflection_PendingResolveList)(nil)
+var _ protoreflect.Message = (*fastReflection_FeeReceiptItem)(nil)
 
-type fastReflection_PendingResolveList PendingResolveList
+type fastReflection_FeeReceiptItem FeeReceiptItem
 
-func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PendingResolveList)(x)
+func (x *FeeReceiptItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeReceiptItem)(x)
 }
 
-func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
+func (x *FeeReceiptItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9952,43 +10861,43 @@ func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PendingResolveList_messageType fastReflection_PendingResolveList_messageType
-var _ protoreflect.MessageType = fastReflection_PendingResolveList_messageType{}
+var _fastReflection_FeeReceiptItem_messageType fastReflection_FeeReceiptItem_messageType
+var _ protoreflect.MessageType = fastReflection_FeeReceiptItem_messageType{}
 
-type fastReflection_PendingResolveList_messageType struct{}
+type fastReflection_FeeReceiptItem_messageType struct{}
 
-func (x fastReflection_PendingResolveList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PendingResolveList)(nil)
+func (x fastReflection_FeeReceiptItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeReceiptItem)(nil)
 }
-func (x fastReflection_PendingResolveList_messageType) New() protoreflect.Message {
-	return new(fastReflection_PendingResolveList)
+func (x fastReflection_FeeReceiptItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeReceiptItem)
 }
-func (x fastReflection_PendingResolveList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingResolveList
+func (x fastReflection_FeeReceiptItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeReceiptItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PendingResolveList) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingResolveList
+func (x *fastReflection_FeeReceiptItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeReceiptItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PendingResolveList) Type() protoreflect.MessageType {
-	return _fastReflection_PendingResolveList_messageType
+func (x *fastReflection_FeeReceiptItem) Type() protoreflect.MessageType {
+	return _fastReflection_FeeReceiptItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PendingResolveList) New() protoreflect.Message {
-	return new(fastReflection_PendingResolveList)
+func (x *fastReflection_FeeReceiptItem) New() protoreflect.Message {
+	return new(fastReflection_FeeReceiptItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessage {
-	return (*PendingResolveList)(x)
+func (x *fastReflection_FeeReceiptItem) Interface() protoreflect.ProtoMessage {
+	return (*FeeReceiptItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9996,10 +10905,40 @@ func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.RequestIds) != 0 {
-		value := protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &x.RequestIds})
-		if !f(fd_PendingResolveList_request_ids, value) {
+func (x *fastReflection_FeeReceiptItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_FeeReceiptItem_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_FeeReceiptItem_data_source_id, value) {
+			return
+		}
+	}
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_FeeReceiptItem_treasury, value) {
+			return
+		}
+	}
+	if len(x.FeePerReport) != 0 {
+		value := protoreflect.ValueOfList(&_FeeReceiptItem_4_list{list: &x.FeePerReport})
+		if !f(fd_FeeReceiptItem_fee_per_report, value) {
+			return
+		}
+	}
+	if x.ReportCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportCount)
+		if !f(fd_FeeReceiptItem_report_count, value) {
+			return
+		}
+	}
+	if len(x.Paid) != 0 {
+		value := protoreflect.ValueOfList(&_FeeReceiptItem_6_list{list: &x.Paid})
+		if !f(fd_FeeReceiptItem_paid, value) {
 			return
 		}
 	}
@@ -10016,15 +10955,25 @@ func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeReceiptItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		return len(x.RequestIds) != 0
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
+		return len(x.FeePerReport) != 0
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		return x.ReportCount != uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		return len(x.Paid) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10034,15 +10983,25 @@ func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeReceiptItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		x.RequestIds = nil
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
+		x.FeePerReport = nil
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		x.ReportCount = uint64(0)
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		x.Paid = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10052,19 +11011,37 @@ func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PendingResolveList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceiptItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		if len(x.RequestIds) == 0 {
-			return protoreflect.ValueOfList(&_PendingResolveList_1_list{})
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
+		if len(x.FeePerReport) == 0 {
+			return protoreflect.ValueOfList(&_FeeReceiptItem_4_list{})
 		}
-		listValue := &_PendingResolveList_1_list{list: &x.RequestIds}
+		listValue := &_FeeReceiptItem_4_list{list: &x.FeePerReport}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		value := x.ReportCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		if len(x.Paid) == 0 {
+			return protoreflect.ValueOfList(&_FeeReceiptItem_6_list{})
+		}
+		listValue := &_FeeReceiptItem_6_list{list: &x.Paid}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10078,17 +11055,29 @@ func (x *fastReflection_PendingResolveList) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeReceiptItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
 		lv := value.List()
-		clv := lv.(*_PendingResolveList_1_list)
-		x.RequestIds = *clv.list
+		clv := lv.(*_FeeReceiptItem_4_list)
+		x.FeePerReport = *clv.list
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		x.ReportCount = value.Uint()
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		lv := value.List()
+		clv := lv.(*_FeeReceiptItem_6_list)
+		x.Paid = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10102,45 +11091,70 @@ func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceiptItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		if x.RequestIds == nil {
-			x.RequestIds = []uint64{}
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
+		if x.FeePerReport == nil {
+			x.FeePerReport = []*v1beta1.Coin{}
 		}
-		value := &_PendingResolveList_1_list{list: &x.RequestIds}
+		value := &_FeeReceiptItem_4_list{list: &x.FeePerReport}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		if x.Paid == nil {
+			x.Paid = []*v1beta1.Coin{}
+		}
+		value := &_FeeReceiptItem_6_list{list: &x.Paid}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.FeeReceiptItem is not mutable"))
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.FeeReceiptItem is not mutable"))
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.FeeReceiptItem is not mutable"))
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		panic(fmt.Errorf("field report_count of message band.oracle.v1.FeeReceiptItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PendingResolveList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeReceiptItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &list})
+	case "band.oracle.v1.FeeReceiptItem.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.FeeReceiptItem.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.FeeReceiptItem.treasury":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.FeeReceiptItem.fee_per_report":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeReceiptItem_4_list{list: &list})
+	case "band.oracle.v1.FeeReceiptItem.report_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.FeeReceiptItem.paid":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeReceiptItem_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeReceiptItem"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeReceiptItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeReceiptItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PendingResolveList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.FeeReceiptItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10148,7 +11162,7 @@ func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeReceiptItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10159,7 +11173,7 @@ func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeReceiptItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10171,7 +11185,7 @@ func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PendingResolveList) IsValid() bool {
+func (x *fastReflection_FeeReceiptItem) IsValid() bool {
 	return x != nil
 }
 
@@ -10181,9 +11195,9 @@ func (x *fastReflection_PendingResolveList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeReceiptItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*FeeReceiptItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10195,12 +11209,30 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.RequestIds) > 0 {
-			l = 0
-			for _, e := range x.RequestIds {
-				l += runtime.Sov(uint64(e))
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeePerReport) > 0 {
+			for _, e := range x.FeePerReport {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ReportCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportCount))
+		}
+		if len(x.Paid) > 0 {
+			for _, e := range x.Paid {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
-			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10212,7 +11244,7 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*FeeReceiptItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10231,25 +11263,59 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RequestIds) > 0 {
-			var pksize2 int
-			for _, num := range x.RequestIds {
-				pksize2 += runtime.Sov(uint64(num))
+		if len(x.Paid) > 0 {
+			for iNdEx := len(x.Paid) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Paid[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
 			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.RequestIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
+		}
+		if x.ReportCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.FeePerReport) > 0 {
+			for iNdEx := len(x.FeePerReport) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeePerReport[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				dAtA[j1] = uint8(num)
-				j1++
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10262,7 +11328,7 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*FeeReceiptItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10294,88 +11360,169 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeReceiptItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeReceiptItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					x.RequestIds = append(x.RequestIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					elementCount = count
-					if elementCount != 0 && len(x.RequestIds) == 0 {
-						x.RequestIds = make([]uint64, 0, elementCount)
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RequestIds = append(x.RequestIds, v)
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePerReport", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePerReport = append(x.FeePerReport, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeePerReport[len(x.FeePerReport)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+				}
+				x.ReportCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Paid = append(x.Paid, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paid[len(x.Paid)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10412,27 +11559,29 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_IBCChannel            protoreflect.MessageDescriptor
-	fd_IBCChannel_port_id    protoreflect.FieldDescriptor
-	fd_IBCChannel_channel_id protoreflect.FieldDescriptor
+	md_SigningResult                 protoreflect.MessageDescriptor
+	fd_SigningResult_signing_id      protoreflect.FieldDescriptor
+	fd_SigningResult_error_codespace protoreflect.FieldDescriptor
+	fd_SigningResult_error_code      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_IBCChannel = File_band_oracle_v1_oracle_proto.Messages().ByName("IBCChannel")
-	fd_IBCChannel_port_id = md_IBCChannel.Fields().ByName("port_id")
-	fd_IBCChannel_channel_id = md_IBCChannel.Fields().ByName("channel_id")
+	md_SigningResult = File_band_oracle_v1_oracle_proto.Messages().ByName("SigningResult")
+	fd_SigningResult_signing_id = md_SigningResult.Fields().ByName("signing_id")
+	fd_SigningResult_error_codespace = md_SigningResult.Fields().ByName("error_codespace")
+	fd_SigningResult_error_code = md_SigningResult.Fields().ByName("error_code")
 }
 
-var _ protoreflect.Message = (*fastReflection_IBCChannel)(nil)
+var _ protoreflect.Message = (*fastReflection_SigningResult)(nil)
 
-type fastReflection_IBCChannel IBCChannel
+type fastReflection_SigningResult SigningResult
 
-func (x *IBCChannel) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IBCChannel)(x)
+func (x *SigningResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningResult)(x)
 }
 
-func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
+func (x *SigningResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10444,43 +11593,43 @@ func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IBCChannel_messageType fastReflection_IBCChannel_messageType
-var _ protoreflect.MessageType = fastReflection_IBCChannel_messageType{}
+var _fastReflection_SigningResult_messageType fastReflection_SigningResult_messageType
+var _ protoreflect.MessageType = fastReflection_SigningResult_messageType{}
 
-type fastReflection_IBCChannel_messageType struct{}
+type fastReflection_SigningResult_messageType struct{}
 
-func (x fastReflection_IBCChannel_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IBCChannel)(nil)
+func (x fastReflection_SigningResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningResult)(nil)
 }
-func (x fastReflection_IBCChannel_messageType) New() protoreflect.Message {
-	return new(fastReflection_IBCChannel)
+func (x fastReflection_SigningResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningResult)
 }
-func (x fastReflection_IBCChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCChannel
+func (x fastReflection_SigningResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IBCChannel) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCChannel
+func (x *fastReflection_SigningResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IBCChannel) Type() protoreflect.MessageType {
-	return _fastReflection_IBCChannel_messageType
+func (x *fastReflection_SigningResult) Type() protoreflect.MessageType {
+	return _fastReflection_SigningResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IBCChannel) New() protoreflect.Message {
-	return new(fastReflection_IBCChannel)
+func (x *fastReflection_SigningResult) New() protoreflect.Message {
+	return new(fastReflection_SigningResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
-	return (*IBCChannel)(x)
+func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
+	return (*SigningResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10488,16 +11637,22 @@ func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PortId != "" {
-		value := protoreflect.ValueOfString(x.PortId)
-		if !f(fd_IBCChannel_port_id, value) {
+func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_SigningResult_signing_id, value) {
 			return
 		}
 	}
-	if x.ChannelId != "" {
-		value := protoreflect.ValueOfString(x.ChannelId)
-		if !f(fd_IBCChannel_channel_id, value) {
+	if x.ErrorCodespace != "" {
+		value := protoreflect.ValueOfString(x.ErrorCodespace)
+		if !f(fd_SigningResult_error_codespace, value) {
+			return
+		}
+	}
+	if x.ErrorCode != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ErrorCode)
+		if !f(fd_SigningResult_error_code, value) {
 			return
 		}
 	}
@@ -10514,17 +11669,19 @@ func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		return x.PortId != ""
-	case "band.oracle.v1.IBCChannel.channel_id":
-		return x.ChannelId != ""
+	case "band.oracle.v1.SigningResult.signing_id":
+		return x.SigningId != uint64(0)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		return x.ErrorCodespace != ""
+	case "band.oracle.v1.SigningResult.error_code":
+		return x.ErrorCode != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10534,17 +11691,19 @@ func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		x.PortId = ""
-	case "band.oracle.v1.IBCChannel.channel_id":
-		x.ChannelId = ""
+	case "band.oracle.v1.SigningResult.signing_id":
+		x.SigningId = uint64(0)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		x.ErrorCodespace = ""
+	case "band.oracle.v1.SigningResult.error_code":
+		x.ErrorCode = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10554,19 +11713,22 @@ func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		value := x.PortId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.IBCChannel.channel_id":
-		value := x.ChannelId
+	case "band.oracle.v1.SigningResult.signing_id":
+		value := x.SigningId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		value := x.ErrorCodespace
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.SigningResult.error_code":
+		value := x.ErrorCode
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10580,17 +11742,19 @@ func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		x.PortId = value.Interface().(string)
-	case "band.oracle.v1.IBCChannel.channel_id":
-		x.ChannelId = value.Interface().(string)
+	case "band.oracle.v1.SigningResult.signing_id":
+		x.SigningId = value.Uint()
+	case "band.oracle.v1.SigningResult.error_codespace":
+		x.ErrorCodespace = value.Interface().(string)
+	case "band.oracle.v1.SigningResult.error_code":
+		x.ErrorCode = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10604,44 +11768,48 @@ func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		panic(fmt.Errorf("field port_id of message band.oracle.v1.IBCChannel is not mutable"))
-	case "band.oracle.v1.IBCChannel.channel_id":
-		panic(fmt.Errorf("field channel_id of message band.oracle.v1.IBCChannel is not mutable"))
+	case "band.oracle.v1.SigningResult.signing_id":
+		panic(fmt.Errorf("field signing_id of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.SigningResult.error_codespace":
+		panic(fmt.Errorf("field error_codespace of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.SigningResult.error_code":
+		panic(fmt.Errorf("field error_code of message band.oracle.v1.SigningResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IBCChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.IBCChannel.channel_id":
+	case "band.oracle.v1.SigningResult.signing_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.SigningResult.error_codespace":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.SigningResult.error_code":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.IBCChannel", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.SigningResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10649,7 +11817,7 @@ func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10660,7 +11828,7 @@ func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10672,7 +11840,7 @@ func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IBCChannel) IsValid() bool {
+func (x *fastReflection_SigningResult) IsValid() bool {
 	return x != nil
 }
 
@@ -10682,9 +11850,9 @@ func (x *fastReflection_IBCChannel) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10696,14 +11864,16 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.PortId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
 		}
-		l = len(x.ChannelId)
+		l = len(x.ErrorCodespace)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ErrorCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10714,7 +11884,7 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10733,19 +11903,22 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ChannelId) > 0 {
-			i -= len(x.ChannelId)
-			copy(dAtA[i:], x.ChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+		if x.ErrorCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ErrorCodespace) > 0 {
+			i -= len(x.ErrorCodespace)
+			copy(dAtA[i:], x.ErrorCodespace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorCodespace)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.PortId) > 0 {
-			i -= len(x.PortId)
-			copy(dAtA[i:], x.PortId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10758,7 +11931,7 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10790,17 +11963,17 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
 				}
-				var stringLen uint64
+				x.SigningId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10810,27 +11983,14 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.SigningId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PortId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10858,8 +12018,27 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				x.ErrorCodespace = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				}
+				x.ErrorCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ErrorCode |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10896,33 +12075,27 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RequestVerification                protoreflect.MessageDescriptor
-	fd_RequestVerification_chain_id       protoreflect.FieldDescriptor
-	fd_RequestVerification_validator      protoreflect.FieldDescriptor
-	fd_RequestVerification_request_id     protoreflect.FieldDescriptor
-	fd_RequestVerification_external_id    protoreflect.FieldDescriptor
-	fd_RequestVerification_data_source_id protoreflect.FieldDescriptor
+	md_ValidatorStatus           protoreflect.MessageDescriptor
+	fd_ValidatorStatus_is_active protoreflect.FieldDescriptor
+	fd_ValidatorStatus_since     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RequestVerification = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestVerification")
-	fd_RequestVerification_chain_id = md_RequestVerification.Fields().ByName("chain_id")
-	fd_RequestVerification_validator = md_RequestVerification.Fields().ByName("validator")
-	fd_RequestVerification_request_id = md_RequestVerification.Fields().ByName("request_id")
-	fd_RequestVerification_external_id = md_RequestVerification.Fields().ByName("external_id")
-	fd_RequestVerification_data_source_id = md_RequestVerification.Fields().ByName("data_source_id")
+	md_ValidatorStatus = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorStatus")
+	fd_ValidatorStatus_is_active = md_ValidatorStatus.Fields().ByName("is_active")
+	fd_ValidatorStatus_since = md_ValidatorStatus.Fields().ByName("since")
 }
 
-var _ protoreflect.Message = (*fastReflection_RequestVerification)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorStatus)(nil)
 
-type fastReflection_RequestVerification RequestVerification
+type fastReflection_ValidatorStatus ValidatorStatus
 
-func (x *RequestVerification) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RequestVerification)(x)
+func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorStatus)(x)
 }
 
-func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10934,43 +12107,43 @@ func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RequestVerification_messageType fastReflection_RequestVerification_messageType
-var _ protoreflect.MessageType = fastReflection_RequestVerification_messageType{}
+var _fastReflection_ValidatorStatus_messageType fastReflection_ValidatorStatus_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorStatus_messageType{}
 
-type fastReflection_RequestVerification_messageType struct{}
+type fastReflection_ValidatorStatus_messageType struct{}
 
-func (x fastReflection_RequestVerification_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RequestVerification)(nil)
+func (x fastReflection_ValidatorStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorStatus)(nil)
 }
-func (x fastReflection_RequestVerification_messageType) New() protoreflect.Message {
-	return new(fastReflection_RequestVerification)
+func (x fastReflection_ValidatorStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorStatus)
 }
-func (x fastReflection_RequestVerification_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestVerification
+func (x fastReflection_ValidatorStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RequestVerification) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestVerification
+func (x *fastReflection_ValidatorStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RequestVerification) Type() protoreflect.MessageType {
-	return _fastReflection_RequestVerification_messageType
+func (x *fastReflection_ValidatorStatus) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RequestVerification) New() protoreflect.Message {
-	return new(fastReflection_RequestVerification)
+func (x *fastReflection_ValidatorStatus) New() protoreflect.Message {
+	return new(fastReflection_ValidatorStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessage {
-	return (*RequestVerification)(x)
+func (x *fastReflection_ValidatorStatus) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10978,34 +12151,16 @@ func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_RequestVerification_chain_id, value) {
-			return
-		}
-	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_RequestVerification_validator, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_RequestVerification_request_id, value) {
-			return
-		}
-	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RequestVerification_external_id, value) {
+func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_ValidatorStatus_is_active, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_RequestVerification_data_source_id, value) {
+	if x.Since != nil {
+		value := protoreflect.ValueOfMessage(x.Since.ProtoReflect())
+		if !f(fd_ValidatorStatus_since, value) {
 			return
 		}
 	}
@@ -11022,23 +12177,17 @@ func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.RequestVerification.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.RequestVerification.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.RequestVerification.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		return x.IsActive != false
+	case "band.oracle.v1.ValidatorStatus.since":
+		return x.Since != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11048,23 +12197,17 @@ func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.RequestVerification.validator":
-		x.Validator = ""
-	case "band.oracle.v1.RequestVerification.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.RequestVerification.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		x.IsActive = false
+	case "band.oracle.v1.ValidatorStatus.since":
+		x.Since = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11074,28 +12217,19 @@ func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.RequestVerification.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.RequestVerification.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RequestVerification.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		value := x.DataSourceId
-		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.ValidatorStatus.since":
+		value := x.Since
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11109,23 +12243,17 @@ func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.RequestVerification.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.RequestVerification.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.RequestVerification.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		x.IsActive = value.Bool()
+	case "band.oracle.v1.ValidatorStatus.since":
+		x.Since = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11139,56 +12267,48 @@ func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.ValidatorStatus.since":
+		if x.Since == nil {
+			x.Since = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Since.ProtoReflect())
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		panic(fmt.Errorf("field is_active of message band.oracle.v1.ValidatorStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RequestVerification) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.RequestVerification.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.RequestVerification.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RequestVerification.external_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.ValidatorStatus.since":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestVerification", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11196,7 +12316,7 @@ func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11207,7 +12327,7 @@ func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11219,7 +12339,7 @@ func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RequestVerification) IsValid() bool {
+func (x *fastReflection_ValidatorStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -11229,9 +12349,9 @@ func (x *fastReflection_RequestVerification) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11243,23 +12363,13 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.IsActive {
+			n += 2
 		}
-		l = len(x.Validator)
-		if l > 0 {
+		if x.Since != nil {
+			l = options.Size(x.Since)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11270,7 +12380,7 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11289,34 +12399,29 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.Since != nil {
+			encoded, err := options.Marshal(x.Since)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+		if x.IsActive {
 			i--
-			dAtA[i] = 0xa
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11329,7 +12434,7 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11361,17 +12466,17 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11381,29 +12486,17 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.IsActive = bool(v != 0)
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11413,81 +12506,28 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				if x.Since == nil {
+					x.Since = &timestamppb.Timestamp{}
 				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-				}
-				x.ExternalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-				}
-				x.DataSourceId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Since); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11524,33 +12564,27 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_PriceResult              protoreflect.MessageDescriptor
-	fd_PriceResult_symbol       protoreflect.FieldDescriptor
-	fd_PriceResult_multiplier   protoreflect.FieldDescriptor
-	fd_PriceResult_px           protoreflect.FieldDescriptor
-	fd_PriceResult_request_id   protoreflect.FieldDescriptor
-	fd_PriceResult_resolve_time protoreflect.FieldDescriptor
+	md_ActiveValidator         protoreflect.MessageDescriptor
+	fd_ActiveValidator_address protoreflect.FieldDescriptor
+	fd_ActiveValidator_power   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_PriceResult = File_band_oracle_v1_oracle_proto.Messages().ByName("PriceResult")
-	fd_PriceResult_symbol = md_PriceResult.Fields().ByName("symbol")
-	fd_PriceResult_multiplier = md_PriceResult.Fields().ByName("multiplier")
-	fd_PriceResult_px = md_PriceResult.Fields().ByName("px")
-	fd_PriceResult_request_id = md_PriceResult.Fields().ByName("request_id")
-	fd_PriceResult_resolve_time = md_PriceResult.Fields().ByName("resolve_time")
+	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
+	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
+	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_PriceResult)(nil)
+var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
 
-type fastReflection_PriceResult PriceResult
+type fastReflection_ActiveValidator ActiveValidator
 
-func (x *PriceResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PriceResult)(x)
+func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(x)
 }
 
-func (x *PriceResult) slowProtoReflect() protoreflect.Message {
+func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11562,43 +12596,43 @@ func (x *PriceResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PriceResult_messageType fastReflection_PriceResult_messageType
-var _ protoreflect.MessageType = fastReflection_PriceResult_messageType{}
+var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
+var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
 
-type fastReflection_PriceResult_messageType struct{}
+type fastReflection_ActiveValidator_messageType struct{}
 
-func (x fastReflection_PriceResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PriceResult)(nil)
+func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(nil)
 }
-func (x fastReflection_PriceResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_PriceResult)
+func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
-func (x fastReflection_PriceResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceResult
+func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PriceResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceResult
+func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PriceResult) Type() protoreflect.MessageType {
-	return _fastReflection_PriceResult_messageType
+func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
+	return _fastReflection_ActiveValidator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PriceResult) New() protoreflect.Message {
-	return new(fastReflection_PriceResult)
+func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PriceResult) Interface() protoreflect.ProtoMessage {
-	return (*PriceResult)(x)
+func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
+	return (*ActiveValidator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11606,34 +12640,16 @@ func (x *fastReflection_PriceResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PriceResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Symbol != "" {
-		value := protoreflect.ValueOfString(x.Symbol)
-		if !f(fd_PriceResult_symbol, value) {
-			return
-		}
-	}
-	if x.Multiplier != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Multiplier)
-		if !f(fd_PriceResult_multiplier, value) {
-			return
-		}
-	}
-	if x.Px != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Px)
-		if !f(fd_PriceResult_px, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_PriceResult_request_id, value) {
+func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ActiveValidator_address, value) {
 			return
 		}
 	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_PriceResult_resolve_time, value) {
+	if x.Power != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Power)
+		if !f(fd_ActiveValidator_power, value) {
 			return
 		}
 	}
@@ -11650,23 +12666,17 @@ func (x *fastReflection_PriceResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PriceResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		return x.Symbol != ""
-	case "band.oracle.v1.PriceResult.multiplier":
-		return x.Multiplier != uint64(0)
-	case "band.oracle.v1.PriceResult.px":
-		return x.Px != uint64(0)
-	case "band.oracle.v1.PriceResult.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.ActiveValidator.address":
+		return x.Address != ""
+	case "band.oracle.v1.ActiveValidator.power":
+		return x.Power != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11676,23 +12686,17 @@ func (x *fastReflection_PriceResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		x.Symbol = ""
-	case "band.oracle.v1.PriceResult.multiplier":
-		x.Multiplier = uint64(0)
-	case "band.oracle.v1.PriceResult.px":
-		x.Px = uint64(0)
-	case "band.oracle.v1.PriceResult.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		x.ResolveTime = int64(0)
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = ""
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11702,28 +12706,19 @@ func (x *fastReflection_PriceResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PriceResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		value := x.Symbol
+	case "band.oracle.v1.ActiveValidator.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.PriceResult.multiplier":
-		value := x.Multiplier
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.px":
-		value := x.Px
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.ActiveValidator.power":
+		value := x.Power
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11737,23 +12732,17 @@ func (x *fastReflection_PriceResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		x.Symbol = value.Interface().(string)
-	case "band.oracle.v1.PriceResult.multiplier":
-		x.Multiplier = value.Uint()
-	case "band.oracle.v1.PriceResult.px":
-		x.Px = value.Uint()
-	case "band.oracle.v1.PriceResult.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.PriceResult.resolve_time":
-		x.ResolveTime = value.Int()
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = value.Interface().(string)
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11767,56 +12756,44 @@ func (x *fastReflection_PriceResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		panic(fmt.Errorf("field symbol of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.multiplier":
-		panic(fmt.Errorf("field multiplier of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.px":
-		panic(fmt.Errorf("field px of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.ActiveValidator.address":
+		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.ActiveValidator.power":
+		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PriceResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
+	case "band.oracle.v1.ActiveValidator.address":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.PriceResult.multiplier":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.px":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.request_id":
+	case "band.oracle.v1.ActiveValidator.power":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PriceResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PriceResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11824,7 +12801,7 @@ func (x *fastReflection_PriceResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PriceResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11835,7 +12812,7 @@ func (x *fastReflection_PriceResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11847,7 +12824,7 @@ func (x *fastReflection_PriceResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PriceResult) IsValid() bool {
+func (x *fastReflection_ActiveValidator) IsValid() bool {
 	return x != nil
 }
 
@@ -11857,9 +12834,9 @@ func (x *fastReflection_PriceResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PriceResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11871,21 +12848,12 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Symbol)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Multiplier != 0 {
-			n += 1 + runtime.Sov(uint64(x.Multiplier))
-		}
-		if x.Px != 0 {
-			n += 1 + runtime.Sov(uint64(x.Px))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11897,7 +12865,7 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PriceResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11916,30 +12884,15 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Px != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Px))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Multiplier != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Multiplier))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Symbol) > 0 {
-			i -= len(x.Symbol)
-			copy(dAtA[i:], x.Symbol)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11954,7 +12907,7 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PriceResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11986,15 +12939,15 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12022,70 +12975,13 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Symbol = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
-				}
-				x.Multiplier = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Multiplier |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
-				}
-				x.Px = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Px |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.ResolveTime = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12095,7 +12991,7 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
+					x.Power |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -12135,28 +13031,109 @@ func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_OracleResultSignatureOrder            protoreflect.MessageDescriptor
-	fd_OracleResultSignatureOrder_request_id protoreflect.FieldDescriptor
-	fd_OracleResultSignatureOrder_encoder    protoreflect.FieldDescriptor
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_max_raw_request_count           protoreflect.FieldDescriptor
+	fd_Params_max_ask_count                   protoreflect.FieldDescriptor
+	fd_Params_max_calldata_size               protoreflect.FieldDescriptor
+	fd_Params_max_report_data_size            protoreflect.FieldDescriptor
+	fd_Params_expiration_block_count          protoreflect.FieldDescriptor
+	fd_Params_base_owasm_gas                  protoreflect.FieldDescriptor
+	fd_Params_per_validator_request_gas       protoreflect.FieldDescriptor
+	fd_Params_sampling_try_count              protoreflect.FieldDescriptor
+	fd_Params_oracle_reward_percentage        protoreflect.FieldDescriptor
+	fd_Params_inactive_penalty_duration       protoreflect.FieldDescriptor
+	fd_Params_ibc_request_enabled             protoreflect.FieldDescriptor
+	fd_Params_latest_result_retention         protoreflect.FieldDescriptor
+	fd_Params_max_subscription_runs_per_block protoreflect.FieldDescriptor
+	fd_Params_result_retention_period         protoreflect.FieldDescriptor
+	fd_Params_result_retention_count          protoreflect.FieldDescriptor
+	fd_Params_max_result_prunes_per_block     protoreflect.FieldDescriptor
+	fd_Params_subscription_gas_prices         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleResultSignatureOrder = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResultSignatureOrder")
-	fd_OracleResultSignatureOrder_request_id = md_OracleResultSignatureOrder.Fields().ByName("request_id")
-	fd_OracleResultSignatureOrder_encoder = md_OracleResultSignatureOrder.Fields().ByName("encoder")
+	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
+	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
+	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
+	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
+	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
+	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
+	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
+	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
+	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
+	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
+	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
+	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_latest_result_retention = md_Params.Fields().ByName("latest_result_retention")
+	fd_Params_max_subscription_runs_per_block = md_Params.Fields().ByName("max_subscription_runs_per_block")
+	fd_Params_result_retention_period = md_Params.Fields().ByName("result_retention_period")
+	fd_Params_result_retention_count = md_Params.Fields().ByName("result_retention_count")
+	fd_Params_max_result_prunes_per_block = md_Params.Fields().ByName("max_result_prunes_per_block")
+	fd_Params_subscription_gas_prices = md_Params.Fields().ByName("subscription_gas_prices")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleResultSignatureOrder)(nil)
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
 
-type fastReflection_OracleResultSignatureOrder OracleResultSignatureOrder
+type fastReflection_Params Params
 
-func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleResultSignatureOrder)(x)
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
 }
 
-func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
+func (x *Params) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -12168,43 +13145,43 @@ func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleResultSignatureOrder_messageType fastReflection_OracleResultSignatureOrder_messageType
-var _ protoreflect.MessageType = fastReflection_OracleResultSignatureOrder_messageType{}
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
 
-type fastReflection_OracleResultSignatureOrder_messageType struct{}
+type fastReflection_Params_messageType struct{}
 
-func (x fastReflection_OracleResultSignatureOrder_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleResultSignatureOrder)(nil)
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
 }
-func (x fastReflection_OracleResultSignatureOrder_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleResultSignatureOrder)
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
-func (x fastReflection_OracleResultSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResultSignatureOrder
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleResultSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResultSignatureOrder
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleResultSignatureOrder) Type() protoreflect.MessageType {
-	return _fastReflection_OracleResultSignatureOrder_messageType
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleResultSignatureOrder) New() protoreflect.Message {
-	return new(fastReflection_OracleResultSignatureOrder)
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleResultSignatureOrder) Interface() protoreflect.ProtoMessage {
-	return (*OracleResultSignatureOrder)(x)
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12212,43 +13189,163 @@ func (x *fastReflection_OracleResultSignatureOrder) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleResultSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleResultSignatureOrder_request_id, value) {
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRawRequestCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
+		if !f(fd_Params_max_raw_request_count, value) {
 			return
 		}
 	}
-	if x.Encoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
-		if !f(fd_OracleResultSignatureOrder_encoder, value) {
+	if x.MaxAskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAskCount)
+		if !f(fd_Params_max_ask_count, value) {
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleResultSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		return x.Encoder != 0
+	if x.MaxCalldataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
+		if !f(fd_Params_max_calldata_size, value) {
+			return
+		}
+	}
+	if x.MaxReportDataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
+		if !f(fd_Params_max_report_data_size, value) {
+			return
+		}
+	}
+	if x.ExpirationBlockCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
+		if !f(fd_Params_expiration_block_count, value) {
+			return
+		}
+	}
+	if x.BaseOwasmGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
+		if !f(fd_Params_base_owasm_gas, value) {
+			return
+		}
+	}
+	if x.PerValidatorRequestGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
+		if !f(fd_Params_per_validator_request_gas, value) {
+			return
+		}
+	}
+	if x.SamplingTryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
+		if !f(fd_Params_sampling_try_count, value) {
+			return
+		}
+	}
+	if x.OracleRewardPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
+		if !f(fd_Params_oracle_reward_percentage, value) {
+			return
+		}
+	}
+	if x.InactivePenaltyDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
+		if !f(fd_Params_inactive_penalty_duration, value) {
+			return
+		}
+	}
+	if x.IbcRequestEnabled != false {
+		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
+		if !f(fd_Params_ibc_request_enabled, value) {
+			return
+		}
+	}
+	if x.LatestResultRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LatestResultRetention)
+		if !f(fd_Params_latest_result_retention, value) {
+			return
+		}
+	}
+	if x.MaxSubscriptionRunsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSubscriptionRunsPerBlock)
+		if !f(fd_Params_max_subscription_runs_per_block, value) {
+			return
+		}
+	}
+	if x.ResultRetentionPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResultRetentionPeriod)
+		if !f(fd_Params_result_retention_period, value) {
+			return
+		}
+	}
+	if x.ResultRetentionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResultRetentionCount)
+		if !f(fd_Params_result_retention_count, value) {
+			return
+		}
+	}
+	if x.MaxResultPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxResultPrunesPerBlock)
+		if !f(fd_Params_max_result_prunes_per_block, value) {
+			return
+		}
+	}
+	if len(x.SubscriptionGasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.SubscriptionGasPrices})
+		if !f(fd_Params_subscription_gas_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return x.MaxRawRequestCount != uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		return x.MaxAskCount != uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		return x.MaxCalldataSize != uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		return x.MaxReportDataSize != uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		return x.ExpirationBlockCount != uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return x.BaseOwasmGas != uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return x.PerValidatorRequestGas != uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		return x.SamplingTryCount != uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return x.OracleRewardPercentage != uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return x.InactivePenaltyDuration != uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.latest_result_retention":
+		return x.LatestResultRetention != uint64(0)
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		return x.MaxSubscriptionRunsPerBlock != uint64(0)
+	case "band.oracle.v1.Params.result_retention_period":
+		return x.ResultRetentionPeriod != uint64(0)
+	case "band.oracle.v1.Params.result_retention_count":
+		return x.ResultRetentionCount != uint64(0)
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		return x.MaxResultPrunesPerBlock != uint64(0)
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		return len(x.SubscriptionGasPrices) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12258,17 +13355,47 @@ func (x *fastReflection_OracleResultSignatureOrder) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		x.Encoder = 0
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.latest_result_retention":
+		x.LatestResultRetention = uint64(0)
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		x.MaxSubscriptionRunsPerBlock = uint64(0)
+	case "band.oracle.v1.Params.result_retention_period":
+		x.ResultRetentionPeriod = uint64(0)
+	case "band.oracle.v1.Params.result_retention_count":
+		x.ResultRetentionCount = uint64(0)
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		x.MaxResultPrunesPerBlock = uint64(0)
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		x.SubscriptionGasPrices = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12278,19 +13405,67 @@ func (x *fastReflection_OracleResultSignatureOrder) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleResultSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.Params.max_raw_request_count":
+		value := x.MaxRawRequestCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		value := x.Encoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Params.max_ask_count":
+		value := x.MaxAskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_calldata_size":
+		value := x.MaxCalldataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_report_data_size":
+		value := x.MaxReportDataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.expiration_block_count":
+		value := x.ExpirationBlockCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		value := x.BaseOwasmGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		value := x.PerValidatorRequestGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.sampling_try_count":
+		value := x.SamplingTryCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		value := x.OracleRewardPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		value := x.InactivePenaltyDuration
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		value := x.IbcRequestEnabled
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.latest_result_retention":
+		value := x.LatestResultRetention
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		value := x.MaxSubscriptionRunsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.result_retention_period":
+		value := x.ResultRetentionPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.result_retention_count":
+		value := x.ResultRetentionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		value := x.MaxResultPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		if len(x.SubscriptionGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.SubscriptionGasPrices}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12304,17 +13479,49 @@ func (x *fastReflection_OracleResultSignatureOrder) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		x.Encoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = value.Uint()
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = value.Uint()
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = value.Uint()
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = value.Uint()
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = value.Uint()
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = value.Uint()
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = value.Uint()
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = value.Uint()
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = value.Uint()
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = value.Uint()
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.latest_result_retention":
+		x.LatestResultRetention = value.Uint()
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		x.MaxSubscriptionRunsPerBlock = value.Uint()
+	case "band.oracle.v1.Params.result_retention_period":
+		x.ResultRetentionPeriod = value.Uint()
+	case "band.oracle.v1.Params.result_retention_count":
+		x.ResultRetentionCount = value.Uint()
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		x.MaxResultPrunesPerBlock = value.Uint()
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.SubscriptionGasPrices = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12328,44 +13535,109 @@ func (x *fastReflection_OracleResultSignatureOrder) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		panic(fmt.Errorf("field encoder of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleResultSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		return protoreflect.ValueOfEnum(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		if x.SubscriptionGasPrices == nil {
+			x.SubscriptionGasPrices = []*v1beta1.DecCoin{}
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
-	}
+		value := &_Params_17_list{list: &x.SubscriptionGasPrices}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Params.max_raw_request_count":
+		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_ask_count":
+		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_calldata_size":
+		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_report_data_size":
+		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.expiration_block_count":
+		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.sampling_try_count":
+		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.latest_result_retention":
+		panic(fmt.Errorf("field latest_result_retention of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		panic(fmt.Errorf("field max_subscription_runs_per_block of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.result_retention_period":
+		panic(fmt.Errorf("field result_retention_period of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.result_retention_count":
+		panic(fmt.Errorf("field result_retention_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		panic(fmt.Errorf("field max_result_prunes_per_block of message band.oracle.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_calldata_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_report_data_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.expiration_block_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.sampling_try_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.latest_result_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_subscription_runs_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.result_retention_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.result_retention_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_result_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.subscription_gas_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleResultSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResultSignatureOrder", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12373,7 +13645,7 @@ func (x *fastReflection_OracleResultSignatureOrder) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleResultSignatureOrder) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12384,7 +13656,7 @@ func (x *fastReflection_OracleResultSignatureOrder) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12396,7 +13668,7 @@ func (x *fastReflection_OracleResultSignatureOrder) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleResultSignatureOrder) IsValid() bool {
+func (x *fastReflection_Params) IsValid() bool {
 	return x != nil
 }
 
@@ -12406,9 +13678,9 @@ func (x *fastReflection_OracleResultSignatureOrder) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12420,11 +13692,59 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
+		if x.MaxRawRequestCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
 		}
-		if x.Encoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.Encoder))
+		if x.MaxAskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
+		}
+		if x.MaxCalldataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
+		}
+		if x.MaxReportDataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
+		}
+		if x.ExpirationBlockCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
+		}
+		if x.BaseOwasmGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
+		}
+		if x.PerValidatorRequestGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
+		}
+		if x.SamplingTryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
+		}
+		if x.OracleRewardPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
+		}
+		if x.InactivePenaltyDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		}
+		if x.IbcRequestEnabled {
+			n += 2
+		}
+		if x.LatestResultRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.LatestResultRetention))
+		}
+		if x.MaxSubscriptionRunsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSubscriptionRunsPerBlock))
+		}
+		if x.ResultRetentionPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResultRetentionPeriod))
+		}
+		if x.ResultRetentionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResultRetentionCount))
+		}
+		if x.MaxResultPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxResultPrunesPerBlock))
+		}
+		if len(x.SubscriptionGasPrices) > 0 {
+			for _, e := range x.SubscriptionGasPrices {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -12436,7 +13756,7 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12455,13 +13775,108 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Encoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+		if len(x.SubscriptionGasPrices) > 0 {
+			for iNdEx := len(x.SubscriptionGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubscriptionGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.MaxResultPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxResultPrunesPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.ResultRetentionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResultRetentionCount))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ResultRetentionPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResultRetentionPeriod))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxSubscriptionRunsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSubscriptionRunsPerBlock))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.LatestResultRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LatestResultRetention))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IbcRequestEnabled {
+			i--
+			if x.IbcRequestEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.InactivePenaltyDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.OracleRewardPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SamplingTryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PerValidatorRequestGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BaseOwasmGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpirationBlockCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxReportDataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxCalldataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxAskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if x.MaxRawRequestCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -12476,7 +13891,7 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12495,30 +13910,4418 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+				}
+				x.MaxRawRequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+				}
+				x.MaxAskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				}
+				x.MaxCalldataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCalldataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+				}
+				x.MaxReportDataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxReportDataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+				}
+				x.ExpirationBlockCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+				}
+				x.BaseOwasmGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseOwasmGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+				}
+				x.PerValidatorRequestGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				}
+				x.SamplingTryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SamplingTryCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				}
+				x.OracleRewardPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				}
+				x.InactivePenaltyDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestResultRetention", wireType)
+				}
+				x.LatestResultRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LatestResultRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionRunsPerBlock", wireType)
+				}
+				x.MaxSubscriptionRunsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSubscriptionRunsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionPeriod", wireType)
+				}
+				x.ResultRetentionPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResultRetentionPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionCount", wireType)
+				}
+				x.ResultRetentionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResultRetentionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxResultPrunesPerBlock", wireType)
+				}
+				x.MaxResultPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxResultPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubscriptionGasPrices = append(x.SubscriptionGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubscriptionGasPrices[len(x.SubscriptionGasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingResolveList_1_list)(nil)
+
+type _PendingResolveList_1_list struct {
+	list *[]uint64
+}
+
+func (x *_PendingResolveList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingResolveList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_PendingResolveList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingResolveList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingResolveList_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PendingResolveList at list field RequestIds as it is not of Message kind"))
+}
+
+func (x *_PendingResolveList_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingResolveList_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_PendingResolveList_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingResolveList             protoreflect.MessageDescriptor
+	fd_PendingResolveList_request_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_PendingResolveList = File_band_oracle_v1_oracle_proto.Messages().ByName("PendingResolveList")
+	fd_PendingResolveList_request_ids = md_PendingResolveList.Fields().ByName("request_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingResolveList)(nil)
+
+type fastReflection_PendingResolveList PendingResolveList
+
+func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingResolveList)(x)
+}
+
+func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingResolveList_messageType fastReflection_PendingResolveList_messageType
+var _ protoreflect.MessageType = fastReflection_PendingResolveList_messageType{}
+
+type fastReflection_PendingResolveList_messageType struct{}
+
+func (x fastReflection_PendingResolveList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingResolveList)(nil)
+}
+func (x fastReflection_PendingResolveList_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingResolveList)
+}
+func (x fastReflection_PendingResolveList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingResolveList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingResolveList) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingResolveList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingResolveList) Type() protoreflect.MessageType {
+	return _fastReflection_PendingResolveList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingResolveList) New() protoreflect.Message {
+	return new(fastReflection_PendingResolveList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessage {
+	return (*PendingResolveList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequestIds) != 0 {
+		value := protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &x.RequestIds})
+		if !f(fd_PendingResolveList_request_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		return len(x.RequestIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		x.RequestIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingResolveList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		if len(x.RequestIds) == 0 {
+			return protoreflect.ValueOfList(&_PendingResolveList_1_list{})
+		}
+		listValue := &_PendingResolveList_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		lv := value.List()
+		clv := lv.(*_PendingResolveList_1_list)
+		x.RequestIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		if x.RequestIds == nil {
+			x.RequestIds = []uint64{}
+		}
+		value := &_PendingResolveList_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingResolveList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PendingResolveList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingResolveList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.RequestIds) > 0 {
+			l = 0
+			for _, e := range x.RequestIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RequestIds) > 0 {
+			var pksize2 int
+			for _, num := range x.RequestIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.RequestIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RequestIds = append(x.RequestIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.RequestIds) == 0 {
+						x.RequestIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RequestIds = append(x.RequestIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IBCChannel            protoreflect.MessageDescriptor
+	fd_IBCChannel_port_id    protoreflect.FieldDescriptor
+	fd_IBCChannel_channel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_IBCChannel = File_band_oracle_v1_oracle_proto.Messages().ByName("IBCChannel")
+	fd_IBCChannel_port_id = md_IBCChannel.Fields().ByName("port_id")
+	fd_IBCChannel_channel_id = md_IBCChannel.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_IBCChannel)(nil)
+
+type fastReflection_IBCChannel IBCChannel
+
+func (x *IBCChannel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCChannel)(x)
+}
+
+func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IBCChannel_messageType fastReflection_IBCChannel_messageType
+var _ protoreflect.MessageType = fastReflection_IBCChannel_messageType{}
+
+type fastReflection_IBCChannel_messageType struct{}
+
+func (x fastReflection_IBCChannel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCChannel)(nil)
+}
+func (x fastReflection_IBCChannel_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCChannel)
+}
+func (x fastReflection_IBCChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCChannel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCChannel) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCChannel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCChannel) Type() protoreflect.MessageType {
+	return _fastReflection_IBCChannel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCChannel) New() protoreflect.Message {
+	return new(fastReflection_IBCChannel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
+	return (*IBCChannel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_IBCChannel_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IBCChannel_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		return x.PortId != ""
+	case "band.oracle.v1.IBCChannel.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		x.PortId = ""
+	case "band.oracle.v1.IBCChannel.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.IBCChannel.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		x.PortId = value.Interface().(string)
+	case "band.oracle.v1.IBCChannel.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		panic(fmt.Errorf("field port_id of message band.oracle.v1.IBCChannel is not mutable"))
+	case "band.oracle.v1.IBCChannel.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.oracle.v1.IBCChannel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.IBCChannel.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.IBCChannel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCChannel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RequestVerification                protoreflect.MessageDescriptor
+	fd_RequestVerification_chain_id       protoreflect.FieldDescriptor
+	fd_RequestVerification_validator      protoreflect.FieldDescriptor
+	fd_RequestVerification_request_id     protoreflect.FieldDescriptor
+	fd_RequestVerification_external_id    protoreflect.FieldDescriptor
+	fd_RequestVerification_data_source_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RequestVerification = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestVerification")
+	fd_RequestVerification_chain_id = md_RequestVerification.Fields().ByName("chain_id")
+	fd_RequestVerification_validator = md_RequestVerification.Fields().ByName("validator")
+	fd_RequestVerification_request_id = md_RequestVerification.Fields().ByName("request_id")
+	fd_RequestVerification_external_id = md_RequestVerification.Fields().ByName("external_id")
+	fd_RequestVerification_data_source_id = md_RequestVerification.Fields().ByName("data_source_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestVerification)(nil)
+
+type fastReflection_RequestVerification RequestVerification
+
+func (x *RequestVerification) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestVerification)(x)
+}
+
+func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestVerification_messageType fastReflection_RequestVerification_messageType
+var _ protoreflect.MessageType = fastReflection_RequestVerification_messageType{}
+
+type fastReflection_RequestVerification_messageType struct{}
+
+func (x fastReflection_RequestVerification_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestVerification)(nil)
+}
+func (x fastReflection_RequestVerification_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestVerification)
+}
+func (x fastReflection_RequestVerification_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVerification
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestVerification) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVerification
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestVerification) Type() protoreflect.MessageType {
+	return _fastReflection_RequestVerification_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestVerification) New() protoreflect.Message {
+	return new(fastReflection_RequestVerification)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessage {
+	return (*RequestVerification)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_RequestVerification_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_RequestVerification_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_RequestVerification_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RequestVerification_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RequestVerification_data_source_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.RequestVerification.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.RequestVerification.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.RequestVerification.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		return x.DataSourceId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.RequestVerification.validator":
+		x.Validator = ""
+	case "band.oracle.v1.RequestVerification.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.RequestVerification.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		x.DataSourceId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestVerification.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestVerification.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestVerification.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.RequestVerification.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.RequestVerification.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.RequestVerification.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		x.DataSourceId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RequestVerification is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestVerification) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestVerification.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestVerification.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestVerification.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestVerification", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestVerification) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceResult              protoreflect.MessageDescriptor
+	fd_PriceResult_symbol       protoreflect.FieldDescriptor
+	fd_PriceResult_multiplier   protoreflect.FieldDescriptor
+	fd_PriceResult_px           protoreflect.FieldDescriptor
+	fd_PriceResult_request_id   protoreflect.FieldDescriptor
+	fd_PriceResult_resolve_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_PriceResult = File_band_oracle_v1_oracle_proto.Messages().ByName("PriceResult")
+	fd_PriceResult_symbol = md_PriceResult.Fields().ByName("symbol")
+	fd_PriceResult_multiplier = md_PriceResult.Fields().ByName("multiplier")
+	fd_PriceResult_px = md_PriceResult.Fields().ByName("px")
+	fd_PriceResult_request_id = md_PriceResult.Fields().ByName("request_id")
+	fd_PriceResult_resolve_time = md_PriceResult.Fields().ByName("resolve_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceResult)(nil)
+
+type fastReflection_PriceResult PriceResult
+
+func (x *PriceResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceResult)(x)
+}
+
+func (x *PriceResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceResult_messageType fastReflection_PriceResult_messageType
+var _ protoreflect.MessageType = fastReflection_PriceResult_messageType{}
+
+type fastReflection_PriceResult_messageType struct{}
+
+func (x fastReflection_PriceResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceResult)(nil)
+}
+func (x fastReflection_PriceResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceResult)
+}
+func (x fastReflection_PriceResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceResult) Type() protoreflect.MessageType {
+	return _fastReflection_PriceResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceResult) New() protoreflect.Message {
+	return new(fastReflection_PriceResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceResult) Interface() protoreflect.ProtoMessage {
+	return (*PriceResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_PriceResult_symbol, value) {
+			return
+		}
+	}
+	if x.Multiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Multiplier)
+		if !f(fd_PriceResult_multiplier, value) {
+			return
+		}
+	}
+	if x.Px != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Px)
+		if !f(fd_PriceResult_px, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_PriceResult_request_id, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_PriceResult_resolve_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		return x.Symbol != ""
+	case "band.oracle.v1.PriceResult.multiplier":
+		return x.Multiplier != uint64(0)
+	case "band.oracle.v1.PriceResult.px":
+		return x.Px != uint64(0)
+	case "band.oracle.v1.PriceResult.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		return x.ResolveTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		x.Symbol = ""
+	case "band.oracle.v1.PriceResult.multiplier":
+		x.Multiplier = uint64(0)
+	case "band.oracle.v1.PriceResult.px":
+		x.Px = uint64(0)
+	case "band.oracle.v1.PriceResult.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		x.ResolveTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.PriceResult.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.px":
+		value := x.Px
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		x.Symbol = value.Interface().(string)
+	case "band.oracle.v1.PriceResult.multiplier":
+		x.Multiplier = value.Uint()
+	case "band.oracle.v1.PriceResult.px":
+		x.Px = value.Uint()
+	case "band.oracle.v1.PriceResult.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.PriceResult.resolve_time":
+		x.ResolveTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		panic(fmt.Errorf("field symbol of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.multiplier":
+		panic(fmt.Errorf("field multiplier of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.px":
+		panic(fmt.Errorf("field px of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.PriceResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.PriceResult.multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.px":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PriceResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Multiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Multiplier))
+		}
+		if x.Px != 0 {
+			n += 1 + runtime.Sov(uint64(x.Px))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Px != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Px))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Multiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Multiplier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				x.Multiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Multiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
+				}
+				x.Px = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Px |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleResultSignatureOrder            protoreflect.MessageDescriptor
+	fd_OracleResultSignatureOrder_request_id protoreflect.FieldDescriptor
+	fd_OracleResultSignatureOrder_encoder    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleResultSignatureOrder = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResultSignatureOrder")
+	fd_OracleResultSignatureOrder_request_id = md_OracleResultSignatureOrder.Fields().ByName("request_id")
+	fd_OracleResultSignatureOrder_encoder = md_OracleResultSignatureOrder.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleResultSignatureOrder)(nil)
+
+type fastReflection_OracleResultSignatureOrder OracleResultSignatureOrder
+
+func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleResultSignatureOrder)(x)
+}
+
+func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleResultSignatureOrder_messageType fastReflection_OracleResultSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_OracleResultSignatureOrder_messageType{}
+
+type fastReflection_OracleResultSignatureOrder_messageType struct{}
+
+func (x fastReflection_OracleResultSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleResultSignatureOrder)(nil)
+}
+func (x fastReflection_OracleResultSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleResultSignatureOrder)
+}
+func (x fastReflection_OracleResultSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResultSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleResultSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResultSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleResultSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_OracleResultSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleResultSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_OracleResultSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleResultSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*OracleResultSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleResultSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleResultSignatureOrder_request_id, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_OracleResultSignatureOrder_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleResultSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleResultSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		x.Encoder = (Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		panic(fmt.Errorf("field encoder of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleResultSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleResultSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResultSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleResultSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleResultSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Subscription_9_list)(nil)
+
+type _Subscription_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Subscription_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Subscription_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Subscription_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Subscription_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Subscription_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Subscription_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Subscription_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Subscription_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Subscription                       protoreflect.MessageDescriptor
+	fd_Subscription_id                    protoreflect.FieldDescriptor
+	fd_Subscription_owner                 protoreflect.FieldDescriptor
+	fd_Subscription_deposit_account       protoreflect.FieldDescriptor
+	fd_Subscription_oracle_script_id      protoreflect.FieldDescriptor
+	fd_Subscription_calldata              protoreflect.FieldDescriptor
+	fd_Subscription_ask_count             protoreflect.FieldDescriptor
+	fd_Subscription_min_count             protoreflect.FieldDescriptor
+	fd_Subscription_client_id             protoreflect.FieldDescriptor
+	fd_Subscription_fee_limit             protoreflect.FieldDescriptor
+	fd_Subscription_prepare_gas           protoreflect.FieldDescriptor
+	fd_Subscription_execute_gas           protoreflect.FieldDescriptor
+	fd_Subscription_tss_encoder           protoreflect.FieldDescriptor
+	fd_Subscription_interval              protoreflect.FieldDescriptor
+	fd_Subscription_interval_unit         protoreflect.FieldDescriptor
+	fd_Subscription_max_runs              protoreflect.FieldDescriptor
+	fd_Subscription_runs                  protoreflect.FieldDescriptor
+	fd_Subscription_next_run              protoreflect.FieldDescriptor
+	fd_Subscription_status                protoreflect.FieldDescriptor
+	fd_Subscription_oracle_script_version protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Subscription = File_band_oracle_v1_oracle_proto.Messages().ByName("Subscription")
+	fd_Subscription_id = md_Subscription.Fields().ByName("id")
+	fd_Subscription_owner = md_Subscription.Fields().ByName("owner")
+	fd_Subscription_deposit_account = md_Subscription.Fields().ByName("deposit_account")
+	fd_Subscription_oracle_script_id = md_Subscription.Fields().ByName("oracle_script_id")
+	fd_Subscription_calldata = md_Subscription.Fields().ByName("calldata")
+	fd_Subscription_ask_count = md_Subscription.Fields().ByName("ask_count")
+	fd_Subscription_min_count = md_Subscription.Fields().ByName("min_count")
+	fd_Subscription_client_id = md_Subscription.Fields().ByName("client_id")
+	fd_Subscription_fee_limit = md_Subscription.Fields().ByName("fee_limit")
+	fd_Subscription_prepare_gas = md_Subscription.Fields().ByName("prepare_gas")
+	fd_Subscription_execute_gas = md_Subscription.Fields().ByName("execute_gas")
+	fd_Subscription_tss_encoder = md_Subscription.Fields().ByName("tss_encoder")
+	fd_Subscription_interval = md_Subscription.Fields().ByName("interval")
+	fd_Subscription_interval_unit = md_Subscription.Fields().ByName("interval_unit")
+	fd_Subscription_max_runs = md_Subscription.Fields().ByName("max_runs")
+	fd_Subscription_runs = md_Subscription.Fields().ByName("runs")
+	fd_Subscription_next_run = md_Subscription.Fields().ByName("next_run")
+	fd_Subscription_status = md_Subscription.Fields().ByName("status")
+	fd_Subscription_oracle_script_version = md_Subscription.Fields().ByName("oracle_script_version")
+}
+
+var _ protoreflect.Message = (*fastReflection_Subscription)(nil)
+
+type fastReflection_Subscription Subscription
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Subscription)(x)
+}
+
+func (x *Subscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Subscription_messageType fastReflection_Subscription_messageType
+var _ protoreflect.MessageType = fastReflection_Subscription_messageType{}
+
+type fastReflection_Subscription_messageType struct{}
+
+func (x fastReflection_Subscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Subscription)(nil)
+}
+func (x fastReflection_Subscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+func (x fastReflection_Subscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Subscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_Subscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Subscription) Type() protoreflect.MessageType {
+	return _fastReflection_Subscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Subscription) New() protoreflect.Message {
+	return new(fastReflection_Subscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Subscription) Interface() protoreflect.ProtoMessage {
+	return (*Subscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Subscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Subscription_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Subscription_owner, value) {
+			return
+		}
+	}
+	if x.DepositAccount != "" {
+		value := protoreflect.ValueOfString(x.DepositAccount)
+		if !f(fd_Subscription_deposit_account, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Subscription_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Subscription_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_Subscription_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Subscription_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Subscription_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Subscription_9_list{list: &x.FeeLimit})
+		if !f(fd_Subscription_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_Subscription_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_Subscription_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_Subscription_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_Subscription_interval, value) {
+			return
+		}
+	}
+	if x.IntervalUnit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.IntervalUnit))
+		if !f(fd_Subscription_interval_unit, value) {
+			return
+		}
+	}
+	if x.MaxRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRuns)
+		if !f(fd_Subscription_max_runs, value) {
+			return
+		}
+	}
+	if x.Runs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Runs)
+		if !f(fd_Subscription_runs, value) {
+			return
+		}
+	}
+	if x.NextRun != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextRun)
+		if !f(fd_Subscription_next_run, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Subscription_status, value) {
+			return
+		}
+	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_Subscription_oracle_script_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Subscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		return x.Id != uint64(0)
+	case "band.oracle.v1.Subscription.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.Subscription.deposit_account":
+		return x.DepositAccount != ""
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Subscription.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Subscription.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.Subscription.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Subscription.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Subscription.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Subscription.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.Subscription.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.Subscription.interval":
+		return x.Interval != uint64(0)
+	case "band.oracle.v1.Subscription.interval_unit":
+		return x.IntervalUnit != 0
+	case "band.oracle.v1.Subscription.max_runs":
+		return x.MaxRuns != uint64(0)
+	case "band.oracle.v1.Subscription.runs":
+		return x.Runs != uint64(0)
+	case "band.oracle.v1.Subscription.next_run":
+		return x.NextRun != int64(0)
+	case "band.oracle.v1.Subscription.status":
+		return x.Status != 0
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		x.Id = uint64(0)
+	case "band.oracle.v1.Subscription.owner":
+		x.Owner = ""
+	case "band.oracle.v1.Subscription.deposit_account":
+		x.DepositAccount = ""
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Subscription.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Subscription.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.Subscription.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Subscription.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Subscription.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.Subscription.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.Subscription.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.Subscription.interval":
+		x.Interval = uint64(0)
+	case "band.oracle.v1.Subscription.interval_unit":
+		x.IntervalUnit = 0
+	case "band.oracle.v1.Subscription.max_runs":
+		x.MaxRuns = uint64(0)
+	case "band.oracle.v1.Subscription.runs":
+		x.Runs = uint64(0)
+	case "band.oracle.v1.Subscription.next_run":
+		x.NextRun = int64(0)
+	case "band.oracle.v1.Subscription.status":
+		x.Status = 0
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Subscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.deposit_account":
+		value := x.DepositAccount
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Subscription.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Subscription.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_Subscription_9_list{})
+		}
+		listValue := &_Subscription_9_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Subscription.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Subscription.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.interval_unit":
+		value := x.IntervalUnit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Subscription.max_runs":
+		value := x.MaxRuns
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.runs":
+		value := x.Runs
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Subscription.next_run":
+		value := x.NextRun
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Subscription.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		x.Id = value.Uint()
+	case "band.oracle.v1.Subscription.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.Subscription.deposit_account":
+		x.DepositAccount = value.Interface().(string)
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Subscription.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Subscription.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.Subscription.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Subscription.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Subscription.fee_limit":
+		lv := value.List()
+		clv := lv.(*_Subscription_9_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Subscription.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.Subscription.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.Subscription.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Subscription.interval":
+		x.Interval = value.Uint()
+	case "band.oracle.v1.Subscription.interval_unit":
+		x.IntervalUnit = (IntervalUnit)(value.Enum())
+	case "band.oracle.v1.Subscription.max_runs":
+		x.MaxRuns = value.Uint()
+	case "band.oracle.v1.Subscription.runs":
+		x.Runs = value.Uint()
+	case "band.oracle.v1.Subscription.next_run":
+		x.NextRun = value.Int()
+	case "band.oracle.v1.Subscription.status":
+		x.Status = (SubscriptionStatus)(value.Enum())
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_Subscription_9_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Subscription.id":
+		panic(fmt.Errorf("field id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.deposit_account":
+		panic(fmt.Errorf("field deposit_account of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.interval":
+		panic(fmt.Errorf("field interval of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.interval_unit":
+		panic(fmt.Errorf("field interval_unit of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.max_runs":
+		panic(fmt.Errorf("field max_runs of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.runs":
+		panic(fmt.Errorf("field runs of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.next_run":
+		panic(fmt.Errorf("field next_run of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.status":
+		panic(fmt.Errorf("field status of message band.oracle.v1.Subscription is not mutable"))
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.Subscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Subscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Subscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.deposit_account":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Subscription.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Subscription.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Subscription_9_list{list: &list})
+	case "band.oracle.v1.Subscription.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Subscription.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.interval_unit":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Subscription.max_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Subscription.next_run":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Subscription.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Subscription.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Subscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Subscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Subscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Subscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Subscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Subscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Subscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Subscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DepositAccount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.IntervalUnit != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalUnit))
+		}
+		if x.MaxRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRuns))
+		}
+		if x.Runs != 0 {
+			n += 2 + runtime.Sov(uint64(x.Runs))
+		}
+		if x.NextRun != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextRun))
+		}
+		if x.Status != 0 {
+			n += 2 + runtime.Sov(uint64(x.Status))
+		}
+		if x.OracleScriptVersion != 0 {
+			n += 2 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.NextRun != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRun))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.Runs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Runs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRuns))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.IntervalUnit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalUnit))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.DepositAccount) > 0 {
+			i -= len(x.DepositAccount)
+			copy(dAtA[i:], x.DepositAccount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositAccount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Subscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositAccount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositAccount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.IntervalUnit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalUnit |= IntervalUnit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			case 15:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
 				}
-				x.RequestId = 0
+				x.MaxRuns = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12528,16 +18331,16 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.MaxRuns |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+			case 16:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 				}
-				x.Encoder = 0
+				x.Runs = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12547,7 +18350,64 @@ func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Encoder |= Encoder(b&0x7F) << shift
+					x.Runs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRun", wireType)
+				}
+				x.NextRun = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRun |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SubscriptionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -12715,6 +18575,116 @@ func (Encoder) EnumDescriptor() ([]byte, []int) {
 	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
 }
 
+// IntervalUnit is an enumerator that defines the unit of a subscription
+// interval.
+type IntervalUnit int32
+
+const (
+	// INTERVAL_UNIT_UNSPECIFIED is for unspecified value
+	IntervalUnit_INTERVAL_UNIT_UNSPECIFIED IntervalUnit = 0
+	// INTERVAL_UNIT_BLOCK is for an interval in blocks
+	IntervalUnit_INTERVAL_UNIT_BLOCK IntervalUnit = 1
+	// INTERVAL_UNIT_SECOND is for an interval in seconds
+	IntervalUnit_INTERVAL_UNIT_SECOND IntervalUnit = 2
+)
+
+// Enum value maps for IntervalUnit.
+var (
+	IntervalUnit_name = map[int32]string{
+		0: "INTERVAL_UNIT_UNSPECIFIED",
+		1: "INTERVAL_UNIT_BLOCK",
+		2: "INTERVAL_UNIT_SECOND",
+	}
+	IntervalUnit_value = map[string]int32{
+		"INTERVAL_UNIT_UNSPECIFIED": 0,
+		"INTERVAL_UNIT_BLOCK":       1,
+		"INTERVAL_UNIT_SECOND":      2,
+	}
+)
+
+func (x IntervalUnit) Enum() *IntervalUnit {
+	p := new(IntervalUnit)
+	*p = x
+	return p
+}
+
+func (x IntervalUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntervalUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_oracle_v1_oracle_proto_enumTypes[2].Descriptor()
+}
+
+func (IntervalUnit) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[2]
+}
+
+func (x IntervalUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntervalUnit.Descriptor instead.
+func (IntervalUnit) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+// SubscriptionStatus is an enumerator that defines the status of a
+// subscription.
+type SubscriptionStatus int32
+
+const (
+	// SUBSCRIPTION_STATUS_UNSPECIFIED is for unspecified value
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	// SUBSCRIPTION_STATUS_ACTIVE is for a subscription that makes requests on
+	// schedule
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE SubscriptionStatus = 1
+	// SUBSCRIPTION_STATUS_PAUSED is for a subscription that stopped making
+	// requests because its deposit ran out
+	SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED SubscriptionStatus = 2
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_ACTIVE",
+		2: "SUBSCRIPTION_STATUS_PAUSED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_ACTIVE":      1,
+		"SUBSCRIPTION_STATUS_PAUSED":      2,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_oracle_v1_oracle_proto_enumTypes[3].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[3]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+}
+
 // DataSource is the data structure for storing data sources in the storage.
 type DataSource struct {
 	state         protoimpl.MessageState
@@ -12869,19 +18839,194 @@ func (x *OracleScript) GetFilename() string {
 	if x != nil {
 		return x.Filename
 	}
-	return ""
+	return ""
+}
+
+func (x *OracleScript) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *OracleScript) GetSourceCodeUrl() string {
+	if x != nil {
+		return x.SourceCodeUrl
+	}
+	return ""
+}
+
+// DataSourceVersion is an immutable record of a data source executable, which
+// is appended every time the data source is created or edited.
+type DataSourceVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is the ID of the data source
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Editor is the address of the account who created this version
+	Editor string `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Changelog is an optional description of the changes in this version
+	Changelog string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
+}
+
+func (x *DataSourceVersion) Reset() {
+	*x = DataSourceVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceVersion) ProtoMessage() {}
+
+// Deprecated: Use DataSourceVersion.ProtoReflect.Descriptor instead.
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DataSourceVersion) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DataSourceVersion) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *DataSourceVersion) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+// OracleScriptVersion is an immutable record of a compiled oracle script,
+// which is appended every time the oracle script is created or edited.
+type OracleScriptVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Editor is the address of the account who created this version
+	Editor string `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Changelog is an optional description of the changes in this version
+	Changelog string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// Schema is the OBI schema of the oracle script at this version
+	Schema string `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *OracleScriptVersion) Reset() {
+	*x = OracleScriptVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleScriptVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleScriptVersion) ProtoMessage() {}
+
+// Deprecated: Use OracleScriptVersion.ProtoReflect.Descriptor instead.
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OracleScriptVersion) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *OracleScriptVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OracleScriptVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OracleScriptVersion) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *OracleScriptVersion) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (x *OracleScript) GetSchema() string {
+func (x *OracleScriptVersion) GetChangelog() string {
 	if x != nil {
-		return x.Schema
+		return x.Changelog
 	}
 	return ""
 }
 
-func (x *OracleScript) GetSourceCodeUrl() string {
+func (x *OracleScriptVersion) GetSchema() string {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.Schema
 	}
 	return ""
 }
@@ -12899,12 +19044,14 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source to be executed
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (x *RawRequest) Reset() {
 	*x = RawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12918,7 +19065,7 @@ func (*RawRequest) ProtoMessage() {}
 
 // Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RawRequest) GetExternalId() uint64 {
@@ -12942,6 +19089,13 @@ func (x *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (x *RawRequest) GetDataSourceVersion() uint64 {
+	if x != nil {
+		return x.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	state         protoimpl.MessageState
@@ -12963,7 +19117,7 @@ type RawReport struct {
 func (x *RawReport) Reset() {
 	*x = RawReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12977,7 +19131,7 @@ func (*RawReport) ProtoMessage() {}
 
 // Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RawReport) GetExternalId() uint64 {
@@ -13039,12 +19193,18 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// Callback is the result callback to run when the request is resolved or
+	// expired, if any.
+	Callback *RequestCallback `protobuf:"bytes,15,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13058,7 +19218,7 @@ func (*Request) ProtoMessage() {}
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Request) GetOracleScriptId() uint64 {
@@ -13152,6 +19312,77 @@ func (x *Request) GetFeeLimit() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Request) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+func (x *Request) GetCallback() *RequestCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+// RequestCallback is the callback of a module on BandChain that is run when a
+// request is resolved or expired.
+type RequestCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module is the name of the module registered in the result callback router.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Payload is the arbitrary data passed to the callback of the module.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// GasLimit is the maximum amount of gas that the callback can consume. It is
+	// charged when the request is created.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (x *RequestCallback) Reset() {
+	*x = RequestCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCallback) ProtoMessage() {}
+
+// Deprecated: Use RequestCallback.ProtoReflect.Descriptor instead.
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestCallback) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *RequestCallback) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *RequestCallback) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	state         protoimpl.MessageState
@@ -13171,7 +19402,7 @@ type Report struct {
 func (x *Report) Reset() {
 	*x = Report{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13185,7 +19416,7 @@ func (*Report) ProtoMessage() {}
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Report) GetValidator() string {
@@ -13242,12 +19473,15 @@ type OracleRequestPacketData struct {
 	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TssEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,10,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (x *OracleRequestPacketData) Reset() {
 	*x = OracleRequestPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13261,7 +19495,7 @@ func (*OracleRequestPacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OracleRequestPacketData) GetClientId() string {
@@ -13327,6 +19561,13 @@ func (x *OracleRequestPacketData) GetTssEncoder() Encoder {
 	return Encoder_ENCODER_UNSPECIFIED
 }
 
+func (x *OracleRequestPacketData) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
 // OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
 // send back to requester chain.
 type OracleRequestPacketAcknowledgement struct {
@@ -13341,7 +19582,7 @@ type OracleRequestPacketAcknowledgement struct {
 func (x *OracleRequestPacketAcknowledgement) Reset() {
 	*x = OracleRequestPacketAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13355,7 +19596,7 @@ func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
@@ -13398,7 +19639,7 @@ type OracleResponsePacketData struct {
 func (x *OracleResponsePacketData) Reset() {
 	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13412,7 +19653,7 @@ func (*OracleResponsePacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OracleResponsePacketData) GetClientId() string {
@@ -13508,7 +19749,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13522,7 +19763,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Result) GetClientId() string {
@@ -13602,6 +19843,184 @@ func (x *Result) GetResult() []byte {
 	return nil
 }
 
+// FeeReceipt is the itemized breakdown of the data source fees of a request.
+// The fees are held in escrow when the request is made, and they are settled
+// when the request expires: the treasuries are paid for the raw reports that
+// are actually delivered and the remainder is refunded to the payer.
+type FeeReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is the ID of the request that the fees are paid for.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Payer is the address of the account that pays the fees and gets the
+	// refund, unless a refund address is set.
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Escrowed is the total fees that are held in escrow for the request.
+	Escrowed []*v1beta1.Coin `protobuf:"bytes,3,rep,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// Items are the fees of the raw requests that have a fee.
+	Items []*FeeReceiptItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Refunded is the fees that are refunded to the payer.
+	Refunded []*v1beta1.Coin `protobuf:"bytes,5,rep,name=refunded,proto3" json:"refunded,omitempty"`
+	// Settled is a flag indicating whether the fees have been settled.
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
+	// RefundAddress is the address of the account that gets the refund instead
+	// of the payer, e.g. the owner of the subscription that makes the request.
+	RefundAddress string `protobuf:"bytes,7,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (x *FeeReceipt) Reset() {
+	*x = FeeReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeReceipt) ProtoMessage() {}
+
+// Deprecated: Use FeeReceipt.ProtoReflect.Descriptor instead.
+func (*FeeReceipt) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FeeReceipt) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *FeeReceipt) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *FeeReceipt) GetEscrowed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Escrowed
+	}
+	return nil
+}
+
+func (x *FeeReceipt) GetItems() []*FeeReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FeeReceipt) GetRefunded() []*v1beta1.Coin {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *FeeReceipt) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *FeeReceipt) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+// FeeReceiptItem is the fee of a raw request in a fee receipt.
+type FeeReceiptItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExternalID is the external ID of the raw request.
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of the data source of the raw request.
+	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Treasury is the address of the data source treasury at the request time.
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// FeePerReport is the fee paid to the treasury for each raw report.
+	FeePerReport []*v1beta1.Coin `protobuf:"bytes,4,rep,name=fee_per_report,json=feePerReport,proto3" json:"fee_per_report,omitempty"`
+	// ReportCount is the number of raw reports delivered for the raw request.
+	ReportCount uint64 `protobuf:"varint,5,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// Paid is the fees paid to the treasury.
+	Paid []*v1beta1.Coin `protobuf:"bytes,6,rep,name=paid,proto3" json:"paid,omitempty"`
+}
+
+func (x *FeeReceiptItem) Reset() {
+	*x = FeeReceiptItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeReceiptItem) ProtoMessage() {}
+
+// Deprecated: Use FeeReceiptItem.ProtoReflect.Descriptor instead.
+func (*FeeReceiptItem) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FeeReceiptItem) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
+}
+
+func (x *FeeReceiptItem) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *FeeReceiptItem) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *FeeReceiptItem) GetFeePerReport() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeePerReport
+	}
+	return nil
+}
+
+func (x *FeeReceiptItem) GetReportCount() uint64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *FeeReceiptItem) GetPaid() []*v1beta1.Coin {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
 // SigningResult encodes a result of signing of request
 type SigningResult struct {
 	state         protoimpl.MessageState
@@ -13619,7 +20038,7 @@ type SigningResult struct {
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13633,7 +20052,7 @@ func (*SigningResult) ProtoMessage() {}
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SigningResult) GetSigningId() uint64 {
@@ -13674,7 +20093,7 @@ type ValidatorStatus struct {
 func (x *ValidatorStatus) Reset() {
 	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13688,7 +20107,7 @@ func (*ValidatorStatus) ProtoMessage() {}
 
 // Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ValidatorStatus) GetIsActive() bool {
@@ -13720,7 +20139,7 @@ type ActiveValidator struct {
 func (x *ActiveValidator) Reset() {
 	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13734,7 +20153,7 @@ func (*ActiveValidator) ProtoMessage() {}
 
 // Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ActiveValidator) GetAddress() string {
@@ -13788,12 +20207,35 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// LatestResultRetention is the duration that the latest successful result of
+	// a request search key or a price symbol is kept in the index after it is
+	// resolved. Zero disables the index.
+	LatestResultRetention uint64 `protobuf:"varint,12,opt,name=latest_result_retention,json=latestResultRetention,proto3" json:"latest_result_retention,omitempty"`
+	// MaxSubscriptionRunsPerBlock is the maximum number of subscriptions that
+	// can make their requests in a block. The remaining due subscriptions are
+	// carried over to the next blocks. Zero stops all subscriptions from making
+	// requests.
+	MaxSubscriptionRunsPerBlock uint64 `protobuf:"varint,13,opt,name=max_subscription_runs_per_block,json=maxSubscriptionRunsPerBlock,proto3" json:"max_subscription_runs_per_block,omitempty"`
+	// ResultRetentionPeriod is the duration that the result of an expired
+	// request is kept in the state after it is resolved. Zero disables pruning
+	// by age.
+	ResultRetentionPeriod uint64 `protobuf:"varint,14,opt,name=result_retention_period,json=resultRetentionPeriod,proto3" json:"result_retention_period,omitempty"`
+	// ResultRetentionCount is the number of the most recent requests whose
+	// results are kept in the state. Zero disables pruning by count.
+	ResultRetentionCount uint64 `protobuf:"varint,15,opt,name=result_retention_count,json=resultRetentionCount,proto3" json:"result_retention_count,omitempty"`
+	// MaxResultPrunesPerBlock is the maximum number of results that can be
+	// pruned in a block. The remaining results are pruned in the next blocks.
+	MaxResultPrunesPerBlock uint64 `protobuf:"varint,16,opt,name=max_result_prunes_per_block,json=maxResultPrunesPerBlock,proto3" json:"max_result_prunes_per_block,omitempty"`
+	// SubscriptionGasPrices is the gas prices of the gas used by the requests of
+	// subscriptions. The requests are made in the end block without a
+	// transaction, so the fee is paid from the deposit of the subscription.
+	SubscriptionGasPrices []*v1beta1.DecCoin `protobuf:"bytes,17,rep,name=subscription_gas_prices,json=subscriptionGasPrices,proto3" json:"subscription_gas_prices,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13807,7 +20249,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Params) GetMaxRawRequestCount() uint64 {
@@ -13887,6 +20329,48 @@ func (x *Params) GetIbcRequestEnabled() bool {
 	return false
 }
 
+func (x *Params) GetLatestResultRetention() uint64 {
+	if x != nil {
+		return x.LatestResultRetention
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSubscriptionRunsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxSubscriptionRunsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetResultRetentionPeriod() uint64 {
+	if x != nil {
+		return x.ResultRetentionPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetResultRetentionCount() uint64 {
+	if x != nil {
+		return x.ResultRetentionCount
+	}
+	return 0
+}
+
+func (x *Params) GetMaxResultPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxResultPrunesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetSubscriptionGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.SubscriptionGasPrices
+	}
+	return nil
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	state         protoimpl.MessageState
@@ -13900,7 +20384,7 @@ type PendingResolveList struct {
 func (x *PendingResolveList) Reset() {
 	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13914,7 +20398,7 @@ func (*PendingResolveList) ProtoMessage() {}
 
 // Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PendingResolveList) GetRequestIds() []uint64 {
@@ -13942,7 +20426,7 @@ type IBCChannel struct {
 func (x *IBCChannel) Reset() {
 	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13956,7 +20440,7 @@ func (*IBCChannel) ProtoMessage() {}
 
 // Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *IBCChannel) GetPortId() string {
@@ -13995,7 +20479,7 @@ type RequestVerification struct {
 func (x *RequestVerification) Reset() {
 	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14009,7 +20493,7 @@ func (*RequestVerification) ProtoMessage() {}
 
 // Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RequestVerification) GetChainId() string {
@@ -14071,7 +20555,7 @@ type PriceResult struct {
 func (x *PriceResult) Reset() {
 	*x = PriceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14085,7 +20569,7 @@ func (*PriceResult) ProtoMessage() {}
 
 // Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PriceResult) GetSymbol() string {
@@ -14138,7 +20622,7 @@ type OracleResultSignatureOrder struct {
 func (x *OracleResultSignatureOrder) Reset() {
 	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14152,7 +20636,7 @@ func (*OracleResultSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
@@ -14169,6 +20653,212 @@ func (x *OracleResultSignatureOrder) GetEncoder() Encoder {
 	return Encoder_ENCODER_UNSPECIFIED
 }
 
+// Subscription is a recurring oracle request that is made on schedule and paid
+// from its deposit account.
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is the unique identifier of this subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the account address who is allowed to update and cancel the
+	// subscription.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// DepositAccount is the account address that pays the fees of the requests.
+	DepositAccount string `protobuf:"bytes,3,opt,name=deposit_account,json=depositAccount,proto3" json:"deposit_account,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptId uint64 `protobuf:"varint,4,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,5,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,6,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,7,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientId string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,9,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,10,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,11,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,12,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,14,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,15,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Runs is the number of requests that have been made.
+	Runs uint64 `protobuf:"varint,16,opt,name=runs,proto3" json:"runs,omitempty"`
+	// NextRun is the block height or the unix timestamp, depending on the
+	// interval unit, at which the next request is due.
+	NextRun int64 `protobuf:"varint,17,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	// Status is the status of the subscription.
+	Status SubscriptionStatus `protobuf:"varint,18,opt,name=status,proto3,enum=band.oracle.v1.SubscriptionStatus" json:"status,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,19,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Subscription) GetDepositAccount() string {
+	if x != nil {
+		return x.DepositAccount
+	}
+	return ""
+}
+
+func (x *Subscription) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *Subscription) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
+}
+
+func (x *Subscription) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *Subscription) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Subscription) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Subscription) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+func (x *Subscription) GetPrepareGas() uint64 {
+	if x != nil {
+		return x.PrepareGas
+	}
+	return 0
+}
+
+func (x *Subscription) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
+	}
+	return 0
+}
+
+func (x *Subscription) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *Subscription) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Subscription) GetIntervalUnit() IntervalUnit {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return IntervalUnit_INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (x *Subscription) GetMaxRuns() uint64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *Subscription) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *Subscription) GetNextRun() int64 {
+	if x != nil {
+		return x.NextRun
+	}
+	return 0
+}
+
+func (x *Subscription) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *Subscription) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
 var File_band_oracle_v1_oracle_proto protoreflect.FileDescriptor
 
 var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
@@ -14180,305 +20870,500 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x6c, 0x6f, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x6f, 0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa1, 0x02, 0x0a, 0x13,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2,
+	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xe5, 0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb6, 0x06, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a,
+	0x0b, 0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e,
+	0xe2, 0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a,
+	0x69, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74,
+	0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54,
+	0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x66, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a,
+	0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x04, 0x0a, 0x17, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x02,
+	0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a,
+	0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x67, 0x0a, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x67, 0x0a, 0x08, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb0, 0x03, 0x0a, 0x0e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x12, 0x71, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
 	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a,
-	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x73, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01,
+	0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xfa,
+	0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73,
+	0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73,
+	0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d, 0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x47, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1b, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x36, 0x0a,
+	0x17, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1b, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x89, 0x01, 0x0a, 0x17, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x15,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a, 0x49, 0x42, 0x43, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
 	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b,
-	0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2,
-	0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69,
-	0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
-	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
-	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x22, 0x91, 0x07, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x18, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x41,
+	0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f,
+	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
 	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
-	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a,
-	0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
+	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48,
+	0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde,
+	0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73,
+	0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x75, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x75,
+	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75,
+	0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
 	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
 	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
@@ -14501,19 +21386,33 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
 	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
 	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x00, 0x2a, 0x66, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x49,
+	0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e,
+	0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x7f, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x1f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14528,51 +21427,71 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
 	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
-	(*DataSource)(nil),                         // 2: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                       // 3: band.oracle.v1.OracleScript
-	(*RawRequest)(nil),                         // 4: band.oracle.v1.RawRequest
-	(*RawReport)(nil),                          // 5: band.oracle.v1.RawReport
-	(*Request)(nil),                            // 6: band.oracle.v1.Request
-	(*Report)(nil),                             // 7: band.oracle.v1.Report
-	(*OracleRequestPacketData)(nil),            // 8: band.oracle.v1.OracleRequestPacketData
-	(*OracleRequestPacketAcknowledgement)(nil), // 9: band.oracle.v1.OracleRequestPacketAcknowledgement
-	(*OracleResponsePacketData)(nil),           // 10: band.oracle.v1.OracleResponsePacketData
-	(*Result)(nil),                             // 11: band.oracle.v1.Result
-	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
-	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(IntervalUnit)(0),                          // 2: band.oracle.v1.IntervalUnit
+	(SubscriptionStatus)(0),                    // 3: band.oracle.v1.SubscriptionStatus
+	(*DataSource)(nil),                         // 4: band.oracle.v1.DataSource
+	(*OracleScript)(nil),                       // 5: band.oracle.v1.OracleScript
+	(*DataSourceVersion)(nil),                  // 6: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil),                // 7: band.oracle.v1.OracleScriptVersion
+	(*RawRequest)(nil),                         // 8: band.oracle.v1.RawRequest
+	(*RawReport)(nil),                          // 9: band.oracle.v1.RawReport
+	(*Request)(nil),                            // 10: band.oracle.v1.Request
+	(*RequestCallback)(nil),                    // 11: band.oracle.v1.RequestCallback
+	(*Report)(nil),                             // 12: band.oracle.v1.Report
+	(*OracleRequestPacketData)(nil),            // 13: band.oracle.v1.OracleRequestPacketData
+	(*OracleRequestPacketAcknowledgement)(nil), // 14: band.oracle.v1.OracleRequestPacketAcknowledgement
+	(*OracleResponsePacketData)(nil),           // 15: band.oracle.v1.OracleResponsePacketData
+	(*Result)(nil),                             // 16: band.oracle.v1.Result
+	(*FeeReceipt)(nil),                         // 17: band.oracle.v1.FeeReceipt
+	(*FeeReceiptItem)(nil),                     // 18: band.oracle.v1.FeeReceiptItem
+	(*SigningResult)(nil),                      // 19: band.oracle.v1.SigningResult
+	(*ValidatorStatus)(nil),                    // 20: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                    // 21: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                             // 22: band.oracle.v1.Params
+	(*PendingResolveList)(nil),                 // 23: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                         // 24: band.oracle.v1.IBCChannel
+	(*RequestVerification)(nil),                // 25: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                        // 26: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),         // 27: band.oracle.v1.OracleResultSignatureOrder
+	(*Subscription)(nil),                       // 28: band.oracle.v1.Subscription
+	(*v1beta1.Coin)(nil),                       // 29: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),              // 30: google.protobuf.Timestamp
+	(*v1beta1.DecCoin)(nil),                    // 31: cosmos.base.v1beta1.DecCoin
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	29, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
+	24, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
 	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	29, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	11, // 5: band.oracle.v1.Request.callback:type_name -> band.oracle.v1.RequestCallback
+	9,  // 6: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
+	29, // 7: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 8: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	0,  // 9: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	0,  // 10: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	29, // 11: band.oracle.v1.FeeReceipt.escrowed:type_name -> cosmos.base.v1beta1.Coin
+	18, // 12: band.oracle.v1.FeeReceipt.items:type_name -> band.oracle.v1.FeeReceiptItem
+	29, // 13: band.oracle.v1.FeeReceipt.refunded:type_name -> cosmos.base.v1beta1.Coin
+	29, // 14: band.oracle.v1.FeeReceiptItem.fee_per_report:type_name -> cosmos.base.v1beta1.Coin
+	29, // 15: band.oracle.v1.FeeReceiptItem.paid:type_name -> cosmos.base.v1beta1.Coin
+	30, // 16: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	31, // 17: band.oracle.v1.Params.subscription_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	1,  // 18: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	29, // 19: band.oracle.v1.Subscription.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 20: band.oracle.v1.Subscription.tss_encoder:type_name -> band.oracle.v1.Encoder
+	2,  // 21: band.oracle.v1.Subscription.interval_unit:type_name -> band.oracle.v1.IntervalUnit
+	3,  // 22: band.oracle.v1.Subscription.status:type_name -> band.oracle.v1.SubscriptionStatus
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14606,7 +21525,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawRequest); i {
+			switch v := v.(*DataSourceVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14618,7 +21537,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawReport); i {
+			switch v := v.(*OracleScriptVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14630,7 +21549,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+			switch v := v.(*RawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14642,7 +21561,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
+			switch v := v.(*RawReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14654,7 +21573,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketData); i {
+			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14666,7 +21585,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketAcknowledgement); i {
+			switch v := v.(*RequestCallback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +21597,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleResponsePacketData); i {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +21609,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*OracleRequestPacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,7 +21621,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigningResult); i {
+			switch v := v.(*OracleRequestPacketAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14714,7 +21633,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorStatus); i {
+			switch v := v.(*OracleResponsePacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14726,7 +21645,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +21657,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*FeeReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +21669,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*FeeReceiptItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +21681,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*SigningResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +21693,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +21705,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*ActiveValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +21717,66 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingResolveList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14809,14 +21788,26 @@ func file_band_oracle_v1_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_band_oracle_v1_oracle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
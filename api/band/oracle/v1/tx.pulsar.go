@@ -69,17 +69,19 @@ func (x *_MsgRequestData_6_list) IsValid() bool {
 }
 
 var (
-	md_MsgRequestData                  protoreflect.MessageDescriptor
-	fd_MsgRequestData_oracle_script_id protoreflect.FieldDescriptor
-	fd_MsgRequestData_calldata         protoreflect.FieldDescriptor
-	fd_MsgRequestData_ask_count        protoreflect.FieldDescriptor
-	fd_MsgRequestData_min_count        protoreflect.FieldDescriptor
-	fd_MsgRequestData_client_id        protoreflect.FieldDescriptor
-	fd_MsgRequestData_fee_limit        protoreflect.FieldDescriptor
-	fd_MsgRequestData_prepare_gas      protoreflect.FieldDescriptor
-	fd_MsgRequestData_execute_gas      protoreflect.FieldDescriptor
-	fd_MsgRequestData_sender           protoreflect.FieldDescriptor
-	fd_MsgRequestData_tss_encoder      protoreflect.FieldDescriptor
+	md_MsgRequestData                       protoreflect.MessageDescriptor
+	fd_MsgRequestData_oracle_script_id      protoreflect.FieldDescriptor
+	fd_MsgRequestData_calldata              protoreflect.FieldDescriptor
+	fd_MsgRequestData_ask_count             protoreflect.FieldDescriptor
+	fd_MsgRequestData_min_count             protoreflect.FieldDescriptor
+	fd_MsgRequestData_client_id             protoreflect.FieldDescriptor
+	fd_MsgRequestData_fee_limit             protoreflect.FieldDescriptor
+	fd_MsgRequestData_prepare_gas           protoreflect.FieldDescriptor
+	fd_MsgRequestData_execute_gas           protoreflect.FieldDescriptor
+	fd_MsgRequestData_sender                protoreflect.FieldDescriptor
+	fd_MsgRequestData_tss_encoder           protoreflect.FieldDescriptor
+	fd_MsgRequestData_oracle_script_version protoreflect.FieldDescriptor
+	fd_MsgRequestData_callback              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -95,6 +97,8 @@ func init() {
 	fd_MsgRequestData_execute_gas = md_MsgRequestData.Fields().ByName("execute_gas")
 	fd_MsgRequestData_sender = md_MsgRequestData.Fields().ByName("sender")
 	fd_MsgRequestData_tss_encoder = md_MsgRequestData.Fields().ByName("tss_encoder")
+	fd_MsgRequestData_oracle_script_version = md_MsgRequestData.Fields().ByName("oracle_script_version")
+	fd_MsgRequestData_callback = md_MsgRequestData.Fields().ByName("callback")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRequestData)(nil)
@@ -222,6 +226,18 @@ func (x *fastReflection_MsgRequestData) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_MsgRequestData_oracle_script_version, value) {
+			return
+		}
+	}
+	if x.Callback != nil {
+		value := protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+		if !f(fd_MsgRequestData_callback, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -257,6 +273,10 @@ func (x *fastReflection_MsgRequestData) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Sender != ""
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		return x.TssEncoder != 0
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	case "band.oracle.v1.MsgRequestData.callback":
+		return x.Callback != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -293,6 +313,10 @@ func (x *fastReflection_MsgRequestData) Clear(fd protoreflect.FieldDescriptor) {
 		x.Sender = ""
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		x.TssEncoder = 0
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	case "band.oracle.v1.MsgRequestData.callback":
+		x.Callback = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -342,6 +366,12 @@ func (x *fastReflection_MsgRequestData) Get(descriptor protoreflect.FieldDescrip
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		value := x.TssEncoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgRequestData.callback":
+		value := x.Callback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -384,6 +414,10 @@ func (x *fastReflection_MsgRequestData) Set(fd protoreflect.FieldDescriptor, val
 		x.Sender = value.Interface().(string)
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	case "band.oracle.v1.MsgRequestData.callback":
+		x.Callback = value.Message().Interface().(*RequestCallback)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -410,6 +444,11 @@ func (x *fastReflection_MsgRequestData) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgRequestData_6_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgRequestData.callback":
+		if x.Callback == nil {
+			x.Callback = new(RequestCallback)
+		}
+		return protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
 	case "band.oracle.v1.MsgRequestData.oracle_script_id":
 		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgRequestData is not mutable"))
 	case "band.oracle.v1.MsgRequestData.calldata":
@@ -428,6 +467,8 @@ func (x *fastReflection_MsgRequestData) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgRequestData is not mutable"))
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.MsgRequestData is not mutable"))
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.MsgRequestData is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -462,6 +503,11 @@ func (x *fastReflection_MsgRequestData) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgRequestData.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgRequestData.callback":
+		m := new(RequestCallback)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -567,6 +613,13 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 		if x.TssEncoder != 0 {
 			n += 1 + runtime.Sov(uint64(x.TssEncoder))
 		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		if x.Callback != nil {
+			l = options.Size(x.Callback)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -596,6 +649,25 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Callback != nil {
+			encoded, err := options.Marshal(x.Callback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x58
+		}
 		if x.TssEncoder != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
 			i--
@@ -958,6 +1030,61 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Callback == nil {
+					x.Callback = &RequestCallback{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Callback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3608,6 +3735,7 @@ var (
 	fd_MsgEditDataSource_treasury       protoreflect.FieldDescriptor
 	fd_MsgEditDataSource_owner          protoreflect.FieldDescriptor
 	fd_MsgEditDataSource_sender         protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_changelog      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3621,6 +3749,7 @@ func init() {
 	fd_MsgEditDataSource_treasury = md_MsgEditDataSource.Fields().ByName("treasury")
 	fd_MsgEditDataSource_owner = md_MsgEditDataSource.Fields().ByName("owner")
 	fd_MsgEditDataSource_sender = md_MsgEditDataSource.Fields().ByName("sender")
+	fd_MsgEditDataSource_changelog = md_MsgEditDataSource.Fields().ByName("changelog")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgEditDataSource)(nil)
@@ -3736,6 +3865,12 @@ func (x *fastReflection_MsgEditDataSource) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Changelog != "" {
+		value := protoreflect.ValueOfString(x.Changelog)
+		if !f(fd_MsgEditDataSource_changelog, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3767,6 +3902,8 @@ func (x *fastReflection_MsgEditDataSource) Has(fd protoreflect.FieldDescriptor)
 		return x.Owner != ""
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		return x.Sender != ""
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		return x.Changelog != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -3799,6 +3936,8 @@ func (x *fastReflection_MsgEditDataSource) Clear(fd protoreflect.FieldDescriptor
 		x.Owner = ""
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		x.Sender = ""
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		x.Changelog = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -3842,6 +3981,9 @@ func (x *fastReflection_MsgEditDataSource) Get(descriptor protoreflect.FieldDesc
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		value := x.Changelog
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -3880,6 +4022,8 @@ func (x *fastReflection_MsgEditDataSource) Set(fd protoreflect.FieldDescriptor,
 		x.Owner = value.Interface().(string)
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		x.Sender = value.Interface().(string)
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		x.Changelog = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -3920,6 +4064,8 @@ func (x *fastReflection_MsgEditDataSource) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditDataSource is not mutable"))
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		panic(fmt.Errorf("field changelog of message band.oracle.v1.MsgEditDataSource is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -3950,6 +4096,8 @@ func (x *fastReflection_MsgEditDataSource) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.MsgEditDataSource.sender":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditDataSource.changelog":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
@@ -4052,6 +4200,10 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Changelog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4081,6 +4233,13 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Changelog) > 0 {
+			i -= len(x.Changelog)
+			copy(dAtA[i:], x.Changelog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Changelog)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.Sender) > 0 {
 			i -= len(x.Sender)
 			copy(dAtA[i:], x.Sender)
@@ -4440,6 +4599,38 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				}
 				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changelog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6003,6 +6194,7 @@ var (
 	fd_MsgEditOracleScript_code             protoreflect.FieldDescriptor
 	fd_MsgEditOracleScript_owner            protoreflect.FieldDescriptor
 	fd_MsgEditOracleScript_sender           protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_changelog        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -6016,6 +6208,7 @@ func init() {
 	fd_MsgEditOracleScript_code = md_MsgEditOracleScript.Fields().ByName("code")
 	fd_MsgEditOracleScript_owner = md_MsgEditOracleScript.Fields().ByName("owner")
 	fd_MsgEditOracleScript_sender = md_MsgEditOracleScript.Fields().ByName("sender")
+	fd_MsgEditOracleScript_changelog = md_MsgEditOracleScript.Fields().ByName("changelog")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgEditOracleScript)(nil)
@@ -6131,6 +6324,12 @@ func (x *fastReflection_MsgEditOracleScript) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Changelog != "" {
+		value := protoreflect.ValueOfString(x.Changelog)
+		if !f(fd_MsgEditOracleScript_changelog, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6162,6 +6361,8 @@ func (x *fastReflection_MsgEditOracleScript) Has(fd protoreflect.FieldDescriptor
 		return x.Owner != ""
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		return x.Sender != ""
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		return x.Changelog != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6194,6 +6395,8 @@ func (x *fastReflection_MsgEditOracleScript) Clear(fd protoreflect.FieldDescript
 		x.Owner = ""
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		x.Sender = ""
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		x.Changelog = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6234,6 +6437,9 @@ func (x *fastReflection_MsgEditOracleScript) Get(descriptor protoreflect.FieldDe
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		value := x.Changelog
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6270,6 +6476,8 @@ func (x *fastReflection_MsgEditOracleScript) Set(fd protoreflect.FieldDescriptor
 		x.Owner = value.Interface().(string)
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		x.Sender = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		x.Changelog = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6306,6 +6514,8 @@ func (x *fastReflection_MsgEditOracleScript) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditOracleScript is not mutable"))
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		panic(fmt.Errorf("field changelog of message band.oracle.v1.MsgEditOracleScript is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6335,6 +6545,8 @@ func (x *fastReflection_MsgEditOracleScript) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.MsgEditOracleScript.sender":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.changelog":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
@@ -6435,6 +6647,10 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Changelog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6464,6 +6680,13 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Changelog) > 0 {
+			i -= len(x.Changelog)
+			copy(dAtA[i:], x.Changelog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Changelog)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.Sender) > 0 {
 			i -= len(x.Sender)
 			copy(dAtA[i:], x.Sender)
@@ -6812,6 +7035,38 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 				}
 				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changelog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7979,28 +8234,156 @@ func (x *fastReflection_MsgActivateResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreateSubscription_6_list)(nil)
+
+type _MsgCreateSubscription_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCreateSubscription_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateSubscription_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateSubscription_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateSubscription_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateSubscription_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateSubscription_13_list)(nil)
+
+type _MsgCreateSubscription_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCreateSubscription_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateSubscription_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateSubscription_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateSubscription_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateSubscription_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSubscription_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgCreateSubscription                       protoreflect.MessageDescriptor
+	fd_MsgCreateSubscription_oracle_script_id      protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_calldata              protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_ask_count             protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_min_count             protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_client_id             protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_fee_limit             protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_prepare_gas           protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_execute_gas           protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_tss_encoder           protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_interval              protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_interval_unit         protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_max_runs              protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_initial_deposit       protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_sender                protoreflect.FieldDescriptor
+	fd_MsgCreateSubscription_oracle_script_version protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgUpdateParams = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgCreateSubscription = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateSubscription")
+	fd_MsgCreateSubscription_oracle_script_id = md_MsgCreateSubscription.Fields().ByName("oracle_script_id")
+	fd_MsgCreateSubscription_calldata = md_MsgCreateSubscription.Fields().ByName("calldata")
+	fd_MsgCreateSubscription_ask_count = md_MsgCreateSubscription.Fields().ByName("ask_count")
+	fd_MsgCreateSubscription_min_count = md_MsgCreateSubscription.Fields().ByName("min_count")
+	fd_MsgCreateSubscription_client_id = md_MsgCreateSubscription.Fields().ByName("client_id")
+	fd_MsgCreateSubscription_fee_limit = md_MsgCreateSubscription.Fields().ByName("fee_limit")
+	fd_MsgCreateSubscription_prepare_gas = md_MsgCreateSubscription.Fields().ByName("prepare_gas")
+	fd_MsgCreateSubscription_execute_gas = md_MsgCreateSubscription.Fields().ByName("execute_gas")
+	fd_MsgCreateSubscription_tss_encoder = md_MsgCreateSubscription.Fields().ByName("tss_encoder")
+	fd_MsgCreateSubscription_interval = md_MsgCreateSubscription.Fields().ByName("interval")
+	fd_MsgCreateSubscription_interval_unit = md_MsgCreateSubscription.Fields().ByName("interval_unit")
+	fd_MsgCreateSubscription_max_runs = md_MsgCreateSubscription.Fields().ByName("max_runs")
+	fd_MsgCreateSubscription_initial_deposit = md_MsgCreateSubscription.Fields().ByName("initial_deposit")
+	fd_MsgCreateSubscription_sender = md_MsgCreateSubscription.Fields().ByName("sender")
+	fd_MsgCreateSubscription_oracle_script_version = md_MsgCreateSubscription.Fields().ByName("oracle_script_version")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateSubscription)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgCreateSubscription MsgCreateSubscription
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgCreateSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateSubscription)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateSubscription) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8012,43 +8395,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgCreateSubscription_messageType fastReflection_MsgCreateSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateSubscription_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgCreateSubscription_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgCreateSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateSubscription)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgCreateSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSubscription)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgCreateSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSubscription
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgCreateSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSubscription
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgCreateSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateSubscription_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgCreateSubscription) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSubscription)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgCreateSubscription) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateSubscription)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8056,16 +8439,94 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgCreateSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_MsgCreateSubscription_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_MsgCreateSubscription_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_MsgCreateSubscription_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_MsgCreateSubscription_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_MsgCreateSubscription_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateSubscription_6_list{list: &x.FeeLimit})
+		if !f(fd_MsgCreateSubscription_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_MsgCreateSubscription_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_MsgCreateSubscription_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_MsgCreateSubscription_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_MsgCreateSubscription_interval, value) {
+			return
+		}
+	}
+	if x.IntervalUnit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.IntervalUnit))
+		if !f(fd_MsgCreateSubscription_interval_unit, value) {
+			return
+		}
+	}
+	if x.MaxRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRuns)
+		if !f(fd_MsgCreateSubscription_max_runs, value) {
+			return
+		}
+	}
+	if len(x.InitialDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateSubscription_13_list{list: &x.InitialDeposit})
+		if !f(fd_MsgCreateSubscription_initial_deposit, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCreateSubscription_sender, value) {
+			return
+		}
+	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_MsgCreateSubscription_oracle_script_version, value) {
 			return
 		}
 	}
@@ -8082,17 +8543,43 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateSubscription) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "band.oracle.v1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		return x.Interval != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		return x.IntervalUnit != 0
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		return x.MaxRuns != uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		return len(x.InitialDeposit) != 0
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		return x.Sender != ""
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8102,17 +8589,43 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateSubscription) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "band.oracle.v1.MsgUpdateParams.params":
-		x.Params = nil
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		x.Interval = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		x.IntervalUnit = 0
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		x.MaxRuns = uint64(0)
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		x.InitialDeposit = nil
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		x.Sender = ""
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8122,19 +8635,64 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.authority":
-		value := x.Authority
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
+		value := x.ClientId
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateSubscription_6_list{})
+		}
+		listValue := &_MsgCreateSubscription_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		value := x.IntervalUnit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		value := x.MaxRuns
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		if len(x.InitialDeposit) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateSubscription_13_list{})
+		}
+		listValue := &_MsgCreateSubscription_13_list{list: &x.InitialDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8148,17 +8706,47 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "band.oracle.v1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		lv := value.List()
+		clv := lv.(*_MsgCreateSubscription_6_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		x.Interval = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		x.IntervalUnit = (IntervalUnit)(value.Enum())
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		x.MaxRuns = value.Uint()
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		lv := value.List()
+		clv := lv.(*_MsgCreateSubscription_13_list)
+		x.InitialDeposit = *clv.list
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		x.Sender = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8172,48 +8760,106 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "band.oracle.v1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message band.oracle.v1.MsgUpdateParams is not mutable"))
+		value := &_MsgCreateSubscription_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		if x.InitialDeposit == nil {
+			x.InitialDeposit = []*v1beta1.Coin{}
+		}
+		value := &_MsgCreateSubscription_13_list{list: &x.InitialDeposit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		panic(fmt.Errorf("field interval of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		panic(fmt.Errorf("field interval_unit of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		panic(fmt.Errorf("field max_runs of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCreateSubscription is not mutable"))
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.MsgCreateSubscription is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgUpdateParams.authority":
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.MsgCreateSubscription.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.client_id":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.MsgCreateSubscription.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCreateSubscription_6_list{list: &list})
+	case "band.oracle.v1.MsgCreateSubscription.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgCreateSubscription.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.interval_unit":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgCreateSubscription.max_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCreateSubscription.initial_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCreateSubscription_13_list{list: &list})
+	case "band.oracle.v1.MsgCreateSubscription.sender":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateSubscription.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscription"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscription does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateSubscription", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8221,7 +8867,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateSubscription) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8232,7 +8878,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateSubscription) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8244,7 +8890,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgCreateSubscription) IsValid() bool {
 	return x != nil
 }
 
@@ -8254,9 +8900,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateSubscription) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgCreateSubscription)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8268,14 +8914,60 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.IntervalUnit != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalUnit))
+		}
+		if x.MaxRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRuns))
+		}
+		if len(x.InitialDeposit) > 0 {
+			for _, e := range x.InitialDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Sender)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8286,7 +8978,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgCreateSubscription)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8305,16 +8997,3685 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.InitialDeposit) > 0 {
+			for iNdEx := len(x.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.MaxRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRuns))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IntervalUnit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalUnit))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
+				}
+				x.IntervalUnit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalUnit |= IntervalUnit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+				}
+				x.MaxRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialDeposit = append(x.InitialDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialDeposit[len(x.InitialDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateSubscriptionResponse                 protoreflect.MessageDescriptor
+	fd_MsgCreateSubscriptionResponse_subscription_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgCreateSubscriptionResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateSubscriptionResponse")
+	fd_MsgCreateSubscriptionResponse_subscription_id = md_MsgCreateSubscriptionResponse.Fields().ByName("subscription_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateSubscriptionResponse)(nil)
+
+type fastReflection_MsgCreateSubscriptionResponse MsgCreateSubscriptionResponse
+
+func (x *MsgCreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateSubscriptionResponse)(x)
+}
+
+func (x *MsgCreateSubscriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateSubscriptionResponse_messageType fastReflection_MsgCreateSubscriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateSubscriptionResponse_messageType{}
+
+type fastReflection_MsgCreateSubscriptionResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateSubscriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateSubscriptionResponse)(nil)
+}
+func (x fastReflection_MsgCreateSubscriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSubscriptionResponse)
+}
+func (x fastReflection_MsgCreateSubscriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSubscriptionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSubscriptionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateSubscriptionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateSubscriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSubscriptionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateSubscriptionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionId)
+		if !f(fd_MsgCreateSubscriptionResponse_subscription_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		return x.SubscriptionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		x.SubscriptionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		value := x.SubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		x.SubscriptionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSubscriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		panic(fmt.Errorf("field subscription_id of message band.oracle.v1.MsgCreateSubscriptionResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateSubscriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateSubscriptionResponse.subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateSubscriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateSubscriptionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateSubscriptionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSubscriptionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateSubscriptionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateSubscriptionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateSubscriptionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSubscriptionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSubscriptionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSubscriptionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+				}
+				x.SubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgUpdateSubscription_7_list)(nil)
+
+type _MsgUpdateSubscription_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgUpdateSubscription_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateSubscription_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateSubscription_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateSubscription_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateSubscription_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSubscription_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateSubscription_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSubscription_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgUpdateSubscription                       protoreflect.MessageDescriptor
+	fd_MsgUpdateSubscription_subscription_id       protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_oracle_script_id      protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_calldata              protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_ask_count             protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_min_count             protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_client_id             protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_fee_limit             protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_prepare_gas           protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_execute_gas           protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_tss_encoder           protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_interval              protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_interval_unit         protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_max_runs              protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_sender                protoreflect.FieldDescriptor
+	fd_MsgUpdateSubscription_oracle_script_version protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgUpdateSubscription = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateSubscription")
+	fd_MsgUpdateSubscription_subscription_id = md_MsgUpdateSubscription.Fields().ByName("subscription_id")
+	fd_MsgUpdateSubscription_oracle_script_id = md_MsgUpdateSubscription.Fields().ByName("oracle_script_id")
+	fd_MsgUpdateSubscription_calldata = md_MsgUpdateSubscription.Fields().ByName("calldata")
+	fd_MsgUpdateSubscription_ask_count = md_MsgUpdateSubscription.Fields().ByName("ask_count")
+	fd_MsgUpdateSubscription_min_count = md_MsgUpdateSubscription.Fields().ByName("min_count")
+	fd_MsgUpdateSubscription_client_id = md_MsgUpdateSubscription.Fields().ByName("client_id")
+	fd_MsgUpdateSubscription_fee_limit = md_MsgUpdateSubscription.Fields().ByName("fee_limit")
+	fd_MsgUpdateSubscription_prepare_gas = md_MsgUpdateSubscription.Fields().ByName("prepare_gas")
+	fd_MsgUpdateSubscription_execute_gas = md_MsgUpdateSubscription.Fields().ByName("execute_gas")
+	fd_MsgUpdateSubscription_tss_encoder = md_MsgUpdateSubscription.Fields().ByName("tss_encoder")
+	fd_MsgUpdateSubscription_interval = md_MsgUpdateSubscription.Fields().ByName("interval")
+	fd_MsgUpdateSubscription_interval_unit = md_MsgUpdateSubscription.Fields().ByName("interval_unit")
+	fd_MsgUpdateSubscription_max_runs = md_MsgUpdateSubscription.Fields().ByName("max_runs")
+	fd_MsgUpdateSubscription_sender = md_MsgUpdateSubscription.Fields().ByName("sender")
+	fd_MsgUpdateSubscription_oracle_script_version = md_MsgUpdateSubscription.Fields().ByName("oracle_script_version")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateSubscription)(nil)
+
+type fastReflection_MsgUpdateSubscription MsgUpdateSubscription
+
+func (x *MsgUpdateSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSubscription)(x)
+}
+
+func (x *MsgUpdateSubscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateSubscription_messageType fastReflection_MsgUpdateSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateSubscription_messageType{}
+
+type fastReflection_MsgUpdateSubscription_messageType struct{}
+
+func (x fastReflection_MsgUpdateSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSubscription)(nil)
+}
+func (x fastReflection_MsgUpdateSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSubscription)
+}
+func (x fastReflection_MsgUpdateSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSubscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSubscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateSubscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateSubscription) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSubscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateSubscription) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateSubscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionId)
+		if !f(fd_MsgUpdateSubscription_subscription_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_MsgUpdateSubscription_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_MsgUpdateSubscription_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_MsgUpdateSubscription_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_MsgUpdateSubscription_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_MsgUpdateSubscription_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateSubscription_7_list{list: &x.FeeLimit})
+		if !f(fd_MsgUpdateSubscription_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_MsgUpdateSubscription_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_MsgUpdateSubscription_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_MsgUpdateSubscription_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_MsgUpdateSubscription_interval, value) {
+			return
+		}
+	}
+	if x.IntervalUnit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.IntervalUnit))
+		if !f(fd_MsgUpdateSubscription_interval_unit, value) {
+			return
+		}
+	}
+	if x.MaxRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRuns)
+		if !f(fd_MsgUpdateSubscription_max_runs, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgUpdateSubscription_sender, value) {
+			return
+		}
+	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_MsgUpdateSubscription_oracle_script_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateSubscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		return x.SubscriptionId != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		return x.Interval != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		return x.IntervalUnit != 0
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		return x.MaxRuns != uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		return x.Sender != ""
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		x.SubscriptionId = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		x.Interval = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		x.IntervalUnit = 0
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		x.MaxRuns = uint64(0)
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		x.Sender = ""
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		value := x.SubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateSubscription_7_list{})
+		}
+		listValue := &_MsgUpdateSubscription_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		value := x.IntervalUnit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		value := x.MaxRuns
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		x.SubscriptionId = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateSubscription_7_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		x.Interval = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		x.IntervalUnit = (IntervalUnit)(value.Enum())
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		x.MaxRuns = value.Uint()
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		x.Sender = value.Interface().(string)
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_MsgUpdateSubscription_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		panic(fmt.Errorf("field subscription_id of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		panic(fmt.Errorf("field interval of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		panic(fmt.Errorf("field interval_unit of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		panic(fmt.Errorf("field max_runs of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.MsgUpdateSubscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateSubscription.subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.MsgUpdateSubscription.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgUpdateSubscription.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgUpdateSubscription_7_list{list: &list})
+	case "band.oracle.v1.MsgUpdateSubscription.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgUpdateSubscription.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.interval_unit":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgUpdateSubscription.max_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateSubscription.sender":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgUpdateSubscription.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateSubscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateSubscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateSubscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateSubscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateSubscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionId))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.IntervalUnit != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalUnit))
+		}
+		if x.MaxRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRuns))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateSubscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.MaxRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRuns))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.IntervalUnit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalUnit))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+				}
+				x.SubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
+				}
+				x.IntervalUnit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalUnit |= IntervalUnit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+				}
+				x.MaxRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateSubscriptionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgUpdateSubscriptionResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateSubscriptionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateSubscriptionResponse)(nil)
+
+type fastReflection_MsgUpdateSubscriptionResponse MsgUpdateSubscriptionResponse
+
+func (x *MsgUpdateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSubscriptionResponse)(x)
+}
+
+func (x *MsgUpdateSubscriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateSubscriptionResponse_messageType fastReflection_MsgUpdateSubscriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateSubscriptionResponse_messageType{}
+
+type fastReflection_MsgUpdateSubscriptionResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateSubscriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSubscriptionResponse)(nil)
+}
+func (x fastReflection_MsgUpdateSubscriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSubscriptionResponse)
+}
+func (x fastReflection_MsgUpdateSubscriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSubscriptionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSubscriptionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateSubscriptionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSubscriptionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateSubscriptionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateSubscriptionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateSubscriptionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateSubscriptionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateSubscriptionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateSubscriptionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSubscriptionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelSubscription                 protoreflect.MessageDescriptor
+	fd_MsgCancelSubscription_subscription_id protoreflect.FieldDescriptor
+	fd_MsgCancelSubscription_sender          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgCancelSubscription = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCancelSubscription")
+	fd_MsgCancelSubscription_subscription_id = md_MsgCancelSubscription.Fields().ByName("subscription_id")
+	fd_MsgCancelSubscription_sender = md_MsgCancelSubscription.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelSubscription)(nil)
+
+type fastReflection_MsgCancelSubscription MsgCancelSubscription
+
+func (x *MsgCancelSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelSubscription)(x)
+}
+
+func (x *MsgCancelSubscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelSubscription_messageType fastReflection_MsgCancelSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelSubscription_messageType{}
+
+type fastReflection_MsgCancelSubscription_messageType struct{}
+
+func (x fastReflection_MsgCancelSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelSubscription)(nil)
+}
+func (x fastReflection_MsgCancelSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelSubscription)
+}
+func (x fastReflection_MsgCancelSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelSubscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelSubscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelSubscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelSubscription) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelSubscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelSubscription) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelSubscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionId)
+		if !f(fd_MsgCancelSubscription_subscription_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCancelSubscription_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelSubscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		return x.SubscriptionId != uint64(0)
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		x.SubscriptionId = uint64(0)
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		value := x.SubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		x.SubscriptionId = value.Uint()
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		panic(fmt.Errorf("field subscription_id of message band.oracle.v1.MsgCancelSubscription is not mutable"))
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCancelSubscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCancelSubscription.subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCancelSubscription.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCancelSubscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelSubscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelSubscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelSubscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelSubscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionId))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelSubscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+				}
+				x.SubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelSubscriptionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgCancelSubscriptionResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCancelSubscriptionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelSubscriptionResponse)(nil)
+
+type fastReflection_MsgCancelSubscriptionResponse MsgCancelSubscriptionResponse
+
+func (x *MsgCancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelSubscriptionResponse)(x)
+}
+
+func (x *MsgCancelSubscriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelSubscriptionResponse_messageType fastReflection_MsgCancelSubscriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelSubscriptionResponse_messageType{}
+
+type fastReflection_MsgCancelSubscriptionResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelSubscriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelSubscriptionResponse)(nil)
+}
+func (x fastReflection_MsgCancelSubscriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelSubscriptionResponse)
+}
+func (x fastReflection_MsgCancelSubscriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelSubscriptionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelSubscriptionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelSubscriptionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelSubscriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelSubscriptionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelSubscriptionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelSubscriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCancelSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCancelSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelSubscriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCancelSubscriptionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelSubscriptionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelSubscriptionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelSubscriptionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelSubscriptionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelSubscriptionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelSubscriptionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelSubscriptionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelSubscriptionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgUpdateParams = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "band.oracle.v1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "band.oracle.v1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "band.oracle.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message band.oracle.v1.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
@@ -8496,7 +12857,7 @@ func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8875,6 +13236,12 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TssEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,11,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// Callback is the result callback of a module on BandChain to run when the
+	// request is resolved or expired, if any.
+	Callback *RequestCallback `protobuf:"bytes,12,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (x *MsgRequestData) Reset() {
@@ -8960,11 +13327,25 @@ func (x *MsgRequestData) GetSender() string {
 	return ""
 }
 
-func (x *MsgRequestData) GetTssEncoder() Encoder {
+func (x *MsgRequestData) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *MsgRequestData) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+func (x *MsgRequestData) GetCallback() *RequestCallback {
 	if x != nil {
-		return x.TssEncoder
+		return x.Callback
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return nil
 }
 
 // MsgRequestDataResponse is response data for MsgRequestData message
@@ -9223,430 +13604,930 @@ type MsgEditDataSource struct {
 	// Owner is the address who is allowed to make further changes to the data
 	// source.
 	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Sender is the signer of this message. Must be the current data source's
-	// owner.
+	// Sender is the signer of this message. Must be the current data source's
+	// owner.
+	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Changelog is an optional description of the changes, which is recorded
+	// in the new version.
+	Changelog string `protobuf:"bytes,9,opt,name=changelog,proto3" json:"changelog,omitempty"`
+}
+
+func (x *MsgEditDataSource) Reset() {
+	*x = MsgEditDataSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditDataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditDataSource) ProtoMessage() {}
+
+// Deprecated: Use MsgEditDataSource.ProtoReflect.Descriptor instead.
+func (*MsgEditDataSource) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgEditDataSource) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *MsgEditDataSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgEditDataSource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MsgEditDataSource) GetExecutable() []byte {
+	if x != nil {
+		return x.Executable
+	}
+	return nil
+}
+
+func (x *MsgEditDataSource) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *MsgEditDataSource) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *MsgEditDataSource) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *MsgEditDataSource) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgEditDataSource) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+// MsgEditDataSourceResponse is response data for MsgEditDataSource message
+type MsgEditDataSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgEditDataSourceResponse) Reset() {
+	*x = MsgEditDataSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditDataSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditDataSourceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgEditDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*MsgEditDataSourceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgCreateOracleScript is a message for creating an oracle script.
+type MsgCreateOracleScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the name of this oracle script used for display (optional).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is the description of this oracle script used for display
+	// (optional).
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Schema is the OBI schema of this oracle script (optional).
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the absolute URI to the script's source code (optional).
+	SourceCodeUrl string `protobuf:"bytes,4,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Code is the oracle WebAssembly binary code. Can be raw of gzip compressed.
+	Code []byte `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	// Owner is the address who is allowed to make further changes to the oracle
+	// script.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Sender is the signer of this message.
+	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *MsgCreateOracleScript) Reset() {
+	*x = MsgCreateOracleScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateOracleScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateOracleScript) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateOracleScript.ProtoReflect.Descriptor instead.
+func (*MsgCreateOracleScript) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgCreateOracleScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgCreateOracleScript) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MsgCreateOracleScript) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *MsgCreateOracleScript) GetSourceCodeUrl() string {
+	if x != nil {
+		return x.SourceCodeUrl
+	}
+	return ""
+}
+
+func (x *MsgCreateOracleScript) GetCode() []byte {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *MsgCreateOracleScript) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *MsgCreateOracleScript) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// MsgCreateOracleScriptResponse is response data for MsgCreateOracleScript
+// message
+type MsgCreateOracleScriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCreateOracleScriptResponse) Reset() {
+	*x = MsgCreateOracleScriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateOracleScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateOracleScriptResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateOracleScriptResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateOracleScriptResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgEditOracleScript is a message for editing an existing oracle script.
+type MsgEditOracleScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is the unique identifier of the oracle script to be edited.
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Name is the name of this oracle script used for display (optional).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is the description of this oracle script used for display
+	// (optional).
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Schema is the OBI schema of this oracle script (optional).
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the absolute URI to the script's source code (optional).
+	SourceCodeUrl string `protobuf:"bytes,5,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Code is the oracle WebAssembly binary code. Can be raw of gzip compressed.
+	Code []byte `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// Owner is an account address who is allowed to make further changes to the
+	// oracle script.
+	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Sender is an account address who sign this message. Must be the current
+	// oracle script's owner.
 	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Changelog is an optional description of the changes, which is recorded
+	// in the new version.
+	Changelog string `protobuf:"bytes,9,opt,name=changelog,proto3" json:"changelog,omitempty"`
 }
 
-func (x *MsgEditDataSource) Reset() {
-	*x = MsgEditDataSource{}
+func (x *MsgEditOracleScript) Reset() {
+	*x = MsgEditOracleScript{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEditDataSource) String() string {
+func (x *MsgEditOracleScript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEditDataSource) ProtoMessage() {}
+func (*MsgEditOracleScript) ProtoMessage() {}
 
-// Deprecated: Use MsgEditDataSource.ProtoReflect.Descriptor instead.
-func (*MsgEditDataSource) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgEditOracleScript.ProtoReflect.Descriptor instead.
+func (*MsgEditOracleScript) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgEditDataSource) GetDataSourceId() uint64 {
+func (x *MsgEditOracleScript) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.DataSourceId
+		return x.OracleScriptId
 	}
 	return 0
 }
 
-func (x *MsgEditDataSource) GetName() string {
+func (x *MsgEditOracleScript) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgEditDataSource) GetDescription() string {
+func (x *MsgEditOracleScript) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *MsgEditDataSource) GetExecutable() []byte {
+func (x *MsgEditOracleScript) GetSchema() string {
 	if x != nil {
-		return x.Executable
+		return x.Schema
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgEditDataSource) GetFee() []*v1beta1.Coin {
+func (x *MsgEditOracleScript) GetSourceCodeUrl() string {
 	if x != nil {
-		return x.Fee
+		return x.SourceCodeUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgEditDataSource) GetTreasury() string {
+func (x *MsgEditOracleScript) GetCode() []byte {
 	if x != nil {
-		return x.Treasury
+		return x.Code
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgEditDataSource) GetOwner() string {
+func (x *MsgEditOracleScript) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *MsgEditDataSource) GetSender() string {
+func (x *MsgEditOracleScript) GetSender() string {
 	if x != nil {
 		return x.Sender
 	}
 	return ""
 }
 
-// MsgEditDataSourceResponse is response data for MsgEditDataSource message
-type MsgEditDataSourceResponse struct {
+func (x *MsgEditOracleScript) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+// MsgEditOracleScriptResponse is response data for MsgEditOracleScript message
+type MsgEditOracleScriptResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgEditDataSourceResponse) Reset() {
-	*x = MsgEditDataSourceResponse{}
+func (x *MsgEditOracleScriptResponse) Reset() {
+	*x = MsgEditOracleScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEditDataSourceResponse) String() string {
+func (x *MsgEditOracleScriptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEditDataSourceResponse) ProtoMessage() {}
+func (*MsgEditOracleScriptResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgEditDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*MsgEditDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgEditOracleScriptResponse.ProtoReflect.Descriptor instead.
+func (*MsgEditOracleScriptResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-// MsgCreateOracleScript is a message for creating an oracle script.
-type MsgCreateOracleScript struct {
+// MsgEditOracleScript is a message for activating a validator to become an
+// oracle provider. However, the activation can be revoked once the validator
+// is unable to provide data to fulfill requests
+type MsgActivate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name is the name of this oracle script used for display (optional).
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is the description of this oracle script used for display
-	// (optional).
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// Schema is the OBI schema of this oracle script (optional).
-	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
-	// SourceCodeURL is the absolute URI to the script's source code (optional).
-	SourceCodeUrl string `protobuf:"bytes,4,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
-	// Code is the oracle WebAssembly binary code. Can be raw of gzip compressed.
-	Code []byte `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
-	// Owner is the address who is allowed to make further changes to the oracle
-	// script.
-	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Sender is the signer of this message.
-	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Validator is the validator address who sign this message and request to be
+	// activated.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (x *MsgCreateOracleScript) Reset() {
-	*x = MsgCreateOracleScript{}
+func (x *MsgActivate) Reset() {
+	*x = MsgActivate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOracleScript) String() string {
+func (x *MsgActivate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOracleScript) ProtoMessage() {}
+func (*MsgActivate) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateOracleScript.ProtoReflect.Descriptor instead.
-func (*MsgCreateOracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgActivate.ProtoReflect.Descriptor instead.
+func (*MsgActivate) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgCreateOracleScript) GetName() string {
+func (x *MsgActivate) GetValidator() string {
 	if x != nil {
-		return x.Name
+		return x.Validator
 	}
 	return ""
 }
 
-func (x *MsgCreateOracleScript) GetDescription() string {
+// MsgActivateResponse is response data for MsgActivate message
+type MsgActivateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgActivateResponse) Reset() {
+	*x = MsgActivateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgActivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgActivateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgActivateResponse.ProtoReflect.Descriptor instead.
+func (*MsgActivateResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgCreateSubscription is a message for creating a new subscription.
+type MsgCreateSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientId string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,11,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,12,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// InitialDeposit is the amount of tokens sent from the sender to the
+	// deposit account of the subscription.
+	InitialDeposit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit,omitempty"`
+	// Sender is the signer of this message and the owner of the subscription.
+	Sender string `protobuf:"bytes,14,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (x *MsgCreateSubscription) Reset() {
+	*x = MsgCreateSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateSubscription) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateSubscription.ProtoReflect.Descriptor instead.
+func (*MsgCreateSubscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgCreateSubscription) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.Description
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgCreateOracleScript) GetSchema() string {
+func (x *MsgCreateSubscription) GetCalldata() []byte {
 	if x != nil {
-		return x.Schema
+		return x.Calldata
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgCreateOracleScript) GetSourceCodeUrl() string {
+func (x *MsgCreateSubscription) GetAskCount() uint64 {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *MsgCreateSubscription) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *MsgCreateSubscription) GetClientId() string {
+	if x != nil {
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *MsgCreateOracleScript) GetCode() []byte {
+func (x *MsgCreateSubscription) GetFeeLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.Code
+		return x.FeeLimit
 	}
 	return nil
 }
 
-func (x *MsgCreateOracleScript) GetOwner() string {
+func (x *MsgCreateSubscription) GetPrepareGas() uint64 {
 	if x != nil {
-		return x.Owner
+		return x.PrepareGas
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgCreateOracleScript) GetSender() string {
+func (x *MsgCreateSubscription) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
+	}
+	return 0
+}
+
+func (x *MsgCreateSubscription) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *MsgCreateSubscription) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MsgCreateSubscription) GetIntervalUnit() IntervalUnit {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return IntervalUnit_INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (x *MsgCreateSubscription) GetMaxRuns() uint64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *MsgCreateSubscription) GetInitialDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.InitialDeposit
+	}
+	return nil
+}
+
+func (x *MsgCreateSubscription) GetSender() string {
 	if x != nil {
 		return x.Sender
 	}
 	return ""
 }
 
-// MsgCreateOracleScriptResponse is response data for MsgCreateOracleScript
+func (x *MsgCreateSubscription) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+// MsgCreateSubscriptionResponse is response data for MsgCreateSubscription
 // message
-type MsgCreateOracleScriptResponse struct {
+type MsgCreateSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID is the ID of the created subscription.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (x *MsgCreateOracleScriptResponse) Reset() {
-	*x = MsgCreateOracleScriptResponse{}
+func (x *MsgCreateSubscriptionResponse) Reset() {
+	*x = MsgCreateSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOracleScriptResponse) String() string {
+func (x *MsgCreateSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOracleScriptResponse) ProtoMessage() {}
+func (*MsgCreateSubscriptionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateOracleScriptResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgCreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-// MsgEditOracleScript is a message for editing an existing oracle script.
-type MsgEditOracleScript struct {
+func (x *MsgCreateSubscriptionResponse) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+// MsgUpdateSubscription is a message for updating an existing subscription. A
+// paused subscription becomes active again once it is updated.
+type MsgUpdateSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is the unique identifier of the oracle script to be edited.
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Name is the name of this oracle script used for display (optional).
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is the description of this oracle script used for display
-	// (optional).
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Schema is the OBI schema of this oracle script (optional).
-	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	// SourceCodeURL is the absolute URI to the script's source code (optional).
-	SourceCodeUrl string `protobuf:"bytes,5,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
-	// Code is the oracle WebAssembly binary code. Can be raw of gzip compressed.
-	Code []byte `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
-	// Owner is an account address who is allowed to make further changes to the
-	// oracle script.
-	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Sender is an account address who sign this message. Must be the current
-	// oracle script's owner.
-	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
-}
-
-func (x *MsgEditOracleScript) Reset() {
-	*x = MsgEditOracleScript{}
+	// SubscriptionID is the unique identifier of the subscription to be updated.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,12,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,13,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Sender is the signer of this message. It must be the owner of the
+	// subscription.
+	Sender string `protobuf:"bytes,14,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (x *MsgUpdateSubscription) Reset() {
+	*x = MsgUpdateSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEditOracleScript) String() string {
+func (x *MsgUpdateSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEditOracleScript) ProtoMessage() {}
+func (*MsgUpdateSubscription) ProtoMessage() {}
 
-// Deprecated: Use MsgEditOracleScript.ProtoReflect.Descriptor instead.
-func (*MsgEditOracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgUpdateSubscription.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSubscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgEditOracleScript) GetOracleScriptId() uint64 {
+func (x *MsgUpdateSubscription) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *MsgUpdateSubscription) GetOracleScriptId() uint64 {
 	if x != nil {
 		return x.OracleScriptId
 	}
 	return 0
 }
 
-func (x *MsgEditOracleScript) GetName() string {
+func (x *MsgUpdateSubscription) GetCalldata() []byte {
 	if x != nil {
-		return x.Name
+		return x.Calldata
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgEditOracleScript) GetDescription() string {
+func (x *MsgUpdateSubscription) GetAskCount() uint64 {
 	if x != nil {
-		return x.Description
+		return x.AskCount
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgEditOracleScript) GetSchema() string {
+func (x *MsgUpdateSubscription) GetMinCount() uint64 {
 	if x != nil {
-		return x.Schema
+		return x.MinCount
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgEditOracleScript) GetSourceCodeUrl() string {
+func (x *MsgUpdateSubscription) GetClientId() string {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *MsgEditOracleScript) GetCode() []byte {
+func (x *MsgUpdateSubscription) GetFeeLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.Code
+		return x.FeeLimit
 	}
 	return nil
 }
 
-func (x *MsgEditOracleScript) GetOwner() string {
+func (x *MsgUpdateSubscription) GetPrepareGas() uint64 {
 	if x != nil {
-		return x.Owner
+		return x.PrepareGas
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgEditOracleScript) GetSender() string {
+func (x *MsgUpdateSubscription) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
+	}
+	return 0
+}
+
+func (x *MsgUpdateSubscription) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
+	}
+	return Encoder_ENCODER_UNSPECIFIED
+}
+
+func (x *MsgUpdateSubscription) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MsgUpdateSubscription) GetIntervalUnit() IntervalUnit {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return IntervalUnit_INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (x *MsgUpdateSubscription) GetMaxRuns() uint64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *MsgUpdateSubscription) GetSender() string {
 	if x != nil {
 		return x.Sender
 	}
 	return ""
 }
 
-// MsgEditOracleScriptResponse is response data for MsgEditOracleScript message
-type MsgEditOracleScriptResponse struct {
+func (x *MsgUpdateSubscription) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+// MsgUpdateSubscriptionResponse is response data for MsgUpdateSubscription
+// message
+type MsgUpdateSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgEditOracleScriptResponse) Reset() {
-	*x = MsgEditOracleScriptResponse{}
+func (x *MsgUpdateSubscriptionResponse) Reset() {
+	*x = MsgUpdateSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEditOracleScriptResponse) String() string {
+func (x *MsgUpdateSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEditOracleScriptResponse) ProtoMessage() {}
+func (*MsgUpdateSubscriptionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgEditOracleScriptResponse.ProtoReflect.Descriptor instead.
-func (*MsgEditOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgUpdateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// MsgEditOracleScript is a message for activating a validator to become an
-// oracle provider. However, the activation can be revoked once the validator
-// is unable to provide data to fulfill requests
-type MsgActivate struct {
+// MsgCancelSubscription is a message for cancelling an existing subscription.
+// The remaining deposit is refunded to the owner.
+type MsgCancelSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Validator is the validator address who sign this message and request to be
-	// activated.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// SubscriptionID is the unique identifier of the subscription to be
+	// cancelled.
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Sender is the signer of this message. It must be the owner of the
+	// subscription.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *MsgActivate) Reset() {
-	*x = MsgActivate{}
+func (x *MsgCancelSubscription) Reset() {
+	*x = MsgCancelSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgActivate) String() string {
+func (x *MsgCancelSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgActivate) ProtoMessage() {}
+func (*MsgCancelSubscription) ProtoMessage() {}
 
-// Deprecated: Use MsgActivate.ProtoReflect.Descriptor instead.
-func (*MsgActivate) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgCancelSubscription.ProtoReflect.Descriptor instead.
+func (*MsgCancelSubscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgActivate) GetValidator() string {
+func (x *MsgCancelSubscription) GetSubscriptionId() uint64 {
 	if x != nil {
-		return x.Validator
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *MsgCancelSubscription) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
 	return ""
 }
 
-// MsgActivateResponse is response data for MsgActivate message
-type MsgActivateResponse struct {
+// MsgCancelSubscriptionResponse is response data for MsgCancelSubscription
+// message
+type MsgCancelSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgActivateResponse) Reset() {
-	*x = MsgActivateResponse{}
+func (x *MsgCancelSubscriptionResponse) Reset() {
+	*x = MsgCancelSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgActivateResponse) String() string {
+func (x *MsgCancelSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgActivateResponse) ProtoMessage() {}
+func (*MsgCancelSubscriptionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgActivateResponse.ProtoReflect.Descriptor instead.
-func (*MsgActivateResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgCancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
@@ -9668,7 +14549,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9682,7 +14563,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -9712,7 +14593,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9726,7 +14607,7 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 var File_band_oracle_v1_tx_proto protoreflect.FileDescriptor
@@ -9744,7 +14625,7 @@ var file_band_oracle_v1_tx_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xad, 0x04, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x05, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
@@ -9776,217 +14657,379 @@ var file_band_oracle_v1_tx_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
 	0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a,
-	0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3, 0x01,
-	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61,
-	0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x09,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x24, 0xe8,
-	0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x03, 0x0a,
-	0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65,
-	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12,
-	0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3,
+	0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x72,
+	0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x24,
+	0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x03,
+	0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x1d,
-	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd3, 0x03,
-	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f,
-	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
-	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7,
-	0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xc5, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1,
+	0x03, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74,
+	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d, 0xe8, 0xa0, 0x1f, 0x01, 0x82,
-	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x13, 0x4d, 0x73,
-	0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa,
-	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
-	0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xc5, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7,
+	0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x13, 0x4d, 0x73, 0x67,
+	0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39,
+	0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x3a, 0x2b, 0xe8,
+	0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x0b, 0x4d, 0x73, 0x67,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d,
+	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x26, 0xe8, 0xa0, 0x1f, 0x01, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe8, 0x06, 0x0a, 0x15, 0x4d, 0x73, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12,
+	0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2,
+	0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74,
+	0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x75, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52,
+	0x75, 0x6e, 0x73, 0x12, 0x79, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69,
-	0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1d, 0x0a,
-	0x1b, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x0b,
-	0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
-	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x26, 0xe8, 0xa0,
-	0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x26, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xf7, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x52, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x25, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x2b, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x45, 0x64, 0x69,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x29,
+	0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x2d, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2,
+	0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0xbc, 0x06, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a,
+	0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73,
+	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x41, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x3a, 0x2d, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d,
+	0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a,
+	0x1d, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7,
+	0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x3a, 0x26, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xbb, 0x08, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x0b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a,
 	0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64,
-	0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2b,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a,
+	0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e,
+	0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb5, 0x01, 0x0a, 0x12, 0x63,
-	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58,
-	0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a,
+	0x01, 0x42, 0xb5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -10001,7 +15044,7 @@ func file_band_oracle_v1_tx_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_tx_proto_rawDescData
 }
 
-var file_band_oracle_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_band_oracle_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_band_oracle_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRequestData)(nil),                // 0: band.oracle.v1.MsgRequestData
 	(*MsgRequestDataResponse)(nil),        // 1: band.oracle.v1.MsgRequestDataResponse
@@ -10017,41 +15060,63 @@ var file_band_oracle_v1_tx_proto_goTypes = []interface{}{
 	(*MsgEditOracleScriptResponse)(nil),   // 11: band.oracle.v1.MsgEditOracleScriptResponse
 	(*MsgActivate)(nil),                   // 12: band.oracle.v1.MsgActivate
 	(*MsgActivateResponse)(nil),           // 13: band.oracle.v1.MsgActivateResponse
-	(*MsgUpdateParams)(nil),               // 14: band.oracle.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),       // 15: band.oracle.v1.MsgUpdateParamsResponse
-	(*v1beta1.Coin)(nil),                  // 16: cosmos.base.v1beta1.Coin
-	(Encoder)(0),                          // 17: band.oracle.v1.Encoder
-	(*RawReport)(nil),                     // 18: band.oracle.v1.RawReport
-	(*Params)(nil),                        // 19: band.oracle.v1.Params
+	(*MsgCreateSubscription)(nil),         // 14: band.oracle.v1.MsgCreateSubscription
+	(*MsgCreateSubscriptionResponse)(nil), // 15: band.oracle.v1.MsgCreateSubscriptionResponse
+	(*MsgUpdateSubscription)(nil),         // 16: band.oracle.v1.MsgUpdateSubscription
+	(*MsgUpdateSubscriptionResponse)(nil), // 17: band.oracle.v1.MsgUpdateSubscriptionResponse
+	(*MsgCancelSubscription)(nil),         // 18: band.oracle.v1.MsgCancelSubscription
+	(*MsgCancelSubscriptionResponse)(nil), // 19: band.oracle.v1.MsgCancelSubscriptionResponse
+	(*MsgUpdateParams)(nil),               // 20: band.oracle.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),       // 21: band.oracle.v1.MsgUpdateParamsResponse
+	(*v1beta1.Coin)(nil),                  // 22: cosmos.base.v1beta1.Coin
+	(Encoder)(0),                          // 23: band.oracle.v1.Encoder
+	(*RequestCallback)(nil),               // 24: band.oracle.v1.RequestCallback
+	(*RawReport)(nil),                     // 25: band.oracle.v1.RawReport
+	(IntervalUnit)(0),                     // 26: band.oracle.v1.IntervalUnit
+	(*Params)(nil),                        // 27: band.oracle.v1.Params
 }
 var file_band_oracle_v1_tx_proto_depIdxs = []int32{
-	16, // 0: band.oracle.v1.MsgRequestData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	17, // 1: band.oracle.v1.MsgRequestData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	18, // 2: band.oracle.v1.MsgReportData.raw_reports:type_name -> band.oracle.v1.RawReport
-	16, // 3: band.oracle.v1.MsgCreateDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	16, // 4: band.oracle.v1.MsgEditDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	19, // 5: band.oracle.v1.MsgUpdateParams.params:type_name -> band.oracle.v1.Params
-	0,  // 6: band.oracle.v1.Msg.RequestData:input_type -> band.oracle.v1.MsgRequestData
-	2,  // 7: band.oracle.v1.Msg.ReportData:input_type -> band.oracle.v1.MsgReportData
-	4,  // 8: band.oracle.v1.Msg.CreateDataSource:input_type -> band.oracle.v1.MsgCreateDataSource
-	6,  // 9: band.oracle.v1.Msg.EditDataSource:input_type -> band.oracle.v1.MsgEditDataSource
-	8,  // 10: band.oracle.v1.Msg.CreateOracleScript:input_type -> band.oracle.v1.MsgCreateOracleScript
-	10, // 11: band.oracle.v1.Msg.EditOracleScript:input_type -> band.oracle.v1.MsgEditOracleScript
-	12, // 12: band.oracle.v1.Msg.Activate:input_type -> band.oracle.v1.MsgActivate
-	14, // 13: band.oracle.v1.Msg.UpdateParams:input_type -> band.oracle.v1.MsgUpdateParams
-	1,  // 14: band.oracle.v1.Msg.RequestData:output_type -> band.oracle.v1.MsgRequestDataResponse
-	3,  // 15: band.oracle.v1.Msg.ReportData:output_type -> band.oracle.v1.MsgReportDataResponse
-	5,  // 16: band.oracle.v1.Msg.CreateDataSource:output_type -> band.oracle.v1.MsgCreateDataSourceResponse
-	7,  // 17: band.oracle.v1.Msg.EditDataSource:output_type -> band.oracle.v1.MsgEditDataSourceResponse
-	9,  // 18: band.oracle.v1.Msg.CreateOracleScript:output_type -> band.oracle.v1.MsgCreateOracleScriptResponse
-	11, // 19: band.oracle.v1.Msg.EditOracleScript:output_type -> band.oracle.v1.MsgEditOracleScriptResponse
-	13, // 20: band.oracle.v1.Msg.Activate:output_type -> band.oracle.v1.MsgActivateResponse
-	15, // 21: band.oracle.v1.Msg.UpdateParams:output_type -> band.oracle.v1.MsgUpdateParamsResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	22, // 0: band.oracle.v1.MsgRequestData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	23, // 1: band.oracle.v1.MsgRequestData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	24, // 2: band.oracle.v1.MsgRequestData.callback:type_name -> band.oracle.v1.RequestCallback
+	25, // 3: band.oracle.v1.MsgReportData.raw_reports:type_name -> band.oracle.v1.RawReport
+	22, // 4: band.oracle.v1.MsgCreateDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	22, // 5: band.oracle.v1.MsgEditDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	22, // 6: band.oracle.v1.MsgCreateSubscription.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	23, // 7: band.oracle.v1.MsgCreateSubscription.tss_encoder:type_name -> band.oracle.v1.Encoder
+	26, // 8: band.oracle.v1.MsgCreateSubscription.interval_unit:type_name -> band.oracle.v1.IntervalUnit
+	22, // 9: band.oracle.v1.MsgCreateSubscription.initial_deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 10: band.oracle.v1.MsgUpdateSubscription.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	23, // 11: band.oracle.v1.MsgUpdateSubscription.tss_encoder:type_name -> band.oracle.v1.Encoder
+	26, // 12: band.oracle.v1.MsgUpdateSubscription.interval_unit:type_name -> band.oracle.v1.IntervalUnit
+	27, // 13: band.oracle.v1.MsgUpdateParams.params:type_name -> band.oracle.v1.Params
+	0,  // 14: band.oracle.v1.Msg.RequestData:input_type -> band.oracle.v1.MsgRequestData
+	2,  // 15: band.oracle.v1.Msg.ReportData:input_type -> band.oracle.v1.MsgReportData
+	4,  // 16: band.oracle.v1.Msg.CreateDataSource:input_type -> band.oracle.v1.MsgCreateDataSource
+	6,  // 17: band.oracle.v1.Msg.EditDataSource:input_type -> band.oracle.v1.MsgEditDataSource
+	8,  // 18: band.oracle.v1.Msg.CreateOracleScript:input_type -> band.oracle.v1.MsgCreateOracleScript
+	10, // 19: band.oracle.v1.Msg.EditOracleScript:input_type -> band.oracle.v1.MsgEditOracleScript
+	12, // 20: band.oracle.v1.Msg.Activate:input_type -> band.oracle.v1.MsgActivate
+	14, // 21: band.oracle.v1.Msg.CreateSubscription:input_type -> band.oracle.v1.MsgCreateSubscription
+	16, // 22: band.oracle.v1.Msg.UpdateSubscription:input_type -> band.oracle.v1.MsgUpdateSubscription
+	18, // 23: band.oracle.v1.Msg.CancelSubscription:input_type -> band.oracle.v1.MsgCancelSubscription
+	20, // 24: band.oracle.v1.Msg.UpdateParams:input_type -> band.oracle.v1.MsgUpdateParams
+	1,  // 25: band.oracle.v1.Msg.RequestData:output_type -> band.oracle.v1.MsgRequestDataResponse
+	3,  // 26: band.oracle.v1.Msg.ReportData:output_type -> band.oracle.v1.MsgReportDataResponse
+	5,  // 27: band.oracle.v1.Msg.CreateDataSource:output_type -> band.oracle.v1.MsgCreateDataSourceResponse
+	7,  // 28: band.oracle.v1.Msg.EditDataSource:output_type -> band.oracle.v1.MsgEditDataSourceResponse
+	9,  // 29: band.oracle.v1.Msg.CreateOracleScript:output_type -> band.oracle.v1.MsgCreateOracleScriptResponse
+	11, // 30: band.oracle.v1.Msg.EditOracleScript:output_type -> band.oracle.v1.MsgEditOracleScriptResponse
+	13, // 31: band.oracle.v1.Msg.Activate:output_type -> band.oracle.v1.MsgActivateResponse
+	15, // 32: band.oracle.v1.Msg.CreateSubscription:output_type -> band.oracle.v1.MsgCreateSubscriptionResponse
+	17, // 33: band.oracle.v1.Msg.UpdateSubscription:output_type -> band.oracle.v1.MsgUpdateSubscriptionResponse
+	19, // 34: band.oracle.v1.Msg.CancelSubscription:output_type -> band.oracle.v1.MsgCancelSubscriptionResponse
+	21, // 35: band.oracle.v1.Msg.UpdateParams:output_type -> band.oracle.v1.MsgUpdateParamsResponse
+	25, // [25:36] is the sub-list for method output_type
+	14, // [14:25] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_tx_proto_init() }
@@ -10230,7 +15295,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgCreateSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10242,6 +15307,78 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -10260,7 +15397,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,9 @@ const (
 	Msg_CreateOracleScript_FullMethodName = "/band.oracle.v1.Msg/CreateOracleScript"
 	Msg_EditOracleScript_FullMethodName   = "/band.oracle.v1.Msg/EditOracleScript"
 	Msg_Activate_FullMethodName           = "/band.oracle.v1.Msg/Activate"
+	Msg_CreateSubscription_FullMethodName = "/band.oracle.v1.Msg/CreateSubscription"
+	Msg_UpdateSubscription_FullMethodName = "/band.oracle.v1.Msg/UpdateSubscription"
+	Msg_CancelSubscription_FullMethodName = "/band.oracle.v1.Msg/CancelSubscription"
 	Msg_UpdateParams_FullMethodName       = "/band.oracle.v1.Msg/UpdateParams"
 )
 
@@ -47,6 +50,14 @@ type MsgClient interface {
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateSubscription defines a method for creating a new subscription that
+	// makes recurring requests.
+	CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error)
+	// UpdateSubscription defines a method for updating an existing subscription.
+	UpdateSubscription(ctx context.Context, in *MsgUpdateSubscription, opts ...grpc.CallOption) (*MsgUpdateSubscriptionResponse, error)
+	// CancelSubscription defines a method for cancelling an existing
+	// subscription.
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -125,6 +136,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error) {
+	out := new(MsgCreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSubscription(ctx context.Context, in *MsgUpdateSubscription, opts ...grpc.CallOption) (*MsgUpdateSubscriptionResponse, error) {
+	out := new(MsgUpdateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -152,6 +190,14 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateSubscription defines a method for creating a new subscription that
+	// makes recurring requests.
+	CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error)
+	// UpdateSubscription defines a method for updating an existing subscription.
+	UpdateSubscription(context.Context, *MsgUpdateSubscription) (*MsgUpdateSubscriptionResponse, error)
+	// CancelSubscription defines a method for cancelling an existing
+	// subscription.
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -185,6 +231,15 @@ func (UnimplementedMsgServer) EditOracleScript(context.Context, *MsgEditOracleSc
 func (UnimplementedMsgServer) Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (UnimplementedMsgServer) CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedMsgServer) UpdateSubscription(context.Context, *MsgUpdateSubscription) (*MsgUpdateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (UnimplementedMsgServer) CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -327,6 +382,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubscription(ctx, req.(*MsgCreateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSubscription(ctx, req.(*MsgUpdateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -380,6 +489,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Msg_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _Msg_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
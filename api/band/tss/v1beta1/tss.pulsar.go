@@ -14223,6 +14223,71 @@ func (ComplaintStatus) EnumDescriptor() ([]byte, []int) {
 	return file_band_tss_v1beta1_tss_proto_rawDescGZIP(), []int{2}
 }
 
+// MisbehaviorType is an enumeration of the provable misbehaviors of a group member.
+type MisbehaviorType int32
+
+const (
+	// MISBEHAVIOR_TYPE_UNSPECIFIED represents an undefined misbehavior.
+	MisbehaviorType_MISBEHAVIOR_TYPE_UNSPECIFIED MisbehaviorType = 0
+	// MISBEHAVIOR_TYPE_INVALID_COMPLAINT represents a complaint that fails verification, which
+	// proves that the complainant accused an honest member.
+	MisbehaviorType_MISBEHAVIOR_TYPE_INVALID_COMPLAINT MisbehaviorType = 1
+	// MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE represents a successful complaint, which proves that
+	// the respondent sent an invalid encrypted secret share.
+	MisbehaviorType_MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE MisbehaviorType = 2
+	// MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE represents a partial signature that fails
+	// verification against the member's public key.
+	MisbehaviorType_MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE MisbehaviorType = 3
+	// MISBEHAVIOR_TYPE_NON_PARTICIPATION represents a member that repeatedly fails to submit
+	// its partial signature before the signing expires.
+	MisbehaviorType_MISBEHAVIOR_TYPE_NON_PARTICIPATION MisbehaviorType = 4
+)
+
+// Enum value maps for MisbehaviorType.
+var (
+	MisbehaviorType_name = map[int32]string{
+		0: "MISBEHAVIOR_TYPE_UNSPECIFIED",
+		1: "MISBEHAVIOR_TYPE_INVALID_COMPLAINT",
+		2: "MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE",
+		3: "MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE",
+		4: "MISBEHAVIOR_TYPE_NON_PARTICIPATION",
+	}
+	MisbehaviorType_value = map[string]int32{
+		"MISBEHAVIOR_TYPE_UNSPECIFIED":               0,
+		"MISBEHAVIOR_TYPE_INVALID_COMPLAINT":         1,
+		"MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE":      2,
+		"MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE": 3,
+		"MISBEHAVIOR_TYPE_NON_PARTICIPATION":         4,
+	}
+)
+
+func (x MisbehaviorType) Enum() *MisbehaviorType {
+	p := new(MisbehaviorType)
+	*p = x
+	return p
+}
+
+func (x MisbehaviorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MisbehaviorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tss_v1beta1_tss_proto_enumTypes[3].Descriptor()
+}
+
+func (MisbehaviorType) Type() protoreflect.EnumType {
+	return &file_band_tss_v1beta1_tss_proto_enumTypes[3]
+}
+
+func (x MisbehaviorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MisbehaviorType.Descriptor instead.
+func (MisbehaviorType) EnumDescriptor() ([]byte, []int) {
+	return file_band_tss_v1beta1_tss_proto_rawDescGZIP(), []int{3}
+}
+
 // Group is a type representing a participant group in a Distributed Key Generation or signing process.
 type Group struct {
 	state         protoimpl.MessageState
@@ -16008,20 +16073,35 @@ var file_band_tss_v1beta1_tss_proto_rawDesc = []byte{
 	0x4d, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
 	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4d, 0x50,
 	0x4c, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc8, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x42, 0x08, 0x54, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
-	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73, 0x73, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x2e,
-	0x54, 0x73, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
-	0x1c, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
-	0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xe4, 0x01, 0x0a, 0x0f,
+	0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x1c, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x26, 0x0a, 0x22, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x49, 0x53,
+	0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x53, 0x48, 0x41,
+	0x52, 0x45, 0x10, 0x02, 0x12, 0x2e, 0x0a, 0x2a, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56,
+	0x49, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56,
+	0x49, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xc8, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
+	0x08, 0x54, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
+	0x42, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x73, 0x73, 0x2e, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73,
+	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1c, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x3a,
+	0x3a, 0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -16036,56 +16116,57 @@ func file_band_tss_v1beta1_tss_proto_rawDescGZIP() []byte {
 	return file_band_tss_v1beta1_tss_proto_rawDescData
 }
 
-var file_band_tss_v1beta1_tss_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_band_tss_v1beta1_tss_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_band_tss_v1beta1_tss_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_band_tss_v1beta1_tss_proto_goTypes = []interface{}{
 	(SigningStatus)(0),             // 0: band.tss.v1beta1.SigningStatus
 	(GroupStatus)(0),               // 1: band.tss.v1beta1.GroupStatus
 	(ComplaintStatus)(0),           // 2: band.tss.v1beta1.ComplaintStatus
-	(*Group)(nil),                  // 3: band.tss.v1beta1.Group
-	(*GroupResult)(nil),            // 4: band.tss.v1beta1.GroupResult
-	(*Round1Info)(nil),             // 5: band.tss.v1beta1.Round1Info
-	(*Round2Info)(nil),             // 6: band.tss.v1beta1.Round2Info
-	(*DE)(nil),                     // 7: band.tss.v1beta1.DE
-	(*DEQueue)(nil),                // 8: band.tss.v1beta1.DEQueue
-	(*Signing)(nil),                // 9: band.tss.v1beta1.Signing
-	(*SigningAttempt)(nil),         // 10: band.tss.v1beta1.SigningAttempt
-	(*AssignedMember)(nil),         // 11: band.tss.v1beta1.AssignedMember
-	(*PendingSignings)(nil),        // 12: band.tss.v1beta1.PendingSignings
-	(*Member)(nil),                 // 13: band.tss.v1beta1.Member
-	(*Confirm)(nil),                // 14: band.tss.v1beta1.Confirm
-	(*Complaint)(nil),              // 15: band.tss.v1beta1.Complaint
-	(*ComplaintWithStatus)(nil),    // 16: band.tss.v1beta1.ComplaintWithStatus
-	(*ComplaintsWithStatus)(nil),   // 17: band.tss.v1beta1.ComplaintsWithStatus
-	(*PendingProcessGroups)(nil),   // 18: band.tss.v1beta1.PendingProcessGroups
-	(*PendingProcessSignings)(nil), // 19: band.tss.v1beta1.PendingProcessSignings
-	(*PartialSignature)(nil),       // 20: band.tss.v1beta1.PartialSignature
-	(*TextSignatureOrder)(nil),     // 21: band.tss.v1beta1.TextSignatureOrder
-	(*EVMSignature)(nil),           // 22: band.tss.v1beta1.EVMSignature
-	(*SigningResult)(nil),          // 23: band.tss.v1beta1.SigningResult
-	(*SigningExpiration)(nil),      // 24: band.tss.v1beta1.SigningExpiration
-	(*SigningExpirations)(nil),     // 25: band.tss.v1beta1.SigningExpirations
-	(*timestamppb.Timestamp)(nil),  // 26: google.protobuf.Timestamp
+	(MisbehaviorType)(0),           // 3: band.tss.v1beta1.MisbehaviorType
+	(*Group)(nil),                  // 4: band.tss.v1beta1.Group
+	(*GroupResult)(nil),            // 5: band.tss.v1beta1.GroupResult
+	(*Round1Info)(nil),             // 6: band.tss.v1beta1.Round1Info
+	(*Round2Info)(nil),             // 7: band.tss.v1beta1.Round2Info
+	(*DE)(nil),                     // 8: band.tss.v1beta1.DE
+	(*DEQueue)(nil),                // 9: band.tss.v1beta1.DEQueue
+	(*Signing)(nil),                // 10: band.tss.v1beta1.Signing
+	(*SigningAttempt)(nil),         // 11: band.tss.v1beta1.SigningAttempt
+	(*AssignedMember)(nil),         // 12: band.tss.v1beta1.AssignedMember
+	(*PendingSignings)(nil),        // 13: band.tss.v1beta1.PendingSignings
+	(*Member)(nil),                 // 14: band.tss.v1beta1.Member
+	(*Confirm)(nil),                // 15: band.tss.v1beta1.Confirm
+	(*Complaint)(nil),              // 16: band.tss.v1beta1.Complaint
+	(*ComplaintWithStatus)(nil),    // 17: band.tss.v1beta1.ComplaintWithStatus
+	(*ComplaintsWithStatus)(nil),   // 18: band.tss.v1beta1.ComplaintsWithStatus
+	(*PendingProcessGroups)(nil),   // 19: band.tss.v1beta1.PendingProcessGroups
+	(*PendingProcessSignings)(nil), // 20: band.tss.v1beta1.PendingProcessSignings
+	(*PartialSignature)(nil),       // 21: band.tss.v1beta1.PartialSignature
+	(*TextSignatureOrder)(nil),     // 22: band.tss.v1beta1.TextSignatureOrder
+	(*EVMSignature)(nil),           // 23: band.tss.v1beta1.EVMSignature
+	(*SigningResult)(nil),          // 24: band.tss.v1beta1.SigningResult
+	(*SigningExpiration)(nil),      // 25: band.tss.v1beta1.SigningExpiration
+	(*SigningExpirations)(nil),     // 26: band.tss.v1beta1.SigningExpirations
+	(*timestamppb.Timestamp)(nil),  // 27: google.protobuf.Timestamp
 }
 var file_band_tss_v1beta1_tss_proto_depIdxs = []int32{
 	1,  // 0: band.tss.v1beta1.Group.status:type_name -> band.tss.v1beta1.GroupStatus
-	3,  // 1: band.tss.v1beta1.GroupResult.group:type_name -> band.tss.v1beta1.Group
-	13, // 2: band.tss.v1beta1.GroupResult.members:type_name -> band.tss.v1beta1.Member
-	5,  // 3: band.tss.v1beta1.GroupResult.round1_infos:type_name -> band.tss.v1beta1.Round1Info
-	6,  // 4: band.tss.v1beta1.GroupResult.round2_infos:type_name -> band.tss.v1beta1.Round2Info
-	17, // 5: band.tss.v1beta1.GroupResult.complaints_with_status:type_name -> band.tss.v1beta1.ComplaintsWithStatus
-	14, // 6: band.tss.v1beta1.GroupResult.confirms:type_name -> band.tss.v1beta1.Confirm
+	4,  // 1: band.tss.v1beta1.GroupResult.group:type_name -> band.tss.v1beta1.Group
+	14, // 2: band.tss.v1beta1.GroupResult.members:type_name -> band.tss.v1beta1.Member
+	6,  // 3: band.tss.v1beta1.GroupResult.round1_infos:type_name -> band.tss.v1beta1.Round1Info
+	7,  // 4: band.tss.v1beta1.GroupResult.round2_infos:type_name -> band.tss.v1beta1.Round2Info
+	18, // 5: band.tss.v1beta1.GroupResult.complaints_with_status:type_name -> band.tss.v1beta1.ComplaintsWithStatus
+	15, // 6: band.tss.v1beta1.GroupResult.confirms:type_name -> band.tss.v1beta1.Confirm
 	0,  // 7: band.tss.v1beta1.Signing.status:type_name -> band.tss.v1beta1.SigningStatus
-	26, // 8: band.tss.v1beta1.Signing.created_timestamp:type_name -> google.protobuf.Timestamp
-	11, // 9: band.tss.v1beta1.SigningAttempt.assigned_members:type_name -> band.tss.v1beta1.AssignedMember
-	15, // 10: band.tss.v1beta1.ComplaintWithStatus.complaint:type_name -> band.tss.v1beta1.Complaint
+	27, // 8: band.tss.v1beta1.Signing.created_timestamp:type_name -> google.protobuf.Timestamp
+	12, // 9: band.tss.v1beta1.SigningAttempt.assigned_members:type_name -> band.tss.v1beta1.AssignedMember
+	16, // 10: band.tss.v1beta1.ComplaintWithStatus.complaint:type_name -> band.tss.v1beta1.Complaint
 	2,  // 11: band.tss.v1beta1.ComplaintWithStatus.complaint_status:type_name -> band.tss.v1beta1.ComplaintStatus
-	16, // 12: band.tss.v1beta1.ComplaintsWithStatus.complaints_with_status:type_name -> band.tss.v1beta1.ComplaintWithStatus
-	9,  // 13: band.tss.v1beta1.SigningResult.signing:type_name -> band.tss.v1beta1.Signing
-	10, // 14: band.tss.v1beta1.SigningResult.current_signing_attempt:type_name -> band.tss.v1beta1.SigningAttempt
-	22, // 15: band.tss.v1beta1.SigningResult.evm_signature:type_name -> band.tss.v1beta1.EVMSignature
-	20, // 16: band.tss.v1beta1.SigningResult.received_partial_signatures:type_name -> band.tss.v1beta1.PartialSignature
-	24, // 17: band.tss.v1beta1.SigningExpirations.signing_expirations:type_name -> band.tss.v1beta1.SigningExpiration
+	17, // 12: band.tss.v1beta1.ComplaintsWithStatus.complaints_with_status:type_name -> band.tss.v1beta1.ComplaintWithStatus
+	10, // 13: band.tss.v1beta1.SigningResult.signing:type_name -> band.tss.v1beta1.Signing
+	11, // 14: band.tss.v1beta1.SigningResult.current_signing_attempt:type_name -> band.tss.v1beta1.SigningAttempt
+	23, // 15: band.tss.v1beta1.SigningResult.evm_signature:type_name -> band.tss.v1beta1.EVMSignature
+	21, // 16: band.tss.v1beta1.SigningResult.received_partial_signatures:type_name -> band.tss.v1beta1.PartialSignature
+	25, // 17: band.tss.v1beta1.SigningExpirations.signing_expirations:type_name -> band.tss.v1beta1.SigningExpiration
 	18, // [18:18] is the sub-list for method output_type
 	18, // [18:18] is the sub-list for method input_type
 	18, // [18:18] is the sub-list for extension type_name
@@ -16381,7 +16462,7 @@ func file_band_tss_v1beta1_tss_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tss_v1beta1_tss_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
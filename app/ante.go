@@ -1,13 +1,18 @@
 package band
 
 import (
+	"slices"
+
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
@@ -83,6 +88,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		feeChecker := feechecker.NewFeeChecker(
 			options.GlobalfeeKeeper,
 			options.StakingKeeper,
+			options.FeedsKeeper,
 		)
 		options.TxFeeChecker = feeChecker.CheckTxFee
 	}
@@ -101,6 +107,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 				options.FeegrantKeeper,
 				options.TxFeeChecker,
 			),
+			options.Cdc,
+			options.GlobalfeeKeeper,
 			options.IgnoreDecoratorMatchFns...,
 		),
 		// SetPubKeyDecorator must be called before all signature verification decorators
@@ -116,40 +124,150 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 }
 
 // IgnoreDecorator is an AnteDecorator that wraps an existing AnteDecorator. It allows
-// for the AnteDecorator to be ignored for specified lanes.
+// for the AnteDecorator to be ignored for specified lanes, as long as the accounts of the
+// transaction are within the free transaction quota of the globalfee module.
 type IgnoreDecorator struct {
-	decorator sdk.AnteDecorator
-	matchFns  []mempool.TxMatchFn
+	decorator       sdk.AnteDecorator
+	cdc             codec.Codec
+	globalfeeKeeper *globalfeekeeper.Keeper
+	matchFns        []mempool.TxMatchFn
 }
 
-// NewIgnoreDecorator returns a new IgnoreDecorator instance.
-func NewIgnoreDecorator(decorator sdk.AnteDecorator, matchFns ...mempool.TxMatchFn) *IgnoreDecorator {
+// NewIgnoreDecorator returns a new IgnoreDecorator instance. The free transaction quota is
+// not applied if globalfeeKeeper is nil.
+func NewIgnoreDecorator(
+	decorator sdk.AnteDecorator,
+	cdc codec.Codec,
+	globalfeeKeeper *globalfeekeeper.Keeper,
+	matchFns ...mempool.TxMatchFn,
+) *IgnoreDecorator {
 	return &IgnoreDecorator{
-		decorator: decorator,
-		matchFns:  matchFns,
+		decorator:       decorator,
+		cdc:             cdc,
+		globalfeeKeeper: globalfeeKeeper,
+		matchFns:        matchFns,
 	}
 }
 
 // NewIgnoreDecorator is a wrapper that implements the sdk.AnteDecorator interface,
 // providing two execution paths for processing transactions:
-//   - If a transaction matches one of the designated bypass lanes, it is forwarded
-//     directly to the next AnteHandler.
+//   - If a transaction matches one of the designated bypass lanes and is within the free
+//     transaction quota, it is forwarded directly to the next AnteHandler.
 //   - Otherwise, the transaction is processed using the embedded decorator's AnteHandler.
 func (ig IgnoreDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	// IgnoreDecorator is only used for check tx and re-check tx.
-	// It is also not used for simulate tx because it affects the gas calculation.
-	if simulate || (!ctx.IsCheckTx() && !ctx.IsReCheckTx()) {
+	// It is not used for simulate tx because it affects the gas calculation.
+	if simulate {
 		return ig.decorator.AnteHandle(ctx, tx, simulate, next)
 	}
 
+	// The quota usage is not charged to the transaction gas.
+	quotaCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	isQuotaEnabled := ig.globalfeeKeeper != nil && ig.globalfeeKeeper.IsFreeTxQuotaEnabled(quotaCtx)
+
+	// IgnoreDecorator is only used for check tx and re-check tx. The delivered transactions of
+	// the designated lanes are counted towards the quota, as the usage recorded in check tx is
+	// discarded on commit.
+	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		if isQuotaEnabled && ig.match(quotaCtx, tx) {
+			if operators, grantees, err := getFreeTxAccounts(ig.cdc, tx); err == nil {
+				ig.globalfeeKeeper.UseFreeTxQuota(quotaCtx, operators, grantees)
+			}
+		}
+
+		return ig.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !ig.match(ctx, tx) {
+		return ig.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if isQuotaEnabled {
+		operators, grantees, err := getFreeTxAccounts(ig.cdc, tx)
+		if err == nil {
+			err = ig.globalfeeKeeper.CheckFreeTxQuota(quotaCtx, operators, grantees)
+		}
+		if err != nil {
+			// Over-quota transactions require the normal fees.
+			ctx.Logger().Debug("free tx is not admitted, requiring fees", "err", err)
+			return ig.decorator.AnteHandle(ctx, tx, simulate, next)
+		}
+
+		ig.globalfeeKeeper.UseFreeTxQuota(quotaCtx, operators, grantees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// match returns true if the transaction matches one of the designated bypass lanes.
+func (ig IgnoreDecorator) match(ctx sdk.Context, tx sdk.Tx) bool {
 	cacheCtx, _ := ctx.CacheContext()
 	for _, matchFn := range ig.matchFns {
 		if matchFn(cacheCtx, tx) {
-			return next(ctx, tx, simulate)
+			return true
 		}
 	}
 
-	return ig.decorator.AnteHandle(ctx, tx, simulate, next)
+	return false
+}
+
+// getFreeTxAccounts returns the accounts that a free transaction counts towards: the signers of
+// the messages as operators and the authz grantees executing the messages as grantees.
+func getFreeTxAccounts(cdc codec.Codec, tx sdk.Tx) (operators, grantees []sdk.AccAddress, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if operators, grantees, err = appendFreeTxMsgAccounts(cdc, msg, operators, grantees); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return operators, grantees, nil
+}
+
+// appendFreeTxMsgAccounts appends the operators and grantees of a message, looking into the
+// messages executed with authz.
+func appendFreeTxMsgAccounts(
+	cdc codec.Codec,
+	msg sdk.Msg,
+	operators, grantees []sdk.AccAddress,
+) ([]sdk.AccAddress, []sdk.AccAddress, error) {
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		signers, _, err := cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, signer := range signers {
+			operators = appendUniqueAccount(operators, signer)
+		}
+
+		return operators, grantees, nil
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(execMsg.Grantee)
+	if err != nil {
+		return nil, nil, err
+	}
+	grantees = appendUniqueAccount(grantees, grantee)
+
+	msgs, err := execMsg.GetMessages()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range msgs {
+		if operators, grantees, err = appendFreeTxMsgAccounts(cdc, m, operators, grantees); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return operators, grantees, nil
+}
+
+// appendUniqueAccount appends the account if it is not in the list.
+func appendUniqueAccount(addrs []sdk.AccAddress, addr sdk.AccAddress) []sdk.AccAddress {
+	if slices.ContainsFunc(addrs, func(a sdk.AccAddress) bool { return a.Equals(addr) }) {
+		return addrs
+	}
+
+	return append(addrs, addr)
 }
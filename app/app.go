@@ -56,7 +56,9 @@ import (
 	"github.com/bandprotocol/chain/v3/app/mempool"
 	"github.com/bandprotocol/chain/v3/app/upgrades"
 	v3 "github.com/bandprotocol/chain/v3/app/upgrades/v3"
+	v3_1 "github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
 	v3_rc4 "github.com/bandprotocol/chain/v3/app/upgrades/v3_rc4"
+	mempoolservice "github.com/bandprotocol/chain/v3/client/grpc/mempool"
 	nodeservice "github.com/bandprotocol/chain/v3/client/grpc/node"
 	proofservice "github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
 	feedskeeper "github.com/bandprotocol/chain/v3/x/feeds/keeper"
@@ -68,7 +70,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v3.Upgrade, v3_rc4.Upgrade}
+	Upgrades = []upgrades.Upgrade{v3.Upgrade, v3_rc4.Upgrade, v3_1.Upgrade}
 )
 
 var (
@@ -460,6 +462,7 @@ func (app *BandApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 
 	// Register grpc-gateway routes for additional services
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	proofservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	cosmosnodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -472,6 +475,9 @@ func (app *BandApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 // RegisterNodeService allows query minimum-gas-prices in app.toml
 func (app *BandApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	if bandMempool, ok := app.Mempool().(*mempool.Mempool); ok {
+		mempoolservice.RegisterMempoolService(app.GRPCQueryRouter(), bandMempool)
+	}
 	proofservice.RegisterProofService(clientCtx, app.GRPCQueryRouter(), cfg)
 	cosmosnodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
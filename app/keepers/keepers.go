@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cast"
+
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
@@ -66,6 +68,7 @@ import (
 
 	owasm "github.com/bandprotocol/go-owasm/api"
 
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/x/bandtss"
 	bandtsskeeper "github.com/bandprotocol/chain/v3/x/bandtss/keeper"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
@@ -426,7 +429,10 @@ func NewAppKeeper(
 		panic(err)
 	}
 
-	appKeepers.RollingseedKeeper = rollingseedkeeper.NewKeeper(appKeepers.keys[rollingseedtypes.StoreKey])
+	appKeepers.RollingseedKeeper = rollingseedkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[rollingseedtypes.StoreKey],
+	)
 
 	// register the request signature types
 	tssContentRouter := tsstypes.NewContentRouter()
@@ -448,15 +454,28 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.StakingKeeper,
+		appKeepers.RestakeKeeper,
 		appKeepers.TSSKeeper,
+		appKeepers.RollingseedKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
 	)
 
+	// Files of oracle scripts and data sources cannot be fetched again, so the default options without
+	// disk size limit are used to never evict them.
+	fileCacheOpts := filecache.DefaultOptions()
+	if fileCacheSize := appOpts.Get(oracle.FlagFileCacheSize); fileCacheSize != nil {
+		fileCacheOpts.MemoryCacheSize = cast.ToUint64(fileCacheSize) * 1024 * 1024
+	}
+
+	// modules reacting to the results of oracle requests register their callbacks here
+	oracleCbRouter := oracletypes.NewCallbackRouter()
+
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[oracletypes.StoreKey],
-		filepath.Join(homePath, "files"),
+		filecache.NewWithOptions(filepath.Join(homePath, "files"), fileCacheOpts),
 		authtypes.FeeCollectorName,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
@@ -468,6 +487,7 @@ func NewAppKeeper(
 		appKeepers.RollingseedKeeper,
 		appKeepers.BandtssKeeper,
 		appKeepers.ScopedOracleKeeper,
+		oracleCbRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -508,11 +528,15 @@ func NewAppKeeper(
 	tssCbRouter.
 		AddRoute(bandtsstypes.RouterKey, bandtsskeeper.NewTSSCallback(appKeepers.BandtssKeeper))
 
+	oracleCbRouter.
+		AddRoute(tunneltypes.RouterKey, tunnelkeeper.NewOracleCallback(appKeepers.TunnelKeeper))
+
 	// It is vital to seal the request signature router here as to not allow
 	// further handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
 	tssContentRouter.Seal()
 	tssCbRouter.Seal()
+	oracleCbRouter.Seal()
 
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(nil, &appKeepers.ICAHostKeeper)
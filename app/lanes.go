@@ -1,8 +1,6 @@
 package band
 
 import (
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,19 +58,16 @@ func CreateLanes(app *BandApp) []*mempool.Lane {
 		nil,
 	)
 
-	// oracleRequestLane handles oracle request data transactions.
+	// oracleRequestLane handles oracle request data transactions and IBC oracle request packets.
 	// Each transaction has a gas limit of 10%, and the total gas limit for the lane is 10%.
 	// It is blocked if the oracle report lane exceeds its limit.
 	oracleRequestLane := mempool.NewLane(
 		app.Logger(),
 		app.txConfig.TxEncoder(),
 		"oracleRequestLane",
-		mempool.NewLaneTxMatchFn(
-			[]sdk.Msg{
-				&oracletypes.MsgRequestData{},
-				&channeltypes.MsgRecvPacket{}, // TODO: Only match oracle request packet
-			},
-			false,
+		anyTxMatchHandler(
+			mempool.NewLaneTxMatchFn([]sdk.Msg{&oracletypes.MsgRequestData{}}, false),
+			ibcPacketTxMatchHandler(isOracleRequestPacket),
 		),
 		math.LegacyMustNewDecFromStr("0.1"),
 		math.LegacyMustNewDecFromStr("0.1"),
@@ -96,7 +91,20 @@ func CreateLanes(app *BandApp) []*mempool.Lane {
 		},
 	)
 
-	// defaultLane handles all other transactions.
+	// ibcTransferLane handles IBC fungible token transfer packets.
+	// Each transaction has a gas limit of 10%, and the total gas limit for the lane is 10%.
+	ibcTransferLane := mempool.NewLane(
+		app.Logger(),
+		app.txConfig.TxEncoder(),
+		"ibcTransferLane",
+		ibcPacketTxMatchHandler(isTransferPacket),
+		math.LegacyMustNewDecFromStr("0.1"),
+		math.LegacyMustNewDecFromStr("0.1"),
+		sdkmempool.DefaultPriorityMempool(),
+		nil,
+	)
+
+	// defaultLane handles all other transactions, including the other IBC packets.
 	// Each transaction has a gas limit of 10%, and the total gas limit for the lane is 10%.
 	defaultLane := mempool.NewLane(
 		app.Logger(),
@@ -109,5 +117,5 @@ func CreateLanes(app *BandApp) []*mempool.Lane {
 		nil,
 	)
 
-	return []*mempool.Lane{feedsLane, tssLane, oracleReportLane, oracleRequestLane, defaultLane}
+	return []*mempool.Lane{feedsLane, tssLane, oracleReportLane, oracleRequestLane, ibcTransferLane, defaultLane}
 }
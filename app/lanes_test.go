@@ -6,14 +6,20 @@ import (
 	"testing"
 	"time"
 
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/stretchr/testify/suite"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -25,6 +31,7 @@ import (
 	bandtsskeeper "github.com/bandprotocol/chain/v3/x/bandtss/keeper"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 	tsstestutils "github.com/bandprotocol/chain/v3/x/tss/testutil"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
@@ -2226,3 +2233,151 @@ func GenFeeds(num int) (feeds []feedstypes.Feed) {
 
 	return
 }
+
+// -----------------------------------------------
+// Free tx quota tests
+// -----------------------------------------------
+
+// TestFreeTxQuotaRequiresFees tests that free transactions over the grantee quota require fees
+func (s *AppTestSuite) TestFreeTxQuotaRequiresFees() {
+	require := s.Require()
+
+	params := globalfeetypes.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))),
+		globalfeetypes.NewFreeTxQuota(100, 0, 1),
+		globalfeetypes.DefaultParams().FeeDenomPricing,
+	)
+	require.NoError(s.app.GlobalFeeKeeper.SetParams(s.ctx, params))
+
+	txOwner := s.valAccWithNumSeq
+	sender := s.reporterAccWithNumSeq
+	genTxBytes := func(seq uint64, feeAmt sdk.Coins) []byte {
+		msgExec := authz.NewMsgExec(sender.Address, []sdk.Msg{genMsgReportData(&txOwner)})
+		tx, err := bandtesting.GenSignedMockTx(
+			rand.New(rand.NewSource(time.Now().UnixNano())),
+			s.txConfig,
+			[]sdk.Msg{&msgExec},
+			feeAmt,
+			1000000,
+			bandtesting.ChainID,
+			[]uint64{sender.Num},
+			[]uint64{seq},
+			sender.PrivKey,
+		)
+		require.NoError(err)
+
+		txBytes, err := s.txConfig.TxEncoder()(tx)
+		require.NoError(err)
+		return txBytes
+	}
+
+	// the first free tx is within the quota
+	s.checkTxAcceptance([][]byte{genTxBytes(sender.Seq, sdk.Coins{})}, "oracleReportLane")
+
+	// the second free tx is over the quota and requires fees
+	checkTxReq := &abci.RequestCheckTx{Tx: genTxBytes(sender.Seq+1, sdk.Coins{}), Type: abci.CheckTxType_New}
+	res, err := s.app.CheckTx(checkTxReq)
+	require.NoError(err)
+	require.Equal(sdkerrors.ErrInsufficientFee.ABCICode(), res.Code)
+
+	// the tx with fees is not free and goes to the default lane
+	s.checkTxAcceptance(
+		[][]byte{genTxBytes(sender.Seq+1, sdk.NewCoins(sdk.NewInt64Coin("uband", 2500)))},
+		"defaultLane",
+	)
+}
+
+// TestIBCPacketLaneRouting tests that IBC relayer txs are routed to the lane of their received packets
+func (s *AppTestSuite) TestIBCPacketLaneRouting() {
+	relayer := s.valAccWithNumSeq.Address.String()
+
+	oracleRequestPacket := channeltypes.NewPacket(
+		oracletypes.NewOracleRequestPacketData(
+			"client",
+			1,
+			[]byte("calldata"),
+			1,
+			1,
+			oracletypes.ENCODER_PROTO,
+			bandtesting.Coins100band,
+			10000,
+			10000,
+		).GetBytes(),
+		1,
+		oracletypes.PortID,
+		"channel-0",
+		oracletypes.PortID,
+		"channel-1",
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+	transferPacket := channeltypes.NewPacket(
+		ibctransfertypes.NewFungibleTokenPacketData("uatom", "100", "sender", relayer, "").GetBytes(),
+		1,
+		ibctransfertypes.PortID,
+		"channel-2",
+		ibctransfertypes.PortID,
+		"channel-3",
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+	// a transfer packet sent to the oracle port is not an oracle request
+	invalidOracleRequestPacket := transferPacket
+	invalidOracleRequestPacket.DestinationPort = oracletypes.PortID
+	icaPacket := channeltypes.NewPacket(
+		[]byte(`{"type":"TYPE_EXECUTE_TX","data":""}`),
+		1,
+		"icacontroller-owner",
+		"channel-4",
+		"icahost",
+		"channel-5",
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+
+	recvPacket := func(packet channeltypes.Packet) sdk.Msg {
+		return channeltypes.NewMsgRecvPacket(packet, []byte("proof"), clienttypes.NewHeight(1, 10), relayer)
+	}
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: relayer}
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		lane string
+	}{
+		{"oracle request packet", []sdk.Msg{recvPacket(oracleRequestPacket)}, "oracleRequestLane"},
+		{
+			"oracle request packets with update client",
+			[]sdk.Msg{updateClient, recvPacket(oracleRequestPacket), recvPacket(oracleRequestPacket)},
+			"oracleRequestLane",
+		},
+		{"transfer packet", []sdk.Msg{recvPacket(transferPacket)}, "ibcTransferLane"},
+		{"transfer packet with update client", []sdk.Msg{updateClient, recvPacket(transferPacket)}, "ibcTransferLane"},
+		{"invalid oracle request packet", []sdk.Msg{recvPacket(invalidOracleRequestPacket)}, "defaultLane"},
+		{"ica packet", []sdk.Msg{updateClient, recvPacket(icaPacket)}, "defaultLane"},
+		{
+			"mixed oracle request and transfer packets",
+			[]sdk.Msg{updateClient, recvPacket(oracleRequestPacket), recvPacket(transferPacket)},
+			"defaultLane",
+		},
+		{"update client only", []sdk.Msg{updateClient}, "defaultLane"},
+	}
+
+	lanes := s.app.Mempool().(*mempool.Mempool).GetLanes()
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txBuilder := s.txConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			tx := txBuilder.GetTx()
+
+			matchedLane := ""
+			for _, lane := range lanes {
+				if lane.Match(s.ctx, tx) {
+					matchedLane = lane.Name()
+					break
+				}
+			}
+			s.Require().Equal(tc.lane, matchedLane)
+		})
+	}
+}
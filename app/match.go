@@ -1,6 +1,10 @@
 package band
 
 import (
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -245,6 +249,63 @@ func isValidMsgReportData(
 	}
 }
 
+// anyTxMatchHandler is a function that returns the match function that matches a tx if any of the given match
+// functions matches it.
+func anyTxMatchHandler(matchFns ...mempool.TxMatchFn) mempool.TxMatchFn {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		for _, matchFn := range matchFns {
+			if matchFn(ctx, tx) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ibcPacketTxMatchHandler is a function that returns the match function for the IBC relayer tx that receives
+// packets. The tx matches if it receives at least one packet and all of its received packets are accepted by
+// isMatchPacket. MsgUpdateClient is allowed alongside the packets as relayers batch it to update the client
+// of the counterparty chain before receiving the packets.
+func ibcPacketTxMatchHandler(isMatchPacket func(channeltypes.Packet) bool) mempool.TxMatchFn {
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		hasPacket := false
+		for _, msg := range tx.GetMsgs() {
+			switch msg := msg.(type) {
+			case *clienttypes.MsgUpdateClient:
+				continue
+			case *channeltypes.MsgRecvPacket:
+				if !isMatchPacket(msg.Packet) {
+					return false
+				}
+				hasPacket = true
+			default:
+				return false
+			}
+		}
+		return hasPacket
+	}
+}
+
+// isOracleRequestPacket returns true if the packet is an oracle request sent to the oracle module.
+func isOracleRequestPacket(packet channeltypes.Packet) bool {
+	if packet.GetDestPort() != oracletypes.PortID {
+		return false
+	}
+
+	var data oracletypes.OracleRequestPacketData
+	return oracletypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data) == nil
+}
+
+// isTransferPacket returns true if the packet is a fungible token transfer sent to the transfer module.
+func isTransferPacket(packet channeltypes.Packet) bool {
+	if packet.GetDestPort() != ibctransfertypes.PortID {
+		return false
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	return ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data) == nil
+}
+
 func isSuccess(_ any, err error) bool {
 	return err == nil
 }
@@ -4,14 +4,19 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-metrics"
 
 	comettypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -40,6 +45,10 @@ type Lane struct {
 	// excluded from the proposal for the current block.
 	blocked bool
 
+	// droppedTxCount is the number of transactions that are rejected by the
+	// lane's mempool or skipped from the proposal as invalid.
+	droppedTxCount atomic.Uint64
+
 	// Add mutex for thread safety.
 	mu sync.RWMutex
 }
@@ -79,18 +88,13 @@ func (l *Lane) Insert(ctx context.Context, tx sdk.Tx) error {
 		return err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	consensusParams := sdkCtx.ConsensusParams()
-
-	transactionLimit, err := NewBlockSpace(
-		uint64(consensusParams.Block.MaxBytes),
-		uint64(consensusParams.Block.MaxGas),
-	).Scale(l.maxTransactionBlockRatio)
+	transactionLimit, err := l.GetTransactionLimit(sdk.UnwrapSDKContext(ctx))
 	if err != nil {
 		return err
 	}
 
 	if transactionLimit.IsExceededBy(txInfo.BlockSpace) {
+		l.dropTx()
 		return fmt.Errorf(
 			"transaction exceeds limit: tx_hash %s, lane %s, limit %s, tx_size %s",
 			txInfo.Hash,
@@ -104,6 +108,7 @@ func (l *Lane) Insert(ctx context.Context, tx sdk.Tx) error {
 	defer l.mu.Unlock()
 
 	if err = l.mempool.Insert(ctx, tx); err != nil {
+		l.dropTx()
 		return err
 	}
 
@@ -160,8 +165,16 @@ func (l *Lane) FillProposal(
 	ctx sdk.Context,
 	proposal *Proposal,
 ) (blockUsed BlockSpace, iterator sdkmempool.Iterator) {
+	labels := []metrics.Label{telemetry.NewLabel("lane", l.name)}
+	defer func() {
+		telemetry.SetGaugeWithLabels([]string{"mempool", "lane", "tx_count"}, float32(l.CountTx()), labels)
+		telemetry.SetGaugeWithLabels([]string{"mempool", "lane", "blocked"}, boolToFloat32(l.IsBlocked()), labels)
+		telemetry.SetGaugeWithLabels([]string{"mempool", "lane", "fill_tx_bytes"}, float32(blockUsed.TxBytes()), labels)
+		telemetry.SetGaugeWithLabels([]string{"mempool", "lane", "fill_gas"}, float32(blockUsed.Gas()), labels)
+	}()
+
 	// if the lane is blocked, we do not add any transactions to the proposal.
-	if l.blocked {
+	if l.IsBlocked() {
 		l.logger.Info("lane %s is blocked, skipping proposal filling", l.name)
 		return
 	}
@@ -188,6 +201,7 @@ func (l *Lane) FillProposal(
 			// If the transaction is not valid, we skip it.
 			// This should never happen, but we log it for debugging purposes.
 			l.logger.Error("failed to get tx info with err:", err)
+			l.dropTx()
 			continue
 		}
 
@@ -222,7 +236,7 @@ func (l *Lane) FillProposalByIterator(
 	limit BlockSpace,
 ) (blockUsed BlockSpace) {
 	// if the lane is blocked, we do not add any transactions to the proposal.
-	if l.blocked {
+	if l.IsBlocked() {
 		return
 	}
 
@@ -240,6 +254,7 @@ func (l *Lane) FillProposalByIterator(
 			// If the transaction is not valid, we skip it.
 			// This should never happen, but we log it for debugging purposes.
 			l.logger.Error("failed to get tx info with err:", err)
+			l.dropTx()
 			continue
 		}
 
@@ -262,6 +277,16 @@ func (l *Lane) FillProposalByIterator(
 	return
 }
 
+// GetTransactionLimit returns the maximum block space that a transaction in the lane can use.
+func (l *Lane) GetTransactionLimit(ctx sdk.Context) (BlockSpace, error) {
+	consensusParams := ctx.ConsensusParams()
+
+	return NewBlockSpace(
+		uint64(consensusParams.Block.MaxBytes),
+		uint64(consensusParams.Block.MaxGas),
+	).Scale(l.maxTransactionBlockRatio)
+}
+
 // getTxInfo returns various information about the transaction that
 // belongs to the lane including its priority, signer's, sequence number,
 // size and more.
@@ -287,5 +312,70 @@ func (l *Lane) getTxInfo(tx sdk.Tx) (TxWithInfo, error) {
 
 // SetBlocked sets the blocked flag to the given value.
 func (l *Lane) SetBlocked(blocked bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	l.blocked = blocked
 }
+
+// IsBlocked returns true if the transactions in the lane are excluded from the proposal.
+func (l *Lane) IsBlocked() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.blocked
+}
+
+// Name returns the name of the lane.
+func (l *Lane) Name() string {
+	return l.name
+}
+
+// MaxTransactionBlockRatio returns the ratio of the block space that a transaction in the lane can use.
+func (l *Lane) MaxTransactionBlockRatio() math.LegacyDec {
+	return l.maxTransactionBlockRatio
+}
+
+// MaxLaneBlockRatio returns the ratio of the block space that the lane can use in the first round of
+// proposal filling.
+func (l *Lane) MaxLaneBlockRatio() math.LegacyDec {
+	return l.maxLaneBlockRatio
+}
+
+// DroppedTxCount returns the number of transactions that are rejected by the lane's mempool or skipped
+// from the proposal as invalid.
+func (l *Lane) DroppedTxCount() uint64 {
+	return l.droppedTxCount.Load()
+}
+
+// GetTxHashes returns the sorted hashes of the transactions in the lane's mempool.
+func (l *Lane) GetTxHashes() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	hashes := make([]string, 0, len(l.txIndex))
+	for hash := range l.txIndex {
+		hashes = append(hashes, hash)
+	}
+	slices.Sort(hashes)
+
+	return hashes
+}
+
+// dropTx counts a dropped transaction of the lane.
+func (l *Lane) dropTx() {
+	l.droppedTxCount.Add(1)
+	telemetry.IncrCounterWithLabels(
+		[]string{"mempool", "lane", "dropped_txs"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("lane", l.name)},
+	)
+}
+
+// boolToFloat32 returns 1 for true and 0 for false, for gauge metrics.
+func boolToFloat32(b bool) float32 {
+	if b {
+		return 1
+	}
+	return 0
+}
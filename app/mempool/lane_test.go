@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -83,12 +84,23 @@ func (s *LaneTestSuite) TestLaneInsertAndCount() {
 	// Ensure lane sees 2 transactions
 	s.Require().Equal(2, lane.CountTx())
 
+	// Ensure lane lists the sorted hashes of the transactions
+	txInfo1, err := lane.getTxInfo(tx1)
+	s.Require().NoError(err)
+	txInfo2, err := lane.getTxInfo(tx2)
+	s.Require().NoError(err)
+
+	expectedHashes := []string{txInfo1.Hash, txInfo2.Hash}
+	slices.Sort(expectedHashes)
+	s.Require().Equal(expectedHashes, lane.GetTxHashes())
+
 	// Create over gas limit tx
 	tx3 := s.createSimpleTx(s.accounts[2], 0, 100)
 	s.Require().Error(lane.Insert(s.ctx, tx3))
 
-	// Ensure lane does not insert tx3
+	// Ensure lane does not insert tx3 and counts it as dropped
 	s.Require().Equal(2, lane.CountTx())
+	s.Require().Equal(uint64(1), lane.DroppedTxCount())
 
 	// set bytes limit to 1000
 	s.ctx = s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
@@ -102,8 +114,9 @@ func (s *LaneTestSuite) TestLaneInsertAndCount() {
 	tx4 := s.createSimpleTx(s.accounts[2], 0, 0) // 217 bytes
 	s.Require().Error(lane.Insert(s.ctx, tx4))
 
-	// Ensure lane does not insert tx4
+	// Ensure lane does not insert tx4 and counts it as dropped
 	s.Require().Equal(2, lane.CountTx())
+	s.Require().Equal(uint64(2), lane.DroppedTxCount())
 }
 
 func (s *LaneTestSuite) TestLaneRemove() {
@@ -424,7 +437,7 @@ func (s *LaneTestSuite) TestLaneBlocked() {
 	// FillProposal
 	blockUsed, iterator := lane.FillProposal(s.ctx, &proposal)
 
-	s.Require().True(lane.blocked)
+	s.Require().True(lane.IsBlocked())
 
 	// We expect no txs to be included in the proposal.
 	s.Require().Equal(uint64(0), blockUsed.TxBytes())
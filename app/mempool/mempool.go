@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 
 	"cosmossdk.io/log"
 
@@ -13,11 +14,18 @@ import (
 
 var _ sdkmempool.Mempool = (*Mempool)(nil)
 
+// MaxRecentProposals is the number of recent proposal stats kept by the Mempool.
+const MaxRecentProposals = 100
+
 // Mempool implements the sdkmempool.Mempool interface and uses Lanes internally.
 type Mempool struct {
 	logger log.Logger
 
 	lanes []*Lane
+
+	// recentProposals holds the stats of the latest prepared proposals, oldest first.
+	recentProposals []ProposalStats
+	mu              sync.RWMutex
 }
 
 // NewMempool returns a new mempool with the given lanes.
@@ -26,11 +34,39 @@ func NewMempool(
 	lanes []*Lane,
 ) *Mempool {
 	return &Mempool{
-		logger: logger,
-		lanes:  lanes,
+		logger:          logger,
+		lanes:           lanes,
+		recentProposals: make([]ProposalStats, 0, MaxRecentProposals),
 	}
 }
 
+// GetLanes returns the lanes of the mempool in order of priority.
+func (m *Mempool) GetLanes() []*Lane {
+	return m.lanes
+}
+
+// GetRecentProposals returns the stats of the latest prepared proposals, oldest first.
+func (m *Mempool) GetRecentProposals() []ProposalStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	proposals := make([]ProposalStats, len(m.recentProposals))
+	copy(proposals, m.recentProposals)
+
+	return proposals
+}
+
+// recordProposal stores the stats of a prepared proposal, evicting the oldest one if needed.
+func (m *Mempool) recordProposal(stats ProposalStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.recentProposals) >= MaxRecentProposals {
+		m.recentProposals = append(m.recentProposals[:0], m.recentProposals[1:]...)
+	}
+	m.recentProposals = append(m.recentProposals, stats)
+}
+
 // Insert will insert a transaction into the mempool. It inserts the transaction
 // into the first lane that it matches.
 func (m *Mempool) Insert(ctx context.Context, tx sdk.Tx) (err error) {
@@ -96,11 +132,23 @@ func (m *Mempool) Remove(tx sdk.Tx) (err error) {
 func (m *Mempool) PrepareProposal(ctx sdk.Context, proposal Proposal) (Proposal, error) {
 	cacheCtx, _ := ctx.CacheContext()
 
+	stats := ProposalStats{
+		Height:        ctx.BlockHeight(),
+		MaxBlockSpace: proposal.maxBlockSpace,
+		Lanes:         make([]LaneProposalStats, len(m.lanes)),
+	}
+	for i, lane := range m.lanes {
+		stats.Lanes[i].Lane = lane.name
+	}
+
 	// Perform the initial fill of proposals
-	laneIterators, _ := m.fillInitialProposals(cacheCtx, &proposal)
+	laneIterators, _ := m.fillInitialProposals(cacheCtx, &proposal, stats.Lanes)
 
 	// Fill proposals with leftover space
-	m.fillRemainderProposals(&proposal, laneIterators)
+	m.fillRemainderProposals(&proposal, laneIterators, stats.Lanes)
+
+	stats.TotalBlockSpace = proposal.totalBlockSpaceUsed
+	m.recordProposal(stats)
 
 	return proposal, nil
 }
@@ -112,6 +160,7 @@ func (m *Mempool) PrepareProposal(ctx sdk.Context, proposal Proposal) (Proposal,
 func (m *Mempool) fillInitialProposals(
 	ctx sdk.Context,
 	proposal *Proposal,
+	laneStats []LaneProposalStats,
 ) (
 	[]sdkmempool.Iterator,
 	BlockSpace,
@@ -121,9 +170,13 @@ func (m *Mempool) fillInitialProposals(
 	laneIterators := make([]sdkmempool.Iterator, len(m.lanes))
 
 	for i, lane := range m.lanes {
+		txCount := len(proposal.txs)
 		blockUsed, iterator := lane.FillProposal(ctx, proposal)
 		totalBlockUsed = totalBlockUsed.Add(blockUsed)
 
+		laneStats[i].TxCount += uint64(len(proposal.txs) - txCount)
+		laneStats[i].BlockSpace = laneStats[i].BlockSpace.Add(blockUsed)
+
 		laneIterators[i] = iterator
 	}
 
@@ -135,16 +188,124 @@ func (m *Mempool) fillInitialProposals(
 func (m *Mempool) fillRemainderProposals(
 	proposal *Proposal,
 	laneIterators []sdkmempool.Iterator,
+	laneStats []LaneProposalStats,
 ) {
 	for i, lane := range m.lanes {
-		lane.FillProposalByIterator(
+		txCount := len(proposal.txs)
+		blockUsed := lane.FillProposalByIterator(
 			proposal,
 			laneIterators[i],
 			proposal.GetRemainingBlockSpace(),
 		)
+
+		laneStats[i].TxCount += uint64(len(proposal.txs) - txCount)
+		laneStats[i].BlockSpace = laneStats[i].BlockSpace.Add(blockUsed)
 	}
 }
 
+// ProcessProposal checks that the transactions of a proposal can be prepared by PrepareProposal. Each
+// transaction must respect the transaction limit of the first lane it matches, and the lanes must be filled
+// in order, first up to their lane limits and then with the remaining block space. A lane can only be
+// filled with the remaining block space if it has reached its lane limit in the first round. The lanes are
+// matched against the committed state, so all validators derive the same lane for a transaction.
+//
+// The proposer scales the lane limits from a block space that is smaller than the one of the consensus
+// params by the size of the block header, the last commit and the evidence, so the lane limits are checked
+// against the range between the lane limits scaled from minBlockSpace and maxBlockSpace.
+func (m *Mempool) ProcessProposal(ctx sdk.Context, txs []sdk.Tx, maxBlockSpace, minBlockSpace BlockSpace) error {
+	cacheCtx, _ := ctx.CacheContext()
+
+	txLimits := make([]BlockSpace, len(m.lanes))
+	maxLaneLimits := make([]BlockSpace, len(m.lanes))
+	minLaneLimits := make([]BlockSpace, len(m.lanes))
+	for i, lane := range m.lanes {
+		var err error
+		if txLimits[i], err = lane.GetTransactionLimit(cacheCtx); err != nil {
+			return err
+		}
+		if maxLaneLimits[i], err = maxBlockSpace.Scale(lane.maxLaneBlockRatio); err != nil {
+			return err
+		}
+		if minLaneLimits[i], err = minBlockSpace.Scale(lane.maxLaneBlockRatio); err != nil {
+			return err
+		}
+	}
+
+	// laneUsed is the block space used by each lane in the first round.
+	laneUsed := make([]BlockSpace, len(m.lanes))
+	totalUsed := NewBlockSpace(0, 0)
+	inRemainder := false
+	currentLane := 0
+	for i, tx := range txs {
+		laneIdx := m.matchLane(cacheCtx, tx)
+		if laneIdx < 0 {
+			return &ProposalError{TxIndex: i, Reason: "transaction matches no lane"}
+		}
+		lane := m.lanes[laneIdx]
+
+		txInfo, err := lane.getTxInfo(tx)
+		if err != nil {
+			return &ProposalError{Lane: lane.name, TxIndex: i, Reason: err.Error()}
+		}
+		if txLimits[laneIdx].IsExceededBy(txInfo.BlockSpace) {
+			return &ProposalError{
+				Lane:    lane.name,
+				TxIndex: i,
+				Reason:  fmt.Sprintf("transaction exceeds limit: limit %s, tx_size %s", txLimits[laneIdx], txInfo.BlockSpace),
+			}
+		}
+		totalUsed = totalUsed.Add(txInfo.BlockSpace)
+		if maxBlockSpace.IsExceededBy(totalUsed) {
+			return &ProposalError{
+				Lane:    lane.name,
+				TxIndex: i,
+				Reason:  fmt.Sprintf("proposal exceeds max block space: %s > %s", totalUsed, maxBlockSpace),
+			}
+		}
+
+		// The transaction is in the first round as long as the lanes are in order and the lane limit is not
+		// reached, the same way as FillProposal adds transactions.
+		if !inRemainder && laneIdx >= currentLane && !maxLaneLimits[laneIdx].IsReachedBy(laneUsed[laneIdx]) {
+			currentLane = laneIdx
+			laneUsed[laneIdx] = laneUsed[laneIdx].Add(txInfo.BlockSpace)
+			continue
+		}
+
+		if !inRemainder {
+			inRemainder = true
+			currentLane = 0
+		}
+		if laneIdx < currentLane {
+			return &ProposalError{Lane: lane.name, TxIndex: i, Reason: "transaction is out of lane order"}
+		}
+		if !minLaneLimits[laneIdx].IsReachedBy(laneUsed[laneIdx]) {
+			return &ProposalError{
+				Lane:    lane.name,
+				TxIndex: i,
+				Reason: fmt.Sprintf(
+					"lane is filled with remaining block space before reaching its lane limit: limit %s, used %s",
+					minLaneLimits[laneIdx],
+					laneUsed[laneIdx],
+				),
+			}
+		}
+		currentLane = laneIdx
+	}
+
+	return nil
+}
+
+// matchLane returns the index of the first lane that the transaction matches, or -1 if there is none.
+func (m *Mempool) matchLane(ctx sdk.Context, tx sdk.Tx) int {
+	for i, lane := range m.lanes {
+		if lane.Match(ctx, tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
 // Contains returns true if the transaction is contained in any of the lanes.
 func (m *Mempool) Contains(tx sdk.Tx) (contains bool) {
 	defer func() {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -106,6 +107,8 @@ func CreateTestEncodingConfig() EncodingConfig {
 
 	std.RegisterLegacyAminoCodec(legacyAmino)
 	std.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	stakingtypes.RegisterInterfaces(interfaceRegistry)
 
 	return EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
@@ -505,6 +508,82 @@ func (s *MempoolTestSuite) TestFillUpLeftOverSpace() {
 	s.Require().Equal(expectedIncludedTxs, result.txs)
 }
 
+func (s *MempoolTestSuite) TestRecentProposals() {
+	bankTx, err := CreateBankSendTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		0,
+		20,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	delegateTx1, err := CreateDelegateTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		0,
+		20,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	delegateTx2, err := CreateDelegateTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		1,
+		0,
+		20,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	mem := s.newMempool()
+	s.Require().NoError(mem.Insert(s.ctx, bankTx))
+	s.Require().NoError(mem.Insert(s.ctx, delegateTx1))
+	s.Require().NoError(mem.Insert(s.ctx, delegateTx2))
+
+	s.Require().Empty(mem.GetRecentProposals())
+
+	for i := 0; i < MaxRecentProposals+1; i++ {
+		proposal := NewProposal(
+			log.NewTestLogger(s.T()),
+			uint64(s.ctx.ConsensusParams().Block.MaxBytes),
+			uint64(s.ctx.ConsensusParams().Block.MaxGas),
+		)
+
+		_, err = mem.PrepareProposal(s.ctx.WithBlockHeight(int64(i+2)), proposal)
+		s.Require().NoError(err)
+	}
+
+	proposals := mem.GetRecentProposals()
+	s.Require().Len(proposals, MaxRecentProposals)
+	// the stats of the first proposal should be evicted
+	s.Require().Equal(int64(3), proposals[0].Height)
+
+	stats := proposals[len(proposals)-1]
+	s.Require().Equal(int64(MaxRecentProposals+2), stats.Height)
+	s.Require().Equal(uint64(100), stats.MaxBlockSpace.Gas())
+	s.Require().Equal(uint64(60), stats.TotalBlockSpace.Gas())
+	s.Require().Len(stats.Lanes, 3)
+
+	// bankSend lane includes 1 tx, delegate lane includes 1 tx in the first round and 1 tx with the remaining space
+	s.Require().Equal("bankSend", stats.Lanes[0].Lane)
+	s.Require().Equal(uint64(1), stats.Lanes[0].TxCount)
+	s.Require().Equal(uint64(20), stats.Lanes[0].BlockSpace.Gas())
+	s.Require().Equal("delegate", stats.Lanes[1].Lane)
+	s.Require().Equal(uint64(2), stats.Lanes[1].TxCount)
+	s.Require().Equal(uint64(40), stats.Lanes[1].BlockSpace.Gas())
+	s.Require().Equal("other", stats.Lanes[2].Lane)
+	s.Require().Equal(uint64(0), stats.Lanes[2].TxCount)
+	s.Require().Equal(uint64(0), stats.Lanes[2].BlockSpace.Gas())
+	s.Require().Equal(
+		stats.TotalBlockSpace.TxBytes(),
+		stats.Lanes[0].BlockSpace.TxBytes()+stats.Lanes[1].BlockSpace.TxBytes(),
+	)
+}
+
 func (s *MempoolTestSuite) TestDependencyBlockLane() {
 	DependentLane := NewLane(
 		log.NewTestLogger(s.T()),
@@ -602,6 +681,177 @@ func (s *MempoolTestSuite) TestDependencyBlockLane() {
 	s.Require().Equal(expectedIncludedTxs, result.txs)
 }
 
+func (s *MempoolTestSuite) TestProcessProposal() {
+	createBankTx := func(nonce, gasLimit uint64) sdk.Tx {
+		tx, err := CreateBankSendTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			nonce,
+			0,
+			gasLimit,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		)
+		s.Require().NoError(err)
+		return tx
+	}
+	createDelegateTx := func(nonce, gasLimit uint64) sdk.Tx {
+		tx, err := CreateDelegateTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			nonce,
+			0,
+			gasLimit,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		)
+		s.Require().NoError(err)
+		return tx
+	}
+
+	bankTx1 := createBankTx(0, 20)
+	bankTx2 := createBankTx(1, 20)
+	bankTx3 := createBankTx(2, 10)
+	delegateTx1 := createDelegateTx(0, 20)
+	delegateTx2 := createDelegateTx(1, 20)
+	delegateTx3 := createDelegateTx(2, 10)
+	bigBankTx := createBankTx(3, 30)
+
+	testCases := []struct {
+		name    string
+		txs     []sdk.Tx
+		errLane string
+	}{
+		{
+			name: "empty proposal",
+			txs:  []sdk.Tx{},
+		},
+		{
+			name: "lanes filled in order then remaining space",
+			txs:  []sdk.Tx{bankTx1, bankTx2, delegateTx1, delegateTx2, bankTx3},
+		},
+		{
+			name: "lanes filled in order without remaining space",
+			txs:  []sdk.Tx{bankTx1, delegateTx1},
+		},
+		{
+			name:    "lane out of order",
+			txs:     []sdk.Tx{delegateTx1, bankTx1},
+			errLane: "bankSend",
+		},
+		{
+			name:    "lane out of order in remaining space",
+			txs:     []sdk.Tx{bankTx1, bankTx2, delegateTx1, delegateTx2, delegateTx3, bankTx3},
+			errLane: "bankSend",
+		},
+		{
+			name:    "lane exceeds lane limit",
+			txs:     []sdk.Tx{bankTx1, bankTx2, bankTx3, delegateTx1},
+			errLane: "delegate",
+		},
+		{
+			name:    "transaction exceeds transaction limit",
+			txs:     []sdk.Tx{bigBankTx},
+			errLane: "bankSend",
+		},
+		{
+			name:    "proposal exceeds max block space",
+			txs:     []sdk.Tx{bankTx1, bankTx2, delegateTx1, delegateTx2, delegateTx3, bigBankTx},
+			errLane: "bankSend",
+		},
+	}
+
+	maxBlockSpace := NewBlockSpace(
+		uint64(s.ctx.ConsensusParams().Block.MaxBytes),
+		uint64(s.ctx.ConsensusParams().Block.MaxGas),
+	)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mem := s.newMempool()
+			err := mem.ProcessProposal(s.ctx, tc.txs, maxBlockSpace, maxBlockSpace)
+			if tc.errLane == "" {
+				s.Require().NoError(err)
+				return
+			}
+
+			var proposalErr *ProposalError
+			s.Require().ErrorAs(err, &proposalErr)
+			s.Require().Equal(tc.errLane, proposalErr.Lane)
+			s.Require().Equal(len(tc.txs)-1, proposalErr.TxIndex)
+		})
+	}
+}
+
+func (s *MempoolTestSuite) TestProcessProposalHandler() {
+	bankTx, err := CreateBankSendTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		0,
+		20,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	delegateTx, err := CreateDelegateTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		0,
+		20,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	mem := s.newMempool()
+	s.Require().NoError(mem.Insert(s.ctx, delegateTx))
+	s.Require().NoError(mem.Insert(s.ctx, bankTx))
+
+	handler := NewProposalHandler(
+		log.NewTestLogger(s.T()),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		mem,
+	)
+
+	prepareResp, err := handler.PrepareProposalHandler()(
+		s.ctx,
+		&abci.RequestPrepareProposal{Height: 2, MaxTxBytes: s.ctx.ConsensusParams().Block.MaxBytes},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(s.getTxBytes(bankTx, delegateTx), prepareResp.Txs)
+
+	testCases := []struct {
+		name   string
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			name:   "prepared proposal",
+			txs:    prepareResp.Txs,
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:   "lane out of order",
+			txs:    s.getTxBytes(delegateTx, bankTx),
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name:   "undecodable transaction",
+			txs:    [][]byte{[]byte("invalid")},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := handler.ProcessProposalHandler()(
+				s.ctx,
+				&abci.RequestProcessProposal{Height: 2, Txs: tc.txs},
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Tx creation helpers
 // -----------------------------------------------------------------------------
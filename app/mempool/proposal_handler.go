@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"errors"
 	"fmt"
 	"math"
 
@@ -9,7 +10,6 @@ import (
 
 	"cosmossdk.io/log"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -85,9 +85,59 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	}
 }
 
-// ProcessProposalHandler returns a no-op process proposal handler.
+// ProcessProposalHandler checks that the proposal respects the lane limits and order of the Mempool.
 func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
-	return baseapp.NoOpProcessProposal()
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
+		// For height <= 1, just accept the proposal, the same as PrepareProposal returns the default TXs.
+		if req.Height <= 1 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+
+		defer func() {
+			if rec := recover(); rec != nil {
+				h.logger.Error("failed to process proposal", "err", rec)
+				resp = &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+				err = fmt.Errorf("failed to process proposal: %v", rec)
+			}
+		}()
+
+		txs := make([]sdk.Tx, len(req.Txs))
+		for i, txBytes := range req.Txs {
+			tx, err := h.txDecoder(txBytes)
+			if err != nil {
+				h.logger.Error("rejected proposal with undecodable tx", "height", req.Height, "tx_index", i, "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			txs[i] = tx
+		}
+
+		maxBytesLimit, maxGasLimit := getBlockLimits(ctx)
+		minBytesLimit := getMinTxBytesLimit(ctx, maxBytesLimit, len(req.ProposedLastCommit.Votes))
+
+		err = h.mempool.ProcessProposal(
+			ctx,
+			txs,
+			NewBlockSpace(maxBytesLimit, maxGasLimit),
+			NewBlockSpace(minBytesLimit, maxGasLimit),
+		)
+		if err != nil {
+			var proposalErr *ProposalError
+			if errors.As(err, &proposalErr) {
+				h.logger.Error(
+					"rejected proposal violating lane",
+					"height", req.Height,
+					"lane", proposalErr.Lane,
+					"tx_index", proposalErr.TxIndex,
+					"reason", proposalErr.Reason,
+				)
+			} else {
+				h.logger.Error("failed to process proposal", "height", req.Height, "err", err)
+			}
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
 }
 
 // getBlockLimits retrieves the maximum block size and gas limit from context.
@@ -110,3 +160,17 @@ func getBlockLimits(ctx sdk.Context) (uint64, uint64) {
 
 	return maxBytesLimit, maxGasLimit
 }
+
+// getMinTxBytesLimit returns the lower bound of the MaxTxBytes that the proposer prepares the proposal with,
+// which is the block size without the maximum size of the block header, the last commit and the evidence.
+func getMinTxBytesLimit(ctx sdk.Context, maxBytesLimit uint64, valsCount int) uint64 {
+	overhead := uint64(comettypes.MaxOverheadForBlock + comettypes.MaxHeaderBytes + comettypes.MaxCommitBytes(valsCount))
+	if evidenceParams := ctx.ConsensusParams().Evidence; evidenceParams != nil && evidenceParams.MaxBytes > 0 {
+		overhead += uint64(evidenceParams.MaxBytes)
+	}
+
+	if overhead >= maxBytesLimit {
+		return 0
+	}
+	return maxBytesLimit - overhead
+}
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"fmt"
 	"reflect"
 	"slices"
 
@@ -18,6 +19,43 @@ type TxWithInfo struct {
 	TxBytes []byte
 }
 
+// ProposalError is returned when a transaction in a proposal violates the limits or the order of its lane.
+type ProposalError struct {
+	// Lane is the name of the lane of the transaction, or empty if the transaction matches no lane.
+	Lane string
+	// TxIndex is the index of the transaction in the proposal.
+	TxIndex int
+	// Reason describes the violation.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ProposalError) Error() string {
+	return fmt.Sprintf("invalid proposal: tx %d in lane %q: %s", e.TxIndex, e.Lane, e.Reason)
+}
+
+// ProposalStats holds the block space usage of a proposal prepared by the Mempool.
+type ProposalStats struct {
+	// Height is the height of the proposed block.
+	Height int64
+	// MaxBlockSpace is the maximum block space available for the proposal.
+	MaxBlockSpace BlockSpace
+	// TotalBlockSpace is the total block space used by the proposal.
+	TotalBlockSpace BlockSpace
+	// Lanes is the usage of each lane in the proposal, in the order of the lanes.
+	Lanes []LaneProposalStats
+}
+
+// LaneProposalStats holds the usage of a lane in a proposal.
+type LaneProposalStats struct {
+	// Lane is the name of the lane.
+	Lane string
+	// TxCount is the number of transactions of the lane in the proposal.
+	TxCount uint64
+	// BlockSpace is the block space used by the transactions of the lane.
+	BlockSpace BlockSpace
+}
+
 type TxMatchFn func(sdk.Context, sdk.Tx) bool
 
 func NewLaneTxMatchFn(msgs []sdk.Msg, onlyFree bool) TxMatchFn {
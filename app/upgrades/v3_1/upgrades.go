@@ -0,0 +1,43 @@
+package v3_1
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/bandprotocol/chain/v3/app/keepers"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+
+		// Run the x/oracle migration that records the first version of every data source and oracle script
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// Set the oracle params that are added in this version to their default values
+		params := keepers.OracleKeeper.GetParams(ctx)
+		params.LatestResultRetention = oracletypes.DefaultLatestResultRetention
+		params.MaxSubscriptionRunsPerBlock = oracletypes.DefaultMaxSubscriptionRuns
+		params.ResultRetentionPeriod = oracletypes.DefaultResultRetentionPeriod
+		params.ResultRetentionCount = oracletypes.DefaultResultRetentionCount
+		params.MaxResultPrunesPerBlock = oracletypes.DefaultMaxResultPrunes
+		params.SubscriptionGasPrices = oracletypes.DefaultSubscriptionGasPrices
+		if err := keepers.OracleKeeper.SetParams(ctx, params); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
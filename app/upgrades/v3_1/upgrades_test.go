@@ -0,0 +1,112 @@
+package v3_1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/app/upgrades"
+	"github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	app *band.BandApp
+	ctx sdk.Context
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	bandtesting.SetCustomUpgrades([]upgrades.Upgrade{v3_1.Upgrade})
+
+	dir := testutil.GetTempDir(s.T())
+	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	s.ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	// Activate validators
+	for _, v := range bandtesting.Validators {
+		err := s.app.OracleKeeper.Activate(s.ctx, v.ValAddress)
+		s.Require().NoError(err)
+	}
+
+	_, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.app.LastBlockHeight() + 1})
+	s.Require().NoError(err)
+	_, err = s.app.Commit()
+	s.Require().NoError(err)
+}
+
+// Ensures the test does not error out.
+func (s *UpgradeTestSuite) TestUpgrade() {
+	preUpgradeChecks(s)
+
+	upgradeHeight := int64(2)
+	s.ConfirmUpgradeSucceeded(v3_1.UpgradeName, upgradeHeight)
+
+	postUpgradeChecks(s)
+}
+
+func preUpgradeChecks(s *UpgradeTestSuite) {
+	// Revert the oracle state to the previous consensus version
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	vm[oracletypes.ModuleName] = 2
+	s.Require().NoError(s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, vm))
+
+	store := s.ctx.KVStore(s.app.GetKey(oracletypes.StoreKey))
+	store.Delete(oracletypes.DataSourceVersionStoreKey(1, 1))
+	store.Delete(oracletypes.OracleScriptVersionStoreKey(1, 1))
+	s.Require().False(s.app.OracleKeeper.HasDataSourceVersion(s.ctx, 1, 1))
+
+	params := s.app.OracleKeeper.GetParams(s.ctx)
+	params.LatestResultRetention = 0
+	params.MaxSubscriptionRunsPerBlock = 0
+	params.SubscriptionGasPrices = nil
+	s.Require().NoError(s.app.OracleKeeper.SetParams(s.ctx, params))
+}
+
+func postUpgradeChecks(s *UpgradeTestSuite) {
+	// Verify changes made by the upgrade
+	dataSource, err := s.app.OracleKeeper.GetDataSource(s.ctx, 1)
+	s.Require().NoError(err)
+	dataSourceVersion, err := s.app.OracleKeeper.GetLatestDataSourceVersion(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), dataSourceVersion.Version)
+	s.Require().Equal(dataSource.Filename, dataSourceVersion.Filename)
+	s.Require().True(s.app.OracleKeeper.HasOracleScriptVersion(s.ctx, 1, 1))
+
+	params := s.app.OracleKeeper.GetParams(s.ctx)
+	s.Require().Equal(oracletypes.DefaultLatestResultRetention, params.LatestResultRetention)
+	s.Require().Equal(oracletypes.DefaultMaxSubscriptionRuns, params.MaxSubscriptionRunsPerBlock)
+	s.Require().Equal(oracletypes.DefaultSubscriptionGasPrices, params.SubscriptionGasPrices)
+
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), vm[oracletypes.ModuleName])
+}
+
+func (s *UpgradeTestSuite) ConfirmUpgradeSucceeded(upgradeName string, upgradeHeight int64) {
+	plan := upgradetypes.Plan{Name: upgradeName, Height: upgradeHeight}
+	err := s.app.AppKeepers.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	_, err = s.app.AppKeepers.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(upgradeHeight)
+	_, err = s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.ctx.BlockHeight()})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,2594 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: band/base/mempool/v1/query.proto
+
+package mempool
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LanesRequest is request type for the Service/Lanes RPC method.
+type LanesRequest struct {
+}
+
+func (m *LanesRequest) Reset()         { *m = LanesRequest{} }
+func (m *LanesRequest) String() string { return proto.CompactTextString(m) }
+func (*LanesRequest) ProtoMessage()    {}
+func (*LanesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{0}
+}
+func (m *LanesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LanesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LanesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LanesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanesRequest.Merge(m, src)
+}
+func (m *LanesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LanesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanesRequest proto.InternalMessageInfo
+
+// LanesResponse is response type for the Service/Lanes RPC method.
+type LanesResponse struct {
+	// Lanes is the list of lanes in order of priority
+	Lanes []LaneInfo `protobuf:"bytes,1,rep,name=lanes,proto3" json:"lanes"`
+}
+
+func (m *LanesResponse) Reset()         { *m = LanesResponse{} }
+func (m *LanesResponse) String() string { return proto.CompactTextString(m) }
+func (*LanesResponse) ProtoMessage()    {}
+func (*LanesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{1}
+}
+func (m *LanesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LanesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LanesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LanesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanesResponse.Merge(m, src)
+}
+func (m *LanesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LanesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanesResponse proto.InternalMessageInfo
+
+func (m *LanesResponse) GetLanes() []LaneInfo {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
+}
+
+// LaneTxsRequest is request type for the Service/LaneTxs RPC method.
+type LaneTxsRequest struct {
+	// Lane is the name of the lane
+	Lane string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	// Pagination is an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LaneTxsRequest) Reset()         { *m = LaneTxsRequest{} }
+func (m *LaneTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*LaneTxsRequest) ProtoMessage()    {}
+func (*LaneTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{2}
+}
+func (m *LaneTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneTxsRequest.Merge(m, src)
+}
+func (m *LaneTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneTxsRequest proto.InternalMessageInfo
+
+func (m *LaneTxsRequest) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *LaneTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// LaneTxsResponse is response type for the Service/LaneTxs RPC method.
+type LaneTxsResponse struct {
+	// TxHashes is the list of hex-encoded transaction hashes sorted in ascending order
+	TxHashes []string `protobuf:"bytes,1,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	// Pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LaneTxsResponse) Reset()         { *m = LaneTxsResponse{} }
+func (m *LaneTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*LaneTxsResponse) ProtoMessage()    {}
+func (*LaneTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{3}
+}
+func (m *LaneTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneTxsResponse.Merge(m, src)
+}
+func (m *LaneTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneTxsResponse proto.InternalMessageInfo
+
+func (m *LaneTxsResponse) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *LaneTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ProposalsRequest is request type for the Service/Proposals RPC method.
+type ProposalsRequest struct {
+}
+
+func (m *ProposalsRequest) Reset()         { *m = ProposalsRequest{} }
+func (m *ProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposalsRequest) ProtoMessage()    {}
+func (*ProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{4}
+}
+func (m *ProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalsRequest.Merge(m, src)
+}
+func (m *ProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalsRequest proto.InternalMessageInfo
+
+// ProposalsResponse is response type for the Service/Proposals RPC method.
+type ProposalsResponse struct {
+	// Proposals is the list of recent proposals, oldest first
+	Proposals []ProposalStats `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *ProposalsResponse) Reset()         { *m = ProposalsResponse{} }
+func (m *ProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposalsResponse) ProtoMessage()    {}
+func (*ProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{5}
+}
+func (m *ProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalsResponse.Merge(m, src)
+}
+func (m *ProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalsResponse proto.InternalMessageInfo
+
+func (m *ProposalsResponse) GetProposals() []ProposalStats {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+// LaneInfo is the data structure for storing the current state of a lane
+type LaneInfo struct {
+	// Name is the name of the lane
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// TxCount is the number of transactions in the lane
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// Blocked indicates whether the lane is excluded from the next proposal
+	Blocked bool `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// DroppedTxCount is the number of transactions rejected or skipped by the lane since the node started
+	DroppedTxCount uint64 `protobuf:"varint,4,opt,name=dropped_tx_count,json=droppedTxCount,proto3" json:"dropped_tx_count,omitempty"`
+	// MaxTransactionBlockRatio is the ratio of the block space that a transaction in the lane can use
+	MaxTransactionBlockRatio string `protobuf:"bytes,5,opt,name=max_transaction_block_ratio,json=maxTransactionBlockRatio,proto3" json:"max_transaction_block_ratio,omitempty"`
+	// MaxLaneBlockRatio is the ratio of the block space that the lane can use in the first round
+	MaxLaneBlockRatio string `protobuf:"bytes,6,opt,name=max_lane_block_ratio,json=maxLaneBlockRatio,proto3" json:"max_lane_block_ratio,omitempty"`
+}
+
+func (m *LaneInfo) Reset()         { *m = LaneInfo{} }
+func (m *LaneInfo) String() string { return proto.CompactTextString(m) }
+func (*LaneInfo) ProtoMessage()    {}
+func (*LaneInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{6}
+}
+func (m *LaneInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneInfo.Merge(m, src)
+}
+func (m *LaneInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneInfo proto.InternalMessageInfo
+
+func (m *LaneInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LaneInfo) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *LaneInfo) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *LaneInfo) GetDroppedTxCount() uint64 {
+	if m != nil {
+		return m.DroppedTxCount
+	}
+	return 0
+}
+
+func (m *LaneInfo) GetMaxTransactionBlockRatio() string {
+	if m != nil {
+		return m.MaxTransactionBlockRatio
+	}
+	return ""
+}
+
+func (m *LaneInfo) GetMaxLaneBlockRatio() string {
+	if m != nil {
+		return m.MaxLaneBlockRatio
+	}
+	return ""
+}
+
+// ProposalStats is the data structure for storing the block space usage of a proposal
+type ProposalStats struct {
+	// Height is the height of the proposed block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// MaxBlockSpace is the maximum block space available for the proposal
+	MaxBlockSpace BlockSpace `protobuf:"bytes,2,opt,name=max_block_space,json=maxBlockSpace,proto3" json:"max_block_space"`
+	// TotalBlockSpace is the total block space used by the proposal
+	TotalBlockSpace BlockSpace `protobuf:"bytes,3,opt,name=total_block_space,json=totalBlockSpace,proto3" json:"total_block_space"`
+	// Lanes is the usage of each lane in the proposal
+	Lanes []LaneUsage `protobuf:"bytes,4,rep,name=lanes,proto3" json:"lanes"`
+}
+
+func (m *ProposalStats) Reset()         { *m = ProposalStats{} }
+func (m *ProposalStats) String() string { return proto.CompactTextString(m) }
+func (*ProposalStats) ProtoMessage()    {}
+func (*ProposalStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{7}
+}
+func (m *ProposalStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalStats.Merge(m, src)
+}
+func (m *ProposalStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalStats proto.InternalMessageInfo
+
+func (m *ProposalStats) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProposalStats) GetMaxBlockSpace() BlockSpace {
+	if m != nil {
+		return m.MaxBlockSpace
+	}
+	return BlockSpace{}
+}
+
+func (m *ProposalStats) GetTotalBlockSpace() BlockSpace {
+	if m != nil {
+		return m.TotalBlockSpace
+	}
+	return BlockSpace{}
+}
+
+func (m *ProposalStats) GetLanes() []LaneUsage {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
+}
+
+// LaneUsage is the data structure for storing the usage of a lane in a proposal
+type LaneUsage struct {
+	// Lane is the name of the lane
+	Lane string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	// TxCount is the number of transactions of the lane in the proposal
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// BlockSpace is the block space used by the transactions of the lane
+	BlockSpace BlockSpace `protobuf:"bytes,3,opt,name=block_space,json=blockSpace,proto3" json:"block_space"`
+}
+
+func (m *LaneUsage) Reset()         { *m = LaneUsage{} }
+func (m *LaneUsage) String() string { return proto.CompactTextString(m) }
+func (*LaneUsage) ProtoMessage()    {}
+func (*LaneUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{8}
+}
+func (m *LaneUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneUsage.Merge(m, src)
+}
+func (m *LaneUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneUsage proto.InternalMessageInfo
+
+func (m *LaneUsage) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *LaneUsage) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *LaneUsage) GetBlockSpace() BlockSpace {
+	if m != nil {
+		return m.BlockSpace
+	}
+	return BlockSpace{}
+}
+
+// BlockSpace is the data structure for storing the size and gas of transactions
+type BlockSpace struct {
+	TxBytes uint64 `protobuf:"varint,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	Gas     uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *BlockSpace) Reset()         { *m = BlockSpace{} }
+func (m *BlockSpace) String() string { return proto.CompactTextString(m) }
+func (*BlockSpace) ProtoMessage()    {}
+func (*BlockSpace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115b836e27854202, []int{9}
+}
+func (m *BlockSpace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSpace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSpace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSpace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSpace.Merge(m, src)
+}
+func (m *BlockSpace) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSpace) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSpace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSpace proto.InternalMessageInfo
+
+func (m *BlockSpace) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *BlockSpace) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LanesRequest)(nil), "band.base.mempool.v1.LanesRequest")
+	proto.RegisterType((*LanesResponse)(nil), "band.base.mempool.v1.LanesResponse")
+	proto.RegisterType((*LaneTxsRequest)(nil), "band.base.mempool.v1.LaneTxsRequest")
+	proto.RegisterType((*LaneTxsResponse)(nil), "band.base.mempool.v1.LaneTxsResponse")
+	proto.RegisterType((*ProposalsRequest)(nil), "band.base.mempool.v1.ProposalsRequest")
+	proto.RegisterType((*ProposalsResponse)(nil), "band.base.mempool.v1.ProposalsResponse")
+	proto.RegisterType((*LaneInfo)(nil), "band.base.mempool.v1.LaneInfo")
+	proto.RegisterType((*ProposalStats)(nil), "band.base.mempool.v1.ProposalStats")
+	proto.RegisterType((*LaneUsage)(nil), "band.base.mempool.v1.LaneUsage")
+	proto.RegisterType((*BlockSpace)(nil), "band.base.mempool.v1.BlockSpace")
+}
+
+func init() { proto.RegisterFile("band/base/mempool/v1/query.proto", fileDescriptor_115b836e27854202) }
+
+var fileDescriptor_115b836e27854202 = []byte{
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x2d, 0xd9, 0x92, 0xc6, 0xf5, 0xd7, 0xc2, 0x28, 0x54, 0xb9, 0x95, 0x55, 0xba, 0xb5,
+	0x85, 0x1e, 0xb8, 0x90, 0xdd, 0x4b, 0x5b, 0xf4, 0xa2, 0x02, 0x75, 0x8b, 0x16, 0x86, 0x41, 0xbb,
+	0x97, 0xa2, 0x80, 0xb0, 0xa4, 0xb6, 0x14, 0x51, 0x92, 0x4b, 0x73, 0x57, 0x2a, 0x8d, 0x20, 0x97,
+	0x04, 0x09, 0x72, 0x4c, 0x90, 0x3f, 0xe5, 0xa3, 0x81, 0x5c, 0x72, 0x0a, 0x02, 0x3b, 0xd7, 0xfc,
+	0x87, 0x60, 0x97, 0x4b, 0x4a, 0x02, 0x64, 0x19, 0xc9, 0xc9, 0xbb, 0xb3, 0xf3, 0xde, 0x9b, 0x79,
+	0xf4, 0x8c, 0xa0, 0xed, 0x90, 0x68, 0x80, 0x1d, 0xc2, 0x29, 0x0e, 0x69, 0x18, 0x33, 0x16, 0xe0,
+	0x71, 0x17, 0x5f, 0x8c, 0x68, 0x72, 0x69, 0xc5, 0x09, 0x13, 0x0c, 0x6d, 0xcb, 0x0c, 0x4b, 0x66,
+	0x58, 0x3a, 0xc3, 0x1a, 0x77, 0x9b, 0x5f, 0x7a, 0x8c, 0x79, 0x01, 0xc5, 0x24, 0xf6, 0x31, 0x89,
+	0x22, 0x26, 0x88, 0xf0, 0x59, 0xc4, 0x33, 0x4c, 0x73, 0xdb, 0x63, 0x1e, 0x53, 0x47, 0x2c, 0x4f,
+	0x3a, 0xfa, 0x9d, 0xcb, 0x78, 0xc8, 0x78, 0xa6, 0xa6, 0x24, 0xf0, 0xb8, 0xeb, 0x50, 0x41, 0xba,
+	0x38, 0x26, 0x9e, 0x1f, 0x29, 0x8a, 0x2c, 0xd7, 0x5c, 0x87, 0xcf, 0xfe, 0x24, 0x11, 0xe5, 0x36,
+	0xbd, 0x18, 0x51, 0x2e, 0xcc, 0x3f, 0x60, 0x4d, 0xdf, 0x79, 0xcc, 0x22, 0x4e, 0xd1, 0x8f, 0xb0,
+	0x1c, 0xc8, 0x40, 0xc3, 0x68, 0x97, 0x3b, 0xab, 0x87, 0x2d, 0x6b, 0x5e, 0x99, 0x96, 0xc4, 0xfc,
+	0x1e, 0xfd, 0xcb, 0x7a, 0x95, 0xab, 0x37, 0xbb, 0x25, 0x3b, 0x83, 0x98, 0x01, 0xac, 0xcb, 0x87,
+	0xf3, 0x34, 0xa7, 0x47, 0x08, 0x2a, 0xf2, 0xa9, 0x61, 0xb4, 0x8d, 0x4e, 0xdd, 0x56, 0x67, 0xf4,
+	0x2b, 0xc0, 0xa4, 0xac, 0xc6, 0x52, 0xdb, 0xe8, 0xac, 0x1e, 0xee, 0x5b, 0x59, 0x0f, 0x99, 0x50,
+	0x66, 0x93, 0xee, 0xc1, 0x3a, 0x25, 0x1e, 0xd5, 0x7c, 0xf6, 0x14, 0xd2, 0xfc, 0x1f, 0x36, 0x0a,
+	0x35, 0x5d, 0xfc, 0x0e, 0xd4, 0x45, 0xda, 0x1f, 0x12, 0x3e, 0xd4, 0x0d, 0xd4, 0xed, 0x9a, 0x48,
+	0x7f, 0x53, 0x77, 0x74, 0x3c, 0x47, 0xf7, 0xe0, 0x5e, 0xdd, 0x8c, 0x79, 0x46, 0x18, 0xc1, 0xe6,
+	0x69, 0xc2, 0x62, 0xc6, 0x49, 0x50, 0xf8, 0xf8, 0x0f, 0x6c, 0x4d, 0xc5, 0x74, 0x39, 0xc7, 0x50,
+	0x8f, 0xf3, 0xa0, 0xf6, 0x73, 0x6f, 0xbe, 0x9f, 0x39, 0xf6, 0x4c, 0x10, 0xc1, 0xb5, 0xa9, 0x13,
+	0xac, 0xf9, 0xde, 0x80, 0x5a, 0x6e, 0xb9, 0xf4, 0x34, 0x22, 0x61, 0xe1, 0xa9, 0x3c, 0xa3, 0x2f,
+	0xa0, 0x26, 0xd2, 0xbe, 0xcb, 0x46, 0x91, 0x50, 0x9d, 0x55, 0xec, 0xaa, 0x48, 0x7f, 0x91, 0x57,
+	0xd4, 0x80, 0xaa, 0x13, 0x30, 0xf7, 0x3f, 0x3a, 0x68, 0x94, 0xdb, 0x46, 0xa7, 0x66, 0xe7, 0x57,
+	0xd4, 0x81, 0xcd, 0x41, 0xc2, 0xe2, 0x98, 0x0e, 0xfa, 0x05, 0xb8, 0xa2, 0xc0, 0xeb, 0x3a, 0x7e,
+	0xae, 0x39, 0x7e, 0x86, 0x9d, 0x90, 0xa4, 0x7d, 0x91, 0x90, 0x88, 0x13, 0x57, 0x9a, 0xd0, 0x57,
+	0x24, 0xfd, 0x44, 0x3a, 0xd2, 0x58, 0x56, 0x95, 0x34, 0x42, 0x92, 0x9e, 0x4f, 0x32, 0x7a, 0x32,
+	0xc1, 0x96, 0xef, 0x08, 0xc3, 0xb6, 0x84, 0xcb, 0xaf, 0x3f, 0x83, 0x5b, 0x51, 0xb8, 0xad, 0x90,
+	0xa4, 0xb2, 0xb9, 0x09, 0xc0, 0x7c, 0xb6, 0x04, 0x6b, 0x33, 0x96, 0xa0, 0xcf, 0x61, 0x65, 0x48,
+	0x7d, 0x6f, 0x28, 0x54, 0xdb, 0x65, 0x5b, 0xdf, 0xd0, 0x09, 0x6c, 0x48, 0xea, 0x8c, 0x95, 0xc7,
+	0xc4, 0xa5, 0xfa, 0xcb, 0xb6, 0xe7, 0x1b, 0xad, 0x44, 0xce, 0x64, 0x9e, 0x76, 0x79, 0x2d, 0x24,
+	0xe9, 0x24, 0x88, 0x6c, 0xd8, 0x12, 0x4c, 0x90, 0x60, 0x86, 0xb1, 0xfc, 0x51, 0x8c, 0x1b, 0x8a,
+	0x60, 0x8a, 0xf3, 0xa7, 0x7c, 0xa4, 0x2a, 0xea, 0x5f, 0x60, 0xf7, 0xee, 0x91, 0xfa, 0x8b, 0x13,
+	0x8f, 0xce, 0xce, 0xd4, 0x63, 0x03, 0xea, 0xc5, 0xd3, 0xdc, 0x79, 0x5a, 0xf0, 0xed, 0x8f, 0x61,
+	0xf5, 0xd3, 0xfb, 0x00, 0xa7, 0x88, 0x98, 0x3f, 0x00, 0x4c, 0x35, 0x94, 0x29, 0x3a, 0x97, 0x42,
+	0x4d, 0x99, 0x56, 0xec, 0xc9, 0x2b, 0xda, 0x84, 0xb2, 0x47, 0xb8, 0xae, 0x43, 0x1e, 0x0f, 0x5f,
+	0x94, 0xa1, 0x7a, 0x46, 0x93, 0xb1, 0xef, 0x52, 0x34, 0x82, 0x65, 0xb5, 0x6d, 0x90, 0x79, 0xb7,
+	0x07, 0xf9, 0x48, 0x35, 0xf7, 0x16, 0xe6, 0x64, 0x23, 0x66, 0xee, 0x3d, 0x7a, 0xf5, 0xee, 0xe5,
+	0xd2, 0x57, 0x68, 0x07, 0xcb, 0x64, 0x77, 0x48, 0xfc, 0x48, 0x2e, 0xda, 0x7c, 0xe7, 0x2a, 0x0f,
+	0xd1, 0x53, 0x03, 0xaa, 0x7a, 0x55, 0xa0, 0x6f, 0xee, 0x66, 0x9d, 0xec, 0xad, 0xe6, 0xb7, 0xf7,
+	0x64, 0x69, 0x75, 0x4b, 0xa9, 0x77, 0xd0, 0xfe, 0x02, 0x75, 0xfc, 0x40, 0xfe, 0x79, 0x88, 0x45,
+	0xca, 0xd1, 0x13, 0x03, 0xea, 0xc5, 0x9a, 0x40, 0xfb, 0x8b, 0x77, 0x41, 0x51, 0xcc, 0xc1, 0xbd,
+	0x79, 0xba, 0x9c, 0x03, 0x55, 0xce, 0xd7, 0x68, 0x77, 0x7e, 0x39, 0xc5, 0x3e, 0xe9, 0x9d, 0x5c,
+	0xdd, 0xb4, 0x8c, 0xeb, 0x9b, 0x96, 0xf1, 0xf6, 0xa6, 0x65, 0x3c, 0xbf, 0x6d, 0x95, 0xae, 0x6f,
+	0x5b, 0xa5, 0xd7, 0xb7, 0xad, 0xd2, 0xdf, 0xdf, 0x7b, 0xbe, 0x18, 0x8e, 0x1c, 0xcb, 0x65, 0xa1,
+	0x22, 0x51, 0xbf, 0x1a, 0x2e, 0x0b, 0xb0, 0x66, 0x3b, 0xc2, 0x6e, 0xe0, 0xd3, 0x48, 0x60, 0x2f,
+	0x89, 0xdd, 0x9c, 0xd9, 0x59, 0x51, 0x69, 0x47, 0x1f, 0x06, 0x00, 0x9d, 0xd0, 0xc1, 0xfc, 0xf6,
+	0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Lanes queries the current state of the lanes in the mempool of this node
+	Lanes(ctx context.Context, in *LanesRequest, opts ...grpc.CallOption) (*LanesResponse, error)
+	// LaneTxs queries the hashes of the transactions in a lane of the mempool of this node
+	LaneTxs(ctx context.Context, in *LaneTxsRequest, opts ...grpc.CallOption) (*LaneTxsResponse, error)
+	// Proposals queries the block space usage of the recent proposals prepared by this node
+	Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Lanes(ctx context.Context, in *LanesRequest, opts ...grpc.CallOption) (*LanesResponse, error) {
+	out := new(LanesResponse)
+	err := c.cc.Invoke(ctx, "/band.base.mempool.v1.Service/Lanes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) LaneTxs(ctx context.Context, in *LaneTxsRequest, opts ...grpc.CallOption) (*LaneTxsResponse, error) {
+	out := new(LaneTxsResponse)
+	err := c.cc.Invoke(ctx, "/band.base.mempool.v1.Service/LaneTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Proposals(ctx context.Context, in *ProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error) {
+	out := new(ProposalsResponse)
+	err := c.cc.Invoke(ctx, "/band.base.mempool.v1.Service/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Lanes queries the current state of the lanes in the mempool of this node
+	Lanes(context.Context, *LanesRequest) (*LanesResponse, error)
+	// LaneTxs queries the hashes of the transactions in a lane of the mempool of this node
+	LaneTxs(context.Context, *LaneTxsRequest) (*LaneTxsResponse, error)
+	// Proposals queries the block space usage of the recent proposals prepared by this node
+	Proposals(context.Context, *ProposalsRequest) (*ProposalsResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Lanes(ctx context.Context, req *LanesRequest) (*LanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (*UnimplementedServiceServer) LaneTxs(ctx context.Context, req *LaneTxsRequest) (*LaneTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaneTxs not implemented")
+}
+func (*UnimplementedServiceServer) Proposals(ctx context.Context, req *ProposalsRequest) (*ProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.mempool.v1.Service/Lanes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Lanes(ctx, req.(*LanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_LaneTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LaneTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LaneTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.mempool.v1.Service/LaneTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LaneTxs(ctx, req.(*LaneTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.mempool.v1.Service/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Proposals(ctx, req.(*ProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.base.mempool.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lanes",
+			Handler:    _Service_Lanes_Handler,
+		},
+		{
+			MethodName: "LaneTxs",
+			Handler:    _Service_LaneTxs_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Service_Proposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/base/mempool/v1/query.proto",
+}
+
+func (m *LanesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LanesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LanesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LanesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LanesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LanesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for iNdEx := len(m.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaneTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaneTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaneInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxLaneBlockRatio) > 0 {
+		i -= len(m.MaxLaneBlockRatio)
+		copy(dAtA[i:], m.MaxLaneBlockRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxLaneBlockRatio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxTransactionBlockRatio) > 0 {
+		i -= len(m.MaxTransactionBlockRatio)
+		copy(dAtA[i:], m.MaxTransactionBlockRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxTransactionBlockRatio)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DroppedTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DroppedTxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for iNdEx := len(m.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TotalBlockSpace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MaxBlockSpace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaneUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockSpace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LanesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LanesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for _, e := range m.Lanes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LaneTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LaneTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LaneInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.Blocked {
+		n += 2
+	}
+	if m.DroppedTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.DroppedTxCount))
+	}
+	l = len(m.MaxTransactionBlockRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxLaneBlockRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.MaxBlockSpace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBlockSpace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Lanes) > 0 {
+		for _, e := range m.Lanes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LaneUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	l = m.BlockSpace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BlockSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.TxBytes))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LanesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LanesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LanesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LanesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LanesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LanesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lanes = append(m.Lanes, LaneInfo{})
+			if err := m.Lanes[len(m.Lanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, ProposalStats{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedTxCount", wireType)
+			}
+			m.DroppedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransactionBlockRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTransactionBlockRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLaneBlockRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxLaneBlockRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSpace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlockSpace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lanes = append(m.Lanes, LaneUsage{})
+			if err := m.Lanes[len(m.Lanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSpace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			m.TxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
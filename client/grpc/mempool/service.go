@@ -0,0 +1,145 @@
+package mempool
+
+import (
+	"context"
+	"sort"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bandprotocol/chain/v3/app/mempool"
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC router.
+func RegisterMempoolService(server gogogrpc.Server, bandMempool *mempool.Mempool) {
+	RegisterServiceServer(server, NewQueryServer(bandMempool))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+// to check queryServer implements ServiceServer
+var _ ServiceServer = queryServer{}
+
+// queryServer implements ServiceServer
+type queryServer struct {
+	mempool *mempool.Mempool
+}
+
+// NewQueryServer returns new queryServer from provided mempool
+func NewQueryServer(bandMempool *mempool.Mempool) ServiceServer {
+	return queryServer{
+		mempool: bandMempool,
+	}
+}
+
+// Lanes returns the current state of the lanes in the mempool
+func (s queryServer) Lanes(_ context.Context, _ *LanesRequest) (*LanesResponse, error) {
+	lanes := s.mempool.GetLanes()
+
+	infos := make([]LaneInfo, 0, len(lanes))
+	for _, lane := range lanes {
+		infos = append(infos, LaneInfo{
+			Name:                     lane.Name(),
+			TxCount:                  uint64(lane.CountTx()),
+			Blocked:                  lane.IsBlocked(),
+			DroppedTxCount:           lane.DroppedTxCount(),
+			MaxTransactionBlockRatio: lane.MaxTransactionBlockRatio().String(),
+			MaxLaneBlockRatio:        lane.MaxLaneBlockRatio().String(),
+		})
+	}
+
+	return &LanesResponse{Lanes: infos}, nil
+}
+
+// LaneTxs returns the paginated hashes of the transactions in a lane of the mempool. The key of the
+// pagination is the first transaction hash of the page.
+func (s queryServer) LaneTxs(_ context.Context, req *LaneTxsRequest) (*LaneTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	lane := s.mempool.GetLane(req.Lane)
+	if lane == nil {
+		return nil, status.Errorf(codes.NotFound, "lane %s not found", req.Lane)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	hashes := lane.GetTxHashes()
+
+	start := uint64(len(hashes))
+	if pageReq.Key != nil {
+		key := string(pageReq.Key)
+		start = uint64(sort.SearchStrings(hashes, key))
+	} else if pageReq.Offset < start {
+		start = pageReq.Offset
+	}
+	end := min(start+limit, uint64(len(hashes)))
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(hashes)) {
+		pageRes.NextKey = []byte(hashes[end])
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(hashes))
+	}
+
+	return &LaneTxsResponse{
+		TxHashes:   hashes[start:end],
+		Pagination: pageRes,
+	}, nil
+}
+
+// Proposals returns the block space usage of the recent proposals prepared by the mempool
+func (s queryServer) Proposals(_ context.Context, _ *ProposalsRequest) (*ProposalsResponse, error) {
+	recentProposals := s.mempool.GetRecentProposals()
+
+	proposals := make([]ProposalStats, 0, len(recentProposals))
+	for _, p := range recentProposals {
+		lanes := make([]LaneUsage, 0, len(p.Lanes))
+		for _, l := range p.Lanes {
+			lanes = append(lanes, LaneUsage{
+				Lane:       l.Lane,
+				TxCount:    l.TxCount,
+				BlockSpace: newBlockSpace(l.BlockSpace),
+			})
+		}
+
+		proposals = append(proposals, ProposalStats{
+			Height:          p.Height,
+			MaxBlockSpace:   newBlockSpace(p.MaxBlockSpace),
+			TotalBlockSpace: newBlockSpace(p.TotalBlockSpace),
+			Lanes:           lanes,
+		})
+	}
+
+	return &ProposalsResponse{Proposals: proposals}, nil
+}
+
+// newBlockSpace converts a mempool block space to its response type
+func newBlockSpace(bs mempool.BlockSpace) BlockSpace {
+	return BlockSpace{
+		TxBytes: bs.TxBytes(),
+		Gas:     bs.Gas(),
+	}
+}
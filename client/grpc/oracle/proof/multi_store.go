@@ -40,3 +40,58 @@ func GetMultiStoreProof(multiStoreEp *ics23.ExistenceProof) MultiStoreProof {
 		AuthToIcahostStoresMerkleHash:         tmbytes.HexBytes(multiStoreEp.Path[4].Prefix[1:]),
 	}
 }
+
+// MultiStoreMerkleProofEthereum is an Ethereum version of MultiStoreMerkleProof for solidity ABI-encoding.
+type MultiStoreMerkleProofEthereum struct {
+	StoreKey           string
+	StoreIAVLStateHash common.Hash `abi:"storeIAVLStateHash"`
+	MerklePaths        []MultiStoreMerklePathEthereum
+}
+
+// MultiStoreMerklePathEthereum is an Ethereum version of MultiStoreMerklePath for solidity ABI-encoding.
+type MultiStoreMerklePathEthereum struct {
+	IsDataOnRight bool
+	SiblingHash   common.Hash
+}
+
+func (m *MultiStoreMerkleProof) encodeToEthFormat() MultiStoreMerkleProofEthereum {
+	paths := make([]MultiStoreMerklePathEthereum, len(m.MerklePaths))
+	for i, path := range m.MerklePaths {
+		paths[i] = MultiStoreMerklePathEthereum{
+			IsDataOnRight: path.IsDataOnRight,
+			SiblingHash:   common.BytesToHash(path.SiblingHash),
+		}
+	}
+
+	return MultiStoreMerkleProofEthereum{
+		StoreKey:           m.StoreKey,
+		StoreIAVLStateHash: common.BytesToHash(m.IAVLStateHash),
+		MerklePaths:        paths,
+	}
+}
+
+// GetMultiStoreMerkleProof returns the merkle proof of a store from the multistore existence proof.
+func GetMultiStoreMerkleProof(multiStoreEp *ics23.ExistenceProof) MultiStoreMerkleProof {
+	paths := make([]MultiStoreMerklePath, len(multiStoreEp.Path))
+	for i, step := range multiStoreEp.Path {
+		// The inner node is SHA256(0x01 || left || right). If the data is on the right, the sibling hash
+		// is in the prefix after 0x01. Otherwise, it is the suffix.
+		if len(step.Prefix) > 1 {
+			paths[i] = MultiStoreMerklePath{
+				IsDataOnRight: true,
+				SiblingHash:   tmbytes.HexBytes(step.Prefix[1:]),
+			}
+		} else {
+			paths[i] = MultiStoreMerklePath{
+				IsDataOnRight: false,
+				SiblingHash:   tmbytes.HexBytes(step.Suffix),
+			}
+		}
+	}
+
+	return MultiStoreMerkleProof{
+		StoreKey:      string(multiStoreEp.Key),
+		IAVLStateHash: tmbytes.HexBytes(multiStoreEp.Value),
+		MerklePaths:   paths,
+	}
+}
@@ -0,0 +1,36 @@
+package proof
+
+import (
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// PacketEthereum is an Ethereum version of Packet for solidity ABI-encoding. The receipt is split into
+// its type URL and value so that the protobuf encoding of the packet can be rebuilt on the destination chain.
+type PacketEthereum struct {
+	TunnelID       uint64
+	Sequence       uint64
+	Prices         []PriceEthereum
+	ReceiptTypeURL string
+	ReceiptValue   []byte
+	CreatedAt      int64
+}
+
+func transformPacket(p tunneltypes.Packet) PacketEthereum {
+	prices := make([]PriceEthereum, len(p.Prices))
+	for i, price := range p.Prices {
+		prices[i] = transformPrice(price)
+	}
+
+	packet := PacketEthereum{
+		TunnelID:  p.TunnelID,
+		Sequence:  p.Sequence,
+		Prices:    prices,
+		CreatedAt: p.CreatedAt,
+	}
+	if p.Receipt != nil {
+		packet.ReceiptTypeURL = p.Receipt.TypeUrl
+		packet.ReceiptValue = p.Receipt.Value
+	}
+
+	return packet
+}
@@ -0,0 +1,22 @@
+package proof
+
+import (
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PriceEthereum is an Ethereum version of Price for solidity ABI-encoding.
+type PriceEthereum struct {
+	SignalID  string
+	Status    uint8
+	Price     uint64
+	Timestamp int64
+}
+
+func transformPrice(p feedstypes.Price) PriceEthereum {
+	return PriceEthereum{
+		SignalID:  p.SignalID,
+		Status:    uint8(p.Status),
+		Price:     p.Price,
+		Timestamp: p.Timestamp,
+	}
+}
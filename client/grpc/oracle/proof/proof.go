@@ -18,9 +18,12 @@ import (
 )
 
 var (
-	relayArguments       abi.Arguments
-	verifyArguments      abi.Arguments
-	verifyCountArguments abi.Arguments
+	relayArguments        abi.Arguments
+	relayStoreArguments   abi.Arguments
+	verifyArguments       abi.Arguments
+	verifyCountArguments  abi.Arguments
+	verifyPriceArguments  abi.Arguments
+	verifyPacketArguments abi.Arguments
 )
 
 const (
@@ -40,6 +43,18 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = json.Unmarshal(relayStoreFormat, &relayStoreArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyPriceFormat, &verifyPriceArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyPacketFormat, &verifyPacketArguments)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (blockRelay *BlockRelayProof) encodeToEthData() ([]byte, error) {
@@ -55,6 +70,19 @@ func (blockRelay *BlockRelayProof) encodeToEthData() ([]byte, error) {
 	)
 }
 
+func (blockRelay *StoreBlockRelayProof) encodeToEthData() ([]byte, error) {
+	parseSignatures := make([]TMSignatureEthereum, len(blockRelay.Signatures))
+	for i, sig := range blockRelay.Signatures {
+		parseSignatures[i] = sig.encodeToEthFormat()
+	}
+	return relayStoreArguments.Pack(
+		blockRelay.MultiStoreMerkleProof.encodeToEthFormat(),
+		blockRelay.BlockHeaderMerkleParts.encodeToEthFormat(),
+		blockRelay.CommonEncodedVotePart.encodeToEthFormat(),
+		parseSignatures,
+	)
+}
+
 func (o *OracleDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
 	parsePaths := make([]IAVLMerklePathEthereum, len(o.MerklePaths))
 	for i, path := range o.MerklePaths {
@@ -81,6 +109,32 @@ func (o *RequestsCountProof) encodeToEthData(blockHeight uint64) ([]byte, error)
 	)
 }
 
+func (p *PriceDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(p.MerklePaths))
+	for i, path := range p.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyPriceArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		transformPrice(p.Price),
+		big.NewInt(int64(p.Version)),
+		parsePaths,
+	)
+}
+
+func (p *PacketDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(p.MerklePaths))
+	for i, path := range p.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyPacketArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		transformPacket(p.Packet),
+		big.NewInt(int64(p.Version)),
+		parsePaths,
+	)
+}
+
 type CommonEncodedVotePartEthereum struct {
 	SignedDataPrefix []byte
 	SignedDataSuffix []byte
@@ -95,13 +149,14 @@ func (commonVote *CommonEncodedVotePart) encodeToEthFormat() CommonEncodedVotePa
 
 func getProofsByKey(
 	ctx client.Context,
+	storeName string,
 	key []byte,
 	queryOptions rpcclient.ABCIQueryOptions,
 	getMultiStoreEp bool,
 ) ([]byte, *ics23.ExistenceProof, *ics23.ExistenceProof, error) {
 	resp, err := ctx.Client.ABCIQueryWithOptions(
 		context.Background(),
-		"/store/oracle/key",
+		fmt.Sprintf("/store/%s/key", storeName),
 		key,
 		queryOptions,
 	)
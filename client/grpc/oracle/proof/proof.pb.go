@@ -6,7 +6,9 @@ package proof
 import (
 	context "context"
 	fmt "fmt"
-	types "github.com/bandprotocol/chain/v3/x/oracle/types"
+	types "github.com/bandprotocol/chain/v3/x/feeds/types"
+	types2 "github.com/bandprotocol/chain/v3/x/oracle/types"
+	types1 "github.com/bandprotocol/chain/v3/x/tunnel/types"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -330,24 +332,26 @@ func (m *RequestCountProofResponse) GetResult() CountProofResult {
 	return CountProofResult{}
 }
 
-// SingleProofResponse is the data structure for response of single proof
-type SingleProofResult struct {
-	Proof         SingleProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
-	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+// FeedsPriceProofRequest is request type for the Service/FeedsPriceProof RPC method.
+type FeedsPriceProofRequest struct {
+	// signal_id is the signal ID of the price
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *SingleProofResult) Reset()         { *m = SingleProofResult{} }
-func (m *SingleProofResult) String() string { return proto.CompactTextString(m) }
-func (*SingleProofResult) ProtoMessage()    {}
-func (*SingleProofResult) Descriptor() ([]byte, []int) {
+func (m *FeedsPriceProofRequest) Reset()         { *m = FeedsPriceProofRequest{} }
+func (m *FeedsPriceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceProofRequest) ProtoMessage()    {}
+func (*FeedsPriceProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{6}
 }
-func (m *SingleProofResult) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SingleProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SingleProofResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -357,50 +361,52 @@ func (m *SingleProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SingleProofResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SingleProofResult.Merge(m, src)
+func (m *FeedsPriceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceProofRequest.Merge(m, src)
 }
-func (m *SingleProofResult) XXX_Size() int {
+func (m *FeedsPriceProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SingleProofResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_SingleProofResult.DiscardUnknown(m)
+func (m *FeedsPriceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SingleProofResult proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceProofRequest proto.InternalMessageInfo
 
-func (m *SingleProofResult) GetProof() SingleProof {
+func (m *FeedsPriceProofRequest) GetSignalId() string {
 	if m != nil {
-		return m.Proof
+		return m.SignalId
 	}
-	return SingleProof{}
+	return ""
 }
 
-func (m *SingleProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceProofRequest) GetHeight() int64 {
 	if m != nil {
-		return m.EvmProofBytes
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-// MultiProofResult is the data structure for response of multi proof
-type MultiProofResult struct {
-	Proof         MultiProof                                       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
-	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+// FeedsPriceProofResponse is response type for the Service/FeedsPriceProof RPC method.
+type FeedsPriceProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result FeedsPriceProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
 }
 
-func (m *MultiProofResult) Reset()         { *m = MultiProofResult{} }
-func (m *MultiProofResult) String() string { return proto.CompactTextString(m) }
-func (*MultiProofResult) ProtoMessage()    {}
-func (*MultiProofResult) Descriptor() ([]byte, []int) {
+func (m *FeedsPriceProofResponse) Reset()         { *m = FeedsPriceProofResponse{} }
+func (m *FeedsPriceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceProofResponse) ProtoMessage()    {}
+func (*FeedsPriceProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{7}
 }
-func (m *MultiProofResult) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiProofResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -410,50 +416,52 @@ func (m *MultiProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MultiProofResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiProofResult.Merge(m, src)
+func (m *FeedsPriceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceProofResponse.Merge(m, src)
 }
-func (m *MultiProofResult) XXX_Size() int {
+func (m *FeedsPriceProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiProofResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiProofResult.DiscardUnknown(m)
+func (m *FeedsPriceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiProofResult proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceProofResponse proto.InternalMessageInfo
 
-func (m *MultiProofResult) GetProof() MultiProof {
+func (m *FeedsPriceProofResponse) GetHeight() int64 {
 	if m != nil {
-		return m.Proof
+		return m.Height
 	}
-	return MultiProof{}
+	return 0
 }
 
-func (m *MultiProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceProofResponse) GetResult() FeedsPriceProofResult {
 	if m != nil {
-		return m.EvmProofBytes
+		return m.Result
 	}
-	return nil
+	return FeedsPriceProofResult{}
 }
 
-// CountProofResult is the data structure for response of count proof
-type CountProofResult struct {
-	Proof         CountProof                                       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
-	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+// FeedsPriceMultiProofRequest is request type for the Service/FeedsPriceMultiProof RPC method.
+type FeedsPriceMultiProofRequest struct {
+	// signal_ids is the list of signal IDs of the prices
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *CountProofResult) Reset()         { *m = CountProofResult{} }
-func (m *CountProofResult) String() string { return proto.CompactTextString(m) }
-func (*CountProofResult) ProtoMessage()    {}
-func (*CountProofResult) Descriptor() ([]byte, []int) {
+func (m *FeedsPriceMultiProofRequest) Reset()         { *m = FeedsPriceMultiProofRequest{} }
+func (m *FeedsPriceMultiProofRequest) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceMultiProofRequest) ProtoMessage()    {}
+func (*FeedsPriceMultiProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{8}
 }
-func (m *CountProofResult) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceMultiProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceMultiProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountProofResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceMultiProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -463,51 +471,52 @@ func (m *CountProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *CountProofResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountProofResult.Merge(m, src)
+func (m *FeedsPriceMultiProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceMultiProofRequest.Merge(m, src)
 }
-func (m *CountProofResult) XXX_Size() int {
+func (m *FeedsPriceMultiProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountProofResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountProofResult.DiscardUnknown(m)
+func (m *FeedsPriceMultiProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceMultiProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountProofResult proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceMultiProofRequest proto.InternalMessageInfo
 
-func (m *CountProofResult) GetProof() CountProof {
+func (m *FeedsPriceMultiProofRequest) GetSignalIds() []string {
 	if m != nil {
-		return m.Proof
+		return m.SignalIds
 	}
-	return CountProof{}
+	return nil
 }
 
-func (m *CountProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceMultiProofRequest) GetHeight() int64 {
 	if m != nil {
-		return m.EvmProofBytes
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-// SingleProof contains block height, oracle data proof and block relay proof
-type SingleProof struct {
-	BlockHeight     uint64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	OracleDataProof OracleDataProof `protobuf:"bytes,2,opt,name=oracle_data_proof,json=oracleDataProof,proto3" json:"oracle_data_proof"`
-	BlockRelayProof BlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+// FeedsPriceMultiProofResponse is response type for the Service/FeedsPriceMultiProof RPC method.
+type FeedsPriceMultiProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result FeedsPriceMultiProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
 }
 
-func (m *SingleProof) Reset()         { *m = SingleProof{} }
-func (m *SingleProof) String() string { return proto.CompactTextString(m) }
-func (*SingleProof) ProtoMessage()    {}
-func (*SingleProof) Descriptor() ([]byte, []int) {
+func (m *FeedsPriceMultiProofResponse) Reset()         { *m = FeedsPriceMultiProofResponse{} }
+func (m *FeedsPriceMultiProofResponse) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceMultiProofResponse) ProtoMessage()    {}
+func (*FeedsPriceMultiProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{9}
 }
-func (m *SingleProof) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceMultiProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SingleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceMultiProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SingleProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceMultiProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -517,58 +526,54 @@ func (m *SingleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SingleProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SingleProof.Merge(m, src)
+func (m *FeedsPriceMultiProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceMultiProofResponse.Merge(m, src)
 }
-func (m *SingleProof) XXX_Size() int {
+func (m *FeedsPriceMultiProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SingleProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_SingleProof.DiscardUnknown(m)
+func (m *FeedsPriceMultiProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceMultiProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SingleProof proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceMultiProofResponse proto.InternalMessageInfo
 
-func (m *SingleProof) GetBlockHeight() uint64 {
+func (m *FeedsPriceMultiProofResponse) GetHeight() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Height
 	}
 	return 0
 }
 
-func (m *SingleProof) GetOracleDataProof() OracleDataProof {
-	if m != nil {
-		return m.OracleDataProof
-	}
-	return OracleDataProof{}
-}
-
-func (m *SingleProof) GetBlockRelayProof() BlockRelayProof {
+func (m *FeedsPriceMultiProofResponse) GetResult() FeedsPriceMultiProofResult {
 	if m != nil {
-		return m.BlockRelayProof
+		return m.Result
 	}
-	return BlockRelayProof{}
+	return FeedsPriceMultiProofResult{}
 }
 
-// MultiProof contains block height, list of oracle data proof and block relay proof
-type MultiProof struct {
-	BlockHeight          uint64            `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	OracleDataMultiProof []OracleDataProof `protobuf:"bytes,2,rep,name=oracle_data_multi_proof,json=oracleDataMultiProof,proto3" json:"oracle_data_multi_proof"`
-	BlockRelayProof      BlockRelayProof   `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+// TunnelPacketProofRequest is request type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofRequest struct {
+	// tunnel_id is the ID of the tunnel
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *MultiProof) Reset()         { *m = MultiProof{} }
-func (m *MultiProof) String() string { return proto.CompactTextString(m) }
-func (*MultiProof) ProtoMessage()    {}
-func (*MultiProof) Descriptor() ([]byte, []int) {
+func (m *TunnelPacketProofRequest) Reset()         { *m = TunnelPacketProofRequest{} }
+func (m *TunnelPacketProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofRequest) ProtoMessage()    {}
+func (*TunnelPacketProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{10}
 }
-func (m *MultiProof) XXX_Unmarshal(b []byte) error {
+func (m *TunnelPacketProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TunnelPacketProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TunnelPacketProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,58 +583,59 @@ func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MultiProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiProof.Merge(m, src)
+func (m *TunnelPacketProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofRequest.Merge(m, src)
 }
-func (m *MultiProof) XXX_Size() int {
+func (m *TunnelPacketProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiProof.DiscardUnknown(m)
+func (m *TunnelPacketProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiProof proto.InternalMessageInfo
+var xxx_messageInfo_TunnelPacketProofRequest proto.InternalMessageInfo
 
-func (m *MultiProof) GetBlockHeight() uint64 {
+func (m *TunnelPacketProofRequest) GetTunnelId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TunnelId
 	}
 	return 0
 }
 
-func (m *MultiProof) GetOracleDataMultiProof() []OracleDataProof {
+func (m *TunnelPacketProofRequest) GetSequence() uint64 {
 	if m != nil {
-		return m.OracleDataMultiProof
+		return m.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (m *MultiProof) GetBlockRelayProof() BlockRelayProof {
+func (m *TunnelPacketProofRequest) GetHeight() int64 {
 	if m != nil {
-		return m.BlockRelayProof
+		return m.Height
 	}
-	return BlockRelayProof{}
+	return 0
 }
 
-// CountProof contains block height, count proof and block relay proof
-type CountProof struct {
-	BlockHeight     uint64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	CountProof      RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof"`
-	BlockRelayProof BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+// TunnelPacketProofResponse is response type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result TunnelPacketProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
 }
 
-func (m *CountProof) Reset()         { *m = CountProof{} }
-func (m *CountProof) String() string { return proto.CompactTextString(m) }
-func (*CountProof) ProtoMessage()    {}
-func (*CountProof) Descriptor() ([]byte, []int) {
+func (m *TunnelPacketProofResponse) Reset()         { *m = TunnelPacketProofResponse{} }
+func (m *TunnelPacketProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofResponse) ProtoMessage()    {}
+func (*TunnelPacketProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{11}
 }
-func (m *CountProof) XXX_Unmarshal(b []byte) error {
+func (m *TunnelPacketProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TunnelPacketProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TunnelPacketProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -639,58 +645,50 @@ func (m *CountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CountProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountProof.Merge(m, src)
+func (m *TunnelPacketProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofResponse.Merge(m, src)
 }
-func (m *CountProof) XXX_Size() int {
+func (m *TunnelPacketProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountProof.DiscardUnknown(m)
+func (m *TunnelPacketProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountProof proto.InternalMessageInfo
+var xxx_messageInfo_TunnelPacketProofResponse proto.InternalMessageInfo
 
-func (m *CountProof) GetBlockHeight() uint64 {
+func (m *TunnelPacketProofResponse) GetHeight() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Height
 	}
 	return 0
 }
 
-func (m *CountProof) GetCountProof() RequestsCountProof {
-	if m != nil {
-		return m.CountProof
-	}
-	return RequestsCountProof{}
-}
-
-func (m *CountProof) GetBlockRelayProof() BlockRelayProof {
+func (m *TunnelPacketProofResponse) GetResult() TunnelPacketProofResult {
 	if m != nil {
-		return m.BlockRelayProof
+		return m.Result
 	}
-	return BlockRelayProof{}
+	return TunnelPacketProofResult{}
 }
 
-// OracleDataProof contains result, version and merkle paths
-type OracleDataProof struct {
-	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
-	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+// SingleProofResponse is the data structure for response of single proof
+type SingleProofResult struct {
+	Proof         SingleProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
-func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
-func (*OracleDataProof) ProtoMessage()    {}
-func (*OracleDataProof) Descriptor() ([]byte, []int) {
+func (m *SingleProofResult) Reset()         { *m = SingleProofResult{} }
+func (m *SingleProofResult) String() string { return proto.CompactTextString(m) }
+func (*SingleProofResult) ProtoMessage()    {}
+func (*SingleProofResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{12}
 }
-func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+func (m *SingleProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SingleProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SingleProofResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -700,60 +698,50 @@ func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *OracleDataProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleDataProof.Merge(m, src)
+func (m *SingleProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleProofResult.Merge(m, src)
 }
-func (m *OracleDataProof) XXX_Size() int {
+func (m *SingleProofResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleDataProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
+func (m *SingleProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleProofResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
-
-func (m *OracleDataProof) GetResult() types.Result {
-	if m != nil {
-		return m.Result
-	}
-	return types.Result{}
-}
+var xxx_messageInfo_SingleProofResult proto.InternalMessageInfo
 
-func (m *OracleDataProof) GetVersion() uint64 {
+func (m *SingleProofResult) GetProof() SingleProof {
 	if m != nil {
-		return m.Version
+		return m.Proof
 	}
-	return 0
+	return SingleProof{}
 }
 
-func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+func (m *SingleProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.MerklePaths
+		return m.EvmProofBytes
 	}
 	return nil
 }
 
-// IAVLMerklePath represents a Merkle step to a leaf data node in an iAVL tree.
-type IAVLMerklePath struct {
-	IsDataOnRight  bool                                             `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
-	SubtreeHeight  uint32                                           `protobuf:"varint,2,opt,name=subtree_height,json=subtreeHeight,proto3" json:"subtree_height,omitempty"`
-	SubtreeSize    uint64                                           `protobuf:"varint,3,opt,name=subtree_size,json=subtreeSize,proto3" json:"subtree_size,omitempty"`
-	SubtreeVersion uint64                                           `protobuf:"varint,4,opt,name=subtree_version,json=subtreeVersion,proto3" json:"subtree_version,omitempty"`
-	SiblingHash    github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=sibling_hash,json=siblingHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"sibling_hash,omitempty"`
+// MultiProofResult is the data structure for response of multi proof
+type MultiProofResult struct {
+	Proof         MultiProof                                       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *IAVLMerklePath) Reset()         { *m = IAVLMerklePath{} }
-func (m *IAVLMerklePath) String() string { return proto.CompactTextString(m) }
-func (*IAVLMerklePath) ProtoMessage()    {}
-func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
+func (m *MultiProofResult) Reset()         { *m = MultiProofResult{} }
+func (m *MultiProofResult) String() string { return proto.CompactTextString(m) }
+func (*MultiProofResult) ProtoMessage()    {}
+func (*MultiProofResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{13}
 }
-func (m *IAVLMerklePath) XXX_Unmarshal(b []byte) error {
+func (m *MultiProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IAVLMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IAVLMerklePath.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiProofResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,73 +751,50 @@ func (m *IAVLMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *IAVLMerklePath) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IAVLMerklePath.Merge(m, src)
+func (m *MultiProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProofResult.Merge(m, src)
 }
-func (m *IAVLMerklePath) XXX_Size() int {
+func (m *MultiProofResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *IAVLMerklePath) XXX_DiscardUnknown() {
-	xxx_messageInfo_IAVLMerklePath.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_IAVLMerklePath proto.InternalMessageInfo
-
-func (m *IAVLMerklePath) GetIsDataOnRight() bool {
-	if m != nil {
-		return m.IsDataOnRight
-	}
-	return false
-}
-
-func (m *IAVLMerklePath) GetSubtreeHeight() uint32 {
-	if m != nil {
-		return m.SubtreeHeight
-	}
-	return 0
+func (m *MultiProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProofResult.DiscardUnknown(m)
 }
 
-func (m *IAVLMerklePath) GetSubtreeSize() uint64 {
-	if m != nil {
-		return m.SubtreeSize
-	}
-	return 0
-}
+var xxx_messageInfo_MultiProofResult proto.InternalMessageInfo
 
-func (m *IAVLMerklePath) GetSubtreeVersion() uint64 {
+func (m *MultiProofResult) GetProof() MultiProof {
 	if m != nil {
-		return m.SubtreeVersion
+		return m.Proof
 	}
-	return 0
+	return MultiProof{}
 }
 
-func (m *IAVLMerklePath) GetSiblingHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *MultiProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.SiblingHash
+		return m.EvmProofBytes
 	}
 	return nil
 }
 
-// BlockRelayProof contains multi store proof, block header merkle parts, common encoded part and signatures
-type BlockRelayProof struct {
-	MultiStoreProof        MultiStoreProof        `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof"`
-	BlockHeaderMerkleParts BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
-	CommonEncodedVotePart  CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
-	Signatures             []TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+// CountProofResult is the data structure for response of count proof
+type CountProofResult struct {
+	Proof         CountProof                                       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *BlockRelayProof) Reset()         { *m = BlockRelayProof{} }
-func (m *BlockRelayProof) String() string { return proto.CompactTextString(m) }
-func (*BlockRelayProof) ProtoMessage()    {}
-func (*BlockRelayProof) Descriptor() ([]byte, []int) {
+func (m *CountProofResult) Reset()         { *m = CountProofResult{} }
+func (m *CountProofResult) String() string { return proto.CompactTextString(m) }
+func (*CountProofResult) ProtoMessage()    {}
+func (*CountProofResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{14}
 }
-func (m *BlockRelayProof) XXX_Unmarshal(b []byte) error {
+func (m *CountProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockRelayProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountProofResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -839,91 +804,50 @@ func (m *BlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *BlockRelayProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockRelayProof.Merge(m, src)
+func (m *CountProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountProofResult.Merge(m, src)
 }
-func (m *BlockRelayProof) XXX_Size() int {
+func (m *CountProofResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockRelayProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockRelayProof.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BlockRelayProof proto.InternalMessageInfo
-
-func (m *BlockRelayProof) GetMultiStoreProof() MultiStoreProof {
-	if m != nil {
-		return m.MultiStoreProof
-	}
-	return MultiStoreProof{}
+func (m *CountProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountProofResult.DiscardUnknown(m)
 }
 
-func (m *BlockRelayProof) GetBlockHeaderMerkleParts() BlockHeaderMerkleParts {
-	if m != nil {
-		return m.BlockHeaderMerkleParts
-	}
-	return BlockHeaderMerkleParts{}
-}
+var xxx_messageInfo_CountProofResult proto.InternalMessageInfo
 
-func (m *BlockRelayProof) GetCommonEncodedVotePart() CommonEncodedVotePart {
+func (m *CountProofResult) GetProof() CountProof {
 	if m != nil {
-		return m.CommonEncodedVotePart
+		return m.Proof
 	}
-	return CommonEncodedVotePart{}
+	return CountProof{}
 }
 
-func (m *BlockRelayProof) GetSignatures() []TMSignature {
+func (m *CountProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.Signatures
+		return m.EvmProofBytes
 	}
 	return nil
 }
 
-// MultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
-// compute (in combination with oracle store hash) Tendermint's application state hash at a given block.
-//
-//	                                 __________________________[AppHash]__________________________
-//	                                /                                                             \
-//	                  ___________[N24]____________                                    ___________[N25]________
-//	                 /                            \                                  /                        \
-//	          _____[N20]_____                 ____[N21]______                   ____[N22]______              _[N23]_
-//	         /               \               /               \                 /               \            /       \
-//	     _[N12]_          _[N13]_          _[N14]_          _[N15]_          _[N16]_          _[N17]_     [N18]     [Q]
-//	    /       \        /       \        /       \        /       \        /       \        /       \    /   \
-//	  [N0]     [N1]    [N2]     [N3]    [N4]     [N5]    [N6]     [N7]    [N8]     [N9]   [N10]    [N11] [O] [P]
-//	 /   \    /   \    /  \    /   \   /   \    /   \   /   \    /   \   /   \    /   \   /   \    /   \
-//	[0] [1]  [2] [3] [4] [5]  [6] [7] [8] [9]  [A] [B] [C] [D]  [E] [F] [G] [H]  [I] [J] [K] [L]  [M] [N]
-//
-// [0] - acc (auth) [1] - authz         [2] - bandtss       [3] - bank        [4] - capability [5] - consensus
-// [6] - crisis     [7] - distribution  [8] - evidence      [9] - feeds       [A] - feegrant   [B] - feeibc
-// [C] - globalfee  [D] - gov           [E] - ibc           [F] - icahost     [G] - mint       [H] - oracle
-// [I] - params     [J] - restake       [K] - rollingseed   [L] - slashing    [M] - staking    [N] - transfer
-// [O] - tss        [P] - tunnel        [Q] - upgrade
-//
-// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
-// root hash, since we only want to validate the correctness of [H] In fact, only
-// [G], [N9], [N17], [N23], and [N24] are needed in order to compute [AppHash].
-type MultiStoreProof struct {
-	OracleIAVLStateHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=oracle_iavl_state_hash,json=oracleIavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"oracle_iavl_state_hash,omitempty"`
-	MintStoreMerkleHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=mint_store_merkle_hash,json=mintStoreMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"mint_store_merkle_hash,omitempty"`
-	ParamsToRestakeStoresMerkleHash       github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=params_to_restake_stores_merkle_hash,json=paramsToRestakeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"params_to_restake_stores_merkle_hash,omitempty"`
-	RollingseedToTransferStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=rollingseed_to_transfer_stores_merkle_hash,json=rollingseedToTransferStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"rollingseed_to_transfer_stores_merkle_hash,omitempty"`
-	TSSToUpgradeStoresMerkleHash          github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=tss_to_upgrade_stores_merkle_hash,json=tssToUpgradeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"tss_to_upgrade_stores_merkle_hash,omitempty"`
-	AuthToIcahostStoresMerkleHash         github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=auth_to_icahost_stores_merkle_hash,json=authToIcahostStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"auth_to_icahost_stores_merkle_hash,omitempty"`
+// FeedsPriceProofResult is the data structure for response of feeds price proof
+type FeedsPriceProofResult struct {
+	Proof         FeedsPriceProof                                  `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
-func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
-func (*MultiStoreProof) ProtoMessage()    {}
-func (*MultiStoreProof) Descriptor() ([]byte, []int) {
+func (m *FeedsPriceProofResult) Reset()         { *m = FeedsPriceProofResult{} }
+func (m *FeedsPriceProofResult) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceProofResult) ProtoMessage()    {}
+func (*FeedsPriceProofResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9deb752797273b09, []int{15}
 }
-func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiStoreProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceProofResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -933,109 +857,103 @@ func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MultiStoreProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiStoreProof.Merge(m, src)
+func (m *FeedsPriceProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceProofResult.Merge(m, src)
 }
-func (m *MultiStoreProof) XXX_Size() int {
+func (m *FeedsPriceProofResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiStoreProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiStoreProof.DiscardUnknown(m)
+func (m *FeedsPriceProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceProofResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiStoreProof proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceProofResult proto.InternalMessageInfo
 
-func (m *MultiStoreProof) GetOracleIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceProofResult) GetProof() FeedsPriceProof {
 	if m != nil {
-		return m.OracleIAVLStateHash
+		return m.Proof
 	}
-	return nil
+	return FeedsPriceProof{}
 }
 
-func (m *MultiStoreProof) GetMintStoreMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.MintStoreMerkleHash
+		return m.EvmProofBytes
 	}
 	return nil
 }
 
-func (m *MultiStoreProof) GetParamsToRestakeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.ParamsToRestakeStoresMerkleHash
-	}
-	return nil
+// FeedsPriceMultiProofResult is the data structure for response of feeds price multi proof
+type FeedsPriceMultiProofResult struct {
+	Proof         FeedsPriceMultiProof                             `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *MultiStoreProof) GetRollingseedToTransferStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.RollingseedToTransferStoresMerkleHash
+func (m *FeedsPriceMultiProofResult) Reset()         { *m = FeedsPriceMultiProofResult{} }
+func (m *FeedsPriceMultiProofResult) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceMultiProofResult) ProtoMessage()    {}
+func (*FeedsPriceMultiProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{16}
+}
+func (m *FeedsPriceMultiProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedsPriceMultiProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedsPriceMultiProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FeedsPriceMultiProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceMultiProofResult.Merge(m, src)
+}
+func (m *FeedsPriceMultiProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedsPriceMultiProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceMultiProofResult.DiscardUnknown(m)
 }
 
-func (m *MultiStoreProof) GetTSSToUpgradeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+var xxx_messageInfo_FeedsPriceMultiProofResult proto.InternalMessageInfo
+
+func (m *FeedsPriceMultiProofResult) GetProof() FeedsPriceMultiProof {
 	if m != nil {
-		return m.TSSToUpgradeStoresMerkleHash
+		return m.Proof
 	}
-	return nil
+	return FeedsPriceMultiProof{}
 }
 
-func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *FeedsPriceMultiProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.AuthToIcahostStoresMerkleHash
+		return m.EvmProofBytes
 	}
 	return nil
 }
 
-// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
-// header hash from app hash, and height.
-//
-// In Tendermint, a block header hash is the Merkle hash of a binary tree with 14 leaf nodes.
-// Each node encodes a data piece of the blockchain. The notable data leaves are: [A] app_hash,
-// [2] height. All data pieces are combined into one 32-byte hash to be signed
-// by block validators. The structure of the Merkle tree is shown below.
-//
-//	                                 [BlockHeader]
-//	                              /                \
-//	                 [3A]                                    [3B]
-//	               /      \                                /      \
-//	       [2A]                [2B]                [2C]                [2D]
-//	      /    \              /    \              /    \              /    \
-//	  [1A]      [1B]      [1C]      [1D]      [1E]      [1F]        [C]    [D]
-//	  /  \      /  \      /  \      /  \      /  \      /  \
-//	[0]  [1]  [2]  [3]  [4]  [5]  [6]  [7]  [8]  [9]  [A]  [B]
-//
-//	[0] - version               [1] - chain_id            [2] - height        [3] - time
-//	[4] - last_block_id         [5] - last_commit_hash    [6] - data_hash     [7] - validators_hash
-//	[8] - next_validators_hash  [9] - consensus_hash      [A] - app_hash      [B] - last_results_hash
-//	[C] - evidence_hash         [D] - proposer_address
-//
-// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
-// root hash, since we only want to validate the correctness of [2], [3], and [A]. In fact, only
-// [1A], [2B], [1E], [B], and [2D] are needed in order to compute [BlockHeader].
-type BlockHeaderMerkleParts struct {
-	VersionAndChainIdHash             github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=version_and_chain_id_hash,json=versionAndChainIdHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"version_and_chain_id_hash,omitempty"`
-	Height                            uint64                                           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	TimeSecond                        uint64                                           `protobuf:"varint,3,opt,name=time_second,json=timeSecond,proto3" json:"time_second,omitempty"`
-	TimeNanoSecond                    uint32                                           `protobuf:"varint,4,opt,name=time_nano_second,json=timeNanoSecond,proto3" json:"time_nano_second,omitempty"`
-	LastBlockIdAndOther               github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=last_block_id_and_other,json=lastBlockIdAndOther,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_block_id_and_other,omitempty"`
-	NextValidatorHashAndConsensusHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=next_validator_hash_and_consensus_hash,json=nextValidatorHashAndConsensusHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"next_validator_hash_and_consensus_hash,omitempty"`
-	LastResultsHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=last_results_hash,json=lastResultsHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_results_hash,omitempty"`
-	EvidenceAndProposerHash           github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=evidence_and_proposer_hash,json=evidenceAndProposerHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evidence_and_proposer_hash,omitempty"`
+// TunnelPacketProofResult is the data structure for response of tunnel packet proof
+type TunnelPacketProofResult struct {
+	Proof         TunnelPacketProof                                `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
 }
 
-func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{} }
-func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
-func (*BlockHeaderMerkleParts) ProtoMessage()    {}
-func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{16}
+func (m *TunnelPacketProofResult) Reset()         { *m = TunnelPacketProofResult{} }
+func (m *TunnelPacketProofResult) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofResult) ProtoMessage()    {}
+func (*TunnelPacketProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{17}
 }
-func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
+func (m *TunnelPacketProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TunnelPacketProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeaderMerkleParts.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TunnelPacketProofResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1045,92 +963,112 @@ func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *BlockHeaderMerkleParts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeaderMerkleParts.Merge(m, src)
+func (m *TunnelPacketProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofResult.Merge(m, src)
 }
-func (m *BlockHeaderMerkleParts) XXX_Size() int {
+func (m *TunnelPacketProofResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeaderMerkleParts) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeaderMerkleParts.DiscardUnknown(m)
+func (m *TunnelPacketProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeaderMerkleParts proto.InternalMessageInfo
+var xxx_messageInfo_TunnelPacketProofResult proto.InternalMessageInfo
 
-func (m *BlockHeaderMerkleParts) GetVersionAndChainIdHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *TunnelPacketProofResult) GetProof() TunnelPacketProof {
 	if m != nil {
-		return m.VersionAndChainIdHash
+		return m.Proof
 	}
-	return nil
+	return TunnelPacketProof{}
 }
 
-func (m *BlockHeaderMerkleParts) GetHeight() uint64 {
+func (m *TunnelPacketProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
-		return m.Height
+		return m.EvmProofBytes
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockHeaderMerkleParts) GetTimeSecond() uint64 {
-	if m != nil {
-		return m.TimeSecond
-	}
-	return 0
+// SingleProof contains block height, oracle data proof and block relay proof
+type SingleProof struct {
+	BlockHeight     uint64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OracleDataProof OracleDataProof `protobuf:"bytes,2,opt,name=oracle_data_proof,json=oracleDataProof,proto3" json:"oracle_data_proof"`
+	BlockRelayProof BlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func (m *BlockHeaderMerkleParts) GetTimeNanoSecond() uint32 {
-	if m != nil {
-		return m.TimeNanoSecond
-	}
-	return 0
+func (m *SingleProof) Reset()         { *m = SingleProof{} }
+func (m *SingleProof) String() string { return proto.CompactTextString(m) }
+func (*SingleProof) ProtoMessage()    {}
+func (*SingleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{18}
 }
-
-func (m *BlockHeaderMerkleParts) GetLastBlockIdAndOther() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.LastBlockIdAndOther
+func (m *SingleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SingleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SingleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SingleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleProof.Merge(m, src)
+}
+func (m *SingleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SingleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleProof.DiscardUnknown(m)
 }
 
-func (m *BlockHeaderMerkleParts) GetNextValidatorHashAndConsensusHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+var xxx_messageInfo_SingleProof proto.InternalMessageInfo
+
+func (m *SingleProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.NextValidatorHashAndConsensusHash
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *BlockHeaderMerkleParts) GetLastResultsHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *SingleProof) GetOracleDataProof() OracleDataProof {
 	if m != nil {
-		return m.LastResultsHash
+		return m.OracleDataProof
 	}
-	return nil
+	return OracleDataProof{}
 }
 
-func (m *BlockHeaderMerkleParts) GetEvidenceAndProposerHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *SingleProof) GetBlockRelayProof() BlockRelayProof {
 	if m != nil {
-		return m.EvidenceAndProposerHash
+		return m.BlockRelayProof
 	}
-	return nil
+	return BlockRelayProof{}
 }
 
-// CommonEncodedVotePart represents the common part of encoded vote
-type CommonEncodedVotePart struct {
-	SignedDataPrefix github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=signed_data_prefix,json=signedDataPrefix,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"signed_data_prefix,omitempty"`
-	SignedDataSuffix github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=signed_data_suffix,json=signedDataSuffix,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"signed_data_suffix,omitempty"`
+// MultiProof contains block height, list of oracle data proof and block relay proof
+type MultiProof struct {
+	BlockHeight          uint64            `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OracleDataMultiProof []OracleDataProof `protobuf:"bytes,2,rep,name=oracle_data_multi_proof,json=oracleDataMultiProof,proto3" json:"oracle_data_multi_proof"`
+	BlockRelayProof      BlockRelayProof   `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func (m *CommonEncodedVotePart) Reset()         { *m = CommonEncodedVotePart{} }
-func (m *CommonEncodedVotePart) String() string { return proto.CompactTextString(m) }
-func (*CommonEncodedVotePart) ProtoMessage()    {}
-func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{17}
+func (m *MultiProof) Reset()         { *m = MultiProof{} }
+func (m *MultiProof) String() string { return proto.CompactTextString(m) }
+func (*MultiProof) ProtoMessage()    {}
+func (*MultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{19}
 }
-func (m *CommonEncodedVotePart) XXX_Unmarshal(b []byte) error {
+func (m *MultiProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommonEncodedVotePart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommonEncodedVotePart.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1140,56 +1078,58 @@ func (m *CommonEncodedVotePart) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CommonEncodedVotePart) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommonEncodedVotePart.Merge(m, src)
+func (m *MultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProof.Merge(m, src)
 }
-func (m *CommonEncodedVotePart) XXX_Size() int {
+func (m *MultiProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommonEncodedVotePart) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommonEncodedVotePart.DiscardUnknown(m)
+func (m *MultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommonEncodedVotePart proto.InternalMessageInfo
+var xxx_messageInfo_MultiProof proto.InternalMessageInfo
 
-func (m *CommonEncodedVotePart) GetSignedDataPrefix() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *MultiProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.SignedDataPrefix
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *CommonEncodedVotePart) GetSignedDataSuffix() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *MultiProof) GetOracleDataMultiProof() []OracleDataProof {
 	if m != nil {
-		return m.SignedDataSuffix
+		return m.OracleDataMultiProof
 	}
 	return nil
 }
 
-// TMSignature contains all details of validator signature for performing signer recovery for ECDSA
-// secp256k1 signature. Note that this struct is written specifically for signature signed on
-// Tendermint's precommit data, which includes the block hash and some additional information prepended
-// and appended to the block hash. The prepended part (prefix) and the appended part (suffix) are
-// different for each signer (including signature size, machine clock, validator index, etc).
-type TMSignature struct {
-	R                github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=r,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"r,omitempty"`
-	S                github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=s,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"s,omitempty"`
-	V                uint32                                           `protobuf:"varint,3,opt,name=v,proto3" json:"v,omitempty"`
-	EncodedTimestamp github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=encoded_timestamp,json=encodedTimestamp,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"encoded_timestamp,omitempty"`
+func (m *MultiProof) GetBlockRelayProof() BlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
+	}
+	return BlockRelayProof{}
 }
 
-func (m *TMSignature) Reset()         { *m = TMSignature{} }
-func (m *TMSignature) String() string { return proto.CompactTextString(m) }
-func (*TMSignature) ProtoMessage()    {}
-func (*TMSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{18}
+// CountProof contains block height, count proof and block relay proof
+type CountProof struct {
+	BlockHeight     uint64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CountProof      RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof"`
+	BlockRelayProof BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
-func (m *TMSignature) XXX_Unmarshal(b []byte) error {
+
+func (m *CountProof) Reset()         { *m = CountProof{} }
+func (m *CountProof) String() string { return proto.CompactTextString(m) }
+func (*CountProof) ProtoMessage()    {}
+func (*CountProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{20}
+}
+func (m *CountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TMSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TMSignature.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1199,65 +1139,58 @@ func (m *TMSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TMSignature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TMSignature.Merge(m, src)
+func (m *CountProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountProof.Merge(m, src)
 }
-func (m *TMSignature) XXX_Size() int {
+func (m *CountProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *TMSignature) XXX_DiscardUnknown() {
-	xxx_messageInfo_TMSignature.DiscardUnknown(m)
+func (m *CountProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TMSignature proto.InternalMessageInfo
-
-func (m *TMSignature) GetR() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.R
-	}
-	return nil
-}
+var xxx_messageInfo_CountProof proto.InternalMessageInfo
 
-func (m *TMSignature) GetS() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *CountProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.S
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *TMSignature) GetV() uint32 {
+func (m *CountProof) GetCountProof() RequestsCountProof {
 	if m != nil {
-		return m.V
+		return m.CountProof
 	}
-	return 0
+	return RequestsCountProof{}
 }
 
-func (m *TMSignature) GetEncodedTimestamp() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *CountProof) GetBlockRelayProof() BlockRelayProof {
 	if m != nil {
-		return m.EncodedTimestamp
+		return m.BlockRelayProof
 	}
-	return nil
+	return BlockRelayProof{}
 }
 
-// RequestsCountProof contains count, version and merkle paths
-type RequestsCountProof struct {
-	Count       uint64           `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+// FeedsPriceProof contains block height, price data proof and store block relay proof
+type FeedsPriceProof struct {
+	BlockHeight     uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PriceDataProof  PriceDataProof       `protobuf:"bytes,2,opt,name=price_data_proof,json=priceDataProof,proto3" json:"price_data_proof"`
+	BlockRelayProof StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func (m *RequestsCountProof) Reset()         { *m = RequestsCountProof{} }
-func (m *RequestsCountProof) String() string { return proto.CompactTextString(m) }
-func (*RequestsCountProof) ProtoMessage()    {}
-func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{19}
+func (m *FeedsPriceProof) Reset()         { *m = FeedsPriceProof{} }
+func (m *FeedsPriceProof) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceProof) ProtoMessage()    {}
+func (*FeedsPriceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{21}
 }
-func (m *RequestsCountProof) XXX_Unmarshal(b []byte) error {
+func (m *FeedsPriceProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RequestsCountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedsPriceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RequestsCountProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedsPriceProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1267,1645 +1200,5687 @@ func (m *RequestsCountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RequestsCountProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RequestsCountProof.Merge(m, src)
+func (m *FeedsPriceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceProof.Merge(m, src)
 }
-func (m *RequestsCountProof) XXX_Size() int {
+func (m *FeedsPriceProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *RequestsCountProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_RequestsCountProof.DiscardUnknown(m)
+func (m *FeedsPriceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RequestsCountProof proto.InternalMessageInfo
+var xxx_messageInfo_FeedsPriceProof proto.InternalMessageInfo
 
-func (m *RequestsCountProof) GetCount() uint64 {
+func (m *FeedsPriceProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Count
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *RequestsCountProof) GetVersion() uint64 {
+func (m *FeedsPriceProof) GetPriceDataProof() PriceDataProof {
 	if m != nil {
-		return m.Version
+		return m.PriceDataProof
 	}
-	return 0
+	return PriceDataProof{}
 }
 
-func (m *RequestsCountProof) GetMerklePaths() []IAVLMerklePath {
+func (m *FeedsPriceProof) GetBlockRelayProof() StoreBlockRelayProof {
 	if m != nil {
-		return m.MerklePaths
+		return m.BlockRelayProof
 	}
-	return nil
+	return StoreBlockRelayProof{}
 }
 
-func init() {
-	proto.RegisterType((*ProofRequest)(nil), "band.base.oracle.v1.ProofRequest")
-	proto.RegisterType((*ProofResponse)(nil), "band.base.oracle.v1.ProofResponse")
-	proto.RegisterType((*MultiProofRequest)(nil), "band.base.oracle.v1.MultiProofRequest")
-	proto.RegisterType((*MultiProofResponse)(nil), "band.base.oracle.v1.MultiProofResponse")
-	proto.RegisterType((*RequestCountProofRequest)(nil), "band.base.oracle.v1.RequestCountProofRequest")
-	proto.RegisterType((*RequestCountProofResponse)(nil), "band.base.oracle.v1.RequestCountProofResponse")
-	proto.RegisterType((*SingleProofResult)(nil), "band.base.oracle.v1.SingleProofResult")
-	proto.RegisterType((*MultiProofResult)(nil), "band.base.oracle.v1.MultiProofResult")
-	proto.RegisterType((*CountProofResult)(nil), "band.base.oracle.v1.CountProofResult")
-	proto.RegisterType((*SingleProof)(nil), "band.base.oracle.v1.SingleProof")
-	proto.RegisterType((*MultiProof)(nil), "band.base.oracle.v1.MultiProof")
-	proto.RegisterType((*CountProof)(nil), "band.base.oracle.v1.CountProof")
-	proto.RegisterType((*OracleDataProof)(nil), "band.base.oracle.v1.OracleDataProof")
-	proto.RegisterType((*IAVLMerklePath)(nil), "band.base.oracle.v1.IAVLMerklePath")
-	proto.RegisterType((*BlockRelayProof)(nil), "band.base.oracle.v1.BlockRelayProof")
-	proto.RegisterType((*MultiStoreProof)(nil), "band.base.oracle.v1.MultiStoreProof")
-	proto.RegisterType((*BlockHeaderMerkleParts)(nil), "band.base.oracle.v1.BlockHeaderMerkleParts")
-	proto.RegisterType((*CommonEncodedVotePart)(nil), "band.base.oracle.v1.CommonEncodedVotePart")
-	proto.RegisterType((*TMSignature)(nil), "band.base.oracle.v1.TMSignature")
-	proto.RegisterType((*RequestsCountProof)(nil), "band.base.oracle.v1.RequestsCountProof")
+// FeedsPriceMultiProof contains block height, list of price data proof and store block relay proof
+type FeedsPriceMultiProof struct {
+	BlockHeight         uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PriceDataMultiProof []PriceDataProof     `protobuf:"bytes,2,rep,name=price_data_multi_proof,json=priceDataMultiProof,proto3" json:"price_data_multi_proof"`
+	BlockRelayProof     StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func init() { proto.RegisterFile("band/base/oracle/v1/proof.proto", fileDescriptor_9deb752797273b09) }
-
-var fileDescriptor_9deb752797273b09 = []byte{
-	// 1577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
-	0x1a, 0xcf, 0xc4, 0x4e, 0xda, 0xfb, 0x39, 0x69, 0x92, 0x93, 0x36, 0x75, 0x73, 0xdb, 0x38, 0x99,
-	0xdb, 0x36, 0x69, 0xab, 0x6b, 0xdf, 0x3e, 0x36, 0x57, 0xf7, 0x6e, 0x92, 0xb6, 0x28, 0x11, 0x7d,
-	0xc9, 0x36, 0x41, 0x42, 0x48, 0xc3, 0xf1, 0xcc, 0x89, 0x3d, 0x74, 0xe6, 0x1c, 0x77, 0xce, 0xb1,
-	0x95, 0x16, 0xa1, 0x22, 0x10, 0x20, 0xb1, 0x01, 0xc1, 0x9a, 0x25, 0x4b, 0xd4, 0x1d, 0x62, 0xc1,
-	0x1f, 0xd0, 0x65, 0x25, 0x16, 0xb0, 0x0a, 0x55, 0xca, 0x8a, 0x3f, 0x81, 0x15, 0x3a, 0x8f, 0xb1,
-	0xc7, 0xf6, 0xa4, 0x09, 0x75, 0xdb, 0xdd, 0xcc, 0x99, 0xef, 0xfc, 0x7e, 0xbf, 0xef, 0x31, 0xdf,
-	0x79, 0x40, 0xa1, 0x86, 0xa9, 0x57, 0xaa, 0x61, 0x4e, 0x4a, 0x2c, 0xc2, 0x6e, 0x40, 0x4a, 0xed,
-	0x8b, 0xa5, 0x66, 0xc4, 0xd8, 0x56, 0xb1, 0x19, 0x31, 0xc1, 0xd0, 0xac, 0x34, 0x28, 0x4a, 0x83,
-	0xa2, 0x36, 0x28, 0xb6, 0x2f, 0xce, 0x9f, 0xac, 0x33, 0x56, 0x0f, 0x48, 0x09, 0x37, 0xfd, 0x12,
-	0xa6, 0x94, 0x09, 0x2c, 0x7c, 0x46, 0xb9, 0x9e, 0x32, 0x7f, 0xb4, 0xce, 0xea, 0x4c, 0x3d, 0x96,
-	0xe4, 0x93, 0x19, 0xfd, 0xa7, 0x62, 0xea, 0x92, 0x18, 0x34, 0xf5, 0xd1, 0xbe, 0x0e, 0x13, 0x77,
-	0x24, 0x69, 0x99, 0xdc, 0x6b, 0x11, 0x2e, 0xd0, 0x29, 0x80, 0x48, 0x3f, 0x3a, 0xbe, 0x97, 0xb7,
-	0x16, 0xad, 0x95, 0x6c, 0xf9, 0x1f, 0x66, 0x64, 0xc3, 0x43, 0x73, 0x30, 0xde, 0x20, 0x7e, 0xbd,
-	0x21, 0xf2, 0xa3, 0x8b, 0xd6, 0x4a, 0xa6, 0x6c, 0xde, 0xec, 0x10, 0x26, 0x0d, 0x0c, 0x6f, 0x32,
-	0xca, 0x49, 0xc2, 0xd0, 0x4a, 0x1a, 0xa2, 0x6b, 0x30, 0x1e, 0x11, 0xde, 0x0a, 0x34, 0x40, 0xee,
-	0xd2, 0xd9, 0x62, 0x8a, 0x9b, 0xc5, 0x8a, 0x4f, 0xeb, 0x01, 0x89, 0x11, 0x5b, 0x81, 0x58, 0xcb,
-	0x3e, 0xde, 0x29, 0x8c, 0x94, 0xcd, 0x5c, 0xfb, 0x0a, 0xcc, 0xdc, 0x6c, 0x05, 0xc2, 0xef, 0x91,
-	0x5e, 0x80, 0x5c, 0x57, 0x3a, 0xcf, 0x5b, 0x8b, 0x99, 0x95, 0x6c, 0x19, 0x3a, 0xda, 0xb9, 0x7d,
-	0x0f, 0x50, 0x72, 0xd6, 0x3e, 0x4a, 0xaf, 0xf6, 0x29, 0x3d, 0x93, 0xaa, 0xb4, 0x07, 0x70, 0x50,
-	0xe8, 0x3c, 0xe4, 0x8d, 0xbc, 0xab, 0xac, 0x45, 0x45, 0x52, 0xaf, 0xbd, 0x0d, 0x27, 0x52, 0xbe,
-	0xbd, 0x14, 0x55, 0x3d, 0x80, 0x83, 0xaa, 0x1e, 0x59, 0x30, 0x33, 0x10, 0x62, 0xf4, 0x7f, 0x18,
-	0x53, 0xf5, 0xa7, 0x18, 0x73, 0x97, 0x16, 0xf7, 0xcb, 0x8c, 0x01, 0xd5, 0x93, 0xd0, 0xbb, 0x30,
-	0x45, 0xda, 0xa1, 0xa3, 0x5e, 0x9c, 0xda, 0x7d, 0x41, 0xb8, 0x52, 0x38, 0xb1, 0x76, 0xe5, 0xcf,
-	0x9d, 0xc2, 0x7f, 0xea, 0xbe, 0x68, 0xb4, 0x6a, 0x45, 0x97, 0x85, 0x25, 0x97, 0x85, 0x44, 0xd4,
-	0xb6, 0x44, 0xf7, 0x21, 0xf0, 0x6b, 0xbc, 0xa4, 0xa6, 0x14, 0xd7, 0xc9, 0xf6, 0x9a, 0x7c, 0x28,
-	0x4f, 0x92, 0x76, 0xa8, 0x59, 0xe4, 0xab, 0xfd, 0xbd, 0x05, 0xd3, 0xfd, 0xa1, 0x46, 0xff, 0xeb,
-	0x15, 0x5c, 0xd8, 0x27, 0x41, 0xaf, 0x5b, 0x6f, 0x7f, 0x12, 0x0e, 0xa6, 0xb7, 0x3b, 0xeb, 0x75,
-	0xea, 0x7d, 0x6a, 0x41, 0x2e, 0x91, 0x5a, 0xb4, 0x04, 0x13, 0xb5, 0x80, 0xb9, 0x77, 0x9d, 0x44,
-	0x11, 0x66, 0xcb, 0x39, 0x35, 0xb6, 0xae, 0x2b, 0x71, 0x13, 0x66, 0xb4, 0x6a, 0xc7, 0xc3, 0x02,
-	0x6b, 0x61, 0xa6, 0x28, 0x4f, 0xa7, 0x7a, 0x76, 0x5b, 0x3d, 0x5d, 0xc3, 0x02, 0x27, 0xdd, 0x9b,
-	0x62, 0xbd, 0xc3, 0x12, 0x57, 0x53, 0x47, 0x24, 0xc0, 0xf7, 0x0d, 0x6e, 0xe6, 0x39, 0xb8, 0x6b,
-	0xd2, 0xba, 0x2c, 0x8d, 0x7b, 0x70, 0x6b, 0xbd, 0xc3, 0xf6, 0x1f, 0x16, 0x40, 0xb7, 0x18, 0x0e,
-	0xe2, 0x21, 0x86, 0xe3, 0x49, 0x0f, 0x43, 0x39, 0xb9, 0xe3, 0x67, 0xe6, 0x6f, 0xfa, 0x79, 0xb4,
-	0xeb, 0x67, 0x42, 0xc5, 0xab, 0x72, 0xf6, 0x17, 0x0b, 0xa0, 0x5b, 0x49, 0x07, 0x71, 0xf6, 0x16,
-	0xe4, 0x5c, 0x39, 0xa1, 0x27, 0x91, 0xcb, 0xa9, 0x1a, 0x4c, 0xd7, 0xe2, 0x03, 0xa5, 0x0a, 0x6e,
-	0x97, 0xf2, 0x55, 0x79, 0xf6, 0xc8, 0x82, 0xa9, 0xbe, 0x08, 0xa3, 0x2b, 0x9d, 0xa6, 0xa8, 0xff,
-	0xac, 0x39, 0x4d, 0x90, 0x54, 0x3c, 0xd8, 0x05, 0x51, 0x1e, 0x0e, 0xb5, 0x49, 0xc4, 0x7d, 0x46,
-	0x95, 0xb7, 0xd9, 0x72, 0xfc, 0x8a, 0x6e, 0xc0, 0x44, 0x48, 0xa2, 0xbb, 0x01, 0x71, 0x9a, 0x58,
-	0x34, 0x78, 0x3e, 0xa3, 0xb2, 0xfd, 0xaf, 0x54, 0xd9, 0x1b, 0xab, 0x9b, 0x37, 0x6e, 0x2a, 0xe3,
-	0x3b, 0x58, 0x34, 0x0c, 0x45, 0x2e, 0xec, 0x8c, 0x70, 0xfb, 0xd3, 0x51, 0x38, 0xd2, 0x6b, 0x85,
-	0x96, 0x61, 0xda, 0xe7, 0xba, 0xaa, 0x18, 0x75, 0xa2, 0x4e, 0x4e, 0x0e, 0x97, 0x27, 0x7d, 0x2e,
-	0xfd, 0xba, 0x4d, 0xcb, 0x2a, 0x2b, 0x67, 0xe0, 0x08, 0x6f, 0xd5, 0x44, 0x44, 0x88, 0x93, 0x58,
-	0x77, 0x27, 0xcb, 0x93, 0x66, 0xd4, 0x24, 0x6f, 0x09, 0x26, 0x62, 0x33, 0xee, 0x3f, 0x20, 0x2a,
-	0xce, 0xd9, 0x72, 0xce, 0x8c, 0x55, 0xfc, 0x07, 0x04, 0x2d, 0xc3, 0x54, 0x6c, 0x12, 0x7b, 0x9d,
-	0x55, 0x56, 0x31, 0xc1, 0xa6, 0x71, 0xfe, 0x6d, 0x98, 0xe0, 0x7e, 0x2d, 0xf0, 0x69, 0xdd, 0x69,
-	0x60, 0xde, 0xc8, 0x8f, 0x0d, 0xd1, 0x65, 0x72, 0x06, 0x69, 0x1d, 0xf3, 0x86, 0xfd, 0x45, 0x06,
-	0xa6, 0xfa, 0x92, 0x2c, 0xab, 0x44, 0xff, 0x56, 0x5c, 0xb0, 0x88, 0x38, 0xc9, 0xf6, 0x78, 0x7a,
-	0xef, 0x76, 0x5e, 0x91, 0xc6, 0x3d, 0x55, 0x12, 0xf6, 0x0e, 0xa3, 0x00, 0x4e, 0xc4, 0x05, 0x8f,
-	0x3d, 0x12, 0x39, 0x9d, 0x74, 0x46, 0x82, 0x9b, 0xda, 0xbe, 0xb0, 0x77, 0x15, 0xae, 0xab, 0x49,
-	0x71, 0xbe, 0x22, 0xc1, 0x0d, 0xcd, 0x5c, 0x2d, 0xf5, 0x2b, 0xf2, 0x21, 0xef, 0xb2, 0x30, 0x64,
-	0xd4, 0x21, 0xd4, 0x65, 0x1e, 0xf1, 0x9c, 0x36, 0x13, 0x9a, 0xcd, 0x94, 0xfc, 0xf9, 0x3d, 0x7a,
-	0xbd, 0x9c, 0x74, 0x5d, 0xcf, 0xd9, 0x64, 0x42, 0xc1, 0x19, 0xae, 0x63, 0x6e, 0xda, 0x47, 0xf4,
-	0x06, 0x00, 0xf7, 0xeb, 0x14, 0x8b, 0x56, 0x44, 0x78, 0x3e, 0xab, 0x0a, 0x33, 0x7d, 0xa5, 0xae,
-	0xde, 0xac, 0xc4, 0x86, 0xf1, 0xef, 0xd9, 0x9d, 0x69, 0xff, 0x38, 0x0e, 0x53, 0x7d, 0xb1, 0x44,
-	0x1f, 0x59, 0x30, 0x67, 0x1a, 0x9e, 0x8f, 0xdb, 0x81, 0xc3, 0x05, 0x16, 0x44, 0x17, 0x81, 0xa5,
-	0x8a, 0xe0, 0xcd, 0xdd, 0x9d, 0xc2, 0xac, 0xfe, 0xf9, 0x64, 0x41, 0x57, 0xe4, 0x77, 0x99, 0xda,
-	0x17, 0xaa, 0x8d, 0x59, 0x4d, 0xb5, 0x81, 0xdb, 0x41, 0x07, 0x08, 0xf9, 0x30, 0x17, 0xfa, 0x54,
-	0x98, 0x72, 0x30, 0x59, 0x53, 0x0a, 0x86, 0x59, 0xec, 0x66, 0x25, 0xa6, 0x72, 0x54, 0x67, 0x4d,
-	0x51, 0x7d, 0x62, 0xc1, 0xe9, 0x26, 0x8e, 0x70, 0xc8, 0x1d, 0xc1, 0x9c, 0x88, 0x70, 0x81, 0xef,
-	0x12, 0x4d, 0xcc, 0x7b, 0x98, 0x33, 0x43, 0x30, 0x17, 0x34, 0x43, 0x95, 0x95, 0x35, 0xbe, 0x12,
-	0xc1, 0x13, 0x2a, 0xbe, 0xb4, 0xe0, 0x7c, 0xc4, 0x02, 0xf9, 0x93, 0x70, 0x42, 0x3c, 0x29, 0x45,
-	0x44, 0x98, 0xf2, 0x2d, 0x12, 0xa5, 0x69, 0xc9, 0x0e, 0xa1, 0xe5, 0x4c, 0x82, 0xa7, 0xca, 0xaa,
-	0x86, 0x65, 0x40, 0xd1, 0xb7, 0x16, 0x2c, 0x09, 0xae, 0x82, 0xd2, 0x6a, 0xd6, 0x23, 0xec, 0xa5,
-	0x06, 0x45, 0x77, 0x85, 0xea, 0xee, 0x4e, 0xe1, 0x64, 0xb5, 0x52, 0xa9, 0xb2, 0xb7, 0xb4, 0x69,
-	0x3f, 0xda, 0x0b, 0x09, 0x3d, 0x29, 0x38, 0xdf, 0x13, 0x11, 0x3d, 0x04, 0x1b, 0xb7, 0x44, 0x43,
-	0xea, 0xf3, 0x5d, 0xdc, 0x60, 0x5c, 0xa4, 0xe9, 0x1b, 0x1f, 0x22, 0x50, 0xa7, 0x24, 0x7e, 0x95,
-	0x6d, 0x68, 0xf4, 0x7e, 0x01, 0xf6, 0x0f, 0x63, 0x30, 0x97, 0xde, 0x26, 0x10, 0x85, 0x13, 0xa6,
-	0xb9, 0x3a, 0x98, 0x7a, 0x8e, 0xdb, 0xc0, 0x3e, 0x75, 0x7c, 0x2f, 0xf9, 0x0f, 0xbd, 0x98, 0xa4,
-	0x63, 0x06, 0x76, 0x95, 0x7a, 0x57, 0x25, 0xe8, 0x86, 0xa7, 0x62, 0xd1, 0x7b, 0x1c, 0xcb, 0x76,
-	0x4e, 0x09, 0x05, 0xc8, 0x09, 0x3f, 0x24, 0x0e, 0x27, 0x2e, 0xa3, 0x9e, 0x59, 0x0e, 0x40, 0x0e,
-	0x55, 0xd4, 0x08, 0x5a, 0x81, 0x69, 0x65, 0x40, 0x31, 0x65, 0xb1, 0x55, 0x56, 0xad, 0x2c, 0x47,
-	0xe4, 0xf8, 0x2d, 0x4c, 0x99, 0xb1, 0x7c, 0x1f, 0x8e, 0x07, 0x98, 0x0b, 0x47, 0xb7, 0x53, 0xdf,
-	0x53, 0x8e, 0x31, 0xd1, 0x20, 0xd1, 0x50, 0x2b, 0xc3, 0xac, 0x04, 0x55, 0x51, 0xdc, 0xf0, 0x56,
-	0xa9, 0x77, 0x5b, 0x02, 0xa2, 0xcf, 0x2d, 0x38, 0x4b, 0xc9, 0xb6, 0x70, 0xda, 0x38, 0xf0, 0x3d,
-	0x2c, 0x58, 0xa4, 0x22, 0xa7, 0x63, 0x29, 0x8f, 0x45, 0x94, 0xb7, 0xf8, 0xf0, 0xf9, 0x5d, 0x92,
-	0x1c, 0x9b, 0x31, 0x85, 0x0c, 0xa4, 0x0c, 0x6b, 0x8c, 0xaf, 0x02, 0xfb, 0x1e, 0xcc, 0x28, 0xaf,
-	0xf5, 0x56, 0xc1, 0x70, 0x1e, 0x1a, 0x82, 0x73, 0x4a, 0xc2, 0xe9, 0x6d, 0x88, 0x66, 0xb8, 0x07,
-	0xf3, 0xa4, 0xed, 0x7b, 0x84, 0xba, 0x44, 0xf9, 0xd7, 0x8c, 0x58, 0x93, 0x71, 0xa2, 0x3d, 0xce,
-	0x1f, 0x1e, 0x82, 0xea, 0x78, 0x8c, 0xbb, 0x4a, 0xbd, 0x3b, 0x06, 0x55, 0x15, 0xee, 0x6f, 0x16,
-	0x1c, 0x4b, 0x5d, 0x72, 0x50, 0x0d, 0x90, 0x5c, 0x1b, 0x88, 0x17, 0xef, 0xe5, 0xc9, 0x96, 0xbf,
-	0x3d, 0x54, 0xc1, 0x4e, 0x6b, 0x3c, 0xbd, 0x47, 0x93, 0x68, 0xfd, 0x1c, 0xbc, 0xb5, 0x25, 0x39,
-	0x46, 0x5f, 0x0e, 0x47, 0x45, 0xa1, 0xd9, 0x9f, 0x8d, 0x42, 0x2e, 0xb1, 0xee, 0xa1, 0x35, 0xb0,
-	0xa2, 0xa1, 0xdc, 0xb0, 0x22, 0x89, 0x31, 0xdc, 0x51, 0xcb, 0xe2, 0x68, 0x02, 0xac, 0xb6, 0xfa,
-	0x0b, 0x27, 0xcb, 0x56, 0x1b, 0x61, 0x98, 0x89, 0xf7, 0x09, 0xf2, 0x67, 0xe3, 0x02, 0x87, 0xcd,
-	0xa1, 0x3a, 0xfb, 0xb4, 0x81, 0xab, 0xc6, 0x68, 0xf6, 0xd7, 0x16, 0xa0, 0xc1, 0x6d, 0x3a, 0x3a,
-	0x0a, 0x63, 0x6a, 0x8b, 0x6e, 0x0e, 0x00, 0xfa, 0xe5, 0x75, 0x6d, 0x84, 0x2f, 0xfd, 0x94, 0x81,
-	0x43, 0x15, 0x12, 0xb5, 0x7d, 0x97, 0xa0, 0x87, 0x30, 0x66, 0x8e, 0x26, 0xa9, 0x60, 0xc9, 0x8b,
-	0x92, 0x79, 0xfb, 0x79, 0x26, 0xfa, 0xbe, 0xc4, 0x2e, 0x7d, 0xfc, 0xf3, 0xef, 0xdf, 0x8c, 0x9e,
-	0x43, 0xcb, 0x25, 0x69, 0xab, 0x1a, 0x6f, 0xf7, 0xae, 0x4b, 0xdf, 0xab, 0x95, 0x3e, 0xe8, 0x5e,
-	0x0f, 0x7d, 0x28, 0x7b, 0x4d, 0xf2, 0x38, 0x78, 0x76, 0xdf, 0xdb, 0x1d, 0xad, 0x65, 0x79, 0xff,
-	0x5b, 0x20, 0x2d, 0x68, 0x45, 0x09, 0xb2, 0xd1, 0x62, 0xaa, 0xa0, 0xc4, 0x59, 0x12, 0x7d, 0x67,
-	0xc1, 0xcc, 0xc0, 0x45, 0x10, 0xfa, 0xf7, 0xf3, 0x8e, 0x5e, 0x03, 0x97, 0x49, 0xf3, 0xc5, 0x83,
-	0x9a, 0x1b, 0x79, 0x17, 0x95, 0xbc, 0x0b, 0xe8, 0x5c, 0xaa, 0x3c, 0x13, 0x28, 0xee, 0x24, 0x8e,
-	0x84, 0x6b, 0x95, 0xc7, 0xbb, 0x0b, 0xd6, 0x93, 0xdd, 0x05, 0xeb, 0xe9, 0xee, 0x82, 0xf5, 0xd5,
-	0xb3, 0x85, 0x91, 0x27, 0xcf, 0x16, 0x46, 0x7e, 0x7d, 0xb6, 0x30, 0xf2, 0xce, 0x7f, 0x13, 0x15,
-	0x2b, 0xe1, 0xd4, 0xd5, 0xa2, 0xcb, 0x82, 0x92, 0xc1, 0xbd, 0x5c, 0x72, 0x03, 0x9f, 0x50, 0x51,
-	0xaa, 0x47, 0x4d, 0xb7, 0x27, 0x27, 0xb5, 0x71, 0x65, 0x7b, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x55, 0x8b, 0xf2, 0x48, 0x0f, 0x15, 0x00, 0x00,
+func (m *FeedsPriceMultiProof) Reset()         { *m = FeedsPriceMultiProof{} }
+func (m *FeedsPriceMultiProof) String() string { return proto.CompactTextString(m) }
+func (*FeedsPriceMultiProof) ProtoMessage()    {}
+func (*FeedsPriceMultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{22}
+}
+func (m *FeedsPriceMultiProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedsPriceMultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedsPriceMultiProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedsPriceMultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsPriceMultiProof.Merge(m, src)
+}
+func (m *FeedsPriceMultiProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedsPriceMultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsPriceMultiProof.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_FeedsPriceMultiProof proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ServiceClient is the client API for Service service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ServiceClient interface {
-	// Proof queries the proof for given request ID
-	Proof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error)
-	// MultiProof queries multiple proofs for given list of request IDs
-	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
-	// RequestCountProof queries the count proof
-	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
-}
-
-type serviceClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
-	return &serviceClient{cc}
-}
-
-func (c *serviceClient) Proof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error) {
-	out := new(ProofResponse)
-	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/Proof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeedsPriceMultiProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *serviceClient) MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error) {
-	out := new(MultiProofResponse)
-	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/MultiProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeedsPriceMultiProof) GetPriceDataMultiProof() []PriceDataProof {
+	if m != nil {
+		return m.PriceDataMultiProof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error) {
-	out := new(RequestCountProofResponse)
-	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/RequestCountProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FeedsPriceMultiProof) GetBlockRelayProof() StoreBlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
 	}
-	return out, nil
+	return StoreBlockRelayProof{}
 }
 
-// ServiceServer is the server API for Service service.
-type ServiceServer interface {
-	// Proof queries the proof for given request ID
-	Proof(context.Context, *ProofRequest) (*ProofResponse, error)
-	// MultiProof queries multiple proofs for given list of request IDs
-	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
-	// RequestCountProof queries the count proof
-	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+// TunnelPacketProof contains block height, packet data proof and store block relay proof
+type TunnelPacketProof struct {
+	BlockHeight     uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PacketDataProof PacketDataProof      `protobuf:"bytes,2,opt,name=packet_data_proof,json=packetDataProof,proto3" json:"packet_data_proof"`
+	BlockRelayProof StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-// UnimplementedServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
+func (m *TunnelPacketProof) Reset()         { *m = TunnelPacketProof{} }
+func (m *TunnelPacketProof) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProof) ProtoMessage()    {}
+func (*TunnelPacketProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{23}
 }
-
-func (*UnimplementedServiceServer) Proof(ctx context.Context, req *ProofRequest) (*ProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proof not implemented")
+func (m *TunnelPacketProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedServiceServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*MultiProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiProof not implemented")
+func (m *TunnelPacketProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedServiceServer) RequestCountProof(ctx context.Context, req *RequestCountProofRequest) (*RequestCountProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
+func (m *TunnelPacketProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProof.Merge(m, src)
 }
-
-func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
-	s.RegisterService(&_Service_serviceDesc, srv)
+func (m *TunnelPacketProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProof.DiscardUnknown(m)
 }
 
-func _Service_Proof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).Proof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.base.oracle.v1.Service/Proof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).Proof(ctx, req.(*ProofRequest))
+var xxx_messageInfo_TunnelPacketProof proto.InternalMessageInfo
+
+func (m *TunnelPacketProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Service_MultiProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MultiProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).MultiProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.base.oracle.v1.Service/MultiProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).MultiProof(ctx, req.(*MultiProofRequest))
+func (m *TunnelPacketProof) GetPacketDataProof() PacketDataProof {
+	if m != nil {
+		return m.PacketDataProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return PacketDataProof{}
 }
 
-func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestCountProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).RequestCountProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.base.oracle.v1.Service/RequestCountProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).RequestCountProof(ctx, req.(*RequestCountProofRequest))
+func (m *TunnelPacketProof) GetBlockRelayProof() StoreBlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return StoreBlockRelayProof{}
 }
 
-var _Service_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.base.oracle.v1.Service",
-	HandlerType: (*ServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Proof",
-			Handler:    _Service_Proof_Handler,
-		},
-		{
-			MethodName: "MultiProof",
-			Handler:    _Service_MultiProof_Handler,
-		},
-		{
-			MethodName: "RequestCountProof",
-			Handler:    _Service_RequestCountProof_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/base/oracle/v1/proof.proto",
+// PriceDataProof contains price, version and merkle paths
+type PriceDataProof struct {
+	Price       types.Price      `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *ProofRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceDataProof) Reset()         { *m = PriceDataProof{} }
+func (m *PriceDataProof) String() string { return proto.CompactTextString(m) }
+func (*PriceDataProof) ProtoMessage()    {}
+func (*PriceDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{24}
+}
+func (m *PriceDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ProofRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDataProof.Merge(m, src)
+}
+func (m *PriceDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDataProof.DiscardUnknown(m)
 }
 
-func (m *ProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_PriceDataProof proto.InternalMessageInfo
+
+func (m *PriceDataProof) GetPrice() types.Price {
+	if m != nil {
+		return m.Price
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+	return types.Price{}
+}
+
+func (m *PriceDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PacketDataProof contains packet, version and merkle paths
+type PacketDataProof struct {
+	Packet      types1.Packet    `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *ProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+func (m *PacketDataProof) Reset()         { *m = PacketDataProof{} }
+func (m *PacketDataProof) String() string { return proto.CompactTextString(m) }
+func (*PacketDataProof) ProtoMessage()    {}
+func (*PacketDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{25}
+}
+func (m *PacketDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PacketDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataProof.Merge(m, src)
+}
+func (m *PacketDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataProof.DiscardUnknown(m)
 }
 
-func (m *MultiProofRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PacketDataProof proto.InternalMessageInfo
+
+func (m *PacketDataProof) GetPacket() types1.Packet {
+	if m != nil {
+		return m.Packet
 	}
-	return dAtA[:n], nil
+	return types1.Packet{}
 }
 
-func (m *MultiProofRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MultiProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestIds) > 0 {
-		dAtA3 := make([]byte, len(m.RequestIds)*10)
-		var j2 int
-		for _, num := range m.RequestIds {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintProof(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+func (m *PacketDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MultiProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PacketDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultiProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OracleDataProof contains result, version and merkle paths
+type OracleDataProof struct {
+	Result      types2.Result    `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *MultiProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
+func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
+func (*OracleDataProof) ProtoMessage()    {}
+func (*OracleDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{26}
+}
+func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RequestCountProofRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OracleDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDataProof.Merge(m, src)
+}
+func (m *OracleDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
 }
 
-func (m *RequestCountProofRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
+
+func (m *OracleDataProof) GetResult() types2.Result {
+	if m != nil {
+		return m.Result
+	}
+	return types2.Result{}
 }
 
-func (m *RequestCountProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *OracleDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *RequestCountProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RequestCountProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// IAVLMerklePath represents a Merkle step to a leaf data node in an iAVL tree.
+type IAVLMerklePath struct {
+	IsDataOnRight  bool                                             `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
+	SubtreeHeight  uint32                                           `protobuf:"varint,2,opt,name=subtree_height,json=subtreeHeight,proto3" json:"subtree_height,omitempty"`
+	SubtreeSize    uint64                                           `protobuf:"varint,3,opt,name=subtree_size,json=subtreeSize,proto3" json:"subtree_size,omitempty"`
+	SubtreeVersion uint64                                           `protobuf:"varint,4,opt,name=subtree_version,json=subtreeVersion,proto3" json:"subtree_version,omitempty"`
+	SiblingHash    github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=sibling_hash,json=siblingHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"sibling_hash,omitempty"`
 }
 
-func (m *RequestCountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+func (m *IAVLMerklePath) Reset()         { *m = IAVLMerklePath{} }
+func (m *IAVLMerklePath) String() string { return proto.CompactTextString(m) }
+func (*IAVLMerklePath) ProtoMessage()    {}
+func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{27}
+}
+func (m *IAVLMerklePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IAVLMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IAVLMerklePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *IAVLMerklePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IAVLMerklePath.Merge(m, src)
+}
+func (m *IAVLMerklePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *IAVLMerklePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_IAVLMerklePath.DiscardUnknown(m)
 }
 
-func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IAVLMerklePath proto.InternalMessageInfo
+
+func (m *IAVLMerklePath) GetIsDataOnRight() bool {
+	if m != nil {
+		return m.IsDataOnRight
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IAVLMerklePath) GetSubtreeHeight() uint32 {
+	if m != nil {
+		return m.SubtreeHeight
+	}
+	return 0
 }
 
-func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
-		i--
-		dAtA[i] = 0x12
+func (m *IAVLMerklePath) GetSubtreeSize() uint64 {
+	if m != nil {
+		return m.SubtreeSize
 	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *IAVLMerklePath) GetSubtreeVersion() uint64 {
+	if m != nil {
+		return m.SubtreeVersion
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IAVLMerklePath) GetSiblingHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SiblingHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockRelayProof contains multi store proof, block header merkle parts, common encoded part and signatures
+type BlockRelayProof struct {
+	MultiStoreProof        MultiStoreProof        `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof"`
+	BlockHeaderMerkleParts BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
+	CommonEncodedVotePart  CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
+	Signatures             []TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
 }
 
-func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+func (m *BlockRelayProof) Reset()         { *m = BlockRelayProof{} }
+func (m *BlockRelayProof) String() string { return proto.CompactTextString(m) }
+func (*BlockRelayProof) ProtoMessage()    {}
+func (*BlockRelayProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{28}
+}
+func (m *BlockRelayProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRelayProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BlockRelayProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRelayProof.Merge(m, src)
+}
+func (m *BlockRelayProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRelayProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRelayProof.DiscardUnknown(m)
 }
 
-func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockRelayProof proto.InternalMessageInfo
+
+func (m *BlockRelayProof) GetMultiStoreProof() MultiStoreProof {
+	if m != nil {
+		return m.MultiStoreProof
 	}
-	return dAtA[:n], nil
+	return MultiStoreProof{}
 }
 
-func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockRelayProof) GetBlockHeaderMerkleParts() BlockHeaderMerkleParts {
+	if m != nil {
+		return m.BlockHeaderMerkleParts
+	}
+	return BlockHeaderMerkleParts{}
 }
 
-func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+func (m *BlockRelayProof) GetCommonEncodedVotePart() CommonEncodedVotePart {
+	if m != nil {
+		return m.CommonEncodedVotePart
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return CommonEncodedVotePart{}
 }
 
-func (m *SingleProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockRelayProof) GetSignatures() []TMSignature {
+	if m != nil {
+		return m.Signatures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StoreBlockRelayProof contains multi store merkle proof, block header merkle parts, common encoded part
+// and signatures. Unlike BlockRelayProof, it can prove the storage hash of any store in the multistore.
+type StoreBlockRelayProof struct {
+	MultiStoreMerkleProof  MultiStoreMerkleProof  `protobuf:"bytes,1,opt,name=multi_store_merkle_proof,json=multiStoreMerkleProof,proto3" json:"multi_store_merkle_proof"`
+	BlockHeaderMerkleParts BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
+	CommonEncodedVotePart  CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
+	Signatures             []TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
 }
 
-func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+func (m *StoreBlockRelayProof) Reset()         { *m = StoreBlockRelayProof{} }
+func (m *StoreBlockRelayProof) String() string { return proto.CompactTextString(m) }
+func (*StoreBlockRelayProof) ProtoMessage()    {}
+func (*StoreBlockRelayProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{29}
+}
+func (m *StoreBlockRelayProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreBlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreBlockRelayProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+}
+func (m *StoreBlockRelayProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreBlockRelayProof.Merge(m, src)
+}
+func (m *StoreBlockRelayProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreBlockRelayProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreBlockRelayProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreBlockRelayProof proto.InternalMessageInfo
+
+func (m *StoreBlockRelayProof) GetMultiStoreMerkleProof() MultiStoreMerkleProof {
+	if m != nil {
+		return m.MultiStoreMerkleProof
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return MultiStoreMerkleProof{}
+}
+
+func (m *StoreBlockRelayProof) GetBlockHeaderMerkleParts() BlockHeaderMerkleParts {
+	if m != nil {
+		return m.BlockHeaderMerkleParts
 	}
-	return len(dAtA) - i, nil
+	return BlockHeaderMerkleParts{}
 }
 
-func (m *MultiProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StoreBlockRelayProof) GetCommonEncodedVotePart() CommonEncodedVotePart {
+	if m != nil {
+		return m.CommonEncodedVotePart
 	}
-	return dAtA[:n], nil
+	return CommonEncodedVotePart{}
 }
 
-func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StoreBlockRelayProof) GetSignatures() []TMSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
 
-func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+// MultiStoreMerkleProof contains the storage hash of a store and the merkle paths from the store to
+// Tendermint's application state hash at a given block.
+//
+// The leaf of the store is SHA256(0x00 || len(store_key) || store_key || 0x20 || SHA256(iavl_state_hash))
+// and each path computes its parent as SHA256(0x01 || left || right).
+type MultiStoreMerkleProof struct {
+	StoreKey      string                                           `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	IAVLStateHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=iavl_state_hash,json=iavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"iavl_state_hash,omitempty"`
+	MerklePaths   []MultiStoreMerklePath                           `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *MultiStoreMerkleProof) Reset()         { *m = MultiStoreMerkleProof{} }
+func (m *MultiStoreMerkleProof) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreMerkleProof) ProtoMessage()    {}
+func (*MultiStoreMerkleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{30}
+}
+func (m *MultiStoreMerkleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreMerkleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreMerkleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleDataMultiProof) > 0 {
-		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *MultiStoreMerkleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreMerkleProof.Merge(m, src)
+}
+func (m *MultiStoreMerkleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreMerkleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreMerkleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStoreMerkleProof proto.InternalMessageInfo
+
+func (m *MultiStoreMerkleProof) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MultiStoreMerkleProof) GetIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.IAVLStateHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CountProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiStoreMerkleProof) GetMerklePaths() []MultiStoreMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MultiStoreMerklePath represents a Merkle step to a store leaf node in the multistore.
+type MultiStoreMerklePath struct {
+	IsDataOnRight bool                                             `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
+	SiblingHash   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=sibling_hash,json=siblingHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"sibling_hash,omitempty"`
 }
 
-func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+func (m *MultiStoreMerklePath) Reset()         { *m = MultiStoreMerklePath{} }
+func (m *MultiStoreMerklePath) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreMerklePath) ProtoMessage()    {}
+func (*MultiStoreMerklePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{31}
+}
+func (m *MultiStoreMerklePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreMerklePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MultiStoreMerklePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreMerklePath.Merge(m, src)
+}
+func (m *MultiStoreMerklePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreMerklePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreMerklePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStoreMerklePath proto.InternalMessageInfo
+
+func (m *MultiStoreMerklePath) GetIsDataOnRight() bool {
+	if m != nil {
+		return m.IsDataOnRight
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiStoreMerklePath) GetSiblingHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SiblingHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
+// compute (in combination with oracle store hash) Tendermint's application state hash at a given block.
+//
+//	                                 __________________________[AppHash]__________________________
+//	                                /                                                             \
+//	                  ___________[N24]____________                                    ___________[N25]________
+//	                 /                            \                                  /                        \
+//	          _____[N20]_____                 ____[N21]______                   ____[N22]______              _[N23]_
+//	         /               \               /               \                 /               \            /       \
+//	     _[N12]_          _[N13]_          _[N14]_          _[N15]_          _[N16]_          _[N17]_     [N18]     [Q]
+//	    /       \        /       \        /       \        /       \        /       \        /       \    /   \
+//	  [N0]     [N1]    [N2]     [N3]    [N4]     [N5]    [N6]     [N7]    [N8]     [N9]   [N10]    [N11] [O] [P]
+//	 /   \    /   \    /  \    /   \   /   \    /   \   /   \    /   \   /   \    /   \   /   \    /   \
+//	[0] [1]  [2] [3] [4] [5]  [6] [7] [8] [9]  [A] [B] [C] [D]  [E] [F] [G] [H]  [I] [J] [K] [L]  [M] [N]
+//
+// [0] - acc (auth) [1] - authz         [2] - bandtss       [3] - bank        [4] - capability [5] - consensus
+// [6] - crisis     [7] - distribution  [8] - evidence      [9] - feeds       [A] - feegrant   [B] - feeibc
+// [C] - globalfee  [D] - gov           [E] - ibc           [F] - icahost     [G] - mint       [H] - oracle
+// [I] - params     [J] - restake       [K] - rollingseed   [L] - slashing    [M] - staking    [N] - transfer
+// [O] - tss        [P] - tunnel        [Q] - upgrade
+//
+// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
+// root hash, since we only want to validate the correctness of [H] In fact, only
+// [G], [N9], [N17], [N23], and [N24] are needed in order to compute [AppHash].
+type MultiStoreProof struct {
+	OracleIAVLStateHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=oracle_iavl_state_hash,json=oracleIavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"oracle_iavl_state_hash,omitempty"`
+	MintStoreMerkleHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=mint_store_merkle_hash,json=mintStoreMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"mint_store_merkle_hash,omitempty"`
+	ParamsToRestakeStoresMerkleHash       github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=params_to_restake_stores_merkle_hash,json=paramsToRestakeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"params_to_restake_stores_merkle_hash,omitempty"`
+	RollingseedToTransferStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=rollingseed_to_transfer_stores_merkle_hash,json=rollingseedToTransferStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"rollingseed_to_transfer_stores_merkle_hash,omitempty"`
+	TSSToUpgradeStoresMerkleHash          github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=tss_to_upgrade_stores_merkle_hash,json=tssToUpgradeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"tss_to_upgrade_stores_merkle_hash,omitempty"`
+	AuthToIcahostStoresMerkleHash         github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=auth_to_icahost_stores_merkle_hash,json=authToIcahostStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"auth_to_icahost_stores_merkle_hash,omitempty"`
 }
 
-func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MerklePaths) > 0 {
-		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Version != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
+func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreProof) ProtoMessage()    {}
+func (*MultiStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{32}
+}
+func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MultiStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreProof.Merge(m, src)
+}
+func (m *MultiStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreProof.DiscardUnknown(m)
 }
 
-func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiStoreProof proto.InternalMessageInfo
+
+func (m *MultiStoreProof) GetOracleIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.OracleIAVLStateHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MultiStoreProof) GetMintStoreMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.MintStoreMerkleHash
+	}
+	return nil
 }
 
-func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SiblingHash) > 0 {
-		i -= len(m.SiblingHash)
-		copy(dAtA[i:], m.SiblingHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SubtreeVersion != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SubtreeSize != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
-		i--
-		dAtA[i] = 0x18
+func (m *MultiStoreProof) GetParamsToRestakeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ParamsToRestakeStoresMerkleHash
 	}
-	if m.SubtreeHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *MultiStoreProof) GetRollingseedToTransferStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RollingseedToTransferStoresMerkleHash
 	}
-	if m.IsDataOnRight {
-		i--
-		if m.IsDataOnRight {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *MultiStoreProof) GetTSSToUpgradeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.TSSToUpgradeStoresMerkleHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.AuthToIcahostStoresMerkleHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
+// header hash from app hash, and height.
+//
+// In Tendermint, a block header hash is the Merkle hash of a binary tree with 14 leaf nodes.
+// Each node encodes a data piece of the blockchain. The notable data leaves are: [A] app_hash,
+// [2] height. All data pieces are combined into one 32-byte hash to be signed
+// by block validators. The structure of the Merkle tree is shown below.
+//
+//	                                 [BlockHeader]
+//	                              /                \
+//	                 [3A]                                    [3B]
+//	               /      \                                /      \
+//	       [2A]                [2B]                [2C]                [2D]
+//	      /    \              /    \              /    \              /    \
+//	  [1A]      [1B]      [1C]      [1D]      [1E]      [1F]        [C]    [D]
+//	  /  \      /  \      /  \      /  \      /  \      /  \
+//	[0]  [1]  [2]  [3]  [4]  [5]  [6]  [7]  [8]  [9]  [A]  [B]
+//
+//	[0] - version               [1] - chain_id            [2] - height        [3] - time
+//	[4] - last_block_id         [5] - last_commit_hash    [6] - data_hash     [7] - validators_hash
+//	[8] - next_validators_hash  [9] - consensus_hash      [A] - app_hash      [B] - last_results_hash
+//	[C] - evidence_hash         [D] - proposer_address
+//
+// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
+// root hash, since we only want to validate the correctness of [2], [3], and [A]. In fact, only
+// [1A], [2B], [1E], [B], and [2D] are needed in order to compute [BlockHeader].
+type BlockHeaderMerkleParts struct {
+	VersionAndChainIdHash             github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=version_and_chain_id_hash,json=versionAndChainIdHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"version_and_chain_id_hash,omitempty"`
+	Height                            uint64                                           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TimeSecond                        uint64                                           `protobuf:"varint,3,opt,name=time_second,json=timeSecond,proto3" json:"time_second,omitempty"`
+	TimeNanoSecond                    uint32                                           `protobuf:"varint,4,opt,name=time_nano_second,json=timeNanoSecond,proto3" json:"time_nano_second,omitempty"`
+	LastBlockIdAndOther               github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=last_block_id_and_other,json=lastBlockIdAndOther,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_block_id_and_other,omitempty"`
+	NextValidatorHashAndConsensusHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=next_validator_hash_and_consensus_hash,json=nextValidatorHashAndConsensusHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"next_validator_hash_and_consensus_hash,omitempty"`
+	LastResultsHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=last_results_hash,json=lastResultsHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_results_hash,omitempty"`
+	EvidenceAndProposerHash           github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=evidence_and_proposer_hash,json=evidenceAndProposerHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evidence_and_proposer_hash,omitempty"`
 }
 
-func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{} }
+func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
+func (*BlockHeaderMerkleParts) ProtoMessage()    {}
+func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{33}
+}
+func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeaderMerkleParts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BlockHeaderMerkleParts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeaderMerkleParts.Merge(m, src)
 }
-
-func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockHeaderMerkleParts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeaderMerkleParts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeaderMerkleParts.DiscardUnknown(m)
 }
 
-func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
-		i -= len(m.AuthToIcahostStoresMerkleHash)
-		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TSSToUpgradeStoresMerkleHash) > 0 {
-		i -= len(m.TSSToUpgradeStoresMerkleHash)
-		copy(dAtA[i:], m.TSSToUpgradeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.TSSToUpgradeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
-		i -= len(m.RollingseedToTransferStoresMerkleHash)
-		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
-		i -= len(m.ParamsToRestakeStoresMerkleHash)
-		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MintStoreMerkleHash) > 0 {
-		i -= len(m.MintStoreMerkleHash)
-		copy(dAtA[i:], m.MintStoreMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OracleIAVLStateHash) > 0 {
-		i -= len(m.OracleIAVLStateHash)
-		copy(dAtA[i:], m.OracleIAVLStateHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_BlockHeaderMerkleParts proto.InternalMessageInfo
+
+func (m *BlockHeaderMerkleParts) GetVersionAndChainIdHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VersionAndChainIdHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockHeaderMerkleParts) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockHeaderMerkleParts) GetTimeSecond() uint64 {
+	if m != nil {
+		return m.TimeSecond
+	}
+	return 0
 }
 
-func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvidenceAndProposerHash) > 0 {
-		i -= len(m.EvidenceAndProposerHash)
-		copy(dAtA[i:], m.EvidenceAndProposerHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LastResultsHash) > 0 {
-		i -= len(m.LastResultsHash)
-		copy(dAtA[i:], m.LastResultsHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.NextValidatorHashAndConsensusHash) > 0 {
-		i -= len(m.NextValidatorHashAndConsensusHash)
-		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LastBlockIdAndOther) > 0 {
-		i -= len(m.LastBlockIdAndOther)
-		copy(dAtA[i:], m.LastBlockIdAndOther)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeNanoSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TimeSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VersionAndChainIdHash) > 0 {
-		i -= len(m.VersionAndChainIdHash)
-		copy(dAtA[i:], m.VersionAndChainIdHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *BlockHeaderMerkleParts) GetTimeNanoSecond() uint32 {
+	if m != nil {
+		return m.TimeNanoSecond
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockHeaderMerkleParts) GetLastBlockIdAndOther() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.LastBlockIdAndOther
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockHeaderMerkleParts) GetNextValidatorHashAndConsensusHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.NextValidatorHashAndConsensusHash
+	}
+	return nil
 }
 
-func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignedDataSuffix) > 0 {
-		i -= len(m.SignedDataSuffix)
-		copy(dAtA[i:], m.SignedDataSuffix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
-		i--
-		dAtA[i] = 0x12
+func (m *BlockHeaderMerkleParts) GetLastResultsHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.LastResultsHash
 	}
-	if len(m.SignedDataPrefix) > 0 {
-		i -= len(m.SignedDataPrefix)
-		copy(dAtA[i:], m.SignedDataPrefix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *BlockHeaderMerkleParts) GetEvidenceAndProposerHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvidenceAndProposerHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TMSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// CommonEncodedVotePart represents the common part of encoded vote
+type CommonEncodedVotePart struct {
+	SignedDataPrefix github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=signed_data_prefix,json=signedDataPrefix,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"signed_data_prefix,omitempty"`
+	SignedDataSuffix github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=signed_data_suffix,json=signedDataSuffix,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"signed_data_suffix,omitempty"`
 }
 
-func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CommonEncodedVotePart) Reset()         { *m = CommonEncodedVotePart{} }
+func (m *CommonEncodedVotePart) String() string { return proto.CompactTextString(m) }
+func (*CommonEncodedVotePart) ProtoMessage()    {}
+func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{34}
 }
-
-func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncodedTimestamp) > 0 {
-		i -= len(m.EncodedTimestamp)
-		copy(dAtA[i:], m.EncodedTimestamp)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.V != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.V))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x12
+func (m *CommonEncodedVotePart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommonEncodedVotePart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommonEncodedVotePart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CommonEncodedVotePart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonEncodedVotePart.Merge(m, src)
+}
+func (m *CommonEncodedVotePart) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommonEncodedVotePart) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonEncodedVotePart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommonEncodedVotePart proto.InternalMessageInfo
+
+func (m *CommonEncodedVotePart) GetSignedDataPrefix() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SignedDataPrefix
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CommonEncodedVotePart) GetSignedDataSuffix() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SignedDataSuffix
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TMSignature contains all details of validator signature for performing signer recovery for ECDSA
+// secp256k1 signature. Note that this struct is written specifically for signature signed on
+// Tendermint's precommit data, which includes the block hash and some additional information prepended
+// and appended to the block hash. The prepended part (prefix) and the appended part (suffix) are
+// different for each signer (including signature size, machine clock, validator index, etc).
+type TMSignature struct {
+	R                github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=r,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"r,omitempty"`
+	S                github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=s,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"s,omitempty"`
+	V                uint32                                           `protobuf:"varint,3,opt,name=v,proto3" json:"v,omitempty"`
+	EncodedTimestamp github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=encoded_timestamp,json=encodedTimestamp,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"encoded_timestamp,omitempty"`
 }
 
-func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MerklePaths) > 0 {
-		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *TMSignature) Reset()         { *m = TMSignature{} }
+func (m *TMSignature) String() string { return proto.CompactTextString(m) }
+func (*TMSignature) ProtoMessage()    {}
+func (*TMSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{35}
+}
+func (m *TMSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TMSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TMSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Version != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Count != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProof(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *TMSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TMSignature.Merge(m, src)
 }
-func (m *ProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovProof(uint64(m.RequestId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
-	}
-	return n
+func (m *TMSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *TMSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_TMSignature.DiscardUnknown(m)
 }
 
-func (m *ProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+var xxx_messageInfo_TMSignature proto.InternalMessageInfo
+
+func (m *TMSignature) GetR() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.R
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MultiProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RequestIds) > 0 {
-		l = 0
-		for _, e := range m.RequestIds {
-			l += sovProof(uint64(e))
-		}
-		n += 1 + sovProof(uint64(l)) + l
+func (m *TMSignature) GetS() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.S
 	}
-	return n
+	return nil
 }
 
-func (m *MultiProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+func (m *TMSignature) GetV() uint32 {
+	if m != nil {
+		return m.V
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return 0
 }
 
-func (m *RequestCountProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TMSignature) GetEncodedTimestamp() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EncodedTimestamp
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *RequestCountProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
-	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+// RequestsCountProof contains count, version and merkle paths
+type RequestsCountProof struct {
+	Count       uint64           `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *SingleProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+func (m *RequestsCountProof) Reset()         { *m = RequestsCountProof{} }
+func (m *RequestsCountProof) String() string { return proto.CompactTextString(m) }
+func (*RequestsCountProof) ProtoMessage()    {}
+func (*RequestsCountProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{36}
+}
+func (m *RequestsCountProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestsCountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestsCountProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RequestsCountProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestsCountProof.Merge(m, src)
+}
+func (m *RequestsCountProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestsCountProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestsCountProof.DiscardUnknown(m)
 }
 
-func (m *MultiProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+var xxx_messageInfo_RequestsCountProof proto.InternalMessageInfo
+
+func (m *RequestsCountProof) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return 0
 }
 
-func (m *CountProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+func (m *RequestsCountProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return 0
 }
 
-func (m *SingleProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+func (m *RequestsCountProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
-	l = m.OracleDataProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MultiProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
-	}
-	if len(m.OracleDataMultiProof) > 0 {
-		for _, e := range m.OracleDataMultiProof {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
-		}
-	}
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+func init() {
+	proto.RegisterType((*ProofRequest)(nil), "band.base.oracle.v1.ProofRequest")
+	proto.RegisterType((*ProofResponse)(nil), "band.base.oracle.v1.ProofResponse")
+	proto.RegisterType((*MultiProofRequest)(nil), "band.base.oracle.v1.MultiProofRequest")
+	proto.RegisterType((*MultiProofResponse)(nil), "band.base.oracle.v1.MultiProofResponse")
+	proto.RegisterType((*RequestCountProofRequest)(nil), "band.base.oracle.v1.RequestCountProofRequest")
+	proto.RegisterType((*RequestCountProofResponse)(nil), "band.base.oracle.v1.RequestCountProofResponse")
+	proto.RegisterType((*FeedsPriceProofRequest)(nil), "band.base.oracle.v1.FeedsPriceProofRequest")
+	proto.RegisterType((*FeedsPriceProofResponse)(nil), "band.base.oracle.v1.FeedsPriceProofResponse")
+	proto.RegisterType((*FeedsPriceMultiProofRequest)(nil), "band.base.oracle.v1.FeedsPriceMultiProofRequest")
+	proto.RegisterType((*FeedsPriceMultiProofResponse)(nil), "band.base.oracle.v1.FeedsPriceMultiProofResponse")
+	proto.RegisterType((*TunnelPacketProofRequest)(nil), "band.base.oracle.v1.TunnelPacketProofRequest")
+	proto.RegisterType((*TunnelPacketProofResponse)(nil), "band.base.oracle.v1.TunnelPacketProofResponse")
+	proto.RegisterType((*SingleProofResult)(nil), "band.base.oracle.v1.SingleProofResult")
+	proto.RegisterType((*MultiProofResult)(nil), "band.base.oracle.v1.MultiProofResult")
+	proto.RegisterType((*CountProofResult)(nil), "band.base.oracle.v1.CountProofResult")
+	proto.RegisterType((*FeedsPriceProofResult)(nil), "band.base.oracle.v1.FeedsPriceProofResult")
+	proto.RegisterType((*FeedsPriceMultiProofResult)(nil), "band.base.oracle.v1.FeedsPriceMultiProofResult")
+	proto.RegisterType((*TunnelPacketProofResult)(nil), "band.base.oracle.v1.TunnelPacketProofResult")
+	proto.RegisterType((*SingleProof)(nil), "band.base.oracle.v1.SingleProof")
+	proto.RegisterType((*MultiProof)(nil), "band.base.oracle.v1.MultiProof")
+	proto.RegisterType((*CountProof)(nil), "band.base.oracle.v1.CountProof")
+	proto.RegisterType((*FeedsPriceProof)(nil), "band.base.oracle.v1.FeedsPriceProof")
+	proto.RegisterType((*FeedsPriceMultiProof)(nil), "band.base.oracle.v1.FeedsPriceMultiProof")
+	proto.RegisterType((*TunnelPacketProof)(nil), "band.base.oracle.v1.TunnelPacketProof")
+	proto.RegisterType((*PriceDataProof)(nil), "band.base.oracle.v1.PriceDataProof")
+	proto.RegisterType((*PacketDataProof)(nil), "band.base.oracle.v1.PacketDataProof")
+	proto.RegisterType((*OracleDataProof)(nil), "band.base.oracle.v1.OracleDataProof")
+	proto.RegisterType((*IAVLMerklePath)(nil), "band.base.oracle.v1.IAVLMerklePath")
+	proto.RegisterType((*BlockRelayProof)(nil), "band.base.oracle.v1.BlockRelayProof")
+	proto.RegisterType((*StoreBlockRelayProof)(nil), "band.base.oracle.v1.StoreBlockRelayProof")
+	proto.RegisterType((*MultiStoreMerkleProof)(nil), "band.base.oracle.v1.MultiStoreMerkleProof")
+	proto.RegisterType((*MultiStoreMerklePath)(nil), "band.base.oracle.v1.MultiStoreMerklePath")
+	proto.RegisterType((*MultiStoreProof)(nil), "band.base.oracle.v1.MultiStoreProof")
+	proto.RegisterType((*BlockHeaderMerkleParts)(nil), "band.base.oracle.v1.BlockHeaderMerkleParts")
+	proto.RegisterType((*CommonEncodedVotePart)(nil), "band.base.oracle.v1.CommonEncodedVotePart")
+	proto.RegisterType((*TMSignature)(nil), "band.base.oracle.v1.TMSignature")
+	proto.RegisterType((*RequestsCountProof)(nil), "band.base.oracle.v1.RequestsCountProof")
 }
 
-func (m *CountProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
-	}
-	l = m.CountProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+func init() { proto.RegisterFile("band/base/oracle/v1/proof.proto", fileDescriptor_9deb752797273b09) }
+
+var fileDescriptor_9deb752797273b09 = []byte{
+	// 2153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0x1b, 0x59,
+	0x19, 0xef, 0x8b, 0xdd, 0xb4, 0xfd, 0x9c, 0xc4, 0xc9, 0xcb, 0x3f, 0x27, 0x6d, 0xe3, 0xc4, 0x6c,
+	0x9b, 0xb4, 0xdd, 0xc6, 0x9b, 0xb6, 0x1c, 0x2a, 0x38, 0xd0, 0x74, 0xbb, 0x4a, 0xd8, 0xcd, 0x36,
+	0xb2, 0x4d, 0x90, 0x00, 0x31, 0x8c, 0x67, 0x5e, 0xec, 0x21, 0xe3, 0x79, 0xee, 0xbc, 0x67, 0xab,
+	0xdd, 0xaa, 0x2a, 0x02, 0x2d, 0x2b, 0x71, 0x01, 0xc1, 0x05, 0x09, 0x71, 0x41, 0x70, 0x5c, 0x55,
+	0x48, 0x08, 0x90, 0x38, 0x71, 0xdb, 0xe3, 0x4a, 0x1c, 0xe0, 0x14, 0x56, 0x29, 0xe2, 0x00, 0x77,
+	0x90, 0x38, 0xa1, 0xf7, 0x67, 0xec, 0x19, 0x7b, 0x1c, 0xbb, 0x75, 0x9b, 0xd3, 0xde, 0x3c, 0x6f,
+	0xbe, 0xf7, 0xfb, 0x7e, 0xbf, 0xf7, 0xbd, 0xef, 0x9b, 0xf7, 0xc7, 0x90, 0x2d, 0x9b, 0x9e, 0x9d,
+	0x2f, 0x9b, 0x8c, 0xe4, 0xa9, 0x6f, 0x5a, 0x2e, 0xc9, 0x37, 0x37, 0xf2, 0x75, 0x9f, 0xd2, 0xfd,
+	0xf5, 0xba, 0x4f, 0x39, 0xc5, 0xd3, 0xc2, 0x60, 0x5d, 0x18, 0xac, 0x2b, 0x83, 0xf5, 0xe6, 0xc6,
+	0xe2, 0x85, 0x0a, 0xa5, 0x15, 0x97, 0xe4, 0xcd, 0xba, 0x93, 0x37, 0x3d, 0x8f, 0x72, 0x93, 0x3b,
+	0xd4, 0x63, 0xaa, 0xcb, 0xe2, 0x4c, 0x85, 0x56, 0xa8, 0xfc, 0x99, 0x17, 0xbf, 0x74, 0xeb, 0x79,
+	0xe9, 0xa9, 0xed, 0x44, 0xa3, 0xa9, 0x97, 0x4b, 0xf2, 0xe5, 0x3e, 0x21, 0x36, 0xcb, 0x37, 0x37,
+	0xca, 0x84, 0x9b, 0x1b, 0xea, 0x49, 0xbf, 0x5f, 0x96, 0xef, 0x79, 0xc3, 0xf3, 0x88, 0xdb, 0x32,
+	0x50, 0x8f, 0xca, 0x22, 0x77, 0x0f, 0xc6, 0x76, 0x05, 0xed, 0x02, 0x79, 0xd0, 0x20, 0x8c, 0xe3,
+	0x8b, 0x00, 0xbe, 0xfa, 0x69, 0x38, 0x76, 0x06, 0x2d, 0xa3, 0xb5, 0x64, 0xe1, 0x9c, 0x6e, 0xd9,
+	0xb6, 0xf1, 0x1c, 0x8c, 0x56, 0x89, 0x53, 0xa9, 0xf2, 0xcc, 0xc8, 0x32, 0x5a, 0x4b, 0x14, 0xf4,
+	0x53, 0xae, 0x06, 0xe3, 0x1a, 0x86, 0xd5, 0xa9, 0xc7, 0x48, 0xc8, 0x10, 0x85, 0x0d, 0xf1, 0xdb,
+	0x30, 0xea, 0x13, 0xd6, 0x70, 0x15, 0x40, 0xea, 0xc6, 0xe5, 0xf5, 0x98, 0x81, 0x5a, 0x2f, 0x3a,
+	0x5e, 0xc5, 0x25, 0x01, 0x62, 0xc3, 0xe5, 0x9b, 0xc9, 0x4f, 0x0e, 0xb3, 0xa7, 0x0a, 0xba, 0x6f,
+	0xee, 0x16, 0x4c, 0xed, 0x34, 0x5c, 0xee, 0x44, 0xa8, 0x67, 0x21, 0xd5, 0xa6, 0xce, 0x32, 0x68,
+	0x39, 0xb1, 0x96, 0x2c, 0x40, 0x8b, 0x3b, 0xcb, 0x3d, 0x00, 0x1c, 0xee, 0xd5, 0x87, 0xe9, 0xdd,
+	0x0e, 0xa6, 0x97, 0x62, 0x99, 0x46, 0x00, 0xbb, 0x89, 0x2e, 0x42, 0x46, 0xd3, 0xbb, 0x4b, 0x1b,
+	0x1e, 0x0f, 0xf3, 0xcd, 0x3d, 0x84, 0x85, 0x98, 0x77, 0xaf, 0x84, 0x55, 0x04, 0xb0, 0x9b, 0xd5,
+	0x0e, 0xcc, 0xbd, 0x23, 0x66, 0xc9, 0xae, 0xef, 0x58, 0x24, 0x32, 0x86, 0xe7, 0xe1, 0x1c, 0x73,
+	0x2a, 0x9e, 0xe9, 0x06, 0xd1, 0x3f, 0x57, 0x38, 0xab, 0x1a, 0x8e, 0x09, 0xfe, 0x63, 0x98, 0xef,
+	0x82, 0xeb, 0x23, 0x63, 0xab, 0x43, 0xc6, 0xd5, 0x58, 0x19, 0xdd, 0xa8, 0xdd, 0x5a, 0x4a, 0x70,
+	0xbe, 0x6d, 0xd6, 0x3d, 0x29, 0x2e, 0x02, 0xb4, 0x04, 0xa9, 0x39, 0x71, 0xae, 0x70, 0x2e, 0x50,
+	0xc4, 0x7a, 0x4a, 0xfa, 0x10, 0xc1, 0x85, 0x78, 0xd8, 0x3e, 0xc2, 0x76, 0x3a, 0x84, 0xe5, 0xfb,
+	0x08, 0xeb, 0x33, 0x7f, 0x0e, 0x20, 0x53, 0x92, 0xe9, 0xba, 0x6b, 0x5a, 0x07, 0x84, 0x77, 0xc6,
+	0x4a, 0xa5, 0x72, 0x3b, 0x53, 0xcf, 0xaa, 0x86, 0x6d, 0x1b, 0x2f, 0xc2, 0x59, 0x26, 0xec, 0x3c,
+	0x8b, 0x48, 0x26, 0xc9, 0x42, 0xeb, 0x39, 0xc4, 0x3d, 0x11, 0x11, 0xfd, 0x14, 0x16, 0x62, 0x9c,
+	0xf5, 0x11, 0xfc, 0xd5, 0x0e, 0xc1, 0x6f, 0xc6, 0x0a, 0x8e, 0xc3, 0xed, 0x56, 0xfb, 0x0c, 0xc1,
+	0x54, 0x57, 0xea, 0xe3, 0x2f, 0xc3, 0x69, 0x59, 0x59, 0xa5, 0xe3, 0xd4, 0x8d, 0xe5, 0x7e, 0x15,
+	0x43, 0x83, 0xaa, 0x4e, 0xf8, 0x5b, 0x90, 0x26, 0xcd, 0x9a, 0x21, 0x1f, 0x8c, 0xf2, 0x23, 0x4e,
+	0x98, 0x24, 0x3a, 0xb6, 0x79, 0xeb, 0x7f, 0x87, 0xd9, 0xb7, 0x2a, 0x0e, 0xaf, 0x36, 0xca, 0xeb,
+	0x16, 0xad, 0xe5, 0x2d, 0x5a, 0x23, 0xbc, 0xbc, 0xcf, 0xdb, 0x3f, 0x5c, 0xa7, 0xcc, 0xf2, 0xb2,
+	0xcb, 0xfa, 0x16, 0x79, 0xb8, 0x29, 0x7e, 0x14, 0xc6, 0x49, 0xb3, 0xa6, 0xbc, 0x88, 0xc7, 0xdc,
+	0xc7, 0x08, 0x26, 0x3b, 0x43, 0x88, 0xbf, 0x14, 0x25, 0x9c, 0xed, 0x53, 0x38, 0x4e, 0x9a, 0x6f,
+	0x67, 0x71, 0x18, 0x8c, 0x6f, 0xbb, 0xd7, 0x49, 0xf2, 0xfd, 0x03, 0x82, 0xd9, 0xd8, 0x2a, 0x80,
+	0xbf, 0x12, 0x25, 0xfd, 0xc6, 0x20, 0x05, 0xe4, 0x24, 0x99, 0xff, 0x19, 0xc1, 0x62, 0xef, 0x34,
+	0xc7, 0xf7, 0xa2, 0xf4, 0xaf, 0x0c, 0x5c, 0x26, 0x4e, 0x52, 0xc3, 0x9f, 0x10, 0xcc, 0xf7, 0xc8,
+	0x5c, 0xbc, 0x19, 0x15, 0x70, 0x79, 0xb0, 0xb4, 0x3f, 0x49, 0xf6, 0x9f, 0x21, 0x48, 0x85, 0xca,
+	0x02, 0x5e, 0x81, 0xb1, 0xb2, 0x4b, 0xad, 0x03, 0x23, 0x54, 0xc7, 0x92, 0x85, 0x94, 0x6c, 0xdb,
+	0x92, 0x4d, 0x78, 0x0f, 0xa6, 0x14, 0x79, 0xc3, 0x36, 0xb9, 0xa9, 0x88, 0x65, 0x46, 0x8e, 0x99,
+	0x60, 0xf7, 0xe5, 0xaf, 0xb7, 0x4d, 0x6e, 0x86, 0xe5, 0xa5, 0x69, 0xb4, 0x59, 0xe0, 0x2a, 0xd7,
+	0x3e, 0x71, 0xcd, 0x47, 0x1a, 0x37, 0x71, 0x0c, 0xee, 0xa6, 0xb0, 0x2e, 0x08, 0xe3, 0x08, 0x6e,
+	0x39, 0xda, 0x9c, 0xfb, 0x17, 0x02, 0x68, 0x4f, 0x8d, 0x41, 0x14, 0x9a, 0x30, 0x1f, 0x56, 0x58,
+	0x13, 0x9d, 0x5b, 0x3a, 0x13, 0x2f, 0xa8, 0x73, 0xa6, 0xad, 0x33, 0xc4, 0xe2, 0x75, 0x89, 0xfd,
+	0x2b, 0x02, 0x68, 0x57, 0xa1, 0x41, 0xc4, 0xbe, 0x0f, 0x29, 0x4b, 0x74, 0x88, 0x04, 0x72, 0x35,
+	0x96, 0x83, 0xfe, 0xa8, 0xb2, 0xae, 0x32, 0x07, 0x56, 0xdb, 0xe5, 0xeb, 0x52, 0xf6, 0x4f, 0x04,
+	0xe9, 0x8e, 0x52, 0x35, 0x88, 0xbc, 0x22, 0x4c, 0xd6, 0x45, 0x87, 0xee, 0xc9, 0xfa, 0x85, 0x58,
+	0x36, 0x12, 0xbd, 0x33, 0x86, 0x13, 0xf5, 0x48, 0x2b, 0xfe, 0x66, 0x6f, 0x8d, 0xf1, 0x45, 0xaa,
+	0xc8, 0xa9, 0x4f, 0x06, 0x14, 0xfa, 0x1f, 0x04, 0x33, 0x71, 0x45, 0x6d, 0x10, 0xb5, 0xdf, 0x86,
+	0xb9, 0x90, 0xda, 0xee, 0x89, 0xfb, 0x02, 0x9a, 0xa7, 0x5b, 0x9a, 0x43, 0x14, 0x5e, 0xab, 0xf0,
+	0x7f, 0x23, 0x98, 0xea, 0x2a, 0x86, 0x03, 0x56, 0xa4, 0xba, 0xec, 0x31, 0x68, 0x45, 0x52, 0xf8,
+	0x5d, 0x15, 0xa9, 0x1e, 0x6d, 0x7e, 0xbd, 0x6a, 0x9f, 0x21, 0x98, 0x88, 0x0e, 0x3c, 0xfe, 0xa2,
+	0xf8, 0x5c, 0x38, 0x16, 0xd1, 0x9f, 0x8b, 0x05, 0xe5, 0x43, 0xed, 0x55, 0xf5, 0xc6, 0x54, 0xc5,
+	0xaa, 0xfd, 0x85, 0x70, 0x2c, 0x82, 0x33, 0x70, 0xa6, 0x49, 0x7c, 0xe6, 0x50, 0x4f, 0xaf, 0x62,
+	0x83, 0x47, 0xfc, 0x1e, 0x8c, 0xd5, 0x88, 0x7f, 0xe0, 0x12, 0xa3, 0x6e, 0xf2, 0x2a, 0xcb, 0x24,
+	0x8e, 0x99, 0x04, 0xdb, 0x77, 0xf6, 0xde, 0xdb, 0x91, 0xc6, 0xbb, 0x26, 0xaf, 0x6a, 0x0f, 0xa9,
+	0x5a, 0xab, 0x85, 0xe5, 0x7e, 0x87, 0x20, 0xdd, 0x31, 0x72, 0xf8, 0x36, 0x8c, 0xaa, 0x51, 0xd3,
+	0x9c, 0xcf, 0x2b, 0x6c, 0xbd, 0x7d, 0x6e, 0x91, 0x96, 0x26, 0xc1, 0x42, 0x56, 0x75, 0x38, 0x31,
+	0xda, 0xcf, 0x10, 0xa4, 0x3b, 0x4a, 0x33, 0xbe, 0xd5, 0x5a, 0x90, 0x2b, 0xda, 0x73, 0x0a, 0x3b,
+	0x5c, 0xea, 0xba, 0x97, 0xde, 0x27, 0xc6, 0xf8, 0xc3, 0x11, 0x98, 0x88, 0x5a, 0xe1, 0x55, 0x98,
+	0x74, 0x98, 0x9a, 0xde, 0xd4, 0x33, 0xfc, 0x56, 0x26, 0x9c, 0x2d, 0x8c, 0x3b, 0x4c, 0xe8, 0xba,
+	0xef, 0x15, 0x64, 0x2e, 0x5c, 0x82, 0x09, 0xd6, 0x28, 0x73, 0x9f, 0x10, 0x23, 0xb4, 0x69, 0x1b,
+	0x2f, 0x8c, 0xeb, 0x56, 0x9d, 0x32, 0x2b, 0x30, 0x16, 0x98, 0x31, 0xe7, 0x03, 0x22, 0x67, 0x75,
+	0xb2, 0x90, 0xd2, 0x6d, 0x45, 0xe7, 0x03, 0x82, 0x57, 0x21, 0x1d, 0x98, 0x04, 0xaa, 0x93, 0xd2,
+	0x2a, 0x70, 0xb0, 0xa7, 0xc5, 0x7f, 0x1d, 0xc6, 0x98, 0x53, 0x76, 0x1d, 0xaf, 0x62, 0x54, 0x4d,
+	0x56, 0xcd, 0x9c, 0x1e, 0x62, 0x79, 0x92, 0xd2, 0x48, 0x5b, 0x26, 0xab, 0xe6, 0x7e, 0x94, 0x80,
+	0x74, 0x47, 0x36, 0x89, 0x5c, 0x57, 0x65, 0x8d, 0x89, 0x5c, 0x33, 0xfa, 0x2f, 0x6f, 0x65, 0xf5,
+	0x92, 0x89, 0x19, 0x49, 0xc7, 0x5a, 0xb4, 0x19, 0xbb, 0xb0, 0x10, 0x94, 0x19, 0xd3, 0x26, 0xbe,
+	0xd1, 0x0a, 0xa7, 0xcf, 0x99, 0xae, 0x25, 0xd7, 0x7a, 0x7f, 0xbe, 0xb6, 0x64, 0xa7, 0x20, 0x5e,
+	0x3e, 0x67, 0xda, 0xcd, 0x5c, 0x39, 0xf6, 0x2d, 0x76, 0x20, 0x63, 0xd1, 0x5a, 0x8d, 0x7a, 0x06,
+	0xf1, 0x2c, 0x6a, 0x13, 0xdb, 0x68, 0x52, 0xae, 0xbc, 0x65, 0x12, 0xc7, 0x6c, 0xf6, 0xef, 0xca,
+	0x4e, 0xf7, 0x54, 0x9f, 0x3d, 0xca, 0x25, 0x9c, 0xf6, 0x35, 0x6b, 0xc5, 0xbd, 0xc4, 0xef, 0xe8,
+	0xcd, 0x3d, 0x6f, 0xf8, 0x84, 0x65, 0x92, 0xcb, 0x89, 0x9e, 0xdb, 0xc3, 0xd2, 0x4e, 0x31, 0x30,
+	0x0c, 0xbe, 0xeb, 0xed, 0x9e, 0xb9, 0x5f, 0x25, 0x60, 0x26, 0xae, 0xbe, 0x09, 0x2d, 0xe1, 0x88,
+	0x04, 0x03, 0x17, 0x0a, 0xcc, 0xd5, 0x3e, 0x81, 0xd1, 0x23, 0x13, 0x0a, 0xcf, 0x6c, 0x2d, 0xee,
+	0xe5, 0xe7, 0x41, 0xea, 0x17, 0xa4, 0xff, 0x22, 0x98, 0x8d, 0x1d, 0x57, 0x79, 0x68, 0x25, 0xe3,
+	0x73, 0x40, 0x1e, 0xb5, 0x0e, 0xad, 0x44, 0xc3, 0xbb, 0xe4, 0x11, 0xae, 0x41, 0xda, 0x31, 0x9b,
+	0xae, 0xc1, 0xb8, 0xc9, 0x89, 0x4a, 0x62, 0xb5, 0xc7, 0xb8, 0x77, 0x74, 0x98, 0x1d, 0x17, 0xa5,
+	0xa8, 0x28, 0xde, 0x88, 0xa4, 0x7c, 0xb9, 0x4d, 0x87, 0x40, 0x6f, 0x41, 0xe0, 0x42, 0x6c, 0xb5,
+	0xbc, 0x32, 0xd8, 0x2c, 0xe9, 0x51, 0x33, 0x7f, 0x8e, 0x60, 0x26, 0xce, 0x76, 0xf0, 0xca, 0xd9,
+	0x59, 0xc6, 0x46, 0x5e, 0x55, 0x19, 0xfb, 0xe3, 0x28, 0xa4, 0x3b, 0xaa, 0x10, 0xfe, 0x1e, 0x82,
+	0x39, 0xbd, 0xc7, 0xe8, 0x1c, 0x79, 0x24, 0xfd, 0xbe, 0x7b, 0x74, 0x98, 0x9d, 0x56, 0x9f, 0xad,
+	0xe1, 0xc7, 0x7f, 0x5a, 0xb9, 0xda, 0x8e, 0x44, 0xc1, 0x81, 0xb9, 0x9a, 0xe3, 0xf1, 0x68, 0xda,
+	0x0e, 0xad, 0x7c, 0x5a, 0x60, 0x86, 0x62, 0x20, 0x5d, 0xfd, 0x00, 0xc1, 0x1b, 0x75, 0xd3, 0x37,
+	0x6b, 0xcc, 0xe0, 0xd4, 0xf0, 0x09, 0xe3, 0xe6, 0x01, 0x51, 0x8e, 0x59, 0xc4, 0x73, 0x62, 0x08,
+	0xcf, 0x59, 0xe5, 0xa1, 0x44, 0x0b, 0x0a, 0x5f, 0x92, 0x60, 0x21, 0x16, 0x3f, 0x46, 0x70, 0xd5,
+	0xa7, 0xae, 0x88, 0x0b, 0x23, 0xc4, 0x16, 0x54, 0xb8, 0x6f, 0x7a, 0x6c, 0x9f, 0xf8, 0x71, 0x5c,
+	0x92, 0x43, 0x70, 0xb9, 0x14, 0xf2, 0x53, 0xa2, 0x25, 0xed, 0xa5, 0x8b, 0xd1, 0x2f, 0x11, 0xac,
+	0x70, 0x26, 0x07, 0xa5, 0x51, 0xaf, 0xf8, 0xa6, 0x1d, 0x3b, 0x28, 0xea, 0x7b, 0x5a, 0x3a, 0x3a,
+	0xcc, 0x5e, 0x28, 0x15, 0x8b, 0x25, 0xfa, 0x35, 0x65, 0xda, 0x89, 0xf6, 0x52, 0x44, 0x2f, 0x70,
+	0xc6, 0x7a, 0x22, 0xe2, 0xa7, 0x90, 0x33, 0x1b, 0xbc, 0x2a, 0xf8, 0x39, 0x96, 0x59, 0xa5, 0x8c,
+	0xc7, 0xf1, 0x1b, 0x1d, 0x62, 0xa0, 0x2e, 0x0a, 0xfc, 0x12, 0xdd, 0x56, 0xe8, 0x9d, 0x04, 0x72,
+	0xbf, 0x3f, 0x0d, 0x73, 0xf1, 0xb5, 0x1b, 0x7b, 0xb0, 0xa0, 0x97, 0x25, 0x86, 0xe9, 0xd9, 0x86,
+	0x55, 0x35, 0x1d, 0xcf, 0x70, 0xec, 0x70, 0x0e, 0xbd, 0x1c, 0xa5, 0x59, 0x0d, 0x7b, 0xc7, 0xb3,
+	0xef, 0x0a, 0xd0, 0x6d, 0x5b, 0x8e, 0x45, 0xf4, 0x14, 0x3c, 0xd9, 0x3a, 0xdb, 0xcd, 0x42, 0x8a,
+	0x3b, 0x35, 0x62, 0x30, 0x62, 0x51, 0xcf, 0xd6, 0x0b, 0x29, 0x10, 0x4d, 0x45, 0xd9, 0x82, 0xd7,
+	0x60, 0x52, 0x1a, 0x78, 0xa6, 0x47, 0x03, 0xab, 0xa4, 0x5c, 0x93, 0x4d, 0x88, 0xf6, 0xf7, 0x4d,
+	0x8f, 0x6a, 0xcb, 0xef, 0xc2, 0xbc, 0x6b, 0x32, 0x6e, 0xa8, 0x6f, 0x9c, 0x63, 0x4b, 0x61, 0x94,
+	0x57, 0x89, 0x3f, 0xd4, 0x9a, 0x6a, 0x5a, 0x80, 0xca, 0x51, 0xdc, 0xb6, 0xef, 0x78, 0xf6, 0x7d,
+	0x01, 0x88, 0x3f, 0x42, 0x70, 0xd9, 0x23, 0x0f, 0xb9, 0xd1, 0x34, 0x5d, 0xc7, 0x36, 0x39, 0xf5,
+	0xe5, 0xc8, 0xa9, 0xb1, 0xa4, 0x1e, 0x23, 0x1e, 0x6b, 0xb0, 0xe1, 0xe3, 0xbb, 0x22, 0x7c, 0xec,
+	0x05, 0x2e, 0xc4, 0x40, 0x8a, 0x61, 0x0d, 0xf0, 0xe5, 0xc0, 0x7e, 0x07, 0xa6, 0xa4, 0x6a, 0xb5,
+	0xc8, 0xd6, 0x3e, 0xcf, 0x0c, 0xe1, 0x33, 0x2d, 0xe0, 0xd4, 0x02, 0x5e, 0x79, 0x78, 0x00, 0x8b,
+	0xa4, 0xe9, 0xd8, 0xc4, 0xb3, 0x88, 0xd4, 0x57, 0xf7, 0x69, 0x9d, 0x32, 0xa2, 0x14, 0x67, 0xce,
+	0x0e, 0xe1, 0x6a, 0x3e, 0xc0, 0xbd, 0xe3, 0xd9, 0xbb, 0x1a, 0x55, 0x4e, 0xdc, 0xbf, 0x23, 0x98,
+	0x8d, 0x5d, 0x07, 0xe0, 0x32, 0x60, 0xf1, 0xc1, 0x26, 0x76, 0xb0, 0x59, 0x25, 0xfb, 0xce, 0xc3,
+	0xa1, 0x26, 0xec, 0xa4, 0xc2, 0x53, 0xbb, 0x1b, 0x81, 0xd6, 0xe9, 0x83, 0x35, 0xf6, 0x85, 0x8f,
+	0x91, 0x57, 0xe3, 0xa3, 0x28, 0xd1, 0x72, 0x3f, 0x1c, 0x81, 0x54, 0x68, 0x31, 0x82, 0x37, 0x01,
+	0xf9, 0x43, 0xc9, 0x40, 0xbe, 0xc0, 0x18, 0xee, 0x74, 0x13, 0x31, 0x3c, 0x06, 0xa8, 0x29, 0xb3,
+	0x70, 0xbc, 0x80, 0x9a, 0xd8, 0x84, 0xa9, 0x60, 0xf1, 0x26, 0x92, 0x8d, 0x71, 0xb3, 0x56, 0x1f,
+	0xaa, 0xb2, 0x4f, 0x6a, 0xb8, 0x52, 0x80, 0x96, 0xfb, 0x29, 0x02, 0xdc, 0x7d, 0x32, 0x86, 0x67,
+	0xe0, 0xb4, 0x3c, 0x15, 0xd3, 0x07, 0x16, 0xea, 0xe1, 0xa4, 0xb6, 0x90, 0x37, 0x7e, 0x7b, 0x06,
+	0xce, 0x14, 0x89, 0xdf, 0x14, 0xe7, 0x03, 0x4f, 0xe1, 0xb4, 0x3e, 0x4a, 0xe9, 0x71, 0xfa, 0xd3,
+	0xbe, 0x2f, 0x5b, 0xcc, 0x1d, 0x67, 0xa2, 0x6e, 0xb9, 0x72, 0xf9, 0xef, 0xff, 0xe5, 0x1f, 0x3f,
+	0x1b, 0xb9, 0x82, 0x57, 0xf3, 0xc2, 0x56, 0x16, 0xde, 0xf6, 0xa5, 0xbb, 0xba, 0xe0, 0xcf, 0x3f,
+	0x6e, 0xdf, 0x32, 0x3f, 0xc1, 0x1f, 0x45, 0x4f, 0x60, 0x2f, 0xf7, 0xbd, 0x24, 0x56, 0x5c, 0x56,
+	0xfb, 0xda, 0x69, 0x42, 0x6b, 0x92, 0x50, 0x0e, 0x2f, 0xc7, 0x12, 0x0a, 0x9d, 0x82, 0xe1, 0xdf,
+	0x20, 0x98, 0xea, 0xba, 0x4f, 0xc6, 0xd7, 0x8f, 0x3b, 0xed, 0xec, 0xba, 0x93, 0x5e, 0x5c, 0x1f,
+	0xd4, 0x5c, 0xd3, 0xdb, 0x90, 0xf4, 0xae, 0xe1, 0x2b, 0xb1, 0xf4, 0xf4, 0x40, 0x31, 0x23, 0x74,
+	0x0a, 0x8b, 0x7f, 0x11, 0x73, 0xd8, 0x79, 0x6d, 0xb0, 0xeb, 0x5f, 0xc5, 0xf1, 0xcd, 0xc1, 0x8c,
+	0x35, 0xc3, 0xeb, 0x92, 0xe1, 0x2a, 0xbe, 0x14, 0x65, 0xa8, 0xfe, 0x33, 0xa1, 0x03, 0xda, 0xba,
+	0x21, 0x7e, 0x82, 0x7f, 0xdd, 0xeb, 0x84, 0xf2, 0xad, 0x17, 0xb8, 0xc8, 0x55, 0x3c, 0x37, 0x5e,
+	0xa0, 0x87, 0x26, 0xbb, 0x2a, 0xc9, 0xae, 0xe0, 0x6c, 0x1c, 0xd9, 0x70, 0xb0, 0x3f, 0x8e, 0x3d,
+	0x4f, 0xbc, 0x3e, 0xe8, 0xdd, 0xeb, 0x71, 0xc1, 0xee, 0x79, 0x05, 0x9c, 0xbb, 0x2d, 0xd9, 0xdd,
+	0xc4, 0x1b, 0x51, 0x76, 0xfa, 0xef, 0x25, 0x7a, 0x2c, 0x5b, 0x57, 0xd2, 0x4f, 0xf2, 0x8f, 0x83,
+	0x1b, 0xe7, 0x27, 0x9b, 0xc5, 0x4f, 0x8e, 0x96, 0xd0, 0xa7, 0x47, 0x4b, 0xe8, 0xb3, 0xa3, 0x25,
+	0xf4, 0x93, 0xe7, 0x4b, 0xa7, 0x3e, 0x7d, 0xbe, 0x74, 0xea, 0x6f, 0xcf, 0x97, 0x4e, 0x7d, 0xe3,
+	0x76, 0xa8, 0x4c, 0x09, 0x58, 0xf9, 0xb7, 0x14, 0x8b, 0xba, 0x79, 0x8d, 0x7f, 0x33, 0x6f, 0xb9,
+	0x0e, 0xf1, 0x78, 0xbe, 0xe2, 0xd7, 0xad, 0x48, 0x22, 0x96, 0x47, 0xa5, 0xed, 0xcd, 0xff, 0x0f,
+	0x00, 0x8b, 0xca, 0x9f, 0xe2, 0x8d, 0x23, 0x00, 0x00,
 }
 
-func (m *OracleDataProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
-	}
-	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
-		}
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Proof queries the proof for given request ID
+	Proof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error)
+	// MultiProof queries multiple proofs for given list of request IDs
+	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
+	// RequestCountProof queries the count proof
+	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// FeedsPriceProof queries the proof for the price of given signal ID
+	FeedsPriceProof(ctx context.Context, in *FeedsPriceProofRequest, opts ...grpc.CallOption) (*FeedsPriceProofResponse, error)
+	// FeedsPriceMultiProof queries the proof for the prices of given list of signal IDs
+	FeedsPriceMultiProof(ctx context.Context, in *FeedsPriceMultiProofRequest, opts ...grpc.CallOption) (*FeedsPriceMultiProofResponse, error)
+	// TunnelPacketProof queries the proof for the packet of given tunnel ID and sequence
+	TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error)
 }
 
-func (m *IAVLMerklePath) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsDataOnRight {
-		n += 2
-	}
-	if m.SubtreeHeight != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeHeight))
-	}
-	if m.SubtreeSize != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeSize))
-	}
-	if m.SubtreeVersion != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeVersion))
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Proof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error) {
+	out := new(ProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/Proof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SiblingHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error) {
+	out := new(MultiProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/MultiProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BlockRelayProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error) {
+	out := new(RequestCountProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/RequestCountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MultiStoreProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockHeaderMerkleParts.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.CommonEncodedVotePart.Size()
-	n += 1 + l + sovProof(uint64(l))
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *serviceClient) FeedsPriceProof(ctx context.Context, in *FeedsPriceProofRequest, opts ...grpc.CallOption) (*FeedsPriceProofResponse, error) {
+	out := new(FeedsPriceProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/FeedsPriceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MultiStoreProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) FeedsPriceMultiProof(ctx context.Context, in *FeedsPriceMultiProofRequest, opts ...grpc.CallOption) (*FeedsPriceMultiProofResponse, error) {
+	out := new(FeedsPriceMultiProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/FeedsPriceMultiProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OracleIAVLStateHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.MintStoreMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error) {
+	out := new(TunnelPacketProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/TunnelPacketProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ParamsToRestakeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Proof queries the proof for given request ID
+	Proof(context.Context, *ProofRequest) (*ProofResponse, error)
+	// MultiProof queries multiple proofs for given list of request IDs
+	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
+	// RequestCountProof queries the count proof
+	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// FeedsPriceProof queries the proof for the price of given signal ID
+	FeedsPriceProof(context.Context, *FeedsPriceProofRequest) (*FeedsPriceProofResponse, error)
+	// FeedsPriceMultiProof queries the proof for the prices of given list of signal IDs
+	FeedsPriceMultiProof(context.Context, *FeedsPriceMultiProofRequest) (*FeedsPriceMultiProofResponse, error)
+	// TunnelPacketProof queries the proof for the packet of given tunnel ID and sequence
+	TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Proof(ctx context.Context, req *ProofRequest) (*ProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proof not implemented")
+}
+func (*UnimplementedServiceServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*MultiProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiProof not implemented")
+}
+func (*UnimplementedServiceServer) RequestCountProof(ctx context.Context, req *RequestCountProofRequest) (*RequestCountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
+}
+func (*UnimplementedServiceServer) FeedsPriceProof(ctx context.Context, req *FeedsPriceProofRequest) (*FeedsPriceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedsPriceProof not implemented")
+}
+func (*UnimplementedServiceServer) FeedsPriceMultiProof(ctx context.Context, req *FeedsPriceMultiProofRequest) (*FeedsPriceMultiProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedsPriceMultiProof not implemented")
+}
+func (*UnimplementedServiceServer) TunnelPacketProof(ctx context.Context, req *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelPacketProof not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Proof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.RollingseedToTransferStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).Proof(ctx, in)
 	}
-	l = len(m.TSSToUpgradeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/Proof",
 	}
-	l = len(m.AuthToIcahostStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Proof(ctx, req.(*ProofRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockHeaderMerkleParts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VersionAndChainIdHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+func _Service_MultiProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(ServiceServer).MultiProof(ctx, in)
 	}
-	if m.TimeSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeSecond))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/MultiProof",
 	}
-	if m.TimeNanoSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).MultiProof(ctx, req.(*MultiProofRequest))
 	}
-	l = len(m.LastBlockIdAndOther)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NextValidatorHashAndConsensusHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).RequestCountProof(ctx, in)
 	}
-	l = len(m.LastResultsHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/RequestCountProof",
 	}
-	l = len(m.EvidenceAndProposerHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RequestCountProof(ctx, req.(*RequestCountProofRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommonEncodedVotePart) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_FeedsPriceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedsPriceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SignedDataPrefix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).FeedsPriceProof(ctx, in)
 	}
-	l = len(m.SignedDataSuffix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/FeedsPriceProof",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FeedsPriceProof(ctx, req.(*FeedsPriceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TMSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_FeedsPriceMultiProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedsPriceMultiProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).FeedsPriceMultiProof(ctx, in)
 	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/FeedsPriceMultiProof",
 	}
-	if m.V != 0 {
-		n += 1 + sovProof(uint64(m.V))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FeedsPriceMultiProof(ctx, req.(*FeedsPriceMultiProofRequest))
 	}
-	l = len(m.EncodedTimestamp)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TunnelPacketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelPacketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/TunnelPacketProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, req.(*TunnelPacketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestsCountProof) Size() (n int) {
-	if m == nil {
-		return 0
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.base.oracle.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Proof",
+			Handler:    _Service_Proof_Handler,
+		},
+		{
+			MethodName: "MultiProof",
+			Handler:    _Service_MultiProof_Handler,
+		},
+		{
+			MethodName: "RequestCountProof",
+			Handler:    _Service_RequestCountProof_Handler,
+		},
+		{
+			MethodName: "FeedsPriceProof",
+			Handler:    _Service_FeedsPriceProof_Handler,
+		},
+		{
+			MethodName: "FeedsPriceMultiProof",
+			Handler:    _Service_FeedsPriceMultiProof_Handler,
+		},
+		{
+			MethodName: "TunnelPacketProof",
+			Handler:    _Service_TunnelPacketProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/base/oracle/v1/proof.proto",
+}
+
+func (m *ProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovProof(uint64(m.Count))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
+	if m.RequestId != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		dAtA3 := make([]byte, len(m.RequestIds)*10)
+		var j2 int
+		for _, num := range m.RequestIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintProof(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCountProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCountProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCountProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCountProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCountProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceMultiProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceMultiProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceMultiProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceMultiProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceMultiProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceMultiProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TunnelPacketProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelPacketProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelPacketProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TunnelPacketProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelPacketProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelPacketProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceMultiProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceMultiProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceMultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TunnelPacketProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelPacketProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelPacketProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SingleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleDataMultiProof) > 0 {
+		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PriceDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedsPriceMultiProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedsPriceMultiProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedsPriceMultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PriceDataMultiProof) > 0 {
+		for iNdEx := len(m.PriceDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TunnelPacketProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelPacketProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelPacketProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PacketDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SubtreeVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SubtreeSize != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubtreeHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreBlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreBlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreBlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MultiStoreMerkleProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStoreMerkleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreMerkleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreMerkleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IAVLStateHash) > 0 {
+		i -= len(m.IAVLStateHash)
+		copy(dAtA[i:], m.IAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.IAVLStateHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStoreMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
+		i -= len(m.AuthToIcahostStoresMerkleHash)
+		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TSSToUpgradeStoresMerkleHash) > 0 {
+		i -= len(m.TSSToUpgradeStoresMerkleHash)
+		copy(dAtA[i:], m.TSSToUpgradeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.TSSToUpgradeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
+		i -= len(m.RollingseedToTransferStoresMerkleHash)
+		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
+		i -= len(m.ParamsToRestakeStoresMerkleHash)
+		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MintStoreMerkleHash) > 0 {
+		i -= len(m.MintStoreMerkleHash)
+		copy(dAtA[i:], m.MintStoreMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OracleIAVLStateHash) > 0 {
+		i -= len(m.OracleIAVLStateHash)
+		copy(dAtA[i:], m.OracleIAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceAndProposerHash) > 0 {
+		i -= len(m.EvidenceAndProposerHash)
+		copy(dAtA[i:], m.EvidenceAndProposerHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LastResultsHash) > 0 {
+		i -= len(m.LastResultsHash)
+		copy(dAtA[i:], m.LastResultsHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextValidatorHashAndConsensusHash) > 0 {
+		i -= len(m.NextValidatorHashAndConsensusHash)
+		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastBlockIdAndOther) > 0 {
+		i -= len(m.LastBlockIdAndOther)
+		copy(dAtA[i:], m.LastBlockIdAndOther)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeNanoSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VersionAndChainIdHash) > 0 {
+		i -= len(m.VersionAndChainIdHash)
+		copy(dAtA[i:], m.VersionAndChainIdHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignedDataSuffix) > 0 {
+		i -= len(m.SignedDataSuffix)
+		copy(dAtA[i:], m.SignedDataSuffix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignedDataPrefix) > 0 {
+		i -= len(m.SignedDataPrefix)
+		copy(dAtA[i:], m.SignedDataPrefix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TMSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncodedTimestamp) > 0 {
+		i -= len(m.EncodedTimestamp)
+		copy(dAtA[i:], m.EncodedTimestamp)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.V != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovProof(uint64(m.RequestId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovProof(uint64(e))
+		}
+		n += 1 + sovProof(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MultiProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *RequestCountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestCountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *FeedsPriceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FeedsPriceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *FeedsPriceMultiProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FeedsPriceMultiProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *TunnelPacketProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovProof(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProof(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TunnelPacketProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *SingleProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CountProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedsPriceProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedsPriceMultiProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelPacketProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *SingleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.OracleDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	if len(m.OracleDataMultiProof) > 0 {
+		for _, e := range m.OracleDataMultiProof {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *CountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.CountProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *FeedsPriceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.PriceDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *FeedsPriceMultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	if len(m.PriceDataMultiProof) > 0 {
+		for _, e := range m.PriceDataMultiProof {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *TunnelPacketProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.PacketDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IAVLMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	if m.SubtreeHeight != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeHeight))
+	}
+	if m.SubtreeSize != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeSize))
+	}
+	if m.SubtreeVersion != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeVersion))
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MultiStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreBlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MultiStoreMerkleProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStoreMerkleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.IAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStoreMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleIAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.MintStoreMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.ParamsToRestakeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.RollingseedToTransferStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.TSSToUpgradeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.AuthToIcahostStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeaderMerkleParts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersionAndChainIdHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	if m.TimeSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeSecond))
+	}
+	if m.TimeNanoSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+	}
+	l = len(m.LastBlockIdAndOther)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.NextValidatorHashAndConsensusHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.LastResultsHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.EvidenceAndProposerHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonEncodedVotePart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignedDataPrefix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.SignedDataSuffix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *TMSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.V != 0 {
+		n += 1 + sovProof(uint64(m.V))
+	}
+	l = len(m.EncodedTimestamp)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestsCountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovProof(uint64(m.Count))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceMultiProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceMultiProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedsPriceMultiProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsPriceMultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovProof(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProof(x uint64) (n int) {
-	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+func (m *TunnelPacketProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2928,17 +6903,17 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelPacketProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelPacketProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.RequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2948,16 +6923,30 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2967,11 +6956,26 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -2993,7 +6997,7 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3016,17 +7020,17 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3036,14 +7040,14 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3070,7 +7074,40 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3095,7 +7132,7 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,88 +7155,98 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				m.RequestIds = append(m.RequestIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProof
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProof
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
+			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIds) == 0 {
-					m.RequestIds = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProof
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIds = append(m.RequestIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3221,7 +7268,7 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+func (m *CountProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,17 +7291,17 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3264,14 +7311,14 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3298,60 +7345,43 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProof(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProof
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthProof
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProof
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3373,7 +7403,7 @@ func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+func (m *FeedsPriceProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3396,17 +7426,17 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedsPriceProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedsPriceProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3416,14 +7446,14 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3450,7 +7480,40 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3475,7 +7538,7 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+func (m *FeedsPriceMultiProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3498,15 +7561,34 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedsPriceMultiProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedsPriceMultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDataMultiProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3533,15 +7615,16 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceDataMultiProof = append(m.PriceDataMultiProof, PriceDataProof{})
+			if err := m.PriceDataMultiProof[len(m.PriceDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3551,24 +7634,23 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3592,7 +7674,7 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+func (m *TunnelPacketProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,15 +7697,34 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelPacketProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelPacketProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3650,15 +7751,15 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3668,24 +7769,23 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3709,7 +7809,7 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+func (m *PriceDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,15 +7832,15 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3767,15 +7867,34 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3785,24 +7904,24 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3826,7 +7945,7 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProof) Unmarshal(dAtA []byte) error {
+func (m *PacketDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,34 +7968,15 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,13 +8003,32 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3936,7 +8055,8 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3961,7 +8081,7 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProof) Unmarshal(dAtA []byte) error {
+func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3984,34 +8104,15 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4038,14 +8139,32 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
-			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4072,7 +8191,8 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4097,7 +8217,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProof) Unmarshal(dAtA []byte) error {
+func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4120,17 +8240,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
 			}
-			m.BlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4140,16 +8260,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsDataOnRight = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
 			}
-			var msglen int
+			m.SubtreeHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4159,30 +8280,54 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubtreeHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
+			m.SubtreeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
 			}
-			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubtreeVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4192,23 +8337,24 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4232,7 +8378,7 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
+func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4255,15 +8401,48 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4290,15 +8469,15 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4308,14 +8487,28 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4342,8 +8535,8 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
-			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signatures = append(m.Signatures, TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4368,7 +8561,7 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
+func (m *StoreBlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,17 +8584,17 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreBlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreBlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreMerkleProof", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4411,17 +8604,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDataOnRight = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiStoreMerkleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
 			}
-			m.SubtreeHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4431,16 +8637,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeHeight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
 			}
-			m.SubtreeSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4450,35 +8670,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProof
 			}
-			m.SubtreeVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubtreeVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4488,24 +8703,24 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.SiblingHash == nil {
-				m.SiblingHash = []byte{}
+			m.Signatures = append(m.Signatures, TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4529,7 +8744,7 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
+func (m *MultiStoreMerkleProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4552,17 +8767,17 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStoreMerkleProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStoreMerkleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4572,30 +8787,29 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLStateHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4605,28 +8819,29 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IAVLStateHash = append(m.IAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IAVLStateHash == nil {
+				m.IAVLStateHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4653,15 +8868,86 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, MultiStoreMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStoreMerklePath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDataOnRight = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4671,24 +8957,24 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, TMSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2,13 +2,19 @@ package proof
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func hexToBytes(hexstr string) []byte {
@@ -211,3 +217,138 @@ func TestEncodeVerifyCount(t *testing.T) {
 	)
 	require.Equal(t, expect, result)
 }
+
+func TestEncodeRelayStore(t *testing.T) {
+	block := StoreBlockRelayProof{
+		MultiStoreMerkleProof: MultiStoreMerkleProof{
+			StoreKey:      "feeds",
+			IAVLStateHash: hexToBytes("5248463E932D16F7D092E268C0DED87B23D3B0E71856F1C6AE2AA91F6C713320"),
+			MerklePaths: []MultiStoreMerklePath{
+				{
+					IsDataOnRight: true,
+					SiblingHash:   hexToBytes("7FD5F5C7C2920C187618542901CDC5717BE8204F24BE856E80902A1BB04737E4"),
+				},
+				{
+					IsDataOnRight: false,
+					SiblingHash:   hexToBytes("F981716562A49DE06E3DCAFBFB6388C294BAA4FA9D45777E25740A92F81CF65E"),
+				},
+			},
+		},
+		BlockHeaderMerkleParts: BlockHeaderMerkleParts{
+			VersionAndChainIdHash: hexToBytes("3F02642D9E70D5C1C493A4F732BFE9C9B95A4A42651703B816EDCFC8FADA5312"),
+			Height:                25000,
+			TimeSecond:            1629849931,
+			TimeNanoSecond:        290650376,
+		},
+		CommonEncodedVotePart: CommonEncodedVotePart{
+			SignedDataPrefix: hexToBytes("080211A861000000000000"),
+			SignedDataSuffix: hexToBytes("12240801"),
+		},
+	}
+
+	result, err := block.encodeToEthData()
+	require.Nil(t, err)
+
+	values, err := relayStoreArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Len(t, values, 4)
+
+	multiStore := *abi.ConvertType(values[0], new(MultiStoreMerkleProofEthereum)).(*MultiStoreMerkleProofEthereum)
+	require.Equal(t, block.MultiStoreMerkleProof.encodeToEthFormat(), multiStore)
+	require.Equal(t, "feeds", multiStore.StoreKey)
+	require.True(t, multiStore.MerklePaths[0].IsDataOnRight)
+	require.False(t, multiStore.MerklePaths[1].IsDataOnRight)
+
+	merkleParts := *abi.ConvertType(values[1], new(BlockHeaderMerklePartsEthereum)).(*BlockHeaderMerklePartsEthereum)
+	require.Equal(t, block.BlockHeaderMerkleParts.encodeToEthFormat(), merkleParts)
+}
+
+func TestEncodeVerifyPrice(t *testing.T) {
+	data := PriceDataProof{
+		Version: 217,
+		Price: feedstypes.Price{
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BTC-USD",
+			Price:     60000000000000,
+			Timestamp: 1629803667,
+		},
+		MerklePaths: []IAVLMerklePath{
+			{
+				IsDataOnRight:  true,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 217,
+				SiblingHash:    hexToBytes("EB739BB22F48B7F3053A90BA2BA4FE07FAB262CADF8664489565C50FF505B8BD"),
+			},
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+
+	values, err := verifyPriceArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Len(t, values, 4)
+	require.Equal(t, big.NewInt(25000), values[0])
+	require.Equal(t, big.NewInt(217), values[2])
+
+	price := *abi.ConvertType(values[1], new(PriceEthereum)).(*PriceEthereum)
+	require.Equal(t, PriceEthereum{
+		SignalID:  "CS:BTC-USD",
+		Status:    uint8(feedstypes.PRICE_STATUS_AVAILABLE),
+		Price:     60000000000000,
+		Timestamp: 1629803667,
+	}, price)
+
+	paths := *abi.ConvertType(values[3], new([]IAVLMerklePathEthereum)).(*[]IAVLMerklePathEthereum)
+	require.Equal(t, []IAVLMerklePathEthereum{data.MerklePaths[0].encodeToEthFormat()}, paths)
+}
+
+func TestEncodeVerifyPacket(t *testing.T) {
+	receipt, err := codectypes.NewAnyWithValue(&tunneltypes.TSSPacketReceipt{SigningID: 3})
+	require.Nil(t, err)
+
+	data := PacketDataProof{
+		Version: 217,
+		Packet: tunneltypes.Packet{
+			TunnelID: 1,
+			Sequence: 2,
+			Prices: []feedstypes.Price{
+				{
+					Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+					SignalID:  "CS:BTC-USD",
+					Price:     60000000000000,
+					Timestamp: 1629803667,
+				},
+				{
+					Status:    feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS,
+					SignalID:  "CS:ETH-USD",
+					Price:     0,
+					Timestamp: 1629803667,
+				},
+			},
+			Receipt:   receipt,
+			CreatedAt: 1629803670,
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+
+	values, err := verifyPacketArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Len(t, values, 4)
+	require.Equal(t, big.NewInt(25000), values[0])
+	require.Equal(t, big.NewInt(217), values[2])
+
+	packet := *abi.ConvertType(values[1], new(PacketEthereum)).(*PacketEthereum)
+	require.Equal(t, uint64(1), packet.TunnelID)
+	require.Equal(t, uint64(2), packet.Sequence)
+	require.Equal(t, []PriceEthereum{
+		transformPrice(data.Packet.Prices[0]),
+		transformPrice(data.Packet.Prices[1]),
+	}, packet.Prices)
+	require.Equal(t, "/band.tunnel.v1beta1.TSSPacketReceipt", packet.ReceiptTypeURL)
+	require.Equal(t, receipt.Value, packet.ReceiptValue)
+	require.Equal(t, int64(1629803670), packet.CreatedAt)
+}
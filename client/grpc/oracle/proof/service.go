@@ -10,13 +10,17 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	ics23 "github.com/cosmos/ics23/go"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 // RegisterProofService registers the node gRPC service on the provided gRPC router.
@@ -80,6 +84,7 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 	// Get the proofs for the requested id and height
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.ResultStoreKey(requestID),
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -200,6 +205,7 @@ func (s proofServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*M
 
 		value, iavlEp, multiStoreEp, err := getProofsByKey(
 			cliCtx,
+			types.StoreKey,
 			types.ResultStoreKey(requestID),
 			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 			idx == 0,
@@ -294,6 +300,7 @@ func (s proofServer) RequestCountProof(
 	// Get the proofs for the count from the IAVL tree
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.RequestCountStoreKey,
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -367,3 +374,284 @@ func (s proofServer) RequestCountProof(
 		},
 	}, nil
 }
+
+// FeedsPriceProof returns a proof of the price of a signal ID from provided signal ID and block height
+func (s proofServer) FeedsPriceProof(
+	ctx context.Context,
+	req *FeedsPriceProofRequest,
+) (*FeedsPriceProofResponse, error) {
+	res, err := s.FeedsPriceMultiProof(ctx, &FeedsPriceMultiProofRequest{
+		SignalIds: []string{req.SignalId},
+		Height:    req.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	proof := res.Result.Proof
+	blockRelayBytes, err := proof.BlockRelayProof.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	priceDataBytes, err := proof.PriceDataMultiProof[0].encodeToEthData(proof.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the encoded block relay bytes and price data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, priceDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a FeedsPriceProofResponse object with the relevant information
+	return &FeedsPriceProofResponse{
+		Height: res.Height,
+		Result: FeedsPriceProofResult{
+			Proof: FeedsPriceProof{
+				BlockHeight:     proof.BlockHeight,
+				PriceDataProof:  proof.PriceDataMultiProof[0],
+				BlockRelayProof: proof.BlockRelayProof,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// FeedsPriceMultiProof returns a proof of the prices of multiple signal IDs from provided signal IDs and
+// block height
+func (s proofServer) FeedsPriceMultiProof(
+	ctx context.Context,
+	req *FeedsPriceMultiProofRequest,
+) (*FeedsPriceMultiProofResponse, error) {
+	// If there are no signal IDs, return an error
+	if len(req.SignalIds) == 0 {
+		return nil, fmt.Errorf("please provide signal ids")
+	}
+
+	cliCtx, commit, err := s.getCanonicalCommit(req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockRelay := StoreBlockRelayProof{}
+	priceDataBytesList := make([][]byte, len(req.SignalIds))
+	priceDataList := make([]PriceDataProof, len(req.SignalIds))
+
+	// Loop through each signal ID and get the relevant proofs
+	for idx, signalID := range req.SignalIds {
+		value, iavlEp, multiStoreEp, err := getProofsByKey(
+			cliCtx,
+			feedstypes.StoreKey,
+			feedstypes.PriceStoreKey(signalID),
+			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+			idx == 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, fmt.Errorf("price of signal id %s not found", signalID)
+		}
+
+		// Unmarshal the value into a Price object
+		var price feedstypes.Price
+		if err := price.Unmarshal(value); err != nil {
+			return nil, err
+		}
+
+		// Create a PriceDataProof object with the relevant information
+		priceData := PriceDataProof{
+			Price:       price,
+			Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+			MerklePaths: GetMerklePaths(iavlEp),
+		}
+		// Encode the price data proof into Ethereum-compatible format
+		priceDataBytes, err := priceData.encodeToEthData(uint64(commit.Height))
+		if err != nil {
+			return nil, err
+		}
+		priceDataBytesList[idx] = priceDataBytes
+		priceDataList[idx] = priceData
+
+		// If this is the first iteration, create the blockRelay object with the multiStoreProof
+		if idx == 0 {
+			blockRelay, err = getStoreBlockRelayProof(commit, multiStoreEp)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Encode the block relay proof
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for MultiProofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes[]"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Pack the encoded block relay bytes and price data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, priceDataBytesList)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a FeedsPriceMultiProofResponse object with the relevant information
+	return &FeedsPriceMultiProofResponse{
+		Height: cliCtx.Height,
+		Result: FeedsPriceMultiProofResult{
+			Proof: FeedsPriceMultiProof{
+				BlockHeight:         uint64(commit.Height),
+				PriceDataMultiProof: priceDataList,
+				BlockRelayProof:     blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// TunnelPacketProof returns a proof of a tunnel packet from provided tunnel ID, sequence and block height
+func (s proofServer) TunnelPacketProof(
+	ctx context.Context,
+	req *TunnelPacketProofRequest,
+) (*TunnelPacketProofResponse, error) {
+	cliCtx, commit, err := s.getCanonicalCommit(req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proofs for the requested packet and height
+	value, iavlEp, multiStoreEp, err := getProofsByKey(
+		cliCtx,
+		tunneltypes.StoreKey,
+		tunneltypes.TunnelPacketStoreKey(req.TunnelId, req.Sequence),
+		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("packet of tunnel id %d and sequence %d not found", req.TunnelId, req.Sequence)
+	}
+
+	blockRelay, err := getStoreBlockRelayProof(commit, multiStoreEp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the value into a Packet object. The receipt is kept packed as it is encoded as is.
+	var packet tunneltypes.Packet
+	if err := packet.Unmarshal(value); err != nil {
+		return nil, err
+	}
+
+	// Create a PacketDataProof object with the relevant information
+	packetData := PacketDataProof{
+		Packet:      packet,
+		Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+		MerklePaths: GetMerklePaths(iavlEp),
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	packetDataBytes, err := packetData.encodeToEthData(uint64(commit.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the encoded block relay bytes and packet data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, packetDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a TunnelPacketProofResponse object with the relevant information
+	return &TunnelPacketProofResponse{
+		Height: cliCtx.Height,
+		Result: TunnelPacketProofResult{
+			Proof: TunnelPacketProof{
+				BlockHeight:     uint64(commit.Height),
+				PacketDataProof: packetData,
+				BlockRelayProof: blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// getCanonicalCommit returns the client context at the given height and the canonical commit of the height.
+// If the height is 0, the latest block height - 1 is used.
+func (s proofServer) getCanonicalCommit(height int64) (client.Context, *coretypes.ResultCommit, error) {
+	cliCtx := s.clientCtx
+	cliCtx.Height = height
+
+	if cliCtx.Height == 0 {
+		status, err := cliCtx.Client.Status(context.Background())
+		if err != nil {
+			return cliCtx, nil, err
+		}
+		cliCtx.Height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	// If the height is negative, return an error
+	if cliCtx.Height < 0 {
+		return cliCtx, nil, fmt.Errorf("negative height in response")
+	}
+
+	commit, err := cliCtx.Client.Commit(context.Background(), &cliCtx.Height)
+	if err != nil {
+		return cliCtx, nil, err
+	}
+
+	// Check if the commit is canonical, else return an error
+	if !commit.CanonicalCommit {
+		return cliCtx, nil, fmt.Errorf("commit is not canonical")
+	}
+
+	return cliCtx, commit, nil
+}
+
+// getStoreBlockRelayProof returns the store block relay proof of the commit from the multistore existence proof.
+func getStoreBlockRelayProof(
+	commit *coretypes.ResultCommit,
+	multiStoreEp *ics23.ExistenceProof,
+) (StoreBlockRelayProof, error) {
+	// Get the signatures and common vote prefix from the commit header
+	signatures, commonVote, err := GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return StoreBlockRelayProof{}, err
+	}
+
+	return StoreBlockRelayProof{
+		MultiStoreMerkleProof:  GetMultiStoreMerkleProof(multiStoreEp),
+		BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(commit.Header),
+		CommonEncodedVotePart:  commonVote,
+		Signatures:             signatures,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/x/oracle"
+)
+
+const flagDryRun = "dry-run"
+
+// FileCacheCmd returns the filecache cobra Command to maintain the data source and oracle script files of the node.
+func FileCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filecache",
+		Short: "Maintain the data source and oracle script files of the node",
+		Long: strings.TrimSpace(`Maintain the data source and oracle script files of the node.
+
+These commands read the application state from the node's database, so the node must be stopped
+before running them.`),
+	}
+
+	cmd.AddCommand(
+		fileCacheGCCmd(),
+		fileCacheVerifyCmd(),
+	)
+
+	return cmd
+}
+
+func fileCacheGCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Remove files that are not referenced by any oracle script, data source or their versions",
+		Args:  cobra.NoArgs,
+		// Errors are about the files rather than the usage of the command.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+
+			fileCache, referenced, err := loadFileCacheState(cmd)
+			if err != nil {
+				return err
+			}
+
+			filenames, err := fileCache.Filenames()
+			if err != nil {
+				return err
+			}
+
+			removed := 0
+			for _, filename := range filenames {
+				if referenced[filename] {
+					continue
+				}
+
+				if !dryRun {
+					if err := fileCache.RemoveFile(filename); err != nil {
+						return fmt.Errorf("failed to remove file %s: %w", filename, err)
+					}
+				}
+				removed++
+				fmt.Fprintf(cmd.OutOrStdout(), "unreferenced file %s\n", filename)
+			}
+
+			if dryRun {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "dry run: %d of %d files would be removed\n", removed, len(filenames))
+			} else {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "removed %d of %d files\n", removed, len(filenames))
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().Bool(flagDryRun, false, "Print the unreferenced files without removing them")
+
+	return cmd
+}
+
+func fileCacheVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check that all referenced files exist and all files match their content hash",
+		Args:  cobra.NoArgs,
+		// Errors are about the files rather than the usage of the command.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fileCache, referenced, err := loadFileCacheState(cmd)
+			if err != nil {
+				return err
+			}
+
+			filenames, err := fileCache.Filenames()
+			if err != nil {
+				return err
+			}
+
+			onDisk := make(map[string]bool, len(filenames))
+			corrupted, unreferenced := 0, 0
+			for _, filename := range filenames {
+				onDisk[filename] = true
+				if err := fileCache.VerifyFile(filename); err != nil {
+					corrupted++
+					fmt.Fprintf(cmd.OutOrStdout(), "corrupted file %s: %s\n", filename, err)
+				}
+				if !referenced[filename] {
+					unreferenced++
+				}
+			}
+
+			missingFiles := make([]string, 0)
+			for filename := range referenced {
+				if !onDisk[filename] {
+					missingFiles = append(missingFiles, filename)
+				}
+			}
+			sort.Strings(missingFiles)
+			for _, filename := range missingFiles {
+				fmt.Fprintf(cmd.OutOrStdout(), "missing file %s\n", filename)
+			}
+
+			fmt.Fprintf(
+				cmd.OutOrStdout(),
+				"verified %d files: %d corrupted, %d missing, %d unreferenced\n",
+				len(filenames), corrupted, len(missingFiles), unreferenced,
+			)
+
+			if corrupted > 0 || len(missingFiles) > 0 {
+				return fmt.Errorf("found %d corrupted and %d missing files", corrupted, len(missingFiles))
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// loadFileCacheState loads the application from the node's database and returns its file cache together
+// with the set of files referenced by the latest committed state.
+func loadFileCacheState(cmd *cobra.Command) (filecache.Cache, map[string]bool, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	home := serverCtx.Config.RootDir
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+	if err != nil {
+		return filecache.Cache{}, nil, err
+	}
+	defer db.Close()
+
+	bandApp := band.NewBandApp(
+		log.NewNopLogger(),
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		serverCtx.Viper,
+		cast.ToUint32(serverCtx.Viper.Get(oracle.FlagWithOwasmCacheSize)),
+	)
+	if bandApp.LastBlockHeight() == 0 {
+		return filecache.Cache{}, nil, fmt.Errorf("no committed state found in %s", home)
+	}
+
+	ctx := bandApp.NewUncachedContext(false, cmtproto.Header{Height: bandApp.LastBlockHeight()})
+	return bandApp.OracleKeeper.FileCache(), bandApp.OracleKeeper.GetReferencedFilenames(ctx), nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+const flagSchema = "schema"
+
+// ObiCmd returns the obi cobra Command to convert between OBI bytes and JSON with a schema.
+func ObiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "obi",
+		Short: "Encode and decode OBI data with a schema",
+		Long: strings.TrimSpace(`Encode and decode OBI data with a compact OBI schema, e.g. the input or
+the output part of the schema of an oracle script.
+
+Integers are JSON numbers or decimal strings and bytes are hex strings.`),
+	}
+
+	cmd.AddCommand(
+		obiEncodeCmd(),
+		obiDecodeCmd(),
+	)
+
+	return cmd
+}
+
+func obiEncodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "encode [json]",
+		Short:   "Encode a JSON value into hex-encoded OBI bytes",
+		Example: `bandd obi encode '{"symbols":["BTC"],"multiplier":100}' --schema '{symbols:[string],multiplier:u64}'`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			typ, err := getObiType(cmd)
+			if err != nil {
+				return err
+			}
+
+			encoded, err := typ.EncodeJSON([]byte(args[0]))
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(encoded))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagSchema, "", "The compact OBI schema of the value")
+	_ = cmd.MarkFlagRequired(flagSchema)
+
+	return cmd
+}
+
+func obiDecodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decode [hex]",
+		Short:   "Decode hex-encoded OBI bytes into a JSON value",
+		Example: `bandd obi decode 0000000100000003425443 --schema '[string]'`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			typ, err := getObiType(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid hex data: %w", err)
+			}
+
+			decoded, err := typ.DecodeJSON(data)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(decoded))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagSchema, "", "The compact OBI schema of the value")
+	_ = cmd.MarkFlagRequired(flagSchema)
+
+	return cmd
+}
+
+func getObiType(cmd *cobra.Command) (*obi.Type, error) {
+	schema, err := cmd.Flags().GetString(flagSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	return obi.ParseSchema(schema)
+}
@@ -216,6 +216,8 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(basicManager),
 		keys.Commands(),
+		ObiCmd(),
+		FileCacheCmd(),
 	)
 
 	// add rosetta
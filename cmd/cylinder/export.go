@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 
@@ -14,7 +15,8 @@ import (
 )
 
 const (
-	flagOutput = "output"
+	flagOutput    = "output"
+	flagPlaintext = "plaintext"
 )
 
 // exportCmd returns a Cobra command for exporting data from store.
@@ -75,8 +77,8 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 				}
 			}
 
-			// marshal data of groups to json
-			bytes, err := json.Marshal(groups)
+			// marshal data of groups to an encrypted archive
+			bytes, err := encodeExport(ctx, cmd, groups)
 			if err != nil {
 				return err
 			}
@@ -99,6 +101,7 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data as a plaintext json file instead of an encrypted archive")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
@@ -147,8 +150,8 @@ func exportDKGsCmd(ctx *context.Context) *cobra.Command {
 				}
 			}
 
-			// marshal data of dkgs to json
-			bytes, err := json.Marshal(dkgs)
+			// marshal data of dkgs to an encrypted archive
+			bytes, err := encodeExport(ctx, cmd, dkgs)
 			if err != nil {
 				return err
 			}
@@ -171,6 +174,7 @@ func exportDKGsCmd(ctx *context.Context) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data as a plaintext json file instead of an encrypted archive")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
@@ -201,8 +205,8 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
-			// marshal data of DEs to json
-			bytes, err := json.Marshal(des)
+			// marshal data of DEs to an encrypted archive
+			bytes, err := encodeExport(ctx, cmd, des)
 			if err != nil {
 				return err
 			}
@@ -225,8 +229,38 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data as a plaintext json file instead of an encrypted archive")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
 	return cmd
 }
+
+// encodeExport marshals the data to json and seals it into an encrypted archive with the secret
+// of the store, unless the plaintext flag is set.
+func encodeExport(ctx *context.Context, cmd *cobra.Command, data any) ([]byte, error) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := cmd.Flags().GetBool(flagPlaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	if plaintext {
+		return bytes, nil
+	}
+
+	secret, err := ctx.Secret()
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("%w; or use --%s to export plaintext data", store.ErrNoSecret, flagPlaintext)
+	}
+
+	return store.SealArchive(*secret, bytes)
+}
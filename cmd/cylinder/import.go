@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 
@@ -30,7 +31,7 @@ func importCmd(ctx *context.Context) *cobra.Command {
 // importGroupsCmd returns a Cobra command for importing groups data
 func importGroupsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "groups [path_to_json_file]",
+		Use:   "groups [path_to_archive_file]",
 		Short: "Import groups data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -40,21 +41,21 @@ func importGroupsCmd(ctx *context.Context) *cobra.Command {
 			}
 
 			// open the file
-			jsonFile, err := os.Open(args[0])
+			file, err := os.Open(args[0])
 			if err != nil {
 				return err
 			}
-			defer jsonFile.Close()
+			defer file.Close()
 
 			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
+			bytes, err := io.ReadAll(file)
 			if err != nil {
 				return err
 			}
 
-			// unmarshal json to data
+			// unmarshal archive to data
 			var groups []store.Group
-			err = json.Unmarshal(bytes, &groups)
+			err = decodeImport(ctx, cmd, bytes, &groups)
 			if err != nil {
 				return err
 			}
@@ -70,13 +71,15 @@ func importGroupsCmd(ctx *context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagPlaintext, false, "Import data from a plaintext json file instead of an encrypted archive")
+
 	return cmd
 }
 
 // importDKGsCmd returns a Cobra command for importing dkgs data
 func importDKGsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "dkgs [path_to_json_file]",
+		Use:   "dkgs [path_to_archive_file]",
 		Short: "Import DKGs data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -86,21 +89,21 @@ func importDKGsCmd(ctx *context.Context) *cobra.Command {
 			}
 
 			// open the file
-			jsonFile, err := os.Open(args[0])
+			file, err := os.Open(args[0])
 			if err != nil {
 				return err
 			}
-			defer jsonFile.Close()
+			defer file.Close()
 
 			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
+			bytes, err := io.ReadAll(file)
 			if err != nil {
 				return err
 			}
 
-			// unmarshal json to data
+			// unmarshal archive to data
 			var dkgs []store.DKG
-			err = json.Unmarshal(bytes, &dkgs)
+			err = decodeImport(ctx, cmd, bytes, &dkgs)
 			if err != nil {
 				return err
 			}
@@ -117,13 +120,15 @@ func importDKGsCmd(ctx *context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagPlaintext, false, "Import data from a plaintext json file instead of an encrypted archive")
+
 	return cmd
 }
 
 // importDEsCmd returns a Cobra command for importing des data
 func importDEsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "des [path_to_json_file]",
+		Use:   "des [path_to_archive_file]",
 		Short: "Import DEs data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -133,22 +138,22 @@ func importDEsCmd(ctx *context.Context) *cobra.Command {
 			}
 
 			// open the file
-			jsonFile, err := os.Open(args[0])
+			file, err := os.Open(args[0])
 			if err != nil {
 				return err
 			}
-			defer jsonFile.Close()
+			defer file.Close()
 
 			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
+			bytes, err := io.ReadAll(file)
 			if err != nil {
 				return err
 			}
 
-			// unmarshal json to data
+			// unmarshal archive to data
 			var des []store.DE
-			if err := json.Unmarshal(bytes, &des); err != nil {
-				panic(err)
+			if err := decodeImport(ctx, cmd, bytes, &des); err != nil {
+				return err
 			}
 
 			// loop to set each de to store
@@ -163,5 +168,34 @@ func importDEsCmd(ctx *context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagPlaintext, false, "Import data from a plaintext json file instead of an encrypted archive")
+
 	return cmd
 }
+
+// decodeImport opens the encrypted archive with the secret of the store and unmarshals its data,
+// unless the plaintext flag is set.
+func decodeImport(ctx *context.Context, cmd *cobra.Command, bytes []byte, data any) error {
+	plaintext, err := cmd.Flags().GetBool(flagPlaintext)
+	if err != nil {
+		return err
+	}
+
+	if !plaintext {
+		secret, err := ctx.Secret()
+		if err != nil {
+			return err
+		}
+
+		if secret == nil {
+			return fmt.Errorf("%w; or use --%s to import plaintext data", store.ErrNoSecret, flagPlaintext)
+		}
+
+		bytes, err = store.OpenArchive(*secret, bytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(bytes, data)
+}
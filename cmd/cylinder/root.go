@@ -75,6 +75,8 @@ func NewRootCmd(ctx *context.Context) *cobra.Command {
 		statusCmd(ctx),
 		importCmd(ctx),
 		exportCmd(ctx),
+		storeCmd(ctx),
+		signerCmd(ctx),
 		runCmd(ctx),
 		removeUnusedDECmd(ctx),
 		version.NewVersionCommand(),
@@ -12,6 +12,9 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/de"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/group"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/sender"
@@ -32,7 +35,13 @@ const (
 	flagRandomSecret        = "random-secret"
 	flagCheckDEInterval     = "check-de-interval"
 	flagCheckStatusInterval = "check-status-interval"
+	flagAutoActivate        = "auto-activate"
 	flagMetricsListenAddr   = "metrics-listen-addr"
+	flagKeyFile             = "key-file"
+	flagSignerAddr          = "signer-addr"
+	flagSignerTokenFile     = "signer-token-file"
+	flagSignerCAFile        = "signer-ca-file"
+	flagSignerTimeout       = "signer-timeout"
 )
 
 // runCmd returns a Cobra command to run the cylinder process.
@@ -43,7 +52,7 @@ func runCmd(ctx *context.Context) *cobra.Command {
 		Short:   "Run the cylinder process",
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			ctx, err = ctx.WithGoLevelDB()
+			ctx, err = withSigner(ctx)
 			if err != nil {
 				return err
 			}
@@ -83,22 +92,14 @@ func runCmd(ctx *context.Context) *cobra.Command {
 					return fmt.Errorf("failed to start metrics server: %w", err)
 				}
 
-				// Add metrics from import data
-				groups, err := ctx.Store.GetAllGroups()
+				// Add metrics from the secrets kept by the signer
+				status, err := ctx.Signer.Status(&signertypes.StatusRequest{})
 				if err != nil {
 					return err
 				}
-				metrics.AddGroupCount(float64(len(groups)))
-				dkgs, err := ctx.Store.GetAllDKGs()
-				if err != nil {
-					return err
-				}
-				metrics.AddDKGLeftGauge(float64(len(dkgs)))
-				des, err := ctx.Store.GetAllDEs()
-				if err != nil {
-					return err
-				}
-				metrics.AddOffChainDELeftGauge(float64(len(des)))
+				metrics.AddGroupCount(float64(status.GroupCount))
+				metrics.AddDKGLeftGauge(float64(status.DKGCount))
+				metrics.AddOffChainDELeftGauge(float64(status.DECount))
 			}
 
 			return cylinder.Run(ctx, workers)
@@ -106,7 +107,7 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	}
 
 	cmd.Flags().String(flags.FlagChainID, "", "chain ID of BandChain network")
-	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to BandChain node")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "comma-separated RPC urls to BandChain nodes")
 	cmd.Flags().String(flagGranter, "", "granter address")
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for a transaction")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
@@ -120,13 +121,24 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().BytesHex(flagRandomSecret, nil, "The secret value that is used for random D,E")
 	cmd.Flags().Duration(flagCheckDEInterval, time.Minute, "The interval of checking DE")
 	cmd.Flags().Duration(flagCheckStatusInterval, time.Minute, "The interval of checking the status of the member")
+	cmd.Flags().Bool(
+		flagAutoActivate,
+		false,
+		"Activate the member automatically once the inactive penalty has elapsed (requires the MsgActivate grant)",
+	)
 	cmd.Flags().String(flagMetricsListenAddr, "", "address to use for metrics server.")
+	cmd.Flags().String(flagKeyFile, "", "path to the key file used to encrypt the store")
+	cmd.Flags().String(flagSignerAddr, "", "address of the remote signer (unix:///path or tcp://host:port); empty to sign in-process")
+	cmd.Flags().String(flagSignerTokenFile, "", "path to the file of the token to authenticate with the remote signer")
+	cmd.Flags().String(flagSignerCAFile, "", "path to the CA certificate of the remote signer; empty to disable TLS")
+	cmd.Flags().Duration(flagSignerTimeout, 10*time.Second, "The timeout of a request to the remote signer")
 
 	flagNames := []string{
 		flags.FlagChainID, flags.FlagNode, flagGranter, flags.FlagGasPrices, flagLogLevel,
 		flagMaxMessages, flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry, flagMinDE,
 		flagGasAdjustStart, flagGasAdjustStep, flagRandomSecret, flagCheckDEInterval,
-		flagCheckStatusInterval, flagMetricsListenAddr,
+		flagCheckStatusInterval, flagAutoActivate, flagMetricsListenAddr, flagKeyFile,
+		flagSignerAddr, flagSignerTokenFile, flagSignerCAFile, flagSignerTimeout,
 	}
 
 	for _, flagName := range flagNames {
@@ -137,3 +149,56 @@ func runCmd(ctx *context.Context) *cobra.Command {
 
 	return cmd
 }
+
+// withSigner sets the signer of the context. It connects to the remote signer if the signer address
+// is configured; otherwise, it opens the local store and signs in-process.
+func withSigner(ctx *context.Context) (*context.Context, error) {
+	if ctx.Config.SignerAddr != "" {
+		token, err := signer.ReadToken(ctx.Config.SignerTokenFile)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Signer, err = signer.NewRemoteSigner(
+			ctx.Config.SignerAddr,
+			token,
+			ctx.Config.SignerCAFile,
+			ctx.Config.SignerTimeout,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Logger.Info(":lock: Using the remote signer at %s", ctx.Config.SignerAddr)
+		return ctx, nil
+	}
+
+	ctx, err := openStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Signer = signer.NewLocalSigner(ctx.Store, ctx.Config.RandomSecret)
+	return ctx, nil
+}
+
+// openStore opens the local store and warns if the store is not fully encrypted.
+func openStore(ctx *context.Context) (*context.Context, error) {
+	ctx, err := ctx.WithGoLevelDB()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ctx.Store.IsEncrypted() {
+		ctx.Logger.Warn(
+			":warning: The store is not encrypted; set $%s or the key-file config and run `cylinder store migrate`",
+			store.EnvPassphrase,
+		)
+	} else if count, err := ctx.Store.CountPlaintextValues(); err != nil {
+		return nil, err
+	} else if count > 0 {
+		ctx.Logger.Warn(":warning: The store has %d plaintext values; run `cylinder store migrate`", count)
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+)
+
+const (
+	flagListen          = "listen"
+	flagTokenFile       = "token-file"
+	flagTLSCert         = "tls-cert"
+	flagTLSKey          = "tls-key"
+	defaultSignerSocket = "signer.sock"
+)
+
+// signerCmd returns a Cobra command to run the cylinder signer that keeps the secrets of the member
+// and serves the tss operations to the cylinder process over an authenticated gRPC API.
+func signerCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Run the cylinder signer that holds the secrets of the member",
+		Long: "Run the cylinder signer that holds the secrets of the member. The cylinder process connects to " +
+			"the signer with the signer-addr and signer-token-file configurations.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// the flags are not bound to the config to not override the ones of the run command.
+			listenAddr, err := cmd.Flags().GetString(flagListen)
+			if err != nil {
+				return err
+			}
+			if listenAddr == "" {
+				listenAddr = "unix://" + filepath.Join(ctx.Home, defaultSignerSocket)
+			}
+
+			tokenFile, err := cmd.Flags().GetString(flagTokenFile)
+			if err != nil {
+				return err
+			}
+			if tokenFile == "" {
+				tokenFile = ctx.Config.SignerTokenFile
+			}
+
+			token, err := signer.ReadToken(tokenFile)
+			if err != nil {
+				return err
+			}
+
+			certFile, err := cmd.Flags().GetString(flagTLSCert)
+			if err != nil {
+				return err
+			}
+
+			keyFile, err := cmd.Flags().GetString(flagTLSKey)
+			if err != nil {
+				return err
+			}
+
+			var tlsConfig *tls.Config
+			if certFile != "" || keyFile != "" {
+				cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+				if err != nil {
+					return fmt.Errorf("failed to load TLS certificate: %w", err)
+				}
+
+				tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+			}
+
+			ctx, err = openStore(ctx)
+			if err != nil {
+				return err
+			}
+
+			server, err := signer.NewServer(
+				signer.NewLocalSigner(ctx.Store, ctx.Config.RandomSecret),
+				token,
+				tlsConfig,
+			)
+			if err != nil {
+				return err
+			}
+
+			listener, err := signer.Listen(listenAddr)
+			if err != nil {
+				return err
+			}
+
+			if tlsConfig == nil && listener.Addr().Network() == "tcp" {
+				ctx.Logger.Warn(":warning: The signer listens on TCP without TLS; the token is sent in plaintext")
+			}
+
+			// stop the server gracefully on interrupt.
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigCh
+				ctx.Logger.Info(":wave: Stopping the signer")
+				server.GracefulStop()
+			}()
+
+			ctx.Logger.Info(":lock: Signer is listening on %s", listenAddr)
+			return server.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagListen, "", "address to listen on (unix:///path or tcp://host:port); default to a unix socket in the home directory")
+	cmd.Flags().String(flagTokenFile, "", "path to the file of the token that the cylinder process must present; default to signer-token-file config")
+	cmd.Flags().String(flagTLSCert, "", "path to the TLS certificate of the signer")
+	cmd.Flags().String(flagTLSKey, "", "path to the TLS private key of the signer")
+
+	return cmd
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+)
+
+const (
+	flagNewKeyFile = "new-key-file"
+
+	// envNewPassphrase is the environment variable that holds the new passphrase for key rotation.
+	envNewPassphrase = "CYLINDER_NEW_PASSPHRASE"
+)
+
+// storeCmd returns a Cobra command for managing the encryption of cylinder's store.
+func storeCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage the encryption of cylinder's store",
+	}
+
+	cmd.AddCommand(
+		migrateStoreCmd(ctx),
+		rotateKeyCmd(ctx),
+	)
+
+	return cmd
+}
+
+// migrateStoreCmd returns a Cobra command for encrypting the existing plaintext store.
+func migrateStoreCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Encrypt all plaintext data in cylinder's store",
+		Long: fmt.Sprintf(
+			"Encrypt all plaintext data in cylinder's store with the key derived from $%s or the key file ($%s or key-file config)",
+			store.EnvPassphrase,
+			store.EnvKeyFile,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			secret, err := ctx.Secret()
+			if err != nil {
+				return err
+			}
+
+			if secret == nil {
+				return store.ErrNoSecret
+			}
+
+			ctx, err = ctx.WithGoLevelDB()
+			if err != nil {
+				return err
+			}
+
+			count, err := ctx.Store.Migrate()
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info(":white_check_mark: Successfully encrypted %d values in the store", count)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// rotateKeyCmd returns a Cobra command for re-encrypting the store with a new key.
+func rotateKeyCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt all data in cylinder's store with a new key",
+		Long: fmt.Sprintf(
+			"Re-encrypt all data in cylinder's store with the key derived from $%s or the --%s flag",
+			envNewPassphrase,
+			flagNewKeyFile,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			newKeyFile, err := cmd.Flags().GetString(flagNewKeyFile)
+			if err != nil {
+				return err
+			}
+
+			var newSecret *store.Secret
+			if passphrase := os.Getenv(envNewPassphrase); passphrase != "" {
+				newSecret = &store.Secret{Passphrase: passphrase}
+			} else if newKeyFile != "" {
+				newSecret, err = store.ReadKeyFile(newKeyFile)
+				if err != nil {
+					return err
+				}
+			} else {
+				return fmt.Errorf("new key is not set; set $%s or --%s", envNewPassphrase, flagNewKeyFile)
+			}
+
+			ctx, err = ctx.WithGoLevelDB()
+			if err != nil {
+				return err
+			}
+
+			if !ctx.Store.IsEncrypted() {
+				return fmt.Errorf("%w; run `cylinder store migrate` first", store.ErrNoSecret)
+			}
+
+			count, err := ctx.Store.RotateKey(*newSecret)
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info(
+				":white_check_mark: Successfully re-encrypted %d values in the store; update the passphrase or the key file before restarting cylinder",
+				count,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNewKeyFile, "", "Path to the new key file")
+
+	return cmd
+}
@@ -7,8 +7,6 @@ import (
 
 	"google.golang.org/grpc"
 
-	rpcclient "github.com/cometbft/cometbft/rpc/client"
-	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,15 +22,16 @@ import (
 
 	cylinderctx "github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/pkg/rpcpool"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
 type Client struct {
-	client    rpcclient.Client // RPC client for communication with the node.
-	context   client.Context   // Context that holds the client's configuration and context.
-	txFactory tx.Factory       // Factory for creating and handling transactions.
+	client    *rpcpool.Pool  // RPC client for communication with the healthiest node.
+	context   client.Context // Context that holds the client's configuration and context.
+	txFactory tx.Factory     // Factory for creating and handling transactions.
 
 	maxTry       uint64        // Maximum number of tries to submit a transaction and query.
 	timeout      time.Duration // Timeout duration for waiting for transaction commits.
@@ -47,13 +46,13 @@ type Client struct {
 func New(cylinderCtx *cylinderctx.Context) (*Client, error) {
 	cfg := cylinderCtx.Config
 
-	// Create a new HTTP client for the specified node URI
-	c, err := httpclient.New(cfg.NodeURI, "/websocket")
+	// Create a new pool of clients for the specified node URIs
+	c, err := rpcpool.New(rpcpool.ParseNodeURIs(cfg.NodeURI), cylinderCtx.Logger)
 	if err != nil {
 		return nil, err
 	}
 
-	// Start the client to establish a connection
+	// Start the clients to establish connections
 	if err = c.Start(); err != nil {
 		return nil, err
 	}
@@ -259,6 +258,19 @@ func (c *Client) QueryTssParams() (*tsstypes.Params, error) {
 	return &res.Params, nil
 }
 
+// QueryBandtssParams queries the current bandtss parameters.
+func (c *Client) QueryBandtssParams() (*bandtsstypes.Params, error) {
+	queryClient := bandtsstypes.NewQueryClient(c.context)
+	input := &bandtsstypes.QueryParamsRequest{}
+
+	res, err := queryWithRetry(queryClient.Params, input, c.maxTry, c.pollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Params, nil
+}
+
 // BroadcastAndConfirm broadcasts and confirms the messages by signing and submitting them using the provided key.
 // It returns the transaction response or an error. It retries broadcasting and confirming up to maxTry times.
 func (c *Client) BroadcastAndConfirm(
@@ -386,7 +398,7 @@ func (c *Client) QueryAccount(key *keyring.Record) (client.Account, error) {
 	return acc, nil
 }
 
-// Stop stops the client by terminating the underlying RPC client connection.
+// Stop stops the client by terminating the underlying RPC client connections.
 // It returns an error if the client cannot be stopped.
 func (c *Client) Stop() error {
 	return c.client.Stop()
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/cylinder/store"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
@@ -23,7 +24,7 @@ import (
 // Config data structure for Cylinder process.
 type Config struct {
 	ChainID             string        `mapstructure:"chain-id"`              // ChainID of the target chain
-	NodeURI             string        `mapstructure:"node"`                  // Remote RPC URI of BandChain node to connect to
+	NodeURI             string        `mapstructure:"node"`                  // Comma-separated remote RPC URIs of BandChain nodes to connect to
 	Granter             string        `mapstructure:"granter"`               // The granter address
 	GasPrices           string        `mapstructure:"gas-prices"`            // Gas prices of the transaction
 	LogLevel            string        `mapstructure:"log-level"`             // Log level of the logger
@@ -31,12 +32,19 @@ type Config struct {
 	BroadcastTimeout    time.Duration `mapstructure:"broadcast-timeout"`     // The time that cylinder will wait for tx commit
 	RPCPollInterval     time.Duration `mapstructure:"rpc-poll-interval"`     // The duration of rpc poll interval
 	MaxTry              uint64        `mapstructure:"max-try"`               // The maximum number of tries to submit a report transaction
+	MinDE               uint64        `mapstructure:"min-de"`                // The minimum number of DE
 	GasAdjustStart      float64       `mapstructure:"gas-adjust-start"`      // The start value of gas adjustment
 	GasAdjustStep       float64       `mapstructure:"gas-adjust-step"`       // The increment step of gas adjustment
 	RandomSecret        tss.Scalar    `mapstructure:"random-secret"`         // The secret value that is used for random D,E
 	CheckDEInterval     time.Duration `mapstructure:"check-de-interval"`     // The interval for updating DE
 	CheckStatusInterval time.Duration `mapstructure:"check-status-interval"` // The interval for checking the status of the member
+	AutoActivate        bool          `mapstructure:"auto-activate"`         // Whether to activate the member automatically after the penalty
 	MetricsListenAddr   string        `mapstructure:"metrics-listen-addr"`   // Address to use for metrics server
+	KeyFile             string        `mapstructure:"key-file"`              // Path to the key file used to encrypt the store
+	SignerAddr          string        `mapstructure:"signer-addr"`           // Address of the remote signer; empty to sign in-process
+	SignerTokenFile     string        `mapstructure:"signer-token-file"`     // Path to the file of the token to authenticate with the remote signer
+	SignerCAFile        string        `mapstructure:"signer-ca-file"`        // Path to the CA certificate of the remote signer; empty to disable TLS
+	SignerTimeout       time.Duration `mapstructure:"signer-timeout"`        // The timeout of a request to the remote signer
 }
 
 // Context holds the context information for the Cylinder process.
@@ -55,6 +63,7 @@ type Context struct {
 
 	DataDir string
 	Store   *store.Store
+	Signer  signertypes.Signer
 }
 
 // NewContext creates a new instance of the Context.
@@ -100,7 +109,8 @@ func (ctx *Context) WithGoLevelDB() (*Context, error) {
 	return ctx.WithDB(db)
 }
 
-// WithDB sets the DB for the context.
+// WithDB sets the DB for the context. The store is encrypted if the passphrase or the key file
+// is configured; otherwise, it fails to open the store that is already encrypted.
 func (ctx *Context) WithDB(db dbm.DB) (*Context, error) {
 	if ctx.Store != nil {
 		if err := ctx.Store.DB.Close(); err != nil {
@@ -108,6 +118,37 @@ func (ctx *Context) WithDB(db dbm.DB) (*Context, error) {
 		}
 	}
 
+	secret, err := ctx.Secret()
+	if err != nil {
+		return nil, err
+	}
+
+	if secret != nil {
+		ctx.Store, err = store.NewEncryptedStore(db, *secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return ctx, nil
+	}
+
+	if _, found, err := store.GetEncryptionInfo(db); err != nil {
+		return nil, err
+	} else if found {
+		return nil, store.ErrNoSecret
+	}
+
 	ctx.Store = store.NewStore(db)
 	return ctx, nil
 }
+
+// Secret loads the secret of the store from the environment variables or the configured key file.
+// It returns nil if no secret is configured.
+func (ctx *Context) Secret() (*store.Secret, error) {
+	keyFile := ""
+	if ctx.Config != nil {
+		keyFile = ctx.Config.KeyFile
+	}
+
+	return store.LoadSecret(keyFile)
+}
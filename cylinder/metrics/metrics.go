@@ -38,7 +38,12 @@ type PrometheusMetrics struct {
 	GroupCount                 prometheus.Counter
 
 	// Member metrics
-	MemberStatusGauge *prometheus.GaugeVec
+	MemberStatusGauge         *prometheus.GaugeVec
+	MemberReactivationTime    *prometheus.GaugeVec
+	ActivationDecisionCount   *prometheus.CounterVec
+	ActivationSuccessCount    *prometheus.CounterVec
+	ActivationFailureCount    *prometheus.CounterVec
+	ActivationBackoffDuration *prometheus.GaugeVec
 
 	// DE metrics
 	OnChinDELeftGauge   prometheus.Gauge
@@ -181,6 +186,41 @@ func SetMemberStatus(groupID uint64, status bool) {
 	})
 }
 
+// SetMemberReactivationTime sets the unix time that the member of the group can be reactivated.
+func SetMemberReactivationTime(groupID uint64, unixTime float64) {
+	updateMetrics(func() {
+		metrics.MemberReactivationTime.WithLabelValues(fmt.Sprintf("%d", groupID)).Set(unixTime)
+	})
+}
+
+// IncActivationDecisionCount increments the count of the given activation decision for a specific group.
+func IncActivationDecisionCount(groupID uint64, decision string) {
+	updateMetrics(func() {
+		metrics.ActivationDecisionCount.WithLabelValues(fmt.Sprintf("%d", groupID), decision).Inc()
+	})
+}
+
+// IncActivationSuccessCount increments the count of successful activations for a specific group.
+func IncActivationSuccessCount(groupID uint64) {
+	updateMetrics(func() {
+		metrics.ActivationSuccessCount.WithLabelValues(fmt.Sprintf("%d", groupID)).Inc()
+	})
+}
+
+// IncActivationFailureCount increments the count of failed activations for a specific group.
+func IncActivationFailureCount(groupID uint64) {
+	updateMetrics(func() {
+		metrics.ActivationFailureCount.WithLabelValues(fmt.Sprintf("%d", groupID)).Inc()
+	})
+}
+
+// SetActivationBackoffDuration sets the current backoff duration of the activation for a specific group.
+func SetActivationBackoffDuration(groupID uint64, duration float64) {
+	updateMetrics(func() {
+		metrics.ActivationBackoffDuration.WithLabelValues(fmt.Sprintf("%d", groupID)).Set(duration)
+	})
+}
+
 // SetOnChainDELeftGauge sets the value of the on-chain DE left gauge.
 func SetOnChainDELeftGauge(value float64) {
 	updateMetrics(func() {
@@ -275,6 +315,7 @@ func ObserveSubmitTxTime(duration float64) {
 func InitPrometheusMetrics(labels prometheus.Labels) {
 	roundLabels := []string{"group_id"}
 	memberLabels := []string{"group_id"}
+	activationDecisionLabels := []string{"group_id", "decision"}
 	signingLabels := []string{"group_id"}
 
 	metrics = &PrometheusMetrics{
@@ -355,6 +396,31 @@ func InitPrometheusMetrics(labels prometheus.Labels) {
 			Help:        "Status of a member",
 			ConstLabels: labels,
 		}, memberLabels),
+		MemberReactivationTime: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "cylinder_member_reactivation_time",
+			Help:        "Unix time that an inactive member can be reactivated",
+			ConstLabels: labels,
+		}, memberLabels),
+		ActivationDecisionCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name:        "cylinder_activation_decision_count",
+			Help:        "Number of activation decisions made for an inactive member",
+			ConstLabels: labels,
+		}, activationDecisionLabels),
+		ActivationSuccessCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name:        "cylinder_activation_success_count",
+			Help:        "Number of successful activations",
+			ConstLabels: labels,
+		}, memberLabels),
+		ActivationFailureCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name:        "cylinder_activation_failure_count",
+			Help:        "Number of activation attempts that did not activate the member",
+			ConstLabels: labels,
+		}, memberLabels),
+		ActivationBackoffDuration: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "cylinder_activation_backoff_duration",
+			Help:        "Duration in seconds to wait before the next activation attempt",
+			ConstLabels: labels,
+		}, memberLabels),
 		OnChinDELeftGauge: promauto.NewGauge(prometheus.GaugeOpts{
 			Name:        "cylinder_on_chain_de_left_gauge",
 			Help:        "Number of on-chain DE left",
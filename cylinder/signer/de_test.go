@@ -1,11 +1,11 @@
-package de_test
+package signer_test
 
 import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
-	"github.com/bandprotocol/chain/v3/cylinder/workers/de"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
@@ -14,7 +14,7 @@ type MockDEGetter struct{}
 func (m *MockDEGetter) HasDE(de types.DE) bool { return false }
 
 func TestGenerateDEs(t *testing.T) {
-	privDEs, err := de.GenerateDEs(10, []byte("secret"), &MockDEGetter{})
+	privDEs, err := signer.GenerateDEs(10, []byte("secret"), &MockDEGetter{})
 	assert.NoError(t, err)
 
 	for _, privDE := range privDEs {
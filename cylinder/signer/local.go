@@ -0,0 +1,259 @@
+package signer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/signer/types"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// LocalSigner is the in-process signer that keeps the secrets in the local store.
+type LocalSigner struct {
+	store        *store.Store
+	randomSecret tss.Scalar
+
+	deMtx sync.Mutex // guards the consumption of DEs in Sign
+}
+
+var _ types.Signer = &LocalSigner{}
+
+// NewLocalSigner creates a new instance of LocalSigner.
+func NewLocalSigner(s *store.Store, randomSecret tss.Scalar) *LocalSigner {
+	return &LocalSigner{
+		store:        s,
+		randomSecret: randomSecret,
+	}
+}
+
+// Round1 generates round 1 information of the group and keeps the DKG secrets in the store.
+func (s *LocalSigner) Round1(req *types.Round1Request) (*types.Round1Response, error) {
+	data, err := tss.GenerateRound1Info(req.MemberID, req.Threshold, req.DKGContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate round1 data: %w", err)
+	}
+
+	err = s.store.SetDKG(store.DKG{
+		GroupID:        req.GroupID,
+		MemberID:       req.MemberID,
+		Coefficients:   data.Coefficients,
+		OneTimePrivKey: data.OneTimePrivKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set DKG: %w", err)
+	}
+
+	return &types.Round1Response{
+		Round1Info: tsstypes.Round1Info{
+			MemberID:           req.MemberID,
+			CoefficientCommits: data.CoefficientCommits,
+			OneTimePubKey:      data.OneTimePubKey,
+			A0Signature:        data.A0Signature,
+			OneTimeSignature:   data.OneTimeSignature,
+		},
+	}, nil
+}
+
+// Round2 computes the encrypted secret shares of the group from the DKG secrets in the store.
+func (s *LocalSigner) Round2(req *types.Round2Request) (*types.Round2Response, error) {
+	dkg, err := s.store.GetDKG(req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	encSecretShares, err := tss.ComputeEncryptedSecretShares(
+		dkg.MemberID,
+		dkg.OneTimePrivKey,
+		req.OneTimePubKeys,
+		dkg.Coefficients,
+		tss.DefaultNonce16Generator{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate encrypted secret shares: %w", err)
+	}
+
+	return &types.Round2Response{
+		Round2Info: tsstypes.Round2Info{
+			MemberID:              dkg.MemberID,
+			EncryptedSecretShares: encSecretShares,
+		},
+	}, nil
+}
+
+// Round3 computes the own private key of the group and keeps it in the store, or returns complaints
+// if any secret share is invalid. It returns the signature of the own public key.
+func (s *LocalSigner) Round3(req *types.Round3Request) (*types.Round3Response, error) {
+	groupRes := &client.GroupResult{GroupResult: req.GroupResult}
+	keyGenerated := false
+
+	group, err := s.store.GetGroup(groupRes.Group.PubKey)
+	if err != nil {
+		dkg, err := s.store.GetDKG(groupRes.Group.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get own private key
+		ownPrivKey, complaints, err := getOwnPrivKey(dkg, groupRes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get own private key or complaints: %w", err)
+		}
+
+		if len(complaints) > 0 {
+			return &types.Round3Response{
+				MemberID:   dkg.MemberID,
+				Complaints: complaints,
+			}, nil
+		}
+
+		group = store.Group{
+			GroupPubKey: groupRes.Group.PubKey,
+			MemberID:    dkg.MemberID,
+			PrivKey:     ownPrivKey,
+		}
+
+		if err := s.store.SetGroup(group); err != nil {
+			return nil, fmt.Errorf("failed to set group: %w", err)
+		}
+
+		if err := s.store.DeleteDKG(groupRes.Group.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete DKG: %w", err)
+		}
+
+		keyGenerated = true
+	}
+
+	// Sign own public key
+	ownPubKeySig, err := tss.SignOwnPubKey(
+		group.MemberID,
+		groupRes.DKGContext,
+		group.PrivKey.Point(),
+		group.PrivKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign own public key: %w", err)
+	}
+
+	return &types.Round3Response{
+		MemberID:     group.MemberID,
+		OwnPubKeySig: ownPubKeySig,
+		KeyGenerated: keyGenerated,
+	}, nil
+}
+
+// GenerateDEs generates new DE pairs and keeps them in the store.
+func (s *LocalSigner) GenerateDEs(req *types.GenerateDEsRequest) (*types.GenerateDEsResponse, error) {
+	privDEs, err := GenerateDEs(req.Count, s.randomSecret, s.store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate new DE pairs: %w", err)
+	}
+
+	pubDEs := make([]tsstypes.DE, 0, len(privDEs))
+	for _, privDE := range privDEs {
+		if err := s.store.SetDE(privDE); err != nil {
+			return nil, fmt.Errorf("failed to set new DE in the store: %w", err)
+		}
+
+		pubDEs = append(pubDEs, privDE.PubDE)
+	}
+
+	return &types.GenerateDEsResponse{DEs: pubDEs}, nil
+}
+
+// DeleteDE deletes the DE pair from the store.
+func (s *LocalSigner) DeleteDE(req *types.DeleteDERequest) (*types.DeleteDEResponse, error) {
+	if err := s.store.DeleteDE(req.DE); err != nil {
+		return nil, err
+	}
+
+	return &types.DeleteDEResponse{}, nil
+}
+
+// Sign computes the partial signature with the group private key and the DE pair in the store.
+// The DE pair is removed from the store before signing, so that it can never sign another message;
+// two partial signatures with the same DE pair would reveal the private key of the member.
+func (s *LocalSigner) Sign(req *types.SignRequest) (*types.SignResponse, error) {
+	group, err := s.store.GetGroup(req.GroupPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privDE, err := s.consumeDE(req.DE)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute own private nonce
+	privNonce, err := tss.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, req.BindingFactor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute own private nonce: %w", err)
+	}
+
+	// Compute lagrange
+	lagrange, err := tss.ComputeLagrangeCoefficient(group.MemberID, req.MemberIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute lagrange coefficient: %w", err)
+	}
+
+	sig, err := tss.SignSigning(
+		req.GroupPubNonce,
+		req.GroupPubKey,
+		req.Message,
+		lagrange,
+		privNonce,
+		group.PrivKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign signing: %w", err)
+	}
+
+	return &types.SignResponse{
+		MemberID:  group.MemberID,
+		Signature: sig,
+	}, nil
+}
+
+// consumeDE retrieves the DE pair from the store and removes it at once. It fails if the DE pair
+// doesn't exist, including when it is already used by another Sign request.
+func (s *LocalSigner) consumeDE(pubDE tsstypes.DE) (store.DE, error) {
+	s.deMtx.Lock()
+	defer s.deMtx.Unlock()
+
+	privDE, err := s.store.GetDE(pubDE)
+	if err != nil {
+		return store.DE{}, err
+	}
+
+	if err := s.store.DeleteDE(pubDE); err != nil {
+		return store.DE{}, fmt.Errorf("failed to consume DE: %w", err)
+	}
+
+	return privDE, nil
+}
+
+// Status returns the number of secrets in the store.
+func (s *LocalSigner) Status(_ *types.StatusRequest) (*types.StatusResponse, error) {
+	groups, err := s.store.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	dkgs, err := s.store.GetAllDKGs()
+	if err != nil {
+		return nil, err
+	}
+
+	des, err := s.store.GetAllDEs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StatusResponse{
+		GroupCount: uint64(len(groups)),
+		DKGCount:   uint64(len(dkgs)),
+		DECount:    uint64(len(des)),
+	}, nil
+}
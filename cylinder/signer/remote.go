@@ -0,0 +1,152 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bandprotocol/chain/v3/cylinder/signer/types"
+)
+
+// RemoteSigner is the signer that forwards the requests to a remote cylinder signer.
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  types.SignerClient
+	timeout time.Duration
+}
+
+var _ types.Signer = &RemoteSigner{}
+
+// NewRemoteSigner creates a new instance of RemoteSigner that connects to the signer at the given
+// address in the form of unix:///path/to/socket or tcp://host:port. The server certificate is
+// verified with the CA in caFile if it is set; otherwise, the connection is not encrypted.
+func NewRemoteSigner(addr string, token string, caFile string, timeout time.Duration) (*RemoteSigner, error) {
+	network, address, err := parseAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	target := address
+	if network == "unix" {
+		target = "unix://" + address
+	}
+
+	transportCreds := insecure.NewCredentials()
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse CA file %s", caFile)
+		}
+
+		transportCreds = credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+	}
+
+	conn, err := grpc.NewClient(
+		target,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithPerRPCCredentials(tokenCredentials{token: token, requireTLS: caFile != ""}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteSigner{
+		conn:    conn,
+		client:  types.NewSignerClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// Close closes the connection to the remote signer.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+// context returns the context of a request with the timeout of the signer.
+func (s *RemoteSigner) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+// Round1 forwards the request to the remote signer.
+func (s *RemoteSigner) Round1(req *types.Round1Request) (*types.Round1Response, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.Round1(ctx, req)
+}
+
+// Round2 forwards the request to the remote signer.
+func (s *RemoteSigner) Round2(req *types.Round2Request) (*types.Round2Response, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.Round2(ctx, req)
+}
+
+// Round3 forwards the request to the remote signer.
+func (s *RemoteSigner) Round3(req *types.Round3Request) (*types.Round3Response, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.Round3(ctx, req)
+}
+
+// GenerateDEs forwards the request to the remote signer.
+func (s *RemoteSigner) GenerateDEs(req *types.GenerateDEsRequest) (*types.GenerateDEsResponse, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.GenerateDEs(ctx, req)
+}
+
+// DeleteDE forwards the request to the remote signer.
+func (s *RemoteSigner) DeleteDE(req *types.DeleteDERequest) (*types.DeleteDEResponse, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.DeleteDE(ctx, req)
+}
+
+// Sign forwards the request to the remote signer.
+func (s *RemoteSigner) Sign(req *types.SignRequest) (*types.SignResponse, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.Sign(ctx, req)
+}
+
+// Status forwards the request to the remote signer.
+func (s *RemoteSigner) Status(req *types.StatusRequest) (*types.StatusResponse, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	return s.client.Status(ctx, req)
+}
+
+// tokenCredentials attaches the token of the signer to every request.
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + c.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,169 @@
+package signer
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bandprotocol/chain/v3/cylinder/signer/types"
+)
+
+const (
+	// authorizationHeader is the metadata key that carries the token of the signer.
+	authorizationHeader = "authorization"
+	// bearerPrefix is the prefix of the token in the authorization header.
+	bearerPrefix = "Bearer "
+)
+
+// Server exposes a signer through the gRPC service.
+type Server struct {
+	signer types.Signer
+}
+
+var _ types.SignerServer = Server{}
+
+// NewServer creates a new gRPC server that serves the given signer. Every request must carry the
+// given token; the connection is secured by TLS if tlsConfig is not nil.
+func NewServer(signer types.Signer, token string, tlsConfig *tls.Config) (*grpc.Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("token of the signer must not be empty")
+	}
+
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(authInterceptor(token))}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(opts...)
+	types.RegisterSignerServer(server, Server{signer: signer})
+
+	return server, nil
+}
+
+// authInterceptor rejects the requests that don't carry the given token.
+func authInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		values := md.Get(authorizationHeader)
+		if len(values) != 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "missing token")
+		}
+
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(values[0], bearerPrefix)), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Listen listens on the given address in the form of unix:///path/to/socket or tcp://host:port.
+// The unix socket is only accessible by the owner.
+func Listen(addr string) (net.Listener, error) {
+	network, address, err := parseAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if network != "unix" {
+		return net.Listen(network, address)
+	}
+
+	// remove the stale socket left by the previous process.
+	if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(address, 0o600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// parseAddress splits the address into the network and the address of the network.
+func parseAddress(addr string) (string, string, error) {
+	switch {
+	case strings.HasPrefix(addr, "unix://"):
+		return "unix", strings.TrimPrefix(addr, "unix://"), nil
+	case strings.HasPrefix(addr, "tcp://"):
+		return "tcp", strings.TrimPrefix(addr, "tcp://"), nil
+	default:
+		return "", "", fmt.Errorf("invalid signer address %q; must start with unix:// or tcp://", addr)
+	}
+}
+
+// ReadToken reads the token of the signer from the given file.
+func ReadToken(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(bz))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// Round1 implements the Signer/Round1 RPC method.
+func (s Server) Round1(_ context.Context, req *types.Round1Request) (*types.Round1Response, error) {
+	return s.signer.Round1(req)
+}
+
+// Round2 implements the Signer/Round2 RPC method.
+func (s Server) Round2(_ context.Context, req *types.Round2Request) (*types.Round2Response, error) {
+	return s.signer.Round2(req)
+}
+
+// Round3 implements the Signer/Round3 RPC method.
+func (s Server) Round3(_ context.Context, req *types.Round3Request) (*types.Round3Response, error) {
+	return s.signer.Round3(req)
+}
+
+// GenerateDEs implements the Signer/GenerateDEs RPC method.
+func (s Server) GenerateDEs(_ context.Context, req *types.GenerateDEsRequest) (*types.GenerateDEsResponse, error) {
+	return s.signer.GenerateDEs(req)
+}
+
+// DeleteDE implements the Signer/DeleteDE RPC method.
+func (s Server) DeleteDE(_ context.Context, req *types.DeleteDERequest) (*types.DeleteDEResponse, error) {
+	return s.signer.DeleteDE(req)
+}
+
+// Sign implements the Signer/Sign RPC method.
+func (s Server) Sign(_ context.Context, req *types.SignRequest) (*types.SignResponse, error) {
+	return s.signer.Sign(req)
+}
+
+// Status implements the Signer/Status RPC method.
+func (s Server) Status(_ context.Context, req *types.StatusRequest) (*types.StatusResponse, error) {
+	return s.signer.Status(req)
+}
@@ -0,0 +1,124 @@
+package signer_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+	"github.com/bandprotocol/chain/v3/cylinder/signer/types"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/testutil"
+)
+
+func startServer(t *testing.T, token string) (string, *store.Store) {
+	s := store.NewStore(dbm.NewMemDB())
+	server, err := signer.NewServer(signer.NewLocalSigner(s, []byte("secret")), token, nil)
+	require.NoError(t, err)
+
+	addr := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := signer.Listen(addr)
+	require.NoError(t, err)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return addr, s
+}
+
+func TestRemoteSigner(t *testing.T) {
+	addr, s := startServer(t, "token")
+
+	remote, err := signer.NewRemoteSigner(addr, "token", "", 5*time.Second)
+	require.NoError(t, err)
+	defer remote.Close()
+
+	// generate DEs; the private values are kept by the signer.
+	res, err := remote.GenerateDEs(&types.GenerateDEsRequest{Count: 3})
+	require.NoError(t, err)
+	require.Len(t, res.DEs, 3)
+	for _, de := range res.DEs {
+		require.True(t, s.HasDE(de))
+	}
+
+	_, err = remote.DeleteDE(&types.DeleteDERequest{DE: res.DEs[0]})
+	require.NoError(t, err)
+	require.False(t, s.HasDE(res.DEs[0]))
+
+	// round 1 keeps the DKG secrets.
+	round1Res, err := remote.Round1(&types.Round1Request{
+		GroupID:    1,
+		MemberID:   1,
+		Threshold:  2,
+		DKGContext: []byte("dkg-context"),
+	})
+	require.NoError(t, err)
+	require.Len(t, round1Res.Round1Info.CoefficientCommits, 2)
+
+	dkg, err := s.GetDKG(1)
+	require.NoError(t, err)
+	require.Equal(t, dkg.OneTimePrivKey.Point(), round1Res.Round1Info.OneTimePubKey)
+
+	statusRes, err := remote.Status(&types.StatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.StatusResponse{GroupCount: 0, DKGCount: 1, DECount: 2}, statusRes)
+}
+
+func TestRemoteSignerInvalidToken(t *testing.T) {
+	addr, _ := startServer(t, "token")
+
+	remote, err := signer.NewRemoteSigner(addr, "wrong-token", "", 5*time.Second)
+	require.NoError(t, err)
+	defer remote.Close()
+
+	_, err = remote.Status(&types.StatusRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNewServerEmptyToken(t *testing.T) {
+	_, err := signer.NewServer(signer.NewLocalSigner(nil, nil), "", nil)
+	require.Error(t, err)
+}
+
+func TestLocalSignerConsumesDE(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+	localSigner := signer.NewLocalSigner(s, []byte("secret"))
+
+	kp, err := tss.GenerateKeyPair()
+	require.NoError(t, err)
+	require.NoError(t, s.SetGroup(store.Group{GroupPubKey: kp.PubKey, MemberID: 1, PrivKey: kp.PrivKey}))
+
+	res, err := localSigner.GenerateDEs(&types.GenerateDEsRequest{Count: 1})
+	require.NoError(t, err)
+	de := res.DEs[0]
+
+	bindingFactor := tss.Scalar(testutil.HexDecode("0000000000000000000000000000000000000000000000000000000000000001"))
+	groupPubNonce, err := tss.ComputeOwnPubNonce(de.PubD, de.PubE, bindingFactor)
+	require.NoError(t, err)
+
+	req := &types.SignRequest{
+		GroupPubKey:   kp.PubKey,
+		GroupPubNonce: groupPubNonce,
+		Message:       []byte("message"),
+		DE:            de,
+		BindingFactor: bindingFactor,
+		MemberIDs:     []tss.MemberID{1},
+	}
+	signRes, err := localSigner.Sign(req)
+	require.NoError(t, err)
+	require.Equal(t, tss.MemberID(1), signRes.MemberID)
+
+	// the DE is removed once it is used, so it cannot sign any other message.
+	require.False(t, s.HasDE(de))
+
+	req.Message = []byte("another message")
+	_, err = localSigner.Sign(req)
+	require.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package types
+
+// Signer computes the tss operations that require the secrets of a member. The secrets never
+// leave the signer; only the public results are returned to the caller.
+type Signer interface {
+	Round1(req *Round1Request) (*Round1Response, error)
+	Round2(req *Round2Request) (*Round2Response, error)
+	Round3(req *Round3Request) (*Round3Response, error)
+	GenerateDEs(req *GenerateDEsRequest) (*GenerateDEsResponse, error)
+	DeleteDE(req *DeleteDERequest) (*DeleteDEResponse, error)
+	Sign(req *SignRequest) (*SignResponse, error)
+	Status(req *StatusRequest) (*StatusResponse, error)
+}
@@ -0,0 +1,3542 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: band/cylinder/signer/v1/signer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_bandprotocol_chain_v3_pkg_tss "github.com/bandprotocol/chain/v3/pkg/tss"
+	types "github.com/bandprotocol/chain/v3/x/tss/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Round1Request is the request type for the Signer/Round1 RPC method.
+type Round1Request struct {
+	// group_id is the ID of the group.
+	GroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"group_id,omitempty"`
+	// member_id is the ID of the member in the group.
+	MemberID github_com_bandprotocol_chain_v3_pkg_tss.MemberID `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.MemberID" json:"member_id,omitempty"`
+	// threshold is the threshold of the group.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// dkg_context is the DKG context of the group.
+	DKGContext []byte `protobuf:"bytes,4,opt,name=dkg_context,json=dkgContext,proto3" json:"dkg_context,omitempty"`
+}
+
+func (m *Round1Request) Reset()         { *m = Round1Request{} }
+func (m *Round1Request) String() string { return proto.CompactTextString(m) }
+func (*Round1Request) ProtoMessage()    {}
+func (*Round1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{0}
+}
+func (m *Round1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round1Request.Merge(m, src)
+}
+func (m *Round1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round1Request proto.InternalMessageInfo
+
+func (m *Round1Request) GetGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *Round1Request) GetMemberID() github_com_bandprotocol_chain_v3_pkg_tss.MemberID {
+	if m != nil {
+		return m.MemberID
+	}
+	return 0
+}
+
+func (m *Round1Request) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Round1Request) GetDKGContext() []byte {
+	if m != nil {
+		return m.DKGContext
+	}
+	return nil
+}
+
+// Round1Response is the response type for the Signer/Round1 RPC method.
+type Round1Response struct {
+	// round1_info is the public round 1 information of the member.
+	Round1Info types.Round1Info `protobuf:"bytes,1,opt,name=round1_info,json=round1Info,proto3" json:"round1_info"`
+}
+
+func (m *Round1Response) Reset()         { *m = Round1Response{} }
+func (m *Round1Response) String() string { return proto.CompactTextString(m) }
+func (*Round1Response) ProtoMessage()    {}
+func (*Round1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{1}
+}
+func (m *Round1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round1Response.Merge(m, src)
+}
+func (m *Round1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round1Response proto.InternalMessageInfo
+
+func (m *Round1Response) GetRound1Info() types.Round1Info {
+	if m != nil {
+		return m.Round1Info
+	}
+	return types.Round1Info{}
+}
+
+// Round2Request is the request type for the Signer/Round2 RPC method.
+type Round2Request struct {
+	// group_id is the ID of the group.
+	GroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"group_id,omitempty"`
+	// one_time_pub_keys is the list of one-time public keys of all members ordered by member ID.
+	OneTimePubKeys github_com_bandprotocol_chain_v3_pkg_tss.Points `protobuf:"bytes,2,rep,name=one_time_pub_keys,json=oneTimePubKeys,proto3,castrepeated=github.com/bandprotocol/chain/v3/pkg/tss.Points" json:"one_time_pub_keys,omitempty"`
+}
+
+func (m *Round2Request) Reset()         { *m = Round2Request{} }
+func (m *Round2Request) String() string { return proto.CompactTextString(m) }
+func (*Round2Request) ProtoMessage()    {}
+func (*Round2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{2}
+}
+func (m *Round2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round2Request.Merge(m, src)
+}
+func (m *Round2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round2Request proto.InternalMessageInfo
+
+func (m *Round2Request) GetGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *Round2Request) GetOneTimePubKeys() github_com_bandprotocol_chain_v3_pkg_tss.Points {
+	if m != nil {
+		return m.OneTimePubKeys
+	}
+	return nil
+}
+
+// Round2Response is the response type for the Signer/Round2 RPC method.
+type Round2Response struct {
+	// round2_info is the round 2 information of the member.
+	Round2Info types.Round2Info `protobuf:"bytes,1,opt,name=round2_info,json=round2Info,proto3" json:"round2_info"`
+}
+
+func (m *Round2Response) Reset()         { *m = Round2Response{} }
+func (m *Round2Response) String() string { return proto.CompactTextString(m) }
+func (*Round2Response) ProtoMessage()    {}
+func (*Round2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{3}
+}
+func (m *Round2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round2Response.Merge(m, src)
+}
+func (m *Round2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round2Response proto.InternalMessageInfo
+
+func (m *Round2Response) GetRound2Info() types.Round2Info {
+	if m != nil {
+		return m.Round2Info
+	}
+	return types.Round2Info{}
+}
+
+// Round3Request is the request type for the Signer/Round3 RPC method.
+type Round3Request struct {
+	// group_result is the group information with the round 1 and round 2 information of all members.
+	GroupResult types.GroupResult `protobuf:"bytes,1,opt,name=group_result,json=groupResult,proto3" json:"group_result"`
+}
+
+func (m *Round3Request) Reset()         { *m = Round3Request{} }
+func (m *Round3Request) String() string { return proto.CompactTextString(m) }
+func (*Round3Request) ProtoMessage()    {}
+func (*Round3Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{4}
+}
+func (m *Round3Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round3Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round3Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round3Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round3Request.Merge(m, src)
+}
+func (m *Round3Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round3Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round3Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round3Request proto.InternalMessageInfo
+
+func (m *Round3Request) GetGroupResult() types.GroupResult {
+	if m != nil {
+		return m.GroupResult
+	}
+	return types.GroupResult{}
+}
+
+// Round3Response is the response type for the Signer/Round3 RPC method.
+type Round3Response struct {
+	// member_id is the ID of the member in the group.
+	MemberID github_com_bandprotocol_chain_v3_pkg_tss.MemberID `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.MemberID" json:"member_id,omitempty"`
+	// complaints is the list of complaints against members that send invalid secret shares.
+	Complaints []types.Complaint `protobuf:"bytes,2,rep,name=complaints,proto3" json:"complaints"`
+	// own_pub_key_sig is the signature of the own public key; it is empty if there is any complaint.
+	OwnPubKeySig github_com_bandprotocol_chain_v3_pkg_tss.Signature `protobuf:"bytes,3,opt,name=own_pub_key_sig,json=ownPubKeySig,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Signature" json:"own_pub_key_sig,omitempty"`
+	// key_generated is true if the private key of the member is generated by this request.
+	KeyGenerated bool `protobuf:"varint,4,opt,name=key_generated,json=keyGenerated,proto3" json:"key_generated,omitempty"`
+}
+
+func (m *Round3Response) Reset()         { *m = Round3Response{} }
+func (m *Round3Response) String() string { return proto.CompactTextString(m) }
+func (*Round3Response) ProtoMessage()    {}
+func (*Round3Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{5}
+}
+func (m *Round3Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Round3Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Round3Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Round3Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round3Response.Merge(m, src)
+}
+func (m *Round3Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *Round3Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round3Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round3Response proto.InternalMessageInfo
+
+func (m *Round3Response) GetMemberID() github_com_bandprotocol_chain_v3_pkg_tss.MemberID {
+	if m != nil {
+		return m.MemberID
+	}
+	return 0
+}
+
+func (m *Round3Response) GetComplaints() []types.Complaint {
+	if m != nil {
+		return m.Complaints
+	}
+	return nil
+}
+
+func (m *Round3Response) GetOwnPubKeySig() github_com_bandprotocol_chain_v3_pkg_tss.Signature {
+	if m != nil {
+		return m.OwnPubKeySig
+	}
+	return nil
+}
+
+func (m *Round3Response) GetKeyGenerated() bool {
+	if m != nil {
+		return m.KeyGenerated
+	}
+	return false
+}
+
+// GenerateDEsRequest is the request type for the Signer/GenerateDEs RPC method.
+type GenerateDEsRequest struct {
+	// count is the number of DE pairs to be generated.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GenerateDEsRequest) Reset()         { *m = GenerateDEsRequest{} }
+func (m *GenerateDEsRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateDEsRequest) ProtoMessage()    {}
+func (*GenerateDEsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{6}
+}
+func (m *GenerateDEsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateDEsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateDEsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenerateDEsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateDEsRequest.Merge(m, src)
+}
+func (m *GenerateDEsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateDEsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateDEsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateDEsRequest proto.InternalMessageInfo
+
+func (m *GenerateDEsRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// GenerateDEsResponse is the response type for the Signer/GenerateDEs RPC method.
+type GenerateDEsResponse struct {
+	// des is the list of public values of the generated DE pairs.
+	DEs []types.DE `protobuf:"bytes,1,rep,name=des,proto3" json:"des"`
+}
+
+func (m *GenerateDEsResponse) Reset()         { *m = GenerateDEsResponse{} }
+func (m *GenerateDEsResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateDEsResponse) ProtoMessage()    {}
+func (*GenerateDEsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{7}
+}
+func (m *GenerateDEsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateDEsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateDEsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenerateDEsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateDEsResponse.Merge(m, src)
+}
+func (m *GenerateDEsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateDEsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateDEsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateDEsResponse proto.InternalMessageInfo
+
+func (m *GenerateDEsResponse) GetDEs() []types.DE {
+	if m != nil {
+		return m.DEs
+	}
+	return nil
+}
+
+// DeleteDERequest is the request type for the Signer/DeleteDE RPC method.
+type DeleteDERequest struct {
+	// de is the public values of the DE pair.
+	DE types.DE `protobuf:"bytes,1,opt,name=de,proto3" json:"de"`
+}
+
+func (m *DeleteDERequest) Reset()         { *m = DeleteDERequest{} }
+func (m *DeleteDERequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDERequest) ProtoMessage()    {}
+func (*DeleteDERequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{8}
+}
+func (m *DeleteDERequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDERequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDERequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteDERequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDERequest.Merge(m, src)
+}
+func (m *DeleteDERequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDERequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDERequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDERequest proto.InternalMessageInfo
+
+func (m *DeleteDERequest) GetDE() types.DE {
+	if m != nil {
+		return m.DE
+	}
+	return types.DE{}
+}
+
+// DeleteDEResponse is the response type for the Signer/DeleteDE RPC method.
+type DeleteDEResponse struct {
+}
+
+func (m *DeleteDEResponse) Reset()         { *m = DeleteDEResponse{} }
+func (m *DeleteDEResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteDEResponse) ProtoMessage()    {}
+func (*DeleteDEResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{9}
+}
+func (m *DeleteDEResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteDEResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteDEResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteDEResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDEResponse.Merge(m, src)
+}
+func (m *DeleteDEResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteDEResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDEResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDEResponse proto.InternalMessageInfo
+
+// SignRequest is the request type for the Signer/Sign RPC method.
+type SignRequest struct {
+	// group_pub_key is the public key of the group.
+	GroupPubKey github_com_bandprotocol_chain_v3_pkg_tss.Point `protobuf:"bytes,1,opt,name=group_pub_key,json=groupPubKey,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Point" json:"group_pub_key,omitempty"`
+	// group_pub_nonce is the public nonce of the signing.
+	GroupPubNonce github_com_bandprotocol_chain_v3_pkg_tss.Point `protobuf:"bytes,2,opt,name=group_pub_nonce,json=groupPubNonce,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Point" json:"group_pub_nonce,omitempty"`
+	// message is the message to be signed.
+	Message []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// de is the public values of the DE pair assigned to the member.
+	DE types.DE `protobuf:"bytes,4,opt,name=de,proto3" json:"de"`
+	// binding_factor is the binding factor of the member.
+	BindingFactor github_com_bandprotocol_chain_v3_pkg_tss.Scalar `protobuf:"bytes,5,opt,name=binding_factor,json=bindingFactor,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Scalar" json:"binding_factor,omitempty"`
+	// member_ids is the list of IDs of the assigned members.
+	MemberIDs []github_com_bandprotocol_chain_v3_pkg_tss.MemberID `protobuf:"varint,6,rep,packed,name=member_ids,json=memberIds,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.MemberID" json:"member_ids,omitempty"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{10}
+}
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(m, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetGroupPubKey() github_com_bandprotocol_chain_v3_pkg_tss.Point {
+	if m != nil {
+		return m.GroupPubKey
+	}
+	return nil
+}
+
+func (m *SignRequest) GetGroupPubNonce() github_com_bandprotocol_chain_v3_pkg_tss.Point {
+	if m != nil {
+		return m.GroupPubNonce
+	}
+	return nil
+}
+
+func (m *SignRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SignRequest) GetDE() types.DE {
+	if m != nil {
+		return m.DE
+	}
+	return types.DE{}
+}
+
+func (m *SignRequest) GetBindingFactor() github_com_bandprotocol_chain_v3_pkg_tss.Scalar {
+	if m != nil {
+		return m.BindingFactor
+	}
+	return nil
+}
+
+func (m *SignRequest) GetMemberIDs() []github_com_bandprotocol_chain_v3_pkg_tss.MemberID {
+	if m != nil {
+		return m.MemberIDs
+	}
+	return nil
+}
+
+// SignResponse is the response type for the Signer/Sign RPC method.
+type SignResponse struct {
+	// member_id is the ID of the member in the group.
+	MemberID github_com_bandprotocol_chain_v3_pkg_tss.MemberID `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.MemberID" json:"member_id,omitempty"`
+	// signature is the partial signature of the member.
+	Signature github_com_bandprotocol_chain_v3_pkg_tss.Signature `protobuf:"bytes,2,opt,name=signature,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Signature" json:"signature,omitempty"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{11}
+}
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetMemberID() github_com_bandprotocol_chain_v3_pkg_tss.MemberID {
+	if m != nil {
+		return m.MemberID
+	}
+	return 0
+}
+
+func (m *SignResponse) GetSignature() github_com_bandprotocol_chain_v3_pkg_tss.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// StatusRequest is the request type for the Signer/Status RPC method.
+type StatusRequest struct {
+}
+
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{12}
+}
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(m, src)
+}
+func (m *StatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+
+// StatusResponse is the response type for the Signer/Status RPC method.
+type StatusResponse struct {
+	// group_count is the number of groups whose private keys are kept.
+	GroupCount uint64 `protobuf:"varint,1,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
+	// dkg_count is the number of groups in the key generation process.
+	DKGCount uint64 `protobuf:"varint,2,opt,name=dkg_count,json=dkgCount,proto3" json:"dkg_count,omitempty"`
+	// de_count is the number of DE pairs kept.
+	DECount uint64 `protobuf:"varint,3,opt,name=de_count,json=deCount,proto3" json:"de_count,omitempty"`
+}
+
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdd47cddcee8a1b0, []int{13}
+}
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(m, src)
+}
+func (m *StatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+
+func (m *StatusResponse) GetGroupCount() uint64 {
+	if m != nil {
+		return m.GroupCount
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetDKGCount() uint64 {
+	if m != nil {
+		return m.DKGCount
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetDECount() uint64 {
+	if m != nil {
+		return m.DECount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Round1Request)(nil), "band.cylinder.signer.v1.Round1Request")
+	proto.RegisterType((*Round1Response)(nil), "band.cylinder.signer.v1.Round1Response")
+	proto.RegisterType((*Round2Request)(nil), "band.cylinder.signer.v1.Round2Request")
+	proto.RegisterType((*Round2Response)(nil), "band.cylinder.signer.v1.Round2Response")
+	proto.RegisterType((*Round3Request)(nil), "band.cylinder.signer.v1.Round3Request")
+	proto.RegisterType((*Round3Response)(nil), "band.cylinder.signer.v1.Round3Response")
+	proto.RegisterType((*GenerateDEsRequest)(nil), "band.cylinder.signer.v1.GenerateDEsRequest")
+	proto.RegisterType((*GenerateDEsResponse)(nil), "band.cylinder.signer.v1.GenerateDEsResponse")
+	proto.RegisterType((*DeleteDERequest)(nil), "band.cylinder.signer.v1.DeleteDERequest")
+	proto.RegisterType((*DeleteDEResponse)(nil), "band.cylinder.signer.v1.DeleteDEResponse")
+	proto.RegisterType((*SignRequest)(nil), "band.cylinder.signer.v1.SignRequest")
+	proto.RegisterType((*SignResponse)(nil), "band.cylinder.signer.v1.SignResponse")
+	proto.RegisterType((*StatusRequest)(nil), "band.cylinder.signer.v1.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "band.cylinder.signer.v1.StatusResponse")
+}
+
+func init() {
+	proto.RegisterFile("band/cylinder/signer/v1/signer.proto", fileDescriptor_cdd47cddcee8a1b0)
+}
+
+var fileDescriptor_cdd47cddcee8a1b0 = []byte{
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0xeb, 0x24, 0x6d, 0x93, 0x37, 0x49, 0x0b, 0x43, 0x25, 0xa2, 0xb0, 0xc4, 0x95, 0x59,
+	0x76, 0xbb, 0x80, 0xe2, 0x8d, 0x23, 0xed, 0x15, 0xad, 0x9b, 0x6e, 0x55, 0x56, 0xa0, 0xca, 0x59,
+	0x40, 0x54, 0x82, 0xc8, 0x89, 0xa7, 0xae, 0xd5, 0x64, 0x26, 0x78, 0xec, 0x2e, 0xb9, 0xf3, 0x01,
+	0x38, 0x70, 0xe2, 0x23, 0xf0, 0x05, 0xf8, 0x02, 0x1c, 0x56, 0x82, 0xc3, 0x1e, 0x39, 0x19, 0x94,
+	0x7e, 0x8b, 0x9e, 0xd0, 0xfc, 0x6b, 0xda, 0x0d, 0x25, 0xd9, 0x05, 0xed, 0x6d, 0xfc, 0xfa, 0x99,
+	0xdf, 0x8c, 0x9f, 0x79, 0xdf, 0x79, 0x0d, 0xb7, 0xfb, 0x3e, 0x09, 0xec, 0xc1, 0x64, 0x18, 0x91,
+	0x00, 0xc7, 0x36, 0x8b, 0x42, 0x82, 0x63, 0xfb, 0xac, 0xa5, 0x46, 0xcd, 0x71, 0x4c, 0x13, 0x8a,
+	0xde, 0xe6, 0xaa, 0xa6, 0x56, 0x35, 0xd5, 0xbb, 0xb3, 0x56, 0x7d, 0x2b, 0xa4, 0x21, 0x15, 0x1a,
+	0x9b, 0x8f, 0xa4, 0xbc, 0x5e, 0x17, 0xd0, 0x84, 0x31, 0xfb, 0xac, 0xd5, 0xc7, 0x89, 0xdf, 0xe2,
+	0x63, 0xf9, 0xce, 0xfa, 0x29, 0x07, 0x55, 0x8f, 0xa6, 0x24, 0x68, 0x79, 0xf8, 0xdb, 0x14, 0xb3,
+	0x04, 0x1d, 0x41, 0x31, 0x8c, 0x69, 0x3a, 0xee, 0x45, 0x41, 0xcd, 0xd8, 0x36, 0x76, 0x0a, 0xee,
+	0xc7, 0xd3, 0xcc, 0x5c, 0xdf, 0xe7, 0xb1, 0x83, 0xce, 0x45, 0x66, 0xde, 0x0f, 0xa3, 0xe4, 0x24,
+	0xed, 0x37, 0x07, 0x74, 0x64, 0x73, 0xb2, 0x00, 0x0d, 0xe8, 0xd0, 0x1e, 0x9c, 0xf8, 0x11, 0xb1,
+	0xcf, 0xda, 0xf6, 0xf8, 0x34, 0x14, 0x4b, 0xa8, 0x39, 0xde, 0xba, 0x00, 0x1e, 0x04, 0xe8, 0x1b,
+	0x28, 0x8d, 0xf0, 0xa8, 0x8f, 0x63, 0x0e, 0xcf, 0x09, 0xf8, 0xc3, 0x69, 0x66, 0x16, 0x3f, 0x15,
+	0x41, 0x41, 0x6f, 0x2d, 0x4d, 0xd7, 0x93, 0xbc, 0xa2, 0x64, 0x1e, 0x04, 0xe8, 0x16, 0x94, 0x92,
+	0x93, 0x18, 0xb3, 0x13, 0x3a, 0x0c, 0x6a, 0x79, 0xce, 0xf7, 0x66, 0x01, 0x64, 0x43, 0x39, 0x38,
+	0x0d, 0x7b, 0x03, 0x4a, 0x12, 0xfc, 0x5d, 0x52, 0x2b, 0x6c, 0x1b, 0x3b, 0x15, 0x77, 0x63, 0x9a,
+	0x99, 0xd0, 0x79, 0xbc, 0xbf, 0x2b, 0xa3, 0x1e, 0x04, 0xa7, 0xa1, 0x1a, 0x5b, 0x9f, 0xc3, 0x86,
+	0xf6, 0x86, 0x8d, 0x29, 0x61, 0x18, 0xed, 0x42, 0x39, 0x16, 0x91, 0x5e, 0x44, 0x8e, 0xa9, 0xf0,
+	0xa7, 0xec, 0xdc, 0x6a, 0x8a, 0xf3, 0xe0, 0x7b, 0x52, 0x06, 0x37, 0xe5, 0xb4, 0x03, 0x72, 0x4c,
+	0xdd, 0xc2, 0xb3, 0xcc, 0x5c, 0xf1, 0x20, 0xbe, 0x8c, 0x58, 0xbf, 0x19, 0xca, 0x73, 0xe7, 0xf5,
+	0x78, 0xfe, 0x26, 0x25, 0xb8, 0x97, 0x44, 0x23, 0xdc, 0x1b, 0xa7, 0xfd, 0xde, 0x29, 0x9e, 0xb0,
+	0x5a, 0x6e, 0x3b, 0xbf, 0x53, 0x71, 0xdb, 0x3f, 0xff, 0x69, 0xda, 0x4b, 0x93, 0x0f, 0x69, 0x44,
+	0x12, 0xe6, 0x6d, 0x50, 0x82, 0x9f, 0x44, 0x23, 0x7c, 0x98, 0xf6, 0x1f, 0xe3, 0x09, 0xbb, 0x34,
+	0xc9, 0x99, 0x33, 0xc9, 0x59, 0xc6, 0x24, 0x67, 0xce, 0x24, 0x11, 0xb1, 0xbe, 0x54, 0x1e, 0xb5,
+	0xb5, 0x47, 0x8f, 0xa0, 0x22, 0x3d, 0x8a, 0x31, 0x4b, 0x87, 0x89, 0xc2, 0xbe, 0x3b, 0x8f, 0x15,
+	0x0e, 0x78, 0x42, 0xa4, 0xb8, 0xe5, 0x70, 0x16, 0xb2, 0x7e, 0xc9, 0xa9, 0x0d, 0xb7, 0x2f, 0x37,
+	0x7c, 0x2d, 0x2d, 0x8d, 0xff, 0x3f, 0x2d, 0x1f, 0x02, 0x0c, 0xe8, 0x68, 0x3c, 0xf4, 0xb9, 0x81,
+	0xc2, 0xfb, 0xb2, 0xf3, 0xce, 0xfc, 0xc6, 0x77, 0xb5, 0x46, 0xdb, 0x31, 0x9b, 0x84, 0xbe, 0x86,
+	0x4d, 0xfa, 0x94, 0xe8, 0x03, 0xec, 0xb1, 0x28, 0x14, 0xf9, 0x5d, 0x71, 0x1f, 0x5c, 0x64, 0xa6,
+	0xb3, 0xf4, 0xe6, 0xba, 0x51, 0x48, 0xfc, 0x24, 0x8d, 0xb1, 0x57, 0xa1, 0x4f, 0x89, 0x3c, 0xc2,
+	0x6e, 0x14, 0xa2, 0xf7, 0xa0, 0xca, 0xb1, 0x21, 0x26, 0x38, 0xf6, 0x13, 0x1c, 0x88, 0xe2, 0x28,
+	0x7a, 0x95, 0x53, 0x3c, 0xd9, 0xd7, 0x31, 0xeb, 0x03, 0x40, 0xfa, 0xa1, 0xb3, 0xc7, 0xf4, 0xb9,
+	0x6c, 0xc1, 0xea, 0x80, 0xa6, 0x44, 0x1e, 0x48, 0xc1, 0x93, 0x0f, 0xd6, 0x27, 0xf0, 0xd6, 0x35,
+	0xad, 0x72, 0xba, 0x0d, 0xf9, 0x00, 0xb3, 0x9a, 0x21, 0x2c, 0xd8, 0x9a, 0xb7, 0xa0, 0xb3, 0xe7,
+	0x96, 0xf9, 0xb7, 0x4f, 0x33, 0x33, 0xcf, 0xe7, 0x71, 0xb5, 0xb5, 0x0b, 0x9b, 0x1d, 0x3c, 0xc4,
+	0x9c, 0xa4, 0x17, 0xbd, 0x0f, 0xb9, 0x00, 0xab, 0x14, 0xf8, 0x67, 0x0c, 0x28, 0x4c, 0xae, 0xb3,
+	0xe7, 0xe5, 0x02, 0x6c, 0x21, 0x78, 0x63, 0x06, 0x91, 0xbb, 0xb1, 0x7e, 0xcf, 0x43, 0x99, 0x3b,
+	0xa2, 0xa9, 0x5f, 0x40, 0x55, 0xa6, 0x98, 0xb2, 0x59, 0x2c, 0x50, 0x71, 0x9d, 0x8b, 0xcc, 0x6c,
+	0xbe, 0x5c, 0x99, 0xa8, 0x94, 0x93, 0x06, 0xa3, 0x23, 0xd8, 0x9c, 0x71, 0x09, 0x25, 0x03, 0x5c,
+	0xcb, 0xbd, 0x32, 0xb9, 0xaa, 0xc9, 0x9f, 0x71, 0x10, 0xaa, 0xc1, 0xfa, 0x08, 0x33, 0xe6, 0x87,
+	0x58, 0x26, 0x84, 0xa7, 0x1f, 0x95, 0x47, 0x85, 0xe5, 0x3d, 0x42, 0x47, 0xb0, 0xd1, 0x8f, 0x48,
+	0x10, 0x91, 0xb0, 0x77, 0xec, 0x0f, 0x12, 0x1a, 0xd7, 0x56, 0xc5, 0x36, 0xdb, 0x17, 0xd9, 0x4b,
+	0xdc, 0x13, 0xdd, 0x81, 0x3f, 0xf4, 0x63, 0xaf, 0xaa, 0x50, 0x8f, 0x04, 0x09, 0xf9, 0x00, 0x97,
+	0x35, 0xc6, 0x6a, 0x6b, 0xdb, 0xf9, 0x9d, 0x82, 0xeb, 0x4e, 0x33, 0xb3, 0xa4, 0xeb, 0x85, 0xbd,
+	0x5a, 0x95, 0x95, 0x74, 0x95, 0x31, 0xeb, 0x57, 0x03, 0x2a, 0xf2, 0x38, 0x5f, 0x53, 0x5d, 0x3f,
+	0x81, 0x12, 0xd3, 0x05, 0x55, 0xcb, 0xfd, 0xa7, 0x72, 0x9c, 0x81, 0xac, 0x4d, 0xa8, 0x76, 0x13,
+	0x3f, 0x49, 0x75, 0x85, 0x59, 0xdf, 0x1b, 0xb0, 0xa1, 0x23, 0xea, 0xcb, 0x4c, 0x90, 0x09, 0xd6,
+	0xbb, 0x5a, 0x7a, 0x20, 0x42, 0xbb, 0x3c, 0x82, 0xee, 0x41, 0x49, 0xf6, 0x3a, 0xfe, 0x5a, 0x76,
+	0xda, 0x0a, 0xff, 0x74, 0xd1, 0xe9, 0x52, 0x92, 0x78, 0x45, 0xd1, 0xe7, 0xb8, 0xf4, 0x0e, 0x14,
+	0x03, 0xac, 0x94, 0xa2, 0x67, 0xba, 0x65, 0xde, 0x7c, 0x3a, 0x7b, 0x52, 0xb8, 0x1e, 0x60, 0x31,
+	0x70, 0x7e, 0x5c, 0x85, 0xb5, 0xae, 0xf8, 0xd5, 0x40, 0x5f, 0xc1, 0x9a, 0xec, 0x70, 0xe8, 0x4e,
+	0xf3, 0x86, 0x7f, 0x91, 0xe6, 0xb5, 0xbf, 0x8a, 0xfa, 0xdd, 0x85, 0x3a, 0xf5, 0x65, 0x1a, 0xed,
+	0x2c, 0x42, 0x3b, 0x4b, 0xa2, 0x9d, 0x39, 0x74, 0x7b, 0x11, 0xba, 0xbd, 0x24, 0x7a, 0xd6, 0x41,
+	0x4e, 0xa0, 0x7c, 0xe5, 0xba, 0x43, 0x1f, 0xde, 0x38, 0x6f, 0xfe, 0x02, 0xad, 0x7f, 0xb4, 0x9c,
+	0x58, 0xad, 0xd4, 0x83, 0xa2, 0xbe, 0xc7, 0xd0, 0xce, 0x8d, 0x33, 0x5f, 0xb8, 0x2f, 0xeb, 0xf7,
+	0x96, 0x50, 0xaa, 0x05, 0xba, 0x50, 0xe0, 0xa7, 0x8c, 0x6e, 0xdf, 0x38, 0xe5, 0xca, 0x95, 0x59,
+	0x7f, 0x7f, 0x81, 0x6a, 0x66, 0xbd, 0xcc, 0xe0, 0x7f, 0xb1, 0xfe, 0x5a, 0xd2, 0xd7, 0xef, 0x2e,
+	0xd4, 0x49, 0xb4, 0x7b, 0xf8, 0x6c, 0xda, 0x30, 0x9e, 0x4f, 0x1b, 0xc6, 0x5f, 0xd3, 0x86, 0xf1,
+	0xc3, 0x79, 0x63, 0xe5, 0xf9, 0x79, 0x63, 0xe5, 0x8f, 0xf3, 0xc6, 0xca, 0xd1, 0x83, 0x85, 0x75,
+	0xf8, 0xe2, 0x8f, 0x76, 0x32, 0x19, 0x63, 0xd6, 0x5f, 0x13, 0xc2, 0xf6, 0xdf, 0x03, 0x00, 0xd9,
+	0x26, 0xfc, 0x90, 0x8d, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SignerClient interface {
+	// Round1 generates and keeps the secrets of round 1 and returns the public round 1 information.
+	Round1(ctx context.Context, in *Round1Request, opts ...grpc.CallOption) (*Round1Response, error)
+	// Round2 computes the encrypted secret shares of round 2 for the other members.
+	Round2(ctx context.Context, in *Round2Request, opts ...grpc.CallOption) (*Round2Response, error)
+	// Round3 computes and keeps the private key of the member, or returns complaints against
+	// members that send invalid secret shares.
+	Round3(ctx context.Context, in *Round3Request, opts ...grpc.CallOption) (*Round3Response, error)
+	// GenerateDEs generates and keeps new DE pairs and returns their public values.
+	GenerateDEs(ctx context.Context, in *GenerateDEsRequest, opts ...grpc.CallOption) (*GenerateDEsResponse, error)
+	// DeleteDE deletes the DE pair of the given public values.
+	DeleteDE(ctx context.Context, in *DeleteDERequest, opts ...grpc.CallOption) (*DeleteDEResponse, error)
+	// Sign computes the partial signature of the member for a signing.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Status returns the number of secrets kept by the signer.
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+}
+
+type signerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSignerClient(cc grpc1.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) Round1(ctx context.Context, in *Round1Request, opts ...grpc.CallOption) (*Round1Response, error) {
+	out := new(Round1Response)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/Round1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Round2(ctx context.Context, in *Round2Request, opts ...grpc.CallOption) (*Round2Response, error) {
+	out := new(Round2Response)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/Round2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Round3(ctx context.Context, in *Round3Request, opts ...grpc.CallOption) (*Round3Response, error) {
+	out := new(Round3Response)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/Round3", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) GenerateDEs(ctx context.Context, in *GenerateDEsRequest, opts ...grpc.CallOption) (*GenerateDEsResponse, error) {
+	out := new(GenerateDEsResponse)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/GenerateDEs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeleteDE(ctx context.Context, in *DeleteDERequest, opts ...grpc.CallOption) (*DeleteDEResponse, error) {
+	out := new(DeleteDEResponse)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/DeleteDE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/band.cylinder.signer.v1.Signer/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+type SignerServer interface {
+	// Round1 generates and keeps the secrets of round 1 and returns the public round 1 information.
+	Round1(context.Context, *Round1Request) (*Round1Response, error)
+	// Round2 computes the encrypted secret shares of round 2 for the other members.
+	Round2(context.Context, *Round2Request) (*Round2Response, error)
+	// Round3 computes and keeps the private key of the member, or returns complaints against
+	// members that send invalid secret shares.
+	Round3(context.Context, *Round3Request) (*Round3Response, error)
+	// GenerateDEs generates and keeps new DE pairs and returns their public values.
+	GenerateDEs(context.Context, *GenerateDEsRequest) (*GenerateDEsResponse, error)
+	// DeleteDE deletes the DE pair of the given public values.
+	DeleteDE(context.Context, *DeleteDERequest) (*DeleteDEResponse, error)
+	// Sign computes the partial signature of the member for a signing.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	// Status returns the number of secrets kept by the signer.
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+}
+
+// UnimplementedSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedSignerServer struct {
+}
+
+func (*UnimplementedSignerServer) Round1(ctx context.Context, req *Round1Request) (*Round1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round1 not implemented")
+}
+func (*UnimplementedSignerServer) Round2(ctx context.Context, req *Round2Request) (*Round2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round2 not implemented")
+}
+func (*UnimplementedSignerServer) Round3(ctx context.Context, req *Round3Request) (*Round3Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round3 not implemented")
+}
+func (*UnimplementedSignerServer) GenerateDEs(ctx context.Context, req *GenerateDEsRequest) (*GenerateDEsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDEs not implemented")
+}
+func (*UnimplementedSignerServer) DeleteDE(ctx context.Context, req *DeleteDERequest) (*DeleteDEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDE not implemented")
+}
+func (*UnimplementedSignerServer) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (*UnimplementedSignerServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+
+func RegisterSignerServer(s grpc1.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_Round1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/Round1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round1(ctx, req.(*Round1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Round2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/Round2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round2(ctx, req.(*Round2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Round3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round3Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Round3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/Round3",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Round3(ctx, req.(*Round3Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_GenerateDEs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDEsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).GenerateDEs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/GenerateDEs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).GenerateDEs(ctx, req.(*GenerateDEsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeleteDE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeleteDE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/DeleteDE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeleteDE(ctx, req.(*DeleteDERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.cylinder.signer.v1.Signer/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.cylinder.signer.v1.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Round1",
+			Handler:    _Signer_Round1_Handler,
+		},
+		{
+			MethodName: "Round2",
+			Handler:    _Signer_Round2_Handler,
+		},
+		{
+			MethodName: "Round3",
+			Handler:    _Signer_Round3_Handler,
+		},
+		{
+			MethodName: "GenerateDEs",
+			Handler:    _Signer_GenerateDEs_Handler,
+		},
+		{
+			MethodName: "DeleteDE",
+			Handler:    _Signer_DeleteDE_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Signer_Sign_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Signer_Status_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/cylinder/signer/v1/signer.proto",
+}
+
+func (m *Round1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DKGContext) > 0 {
+		i -= len(m.DKGContext)
+		copy(dAtA[i:], m.DKGContext)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.DKGContext)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemberID != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.MemberID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Round1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Round1Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Round2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OneTimePubKeys) > 0 {
+		for iNdEx := len(m.OneTimePubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OneTimePubKeys[iNdEx])
+			copy(dAtA[i:], m.OneTimePubKeys[iNdEx])
+			i = encodeVarintSigner(dAtA, i, uint64(len(m.OneTimePubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Round2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Round2Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Round3Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round3Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round3Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GroupResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Round3Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Round3Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round3Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyGenerated {
+		i--
+		if m.KeyGenerated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnPubKeySig) > 0 {
+		i -= len(m.OwnPubKeySig)
+		copy(dAtA[i:], m.OwnPubKeySig)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.OwnPubKeySig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Complaints) > 0 {
+		for iNdEx := len(m.Complaints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Complaints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MemberID != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.MemberID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenerateDEsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDEsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateDEsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenerateDEsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDEsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateDEsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DEs) > 0 {
+		for iNdEx := len(m.DEs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DEs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDERequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDERequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDERequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DE.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteDEResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDEResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteDEResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberIDs) > 0 {
+		dAtA6 := make([]byte, len(m.MemberIDs)*10)
+		var j5 int
+		for _, num := range m.MemberIDs {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintSigner(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BindingFactor) > 0 {
+		i -= len(m.BindingFactor)
+		copy(dAtA[i:], m.BindingFactor)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.BindingFactor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.DE.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPubNonce) > 0 {
+		i -= len(m.GroupPubNonce)
+		copy(dAtA[i:], m.GroupPubNonce)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.GroupPubNonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupPubKey) > 0 {
+		i -= len(m.GroupPubKey)
+		copy(dAtA[i:], m.GroupPubKey)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.GroupPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MemberID != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.MemberID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DECount != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.DECount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DKGCount != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.DKGCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupCount != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.GroupCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Round1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovSigner(uint64(m.GroupID))
+	}
+	if m.MemberID != 0 {
+		n += 1 + sovSigner(uint64(m.MemberID))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovSigner(uint64(m.Threshold))
+	}
+	l = len(m.DKGContext)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *Round1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Round1Info.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	return n
+}
+
+func (m *Round2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovSigner(uint64(m.GroupID))
+	}
+	if len(m.OneTimePubKeys) > 0 {
+		for _, b := range m.OneTimePubKeys {
+			l = len(b)
+			n += 1 + l + sovSigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Round2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Round2Info.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	return n
+}
+
+func (m *Round3Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GroupResult.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	return n
+}
+
+func (m *Round3Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberID != 0 {
+		n += 1 + sovSigner(uint64(m.MemberID))
+	}
+	if len(m.Complaints) > 0 {
+		for _, e := range m.Complaints {
+			l = e.Size()
+			n += 1 + l + sovSigner(uint64(l))
+		}
+	}
+	l = len(m.OwnPubKeySig)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	if m.KeyGenerated {
+		n += 2
+	}
+	return n
+}
+
+func (m *GenerateDEsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovSigner(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *GenerateDEsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DEs) > 0 {
+		for _, e := range m.DEs {
+			l = e.Size()
+			n += 1 + l + sovSigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteDERequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DE.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	return n
+}
+
+func (m *DeleteDEResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPubKey)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.GroupPubNonce)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = m.DE.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	l = len(m.BindingFactor)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	if len(m.MemberIDs) > 0 {
+		l = 0
+		for _, e := range m.MemberIDs {
+			l += sovSigner(uint64(e))
+		}
+		n += 1 + sovSigner(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberID != 0 {
+		n += 1 + sovSigner(uint64(m.MemberID))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupCount != 0 {
+		n += 1 + sovSigner(uint64(m.GroupCount))
+	}
+	if m.DKGCount != 0 {
+		n += 1 + sovSigner(uint64(m.DKGCount))
+	}
+	if m.DECount != 0 {
+		n += 1 + sovSigner(uint64(m.DECount))
+	}
+	return n
+}
+
+func sovSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigner(x uint64) (n int) {
+	return sovSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Round1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+			}
+			m.MemberID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberID |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DKGContext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DKGContext = append(m.DKGContext[:0], dAtA[iNdEx:postIndex]...)
+			if m.DKGContext == nil {
+				m.DKGContext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Round1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round1Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Round1Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Round2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneTimePubKeys = append(m.OneTimePubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.OneTimePubKeys[len(m.OneTimePubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Round2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round2Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Round2Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Round3Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round3Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round3Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Round3Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Round3Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Round3Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+			}
+			m.MemberID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberID |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Complaints = append(m.Complaints, types.Complaint{})
+			if err := m.Complaints[len(m.Complaints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnPubKeySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnPubKeySig = append(m.OwnPubKeySig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnPubKeySig == nil {
+				m.OwnPubKeySig = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyGenerated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyGenerated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateDEsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateDEsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateDEsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateDEsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateDEsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateDEsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DEs = append(m.DEs, types.DE{})
+			if err := m.DEs[len(m.DEs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDERequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDERequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDERequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DE", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDEResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDEResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDEResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubKey = append(m.GroupPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubKey == nil {
+				m.GroupPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPubNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPubNonce = append(m.GroupPubNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPubNonce == nil {
+				m.GroupPubNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DE", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingFactor = append(m.BindingFactor[:0], dAtA[iNdEx:postIndex]...)
+			if m.BindingFactor == nil {
+				m.BindingFactor = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v github_com_bandprotocol_chain_v3_pkg_tss.MemberID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MemberIDs = append(m.MemberIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSigner
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSigner
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MemberIDs) == 0 {
+					m.MemberIDs = make([]github_com_bandprotocol_chain_v3_pkg_tss.MemberID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_bandprotocol_chain_v3_pkg_tss.MemberID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSigner
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MemberIDs = append(m.MemberIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+			}
+			m.MemberID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberID |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupCount", wireType)
+			}
+			m.GroupCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DKGCount", wireType)
+			}
+			m.DKGCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DKGCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DECount", wireType)
+			}
+			m.DECount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DECount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigner = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// archiveVersion is the version of the archive format.
+const archiveVersion = 1
+
+// archiveAdditionalData binds the ciphertext of an archive to its purpose.
+var archiveAdditionalData = []byte("cylinder-archive")
+
+// Archive is an encrypted container of exported data. Its key is derived from the secret with a
+// fresh salt, so the archive can be imported into a store encrypted with another key.
+type Archive struct {
+	Version    uint32         `json:"version"`    // Version of the archive format
+	Encryption EncryptionInfo `json:"encryption"` // Encryption info used to derive the key of the archive
+	Ciphertext []byte         `json:"ciphertext"` // Encrypted data of the archive
+}
+
+// SealArchive encrypts the data into an archive with the key derived from the given secret.
+func SealArchive(secret Secret, data []byte) ([]byte, error) {
+	cipher, err := NewCipher(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := cipher.Encrypt(data, archiveAdditionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Archive{
+		Version:    archiveVersion,
+		Encryption: cipher.Info(),
+		Ciphertext: ciphertext,
+	})
+}
+
+// OpenArchive decrypts the data of the archive with the key derived from the given secret.
+func OpenArchive(secret Secret, bz []byte) ([]byte, error) {
+	var archive Archive
+	if err := json.Unmarshal(bz, &archive); err != nil {
+		return nil, fmt.Errorf("file is not an encrypted archive: %w", err)
+	}
+
+	if archive.Version != archiveVersion || len(archive.Ciphertext) == 0 {
+		return nil, errors.New("file is not an encrypted archive")
+	}
+
+	cipher, err := LoadCipher(secret, archive.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.Decrypt(archive.Ciphertext, archiveAdditionalData)
+}
@@ -0,0 +1,231 @@
+package store
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// EnvPassphrase is the environment variable that holds the passphrase of the store.
+	EnvPassphrase = "CYLINDER_PASSPHRASE"
+	// EnvKeyFile is the environment variable that holds the path to the key file of the store.
+	EnvKeyFile = "CYLINDER_KEY_FILE"
+
+	// KeySize is the size of the key that is used for encrypting values.
+	KeySize = chacha20poly1305.KeySize
+	// SaltSize is the size of the salt that is used for deriving a key from the secret.
+	SaltSize = 32
+
+	// encryptedValueVersion is the first byte of every encrypted value. Plaintext values are
+	// JSON objects, so they never start with this byte.
+	encryptedValueVersion = byte(0x01)
+
+	kdfScrypt = "scrypt"
+	kdfHKDF   = "hkdf-sha256"
+
+	// scrypt parameters recommended for interactive logins.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrNoSecret is returned when the store is encrypted but no secret is provided.
+	ErrNoSecret = errors.New("store is encrypted; set the passphrase or the key file")
+	// ErrInvalidSecret is returned when the secret doesn't match the one used to encrypt the store.
+	ErrInvalidSecret = errors.New("invalid passphrase or key file")
+
+	// keyCheckPlaintext is the value that is encrypted to verify the secret of the store.
+	keyCheckPlaintext = []byte("cylinder-key-check")
+)
+
+// Secret represents the user-provided secret that the encryption key is derived from. Either
+// Passphrase or Key must be set.
+type Secret struct {
+	Passphrase string // Passphrase that is stretched by scrypt
+	Key        []byte // Raw key material read from a key file
+}
+
+// LoadSecret loads the secret from the environment variables, falling back to the given key file.
+// It returns nil if no secret is configured.
+func LoadSecret(keyFile string) (*Secret, error) {
+	if passphrase := os.Getenv(EnvPassphrase); passphrase != "" {
+		return &Secret{Passphrase: passphrase}, nil
+	}
+
+	if path := os.Getenv(EnvKeyFile); path != "" {
+		keyFile = path
+	}
+
+	if keyFile == "" {
+		return nil, nil
+	}
+
+	return ReadKeyFile(keyFile)
+}
+
+// ReadKeyFile reads the hex-encoded key from the given file.
+func ReadKeyFile(path string) (*Secret, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("key file must contain a hex-encoded key: %w", err)
+	}
+
+	if len(key) < KeySize {
+		return nil, fmt.Errorf("key file must contain at least %d bytes of key", KeySize)
+	}
+
+	return &Secret{Key: key}, nil
+}
+
+// Validate checks that exactly one kind of secret is set.
+func (s Secret) Validate() error {
+	if (s.Passphrase == "") == (len(s.Key) == 0) {
+		return errors.New("either passphrase or key must be set")
+	}
+
+	return nil
+}
+
+// kdf returns the name of the key derivation function of the secret.
+func (s Secret) kdf() string {
+	if s.Passphrase != "" {
+		return kdfScrypt
+	}
+
+	return kdfHKDF
+}
+
+// deriveKey derives the encryption key from the secret with the given salt.
+func (s Secret) deriveKey(salt []byte) ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	if s.Passphrase != "" {
+		return scrypt.Key([]byte(s.Passphrase), salt, scryptN, scryptR, scryptP, KeySize)
+	}
+
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, s.Key, salt, []byte("cylinder")), key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// EncryptionInfo is the metadata of the encryption that is stored along with the encrypted values.
+type EncryptionInfo struct {
+	KDF      string `json:"kdf"`       // Key derivation function used to derive the key from the secret
+	Salt     []byte `json:"salt"`      // Salt used in the key derivation
+	KeyCheck []byte `json:"key_check"` // Encrypted known value used to verify the secret
+}
+
+// Cipher encrypts and decrypts values of the store.
+type Cipher struct {
+	info EncryptionInfo
+	key  []byte
+}
+
+// NewCipher creates a new cipher with a fresh salt for the given secret.
+func NewCipher(secret Secret) (*Cipher, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	key, err := secret.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cipher{info: EncryptionInfo{KDF: secret.kdf(), Salt: salt}, key: key}
+	c.info.KeyCheck, err = c.Encrypt(keyCheckPlaintext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// LoadCipher recreates the cipher from the stored encryption info and verifies the secret.
+func LoadCipher(secret Secret, info EncryptionInfo) (*Cipher, error) {
+	if secret.kdf() != info.KDF {
+		return nil, fmt.Errorf("%w: store is encrypted with %s", ErrInvalidSecret, info.KDF)
+	}
+
+	key, err := secret.deriveKey(info.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cipher{info: info, key: key}
+	check, err := c.Decrypt(info.KeyCheck, nil)
+	if err != nil || !bytes.Equal(check, keyCheckPlaintext) {
+		return nil, ErrInvalidSecret
+	}
+
+	return c, nil
+}
+
+// Info returns the encryption info of the cipher.
+func (c *Cipher) Info() EncryptionInfo {
+	return c.info
+}
+
+// Encrypt encrypts the plaintext and binds it to the given additional data (e.g. the store key).
+func (c *Cipher) Encrypt(plaintext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(c.key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), 1+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := append([]byte{encryptedValueVersion}, nonce...)
+	return aead.Seal(out, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt decrypts the value that was encrypted with the same additional data.
+func (c *Cipher) Decrypt(value []byte, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(c.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsEncrypted(value) || len(value) < 1+aead.NonceSize()+aead.Overhead() {
+		return nil, errors.New("value is not encrypted")
+	}
+
+	nonce, ciphertext := value[1:1+aead.NonceSize()], value[1+aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// IsEncrypted checks whether the value is encrypted by a cipher.
+func IsEncrypted(value []byte) bool {
+	return len(value) > 0 && value[0] == encryptedValueVersion
+}
@@ -16,6 +16,12 @@ var (
 	GroupStoreKeyPrefix = []byte{0x02}
 	// DEStoreKeyPrefix is the prefix for DE store.
 	DEStoreKeyPrefix = []byte{0x03}
+
+	// EncryptionInfoStoreKey is the key that keeps the encryption info of the store.
+	EncryptionInfoStoreKey = append(GlobalStoreKeyPrefix, []byte("EncryptionInfo")...)
+
+	// SecretStoreKeyPrefixes are the prefixes of the stores that keep secret values.
+	SecretStoreKeyPrefixes = [][]byte{DKGStoreKeyPrefix, GroupStoreKeyPrefix, DEStoreKeyPrefix}
 )
 
 // DKGStoreKey returns the key to retrieve all data for a group.
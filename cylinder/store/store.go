@@ -14,24 +14,232 @@ import (
 
 // Store represents a data store for storing data information for Cylinder process
 type Store struct {
-	DB dbm.DB
+	DB     dbm.DB
+	Cipher *Cipher // Cipher used to encrypt secret values; nil if the store is not encrypted
 }
 
-// NewStore creates a new instance of Store with the provided database.
+// NewStore creates a new instance of Store with the provided database. Values are written in
+// plaintext; it fails to read values of an encrypted store.
 func NewStore(db dbm.DB) *Store {
 	return &Store{
 		DB: db,
 	}
 }
 
+// NewEncryptedStore creates a new instance of Store that encrypts secret values with the key
+// derived from the given secret. The encryption info is initialized on first use and the
+// secret is verified against it afterward.
+func NewEncryptedStore(db dbm.DB, secret Secret) (*Store, error) {
+	info, found, err := GetEncryptionInfo(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var cipher *Cipher
+	if found {
+		cipher, err = LoadCipher(secret, info)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		cipher, err = NewCipher(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := setEncryptionInfo(db, cipher.Info()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Store{
+		DB:     db,
+		Cipher: cipher,
+	}, nil
+}
+
+// GetEncryptionInfo retrieves the encryption info of the database, if any.
+func GetEncryptionInfo(db dbm.DB) (EncryptionInfo, bool, error) {
+	bytes, err := db.Get(EncryptionInfoStoreKey)
+	if err != nil || bytes == nil {
+		return EncryptionInfo{}, false, err
+	}
+
+	var info EncryptionInfo
+	if err := json.Unmarshal(bytes, &info); err != nil {
+		return EncryptionInfo{}, false, err
+	}
+
+	return info, true, nil
+}
+
+// setEncryptionInfo stores the encryption info of the database.
+func setEncryptionInfo(db dbm.DB, info EncryptionInfo) error {
+	bytes, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return db.SetSync(EncryptionInfoStoreKey, bytes)
+}
+
+// IsEncrypted checks whether the store encrypts its secret values.
+func (s *Store) IsEncrypted() bool {
+	return s.Cipher != nil
+}
+
+// encode marshals the value to json and encrypts it if the store is encrypted.
+func (s *Store) encode(key []byte, value any) ([]byte, error) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cipher == nil {
+		return bytes, nil
+	}
+
+	return s.Cipher.Encrypt(bytes, key)
+}
+
+// decode decrypts the value if needed and unmarshals it from json. Plaintext values written
+// before the store is encrypted are still readable until they are migrated.
+func (s *Store) decode(key []byte, bytes []byte, value any) error {
+	if IsEncrypted(bytes) {
+		if s.Cipher == nil {
+			return ErrNoSecret
+		}
+
+		var err error
+		bytes, err = s.Cipher.Decrypt(bytes, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(bytes, value)
+}
+
+// CountPlaintextValues returns the number of secret values that are not encrypted.
+func (s *Store) CountPlaintextValues() (int, error) {
+	count := 0
+	for _, prefix := range SecretStoreKeyPrefixes {
+		iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		if err != nil {
+			return 0, err
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			if !IsEncrypted(iterator.Value()) {
+				count++
+			}
+		}
+
+		if err := iterator.Close(); err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// Migrate encrypts all plaintext secret values of the store in a single batch and returns
+// the number of migrated values.
+func (s *Store) Migrate() (int, error) {
+	if s.Cipher == nil {
+		return 0, ErrNoSecret
+	}
+
+	return s.reencrypt(s.Cipher, func(value []byte) bool { return !IsEncrypted(value) })
+}
+
+// RotateKey re-encrypts all secret values of the store with the key derived from the new
+// secret in a single batch and returns the number of re-encrypted values.
+func (s *Store) RotateKey(newSecret Secret) (int, error) {
+	if s.Cipher == nil {
+		return 0, ErrNoSecret
+	}
+
+	newCipher, err := NewCipher(newSecret)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := s.reencrypt(newCipher, func([]byte) bool { return true })
+	if err != nil {
+		return 0, err
+	}
+
+	s.Cipher = newCipher
+	return count, nil
+}
+
+// reencrypt decodes the secret values that match the filter with the current cipher, encrypts them
+// with the given cipher and writes them along with the encryption info of the given cipher.
+func (s *Store) reencrypt(cipher *Cipher, filter func(value []byte) bool) (int, error) {
+	batch := s.DB.NewBatch()
+	defer batch.Close()
+
+	count := 0
+	for _, prefix := range SecretStoreKeyPrefixes {
+		iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		if err != nil {
+			return 0, err
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			key, value := iterator.Key(), iterator.Value()
+			if !filter(value) {
+				continue
+			}
+
+			if IsEncrypted(value) {
+				value, err = s.Cipher.Decrypt(value, key)
+				if err != nil {
+					iterator.Close()
+					return 0, err
+				}
+			}
+
+			encrypted, err := cipher.Encrypt(value, key)
+			if err != nil {
+				iterator.Close()
+				return 0, err
+			}
+
+			if err := batch.Set(key, encrypted); err != nil {
+				iterator.Close()
+				return 0, err
+			}
+			count++
+		}
+
+		if err := iterator.Close(); err != nil {
+			return 0, err
+		}
+	}
+
+	info, err := json.Marshal(cipher.Info())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := batch.Set(EncryptionInfoStoreKey, info); err != nil {
+		return 0, err
+	}
+
+	return count, batch.WriteSync()
+}
+
 // SetDKG stores the dkg information by the given group id.
 func (s *Store) SetDKG(dkg DKG) error {
-	bytes, err := json.Marshal(dkg)
+	key := DKGStoreKey(dkg.GroupID)
+	bytes, err := s.encode(key, dkg)
 	if err != nil {
 		return err
 	}
 
-	return s.DB.Set(DKGStoreKey(dkg.GroupID), bytes)
+	return s.DB.Set(key, bytes)
 }
 
 // GetAllDKGs retrieves all DKGs information
@@ -45,7 +253,7 @@ func (s *Store) GetAllDKGs() ([]DKG, error) {
 	dkgs := make([]DKG, 0) // prevent nil slice when exporting data.
 	for ; iterator.Valid(); iterator.Next() {
 		var dkg DKG
-		err = json.Unmarshal(iterator.Value(), &dkg)
+		err = s.decode(iterator.Key(), iterator.Value(), &dkg)
 		if err != nil {
 			return nil, err
 		}
@@ -58,7 +266,8 @@ func (s *Store) GetAllDKGs() ([]DKG, error) {
 
 // GetDKG retrieves the dkg information by the given group id.
 func (s *Store) GetDKG(groupID tss.GroupID) (DKG, error) {
-	bytes, err := s.DB.Get(DKGStoreKey(groupID))
+	key := DKGStoreKey(groupID)
+	bytes, err := s.DB.Get(key)
 	if err != nil {
 		return DKG{}, err
 	}
@@ -68,7 +277,7 @@ func (s *Store) GetDKG(groupID tss.GroupID) (DKG, error) {
 	}
 
 	var dkg DKG
-	err = json.Unmarshal(bytes, &dkg)
+	err = s.decode(key, bytes, &dkg)
 	if err != nil {
 		return DKG{}, err
 	}
@@ -83,12 +292,13 @@ func (s *Store) DeleteDKG(groupID tss.GroupID) error {
 
 // SetGroup stores the group information
 func (s *Store) SetGroup(group Group) error {
-	bytes, err := json.Marshal(group)
+	key := GroupStoreKey(group.GroupPubKey)
+	bytes, err := s.encode(key, group)
 	if err != nil {
 		return err
 	}
 
-	return s.DB.Set(GroupStoreKey(group.GroupPubKey), bytes)
+	return s.DB.Set(key, bytes)
 }
 
 // GetAllGroups retrieves all groups information
@@ -102,7 +312,7 @@ func (s *Store) GetAllGroups() ([]Group, error) {
 	groups := make([]Group, 0) // prevent nil slice when exporting data.
 	for ; iterator.Valid(); iterator.Next() {
 		var group Group
-		err = json.Unmarshal(iterator.Value(), &group)
+		err = s.decode(iterator.Key(), iterator.Value(), &group)
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +325,8 @@ func (s *Store) GetAllGroups() ([]Group, error) {
 
 // GetGroup retrieves the group information by the given public key.
 func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
-	bytes, err := s.DB.Get(GroupStoreKey(pubKey))
+	key := GroupStoreKey(pubKey)
+	bytes, err := s.DB.Get(key)
 	if err != nil {
 		return Group{}, err
 	}
@@ -125,7 +336,7 @@ func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
 	}
 
 	var group Group
-	err = json.Unmarshal(bytes, &group)
+	err = s.decode(key, bytes, &group)
 	if err != nil {
 		return Group{}, err
 	}
@@ -135,12 +346,13 @@ func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
 
 // SetDE stores the private (d, E)
 func (s *Store) SetDE(privDE DE) error {
-	bytes, err := json.Marshal(privDE)
+	key := DEStoreKey(privDE.PubDE)
+	bytes, err := s.encode(key, privDE)
 	if err != nil {
 		return err
 	}
 
-	return s.DB.SetSync(DEStoreKey(privDE.PubDE), bytes)
+	return s.DB.SetSync(key, bytes)
 }
 
 // GetAllDEs retrieves all DEs information
@@ -154,7 +366,7 @@ func (s *Store) GetAllDEs() ([]DE, error) {
 	des := make([]DE, 0) // prevent nil slice when exporting data.
 	for ; iterator.Valid(); iterator.Next() {
 		var de DE
-		err = json.Unmarshal(iterator.Value(), &de)
+		err = s.decode(iterator.Key(), iterator.Value(), &de)
 		if err != nil {
 			return nil, err
 		}
@@ -167,7 +379,8 @@ func (s *Store) GetAllDEs() ([]DE, error) {
 
 // GetDE retrieves the private (d, E) by the given public (D, E)
 func (s *Store) GetDE(pubDE types.DE) (DE, error) {
-	bytes, err := s.DB.Get(DEStoreKey(pubDE))
+	key := DEStoreKey(pubDE)
+	bytes, err := s.DB.Get(key)
 	if err != nil {
 		return DE{}, err
 	}
@@ -177,7 +390,7 @@ func (s *Store) GetDE(pubDE types.DE) (DE, error) {
 	}
 
 	var de DE
-	err = json.Unmarshal(bytes, &de)
+	err = s.decode(key, bytes, &de)
 	if err != nil {
 		return DE{}, err
 	}
@@ -0,0 +1,154 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+var (
+	testDKG = store.DKG{
+		GroupID:        1,
+		MemberID:       2,
+		Coefficients:   tss.Scalars{bytes.Repeat([]byte{0x01}, 32)},
+		OneTimePrivKey: bytes.Repeat([]byte{0x02}, 32),
+	}
+	testGroup = store.Group{
+		GroupPubKey: bytes.Repeat([]byte{0x03}, 33),
+		MemberID:    2,
+		PrivKey:     bytes.Repeat([]byte{0x04}, 32),
+	}
+	testDE = store.DE{
+		PubDE: types.DE{PubD: bytes.Repeat([]byte{0x05}, 33), PubE: bytes.Repeat([]byte{0x06}, 33)},
+		PrivD: bytes.Repeat([]byte{0x07}, 32),
+		PrivE: bytes.Repeat([]byte{0x08}, 32),
+	}
+)
+
+func setTestData(t *testing.T, s *store.Store) {
+	require.NoError(t, s.SetDKG(testDKG))
+	require.NoError(t, s.SetGroup(testGroup))
+	require.NoError(t, s.SetDE(testDE))
+}
+
+func requireTestData(t *testing.T, s *store.Store) {
+	dkg, err := s.GetDKG(testDKG.GroupID)
+	require.NoError(t, err)
+	require.Equal(t, testDKG, dkg)
+
+	group, err := s.GetGroup(testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testGroup, group)
+
+	de, err := s.GetDE(testDE.PubDE)
+	require.NoError(t, err)
+	require.Equal(t, testDE, de)
+
+	des, err := s.GetAllDEs()
+	require.NoError(t, err)
+	require.Equal(t, []store.DE{testDE}, des)
+}
+
+func requireNoPlaintextSecret(t *testing.T, db dbm.DB) {
+	iterator, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		require.False(t, bytes.Contains(iterator.Value(), []byte(`"priv`)))
+		require.False(t, bytes.Contains(iterator.Value(), []byte(`"coefficients"`)))
+	}
+}
+
+func TestEncryptedStore(t *testing.T) {
+	for _, secret := range []store.Secret{
+		{Passphrase: "passphrase"},
+		{Key: bytes.Repeat([]byte{0xab}, store.KeySize)},
+	} {
+		db := dbm.NewMemDB()
+		s, err := store.NewEncryptedStore(db, secret)
+		require.NoError(t, err)
+		require.True(t, s.IsEncrypted())
+
+		setTestData(t, s)
+		requireTestData(t, s)
+		requireNoPlaintextSecret(t, db)
+
+		// reopen with the same secret.
+		s, err = store.NewEncryptedStore(db, secret)
+		require.NoError(t, err)
+		requireTestData(t, s)
+
+		// reopen with a wrong secret.
+		_, err = store.NewEncryptedStore(db, store.Secret{Passphrase: "wrong"})
+		require.ErrorIs(t, err, store.ErrInvalidSecret)
+
+		// the plaintext store cannot read encrypted values.
+		_, err = store.NewStore(db).GetDKG(testDKG.GroupID)
+		require.ErrorIs(t, err, store.ErrNoSecret)
+	}
+}
+
+func TestMigrateAndRotateKey(t *testing.T) {
+	db := dbm.NewMemDB()
+	setTestData(t, store.NewStore(db))
+
+	s, err := store.NewEncryptedStore(db, store.Secret{Passphrase: "old"})
+	require.NoError(t, err)
+
+	// plaintext values are still readable before the migration.
+	requireTestData(t, s)
+	count, err := s.CountPlaintextValues()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	count, err = s.Migrate()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	count, err = s.CountPlaintextValues()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	requireNoPlaintextSecret(t, db)
+	requireTestData(t, s)
+
+	// rotate to a new key.
+	newSecret := store.Secret{Key: bytes.Repeat([]byte{0xcd}, store.KeySize)}
+	count, err = s.RotateKey(newSecret)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	requireTestData(t, s)
+
+	_, err = store.NewEncryptedStore(db, store.Secret{Passphrase: "old"})
+	require.ErrorIs(t, err, store.ErrInvalidSecret)
+
+	s, err = store.NewEncryptedStore(db, newSecret)
+	require.NoError(t, err)
+	requireTestData(t, s)
+}
+
+func TestArchive(t *testing.T) {
+	secret := store.Secret{Passphrase: "passphrase"}
+	data := []byte(`[{"priv_key":"secret"}]`)
+
+	archive, err := store.SealArchive(secret, data)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(archive, []byte("secret")))
+
+	got, err := store.OpenArchive(secret, archive)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	_, err = store.OpenArchive(store.Secret{Passphrase: "wrong"}, archive)
+	require.ErrorIs(t, err, store.ErrInvalidSecret)
+
+	_, err = store.OpenArchive(secret, data)
+	require.Error(t, err)
+}
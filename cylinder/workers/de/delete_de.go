@@ -14,6 +14,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -122,7 +123,7 @@ func (d *DeleteDE) deleteDE(pubDE types.DE) {
 	logger.Info(":delivery_truck: Removing DE")
 
 	// Remove DE from storage
-	err := d.context.Store.DeleteDE(pubDE)
+	_, err := d.context.Signer.DeleteDE(&signertypes.DeleteDERequest{DE: pubDE})
 	if err != nil {
 		d.logger.Error(":cold_sweat: Failed to remove DE: %s", err)
 		return
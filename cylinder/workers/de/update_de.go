@@ -15,6 +15,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/cylinder/parser"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -149,25 +150,14 @@ func (u *UpdateDE) updateDE(numNewDE uint64) error {
 
 	u.logger.Info(":delivery_truck: Updating DE")
 
-	// Generate new DE pairs
-	privDEs, err := GenerateDEs(
-		numNewDE,
-		u.context.Config.RandomSecret,
-		u.context.Store,
-	)
+	// Generate new DE pairs; the private values are kept by the signer
+	res, err := u.context.Signer.GenerateDEs(&signertypes.GenerateDEsRequest{Count: numNewDE})
 	if err != nil {
 		return fmt.Errorf("failed to generate new DE pairs: %s", err)
 	}
+	pubDEs := res.DEs
 
-	// Store all DEs in the store
-	var pubDEs []types.DE
-	for _, privDE := range privDEs {
-		pubDEs = append(pubDEs, privDE.PubDE)
-
-		if err := u.context.Store.SetDE(privDE); err != nil {
-			return fmt.Errorf("failed to set new DE in the store: %s", err)
-		}
-
+	for range pubDEs {
 		metrics.IncOffChainDELeftGauge()
 	}
 
@@ -14,7 +14,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
-	"github.com/bandprotocol/chain/v3/cylinder/store"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -117,8 +117,13 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	// Generate round1 data
-	data, err := tss.GenerateRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext)
+	// Generate round1 data; the secrets are kept by the signer
+	res, err := r.context.Signer.Round1(&signertypes.Round1Request{
+		GroupID:    gid,
+		MemberID:   mid,
+		Threshold:  groupRes.Group.Threshold,
+		DKGContext: groupRes.DKGContext,
+	})
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to generate round1 data with error: %s", err)
 
@@ -126,35 +131,10 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 		return
 	}
 
-	// Set group data
-	dkg := store.DKG{
-		GroupID:        gid,
-		MemberID:       mid,
-		Coefficients:   data.Coefficients,
-		OneTimePrivKey: data.OneTimePrivKey,
-	}
-	err = r.context.Store.SetDKG(dkg)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to set DKG with error: %s", err)
-
-		metrics.IncProcessRound1FailureCount(uint64(gid))
-		return
-	}
-
 	metrics.IncDKGLeftGauge()
 
 	// Generate message
-	msg := types.NewMsgSubmitDKGRound1(
-		gid,
-		types.Round1Info{
-			MemberID:           mid,
-			CoefficientCommits: data.CoefficientCommits,
-			OneTimePubKey:      data.OneTimePubKey,
-			A0Signature:        data.A0Signature,
-			OneTimeSignature:   data.OneTimeSignature,
-		},
-		r.context.Config.Granter,
-	)
+	msg := types.NewMsgSubmitDKGRound1(gid, res.Round1Info, r.context.Config.Granter)
 
 	// Send the message to the message channel
 	r.context.MsgCh <- msg
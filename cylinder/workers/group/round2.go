@@ -14,6 +14,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -113,29 +114,17 @@ func (r *Round2) handleGroup(gid tss.GroupID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	// Get dkg data of the group
-	dkg, err := r.context.Store.GetDKG(gid)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to find group in store: %s", err)
-
-		metrics.IncProcessRound2FailureCount(uint64(gid))
-		return
-	}
-
 	// Get all one time public keys in the group
 	oneTimePubKeys := make(tss.Points, groupRes.Group.Size_)
 	for _, data := range groupRes.Round1Infos {
 		oneTimePubKeys[data.MemberID-1] = data.OneTimePubKey
 	}
 
-	// Compute encrypted secret shares
-	encSecretShares, err := tss.ComputeEncryptedSecretShares(
-		dkg.MemberID,
-		dkg.OneTimePrivKey,
-		oneTimePubKeys,
-		dkg.Coefficients,
-		tss.DefaultNonce16Generator{},
-	)
+	// Compute encrypted secret shares with the DKG secrets kept by the signer
+	res, err := r.context.Signer.Round2(&signertypes.Round2Request{
+		GroupID:        gid,
+		OneTimePubKeys: oneTimePubKeys,
+	})
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to generate encrypted secret shares: %s", err)
 
@@ -144,14 +133,7 @@ func (r *Round2) handleGroup(gid tss.GroupID) {
 	}
 
 	// Generate message for round 2
-	msg := types.NewMsgSubmitDKGRound2(
-		gid,
-		types.Round2Info{
-			MemberID:              dkg.MemberID,
-			EncryptedSecretShares: encSecretShares,
-		},
-		r.context.Config.Granter,
-	)
+	msg := types.NewMsgSubmitDKGRound2(gid, res.Round2Info, r.context.Config.Granter)
 
 	r.context.MsgCh <- msg
 
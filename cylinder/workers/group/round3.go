@@ -14,7 +14,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
-	"github.com/bandprotocol/chain/v3/cylinder/store"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -114,77 +114,30 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	group, err := r.context.Store.GetGroup(groupRes.Group.PubKey)
+	// Compute own private key and sign own public key with the secrets kept by the signer
+	res, err := r.context.Signer.Round3(&signertypes.Round3Request{GroupResult: groupRes.GroupResult})
 	if err != nil {
-		// Set DKG data
-		dkg, err := r.context.Store.GetDKG(gid)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to find group in store: %s", err)
+		logger.Error(":cold_sweat: Failed to process round3: %s", err)
 
-			metrics.IncProcessRound3FailureCount(uint64(gid))
-			return
-		}
-
-		// Get own private key
-		ownPrivKey, complaints, err := getOwnPrivKey(dkg, groupRes)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to get own private key or complaints: %s", err)
-
-			metrics.IncProcessRound3FailureCount(uint64(gid))
-			return
-		}
-
-		// If there is any complaint, send MsgComplain
-		if len(complaints) > 0 {
-			// Send message complaints
-			r.context.MsgCh <- types.NewMsgComplain(gid, complaints, r.context.Config.Granter)
-
-			metrics.IncProcessRound3ComplainCount(uint64(gid))
-			return
-		}
+		metrics.IncProcessRound3FailureCount(uint64(gid))
+		return
+	}
 
-		// Generate own private key and update it in store
-		group = store.Group{
-			GroupPubKey: groupRes.Group.PubKey,
-			MemberID:    dkg.MemberID,
-			PrivKey:     ownPrivKey,
-		}
+	// If there is any complaint, send MsgComplain
+	if len(res.Complaints) > 0 {
+		r.context.MsgCh <- types.NewMsgComplain(gid, res.Complaints, r.context.Config.Granter)
 
-		err = r.context.Store.SetGroup(group)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to set group with error: %s", err)
+		metrics.IncProcessRound3ComplainCount(uint64(gid))
+		return
+	}
 
-			metrics.IncProcessRound3FailureCount(uint64(gid))
-			return
-		}
+	if res.KeyGenerated {
 		metrics.IncGroupCount()
-
-		err = r.context.Store.DeleteDKG(gid)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to delete DKG with error: %s", err)
-
-			metrics.IncProcessRound3FailureCount(uint64(gid))
-			return
-		}
 		metrics.DecDKGLeftGauge()
 	}
 
-	// Sign own public key
-	ownPubKeySig, err := tss.SignOwnPubKey(
-		group.MemberID,
-		groupRes.DKGContext,
-		group.PrivKey.Point(),
-		group.PrivKey,
-	)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to sign own public key: %s", err)
-
-		metrics.IncProcessRound3FailureCount(uint64(gid))
-		return
-	}
-
 	// Send MsgConfirm
-	r.context.MsgCh <- types.NewMsgConfirm(gid, group.MemberID, ownPubKeySig, r.context.Config.Granter)
+	r.context.MsgCh <- types.NewMsgConfirm(gid, res.MemberID, res.OwnPubKeySig, r.context.Config.Granter)
 
 	metrics.ObserveProcessRound3Time(uint64(gid), time.Since(since).Seconds())
 	metrics.IncProcessRound3ConfirmCount(uint64(gid))
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
@@ -23,6 +24,8 @@ func GetMsgDetail(msg sdk.Msg) (detail string) {
 		detail = fmt.Sprintf("Type: %s", sdk.MsgTypeURL(t))
 	case *types.MsgSubmitSignature:
 		detail = fmt.Sprintf("Type: %s, SigningID: %d", sdk.MsgTypeURL(t), t.SigningID)
+	case *bandtsstypes.MsgActivate:
+		detail = fmt.Sprintf("Type: %s, GroupID: %d", sdk.MsgTypeURL(t), t.GroupID)
 	default:
 		detail = "Type: Unknown"
 	}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/cylinder/workers/sender"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -49,6 +50,9 @@ func TestGetMsgDetails(t *testing.T) {
 				&types.MsgSubmitSignature{
 					SigningID: 1,
 				},
+				&bandtsstypes.MsgActivate{
+					GroupID: 5,
+				},
 			},
 			[]string{
 				"Type: /band.tss.v1beta1.MsgSubmitDKGRound1, GroupID: 1",
@@ -57,6 +61,7 @@ func TestGetMsgDetails(t *testing.T) {
 				"Type: /band.tss.v1beta1.MsgComplain, GroupID: 4",
 				"Type: /band.tss.v1beta1.MsgSubmitDEs",
 				"Type: /band.tss.v1beta1.MsgSubmitSignature, SigningID: 1",
+				"Type: /band.bandtss.v1beta1.MsgActivate, GroupID: 5",
 			},
 		},
 		{
@@ -11,6 +11,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 )
 
 // Sender is a worker responsible for sending transactions to the node.
@@ -19,6 +20,8 @@ type Sender struct {
 	logger   *logger.Logger
 	client   *client.Client
 	freeKeys chan *keyring.Record
+
+	pendingMsg sdk.Msg // message taken from the channel to be sent in the next transaction, if any
 }
 
 var _ cylinder.Worker = &Sender{}
@@ -70,18 +73,41 @@ func (s *Sender) Start() {
 
 // collectMsgs collects messages from the message channel up to a limit size.
 // It will block until got at least one message, then return non-empty message list.
+// A standalone message is always returned alone, see IsStandaloneMsg.
 func (s *Sender) collectMsgs() []sdk.Msg {
 	maxSize := int(s.context.Config.MaxMessages)
 	var msgs []sdk.Msg
 
 	for len(msgs) == 0 || (len(msgs) < maxSize && len(s.context.MsgCh) > 0) {
-		msg := <-s.context.MsgCh
+		msg := s.pendingMsg
+		s.pendingMsg = nil
+		if msg == nil {
+			msg = <-s.context.MsgCh
+		}
+
+		if IsStandaloneMsg(msg) {
+			if len(msgs) == 0 {
+				return []sdk.Msg{msg}
+			}
+
+			// keep it for the next transaction.
+			s.pendingMsg = msg
+			break
+		}
+
 		msgs = append(msgs, msg)
 	}
 
 	return msgs
 }
 
+// IsStandaloneMsg returns whether the message must be sent in its own transaction. MsgActivate
+// requires a grant that the other messages don't, so a missing grant must not fail them too.
+func IsStandaloneMsg(msg sdk.Msg) bool {
+	_, ok := msg.(*bandtsstypes.MsgActivate)
+	return ok
+}
+
 // sendMsgs sends the given messages using the provided key.
 func (s *Sender) sendMsgs(key *keyring.Record, msgs []sdk.Msg) {
 	// Return key to the free keys after function ends
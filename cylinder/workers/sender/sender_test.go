@@ -0,0 +1,36 @@
+package sender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func TestCollectMsgsStandalone(t *testing.T) {
+	msgCh := make(chan sdk.Msg, 10)
+	s := &Sender{
+		context: &context.Context{
+			Config: &context.Config{MaxMessages: 10},
+			MsgCh:  msgCh,
+		},
+	}
+
+	signature := &tsstypes.MsgSubmitSignature{SigningID: 1}
+	activate := &bandtsstypes.MsgActivate{GroupID: 1}
+	des := &tsstypes.MsgSubmitDEs{}
+
+	msgCh <- signature
+	msgCh <- activate
+	msgCh <- des
+
+	// the activation message is sent alone, between the other messages.
+	require.Equal(t, []sdk.Msg{signature}, s.collectMsgs())
+	require.Equal(t, []sdk.Msg{activate}, s.collectMsgs())
+	require.Equal(t, []sdk.Msg{des}, s.collectMsgs())
+}
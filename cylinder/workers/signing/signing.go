@@ -15,6 +15,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/cylinder/parser"
+	signertypes "github.com/bandprotocol/chain/v3/cylinder/signer/types"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -103,56 +104,20 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming signing request")
 
-	// Set group data
-	group, err := s.context.Store.GetGroup(signing.GroupPubKey)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to find group in store: %s", err)
-
-		metrics.IncProcessSigningFailureCount(uint64(signing.GroupID))
-		metrics.IncIncomingSigningCount(uint64(signing.GroupID))
-		return
-	}
 	metrics.IncIncomingSigningCount(uint64(signing.GroupID))
 
-	// Get private keys of DE
-	privDE, err := s.context.Store.GetDE(types.DE{
-		PubD: assignedMember.PubD,
-		PubE: assignedMember.PubE,
+	// Sign the signing with the secrets kept by the signer
+	res, err := s.context.Signer.Sign(&signertypes.SignRequest{
+		GroupPubKey:   signing.GroupPubKey,
+		GroupPubNonce: signing.GroupPubNonce,
+		Message:       signing.Message,
+		DE: types.DE{
+			PubD: assignedMember.PubD,
+			PubE: assignedMember.PubE,
+		},
+		BindingFactor: assignedMember.BindingFactor,
+		MemberIDs:     signingRes.GetMemberIDs(),
 	})
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to get private DE from store: %s", err)
-
-		metrics.IncProcessSigningFailureCount(uint64(signing.GroupID))
-		return
-	}
-
-	// Compute own private nonce
-	privNonce, err := tss.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, assignedMember.BindingFactor)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to compute own private nonce: %s", err)
-
-		metrics.IncProcessSigningFailureCount(uint64(signing.GroupID))
-		return
-	}
-
-	// Compute lagrange
-	lagrange, err := tss.ComputeLagrangeCoefficient(group.MemberID, signingRes.GetMemberIDs())
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to compute lagrange coefficient: %s", err)
-
-		metrics.IncProcessSigningFailureCount(uint64(signing.GroupID))
-		return
-	}
-
-	// Sign the signing
-	sig, err := tss.SignSigning(
-		signing.GroupPubNonce,
-		signing.GroupPubKey,
-		signing.Message,
-		lagrange,
-		privNonce,
-		group.PrivKey,
-	)
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to sign signing: %s", err)
 
@@ -161,7 +126,7 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 	}
 
 	// Send MsgSigning
-	s.context.MsgCh <- types.NewMsgSubmitSignature(sid, group.MemberID, sig, s.context.Config.Granter)
+	s.context.MsgCh <- types.NewMsgSubmitSignature(sid, res.MemberID, res.Signature, s.context.Config.Granter)
 
 	metrics.ObserveProcessSigningTime(uint64(signing.GroupID), time.Since(since).Seconds())
 	metrics.IncProcessSigningSuccessCount(uint64(signing.GroupID))
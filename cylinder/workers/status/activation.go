@@ -0,0 +1,64 @@
+package status
+
+import (
+	"time"
+)
+
+// maxActivationBackoff is the maximum duration to wait between activation attempts.
+const maxActivationBackoff = time.Hour
+
+// Decision is the outcome of evaluating whether an inactive member should be activated.
+type Decision string
+
+const (
+	// DecisionDisabled means that the automatic activation is disabled.
+	DecisionDisabled Decision = "disabled"
+	// DecisionPenalty means that the inactive penalty duration has not elapsed yet.
+	DecisionPenalty Decision = "penalty"
+	// DecisionBackoff means that the previous attempt was made too recently.
+	DecisionBackoff Decision = "backoff"
+	// DecisionInsufficientDE means that the member doesn't have enough DEs on the chain to sign.
+	DecisionInsufficientDE Decision = "insufficient_de"
+	// DecisionActivate means that the activation message should be submitted.
+	DecisionActivate Decision = "activate"
+)
+
+// activation tracks the activation attempts of the member in a group.
+type activation struct {
+	attempts    uint64
+	nextAttempt time.Time
+}
+
+// decide evaluates whether the inactive member should be activated at the given time.
+func decide(
+	now time.Time,
+	reactivationTime time.Time,
+	autoActivate bool,
+	deCount uint64,
+	minDE uint64,
+	a activation,
+) Decision {
+	switch {
+	case !autoActivate:
+		return DecisionDisabled
+	case now.Before(reactivationTime):
+		return DecisionPenalty
+	case now.Before(a.nextAttempt):
+		return DecisionBackoff
+	case deCount < minDE:
+		return DecisionInsufficientDE
+	default:
+		return DecisionActivate
+	}
+}
+
+// backoff returns the duration to wait after the given number of attempts. The duration doubles
+// on every attempt, starting from the base duration, and is capped at maxActivationBackoff.
+func backoff(base time.Duration, attempts uint64) time.Duration {
+	wait := base
+	for i := uint64(1); i < attempts && wait < maxActivationBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxActivationBackoff)
+}
@@ -0,0 +1,74 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	tests := []struct {
+		name             string
+		reactivationTime time.Time
+		autoActivate     bool
+		deCount          uint64
+		activation       activation
+		expected         Decision
+	}{
+		{
+			name:             "disabled",
+			reactivationTime: now.Add(-time.Minute),
+			autoActivate:     false,
+			deCount:          10,
+			expected:         DecisionDisabled,
+		},
+		{
+			name:             "penalty not elapsed",
+			reactivationTime: now.Add(time.Minute),
+			autoActivate:     true,
+			deCount:          10,
+			expected:         DecisionPenalty,
+		},
+		{
+			name:             "backoff",
+			reactivationTime: now.Add(-time.Minute),
+			autoActivate:     true,
+			deCount:          10,
+			activation:       activation{attempts: 1, nextAttempt: now.Add(time.Second)},
+			expected:         DecisionBackoff,
+		},
+		{
+			name:             "insufficient DE",
+			reactivationTime: now.Add(-time.Minute),
+			autoActivate:     true,
+			deCount:          4,
+			expected:         DecisionInsufficientDE,
+		},
+		{
+			name:             "activate",
+			reactivationTime: now,
+			autoActivate:     true,
+			deCount:          5,
+			activation:       activation{attempts: 1, nextAttempt: now},
+			expected:         DecisionActivate,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decision := decide(now, tc.reactivationTime, tc.autoActivate, tc.deCount, 5, tc.activation)
+			require.Equal(t, tc.expected, decision)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, time.Minute, backoff(time.Minute, 1))
+	require.Equal(t, 2*time.Minute, backoff(time.Minute, 2))
+	require.Equal(t, 8*time.Minute, backoff(time.Minute, 4))
+	require.Equal(t, maxActivationBackoff, backoff(time.Minute, 10))
+	require.Equal(t, maxActivationBackoff, backoff(time.Minute, 1_000_000))
+}
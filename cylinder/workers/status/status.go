@@ -8,13 +8,17 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder/context"
 	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 )
 
 // Status is a worker responsible for checking the status of the active member of the given group and address.
+// It activates the member automatically once the inactive penalty has elapsed.
 type Status struct {
-	context *context.Context
-	logger  *logger.Logger
-	client  *client.Client
+	context     *context.Context
+	logger      *logger.Logger
+	client      *client.Client
+	activations map[tss.GroupID]activation
 }
 
 var _ cylinder.Worker = &Status{}
@@ -28,14 +32,15 @@ func New(ctx *context.Context) (*Status, error) {
 	}
 
 	return &Status{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "Status"),
-		client:  cli,
+		context:     ctx,
+		logger:      ctx.Logger.With("worker", "Status"),
+		client:      cli,
+		activations: make(map[tss.GroupID]activation),
 	}, nil
 }
 
 // checkStatus queries the status of the active member from the current and incoming groups.
-// It logs the status of the active member.
+// It logs the status of the active member and tries to activate the inactive one.
 func (s *Status) checkStatus() {
 	address := s.context.Config.Granter
 
@@ -52,13 +57,83 @@ func (s *Status) checkStatus() {
 	}
 
 	for _, member := range memberResponse.Members {
+		metrics.SetMemberStatus(uint64(member.GroupID), member.IsActive)
+
 		if !member.IsActive {
 			s.logger.Warn(":warning:group %d with member %s is inactive", member.GroupID, address)
-		} else {
-			s.logger.Debug(":white_check_mark:group %d with member %s is active", member.GroupID, address)
+			s.handleInactiveMember(member)
+			continue
 		}
 
-		metrics.SetMemberStatus(uint64(member.GroupID), member.IsActive)
+		s.logger.Debug(":white_check_mark:group %d with member %s is active", member.GroupID, address)
+
+		if a, ok := s.activations[member.GroupID]; ok {
+			s.logger.Info(
+				":tada: group %d with member %s is reactivated after %d attempt(s)",
+				member.GroupID,
+				address,
+				a.attempts,
+			)
+
+			metrics.IncActivationSuccessCount(uint64(member.GroupID))
+			metrics.SetActivationBackoffDuration(uint64(member.GroupID), 0)
+			delete(s.activations, member.GroupID)
+		}
+	}
+}
+
+// handleInactiveMember decides whether the inactive member should be activated and submits
+// the activation message to the sender if so.
+func (s *Status) handleInactiveMember(member bandtsstypes.Member) {
+	groupID := member.GroupID
+	logger := s.logger.With("group_id", groupID)
+
+	params, err := s.client.QueryBandtssParams()
+	if err != nil {
+		logger.Error(":cold_sweat: Failed to query bandtss params: %s", err)
+		return
+	}
+
+	reactivationTime := member.Since.Add(params.InactivePenaltyDuration)
+	metrics.SetMemberReactivationTime(uint64(groupID), float64(reactivationTime.Unix()))
+
+	deRes, err := s.client.QueryDE(member.Address, 0, 1)
+	if err != nil {
+		logger.Error(":cold_sweat: Failed to query DE information: %s", err)
+		return
+	}
+
+	now := time.Now()
+	a := s.activations[groupID]
+	deCount := deRes.GetRemaining()
+
+	decision := decide(now, reactivationTime, s.context.Config.AutoActivate, deCount, s.context.Config.MinDE, a)
+	metrics.IncActivationDecisionCount(uint64(groupID), string(decision))
+
+	switch decision {
+	case DecisionDisabled:
+		logger.Info(":zzz: Automatic activation is disabled; the member can be activated after %s", reactivationTime)
+	case DecisionPenalty:
+		logger.Info(":hourglass: Waiting for the inactive penalty to end at %s", reactivationTime)
+	case DecisionBackoff:
+		logger.Info(":hourglass: Backing off; next activation attempt at %s", a.nextAttempt)
+	case DecisionInsufficientDE:
+		logger.Warn(":warning: Waiting for DEs to be replenished before activating: %d/%d", deCount, s.context.Config.MinDE)
+	case DecisionActivate:
+		if a.attempts > 0 {
+			logger.Warn(":anxious_face_with_sweat: Previous activation attempt did not activate the member")
+			metrics.IncActivationFailureCount(uint64(groupID))
+		}
+
+		a.attempts++
+		wait := backoff(s.context.Config.CheckStatusInterval, a.attempts)
+		a.nextAttempt = now.Add(wait)
+		s.activations[groupID] = a
+
+		metrics.SetActivationBackoffDuration(uint64(groupID), wait.Seconds())
+
+		logger.Info(":rocket: Submitting activation message (attempt %d)", a.attempts)
+		s.context.MsgCh <- bandtsstypes.NewMsgActivate(member.Address, groupID)
 	}
 }
 
@@ -1,26 +1,98 @@
 package filecache
 
 import (
+	"container/list"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/peterbourgon/diskv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
+// DefaultMemoryCacheSize is the default maximum number of bytes of file content kept in memory.
+const DefaultMemoryCacheSize = 32 * 1024 * 1024 // 32MB
+
+// Options is the configuration of a file cache.
+type Options struct {
+	// MemoryCacheSize is the maximum number of bytes of file content kept in memory.
+	MemoryCacheSize uint64
+	// DiskSizeLimit is the maximum number of bytes of files kept on disk. When the limit is exceeded,
+	// the least recently used files are evicted. Zero means no limit, in which case no file is ever
+	// evicted. It must be zero if the files cannot be fetched again, e.g. on BandChain nodes.
+	DiskSizeLimit uint64
+}
+
+// DefaultOptions returns the default options of a file cache.
+func DefaultOptions() Options {
+	return Options{
+		MemoryCacheSize: DefaultMemoryCacheSize,
+		DiskSizeLimit:   0,
+	}
+}
+
+// Stats is the usage statistics of a file cache.
+type Stats struct {
+	Hits      uint64 // Number of files found in the cache
+	Misses    uint64 // Number of files not found in the cache
+	Evictions uint64 // Number of files evicted to keep the disk size under the limit
+	Files     uint64 // Number of files currently in the cache
+	DiskSize  uint64 // Total size in bytes of files currently in the cache
+}
+
 type Cache struct {
-	fileCache *diskv.Diskv
+	fileCache     *diskv.Diskv
+	basePath      string
+	diskSizeLimit uint64
+	usage         *usage
 }
 
-// New creates and returns a new file-backed data caching instance.
+// usage keeps track of files on disk in the least recently used order and the cache statistics.
+type usage struct {
+	mtx     sync.Mutex
+	order   *list.List // front is the most recently used file
+	entries map[string]*list.Element
+	size    uint64
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+type entry struct {
+	filename string
+	size     uint64
+}
+
+// New creates and returns a new file-backed data caching instance with the default options.
 func New(basePath string) Cache {
-	return Cache{
+	return NewWithOptions(basePath, DefaultOptions())
+}
+
+// NewWithOptions creates and returns a new file-backed data caching instance with the given options.
+func NewWithOptions(basePath string, opts Options) Cache {
+	c := Cache{
 		fileCache: diskv.New(diskv.Options{
 			BasePath:     basePath,
 			Transform:    func(s string) []string { return []string{} },
-			CacheSizeMax: 32 * 1024 * 1024, // 32MB TODO: Make this configurable
+			CacheSizeMax: opts.MemoryCacheSize,
 		}),
+		basePath:      basePath,
+		diskSizeLimit: opts.DiskSizeLimit,
+		usage: &usage{
+			order:   list.New(),
+			entries: make(map[string]*list.Element),
+		},
 	}
+	c.loadUsage()
+	return c
 }
 
 func GetFilename(data []byte) string {
@@ -28,12 +100,25 @@ func GetFilename(data []byte) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// IsFilename returns whether the given name is a valid name of a cached file.
+func IsFilename(name string) bool {
+	if len(name) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
+
 // AddFile saves the given data to a file in HOME/files directory using sha256 sum as filename.
 func (c Cache) AddFile(data []byte) string {
 	filename := GetFilename(data)
 	if !c.fileCache.Has(filename) {
-		_ = c.fileCache.Write(filename, data)
+		if err := c.fileCache.Write(filename, data); err != nil {
+			return filename
+		}
 	}
+	c.touch(filename, uint64(len(data)))
+	c.evict(filename)
 	return filename
 }
 
@@ -41,11 +126,15 @@ func (c Cache) AddFile(data []byte) string {
 func (c Cache) GetFile(filename string) ([]byte, error) {
 	data, err := c.fileCache.Read(filename)
 	if err != nil {
+		c.recordAccess(false)
 		return nil, err
 	}
 	if GetFilename(data) != filename { // Perform integrity check for safety. NEVER EXPECT TO HIT.
+		c.recordAccess(false)
 		return nil, errors.New("inconsistent filecache content")
 	}
+	c.recordAccess(true)
+	c.touch(filename, uint64(len(data)))
 	return data, nil
 }
 
@@ -57,3 +146,155 @@ func (c Cache) MustGetFile(filename string) []byte {
 	}
 	return data
 }
+
+// Filenames returns the names of all files in the file storage, sorted in ascending order.
+func (c Cache) Filenames() ([]string, error) {
+	dirEntries, err := os.ReadDir(c.basePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var filenames []string
+	for _, dirEntry := range dirEntries {
+		if dirEntry.Type().IsRegular() && IsFilename(dirEntry.Name()) {
+			filenames = append(filenames, dirEntry.Name())
+		}
+	}
+	return filenames, nil
+}
+
+// VerifyFile checks that the file exists in the file storage and its content matches its name.
+func (c Cache) VerifyFile(filename string) error {
+	// Read the file directly from disk to bypass the in-memory cache.
+	data, err := os.ReadFile(filepath.Join(c.basePath, filename))
+	if err != nil {
+		return err
+	}
+	if GetFilename(data) != filename {
+		return errors.New("inconsistent filecache content")
+	}
+	return nil
+}
+
+// RemoveFile removes the file from the file storage.
+func (c Cache) RemoveFile(filename string) error {
+	if err := c.fileCache.Erase(filename); err != nil {
+		return err
+	}
+
+	c.usage.mtx.Lock()
+	defer c.usage.mtx.Unlock()
+
+	c.removeEntry(filename)
+	return nil
+}
+
+// Stats returns the usage statistics of the cache.
+func (c Cache) Stats() Stats {
+	c.usage.mtx.Lock()
+	defer c.usage.mtx.Unlock()
+
+	return Stats{
+		Hits:      atomic.LoadUint64(&c.usage.hits),
+		Misses:    atomic.LoadUint64(&c.usage.misses),
+		Evictions: c.usage.evictions,
+		Files:     uint64(c.usage.order.Len()),
+		DiskSize:  c.usage.size,
+	}
+}
+
+// loadUsage loads the files on disk in the order of their last modification time, which is updated
+// whenever a file is used, so that the least recently used order survives restarts.
+func (c Cache) loadUsage() {
+	filenames, err := c.Filenames()
+	if err != nil {
+		return
+	}
+
+	type fileInfo struct {
+		filename string
+		size     uint64
+		modTime  time.Time
+	}
+	infos := make([]fileInfo, 0, len(filenames))
+	for _, filename := range filenames {
+		info, err := os.Stat(filepath.Join(c.basePath, filename))
+		if err != nil {
+			continue
+		}
+		infos = append(infos, fileInfo{filename, uint64(info.Size()), info.ModTime()})
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].modTime.After(infos[j].modTime)
+	})
+
+	for _, info := range infos {
+		c.usage.entries[info.filename] = c.usage.order.PushBack(&entry{info.filename, info.size})
+		c.usage.size += info.size
+	}
+}
+
+// touch marks the file as the most recently used one.
+func (c Cache) touch(filename string, size uint64) {
+	c.usage.mtx.Lock()
+	defer c.usage.mtx.Unlock()
+
+	if elem, ok := c.usage.entries[filename]; ok {
+		c.usage.order.MoveToFront(elem)
+	} else {
+		c.usage.entries[filename] = c.usage.order.PushFront(&entry{filename, size})
+		c.usage.size += size
+	}
+
+	// Only evictable caches need to keep the order on disk.
+	if c.diskSizeLimit > 0 {
+		now := time.Now()
+		_ = os.Chtimes(filepath.Join(c.basePath, filename), now, now)
+	}
+}
+
+// evict removes the least recently used files until the disk size is under the limit. The given
+// file is never evicted, even if it alone exceeds the limit.
+func (c Cache) evict(keep string) {
+	if c.diskSizeLimit == 0 {
+		return
+	}
+
+	c.usage.mtx.Lock()
+	defer c.usage.mtx.Unlock()
+
+	for elem := c.usage.order.Back(); elem != nil && c.usage.size > c.diskSizeLimit; {
+		prev := elem.Prev()
+		e := elem.Value.(*entry)
+		if e.filename != keep && c.fileCache.Erase(e.filename) == nil {
+			c.removeEntry(e.filename)
+			c.usage.evictions++
+			telemetry.IncrCounter(1, "filecache", "eviction")
+		}
+		elem = prev
+	}
+}
+
+// removeEntry removes the file from the usage tracking. The caller must hold the lock.
+func (c Cache) removeEntry(filename string) {
+	elem, ok := c.usage.entries[filename]
+	if !ok {
+		return
+	}
+	c.usage.size -= elem.Value.(*entry).size
+	c.usage.order.Remove(elem)
+	delete(c.usage.entries, filename)
+}
+
+func (c Cache) recordAccess(hit bool) {
+	if hit {
+		atomic.AddUint64(&c.usage.hits, 1)
+		telemetry.IncrCounter(1, "filecache", "hit")
+	} else {
+		atomic.AddUint64(&c.usage.misses, 1)
+		telemetry.IncrCounter(1, "filecache", "miss")
+	}
+}
@@ -1,9 +1,11 @@
 package filecache_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -143,3 +145,120 @@ func TestGetFileInconsistentContent(t *testing.T) {
 	_, err = f.GetFile(filename)
 	require.Error(t, err)
 }
+
+func TestAddFileEvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.NewWithOptions(dir, filecache.Options{MemoryCacheSize: 1024, DiskSizeLimit: 10})
+	file1 := f.AddFile([]byte("1111"))
+	file2 := f.AddFile([]byte("2222"))
+
+	// Use file1 so that file2 becomes the least recently used file.
+	_, err := f.GetFile(file1)
+	require.NoError(t, err)
+
+	file3 := f.AddFile([]byte("3333"))
+	_, err = os.Stat(filepath.Join(dir, file2))
+	require.True(t, os.IsNotExist(err))
+	_, err = f.GetFile(file2)
+	require.Error(t, err)
+
+	for _, filename := range []string{file1, file3} {
+		_, err := f.GetFile(filename)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, filecache.Stats{Hits: 3, Misses: 1, Evictions: 1, Files: 2, DiskSize: 8}, f.Stats())
+}
+
+func TestAddFileLargerThanDiskSizeLimit(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.NewWithOptions(dir, filecache.Options{DiskSizeLimit: 10})
+	file1 := f.AddFile([]byte("1111"))
+	file2 := f.AddFile([]byte("LARGER_THAN_LIMIT"))
+
+	_, err := f.GetFile(file1)
+	require.Error(t, err)
+	content, err := f.GetFile(file2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("LARGER_THAN_LIMIT"), content)
+}
+
+func TestAddFileWithoutDiskSizeLimit(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.New(dir)
+	for i := 0; i < 10; i++ {
+		f.AddFile([]byte(fmt.Sprintf("FILE_%d", i)))
+	}
+
+	stats := f.Stats()
+	require.Equal(t, uint64(10), stats.Files)
+	require.Equal(t, uint64(60), stats.DiskSize)
+	require.Equal(t, uint64(0), stats.Evictions)
+}
+
+func TestNewWithOptionsLoadsUsageFromDisk(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.NewWithOptions(dir, filecache.Options{DiskSizeLimit: 100})
+	file1 := f.AddFile([]byte("1111"))
+	file2 := f.AddFile([]byte("2222"))
+
+	// Make file1 the least recently used file as if it was used long ago.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, file1), past, past))
+
+	f = filecache.NewWithOptions(dir, filecache.Options{DiskSizeLimit: 10})
+	require.Equal(t, filecache.Stats{Files: 2, DiskSize: 8}, f.Stats())
+
+	f.AddFile([]byte("3333"))
+	_, err := os.Stat(filepath.Join(dir, file1))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, file2))
+	require.NoError(t, err)
+}
+
+func TestFilenamesAndRemoveFile(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.New(dir)
+	filenames, err := f.Filenames()
+	require.NoError(t, err)
+	require.Empty(t, filenames)
+
+	file1 := f.AddFile([]byte("BAND"))
+	file2 := f.AddFile([]byte("HELLO_WORLD"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "not_a_file_in_cache"), []byte("BAND"), 0o600))
+
+	filenames, err = f.Filenames()
+	require.NoError(t, err)
+	require.Equal(t, []string{file1, file2}, filenames)
+
+	require.NoError(t, f.RemoveFile(file1))
+	require.Error(t, f.RemoveFile(file1))
+	_, err = f.GetFile(file1)
+	require.Error(t, err)
+
+	filenames, err = f.Filenames()
+	require.NoError(t, err)
+	require.Equal(t, []string{file2}, filenames)
+	require.Equal(t, uint64(11), f.Stats().DiskSize)
+}
+
+func TestVerifyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.New(dir)
+	filename := f.AddFile([]byte("BAND"))
+	require.NoError(t, f.VerifyFile(filename))
+
+	// Corrupt the file behind the in-memory cache.
+	_, err := f.GetFile(filename)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, filename), []byte("NOT_BAND"), 0o600))
+	require.EqualError(t, f.VerifyFile(filename), "inconsistent filecache content")
+
+	require.Error(t, f.VerifyFile("b20727a9b7cc4198d8785b0ef1fa4c774eb9a360e1563dd4f095ddc7af02bd55"))
+}
@@ -0,0 +1,451 @@
+package obi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Type is an OBI type parsed from a schema string. It encodes and decodes OBI bytes without a Go
+// struct, using the generic values below:
+//   - u8, u16, u32, u64, i8, i16, i32 and i64: uint8, uint16, uint32, uint64, int8, int16, int32
+//     and int64; any integer, json.Number or decimal string within range is accepted to encode.
+//   - string: string.
+//   - bytes: []byte; a hex string is accepted to encode.
+//   - [T]: []interface{}; any slice is accepted to encode.
+//   - {name:T,...}: map[string]interface{} with exactly the fields of the struct.
+type Type struct {
+	kind   string
+	elem   *Type
+	fields []Field
+}
+
+// Field is a named field of an OBI struct type.
+type Field struct {
+	Name string
+	Type *Type
+}
+
+// primitiveKinds are the kinds of OBI types that have no inner type.
+var primitiveKinds = map[string]bool{
+	"u8": true, "u16": true, "u32": true, "u64": true,
+	"i8": true, "i16": true, "i32": true, "i64": true,
+	"string": true, "bytes": true,
+}
+
+const (
+	arrayKind  = "array"
+	structKind = "struct"
+)
+
+// ParseSchema parses the given compact OBI individual schema (e.g. {symbols:[string],multiplier:u64})
+// into a Type. Whitespaces are ignored.
+func ParseSchema(schema string) (*Type, error) {
+	s := strings.Join(strings.Fields(schema), "")
+
+	t, rem, err := parseType(s)
+	if err != nil {
+		return nil, err
+	}
+	if rem != "" {
+		return nil, fmt.Errorf("obi: unexpected %q at the end of schema", rem)
+	}
+	return t, nil
+}
+
+// MustParseSchema parses the given compact OBI individual schema into a Type. Panics on error.
+func MustParseSchema(schema string) *Type {
+	t, err := ParseSchema(schema)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// ParseScriptSchema parses the schema of an oracle script, which is the input and output schemas
+// separated by "/", into the input and output Types.
+func ParseScriptSchema(schema string) (input *Type, output *Type, err error) {
+	inputSchema, outputSchema, found := strings.Cut(schema, "/")
+	if !found {
+		return nil, nil, errors.New("obi: no input and output separator found in schema")
+	}
+
+	input, err = ParseSchema(inputSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	output, err = ParseSchema(outputSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return input, output, nil
+}
+
+func parseType(s string) (*Type, string, error) {
+	switch {
+	case strings.HasPrefix(s, "["):
+		elem, rem, err := parseType(s[1:])
+		if err != nil {
+			return nil, "", err
+		}
+		if !strings.HasPrefix(rem, "]") {
+			return nil, "", fmt.Errorf("obi: expect ] at %q", rem)
+		}
+		return &Type{kind: arrayKind, elem: elem}, rem[1:], nil
+
+	case strings.HasPrefix(s, "{"):
+		var fields []Field
+		names := make(map[string]bool)
+		rem := s[1:]
+		for {
+			name, afterName, found := strings.Cut(rem, ":")
+			if !found || !isValidFieldName(name) {
+				return nil, "", fmt.Errorf("obi: expect field name at %q", rem)
+			}
+			if names[name] {
+				return nil, "", fmt.Errorf("obi: duplicate field name %s", name)
+			}
+			names[name] = true
+
+			fieldType, afterType, err := parseType(afterName)
+			if err != nil {
+				return nil, "", err
+			}
+			fields = append(fields, Field{Name: name, Type: fieldType})
+
+			switch {
+			case strings.HasPrefix(afterType, ","):
+				rem = afterType[1:]
+			case strings.HasPrefix(afterType, "}"):
+				return &Type{kind: structKind, fields: fields}, afterType[1:], nil
+			default:
+				return nil, "", fmt.Errorf("obi: expect , or } at %q", afterType)
+			}
+		}
+
+	default:
+		end := strings.IndexAny(s, ",]}")
+		if end == -1 {
+			end = len(s)
+		}
+		if !primitiveKinds[s[:end]] {
+			return nil, "", fmt.Errorf("obi: unsupported type %q", s[:end])
+		}
+		return &Type{kind: s[:end]}, s[end:], nil
+	}
+}
+
+func isValidFieldName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !(c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the compact OBI individual schema of the type.
+func (t *Type) String() string {
+	switch t.kind {
+	case arrayKind:
+		return "[" + t.elem.String() + "]"
+	case structKind:
+		fields := make([]string, 0, len(t.fields))
+		for _, f := range t.fields {
+			fields = append(fields, f.Name+":"+f.Type.String())
+		}
+		return "{" + strings.Join(fields, ",") + "}"
+	default:
+		return t.kind
+	}
+}
+
+// Fields returns the fields of the struct type, or nil if the type is not a struct.
+func (t *Type) Fields() []Field {
+	return t.fields
+}
+
+// Encode uses obi encoding scheme to encode the given generic value of the type into bytes.
+func (t *Type) Encode(v interface{}) ([]byte, error) {
+	switch t.kind {
+	case "u8", "u16", "u32", "u64":
+		bitSize, _ := strconv.Atoi(t.kind[1:])
+		n, err := toUnsigned(v, bitSize)
+		if err != nil {
+			return nil, err
+		}
+		// the value fits in the bit size, so keep only its lower bytes in big-endian order.
+		return EncodeUnsigned64(n)[8-bitSize/8:], nil
+	case "i8", "i16", "i32", "i64":
+		bitSize, _ := strconv.Atoi(t.kind[1:])
+		n, err := toSigned(v, bitSize)
+		if err != nil {
+			return nil, err
+		}
+		return EncodeSigned64(n)[8-bitSize/8:], nil
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("obi: invalid string value %v", v)
+		}
+		return EncodeString(s), nil
+	case "bytes":
+		switch b := v.(type) {
+		case []byte:
+			return EncodeBytes(b), nil
+		case string:
+			decoded, err := hex.DecodeString(strings.TrimPrefix(b, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("obi: invalid bytes value %q: %w", b, err)
+			}
+			return EncodeBytes(decoded), nil
+		default:
+			return nil, fmt.Errorf("obi: invalid bytes value %v", v)
+		}
+	case arrayKind:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("obi: invalid array value %v", v)
+		}
+		res := EncodeUnsigned32(uint32(rv.Len()))
+		for idx := 0; idx < rv.Len(); idx++ {
+			each, err := t.elem.Encode(rv.Index(idx).Interface())
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, each...)
+		}
+		return res, nil
+	case structKind:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("obi: invalid struct value %v", v)
+		}
+		if len(m) != len(t.fields) {
+			return nil, fmt.Errorf("obi: expect %d fields, got %d", len(t.fields), len(m))
+		}
+		res := []byte{}
+		for _, f := range t.fields {
+			fv, ok := m[f.Name]
+			if !ok {
+				return nil, fmt.Errorf("obi: missing field %s", f.Name)
+			}
+			each, err := f.Type.Encode(fv)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, each...)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("obi: unsupported type %q", t.kind)
+	}
+}
+
+// Decode uses obi encoding scheme to decode the given bytes into a generic value of the type.
+func (t *Type) Decode(data []byte) (interface{}, error) {
+	v, rem, err := t.decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rem) != 0 {
+		return nil, errors.New("obi: not all data was consumed while decoding")
+	}
+	return v, nil
+}
+
+func (t *Type) decode(data []byte) (interface{}, []byte, error) {
+	switch t.kind {
+	case "u8":
+		return DecodeUnsigned8(data)
+	case "u16":
+		return DecodeUnsigned16(data)
+	case "u32":
+		return DecodeUnsigned32(data)
+	case "u64":
+		return DecodeUnsigned64(data)
+	case "i8":
+		return DecodeSigned8(data)
+	case "i16":
+		return DecodeSigned16(data)
+	case "i32":
+		return DecodeSigned32(data)
+	case "i64":
+		return DecodeSigned64(data)
+	case "string":
+		return DecodeString(data)
+	case "bytes":
+		return DecodeBytes(data)
+	case arrayKind:
+		length, rem, err := DecodeUnsigned32(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		// each element takes at least one byte, so cap the allocation by the remaining data.
+		res := make([]interface{}, 0, min(int(length), len(rem)))
+		for idx := 0; idx < int(length); idx++ {
+			var each interface{}
+			each, rem, err = t.elem.decode(rem)
+			if err != nil {
+				return nil, nil, err
+			}
+			res = append(res, each)
+		}
+		return res, rem, nil
+	case structKind:
+		res := make(map[string]interface{}, len(t.fields))
+		rem := data
+		for _, f := range t.fields {
+			var (
+				each interface{}
+				err  error
+			)
+			each, rem, err = f.Type.decode(rem)
+			if err != nil {
+				return nil, nil, err
+			}
+			res[f.Name] = each
+		}
+		return res, rem, nil
+	default:
+		return nil, nil, fmt.Errorf("obi: unsupported type %q", t.kind)
+	}
+}
+
+// EncodeJSON uses obi encoding scheme to encode the given JSON value of the type into bytes.
+// Integers may be JSON numbers or decimal strings and bytes are hex strings.
+func (t *Type) EncodeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("obi: invalid JSON: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("obi: unexpected data after JSON value")
+	}
+	return t.Encode(v)
+}
+
+// DecodeJSON uses obi encoding scheme to decode the given bytes into a JSON value of the type.
+// Struct fields keep the order of the schema and bytes are rendered as hex strings.
+func (t *Type) DecodeJSON(data []byte) ([]byte, error) {
+	v, err := t.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := t.writeJSON(buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (t *Type) writeJSON(buf *bytes.Buffer, v interface{}) error {
+	switch t.kind {
+	case "bytes":
+		return writeJSONValue(buf, hex.EncodeToString(v.([]byte)))
+	case arrayKind:
+		buf.WriteString("[")
+		for idx, each := range v.([]interface{}) {
+			if idx != 0 {
+				buf.WriteString(",")
+			}
+			if err := t.elem.writeJSON(buf, each); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("]")
+		return nil
+	case structKind:
+		m := v.(map[string]interface{})
+		buf.WriteString("{")
+		for idx, f := range t.fields {
+			if idx != 0 {
+				buf.WriteString(",")
+			}
+			if err := writeJSONValue(buf, f.Name); err != nil {
+				return err
+			}
+			buf.WriteString(":")
+			if err := f.Type.writeJSON(buf, m[f.Name]); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("}")
+		return nil
+	default:
+		return writeJSONValue(buf, v)
+	}
+}
+
+// writeJSONValue writes the JSON encoding of the given value without escaping HTML characters.
+func writeJSONValue(buf *bytes.Buffer, v interface{}) error {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	// Encode terminates each value with a newline.
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
+
+// toUnsigned converts the given integer value into uint64 if it fits in the given bit size.
+func toUnsigned(v interface{}, bitSize int) (uint64, error) {
+	s, err := toDecimalString(v)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("obi: invalid u%d value %v", bitSize, v)
+	}
+	return n, nil
+}
+
+// toSigned converts the given integer value into int64 if it fits in the given bit size.
+func toSigned(v interface{}, bitSize int) (int64, error) {
+	s, err := toDecimalString(v)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("obi: invalid i%d value %v", bitSize, v)
+	}
+	return n, nil
+}
+
+func toDecimalString(v interface{}) (string, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return string(n), nil
+	case string:
+		return n, nil
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	default:
+		return "", fmt.Errorf("obi: invalid integer value %v", v)
+	}
+}
@@ -0,0 +1,200 @@
+package obi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type ExamplePriceInput struct {
+	Symbols    []string `obi:"symbols"`
+	Multiplier uint64   `obi:"multiplier"`
+}
+
+type ExampleMixedData struct {
+	Name   string      `obi:"name"`
+	Raw    []byte      `obi:"raw"`
+	Delta  int16       `obi:"delta"`
+	Points []AllData   `obi:"points"`
+	IDs    [][]Int8ID  `obi:"ids"`
+	Inner  ExampleInfo `obi:"inner"`
+}
+
+type ExampleInfo struct {
+	Flag uint8 `obi:"flag"`
+}
+
+func TestParseSchema(t *testing.T) {
+	testCases := []struct {
+		schema   string
+		expected string
+	}{
+		{"u64", "u64"},
+		{"[string]", "[string]"},
+		{" { symbols : [ string ] ,\n multiplier : u64 } ", "{symbols:[string],multiplier:u64}"},
+		{MustGetSchema(ExampleMixedData{}), MustGetSchema(ExampleMixedData{})},
+		{"[[{a_1:bytes,b:i32}]]", "[[{a_1:bytes,b:i32}]]"},
+	}
+
+	for _, tc := range testCases {
+		actual, err := ParseSchema(tc.schema)
+		require.NoError(t, err, tc.schema)
+		require.Equal(t, tc.expected, actual.String())
+	}
+}
+
+func TestParseSchemaFail(t *testing.T) {
+	testCases := []struct {
+		schema string
+		errStr string
+	}{
+		{"", `obi: unsupported type ""`},
+		{"u128", `obi: unsupported type "u128"`},
+		{"bool", `obi: unsupported type "bool"`},
+		{"[u8", `obi: expect ] at ""`},
+		{"{}", `obi: expect field name at "}"`},
+		{"{a-b:u8}", `obi: expect field name at "a-b:u8}"`},
+		{"{a:u8,a:u16}", "obi: duplicate field name a"},
+		{"{a:u8", `obi: expect , or } at ""`},
+		{"u8,u8", `obi: unexpected ",u8" at the end of schema`},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseSchema(tc.schema)
+		require.EqualError(t, err, tc.errStr, tc.schema)
+	}
+}
+
+func TestParseScriptSchema(t *testing.T) {
+	input, output, err := ParseScriptSchema("{symbols:[string],multiplier:u64}/{rates:[u64]}")
+	require.NoError(t, err)
+	require.Equal(t, "{symbols:[string],multiplier:u64}", input.String())
+	require.Equal(t, "{rates:[u64]}", output.String())
+	require.Equal(t, []Field{{Name: "rates", Type: MustParseSchema("[u64]")}}, output.Fields())
+
+	_, _, err = ParseScriptSchema("{symbols:[string],multiplier:u64}")
+	require.EqualError(t, err, "obi: no input and output separator found in schema")
+
+	_, _, err = ParseScriptSchema("{symbols:[string]}/{rates:[u64]}/u8")
+	require.EqualError(t, err, `obi: unexpected "/u8" at the end of schema`)
+}
+
+func TestDynamicEncodeDecodeMatchesReflection(t *testing.T) {
+	data := ExampleMixedData{
+		Name:  "band",
+		Raw:   []byte{0xde, 0xad},
+		Delta: -12,
+		Points: []AllData{
+			{1, 2, 3, 4, -5, -6, -7, -8},
+			{255, 65535, 4294967295, 18446744073709551615, -128, -32768, -2147483648, -9223372036854775808},
+		},
+		IDs:   [][]Int8ID{{1, -1}, {}},
+		Inner: ExampleInfo{Flag: 7},
+	}
+	expected := MustEncode(data)
+
+	typ := MustParseSchema(MustGetSchema(data))
+	decoded, err := typ.Decode(expected)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"name":  "band",
+		"raw":   []byte{0xde, 0xad},
+		"delta": int16(-12),
+		"points": []interface{}{
+			map[string]interface{}{
+				"numUint8": uint8(1), "numUint16": uint16(2), "numUint32": uint32(3), "numUint64": uint64(4),
+				"numInt8": int8(-5), "numInt16": int16(-6), "numInt32": int32(-7), "numInt64": int64(-8),
+			},
+			map[string]interface{}{
+				"numUint8":  uint8(255),
+				"numUint16": uint16(65535),
+				"numUint32": uint32(4294967295),
+				"numUint64": uint64(18446744073709551615),
+				"numInt8":   int8(-128),
+				"numInt16":  int16(-32768),
+				"numInt32":  int32(-2147483648),
+				"numInt64":  int64(-9223372036854775808),
+			},
+		},
+		"ids":   []interface{}{[]interface{}{int8(1), int8(-1)}, []interface{}{}},
+		"inner": map[string]interface{}{"flag": uint8(7)},
+	}, decoded)
+
+	encoded, err := typ.Encode(decoded)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	typ := MustParseSchema(MustGetSchema(ExamplePriceInput{}))
+	expected := MustEncode(ExamplePriceInput{Symbols: []string{"BTC", "ETH"}, Multiplier: 1000000000})
+
+	encoded, err := typ.EncodeJSON([]byte(`{"symbols":["BTC","ETH"],"multiplier":1000000000}`))
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	// integers can be given as decimal strings.
+	encoded, err = typ.EncodeJSON([]byte(`{"multiplier":"1000000000","symbols":["BTC","ETH"]}`))
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	encoded, err = MustParseSchema("{raw:bytes,n:u64}").EncodeJSON([]byte(`{"raw":"0xdead","n":18446744073709551615}`))
+	require.NoError(t, err)
+	require.Equal(t, MustEncode([]byte{0xde, 0xad}, uint64(18446744073709551615)), encoded)
+}
+
+func TestEncodeJSONFail(t *testing.T) {
+	testCases := []struct {
+		schema string
+		json   string
+		errStr string
+	}{
+		{"u8", `256`, "obi: invalid u8 value 256"},
+		{"u64", `-1`, "obi: invalid u64 value -1"},
+		{"i8", `1.5`, "obi: invalid i8 value 1.5"},
+		{"u32", `true`, "obi: invalid integer value true"},
+		{"string", `1`, "obi: invalid string value 1"},
+		{"bytes", `"xyz"`, `obi: invalid bytes value "xyz": encoding/hex: invalid byte: U+0078 'x'`},
+		{"[u8]", `{}`, "obi: invalid array value map[]"},
+		{"{a:u8}", `[]`, "obi: invalid struct value []"},
+		{"{a:u8}", `{"b":1}`, "obi: missing field a"},
+		{"{a:u8}", `{"a":1,"b":2}`, "obi: expect 1 fields, got 2"},
+		{"u8", `1 2`, "obi: unexpected data after JSON value"},
+		{"u8", `{`, "obi: invalid JSON: unexpected EOF"},
+	}
+
+	for _, tc := range testCases {
+		_, err := MustParseSchema(tc.schema).EncodeJSON([]byte(tc.json))
+		require.EqualError(t, err, tc.errStr, tc.json)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	data := MustEncode(ExampleMixedData{
+		Name:   "<band>",
+		Raw:    []byte{0xde, 0xad},
+		Delta:  -12,
+		Points: []AllData{},
+		IDs:    [][]Int8ID{{1, -1}},
+		Inner:  ExampleInfo{Flag: 7},
+	})
+
+	decoded, err := MustParseSchema(MustGetSchema(ExampleMixedData{})).DecodeJSON(data)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`{"name":"<band>","raw":"dead","delta":-12,"points":[],"ids":[[1,-1]],"inner":{"flag":7}}`,
+		string(decoded),
+	)
+}
+
+func TestDecodeJSONFail(t *testing.T) {
+	_, err := MustParseSchema("{a:u8,b:string}").DecodeJSON([]byte{0x01, 0x00, 0x00, 0x00, 0x05, 0x61})
+	require.EqualError(t, err, "obi: out of range")
+
+	_, err = MustParseSchema("u8").DecodeJSON([]byte{0x01, 0x02})
+	require.EqualError(t, err, "obi: not all data was consumed while decoding")
+
+	_, err = MustParseSchema("[u64]").DecodeJSON([]byte{0xff, 0xff, 0xff, 0xff})
+	require.EqualError(t, err, "obi: out of range")
+}
@@ -0,0 +1,111 @@
+package rpcpool
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Pool can be used as the client of a client.Context; every request is sent to the current node.
+var _ client.CometRPC = &Pool{}
+
+// ABCIInfo implements client.CometRPC.
+func (p *Pool) ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error) {
+	return p.client().ABCIInfo(ctx)
+}
+
+// ABCIQuery implements client.CometRPC.
+func (p *Pool) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return p.client().ABCIQuery(ctx, path, data)
+}
+
+// ABCIQueryWithOptions implements client.CometRPC.
+func (p *Pool) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	return p.client().ABCIQueryWithOptions(ctx, path, data, opts)
+}
+
+// BroadcastTxCommit implements client.CometRPC.
+func (p *Pool) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return p.client().BroadcastTxCommit(ctx, tx)
+}
+
+// BroadcastTxAsync implements client.CometRPC.
+func (p *Pool) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return p.client().BroadcastTxAsync(ctx, tx)
+}
+
+// BroadcastTxSync implements client.CometRPC.
+func (p *Pool) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return p.client().BroadcastTxSync(ctx, tx)
+}
+
+// Validators implements client.CometRPC.
+func (p *Pool) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	return p.client().Validators(ctx, height, page, perPage)
+}
+
+// Status implements client.CometRPC.
+func (p *Pool) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	return p.client().Status(ctx)
+}
+
+// Block implements client.CometRPC.
+func (p *Pool) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	return p.client().Block(ctx, height)
+}
+
+// BlockByHash implements client.CometRPC.
+func (p *Pool) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	return p.client().BlockByHash(ctx, hash)
+}
+
+// BlockResults implements client.CometRPC.
+func (p *Pool) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return p.client().BlockResults(ctx, height)
+}
+
+// BlockchainInfo implements client.CometRPC.
+func (p *Pool) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
+	return p.client().BlockchainInfo(ctx, minHeight, maxHeight)
+}
+
+// Commit implements client.CometRPC.
+func (p *Pool) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	return p.client().Commit(ctx, height)
+}
+
+// Tx implements client.CometRPC.
+func (p *Pool) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	return p.client().Tx(ctx, hash, prove)
+}
+
+// TxSearch implements client.CometRPC.
+func (p *Pool) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return p.client().TxSearch(ctx, query, prove, page, perPage, orderBy)
+}
+
+// BlockSearch implements client.CometRPC.
+func (p *Pool) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	return p.client().BlockSearch(ctx, query, page, perPage, orderBy)
+}
@@ -0,0 +1,75 @@
+package rpcpool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// dedup remembers the most recently seen keys, up to its capacity.
+type dedup struct {
+	mtx   sync.Mutex
+	seen  map[string]struct{}
+	order []string
+	next  int
+}
+
+// newDedup creates a new dedup that remembers up to capacity keys.
+func newDedup(capacity int) *dedup {
+	return &dedup{
+		seen:  make(map[string]struct{}, capacity),
+		order: make([]string, capacity),
+	}
+}
+
+// add records the key. It returns false if the key has already been seen.
+func (d *dedup) add(key string) bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+
+	// evict the oldest key to make room for the new one
+	if oldest := d.order[d.next]; oldest != "" {
+		delete(d.seen, oldest)
+	}
+
+	d.seen[key] = struct{}{}
+	d.order[d.next] = key
+	d.next = (d.next + 1) % len(d.order)
+
+	return true
+}
+
+// eventKey returns the key that identifies the event across nodes.
+func eventKey(ev ctypes.ResultEvent) string {
+	switch data := ev.Data.(type) {
+	case cmttypes.EventDataTx:
+		return fmt.Sprintf("tx/%d/%d", data.Height, data.Index)
+	case cmttypes.EventDataNewBlock:
+		return fmt.Sprintf("block/%d", data.Block.Height)
+	case cmttypes.EventDataNewBlockEvents:
+		return fmt.Sprintf("block-events/%d", data.Height)
+	}
+
+	// fall back to the attributes of the event
+	keys := make([]string, 0, len(ev.Events))
+	for k := range ev.Events {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(ev.Query)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "/%s=%s", k, strings.Join(ev.Events[k], ","))
+	}
+
+	return sb.String()
+}
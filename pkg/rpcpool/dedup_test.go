@@ -0,0 +1,52 @@
+package rpcpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+func TestDedup(t *testing.T) {
+	d := newDedup(2)
+
+	require.True(t, d.add("a"))
+	require.False(t, d.add("a"))
+	require.True(t, d.add("b"))
+
+	// "a" is evicted to make room for "c"
+	require.True(t, d.add("c"))
+	require.True(t, d.add("a"))
+	require.False(t, d.add("c"))
+}
+
+func TestEventKey(t *testing.T) {
+	txEvent := func(height int64, index uint32) ctypes.ResultEvent {
+		return ctypes.ResultEvent{
+			Query: "tm.event = 'Tx'",
+			Data:  cmttypes.EventDataTx{TxResult: abci.TxResult{Height: height, Index: index}},
+		}
+	}
+
+	require.Equal(t, eventKey(txEvent(10, 1)), eventKey(txEvent(10, 1)))
+	require.NotEqual(t, eventKey(txEvent(10, 1)), eventKey(txEvent(10, 2)))
+	require.NotEqual(t, eventKey(txEvent(10, 1)), eventKey(txEvent(11, 1)))
+
+	other := func(events map[string][]string) ctypes.ResultEvent {
+		return ctypes.ResultEvent{Query: "tm.event = 'ValidatorSetUpdates'", Events: events}
+	}
+
+	require.Equal(
+		t,
+		eventKey(other(map[string][]string{"a": {"1"}, "b": {"2"}})),
+		eventKey(other(map[string][]string{"b": {"2"}, "a": {"1"}})),
+	)
+	require.NotEqual(
+		t,
+		eventKey(other(map[string][]string{"a": {"1"}})),
+		eventKey(other(map[string][]string{"a": {"2"}})),
+	)
+}
@@ -0,0 +1,59 @@
+package rpcpool
+
+import (
+	"time"
+)
+
+// Health is the health of a node at the last check.
+type Health struct {
+	Height     int64     // Latest block height of the node
+	BlockTime  time.Time // Time of the latest block of the node
+	CatchingUp bool      // Whether the node is still syncing
+	Err        error     // Error of the last check, if any
+}
+
+// IsHealthy checks whether the node responded, is synced and has produced a block within the stall timeout.
+func (h Health) IsHealthy(now time.Time, stallTimeout time.Duration) bool {
+	return h.Err == nil && !h.CatchingUp && now.Sub(h.BlockTime) <= stallTimeout
+}
+
+// selectNode returns the index of the node to use. It keeps the current node as long as it is healthy
+// and not lagging behind the highest healthy node by more than maxHeightLag blocks. If no node is
+// healthy, e.g. the chain is halted, the current node is kept unless it doesn't respond.
+func selectNode(healths []Health, current int, now time.Time, stallTimeout time.Duration) int {
+	best := highest(healths, func(h Health) bool { return h.IsHealthy(now, stallTimeout) })
+	if best == -1 {
+		if healths[current].Err == nil {
+			return current
+		}
+
+		if reachable := highest(healths, func(h Health) bool { return h.Err == nil }); reachable != -1 {
+			return reachable
+		}
+
+		return current
+	}
+
+	if healths[current].IsHealthy(now, stallTimeout) && healths[best].Height-healths[current].Height <= maxHeightLag {
+		return current
+	}
+
+	return best
+}
+
+// highest returns the index of the node with the highest block height among the nodes that satisfy
+// the filter, or -1 if there is none.
+func highest(healths []Health, filter func(Health) bool) int {
+	best := -1
+	for i, h := range healths {
+		if !filter(h) {
+			continue
+		}
+
+		if best == -1 || h.Height > healths[best].Height {
+			best = i
+		}
+	}
+
+	return best
+}
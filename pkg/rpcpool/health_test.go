@@ -0,0 +1,90 @@
+package rpcpool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectNode(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	stallTimeout := 30 * time.Second
+
+	healthy := func(height int64) Health {
+		return Health{Height: height, BlockTime: now.Add(-time.Second)}
+	}
+	stalled := func(height int64) Health {
+		return Health{Height: height, BlockTime: now.Add(-time.Minute)}
+	}
+	down := Health{Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name     string
+		healths  []Health
+		current  int
+		expected int
+	}{
+		{
+			name:     "keep healthy current node",
+			healths:  []Health{healthy(100), healthy(101)},
+			current:  0,
+			expected: 0,
+		},
+		{
+			name:     "keep current node lagging within the limit",
+			healths:  []Health{healthy(100), healthy(100 + maxHeightLag)},
+			current:  0,
+			expected: 0,
+		},
+		{
+			name:     "switch from lagging node",
+			healths:  []Health{healthy(100), healthy(101 + maxHeightLag)},
+			current:  0,
+			expected: 1,
+		},
+		{
+			name:     "switch from stalled node",
+			healths:  []Health{stalled(100), healthy(99), healthy(100)},
+			current:  0,
+			expected: 2,
+		},
+		{
+			name:     "switch from node that is down",
+			healths:  []Health{healthy(100), down},
+			current:  1,
+			expected: 0,
+		},
+		{
+			name:     "switch from catching up node",
+			healths:  []Health{{Height: 100, BlockTime: now, CatchingUp: true}, healthy(90)},
+			current:  0,
+			expected: 1,
+		},
+		{
+			name:     "keep current node when all are stalled",
+			healths:  []Health{stalled(100), stalled(101)},
+			current:  0,
+			expected: 0,
+		},
+		{
+			name:     "switch to reachable node when all are stalled",
+			healths:  []Health{down, stalled(100)},
+			current:  0,
+			expected: 1,
+		},
+		{
+			name:     "keep current node when all are down",
+			healths:  []Health{down, down},
+			current:  1,
+			expected: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, selectNode(tc.healths, tc.current, now, stallTimeout))
+		})
+	}
+}
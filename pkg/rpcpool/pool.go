@@ -0,0 +1,341 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	httpclient "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default interval of checking the health of the nodes.
+	DefaultHealthCheckInterval = 5 * time.Second
+	// DefaultStallTimeout is the default duration after the latest block that a node is considered stalled.
+	DefaultStallTimeout = 30 * time.Second
+
+	// maxHeightLag is the number of blocks that the current node may lag behind before switching.
+	maxHeightLag = 3
+	// dedupCapacity is the number of recent events that are remembered per subscription.
+	dedupCapacity = 10_000
+	// requestTimeout is the timeout of health checks and subscription requests.
+	requestTimeout = 5 * time.Second
+)
+
+// ErrNoNode is returned when none of the nodes can be started.
+var ErrNoNode = errors.New("no node is available")
+
+// node is a BandChain node that the pool connects to.
+type node struct {
+	uri    string
+	client *httpclient.HTTP
+}
+
+// subscription is an event subscription that follows the current node of the pool.
+type subscription struct {
+	subscriber string
+	query      string
+	out        chan ctypes.ResultEvent
+	seen       *dedup
+
+	node   int                // index of the node that the events are forwarded from
+	cancel context.CancelFunc // stops forwarding the events from the node
+}
+
+// Pool is a client of multiple BandChain nodes. Requests are sent to the healthiest node, and
+// subscriptions are moved to another node when the current one stalls.
+type Pool struct {
+	logger              *logger.Logger
+	healthCheckInterval time.Duration
+	stallTimeout        time.Duration
+
+	mtx     sync.RWMutex
+	nodes   []*node
+	current int
+	subs    []*subscription
+
+	quit chan struct{}
+}
+
+// ParseNodeURIs splits the comma-separated node URIs.
+func ParseNodeURIs(s string) []string {
+	var uris []string
+	for _, uri := range strings.Split(s, ",") {
+		if uri = strings.TrimSpace(uri); uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+
+	return uris
+}
+
+// New creates a new pool of the given node URIs.
+func New(uris []string, logger *logger.Logger) (*Pool, error) {
+	if len(uris) == 0 {
+		return nil, ErrNoNode
+	}
+
+	nodes := make([]*node, 0, len(uris))
+	for _, uri := range uris {
+		c, err := httpclient.New(uri, "/websocket")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client of node %s: %w", uri, err)
+		}
+
+		nodes = append(nodes, &node{uri: uri, client: c})
+	}
+
+	return &Pool{
+		logger:              logger.With("module", "rpcpool"),
+		healthCheckInterval: DefaultHealthCheckInterval,
+		stallTimeout:        DefaultStallTimeout,
+		nodes:               nodes,
+		quit:                make(chan struct{}),
+	}, nil
+}
+
+// Start connects to the nodes, selects the healthiest one and starts checking the health of the nodes.
+// It returns an error if none of the nodes can be connected.
+func (p *Pool) Start() error {
+	started := 0
+	for _, n := range p.nodes {
+		if err := n.client.Start(); err != nil {
+			p.logger.Warn(":warning: Failed to connect to node %s: %s", n.uri, err)
+			continue
+		}
+
+		started++
+	}
+
+	if started == 0 {
+		return ErrNoNode
+	}
+
+	p.checkHealth()
+	go p.monitor()
+
+	return nil
+}
+
+// Stop stops checking the health of the nodes and disconnects from them.
+func (p *Pool) Stop() error {
+	close(p.quit)
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, sub := range p.subs {
+		sub.cancel()
+	}
+	p.subs = nil
+
+	var errs []error
+	for _, n := range p.nodes {
+		if !n.client.IsRunning() {
+			continue
+		}
+
+		if err := n.client.Stop(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Current returns the URI of the node that requests are sent to.
+func (p *Pool) Current() string {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.nodes[p.current].uri
+}
+
+// client returns the client of the node that requests are sent to.
+func (p *Pool) client() *httpclient.HTTP {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.nodes[p.current].client
+}
+
+// Subscribe subscribes to the events of the query. The events keep coming from the current node
+// after a failover, and events that are received from more than one node are delivered once.
+func (p *Pool) Subscribe(
+	ctx context.Context,
+	subscriber, query string,
+	outCapacity ...int,
+) (<-chan ctypes.ResultEvent, error) {
+	outCap := 1
+	if len(outCapacity) > 0 {
+		outCap = outCapacity[0]
+	}
+
+	sub := &subscription{
+		subscriber: subscriber,
+		query:      query,
+		out:        make(chan ctypes.ResultEvent, outCap),
+		seen:       newDedup(dedupCapacity),
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if err := p.subscribe(ctx, sub, p.current); err != nil {
+		return nil, err
+	}
+	p.subs = append(p.subs, sub)
+
+	return sub.out, nil
+}
+
+// subscribe subscribes to the events of the subscription on the given node, and stops forwarding
+// the events from the previous node once the new subscription is established. It must be called
+// with the lock held.
+func (p *Pool) subscribe(ctx context.Context, sub *subscription, idx int) error {
+	n := p.nodes[idx]
+	in, err := n.client.Subscribe(ctx, sub.subscriber, sub.query, cap(sub.out))
+	if err != nil {
+		return err
+	}
+
+	if sub.cancel != nil {
+		sub.cancel()
+
+		// the previous node may not respond, so don't wait for it.
+		prev := p.nodes[sub.node]
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			defer cancel()
+
+			_ = prev.client.Unsubscribe(ctx, sub.subscriber, sub.query)
+		}()
+	}
+
+	fwdCtx, cancel := context.WithCancel(context.Background())
+	sub.node = idx
+	sub.cancel = cancel
+	go forward(fwdCtx, in, sub)
+
+	return nil
+}
+
+// forward forwards the events that haven't been seen to the output channel of the subscription.
+func forward(ctx context.Context, in <-chan ctypes.ResultEvent, sub *subscription) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-in:
+			if !sub.seen.add(eventKey(ev)) {
+				continue
+			}
+
+			select {
+			case sub.out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// monitor checks the health of the nodes periodically until the pool is stopped.
+func (p *Pool) monitor() {
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-ticker.C:
+			p.checkHealth()
+		}
+	}
+}
+
+// checkHealth checks the health of all nodes, switches to the healthiest node if the current one is
+// unhealthy, and moves the subscriptions to the current node.
+func (p *Pool) checkHealth() {
+	p.mtx.RLock()
+	nodes := p.nodes
+	p.mtx.RUnlock()
+
+	healths := make([]Health, len(nodes))
+
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+			healths[i] = p.queryHealth(n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	next := selectNode(healths, p.current, time.Now(), p.stallTimeout)
+	if next != p.current {
+		p.logger.Warn(
+			":twisted_rightwards_arrows: Switching from node %s (height %d) to node %s (height %d)",
+			nodes[p.current].uri,
+			healths[p.current].Height,
+			nodes[next].uri,
+			healths[next].Height,
+		)
+		p.current = next
+	}
+
+	for _, sub := range p.subs {
+		if sub.node == p.current {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		if err := p.subscribe(ctx, sub, p.current); err != nil {
+			p.logger.Error(
+				":cold_sweat: Failed to resubscribe to %s on node %s: %s",
+				sub.query,
+				nodes[p.current].uri,
+				err,
+			)
+		} else {
+			p.logger.Info(":ear: Resubscribed to %s on node %s", sub.query, nodes[p.current].uri)
+		}
+		cancel()
+	}
+}
+
+// queryHealth queries the status of the node. It tries to connect to the node first if it hasn't
+// been connected yet.
+func (p *Pool) queryHealth(n *node) Health {
+	if !n.client.IsRunning() {
+		if err := n.client.Start(); err != nil {
+			return Health{Err: err}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	status, err := n.client.Status(ctx)
+	if err != nil {
+		p.logger.Debug(":exploding_head: Failed to query status of node %s: %s", n.uri, err)
+		return Health{Err: err}
+	}
+
+	return Health{
+		Height:     status.SyncInfo.LatestBlockHeight,
+		BlockTime:  status.SyncInfo.LatestBlockTime,
+		CatchingUp: status.SyncInfo.CatchingUp,
+	}
+}
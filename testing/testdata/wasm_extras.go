@@ -24,3 +24,32 @@ var WasmExtra2 []byte = wat2wasm(`
 	(func $execute (export "execute") (type $t0))
 	(memory $memory (export "memory") 17))
 `)
+
+// WasmPriceReference is an Owasm code that follows the standard price reference schema, to test the
+// consumers of price results.
+//
+//	PREPARE:
+//	  CALL ask_external_data with EID 1 DID 1 CALLDATA "test"
+//	EXECUTE:
+//	  CALL set_return_data with RETDATA OBI-encoded {rates:[60000]}
+var WasmPriceReference []byte = wat2wasm(`
+(module
+	(type $t0 (func))
+	(type $t1 (func (param i64 i64 i64 i64)))
+	(type $t2 (func (param i64 i64)))
+	(import "env" "ask_external_data" (func $ask_external_data (type $t1)))
+	(import "env" "set_return_data" (func $set_return_data (type $t2)))
+	(func $prepare (export "prepare") (type $t0)
+	  i64.const 1
+	  i64.const 1
+	  i64.const 1024
+	  i64.const 4
+	  call $ask_external_data)
+	(func $execute (export "execute") (type $t0)
+	  i64.const 2048
+	  i64.const 12
+	  call $set_return_data)
+	(memory $memory (export "memory") 17)
+	(data (i32.const 1024) "test")
+	(data (i32.const 2048) "\00\00\00\01\00\00\00\00\00\00\ea\60"))
+`)
@@ -25,5 +25,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		k.ExecuteGroupTransition(ctx, transition)
 	}
 
+	// request a new randomness beacon value from the current group if the beacon interval is reached.
+	k.RequestBeaconSigning(ctx)
+
 	return nil
 }
@@ -66,6 +66,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query the group transition information",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod: "Evidences",
+					Use:       "evidences",
+					Short:     "Query the recorded evidences of misbehaving members",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"address": {
+							Name:  "address",
+							Usage: "Filter evidences by member address",
+						},
+					},
+				},
+				{
+					RpcMethod: "Evidence",
+					Use:       "evidence [id]",
+					Short:     "Query an evidence by evidence ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "evidence_id"},
+					},
+				},
+				{
+					RpcMethod: "ExcludedMembers",
+					Use:       "excluded-members",
+					Short:     "Query the members that are excluded from signing assignments",
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
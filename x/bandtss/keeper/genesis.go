@@ -41,13 +41,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, member := range data.Members {
 		k.SetMember(ctx, member)
 	}
+
+	evidenceCount := uint64(0)
+	for _, evidence := range data.Evidences {
+		k.SetEvidence(ctx, evidence)
+		if evidence.ID > evidenceCount {
+			evidenceCount = evidence.ID
+		}
+	}
+	k.SetEvidenceCount(ctx, evidenceCount)
+
+	for _, addr := range data.ExcludedMembers {
+		ctx.KVStore(k.storeKey).Set(types.ExcludedMemberStoreKey(sdk.MustAccAddressFromBech32(addr)), []byte{0x01})
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:       k.GetParams(ctx),
-		Members:      k.GetMembers(ctx),
-		CurrentGroup: k.GetCurrentGroup(ctx),
+		Params:          k.GetParams(ctx),
+		Members:         k.GetMembers(ctx),
+		CurrentGroup:    k.GetCurrentGroup(ctx),
+		Evidences:       k.GetEvidences(ctx),
+		ExcludedMembers: k.GetExcludedMembers(ctx),
 	}
 }
@@ -224,6 +224,91 @@ func (q queryServer) Signing(
 	}, nil
 }
 
+// Evidences queries the recorded evidences, optionally filtered by the member address.
+func (q queryServer) Evidences(
+	goCtx context.Context,
+	req *types.QueryEvidencesRequest,
+) (*types.QueryEvidencesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+		}
+	}
+
+	evidenceStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.EvidenceStoreKeyPrefix)
+
+	filteredEvidences, pageRes, err := query.GenericFilteredPaginate(
+		q.k.cdc,
+		evidenceStore,
+		req.Pagination,
+		func(key []byte, e *types.Evidence) (*types.Evidence, error) {
+			if req.Address != "" && e.Address != req.Address {
+				return nil, nil
+			}
+
+			return e, nil
+		},
+		func() *types.Evidence {
+			return &types.Evidence{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	evidences := make([]types.Evidence, 0, len(filteredEvidences))
+	for _, e := range filteredEvidences {
+		evidences = append(evidences, *e)
+	}
+
+	return &types.QueryEvidencesResponse{
+		Evidences:  evidences,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Evidence queries the evidence of the given ID.
+func (q queryServer) Evidence(
+	goCtx context.Context,
+	req *types.QueryEvidenceRequest,
+) (*types.QueryEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	evidence, err := q.k.GetEvidence(ctx, req.EvidenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEvidenceResponse{Evidence: evidence}, nil
+}
+
+// ExcludedMembers queries the addresses that are excluded from signing assignments.
+func (q queryServer) ExcludedMembers(
+	goCtx context.Context,
+	req *types.QueryExcludedMembersRequest,
+) (*types.QueryExcludedMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	excludedStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.ExcludedMemberStoreKeyPrefix)
+
+	var addresses []string
+	pageRes, err := query.Paginate(excludedStore, req.Pagination, func(key []byte, _ []byte) error {
+		// the key is prefixed by the length of the address.
+		addresses = append(addresses, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExcludedMembersResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params queries parameters of bandtss module
 func (q queryServer) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(stdCtx)
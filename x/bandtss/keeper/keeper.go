@@ -16,10 +16,14 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
-	authKeeper  types.AccountKeeper
-	bankKeeper  types.BankKeeper
-	distrKeeper types.DistrKeeper
-	tssKeeper   types.TSSKeeper
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	stakingKeeper types.StakingKeeper
+	restakeKeeper types.RestakeKeeper
+	tssKeeper     types.TSSKeeper
+
+	rollingseedKeeper types.RollingseedKeeper
 
 	authority        string
 	feeCollectorName string
@@ -31,7 +35,10 @@ func NewKeeper(
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	stakingKeeper types.StakingKeeper,
+	restakeKeeper types.RestakeKeeper,
 	tssKeeper types.TSSKeeper,
+	rollingseedKeeper types.RollingseedKeeper,
 	authority string,
 	feeCollectorName string,
 ) Keeper {
@@ -45,14 +52,17 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:              cdc,
-		storeKey:         storeKey,
-		authKeeper:       authKeeper,
-		bankKeeper:       bankKeeper,
-		distrKeeper:      distrKeeper,
-		tssKeeper:        tssKeeper,
-		authority:        authority,
-		feeCollectorName: feeCollectorName,
+		cdc:               cdc,
+		storeKey:          storeKey,
+		authKeeper:        authKeeper,
+		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
+		stakingKeeper:     stakingKeeper,
+		restakeKeeper:     restakeKeeper,
+		tssKeeper:         tssKeeper,
+		rollingseedKeeper: rollingseedKeeper,
+		authority:         authority,
+		feeCollectorName:  feeCollectorName,
 	}
 }
 
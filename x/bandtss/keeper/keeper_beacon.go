@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	rollingseedtypes "github.com/bandprotocol/chain/v3/x/rollingseed/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// RequestBeaconSigning requests the current group to sign the latest beacon value once every
+// beacon interval if there is no beacon signing in progress. If the request fails, it emits an
+// event and waits for the next interval.
+func (k Keeper) RequestBeaconSigning(ctx sdk.Context) {
+	interval := k.GetParams(ctx).BeaconInterval
+	if interval == 0 || uint64(ctx.BlockHeight())%interval != 0 || k.GetBeaconSigningID(ctx) != 0 {
+		return
+	}
+
+	currentGroupID := k.GetCurrentGroup(ctx).GroupID
+	if currentGroupID == 0 {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	signingID, err := k.CreateBeaconSigning(cacheCtx, currentGroupID)
+	if err != nil {
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCreateSigningFailed,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", currentGroupID)),
+			sdk.NewAttribute(types.AttributeKeySigningErrReason, err.Error()),
+			sdk.NewAttribute(types.AttributeKeySigningErrCodespace, codespace),
+			sdk.NewAttribute(types.AttributeKeySigningErrCode, fmt.Sprintf("%d", code)),
+		))
+		return
+	}
+	writeFn()
+
+	k.SetBeaconSigningID(ctx, signingID)
+}
+
+// CreateBeaconSigning creates a signing request of the latest beacon value for the next round
+// of the randomness beacon to the given group.
+func (k Keeper) CreateBeaconSigning(ctx sdk.Context, groupID tss.GroupID) (tss.SigningID, error) {
+	beacon, _ := k.rollingseedKeeper.GetBeacon(ctx)
+
+	moduleAcc := k.GetBandtssAccount(ctx)
+	originator := tsstypes.NewDirectOriginator(ctx.ChainID(), moduleAcc.GetAddress().String(), "")
+
+	content := types.NewBeaconSignatureOrder(beacon.Round+1, beacon.Value)
+
+	return k.tssKeeper.RequestSigning(ctx, groupID, &originator, content)
+}
+
+// PublishBeacon derives the beacon value of the next round from the group signature of the
+// given signing and publishes it to the rollingseed module.
+func (k Keeper) PublishBeacon(ctx sdk.Context, signingID tss.SigningID) {
+	signing := k.tssKeeper.MustGetSigning(ctx, signingID)
+	beacon, _ := k.rollingseedKeeper.GetBeacon(ctx)
+
+	beacon = rollingseedtypes.Beacon{
+		Round:  beacon.Round + 1,
+		Value:  tss.Hash(signing.Signature),
+		Height: ctx.BlockHeight(),
+	}
+	k.rollingseedKeeper.SetBeacon(ctx, beacon)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBeaconPublished,
+		sdk.NewAttribute(types.AttributeKeyTSSSigningID, fmt.Sprintf("%d", signingID)),
+		sdk.NewAttribute(types.AttributeKeyBeaconRound, fmt.Sprintf("%d", beacon.Round)),
+		sdk.NewAttribute(types.AttributeKeyBeaconValue, beacon.Value.String()),
+	))
+}
+
+// =====================================
+// Beacon signing store
+// =====================================
+
+// SetBeaconSigningID sets the tss signing ID of the beacon signing in progress.
+func (k Keeper) SetBeaconSigningID(ctx sdk.Context, signingID tss.SigningID) {
+	ctx.KVStore(k.storeKey).Set(types.BeaconSigningStoreKey, sdk.Uint64ToBigEndian(uint64(signingID)))
+}
+
+// GetBeaconSigningID returns the tss signing ID of the beacon signing in progress or zero if
+// there is none.
+func (k Keeper) GetBeaconSigningID(ctx sdk.Context) tss.SigningID {
+	return tss.SigningID(sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.BeaconSigningStoreKey)))
+}
+
+// DeleteBeaconSigningID removes the tss signing ID of the beacon signing in progress.
+func (k Keeper) DeleteBeaconSigningID(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.BeaconSigningStoreKey)
+}
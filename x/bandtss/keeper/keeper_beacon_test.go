@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	rollingseedtypes "github.com/bandprotocol/chain/v3/x/rollingseed/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func (s *KeeperTestSuite) TestRequestBeaconSigning() {
+	currentGroupID := tss.GroupID(1)
+	previousBeacon := rollingseedtypes.Beacon{Round: 2, Value: []byte("beacon-value"), Height: 5}
+
+	testCases := []struct {
+		name              string
+		height            int64
+		interval          uint64
+		preProcess        func()
+		expectedSigningID tss.SigningID
+	}{
+		{
+			name:     "beacon disabled",
+			height:   10,
+			interval: 0,
+			preProcess: func() {
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGroupID, s.ctx.BlockTime()))
+			},
+			expectedSigningID: 0,
+		},
+		{
+			name:     "interval not reached",
+			height:   11,
+			interval: 10,
+			preProcess: func() {
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGroupID, s.ctx.BlockTime()))
+			},
+			expectedSigningID: 0,
+		},
+		{
+			name:              "no current group",
+			height:            10,
+			interval:          10,
+			preProcess:        func() {},
+			expectedSigningID: 0,
+		},
+		{
+			name:     "beacon signing in progress",
+			height:   10,
+			interval: 10,
+			preProcess: func() {
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGroupID, s.ctx.BlockTime()))
+				s.keeper.SetBeaconSigningID(s.ctx, tss.SigningID(3))
+			},
+			expectedSigningID: 3,
+		},
+		{
+			name:     "fail to request signing",
+			height:   10,
+			interval: 10,
+			preProcess: func() {
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGroupID, s.ctx.BlockTime()))
+				s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(s.moduleAcc)
+				s.rollingseedKeeper.EXPECT().GetBeacon(gomock.Any()).Return(previousBeacon, true)
+				s.tssKeeper.EXPECT().
+					RequestSigning(gomock.Any(), currentGroupID, gomock.Any(), gomock.Any()).
+					Return(tss.SigningID(0), tsstypes.ErrGroupIsNotActive)
+			},
+			expectedSigningID: 0,
+		},
+		{
+			name:     "request signing of the next round",
+			height:   10,
+			interval: 10,
+			preProcess: func() {
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGroupID, s.ctx.BlockTime()))
+				s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(s.moduleAcc)
+				s.rollingseedKeeper.EXPECT().GetBeacon(gomock.Any()).Return(previousBeacon, true)
+				s.tssKeeper.EXPECT().
+					RequestSigning(
+						gomock.Any(),
+						currentGroupID,
+						gomock.Any(),
+						types.NewBeaconSignatureOrder(3, previousBeacon.Value),
+					).
+					Return(tss.SigningID(4), nil)
+			},
+			expectedSigningID: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithBlockHeight(tc.height)
+
+			params := types.DefaultParams()
+			params.BeaconInterval = tc.interval
+			s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+			tc.preProcess()
+
+			s.keeper.RequestBeaconSigning(s.ctx)
+			s.Require().Equal(tc.expectedSigningID, s.keeper.GetBeaconSigningID(s.ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPublishBeaconOnSigningCompleted() {
+	signingID := tss.SigningID(4)
+	signature := tss.Signature([]byte("signature"))
+
+	s.keeper.SetBeaconSigningID(s.ctx, signingID)
+	s.ctx = s.ctx.WithBlockHeight(20)
+
+	s.tssKeeper.EXPECT().MustGetSigning(gomock.Any(), signingID).Return(tsstypes.Signing{
+		ID:        signingID,
+		Signature: signature,
+	})
+	s.rollingseedKeeper.EXPECT().
+		GetBeacon(gomock.Any()).
+		Return(rollingseedtypes.Beacon{Round: 2, Value: []byte("beacon-value"), Height: 5}, true)
+	s.rollingseedKeeper.EXPECT().SetBeacon(gomock.Any(), rollingseedtypes.Beacon{
+		Round:  3,
+		Value:  tss.Hash(signature),
+		Height: 20,
+	})
+
+	s.tssCallback.OnSigningCompleted(s.ctx, signingID, nil)
+	s.Require().Equal(tss.SigningID(0), s.keeper.GetBeaconSigningID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestDeleteBeaconSigningOnSigningFailed() {
+	signingID := tss.SigningID(4)
+	s.keeper.SetBeaconSigningID(s.ctx, signingID)
+
+	s.tssCallback.OnSigningFailed(s.ctx, signingID)
+	s.Require().Equal(tss.SigningID(0), s.keeper.GetBeaconSigningID(s.ctx))
+}
@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// HandleMisbehavior penalizes the member that is proven to misbehave. It slashes the validator
+// stake of the member according to the module parameters, records the evidence in the store and
+// excludes the member from any signing assignments until being readmitted by governance.
+func (k Keeper) HandleMisbehavior(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	groupID tss.GroupID,
+	misbehaviorType tsstypes.MisbehaviorType,
+	signingID tss.SigningID,
+) types.Evidence {
+	params := k.GetParams(ctx)
+
+	slashPercentage := params.MisbehaviorSlashPercentage
+	if misbehaviorType == tsstypes.MISBEHAVIOR_TYPE_NON_PARTICIPATION {
+		slashPercentage = params.NonParticipationSlashPercentage
+	}
+
+	slashedAmount := k.SlashMember(ctx, address, slashPercentage)
+	restakeSlashedCoins := k.SlashRestake(ctx, address, slashPercentage)
+
+	evidence := types.NewEvidence(
+		k.GetNextEvidenceID(ctx),
+		address,
+		groupID,
+		misbehaviorType,
+		signingID,
+		slashedAmount,
+		restakeSlashedCoins,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+	k.SetEvidence(ctx, evidence)
+
+	k.ExcludeMember(ctx, address)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEvidenceRecorded,
+		sdk.NewAttribute(types.AttributeKeyEvidenceID, fmt.Sprintf("%d", evidence.ID)),
+		sdk.NewAttribute(types.AttributeKeyAddress, evidence.Address),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		sdk.NewAttribute(types.AttributeKeyMisbehavior, misbehaviorType.String()),
+		sdk.NewAttribute(types.AttributeKeyTSSSigningID, fmt.Sprintf("%d", signingID)),
+		sdk.NewAttribute(types.AttributeKeySlashedAmount, slashedAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyRestakeSlashedCoins, restakeSlashedCoins.String()),
+	))
+
+	return evidence
+}
+
+// SlashMember slashes the given percentage of the stake of the validator operated by the given
+// address, jails the validator and returns the amount of burned tokens. The member is not
+// penalized if it doesn't operate a validator or the slashing fails.
+func (k Keeper) SlashMember(ctx sdk.Context, address sdk.AccAddress, percentage uint64) sdkmath.Int {
+	validator, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(address))
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+
+	// slash in a cached context so that the failed slashing doesn't leave any partial change.
+	cacheCtx, writeFn := ctx.CacheContext()
+	burned := sdkmath.ZeroInt()
+	if percentage > 0 {
+		burned, err = k.stakingKeeper.Slash(
+			cacheCtx,
+			consAddr,
+			ctx.BlockHeight(),
+			validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx)),
+			sdkmath.LegacyNewDecWithPrec(int64(percentage), 2),
+		)
+	}
+
+	// the validator is removed from the active set, so that it stops earning rewards until it is unjailed.
+	if err == nil && !validator.IsJailed() {
+		err = k.stakingKeeper.Jail(cacheCtx, consAddr)
+	}
+	if err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSlashFailed,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return sdkmath.ZeroInt()
+	}
+	writeFn()
+
+	return burned
+}
+
+// SlashRestake slashes the given percentage of the coins staked in the restake module by the given
+// address and sends them to the community pool. It returns the slashed coins. The member is not
+// slashed if the slashing fails.
+func (k Keeper) SlashRestake(ctx sdk.Context, address sdk.AccAddress, percentage uint64) sdk.Coins {
+	if percentage == 0 {
+		return nil
+	}
+
+	// slash in a cached context so that the failed slashing doesn't leave any partial change.
+	cacheCtx, writeFn := ctx.CacheContext()
+	slashedCoins, err := k.restakeKeeper.SlashStake(
+		cacheCtx,
+		address,
+		sdkmath.LegacyNewDecWithPrec(int64(percentage), 2),
+		types.ModuleName,
+	)
+	if err == nil && !slashedCoins.IsZero() {
+		err = k.distrKeeper.FundCommunityPool(cacheCtx, slashedCoins, k.GetBandtssAccount(ctx).GetAddress())
+	}
+	if err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSlashFailed,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return nil
+	}
+	if slashedCoins.IsZero() {
+		return nil
+	}
+	writeFn()
+
+	return slashedCoins
+}
+
+// HandleMissedSigning increases the number of consecutive missed signings of the given address.
+// If the number reaches the maximum value in the module parameters, the member is penalized for
+// non-participation. It returns true if the member is penalized.
+func (k Keeper) HandleMissedSigning(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	groupID tss.GroupID,
+	signingID tss.SigningID,
+) bool {
+	count := k.GetMissedSigningCount(ctx, address) + 1
+
+	maxMissedSignings := k.GetParams(ctx).MaxMissedSignings
+	if maxMissedSignings == 0 || count < maxMissedSignings {
+		k.SetMissedSigningCount(ctx, address, count)
+		return false
+	}
+
+	k.DeleteMissedSigningCount(ctx, address)
+	k.HandleMisbehavior(ctx, address, groupID, tsstypes.MISBEHAVIOR_TYPE_NON_PARTICIPATION, signingID)
+	return true
+}
+
+// =====================================
+// Member exclusion
+// =====================================
+
+// ExcludeMember excludes the address from signing assignments by deactivating it in every group
+// of the module and preventing it from being activated until being readmitted by governance.
+func (k Keeper) ExcludeMember(ctx sdk.Context, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.ExcludedMemberStoreKey(address), []byte{0x01})
+
+	for _, groupID := range []tss.GroupID{k.GetCurrentGroup(ctx).GroupID, k.GetIncomingGroupID(ctx)} {
+		if groupID == 0 || !k.HasMember(ctx, address, groupID) {
+			continue
+		}
+
+		// This shouldn't return an error because the member exists in both tss and bandtss module.
+		if err := k.DeactivateMember(ctx, address, groupID); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ReadmitMember lifts the exclusion of the address. The member still needs to activate itself
+// after the penalty duration is elapsed.
+func (k Keeper) ReadmitMember(ctx sdk.Context, address sdk.AccAddress) error {
+	if !k.IsMemberExcluded(ctx, address) {
+		return types.ErrMemberNotExcluded.Wrapf("address: %s", address)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.ExcludedMemberStoreKey(address))
+	k.DeleteMissedSigningCount(ctx, address)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMemberReadmitted,
+		sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+	))
+
+	return nil
+}
+
+// IsMemberExcluded checks whether the address is excluded from signing assignments.
+func (k Keeper) IsMemberExcluded(ctx sdk.Context, address sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ExcludedMemberStoreKey(address))
+}
+
+// GetExcludedMembersIterator gets an iterator over all excluded addresses.
+func (k Keeper) GetExcludedMembersIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ExcludedMemberStoreKeyPrefix)
+}
+
+// GetExcludedMembers retrieves all excluded addresses from the store.
+func (k Keeper) GetExcludedMembers(ctx sdk.Context) []string {
+	iterator := k.GetExcludedMembersIterator(ctx)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is prefixed by the length of the address.
+		address := sdk.AccAddress(iterator.Key()[len(types.ExcludedMemberStoreKeyPrefix)+1:])
+		addresses = append(addresses, address.String())
+	}
+
+	return addresses
+}
+
+// =====================================
+// Missed signing count store
+// =====================================
+
+// GetMissedSigningCount retrieves the number of consecutive missed signings of the address.
+func (k Keeper) GetMissedSigningCount(ctx sdk.Context, address sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MissedSigningCountStoreKey(address))
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetMissedSigningCount sets the number of consecutive missed signings of the address.
+func (k Keeper) SetMissedSigningCount(ctx sdk.Context, address sdk.AccAddress, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MissedSigningCountStoreKey(address), sdk.Uint64ToBigEndian(count))
+}
+
+// DeleteMissedSigningCount removes the number of consecutive missed signings of the address.
+func (k Keeper) DeleteMissedSigningCount(ctx sdk.Context, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MissedSigningCountStoreKey(address))
+}
+
+// =====================================
+// Evidence store
+// =====================================
+
+// SetEvidenceCount sets the number of recorded evidences.
+func (k Keeper) SetEvidenceCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.EvidenceCountStoreKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetEvidenceCount retrieves the number of recorded evidences.
+func (k Keeper) GetEvidenceCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.EvidenceCountStoreKey)
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetNextEvidenceID increments the evidence count and returns the current number of evidences.
+func (k Keeper) GetNextEvidenceID(ctx sdk.Context) uint64 {
+	count := k.GetEvidenceCount(ctx) + 1
+	k.SetEvidenceCount(ctx, count)
+	return count
+}
+
+// SetEvidence sets the evidence in the store.
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence types.Evidence) {
+	ctx.KVStore(k.storeKey).Set(types.EvidenceStoreKey(evidence.ID), k.cdc.MustMarshal(&evidence))
+}
+
+// GetEvidence retrieves the evidence of the given ID from the store.
+func (k Keeper) GetEvidence(ctx sdk.Context, id uint64) (types.Evidence, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EvidenceStoreKey(id))
+	if bz == nil {
+		return types.Evidence{}, types.ErrEvidenceNotFound.Wrapf("evidence ID: %d", id)
+	}
+
+	var evidence types.Evidence
+	k.cdc.MustUnmarshal(bz, &evidence)
+	return evidence, nil
+}
+
+// GetEvidencesIterator gets an iterator over all evidences.
+func (k Keeper) GetEvidencesIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EvidenceStoreKeyPrefix)
+}
+
+// GetEvidences retrieves all evidences from the store.
+func (k Keeper) GetEvidences(ctx sdk.Context) []types.Evidence {
+	iterator := k.GetEvidencesIterator(ctx)
+	defer iterator.Close()
+
+	var evidences []types.Evidence
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence types.Evidence
+		k.cdc.MustUnmarshal(iterator.Value(), &evidence)
+		evidences = append(evidences, evidence)
+	}
+
+	return evidences
+}
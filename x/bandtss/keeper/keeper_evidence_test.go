@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	restaketypes "github.com/bandprotocol/chain/v3/x/restake/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func (s *AppTestSuite) TestHandleMisbehavior() {
+	ctx, k, tssKeeper := s.ctx, s.app.BandtssKeeper, s.app.TSSKeeper
+	groupCtx := s.SetupNewGroup(5, 3)
+	address := groupCtx.Accounts[0].Address
+
+	evidence := k.HandleMisbehavior(
+		ctx,
+		address,
+		groupCtx.GroupID,
+		tsstypes.MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE,
+		tss.SigningID(1),
+	)
+	s.Require().Equal(uint64(1), evidence.ID)
+	s.Require().Equal(address.String(), evidence.Address)
+	// the member doesn't operate a validator, so nothing is slashed.
+	s.Require().Equal(sdkmath.ZeroInt(), evidence.SlashedAmount)
+
+	got, err := k.GetEvidence(ctx, evidence.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(evidence, got)
+	s.Require().Equal([]types.Evidence{evidence}, k.GetEvidences(ctx))
+
+	// the member is excluded and deactivated.
+	s.Require().True(k.IsMemberExcluded(ctx, address))
+	s.Require().Equal([]string{address.String()}, k.GetExcludedMembers(ctx))
+
+	member, err := k.GetMember(ctx, address, groupCtx.GroupID)
+	s.Require().NoError(err)
+	s.Require().False(member.IsActive)
+
+	tssMember, err := tssKeeper.GetMemberByAddress(ctx, groupCtx.GroupID, address.String())
+	s.Require().NoError(err)
+	s.Require().False(tssMember.IsActive)
+
+	// the member cannot activate itself even though the penalty duration is elapsed.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(k.GetParams(ctx).InactivePenaltyDuration))
+	err = k.ActivateMember(ctx, address, groupCtx.GroupID)
+	s.Require().ErrorIs(err, types.ErrMemberExcluded)
+
+	// the member can activate itself after being readmitted.
+	err = k.ReadmitMember(ctx, address)
+	s.Require().NoError(err)
+	s.Require().False(k.IsMemberExcluded(ctx, address))
+
+	err = k.ActivateMember(ctx, address, groupCtx.GroupID)
+	s.Require().NoError(err)
+
+	// readmit the member that is not excluded.
+	err = k.ReadmitMember(ctx, address)
+	s.Require().ErrorIs(err, types.ErrMemberNotExcluded)
+}
+
+func (s *AppTestSuite) TestHandleMissedSigning() {
+	ctx, k := s.ctx, s.app.BandtssKeeper
+	groupCtx := s.SetupNewGroup(5, 3)
+	address := groupCtx.Accounts[0].Address
+
+	params := k.GetParams(ctx)
+	params.MaxMissedSignings = 3
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	for i := uint64(1); i < params.MaxMissedSignings; i++ {
+		penalized := k.HandleMissedSigning(ctx, address, groupCtx.GroupID, tss.SigningID(i))
+		s.Require().False(penalized)
+		s.Require().Equal(i, k.GetMissedSigningCount(ctx, address))
+	}
+	s.Require().False(k.IsMemberExcluded(ctx, address))
+
+	penalized := k.HandleMissedSigning(ctx, address, groupCtx.GroupID, tss.SigningID(params.MaxMissedSignings))
+	s.Require().True(penalized)
+	s.Require().Equal(uint64(0), k.GetMissedSigningCount(ctx, address))
+	s.Require().True(k.IsMemberExcluded(ctx, address))
+
+	evidence, err := k.GetEvidence(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(tsstypes.MISBEHAVIOR_TYPE_NON_PARTICIPATION, evidence.MisbehaviorType)
+	s.Require().Equal(tss.SigningID(params.MaxMissedSignings), evidence.SigningID)
+}
+
+func (s *AppTestSuite) TestGetEvidenceNotFound() {
+	_, err := s.app.BandtssKeeper.GetEvidence(s.ctx, 1)
+	s.Require().ErrorIs(err, types.ErrEvidenceNotFound)
+}
+
+func (s *AppTestSuite) TestHandleMisbehaviorSlashRestake() {
+	ctx, k := s.ctx, s.app.BandtssKeeper
+	groupCtx := s.SetupNewGroup(5, 3)
+	address := bandtesting.Alice.Address
+
+	stakedCoins := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	err := s.app.BankKeeper.SendCoinsFromAccountToModule(ctx, address, restaketypes.ModuleName, stakedCoins)
+	s.Require().NoError(err)
+	s.app.RestakeKeeper.SetStake(ctx, restaketypes.NewStake(address.String(), stakedCoins))
+
+	communityPool, err := s.app.DistrKeeper.FeePool.Get(ctx)
+	s.Require().NoError(err)
+
+	evidence := k.HandleMisbehavior(
+		ctx,
+		address,
+		groupCtx.GroupID,
+		tsstypes.MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE,
+		tss.SigningID(1),
+	)
+
+	// 1% of the restaked coins are slashed to the community pool.
+	slashedCoins := sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+	s.Require().Equal(slashedCoins, evidence.RestakeSlashedCoins)
+	s.Require().Equal(stakedCoins.Sub(slashedCoins...), s.app.RestakeKeeper.GetStake(ctx, address).Coins)
+
+	newCommunityPool, err := s.app.DistrKeeper.FeePool.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		communityPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(slashedCoins...)...),
+		newCommunityPool.CommunityPool,
+	)
+}
+
+func (s *AppTestSuite) TestHandleMisbehaviorSlashAndJailValidator() {
+	ctx, k := s.ctx, s.app.BandtssKeeper
+	groupCtx := s.SetupNewGroup(5, 3)
+	address := bandtesting.Validators[0].Address
+	valAddress := bandtesting.Validators[0].ValAddress
+
+	validator, err := s.app.StakingKeeper.GetValidator(ctx, valAddress)
+	s.Require().NoError(err)
+	s.Require().False(validator.IsJailed())
+
+	evidence := k.HandleMisbehavior(
+		ctx,
+		address,
+		groupCtx.GroupID,
+		tsstypes.MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE,
+		tss.SigningID(1),
+	)
+	s.Require().True(evidence.SlashedAmount.IsPositive())
+
+	// the validator is slashed and jailed.
+	slashed, err := s.app.StakingKeeper.GetValidator(ctx, valAddress)
+	s.Require().NoError(err)
+	s.Require().True(slashed.IsJailed())
+	s.Require().Equal(validator.Tokens.Sub(evidence.SlashedAmount), slashed.Tokens)
+
+	// the jailed validator is still slashed for another misbehavior.
+	evidence = k.HandleMisbehavior(
+		ctx,
+		address,
+		groupCtx.GroupID,
+		tsstypes.MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE,
+		tss.SigningID(2),
+	)
+	s.Require().True(evidence.SlashedAmount.IsPositive())
+
+	validator, err = s.app.StakingKeeper.GetValidator(ctx, valAddress)
+	s.Require().NoError(err)
+	s.Require().True(validator.IsJailed())
+	s.Require().Equal(slashed.Tokens.Sub(evidence.SlashedAmount), validator.Tokens)
+}
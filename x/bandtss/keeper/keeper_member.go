@@ -54,6 +54,10 @@ func (k Keeper) ActivateMember(ctx sdk.Context, address sdk.AccAddress, groupID
 		return types.ErrMemberAlreadyActive.Wrapf("address %s is already active", address.String())
 	}
 
+	if k.IsMemberExcluded(ctx, address) {
+		return types.ErrMemberExcluded.Wrapf("address %s must be readmitted by governance", address.String())
+	}
+
 	penaltyEndTime := member.Since.Add(k.GetParams(ctx).InactivePenaltyDuration)
 	if penaltyEndTime.After(ctx.BlockTime()) {
 		return types.ErrPenaltyDurationNotElapsed.Wrapf("penalty end time: %s", penaltyEndTime)
@@ -103,15 +107,21 @@ func (k Keeper) AddMembers(ctx sdk.Context, groupID tss.GroupID) error {
 	return nil
 }
 
-// AddMember adds a new member to the group and return error if already exists
+// AddMember adds a new member to the group and return error if already exists. The member is
+// added as inactive if the address is excluded from signing assignments.
 func (k Keeper) AddMember(ctx sdk.Context, address sdk.AccAddress, groupID tss.GroupID) error {
 	if k.HasMember(ctx, address, groupID) {
 		return types.ErrMemberAlreadyExists.Wrapf("address %s already exists", address.String())
 	}
 
-	member := types.NewMember(address, groupID, true, ctx.BlockTime())
+	isExcluded := k.IsMemberExcluded(ctx, address)
+	member := types.NewMember(address, groupID, !isExcluded, ctx.BlockTime())
 	k.SetMember(ctx, member)
 
+	if isExcluded {
+		return k.tssKeeper.DeactivateMember(ctx, groupID, address)
+	}
+
 	return nil
 }
 
@@ -213,11 +213,15 @@ func (s *AppTestSuite) TestParams() {
 		{
 			name: "set full valid params",
 			input: types.Params{
-				RewardPercentage:        types.DefaultRewardPercentage,
-				InactivePenaltyDuration: types.DefaultInactivePenaltyDuration,
-				MinTransitionDuration:   types.DefaultMinTransitionDuration,
-				MaxTransitionDuration:   types.DefaultMaxTransitionDuration,
-				FeePerSigner:            types.DefaultFeePerSigner,
+				RewardPercentage:                types.DefaultRewardPercentage,
+				InactivePenaltyDuration:         types.DefaultInactivePenaltyDuration,
+				MinTransitionDuration:           types.DefaultMinTransitionDuration,
+				MaxTransitionDuration:           types.DefaultMaxTransitionDuration,
+				FeePerSigner:                    types.DefaultFeePerSigner,
+				MisbehaviorSlashPercentage:      types.DefaultMisbehaviorSlashPercentage,
+				NonParticipationSlashPercentage: types.DefaultNonParticipationSlashPercentage,
+				MaxMissedSignings:               types.DefaultMaxMissedSignings,
+				BeaconInterval:                  100,
 			},
 			expectErr: false,
 		},
@@ -259,8 +263,12 @@ type KeeperTestSuite struct {
 	accountKeeper *bandtsstestutil.MockAccountKeeper
 	bankKeeper    *bandtsstestutil.MockBankKeeper
 	distrKeeper   *bandtsstestutil.MockDistrKeeper
+	stakingKeeper *bandtsstestutil.MockStakingKeeper
+	restakeKeeper *bandtsstestutil.MockRestakeKeeper
 	tssKeeper     *bandtsstestutil.MockTSSKeeper
 
+	rollingseedKeeper *bandtsstestutil.MockRollingseedKeeper
+
 	moduleAcc sdk.ModuleAccountI
 	ctx       sdk.Context
 	authority sdk.AccAddress
@@ -279,7 +287,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.accountKeeper = bandtsstestutil.NewMockAccountKeeper(ctrl)
 	s.bankKeeper = bandtsstestutil.NewMockBankKeeper(ctrl)
 	s.distrKeeper = bandtsstestutil.NewMockDistrKeeper(ctrl)
+	s.stakingKeeper = bandtsstestutil.NewMockStakingKeeper(ctrl)
+	s.restakeKeeper = bandtsstestutil.NewMockRestakeKeeper(ctrl)
 	s.tssKeeper = bandtsstestutil.NewMockTSSKeeper(ctrl)
+	s.rollingseedKeeper = bandtsstestutil.NewMockRollingseedKeeper(ctrl)
 
 	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
 	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(s.authority).AnyTimes()
@@ -289,7 +300,10 @@ func (s *KeeperTestSuite) SetupTest() {
 		s.accountKeeper,
 		s.bankKeeper,
 		s.distrKeeper,
+		s.stakingKeeper,
+		s.restakeKeeper,
 		s.tssKeeper,
+		s.rollingseedKeeper,
 		s.authority.String(),
 		authtypes.FeeCollectorName,
 	)
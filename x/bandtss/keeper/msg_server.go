@@ -193,3 +193,25 @@ func (k msgServer) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ReadmitMember lifts the exclusion of a member that was penalized for misbehavior.
+func (k msgServer) ReadmitMember(
+	goCtx context.Context,
+	req *types.MsgReadmitMember,
+) (*types.MsgReadmitMemberResponse, error) {
+	if k.Keeper.GetAuthority() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", k.Keeper.GetAuthority(), req.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+	}
+
+	if err := k.Keeper.ReadmitMember(ctx, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReadmitMemberResponse{}, nil
+}
@@ -102,6 +102,12 @@ func (cb TSSCallback) OnSigningFailed(ctx sdk.Context, signingID tss.SigningID)
 		return
 	}
 
+	// If the signing is for the randomness beacon, wait for the next beacon interval.
+	if signingID == cb.k.GetBeaconSigningID(ctx) {
+		cb.k.DeleteBeaconSigningID(ctx)
+		return
+	}
+
 	// If the signing is for transition, update the transition status.
 	transition, found := cb.k.GetGroupTransition(ctx)
 	if found && signingID == transition.SigningID && transition.Status == types.TRANSITION_STATUS_WAITING_SIGN {
@@ -130,14 +136,34 @@ func (cb TSSCallback) OnSigningTimeout(
 		if err := cb.k.DeactivateMember(ctx, addr, signing.GroupID); err != nil {
 			panic(err)
 		}
+
+		// penalize the member if it repeatedly misses signings.
+		cb.k.HandleMissedSigning(ctx, addr, signing.GroupID, signingID)
 	}
 }
 
+// OnMemberMisbehaved is called when a member is proven to misbehave. It records the evidence,
+// slashes and excludes the member.
+func (cb TSSCallback) OnMemberMisbehaved(
+	ctx sdk.Context,
+	groupID tss.GroupID,
+	address sdk.AccAddress,
+	misbehaviorType tsstypes.MisbehaviorType,
+	signingID tss.SigningID,
+) {
+	cb.k.HandleMisbehavior(ctx, address, groupID, misbehaviorType, signingID)
+}
+
 func (cb TSSCallback) OnSigningCompleted(
 	ctx sdk.Context,
 	signingID tss.SigningID,
 	assignedMembers []sdk.AccAddress,
 ) {
+	// reset the number of consecutive missed signings of members who participated.
+	for _, addr := range assignedMembers {
+		cb.k.DeleteMissedSigningCount(ctx, addr)
+	}
+
 	// If it is normal signing request (not for transition), transfer fee if needed and
 	// delete the bandtssSigningID mapping.
 	bandtssSigningID := cb.k.GetSigningIDMapping(ctx, signingID)
@@ -165,6 +191,13 @@ func (cb TSSCallback) OnSigningCompleted(
 		return
 	}
 
+	// If the signing is for the randomness beacon, publish the beacon value of the next round.
+	if signingID == cb.k.GetBeaconSigningID(ctx) {
+		cb.k.DeleteBeaconSigningID(ctx)
+		cb.k.PublishBeacon(ctx, signingID)
+		return
+	}
+
 	// If the signing is for transition, update the transition status.
 	transition, found := cb.k.GetGroupTransition(ctx)
 	if found && signingID == transition.SigningID && transition.Status == types.TRANSITION_STATUS_WAITING_SIGN {
@@ -15,8 +15,10 @@ import (
 
 	math "cosmossdk.io/math"
 	tss "github.com/bandprotocol/chain/v3/pkg/tss"
-	types "github.com/bandprotocol/chain/v3/x/tss/types"
-	types0 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/bandprotocol/chain/v3/x/rollingseed/types"
+	types0 "github.com/bandprotocol/chain/v3/x/tss/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -45,10 +47,10 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 }
 
 // GetModuleAccount mocks base method.
-func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types0.ModuleAccountI {
+func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types1.ModuleAccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAccount", ctx, name)
-	ret0, _ := ret[0].(types0.ModuleAccountI)
+	ret0, _ := ret[0].(types1.ModuleAccountI)
 	return ret0
 }
 
@@ -59,10 +61,10 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAccount(ctx, name any) *gomock
 }
 
 // GetModuleAddress mocks base method.
-func (m *MockAccountKeeper) GetModuleAddress(name string) types0.AccAddress {
+func (m *MockAccountKeeper) GetModuleAddress(name string) types1.AccAddress {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAddress", name)
-	ret0, _ := ret[0].(types0.AccAddress)
+	ret0, _ := ret[0].(types1.AccAddress)
 	return ret0
 }
 
@@ -73,7 +75,7 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name any) *gomock.Call
 }
 
 // SetModuleAccount mocks base method.
-func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types0.ModuleAccountI) {
+func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types1.ModuleAccountI) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetModuleAccount", arg0, arg1)
 }
@@ -109,10 +111,10 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // GetAllBalances mocks base method.
-func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
+func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types1.AccAddress) types1.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
-	ret0, _ := ret[0].(types0.Coins)
+	ret0, _ := ret[0].(types1.Coins)
 	return ret0
 }
 
@@ -123,7 +125,7 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 }
 
 // SendCoinsFromAccountToModule mocks base method.
-func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types1.AccAddress, recipientModule string, amt types1.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -137,7 +139,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAd
 }
 
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types1.AccAddress, amt types1.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -151,7 +153,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 }
 
 // SendCoinsFromModuleToModule mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -189,7 +191,7 @@ func (m *MockDistrKeeper) EXPECT() *MockDistrKeeperMockRecorder {
 }
 
 // FundCommunityPool mocks base method.
-func (m *MockDistrKeeper) FundCommunityPool(ctx context.Context, amount types0.Coins, sender types0.AccAddress) error {
+func (m *MockDistrKeeper) FundCommunityPool(ctx context.Context, amount types1.Coins, sender types1.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
 	ret0, _ := ret[0].(error)
@@ -217,6 +219,127 @@ func (mr *MockDistrKeeperMockRecorder) GetCommunityTax(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommunityTax", reflect.TypeOf((*MockDistrKeeper)(nil).GetCommunityTax), ctx)
 }
 
+// MockStakingKeeper is a mock of StakingKeeper interface.
+type MockStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockStakingKeeperMockRecorder is the mock recorder for MockStakingKeeper.
+type MockStakingKeeperMockRecorder struct {
+	mock *MockStakingKeeper
+}
+
+// NewMockStakingKeeper creates a new mock instance.
+func NewMockStakingKeeper(ctrl *gomock.Controller) *MockStakingKeeper {
+	mock := &MockStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types2.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types2.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// Jail mocks base method.
+func (m *MockStakingKeeper) Jail(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockStakingKeeperMockRecorder) Jail(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockStakingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// PowerReduction mocks base method.
+func (m *MockStakingKeeper) PowerReduction(ctx context.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerReduction", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// PowerReduction indicates an expected call of PowerReduction.
+func (mr *MockStakingKeeperMockRecorder) PowerReduction(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerReduction", reflect.TypeOf((*MockStakingKeeper)(nil).PowerReduction), ctx)
+}
+
+// Slash mocks base method.
+func (m *MockStakingKeeper) Slash(ctx context.Context, consAddr types1.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Slash", ctx, consAddr, infractionHeight, power, slashFactor)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockStakingKeeperMockRecorder) Slash(ctx, consAddr, infractionHeight, power, slashFactor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockStakingKeeper)(nil).Slash), ctx, consAddr, infractionHeight, power, slashFactor)
+}
+
+// MockRestakeKeeper is a mock of RestakeKeeper interface.
+type MockRestakeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestakeKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockRestakeKeeperMockRecorder is the mock recorder for MockRestakeKeeper.
+type MockRestakeKeeperMockRecorder struct {
+	mock *MockRestakeKeeper
+}
+
+// NewMockRestakeKeeper creates a new mock instance.
+func NewMockRestakeKeeper(ctrl *gomock.Controller) *MockRestakeKeeper {
+	mock := &MockRestakeKeeper{ctrl: ctrl}
+	mock.recorder = &MockRestakeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestakeKeeper) EXPECT() *MockRestakeKeeperMockRecorder {
+	return m.recorder
+}
+
+// SlashStake mocks base method.
+func (m *MockRestakeKeeper) SlashStake(ctx types1.Context, stakerAddr types1.AccAddress, fraction math.LegacyDec, recipientModule string) (types1.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashStake", ctx, stakerAddr, fraction, recipientModule)
+	ret0, _ := ret[0].(types1.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashStake indicates an expected call of SlashStake.
+func (mr *MockRestakeKeeperMockRecorder) SlashStake(ctx, stakerAddr, fraction, recipientModule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashStake", reflect.TypeOf((*MockRestakeKeeper)(nil).SlashStake), ctx, stakerAddr, fraction, recipientModule)
+}
+
 // MockTSSKeeper is a mock of TSSKeeper interface.
 type MockTSSKeeper struct {
 	ctrl     *gomock.Controller
@@ -242,7 +365,7 @@ func (m *MockTSSKeeper) EXPECT() *MockTSSKeeperMockRecorder {
 }
 
 // ActivateMember mocks base method.
-func (m *MockTSSKeeper) ActivateMember(ctx types0.Context, groupID tss.GroupID, address types0.AccAddress) error {
+func (m *MockTSSKeeper) ActivateMember(ctx types1.Context, groupID tss.GroupID, address types1.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ActivateMember", ctx, groupID, address)
 	ret0, _ := ret[0].(error)
@@ -256,7 +379,7 @@ func (mr *MockTSSKeeperMockRecorder) ActivateMember(ctx, groupID, address any) *
 }
 
 // CreateGroup mocks base method.
-func (m *MockTSSKeeper) CreateGroup(ctx types0.Context, members []types0.AccAddress, threshold uint64, moduleOwner string) (tss.GroupID, error) {
+func (m *MockTSSKeeper) CreateGroup(ctx types1.Context, members []types1.AccAddress, threshold uint64, moduleOwner string) (tss.GroupID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateGroup", ctx, members, threshold, moduleOwner)
 	ret0, _ := ret[0].(tss.GroupID)
@@ -271,7 +394,7 @@ func (mr *MockTSSKeeperMockRecorder) CreateGroup(ctx, members, threshold, module
 }
 
 // DeactivateMember mocks base method.
-func (m *MockTSSKeeper) DeactivateMember(ctx types0.Context, groupID tss.GroupID, address types0.AccAddress) error {
+func (m *MockTSSKeeper) DeactivateMember(ctx types1.Context, groupID tss.GroupID, address types1.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeactivateMember", ctx, groupID, address)
 	ret0, _ := ret[0].(error)
@@ -285,10 +408,10 @@ func (mr *MockTSSKeeperMockRecorder) DeactivateMember(ctx, groupID, address any)
 }
 
 // GetDEQueue mocks base method.
-func (m *MockTSSKeeper) GetDEQueue(ctx types0.Context, address types0.AccAddress) types.DEQueue {
+func (m *MockTSSKeeper) GetDEQueue(ctx types1.Context, address types1.AccAddress) types0.DEQueue {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDEQueue", ctx, address)
-	ret0, _ := ret[0].(types.DEQueue)
+	ret0, _ := ret[0].(types0.DEQueue)
 	return ret0
 }
 
@@ -299,10 +422,10 @@ func (mr *MockTSSKeeperMockRecorder) GetDEQueue(ctx, address any) *gomock.Call {
 }
 
 // GetGroup mocks base method.
-func (m *MockTSSKeeper) GetGroup(ctx types0.Context, groupID tss.GroupID) (types.Group, error) {
+func (m *MockTSSKeeper) GetGroup(ctx types1.Context, groupID tss.GroupID) (types0.Group, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
-	ret0, _ := ret[0].(types.Group)
+	ret0, _ := ret[0].(types0.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -314,10 +437,10 @@ func (mr *MockTSSKeeperMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
 }
 
 // GetMemberByAddress mocks base method.
-func (m *MockTSSKeeper) GetMemberByAddress(ctx types0.Context, groupID tss.GroupID, address string) (types.Member, error) {
+func (m *MockTSSKeeper) GetMemberByAddress(ctx types1.Context, groupID tss.GroupID, address string) (types0.Member, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMemberByAddress", ctx, groupID, address)
-	ret0, _ := ret[0].(types.Member)
+	ret0, _ := ret[0].(types0.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -329,10 +452,10 @@ func (mr *MockTSSKeeperMockRecorder) GetMemberByAddress(ctx, groupID, address an
 }
 
 // GetSigning mocks base method.
-func (m *MockTSSKeeper) GetSigning(ctx types0.Context, signingID tss.SigningID) (types.Signing, error) {
+func (m *MockTSSKeeper) GetSigning(ctx types1.Context, signingID tss.SigningID) (types0.Signing, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSigning", ctx, signingID)
-	ret0, _ := ret[0].(types.Signing)
+	ret0, _ := ret[0].(types0.Signing)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -344,10 +467,10 @@ func (mr *MockTSSKeeperMockRecorder) GetSigning(ctx, signingID any) *gomock.Call
 }
 
 // GetSigningResult mocks base method.
-func (m *MockTSSKeeper) GetSigningResult(ctx types0.Context, signingID tss.SigningID) (*types.SigningResult, error) {
+func (m *MockTSSKeeper) GetSigningResult(ctx types1.Context, signingID tss.SigningID) (*types0.SigningResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSigningResult", ctx, signingID)
-	ret0, _ := ret[0].(*types.SigningResult)
+	ret0, _ := ret[0].(*types0.SigningResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -359,10 +482,10 @@ func (mr *MockTSSKeeperMockRecorder) GetSigningResult(ctx, signingID any) *gomoc
 }
 
 // MustGetGroup mocks base method.
-func (m *MockTSSKeeper) MustGetGroup(ctx types0.Context, groupID tss.GroupID) types.Group {
+func (m *MockTSSKeeper) MustGetGroup(ctx types1.Context, groupID tss.GroupID) types0.Group {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustGetGroup", ctx, groupID)
-	ret0, _ := ret[0].(types.Group)
+	ret0, _ := ret[0].(types0.Group)
 	return ret0
 }
 
@@ -373,10 +496,10 @@ func (mr *MockTSSKeeperMockRecorder) MustGetGroup(ctx, groupID any) *gomock.Call
 }
 
 // MustGetMembers mocks base method.
-func (m *MockTSSKeeper) MustGetMembers(ctx types0.Context, groupID tss.GroupID) []types.Member {
+func (m *MockTSSKeeper) MustGetMembers(ctx types1.Context, groupID tss.GroupID) []types0.Member {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustGetMembers", ctx, groupID)
-	ret0, _ := ret[0].([]types.Member)
+	ret0, _ := ret[0].([]types0.Member)
 	return ret0
 }
 
@@ -387,10 +510,10 @@ func (mr *MockTSSKeeperMockRecorder) MustGetMembers(ctx, groupID any) *gomock.Ca
 }
 
 // MustGetSigning mocks base method.
-func (m *MockTSSKeeper) MustGetSigning(ctx types0.Context, signingID tss.SigningID) types.Signing {
+func (m *MockTSSKeeper) MustGetSigning(ctx types1.Context, signingID tss.SigningID) types0.Signing {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustGetSigning", ctx, signingID)
-	ret0, _ := ret[0].(types.Signing)
+	ret0, _ := ret[0].(types0.Signing)
 	return ret0
 }
 
@@ -401,7 +524,7 @@ func (mr *MockTSSKeeperMockRecorder) MustGetSigning(ctx, signingID any) *gomock.
 }
 
 // RequestSigning mocks base method.
-func (m *MockTSSKeeper) RequestSigning(ctx types0.Context, groupID tss.GroupID, originator types.Originator, content types.Content) (tss.SigningID, error) {
+func (m *MockTSSKeeper) RequestSigning(ctx types1.Context, groupID tss.GroupID, originator types0.Originator, content types0.Content) (tss.SigningID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RequestSigning", ctx, groupID, originator, content)
 	ret0, _ := ret[0].(tss.SigningID)
@@ -414,3 +537,54 @@ func (mr *MockTSSKeeperMockRecorder) RequestSigning(ctx, groupID, originator, co
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSigning", reflect.TypeOf((*MockTSSKeeper)(nil).RequestSigning), ctx, groupID, originator, content)
 }
+
+// MockRollingseedKeeper is a mock of RollingseedKeeper interface.
+type MockRollingseedKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockRollingseedKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockRollingseedKeeperMockRecorder is the mock recorder for MockRollingseedKeeper.
+type MockRollingseedKeeperMockRecorder struct {
+	mock *MockRollingseedKeeper
+}
+
+// NewMockRollingseedKeeper creates a new mock instance.
+func NewMockRollingseedKeeper(ctrl *gomock.Controller) *MockRollingseedKeeper {
+	mock := &MockRollingseedKeeper{ctrl: ctrl}
+	mock.recorder = &MockRollingseedKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRollingseedKeeper) EXPECT() *MockRollingseedKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetBeacon mocks base method.
+func (m *MockRollingseedKeeper) GetBeacon(ctx types1.Context) (types.Beacon, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeacon", ctx)
+	ret0, _ := ret[0].(types.Beacon)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetBeacon indicates an expected call of GetBeacon.
+func (mr *MockRollingseedKeeperMockRecorder) GetBeacon(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeacon", reflect.TypeOf((*MockRollingseedKeeper)(nil).GetBeacon), ctx)
+}
+
+// SetBeacon mocks base method.
+func (m *MockRollingseedKeeper) SetBeacon(ctx types1.Context, beacon types.Beacon) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBeacon", ctx, beacon)
+}
+
+// SetBeacon indicates an expected call of SetBeacon.
+func (mr *MockRollingseedKeeperMockRecorder) SetBeacon(ctx, beacon any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBeacon", reflect.TypeOf((*MockRollingseedKeeper)(nil).SetBeacon), ctx, beacon)
+}
@@ -10,7 +10,10 @@ import (
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-const GroupTransitionMsgPrefix = "\x61\xb9\xb7\x41" // tss.Hash([]byte("Transition"))[:4]
+const (
+	GroupTransitionMsgPrefix = "\x61\xb9\xb7\x41" // tss.Hash([]byte("Transition"))[:4]
+	BeaconMsgPrefix          = "\x32\xdc\x09\x88" // tss.Hash([]byte("Beacon"))[:4]
+)
 
 // NewSignatureOrderHandler implements the Handler interface for tss module-based
 // request signatures (i.e. TextSignatureOrder)
@@ -27,6 +30,16 @@ func NewSignatureOrderHandler() tsstypes.Handler {
 				[]byte(""),
 			), nil
 
+		case *types.BeaconSignatureOrder:
+			return bytes.Join(
+				[][]byte{
+					[]byte(BeaconMsgPrefix),
+					sdk.Uint64ToBigEndian(c.Round),
+					c.PreviousValue,
+				},
+				[]byte(""),
+			), nil
+
 		default:
 			return nil, sdkerrors.ErrUnknownRequest.Wrapf(
 				"unrecognized tss request signature message type: %s",
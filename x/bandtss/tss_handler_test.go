@@ -11,4 +11,5 @@ import (
 
 func TestEncoderPrefix(t *testing.T) {
 	require.Equal(t, []byte(bandtss.GroupTransitionMsgPrefix), tss.Hash([]byte("Transition"))[:4])
+	require.Equal(t, []byte(bandtss.BeaconMsgPrefix), tss.Hash([]byte("Beacon"))[:4])
 }
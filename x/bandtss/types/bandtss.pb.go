@@ -5,8 +5,10 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_pkg_tss "github.com/bandprotocol/chain/v3/pkg/tss"
+	types1 "github.com/bandprotocol/chain/v3/x/tss/types"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -448,6 +450,172 @@ func (m *GroupTransitionSignatureOrder) GetTransitionTime() time.Time {
 	return time.Time{}
 }
 
+// BeaconSignatureOrder defines a signature order for a round of the randomness beacon.
+type BeaconSignatureOrder struct {
+	// round is the round of the beacon value to be derived from the signature.
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// previous_value is the beacon value of the previous round that the current group needs to sign.
+	PreviousValue github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=previous_value,json=previousValue,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"previous_value,omitempty"`
+}
+
+func (m *BeaconSignatureOrder) Reset()         { *m = BeaconSignatureOrder{} }
+func (m *BeaconSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*BeaconSignatureOrder) ProtoMessage()    {}
+func (*BeaconSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bc325518cc10c44, []int{5}
+}
+func (m *BeaconSignatureOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconSignatureOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconSignatureOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconSignatureOrder.Merge(m, src)
+}
+func (m *BeaconSignatureOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconSignatureOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconSignatureOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconSignatureOrder proto.InternalMessageInfo
+
+func (m *BeaconSignatureOrder) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BeaconSignatureOrder) GetPreviousValue() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.PreviousValue
+	}
+	return nil
+}
+
+// Evidence is a record of a proven misbehavior of a member.
+type Evidence struct {
+	// id is the unique identifier of the evidence.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the address of the misbehaving member.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the group ID in which the misbehavior occurred.
+	GroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"group_id,omitempty"`
+	// misbehavior_type is the type of the misbehavior.
+	MisbehaviorType types1.MisbehaviorType `protobuf:"varint,4,opt,name=misbehavior_type,json=misbehaviorType,proto3,enum=band.tss.v1beta1.MisbehaviorType" json:"misbehavior_type,omitempty"`
+	// signing_id is the tss signing ID related to the misbehavior, if any.
+	SigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,5,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"signing_id,omitempty"`
+	// slashed_amount is the amount of tokens slashed from the member's validator, if any.
+	SlashedAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	// height is the block height at which the evidence is recorded.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block timestamp at which the evidence is recorded.
+	Time time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	// restake_slashed_coins is the coins slashed from the member's stake in the restake module, if any.
+	RestakeSlashedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=restake_slashed_coins,json=restakeSlashedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"restake_slashed_coins"`
+}
+
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bc325518cc10c44, []int{6}
+}
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Evidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(m, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Evidence) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Evidence) GetGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *Evidence) GetMisbehaviorType() types1.MisbehaviorType {
+	if m != nil {
+		return m.MisbehaviorType
+	}
+	return types1.MISBEHAVIOR_TYPE_UNSPECIFIED
+}
+
+func (m *Evidence) GetSigningID() github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.SigningID
+	}
+	return 0
+}
+
+func (m *Evidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Evidence) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Evidence) GetRestakeSlashedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RestakeSlashedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("band.bandtss.v1beta1.TransitionStatus", TransitionStatus_name, TransitionStatus_value)
 	proto.RegisterType((*Member)(nil), "band.bandtss.v1beta1.Member")
@@ -455,6 +623,8 @@ func init() {
 	proto.RegisterType((*Signing)(nil), "band.bandtss.v1beta1.Signing")
 	proto.RegisterType((*GroupTransition)(nil), "band.bandtss.v1beta1.GroupTransition")
 	proto.RegisterType((*GroupTransitionSignatureOrder)(nil), "band.bandtss.v1beta1.GroupTransitionSignatureOrder")
+	proto.RegisterType((*BeaconSignatureOrder)(nil), "band.bandtss.v1beta1.BeaconSignatureOrder")
+	proto.RegisterType((*Evidence)(nil), "band.bandtss.v1beta1.Evidence")
 }
 
 func init() {
@@ -462,67 +632,83 @@ func init() {
 }
 
 var fileDescriptor_2bc325518cc10c44 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xd6, 0xca, 0x1f, 0x92, 0xd6, 0x86, 0xac, 0xac, 0x63, 0x43, 0xf6, 0xfb, 0x86, 0x54, 0x95,
-	0xa2, 0x15, 0x0a, 0x84, 0x4c, 0x94, 0x9e, 0x7c, 0x68, 0x21, 0xc9, 0xb2, 0x4a, 0x14, 0x96, 0x05,
-	0x4a, 0x46, 0x8b, 0x5c, 0x08, 0x7e, 0xac, 0x98, 0x85, 0x2d, 0xae, 0xc2, 0x5d, 0x19, 0xf6, 0x3f,
-	0x08, 0xd0, 0x4b, 0x7e, 0x42, 0x81, 0x5e, 0x8a, 0x1e, 0x0a, 0x14, 0xe8, 0x8f, 0x08, 0x72, 0xca,
-	0xb1, 0x97, 0xd2, 0x05, 0x7d, 0xe9, 0x6f, 0xf0, 0xa9, 0xe0, 0x92, 0xfa, 0xb4, 0x8b, 0x3a, 0x46,
-	0x2e, 0xd2, 0xce, 0xce, 0xcc, 0xce, 0x3c, 0xcf, 0x33, 0x5c, 0x12, 0x96, 0x2d, 0xd3, 0x73, 0xd4,
-	0xe8, 0x87, 0x33, 0xa6, 0x9e, 0x3d, 0xb3, 0x30, 0x37, 0x9f, 0x8d, 0x6d, 0x65, 0xe8, 0x53, 0x4e,
-	0xd1, 0xc3, 0xc8, 0x54, 0xc6, 0x7b, 0x49, 0xcc, 0xee, 0x43, 0x97, 0xba, 0x54, 0x04, 0xa8, 0xd1,
-	0x2a, 0x8e, 0xdd, 0x95, 0x5d, 0x4a, 0xdd, 0x53, 0xac, 0x0a, 0xcb, 0x1a, 0xf5, 0x55, 0x4e, 0x06,
-	0x98, 0x71, 0x73, 0x30, 0x4c, 0x02, 0x24, 0x9b, 0xb2, 0x01, 0x65, 0xaa, 0x65, 0x32, 0x3c, 0xa9,
-	0x67, 0x53, 0xe2, 0x25, 0xfe, 0x9d, 0xd8, 0x6f, 0xc4, 0x27, 0xc7, 0x46, 0xec, 0x2a, 0xff, 0x09,
-	0xe0, 0xea, 0x21, 0x1e, 0x58, 0xd8, 0x47, 0x45, 0x98, 0x31, 0x1d, 0xc7, 0xc7, 0x8c, 0x15, 0x41,
-	0x09, 0x54, 0x72, 0xfa, 0xd8, 0x44, 0x2f, 0x60, 0xd6, 0xf5, 0xe9, 0x68, 0x68, 0x10, 0xa7, 0x98,
-	0x2e, 0x81, 0xca, 0x72, 0xfd, 0xeb, 0x30, 0x90, 0x33, 0xad, 0x68, 0x4f, 0xdb, 0xbf, 0x0e, 0xe4,
-	0xa7, 0x2e, 0xe1, 0x2f, 0x47, 0x96, 0x62, 0xd3, 0x81, 0xc0, 0x29, 0xce, 0xb6, 0xe9, 0xa9, 0x6a,
-	0xbf, 0x34, 0x89, 0xa7, 0x9e, 0x3d, 0x57, 0x87, 0x27, 0xae, 0x1a, 0x21, 0x4d, 0x72, 0xf4, 0x8c,
-	0x38, 0x50, 0x73, 0xd0, 0xff, 0x60, 0x8e, 0x30, 0xc3, 0xb4, 0x39, 0x39, 0xc3, 0xc5, 0xa5, 0x12,
-	0xa8, 0x64, 0xf5, 0x2c, 0x61, 0x35, 0x61, 0xa3, 0x3d, 0xb8, 0xc2, 0x88, 0x67, 0xe3, 0xe2, 0x72,
-	0x09, 0x54, 0xd6, 0xaa, 0xbb, 0x4a, 0xcc, 0x84, 0x32, 0x66, 0x42, 0xe9, 0x8d, 0x99, 0xa8, 0x67,
-	0xdf, 0x06, 0x72, 0xea, 0xcd, 0xa5, 0x0c, 0xf4, 0x38, 0x65, 0x6f, 0xf9, 0xef, 0x1f, 0x65, 0x50,
-	0xfe, 0x0d, 0xc0, 0xf5, 0xc6, 0xc8, 0xf7, 0xb1, 0xc7, 0x45, 0xe9, 0x39, 0x2c, 0xe0, 0x23, 0x63,
-	0x69, 0xc2, 0xb5, 0x18, 0x88, 0x11, 0x29, 0x24, 0xa8, 0xba, 0x6b, 0xd3, 0x30, 0x4e, 0x8c, 0x5c,
-	0xe5, 0x60, 0x09, 0x66, 0xba, 0xc4, 0xf5, 0x88, 0xe7, 0xa2, 0xc7, 0x30, 0x3d, 0x69, 0x74, 0x33,
-	0x0c, 0xe4, 0xb4, 0xe8, 0x31, 0x97, 0xb8, 0xb5, 0x7d, 0x3d, 0x4d, 0x1c, 0xf4, 0x0a, 0xe6, 0xfb,
-	0x18, 0x1b, 0x43, 0xec, 0x1b, 0x8c, 0xb8, 0x1e, 0xf6, 0x8b, 0xe9, 0xd2, 0x52, 0x65, 0xad, 0xba,
-	0xa3, 0x24, 0x5a, 0x47, 0x83, 0x31, 0x1e, 0x32, 0xa5, 0x41, 0x89, 0x57, 0x7f, 0x1a, 0x55, 0xfe,
-	0xe5, 0x52, 0xae, 0xcc, 0xa0, 0x4d, 0xa6, 0x28, 0xfe, 0x7b, 0xc2, 0x9c, 0x13, 0x95, 0x5f, 0x0c,
-	0x31, 0x13, 0x09, 0x4c, 0x5f, 0xef, 0x63, 0xdc, 0xc1, 0x7e, 0x57, 0x14, 0x40, 0xff, 0x87, 0x39,
-	0x1f, 0xbf, 0x1a, 0x61, 0xc6, 0xb1, 0x2f, 0x64, 0xcb, 0xe9, 0xd3, 0x0d, 0xf4, 0x1a, 0xc0, 0xa2,
-	0x1d, 0xb3, 0x6e, 0xc4, 0x6c, 0xb3, 0xb8, 0xe1, 0x88, 0xf5, 0x65, 0x01, 0xe6, 0x28, 0x0c, 0xe4,
-	0xad, 0x59, 0x65, 0x26, 0x90, 0xae, 0x03, 0xb9, 0x7a, 0x67, 0x0d, 0xa6, 0x44, 0x6c, 0xd9, 0xb7,
-	0x1c, 0xe6, 0xa0, 0x1f, 0x00, 0xdc, 0x21, 0x9e, 0x4d, 0x07, 0x51, 0xf5, 0x1b, 0xbd, 0xac, 0x88,
-	0x5e, 0x3a, 0x61, 0x20, 0x6f, 0x6b, 0x49, 0xd0, 0x47, 0x69, 0x66, 0x9b, 0xdc, 0x76, 0x9a, 0x93,
-	0x0c, 0xe5, 0xe5, 0x0a, 0xdc, 0x10, 0x8e, 0x9e, 0x6f, 0x7a, 0x8c, 0x70, 0x42, 0x3d, 0x64, 0x41,
-	0x38, 0xd3, 0x57, 0x2c, 0x78, 0x23, 0x9c, 0x95, 0xfa, 0x9e, 0xad, 0xe4, 0xd8, 0x84, 0x8b, 0x21,
-	0x2c, 0xcc, 0xab, 0x32, 0x79, 0x9e, 0x0f, 0xc2, 0x40, 0xce, 0xcf, 0xaa, 0x71, 0xcf, 0x47, 0x21,
-	0x3f, 0x2b, 0x82, 0xe6, 0x20, 0x0c, 0xb7, 0xe6, 0x2b, 0x0e, 0x47, 0x96, 0x71, 0x82, 0x2f, 0xc4,
-	0xc8, 0xac, 0xd7, 0xab, 0xd7, 0x81, 0xac, 0xdc, 0xb9, 0x48, 0x87, 0x12, 0x8f, 0xeb, 0x68, 0xb6,
-	0x44, 0x67, 0x64, 0x7d, 0x8b, 0x2f, 0x10, 0x83, 0x0f, 0x16, 0x34, 0x9e, 0xcc, 0x59, 0x2b, 0x0c,
-	0xe4, 0x8d, 0x39, 0x6d, 0xef, 0x09, 0x6d, 0x63, 0x4e, 0x52, 0xcd, 0x41, 0x2e, 0xdc, 0x5e, 0x28,
-	0x3a, 0x06, 0xb7, 0x72, 0x6f, 0x70, 0x9b, 0x73, 0x45, 0x12, 0x74, 0x5f, 0xc1, 0x55, 0xc6, 0x4d,
-	0x3e, 0x62, 0xc5, 0xd5, 0x12, 0xa8, 0xe4, 0xab, 0x9f, 0x29, 0xb7, 0xbd, 0x3c, 0x94, 0xe9, 0x30,
-	0x75, 0x45, 0xb4, 0x9e, 0x64, 0xa1, 0x1a, 0xcc, 0xe1, 0x73, 0x6c, 0xc7, 0x97, 0x52, 0xe6, 0x03,
-	0x2e, 0xa5, 0x6c, 0x94, 0x16, 0x39, 0x90, 0x02, 0x37, 0x09, 0x33, 0xfa, 0xd4, 0xb7, 0xb1, 0xc1,
-	0x27, 0x75, 0x8a, 0x59, 0x71, 0x5f, 0x3f, 0x20, 0xec, 0x20, 0xf2, 0x4c, 0x1b, 0x28, 0xbf, 0x03,
-	0xf0, 0xd1, 0xc2, 0x84, 0x47, 0x13, 0x69, 0xf2, 0x91, 0x8f, 0x8f, 0x7c, 0x07, 0xfb, 0xe8, 0x10,
-	0x66, 0xc6, 0x74, 0x01, 0x41, 0xd7, 0x97, 0x0b, 0xaa, 0xd8, 0x74, 0x80, 0xb9, 0xd5, 0xe7, 0xd3,
-	0xc5, 0x29, 0xb1, 0x98, 0x6a, 0x5d, 0x70, 0xcc, 0x94, 0x6f, 0xf0, 0x79, 0x3d, 0x5a, 0xe8, 0xab,
-	0xc3, 0x98, 0xa3, 0x43, 0xb8, 0x31, 0xed, 0xeb, 0xc3, 0xaf, 0xdf, 0xfc, 0x34, 0x39, 0x72, 0xef,
-	0xad, 0xbd, 0xfb, 0xfd, 0x49, 0xa6, 0x41, 0x3d, 0x8e, 0x3d, 0xfe, 0xc5, 0xaf, 0x00, 0x16, 0x16,
-	0xc9, 0x45, 0x9f, 0xc0, 0x47, 0x3d, 0xbd, 0xd6, 0xee, 0x6a, 0x3d, 0xed, 0xa8, 0x6d, 0x74, 0x7b,
-	0xb5, 0xde, 0x71, 0xd7, 0x38, 0x6e, 0x77, 0x3b, 0xcd, 0x86, 0x76, 0xa0, 0x35, 0xf7, 0x0b, 0x29,
-	0xf4, 0x29, 0x2c, 0xdd, 0x0c, 0x69, 0xe8, 0xcd, 0x5a, 0x4f, 0x6b, 0xb7, 0x8c, 0x96, 0x7e, 0x74,
-	0xdc, 0x29, 0x00, 0x54, 0x86, 0xd2, 0xcd, 0xa8, 0xef, 0x6a, 0x9a, 0x08, 0xea, 0x6a, 0xad, 0x76,
-	0x21, 0x8d, 0x3e, 0x87, 0x8f, 0xff, 0x3d, 0xa6, 0xf9, 0x7d, 0xb3, 0x71, 0x1c, 0x39, 0x0a, 0x4b,
-	0xbb, 0xcb, 0xaf, 0x7f, 0x92, 0x52, 0xf5, 0xf6, 0xcf, 0xa1, 0x04, 0xde, 0x86, 0x12, 0x78, 0x1f,
-	0x4a, 0xe0, 0xaf, 0x50, 0x02, 0x6f, 0xae, 0xa4, 0xd4, 0xfb, 0x2b, 0x29, 0xf5, 0xc7, 0x95, 0x94,
-	0x7a, 0xf1, 0xdf, 0xa3, 0x7f, 0x3e, 0xf9, 0x78, 0x11, 0x2f, 0x00, 0x6b, 0x55, 0x84, 0x3c, 0xff,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x1b, 0x02, 0x05, 0xd9, 0x08, 0x00, 0x00,
+	// 1208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0x3a, 0x7e, 0x9d, 0xb4, 0x8e, 0x3b, 0x79, 0xd1, 0x36, 0xff, 0x7f, 0xbd, 0xae, 0x8b,
+	0xc0, 0x02, 0x65, 0xb7, 0x75, 0x39, 0xa0, 0x1e, 0x40, 0xb6, 0xeb, 0x86, 0x15, 0xc4, 0xb1, 0xd6,
+	0x0e, 0xa0, 0x72, 0x58, 0xed, 0xcb, 0x64, 0x3d, 0x4a, 0xbc, 0xe3, 0xee, 0xcc, 0x5a, 0xcd, 0x89,
+	0x6b, 0x25, 0x2e, 0xe5, 0xc2, 0x19, 0x89, 0x0b, 0xe2, 0x80, 0x84, 0xd4, 0x0f, 0x51, 0xf5, 0x54,
+	0xf5, 0x84, 0x90, 0xd8, 0x22, 0xf7, 0xc2, 0x67, 0xc8, 0x09, 0xed, 0xec, 0xfa, 0x35, 0x41, 0x34,
+	0x51, 0xb8, 0xb4, 0xfb, 0xcc, 0xf3, 0xf6, 0x7b, 0x9e, 0xe7, 0x37, 0xf3, 0x38, 0xa0, 0x6c, 0x1a,
+	0xae, 0xad, 0x84, 0xff, 0x30, 0x4a, 0x95, 0xe1, 0x1d, 0x13, 0x31, 0xe3, 0xce, 0x58, 0x96, 0x07,
+	0x1e, 0x61, 0x04, 0xae, 0x87, 0xa2, 0x3c, 0x3e, 0x8b, 0x6d, 0xb6, 0xd6, 0x1d, 0xe2, 0x10, 0x6e,
+	0xa0, 0x84, 0x5f, 0x91, 0xed, 0x96, 0xe4, 0x10, 0xe2, 0x1c, 0x21, 0x85, 0x4b, 0xa6, 0x7f, 0xa0,
+	0x30, 0xdc, 0x47, 0x94, 0x19, 0xfd, 0x41, 0x6c, 0x50, 0xb4, 0x08, 0xed, 0x13, 0xaa, 0x98, 0x06,
+	0x45, 0x93, 0x7c, 0x16, 0xc1, 0x6e, 0xac, 0xbf, 0x1e, 0xe9, 0xf5, 0x28, 0x72, 0x24, 0xc4, 0xaa,
+	0x2d, 0x8e, 0x75, 0x16, 0xe7, 0x04, 0x63, 0xf9, 0x0f, 0x01, 0xa4, 0x77, 0x51, 0xdf, 0x44, 0x1e,
+	0x14, 0x41, 0xc6, 0xb0, 0x6d, 0x0f, 0x51, 0x2a, 0x0a, 0x25, 0xa1, 0x92, 0xd3, 0xc6, 0x22, 0x7c,
+	0x08, 0xb2, 0x8e, 0x47, 0xfc, 0x81, 0x8e, 0x6d, 0x31, 0x51, 0x12, 0x2a, 0xc9, 0xfa, 0x27, 0xa3,
+	0x40, 0xca, 0xec, 0x84, 0x67, 0xea, 0xfd, 0x93, 0x40, 0xba, 0xed, 0x60, 0xd6, 0xf3, 0x4d, 0xd9,
+	0x22, 0x7d, 0xde, 0x03, 0x1e, 0xdb, 0x22, 0x47, 0x8a, 0xd5, 0x33, 0xb0, 0xab, 0x0c, 0xef, 0x2a,
+	0x83, 0x43, 0x87, 0x67, 0x8d, 0x7d, 0xb4, 0x0c, 0x0f, 0xa8, 0xda, 0xf0, 0x7f, 0x20, 0x87, 0xa9,
+	0x6e, 0x58, 0x0c, 0x0f, 0x91, 0xb8, 0x5c, 0x12, 0x2a, 0x59, 0x2d, 0x8b, 0x69, 0x8d, 0xcb, 0xf0,
+	0x1e, 0x48, 0x51, 0xec, 0x5a, 0x48, 0x4c, 0x96, 0x84, 0xca, 0x4a, 0x75, 0x4b, 0x8e, 0xba, 0x24,
+	0x8f, 0xbb, 0x24, 0x77, 0xc7, 0x5d, 0xaa, 0x67, 0x9f, 0x07, 0xd2, 0xd2, 0xd3, 0xd7, 0x92, 0xa0,
+	0x45, 0x2e, 0xf7, 0x92, 0x7f, 0xfd, 0x20, 0x09, 0xe5, 0x5f, 0x05, 0x70, 0xa5, 0xe1, 0x7b, 0x1e,
+	0x72, 0x19, 0x4f, 0x3d, 0x57, 0x8b, 0x70, 0xc9, 0xb5, 0x34, 0xc1, 0x4a, 0x54, 0x88, 0x1e, 0x4e,
+	0x4f, 0x4c, 0x9c, 0x03, 0x34, 0x88, 0x1c, 0x43, 0x55, 0x39, 0x58, 0x06, 0x99, 0x0e, 0x76, 0x5c,
+	0xec, 0x3a, 0xf0, 0x16, 0x48, 0x4c, 0x80, 0xae, 0x8d, 0x02, 0x29, 0xc1, 0x31, 0xe6, 0x62, 0xb5,
+	0x7a, 0x5f, 0x4b, 0x60, 0x1b, 0x3e, 0x02, 0xf9, 0x03, 0x84, 0xf4, 0x01, 0xf2, 0x74, 0x8a, 0x1d,
+	0x17, 0x79, 0x62, 0xa2, 0xb4, 0x5c, 0x59, 0xa9, 0x5e, 0x97, 0x63, 0x1e, 0x84, 0xa4, 0x19, 0x13,
+	0x50, 0x6e, 0x10, 0xec, 0xd6, 0x6f, 0x87, 0x99, 0x7f, 0x7e, 0x2d, 0x55, 0x66, 0xaa, 0x8d, 0x19,
+	0x16, 0xfd, 0xb7, 0x4d, 0xed, 0x43, 0x85, 0x1d, 0x0f, 0x10, 0xe5, 0x0e, 0x54, 0xbb, 0x72, 0x80,
+	0x50, 0x1b, 0x79, 0x1d, 0x9e, 0x00, 0xfe, 0x1f, 0xe4, 0x3c, 0xf4, 0xc8, 0x47, 0x94, 0x21, 0x8f,
+	0x8f, 0x2d, 0xa7, 0x4d, 0x0f, 0xe0, 0x13, 0x01, 0x88, 0x56, 0xd4, 0x75, 0x3d, 0xea, 0x36, 0x8d,
+	0x00, 0x87, 0x5d, 0x4f, 0xf2, 0x62, 0xf6, 0x46, 0x81, 0xb4, 0x31, 0x3b, 0x99, 0x49, 0x49, 0x27,
+	0x81, 0x54, 0x7d, 0xeb, 0x19, 0x4c, 0x1b, 0xb1, 0x61, 0x9d, 0x11, 0xcc, 0x86, 0xdf, 0x0a, 0xe0,
+	0x3a, 0x76, 0x2d, 0xd2, 0x0f, 0xb3, 0x9f, 0xc2, 0x92, 0xe2, 0x58, 0xda, 0xa3, 0x40, 0xda, 0x54,
+	0x63, 0xa3, 0x4b, 0x01, 0xb3, 0x89, 0xcf, 0x8a, 0x66, 0xc7, 0xa4, 0x7c, 0x9d, 0x02, 0xab, 0x5c,
+	0xd1, 0xf5, 0x0c, 0x97, 0x62, 0x86, 0x89, 0x0b, 0x4d, 0x00, 0x66, 0x70, 0x45, 0x03, 0x6f, 0x8c,
+	0x66, 0x47, 0x7d, 0x41, 0x28, 0x39, 0x3a, 0xe9, 0xc5, 0x00, 0x14, 0xe6, 0xa7, 0x32, 0xb9, 0xcf,
+	0x0f, 0x46, 0x81, 0x94, 0x9f, 0x9d, 0xc6, 0x05, 0xaf, 0x42, 0x7e, 0x76, 0x08, 0xaa, 0x0d, 0x11,
+	0xd8, 0x98, 0xcf, 0x38, 0xf0, 0x4d, 0xfd, 0x10, 0x1d, 0x73, 0xca, 0x5c, 0xa9, 0x57, 0x4f, 0x02,
+	0x49, 0x7e, 0xeb, 0x24, 0x6d, 0x82, 0x5d, 0xa6, 0xc1, 0xd9, 0x14, 0x6d, 0xdf, 0xfc, 0x0c, 0x1d,
+	0x43, 0x0a, 0xae, 0x2d, 0xcc, 0x78, 0xc2, 0xb3, 0x9d, 0x51, 0x20, 0xad, 0xce, 0xcd, 0xf6, 0x82,
+	0xa5, 0xad, 0xce, 0x8d, 0x54, 0xb5, 0xa1, 0x03, 0x36, 0x17, 0x92, 0x8e, 0x8b, 0x4b, 0x5d, 0xb8,
+	0xb8, 0xb5, 0xb9, 0x24, 0x71, 0x75, 0x1f, 0x83, 0x34, 0x65, 0x06, 0xf3, 0xa9, 0x98, 0x2e, 0x09,
+	0x95, 0x7c, 0xf5, 0x5d, 0xf9, 0xac, 0xc5, 0x22, 0x4f, 0xc9, 0xd4, 0xe1, 0xd6, 0x5a, 0xec, 0x05,
+	0x6b, 0x20, 0x87, 0x1e, 0x23, 0x2b, 0x7a, 0x94, 0x32, 0xe7, 0x78, 0x94, 0xb2, 0xa1, 0x5b, 0xa8,
+	0x80, 0x32, 0x58, 0xc3, 0x54, 0x3f, 0x20, 0x9e, 0x85, 0x74, 0x36, 0xc9, 0x23, 0x66, 0xf9, 0x7b,
+	0x7d, 0x0d, 0xd3, 0x07, 0xa1, 0x66, 0x0a, 0xa0, 0xfc, 0x42, 0x00, 0x37, 0x16, 0x18, 0x1e, 0x32,
+	0xd2, 0x60, 0xbe, 0x87, 0xf6, 0x3c, 0x1b, 0x79, 0x70, 0x17, 0x64, 0xc6, 0xed, 0x12, 0x78, 0xbb,
+	0x3e, 0x5c, 0x98, 0x8a, 0x45, 0xfa, 0x88, 0x99, 0x07, 0x6c, 0xfa, 0x71, 0x84, 0x4d, 0xaa, 0x98,
+	0xc7, 0x0c, 0x51, 0xf9, 0x53, 0xf4, 0xb8, 0x1e, 0x7e, 0x68, 0xe9, 0x41, 0xd4, 0xa3, 0x5d, 0xb0,
+	0x3a, 0xc5, 0x75, 0xfe, 0xe7, 0x37, 0x3f, 0x75, 0x0e, 0xd5, 0xf7, 0x56, 0x5e, 0x3c, 0xdb, 0xce,
+	0x34, 0x88, 0xcb, 0x90, 0xcb, 0xca, 0xdf, 0x0b, 0x60, 0xbd, 0x8e, 0x0c, 0xeb, 0x54, 0x0d, 0xeb,
+	0x20, 0xe5, 0x11, 0xdf, 0x8d, 0xaf, 0xab, 0x16, 0x09, 0xf0, 0x6b, 0x90, 0x1f, 0x78, 0x68, 0x88,
+	0x89, 0x4f, 0xf5, 0xa1, 0x71, 0xe4, 0x47, 0x48, 0x2e, 0x5a, 0xe0, 0xd5, 0x71, 0xac, 0x2f, 0xc2,
+	0x50, 0xf3, 0xc0, 0xbe, 0x4b, 0x81, 0x6c, 0x73, 0x88, 0x6d, 0xe4, 0x5a, 0x08, 0x6e, 0xce, 0x6c,
+	0x8a, 0x74, 0xb4, 0x29, 0xf8, 0x72, 0xa8, 0x4e, 0xd7, 0x7a, 0x88, 0x23, 0x57, 0x17, 0x5f, 0x3d,
+	0xdb, 0x5e, 0x8f, 0x17, 0x43, 0x2d, 0xd2, 0x74, 0x98, 0x87, 0x5d, 0xe7, 0xec, 0x85, 0xbf, 0x7c,
+	0xc9, 0x4b, 0xf2, 0x73, 0x50, 0xe8, 0x63, 0x6a, 0xa2, 0x9e, 0x31, 0xc4, 0xc4, 0xd3, 0xc3, 0x15,
+	0xc3, 0xaf, 0x6a, 0xbe, 0x7a, 0x33, 0xe2, 0xf5, 0x2c, 0xa7, 0x77, 0xa7, 0x96, 0xdd, 0xe3, 0x01,
+	0xd2, 0x56, 0xfb, 0xf3, 0x07, 0x0b, 0xcf, 0x66, 0xea, 0x3f, 0x79, 0x36, 0x35, 0x90, 0xa7, 0x47,
+	0x06, 0xed, 0x21, 0x5b, 0x37, 0xfa, 0xc4, 0x77, 0x19, 0xbf, 0x87, 0xb9, 0xfa, 0x07, 0x21, 0x7d,
+	0x7e, 0x0f, 0xa4, 0x8d, 0xa8, 0x99, 0xd4, 0x3e, 0x94, 0x31, 0x51, 0xfa, 0x06, 0xeb, 0xc9, 0xaa,
+	0xcb, 0x5e, 0x3d, 0xdb, 0x06, 0x71, 0x97, 0x55, 0x97, 0x69, 0x57, 0xe3, 0x10, 0x35, 0x1e, 0x01,
+	0x6e, 0x82, 0x74, 0x0f, 0x61, 0xa7, 0xc7, 0xf8, 0x85, 0x5c, 0xd6, 0x62, 0x09, 0x7e, 0x04, 0x92,
+	0x9c, 0xbc, 0xd9, 0x73, 0x90, 0x97, 0x7b, 0xc0, 0x6f, 0xc0, 0x86, 0x17, 0xaa, 0x0e, 0x91, 0x3e,
+	0x46, 0x1b, 0xfe, 0x3c, 0xa4, 0x62, 0xee, 0xf2, 0x7f, 0x0b, 0xac, 0xc5, 0x99, 0x3a, 0x51, 0x22,
+	0x7e, 0x18, 0xed, 0xb6, 0xf7, 0x7f, 0x11, 0x40, 0x61, 0xf1, 0x25, 0x82, 0x37, 0xc1, 0x8d, 0xae,
+	0x56, 0x6b, 0x75, 0xd4, 0xae, 0xba, 0xd7, 0xd2, 0x3b, 0xdd, 0x5a, 0x77, 0xbf, 0xa3, 0xef, 0xb7,
+	0x3a, 0xed, 0x66, 0x43, 0x7d, 0xa0, 0x36, 0xef, 0x17, 0x96, 0xe0, 0x3b, 0xa0, 0x74, 0xda, 0xa4,
+	0xa1, 0x35, 0x6b, 0x5d, 0xb5, 0xb5, 0xa3, 0xef, 0x68, 0x7b, 0xfb, 0xed, 0x82, 0x00, 0xcb, 0xa0,
+	0x78, 0xda, 0xea, 0xcb, 0x9a, 0xca, 0x8d, 0x3a, 0xea, 0x4e, 0xab, 0x90, 0x80, 0xef, 0x81, 0x5b,
+	0xff, 0x6c, 0xd3, 0xfc, 0xaa, 0xd9, 0xd8, 0x0f, 0x15, 0x85, 0xe5, 0xad, 0xe4, 0x93, 0x1f, 0x8b,
+	0x4b, 0xf5, 0xd6, 0x4f, 0xa3, 0xa2, 0xf0, 0x7c, 0x54, 0x14, 0x5e, 0x8e, 0x8a, 0xc2, 0x9f, 0xa3,
+	0xa2, 0xf0, 0xf4, 0x4d, 0x71, 0xe9, 0xe5, 0x9b, 0xe2, 0xd2, 0x6f, 0x6f, 0x8a, 0x4b, 0x0f, 0xff,
+	0x9d, 0xe8, 0x8f, 0x27, 0x7f, 0x05, 0xf0, 0x0e, 0x99, 0x69, 0x6e, 0x72, 0xf7, 0xef, 0x01, 0x00,
+	0x4f, 0x53, 0xac, 0xcf, 0x22, 0x0c, 0x00, 0x00,
 }
 
 func (this *Member) Equal(that interface{}) bool {
@@ -698,6 +884,86 @@ func (this *GroupTransitionSignatureOrder) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BeaconSignatureOrder) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeaconSignatureOrder)
+	if !ok {
+		that2, ok := that.(BeaconSignatureOrder)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.PreviousValue, that1.PreviousValue) {
+		return false
+	}
+	return true
+}
+func (this *Evidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Evidence)
+	if !ok {
+		that2, ok := that.(Evidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.MisbehaviorType != that1.MisbehaviorType {
+		return false
+	}
+	if this.SigningID != that1.SigningID {
+		return false
+	}
+	if !this.SlashedAmount.Equal(that1.SlashedAmount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if len(this.RestakeSlashedCoins) != len(that1.RestakeSlashedCoins) {
+		return false
+	}
+	for i := range this.RestakeSlashedCoins {
+		if !this.RestakeSlashedCoins[i].Equal(&that1.RestakeSlashedCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -959,6 +1225,128 @@ func (m *GroupTransitionSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *BeaconSignatureOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousValue) > 0 {
+		i -= len(m.PreviousValue)
+		copy(dAtA[i:], m.PreviousValue)
+		i = encodeVarintBandtss(dAtA, i, uint64(len(m.PreviousValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Round != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RestakeSlashedCoins) > 0 {
+		for iNdEx := len(m.RestakeSlashedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakeSlashedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBandtss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBandtss(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBandtss(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.SigningID != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.SigningID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MisbehaviorType != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.MisbehaviorType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBandtss(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBandtss(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBandtss(v)
 	base := offset
@@ -1082,10 +1470,64 @@ func (m *GroupTransitionSignatureOrder) Size() (n int) {
 	return n
 }
 
-func sovBandtss(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BeaconSignatureOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovBandtss(uint64(m.Round))
+	}
+	l = len(m.PreviousValue)
+	if l > 0 {
+		n += 1 + l + sovBandtss(uint64(l))
+	}
+	return n
 }
-func sozBandtss(x uint64) (n int) {
+
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBandtss(uint64(m.ID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBandtss(uint64(l))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovBandtss(uint64(m.GroupID))
+	}
+	if m.MisbehaviorType != 0 {
+		n += 1 + sovBandtss(uint64(m.MisbehaviorType))
+	}
+	if m.SigningID != 0 {
+		n += 1 + sovBandtss(uint64(m.SigningID))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovBandtss(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovBandtss(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBandtss(uint64(l))
+	if len(m.RestakeSlashedCoins) > 0 {
+		for _, e := range m.RestakeSlashedCoins {
+			l = e.Size()
+			n += 1 + l + sovBandtss(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBandtss(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBandtss(x uint64) (n int) {
 	return sovBandtss(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Member) Unmarshal(dAtA []byte) error {
@@ -1881,6 +2323,387 @@ func (m *GroupTransitionSignatureOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BeaconSignatureOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBandtss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconSignatureOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValue = append(m.PreviousValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousValue == nil {
+				m.PreviousValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBandtss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBandtss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviorType", wireType)
+			}
+			m.MisbehaviorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviorType |= types1.MisbehaviorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+			}
+			m.SigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningID |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeSlashedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakeSlashedCoins = append(m.RestakeSlashedCoins, types.Coin{})
+			if err := m.RestakeSlashedCoins[len(m.RestakeSlashedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBandtss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBandtss(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
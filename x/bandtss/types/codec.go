@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRequestSignature{}, "bandtss/MsgRequestSignature")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "bandtss/MsgActivate")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "bandtss/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgReadmitMember{}, "bandtss/MsgReadmitMember")
 
 	cdc.RegisterConcrete(Params{}, "bandtss/Params", nil)
 }
@@ -30,11 +31,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRequestSignature{},
 		&MsgActivate{},
 		&MsgUpdateParams{},
+		&MsgReadmitMember{},
 	)
 
 	registry.RegisterImplementations(
 		(*tsstypes.Content)(nil),
 		&GroupTransitionSignatureOrder{},
+		&BeaconSignatureOrder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
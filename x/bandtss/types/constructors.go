@@ -1,11 +1,15 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
 // NewCurrentGroup creates a new current group object.
@@ -83,3 +87,53 @@ func NewSigning(
 		IncomingGroupSigningID: incomingGroupSigningID,
 	}
 }
+
+// NewEvidence creates a new evidence object.
+func NewEvidence(
+	id uint64,
+	address sdk.AccAddress,
+	groupID tss.GroupID,
+	misbehaviorType tsstypes.MisbehaviorType,
+	signingID tss.SigningID,
+	slashedAmount sdkmath.Int,
+	restakeSlashedCoins sdk.Coins,
+	height int64,
+	time time.Time,
+) Evidence {
+	return Evidence{
+		ID:                  id,
+		Address:             address.String(),
+		GroupID:             groupID,
+		MisbehaviorType:     misbehaviorType,
+		SigningID:           signingID,
+		SlashedAmount:       slashedAmount,
+		RestakeSlashedCoins: restakeSlashedCoins,
+		Height:              height,
+		Time:                time,
+	}
+}
+
+// Validate performs basic validation of evidence information.
+func (e Evidence) Validate() error {
+	if e.ID == 0 {
+		return fmt.Errorf("evidence id cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid evidence address: %s", err)
+	}
+
+	if e.MisbehaviorType == tsstypes.MISBEHAVIOR_TYPE_UNSPECIFIED {
+		return fmt.Errorf("evidence %d has unspecified misbehavior type", e.ID)
+	}
+
+	if e.SlashedAmount.IsNil() || e.SlashedAmount.IsNegative() {
+		return fmt.Errorf("evidence %d has invalid slashed amount", e.ID)
+	}
+
+	if err := e.RestakeSlashedCoins.Validate(); err != nil {
+		return fmt.Errorf("evidence %d has invalid restake slashed coins: %w", e.ID, err)
+	}
+
+	return nil
+}
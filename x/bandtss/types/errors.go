@@ -21,4 +21,7 @@ var (
 	ErrNoIncomingGroup           = errorsmod.Register(ModuleName, 16, "no incoming group")
 	ErrInvalidGroupID            = errorsmod.Register(ModuleName, 17, "invalid group ID")
 	ErrInvalidMember             = errorsmod.Register(ModuleName, 18, "invalid member")
+	ErrMemberExcluded            = errorsmod.Register(ModuleName, 19, "member is excluded")
+	ErrMemberNotExcluded         = errorsmod.Register(ModuleName, 20, "member is not excluded")
+	ErrEvidenceNotFound          = errorsmod.Register(ModuleName, 21, "evidence not found")
 )
@@ -9,6 +9,10 @@ const (
 	EventTypeSigningRequestCreated  = "bandtss_signing_request_created"
 	EventTypeCreateSigningFailed    = "create_signing_failed"
 	EventTypeMemberDeleted          = "member_deleted"
+	EventTypeEvidenceRecorded       = "evidence_recorded"
+	EventTypeSlashFailed            = "slash_failed"
+	EventTypeMemberReadmitted       = "member_readmitted"
+	EventTypeBeaconPublished        = "beacon_published"
 
 	AttributeKeyAddress                = "address"
 	AttributeKeySigningID              = "bandtss_signing_id"
@@ -27,4 +31,12 @@ const (
 	AttributeKeySigningErrReason       = "signing_error_reason"
 	AttributeKeySigningErrCode         = "signing_error_code"
 	AttributeKeySigningErrCodespace    = "signing_error_codespace"
+	AttributeKeyEvidenceID             = "evidence_id"
+	AttributeKeyMisbehavior            = "misbehavior"
+	AttributeKeyTSSSigningID           = "tss_signing_id"
+	AttributeKeySlashedAmount          = "slashed_amount"
+	AttributeKeyRestakeSlashedCoins    = "restake_slashed_coins"
+	AttributeKeyReason                 = "reason"
+	AttributeKeyBeaconRound            = "beacon_round"
+	AttributeKeyBeaconValue            = "beacon_value"
 )
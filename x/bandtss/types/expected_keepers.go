@@ -6,8 +6,10 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
+	rollingseedtypes "github.com/bandprotocol/chain/v3/x/rollingseed/types"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
@@ -43,6 +45,30 @@ type DistrKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Slash(
+		ctx context.Context,
+		consAddr sdk.ConsAddress,
+		infractionHeight int64,
+		power int64,
+		slashFactor math.LegacyDec,
+	) (math.Int, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	PowerReduction(ctx context.Context) math.Int
+}
+
+// RestakeKeeper defines the expected restake keeper.
+type RestakeKeeper interface {
+	SlashStake(
+		ctx sdk.Context,
+		stakerAddr sdk.AccAddress,
+		fraction math.LegacyDec,
+		recipientModule string,
+	) (sdk.Coins, error)
+}
+
 // TSSKeeper defines the expected tss keeper (noalias)
 type TSSKeeper interface {
 	CreateGroup(
@@ -72,3 +98,9 @@ type TSSKeeper interface {
 	MustGetSigning(ctx sdk.Context, signingID tss.SigningID) tsstypes.Signing
 	GetSigningResult(ctx sdk.Context, signingID tss.SigningID) (*tsstypes.SigningResult, error)
 }
+
+// RollingseedKeeper defines the expected rollingseed keeper
+type RollingseedKeeper interface {
+	GetBeacon(ctx sdk.Context) (rollingseedtypes.Beacon, bool)
+	SetBeacon(ctx sdk.Context, beacon rollingseedtypes.Beacon)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instance.
@@ -9,11 +11,15 @@ func NewGenesisState(
 	params Params,
 	members []Member,
 	currentGroup CurrentGroup,
+	evidences []Evidence,
+	excludedMembers []string,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		Members:      members,
-		CurrentGroup: currentGroup,
+		Params:          params,
+		Members:         members,
+		CurrentGroup:    currentGroup,
+		Evidences:       evidences,
+		ExcludedMembers: excludedMembers,
 	}
 }
 
@@ -23,6 +29,8 @@ func DefaultGenesisState() *GenesisState {
 		DefaultParams(),
 		[]Member{},
 		CurrentGroup{},
+		[]Evidence{},
+		[]string{},
 	)
 }
 
@@ -43,5 +51,29 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	evidenceIDs := make(map[uint64]bool)
+	for _, e := range gs.Evidences {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+
+		if evidenceIDs[e.ID] {
+			return fmt.Errorf("duplicated evidence id %d", e.ID)
+		}
+		evidenceIDs[e.ID] = true
+	}
+
+	excluded := make(map[string]bool)
+	for _, addr := range gs.ExcludedMembers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid excluded member address: %s", err)
+		}
+
+		if excluded[addr] {
+			return fmt.Errorf("duplicated excluded member %s", addr)
+		}
+		excluded[addr] = true
+	}
+
 	return nil
 }
@@ -37,6 +37,11 @@ type GenesisState struct {
 	Members []Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
 	// current_group is the current group information.
 	CurrentGroup CurrentGroup `protobuf:"bytes,3,opt,name=current_group,json=currentGroup,proto3" json:"current_group"`
+	// evidences is an array containing recorded evidences of misbehaving members.
+	Evidences []Evidence `protobuf:"bytes,4,rep,name=evidences,proto3" json:"evidences"`
+	// excluded_members is a list of addresses that are excluded from signing assignments until
+	// being readmitted by governance.
+	ExcludedMembers []string `protobuf:"bytes,5,rep,name=excluded_members,json=excludedMembers,proto3" json:"excluded_members,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,6 +98,20 @@ func (m *GenesisState) GetCurrentGroup() CurrentGroup {
 	return CurrentGroup{}
 }
 
+func (m *GenesisState) GetEvidences() []Evidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExcludedMembers() []string {
+	if m != nil {
+		return m.ExcludedMembers
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// reward_percentage is the percentage of block rewards allocated to active tss members.
@@ -106,6 +125,18 @@ type Params struct {
 	MaxTransitionDuration time.Duration `protobuf:"bytes,4,opt,name=max_transition_duration,json=maxTransitionDuration,proto3,stdduration" json:"max_transition_duration"`
 	// fee_per_signer is the tokens that will be paid per signer.
 	FeePerSigner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_per_signer,json=feePerSigner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_signer"`
+	// misbehavior_slash_percentage is the percentage of the validator stake that is slashed when a member
+	// is proven to send an invalid complaint, an invalid secret share or an invalid partial signature.
+	MisbehaviorSlashPercentage uint64 `protobuf:"varint,6,opt,name=misbehavior_slash_percentage,json=misbehaviorSlashPercentage,proto3" json:"misbehavior_slash_percentage,omitempty"`
+	// non_participation_slash_percentage is the percentage of the validator stake that is slashed when a
+	// member misses max_missed_signings consecutive signings.
+	NonParticipationSlashPercentage uint64 `protobuf:"varint,7,opt,name=non_participation_slash_percentage,json=nonParticipationSlashPercentage,proto3" json:"non_participation_slash_percentage,omitempty"`
+	// max_missed_signings is the number of consecutive missed signings after which a member is penalized
+	// for non-participation. Zero disables the non-participation penalty.
+	MaxMissedSignings uint64 `protobuf:"varint,8,opt,name=max_missed_signings,json=maxMissedSignings,proto3" json:"max_missed_signings,omitempty"`
+	// beacon_interval is the number of blocks between the signing requests of the randomness beacon
+	// to the current group. Zero disables the randomness beacon.
+	BeaconInterval uint64 `protobuf:"varint,9,opt,name=beacon_interval,json=beaconInterval,proto3" json:"beacon_interval,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -176,6 +207,34 @@ func (m *Params) GetFeePerSigner() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetMisbehaviorSlashPercentage() uint64 {
+	if m != nil {
+		return m.MisbehaviorSlashPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetNonParticipationSlashPercentage() uint64 {
+	if m != nil {
+		return m.NonParticipationSlashPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedSignings() uint64 {
+	if m != nil {
+		return m.MaxMissedSignings
+	}
+	return 0
+}
+
+func (m *Params) GetBeaconInterval() uint64 {
+	if m != nil {
+		return m.BeaconInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.bandtss.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "band.bandtss.v1beta1.Params")
@@ -186,39 +245,48 @@ func init() {
 }
 
 var fileDescriptor_3fe6a4345855d3c9 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x26, 0x04, 0xb4, 0x04, 0x54, 0xac, 0xa0, 0x26, 0x15, 0xb2, 0xab, 0x9c, 0x7a,
-	0x61, 0xb7, 0xa5, 0xb7, 0x8a, 0x0b, 0x06, 0xa9, 0x12, 0x52, 0xa5, 0xc8, 0xe5, 0x04, 0x07, 0x6b,
-	0xed, 0x4c, 0xdc, 0x15, 0xf1, 0xae, 0xd9, 0x5d, 0x87, 0xf4, 0x2d, 0x38, 0x72, 0xe4, 0xcc, 0x93,
-	0xf4, 0xd8, 0x1b, 0x9c, 0x1a, 0x94, 0x5c, 0x78, 0x0c, 0xb4, 0x6b, 0x3b, 0x2d, 0xc8, 0x48, 0x70,
-	0xf1, 0x9f, 0x99, 0x6f, 0x7e, 0xf3, 0xad, 0x67, 0x8c, 0x46, 0x31, 0xe5, 0x13, 0x62, 0x2e, 0x5a,
-	0x29, 0x32, 0x3f, 0x8c, 0x41, 0xd3, 0x43, 0x92, 0x02, 0x07, 0xc5, 0x14, 0xce, 0xa5, 0xd0, 0xc2,
-	0xed, 0x9b, 0x34, 0xae, 0x34, 0xb8, 0xd2, 0xec, 0xf6, 0x53, 0x91, 0x0a, 0x2b, 0x20, 0xe6, 0xa9,
-	0xd4, 0xee, 0x7a, 0xa9, 0x10, 0xe9, 0x0c, 0x88, 0x7d, 0x8b, 0x8b, 0x29, 0x99, 0x14, 0x92, 0x6a,
-	0x26, 0x78, 0x9d, 0x4f, 0x84, 0xca, 0x84, 0x22, 0x31, 0x55, 0xb0, 0x69, 0x97, 0x08, 0x56, 0xe7,
-	0x9b, 0xfd, 0xd4, 0xbd, 0xad, 0x66, 0xf4, 0xcd, 0x41, 0xbd, 0x93, 0xd2, 0xe1, 0x99, 0xa6, 0x1a,
-	0xdc, 0x63, 0xd4, 0xcd, 0xa9, 0xa4, 0x99, 0x1a, 0x38, 0x7b, 0xce, 0xfe, 0xfd, 0x67, 0x4f, 0x70,
-	0x93, 0x63, 0x3c, 0xb6, 0x9a, 0xa0, 0x73, 0x79, 0xed, 0xb7, 0xc2, 0xaa, 0xc2, 0x7d, 0x8e, 0xee,
-	0x66, 0x90, 0xc5, 0x20, 0xd5, 0x60, 0x6b, 0xaf, 0xfd, 0xf7, 0xe2, 0x53, 0x2b, 0xaa, 0x8a, 0xeb,
-	0x12, 0xf7, 0x14, 0x3d, 0x48, 0x0a, 0x29, 0x81, 0xeb, 0x28, 0x95, 0xa2, 0xc8, 0x07, 0x6d, 0x6b,
-	0x60, 0xd4, 0xcc, 0x78, 0x59, 0x4a, 0x4f, 0x8c, 0xb2, 0x22, 0xf5, 0x92, 0x5b, 0xb1, 0xd1, 0xb2,
-	0x8d, 0xba, 0xa5, 0x4b, 0xf7, 0x05, 0x7a, 0x24, 0xe1, 0x23, 0x95, 0x93, 0x28, 0x07, 0x99, 0x00,
-	0xd7, 0x34, 0x05, 0x7b, 0xbc, 0x4e, 0xd0, 0x5f, 0x5d, 0xfb, 0xdb, 0xa1, 0x4d, 0x8e, 0x37, 0xb9,
-	0x70, 0x5b, 0xfe, 0x11, 0x71, 0x23, 0x34, 0x64, 0x9c, 0x26, 0x9a, 0xcd, 0x21, 0xca, 0x81, 0xd3,
-	0x99, 0xbe, 0x88, 0xea, 0x71, 0x0c, 0xb6, 0xac, 0xd1, 0x21, 0x2e, 0xe7, 0x85, 0xeb, 0x79, 0xe1,
-	0x57, 0x95, 0x20, 0xb8, 0x67, 0xfc, 0x7d, 0x5e, 0xfa, 0x4e, 0xb8, 0x53, 0x53, 0xc6, 0x25, 0xa4,
-	0x96, 0xb8, 0xef, 0xd0, 0x4e, 0xc6, 0x78, 0xa4, 0x25, 0xe5, 0x8a, 0x99, 0xc8, 0x0d, 0xbe, 0xfd,
-	0xef, 0xf8, 0xc7, 0x19, 0xe3, 0x6f, 0x36, 0x88, 0xdf, 0xe0, 0x74, 0xd1, 0x08, 0xef, 0xfc, 0x0f,
-	0x9c, 0x2e, 0x1a, 0xe0, 0x1f, 0xd0, 0xc3, 0x29, 0x98, 0xaf, 0x22, 0x23, 0xc5, 0x52, 0x0e, 0x72,
-	0x70, 0xc7, 0x0e, 0x7f, 0x88, 0xcb, 0xfd, 0xc4, 0x66, 0x3f, 0x6f, 0xe6, 0x26, 0x18, 0x0f, 0x0e,
-	0x0c, 0xf3, 0xeb, 0xd2, 0xdf, 0x4f, 0x99, 0x3e, 0x2f, 0x62, 0x9c, 0x88, 0x8c, 0x54, 0xcb, 0x5c,
-	0xde, 0x9e, 0xaa, 0xc9, 0x7b, 0xa2, 0x2f, 0x72, 0x50, 0xb6, 0x40, 0x85, 0xbd, 0x29, 0xc0, 0x18,
-	0xe4, 0x99, 0x6d, 0x70, 0xdc, 0xf9, 0xf9, 0xc5, 0x77, 0x82, 0xd7, 0x97, 0x2b, 0xcf, 0xb9, 0x5a,
-	0x79, 0xce, 0x8f, 0x95, 0xe7, 0x7c, 0x5a, 0x7b, 0xad, 0xab, 0xb5, 0xd7, 0xfa, 0xbe, 0xf6, 0x5a,
-	0x6f, 0x0f, 0x6e, 0x71, 0xcd, 0xe2, 0xd8, 0x53, 0x25, 0x62, 0x46, 0x92, 0x73, 0xca, 0x38, 0x99,
-	0x1f, 0x91, 0xc5, 0xe6, 0xbf, 0xb0, 0x5d, 0xe2, 0xae, 0x95, 0x1c, 0xfd, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0xe9, 0x4e, 0xb2, 0x50, 0xc4, 0x03, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb5, 0xeb, 0x36, 0x33, 0xf6, 0x23, 0x0c, 0x2d, 0x9b, 0xa6, 0x74, 0xea, 0x85,
+	0x71, 0x20, 0xd9, 0xd8, 0x6d, 0xe2, 0x00, 0x05, 0x34, 0x01, 0x9a, 0x54, 0xa5, 0x9c, 0xe0, 0x10,
+	0x39, 0xce, 0x5b, 0x6a, 0xd1, 0xd8, 0xc1, 0x76, 0x4b, 0xf7, 0x5f, 0x70, 0xe4, 0xc8, 0x99, 0x3f,
+	0x04, 0x76, 0xdc, 0x91, 0xd3, 0x86, 0xba, 0x0b, 0x7f, 0x06, 0xb2, 0x93, 0xb4, 0x65, 0x14, 0x09,
+	0x2e, 0x6d, 0xf2, 0xde, 0xf7, 0x7d, 0xfc, 0xfc, 0x7d, 0x76, 0x50, 0x33, 0xc2, 0x2c, 0xf6, 0xf5,
+	0x8f, 0x92, 0xd2, 0x1f, 0x1c, 0x44, 0xa0, 0xf0, 0x81, 0x9f, 0x00, 0x03, 0x49, 0xa5, 0x97, 0x09,
+	0xae, 0xb8, 0xbd, 0xa1, 0xd3, 0x5e, 0xa1, 0xf1, 0x0a, 0xcd, 0xf6, 0x46, 0xc2, 0x13, 0x6e, 0x04,
+	0xbe, 0x7e, 0xca, 0xb5, 0xdb, 0x6e, 0xc2, 0x79, 0xd2, 0x03, 0xdf, 0xbc, 0x45, 0xfd, 0x53, 0x3f,
+	0xee, 0x0b, 0xac, 0x28, 0x67, 0x65, 0x9e, 0x70, 0x99, 0x72, 0xe9, 0x47, 0x58, 0xc2, 0x78, 0x39,
+	0xc2, 0x69, 0x99, 0x9f, 0xdd, 0x4f, 0xb9, 0xb6, 0xd1, 0x34, 0xbf, 0xce, 0xa1, 0xe5, 0xe3, 0xbc,
+	0xc3, 0x8e, 0xc2, 0x0a, 0xec, 0x23, 0x54, 0xcf, 0xb0, 0xc0, 0xa9, 0x74, 0xac, 0x5d, 0x6b, 0xef,
+	0xd6, 0xc3, 0x1d, 0x6f, 0x56, 0xc7, 0x5e, 0xdb, 0x68, 0x5a, 0xb5, 0xf3, 0xcb, 0x46, 0x25, 0x28,
+	0x2a, 0xec, 0x47, 0x68, 0x21, 0x85, 0x34, 0x02, 0x21, 0x9d, 0xb9, 0xdd, 0xea, 0xdf, 0x8b, 0x4f,
+	0x8c, 0xa8, 0x28, 0x2e, 0x4b, 0xec, 0x13, 0x74, 0x9b, 0xf4, 0x85, 0x00, 0xa6, 0xc2, 0x44, 0xf0,
+	0x7e, 0xe6, 0x54, 0x4d, 0x03, 0xcd, 0xd9, 0x8c, 0xa7, 0xb9, 0xf4, 0x58, 0x2b, 0x0b, 0xd2, 0x32,
+	0x99, 0x8a, 0xd9, 0x2d, 0xb4, 0x04, 0x03, 0x1a, 0x03, 0x23, 0x20, 0x9d, 0x9a, 0x69, 0xc7, 0x9d,
+	0x8d, 0x7a, 0x5e, 0xc8, 0x0a, 0xcc, 0xa4, 0xcc, 0xbe, 0x8f, 0xd6, 0x60, 0x48, 0x7a, 0xfd, 0x18,
+	0xe2, 0xb0, 0xdc, 0xd9, 0xfc, 0x6e, 0x75, 0x6f, 0x29, 0x58, 0x2d, 0xe3, 0xf9, 0x5e, 0x64, 0xf3,
+	0xdb, 0x3c, 0xaa, 0xe7, 0xa6, 0xd8, 0x4f, 0xd0, 0xba, 0x80, 0x0f, 0x58, 0xc4, 0x61, 0x06, 0x82,
+	0x00, 0x53, 0x38, 0x01, 0xe3, 0x66, 0xad, 0xb5, 0x31, 0xba, 0x6c, 0xac, 0x05, 0x26, 0xd9, 0x1e,
+	0xe7, 0x82, 0x35, 0x71, 0x23, 0x62, 0x87, 0x68, 0x8b, 0x32, 0x4c, 0x14, 0x1d, 0x40, 0x98, 0x01,
+	0xc3, 0x3d, 0x75, 0x16, 0x96, 0xd3, 0x77, 0xe6, 0x8c, 0x2f, 0x5b, 0x5e, 0x7e, 0x3c, 0xbc, 0xf2,
+	0x78, 0x78, 0xcf, 0x0a, 0x41, 0x6b, 0x51, 0xef, 0xe3, 0xd3, 0x55, 0xc3, 0x0a, 0x36, 0x4b, 0x4a,
+	0x3b, 0x87, 0x94, 0x12, 0xfb, 0x2d, 0xda, 0x4c, 0x29, 0x0b, 0x95, 0xc0, 0x4c, 0x52, 0x1d, 0x99,
+	0xe0, 0xab, 0xff, 0x8e, 0xbf, 0x9b, 0x52, 0xf6, 0x7a, 0x8c, 0xf8, 0x0d, 0x8e, 0x87, 0x33, 0xe1,
+	0xb5, 0xff, 0x81, 0xe3, 0xe1, 0x0c, 0xf8, 0x7b, 0xb4, 0x72, 0x0a, 0xda, 0x15, 0x11, 0x4a, 0x9a,
+	0x30, 0x10, 0x66, 0x22, 0x9a, 0x99, 0x5f, 0x07, 0x4f, 0x5f, 0x87, 0xc9, 0x31, 0xe1, 0x94, 0xb5,
+	0xf6, 0x35, 0xf3, 0xcb, 0x55, 0x63, 0x2f, 0xa1, 0xaa, 0xdb, 0x8f, 0x3c, 0xc2, 0x53, 0xbf, 0xb8,
+	0x3b, 0xf9, 0xdf, 0x03, 0x19, 0xbf, 0xf3, 0xd5, 0x59, 0x06, 0xd2, 0x14, 0xc8, 0x60, 0xf9, 0x14,
+	0xa0, 0x0d, 0xa2, 0x63, 0x16, 0xb0, 0x1f, 0xa3, 0x9d, 0x94, 0xca, 0x08, 0xba, 0x78, 0x40, 0xb9,
+	0x08, 0x65, 0x0f, 0xcb, 0xee, 0xf4, 0x6c, 0xeb, 0x7a, 0xb6, 0xc1, 0xf6, 0x94, 0xa6, 0xa3, 0x25,
+	0x53, 0xf3, 0x7c, 0x85, 0x9a, 0x8c, 0xb3, 0x30, 0xc3, 0x42, 0x51, 0x42, 0x33, 0xb3, 0x93, 0x3f,
+	0x39, 0x0b, 0x86, 0xd3, 0x60, 0x9c, 0xb5, 0xa7, 0x85, 0x37, 0x61, 0x1e, 0xba, 0xa3, 0xed, 0x4d,
+	0xa9, 0x94, 0x10, 0x1b, 0x13, 0x28, 0x4b, 0xa4, 0xb3, 0x68, 0xaa, 0xd7, 0x53, 0x3c, 0x3c, 0x31,
+	0x99, 0x4e, 0x91, 0xb0, 0xef, 0xa1, 0xd5, 0x08, 0x30, 0xe1, 0x2c, 0xa4, 0x4c, 0x81, 0x18, 0xe0,
+	0x9e, 0xb3, 0x64, 0xb4, 0x2b, 0x79, 0xf8, 0x45, 0x11, 0x3d, 0xaa, 0xfd, 0xfc, 0xdc, 0xb0, 0x5a,
+	0x2f, 0xcf, 0x47, 0xae, 0x75, 0x31, 0x72, 0xad, 0x1f, 0x23, 0xd7, 0xfa, 0x78, 0xed, 0x56, 0x2e,
+	0xae, 0xdd, 0xca, 0xf7, 0x6b, 0xb7, 0xf2, 0x66, 0x7f, 0xca, 0x3f, 0x7d, 0x89, 0xcc, 0xf4, 0x08,
+	0xef, 0xf9, 0xa4, 0x8b, 0x29, 0xf3, 0x07, 0x87, 0xfe, 0x70, 0xfc, 0xb9, 0x31, 0x6e, 0x46, 0x75,
+	0x23, 0x39, 0xfc, 0x35, 0x00, 0xac, 0x92, 0xa4, 0x1e, 0x1b, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -260,6 +328,18 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MisbehaviorSlashPercentage != that1.MisbehaviorSlashPercentage {
+		return false
+	}
+	if this.NonParticipationSlashPercentage != that1.NonParticipationSlashPercentage {
+		return false
+	}
+	if this.MaxMissedSignings != that1.MaxMissedSignings {
+		return false
+	}
+	if this.BeaconInterval != that1.BeaconInterval {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -282,6 +362,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExcludedMembers) > 0 {
+		for iNdEx := len(m.ExcludedMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedMembers[iNdEx])
+			copy(dAtA[i:], m.ExcludedMembers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExcludedMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.CurrentGroup.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -339,6 +442,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeaconInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BeaconInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxMissedSignings != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMissedSignings))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NonParticipationSlashPercentage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NonParticipationSlashPercentage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MisbehaviorSlashPercentage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MisbehaviorSlashPercentage))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.FeePerSigner) > 0 {
 		for iNdEx := len(m.FeePerSigner) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -412,6 +535,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.CurrentGroup.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExcludedMembers) > 0 {
+		for _, s := range m.ExcludedMembers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -436,6 +571,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MisbehaviorSlashPercentage != 0 {
+		n += 1 + sovGenesis(uint64(m.MisbehaviorSlashPercentage))
+	}
+	if m.NonParticipationSlashPercentage != 0 {
+		n += 1 + sovGenesis(uint64(m.NonParticipationSlashPercentage))
+	}
+	if m.MaxMissedSignings != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMissedSignings))
+	}
+	if m.BeaconInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.BeaconInterval))
+	}
 	return n
 }
 
@@ -574,6 +721,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, Evidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedMembers = append(m.ExcludedMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -776,6 +989,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviorSlashPercentage", wireType)
+			}
+			m.MisbehaviorSlashPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviorSlashPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonParticipationSlashPercentage", wireType)
+			}
+			m.NonParticipationSlashPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonParticipationSlashPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedSignings", wireType)
+			}
+			m.MaxMissedSignings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedSignings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconInterval", wireType)
+			}
+			m.BeaconInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
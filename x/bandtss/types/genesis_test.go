@@ -6,10 +6,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
 func init() {
@@ -25,6 +28,18 @@ func TestGenesisStateValidate(t *testing.T) {
 		},
 	}
 
+	validEvidence := types.NewEvidence(
+		1,
+		sdk.MustAccAddressFromBech32("band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs"),
+		1,
+		tsstypes.MISBEHAVIOR_TYPE_INVALID_COMPLAINT,
+		0,
+		sdkmath.ZeroInt(),
+		sdk.NewCoins(),
+		10,
+		time.Now(),
+	)
+
 	testCases := []struct {
 		name         string
 		genesisState types.GenesisState
@@ -48,6 +63,37 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesisState - with evidences and excluded members",
+			types.GenesisState{
+				Params:          types.DefaultParams(),
+				Members:         validMembers,
+				CurrentGroup:    types.NewCurrentGroup(1, time.Now()),
+				Evidences:       []types.Evidence{validEvidence},
+				ExcludedMembers: []string{validEvidence.Address},
+			},
+			false,
+		},
+		{
+			"invalid genesisState - duplicate evidence ID",
+			types.GenesisState{
+				Params:       types.DefaultParams(),
+				Members:      validMembers,
+				CurrentGroup: types.NewCurrentGroup(1, time.Now()),
+				Evidences:    []types.Evidence{validEvidence, validEvidence},
+			},
+			true,
+		},
+		{
+			"invalid genesisState - duplicate excluded members",
+			types.GenesisState{
+				Params:          types.DefaultParams(),
+				Members:         validMembers,
+				CurrentGroup:    types.NewCurrentGroup(1, time.Now()),
+				ExcludedMembers: []string{validEvidence.Address, validEvidence.Address},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
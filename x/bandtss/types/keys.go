@@ -28,11 +28,16 @@ var (
 	SigningCountStoreKey    = []byte{0x00}
 	CurrentGroupStoreKey    = []byte{0x01}
 	GroupTransitionStoreKey = []byte{0x02}
+	EvidenceCountStoreKey   = []byte{0x03}
+	BeaconSigningStoreKey   = []byte{0x04}
 
 	// store prefixes
-	MemberStoreKeyPrefix           = []byte{0x10}
-	SigningInfoStoreKeyPrefix      = []byte{0x11}
-	SigningIDMappingStoreKeyPrefix = []byte{0x12}
+	MemberStoreKeyPrefix             = []byte{0x10}
+	SigningInfoStoreKeyPrefix        = []byte{0x11}
+	SigningIDMappingStoreKeyPrefix   = []byte{0x12}
+	EvidenceStoreKeyPrefix           = []byte{0x13}
+	ExcludedMemberStoreKeyPrefix     = []byte{0x14}
+	MissedSigningCountStoreKeyPrefix = []byte{0x15}
 
 	// param store key
 	ParamsKey = []byte{0x90}
@@ -58,3 +63,19 @@ func SigningInfoStoreKey(id SigningID) []byte {
 func SigningIDMappingStoreKey(id tss.SigningID) []byte {
 	return append(SigningIDMappingStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
 }
+
+// EvidenceStoreKey returns the key for storing the evidence of a misbehaving member.
+func EvidenceStoreKey(id uint64) []byte {
+	return append(EvidenceStoreKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ExcludedMemberStoreKey returns the key for storing the exclusion flag of the address.
+func ExcludedMemberStoreKey(address sdk.AccAddress) []byte {
+	return append(ExcludedMemberStoreKeyPrefix, sdkaddress.MustLengthPrefix(address)...)
+}
+
+// MissedSigningCountStoreKey returns the key for storing the number of consecutive missed
+// signings of the address.
+func MissedSigningCountStoreKey(address sdk.AccAddress) []byte {
+	return append(MissedSigningCountStoreKeyPrefix, sdkaddress.MustLengthPrefix(address)...)
+}
@@ -20,12 +20,14 @@ var (
 	_ sdk.Msg = &MsgRequestSignature{}
 	_ sdk.Msg = &MsgActivate{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgReadmitMember{}
 
 	_ sdk.HasValidateBasic = (*MsgTransitionGroup)(nil)
 	_ sdk.HasValidateBasic = (*MsgForceTransitionGroup)(nil)
 	_ sdk.HasValidateBasic = (*MsgRequestSignature)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgReadmitMember)(nil)
 
 	_ types.UnpackInterfacesMessage = &MsgRequestSignature{}
 )
@@ -248,3 +250,28 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// ====================================
+// MsgReadmitMember
+// ====================================
+
+// NewMsgReadmitMember creates a new MsgReadmitMember instance
+func NewMsgReadmitMember(address string, authority string) *MsgReadmitMember {
+	return &MsgReadmitMember{
+		Address:   address,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgReadmitMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
@@ -131,6 +131,33 @@ func TestMsgActivate_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgReadmitMember
+// ====================================
+
+func TestNewMsgReadmitMember(t *testing.T) {
+	msg := types.NewMsgReadmitMember(validMembers[1], validSender)
+	require.Equal(t, validMembers[1], msg.Address)
+	require.Equal(t, validSender, msg.Authority)
+}
+
+func TestMsgReadmitMember_ValidateBasic(t *testing.T) {
+	// Valid input
+	msg := types.NewMsgReadmitMember(validMembers[1], validSender)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// invalid address
+	msg = types.NewMsgReadmitMember("invalid-address", validSender)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// invalid authority
+	msg = types.NewMsgReadmitMember(validMembers[1], "invalid-sender")
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
 // ====================================
 // MsgUpdateParams
 // ====================================
@@ -15,6 +15,14 @@ const (
 	// compute the bandtss reward following the allocation to Oracle. If the Oracle reward amounts to 70%,
 	// the bandtss reward will be determined from the remaining 10%, which is 10% * 30% = 3%.
 	DefaultRewardPercentage = uint64(10)
+	// slash 1% of the validator stake of a member that is proven to send an invalid complaint, an
+	// invalid secret share or an invalid partial signature.
+	DefaultMisbehaviorSlashPercentage = uint64(1)
+	// exclude a member that misses 10 consecutive signings without slashing its stake.
+	DefaultNonParticipationSlashPercentage = uint64(0)
+	DefaultMaxMissedSignings               = uint64(10)
+	// the randomness beacon is disabled by default.
+	DefaultBeaconInterval = uint64(0)
 )
 
 // DefaultFeePerSigner is the default value for the signing request fee per signer.
@@ -27,13 +35,21 @@ func NewParams(
 	minTransitionDuration time.Duration,
 	maxTransitionDuration time.Duration,
 	feePerSigner sdk.Coins,
+	misbehaviorSlashPercentage uint64,
+	nonParticipationSlashPercentage uint64,
+	maxMissedSignings uint64,
+	beaconInterval uint64,
 ) Params {
 	return Params{
-		RewardPercentage:        rewardPercentage,
-		InactivePenaltyDuration: inactivePenaltyDuration,
-		MinTransitionDuration:   minTransitionDuration,
-		MaxTransitionDuration:   maxTransitionDuration,
-		FeePerSigner:            feePerSigner,
+		RewardPercentage:                rewardPercentage,
+		InactivePenaltyDuration:         inactivePenaltyDuration,
+		MinTransitionDuration:           minTransitionDuration,
+		MaxTransitionDuration:           maxTransitionDuration,
+		FeePerSigner:                    feePerSigner,
+		MisbehaviorSlashPercentage:      misbehaviorSlashPercentage,
+		NonParticipationSlashPercentage: nonParticipationSlashPercentage,
+		MaxMissedSignings:               maxMissedSignings,
+		BeaconInterval:                  beaconInterval,
 	}
 }
 
@@ -45,6 +61,10 @@ func DefaultParams() Params {
 		DefaultMinTransitionDuration,
 		DefaultMaxTransitionDuration,
 		DefaultFeePerSigner,
+		DefaultMisbehaviorSlashPercentage,
+		DefaultNonParticipationSlashPercentage,
+		DefaultMaxMissedSignings,
+		DefaultBeaconInterval,
 	)
 }
 
@@ -71,6 +91,16 @@ func (p Params) Validate() error {
 		return sdkerrors.ErrInvalidCoins.Wrap(p.FeePerSigner.String())
 	}
 
+	if err := validatePercentage("misbehavior slash percentage")(p.MisbehaviorSlashPercentage); err != nil {
+		return err
+	}
+
+	if err := validatePercentage("non-participation slash percentage")(
+		p.NonParticipationSlashPercentage,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -87,6 +117,19 @@ func validateUint64(name string, positiveOnly bool) func(interface{}) error {
 	}
 }
 
+func validatePercentage(name string) func(interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v > 100 {
+			return fmt.Errorf("%s must not exceed 100: %d", name, v)
+		}
+		return nil
+	}
+}
+
 func validateTimeDuration(name string) func(interface{}) error {
 	return func(i interface{}) error {
 		v, ok := i.(time.Duration)
@@ -823,6 +823,309 @@ func (m *QueryGroupTransitionResponse) GetGroupTransition() *GroupTransition {
 	return nil
 }
 
+// QueryEvidencesRequest is the request type for the Query/Evidences RPC method.
+type QueryEvidencesRequest struct {
+	// address is an optional member address to filter the evidences.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines pagination settings for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidencesRequest) Reset()         { *m = QueryEvidencesRequest{} }
+func (m *QueryEvidencesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidencesRequest) ProtoMessage()    {}
+func (*QueryEvidencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{14}
+}
+func (m *QueryEvidencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidencesRequest.Merge(m, src)
+}
+func (m *QueryEvidencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidencesRequest proto.InternalMessageInfo
+
+func (m *QueryEvidencesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryEvidencesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEvidencesResponse is the response type for the Query/Evidences RPC method.
+type QueryEvidencesResponse struct {
+	// evidences is the list of recorded evidences.
+	Evidences []Evidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidencesResponse) Reset()         { *m = QueryEvidencesResponse{} }
+func (m *QueryEvidencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidencesResponse) ProtoMessage()    {}
+func (*QueryEvidencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{15}
+}
+func (m *QueryEvidencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidencesResponse.Merge(m, src)
+}
+func (m *QueryEvidencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidencesResponse proto.InternalMessageInfo
+
+func (m *QueryEvidencesResponse) GetEvidences() []Evidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+func (m *QueryEvidencesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEvidenceRequest is the request type for the Query/Evidence RPC method.
+type QueryEvidenceRequest struct {
+	// evidence_id is the ID of the evidence.
+	EvidenceId uint64 `protobuf:"varint,1,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+}
+
+func (m *QueryEvidenceRequest) Reset()         { *m = QueryEvidenceRequest{} }
+func (m *QueryEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceRequest) ProtoMessage()    {}
+func (*QueryEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{16}
+}
+func (m *QueryEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceRequest.Merge(m, src)
+}
+func (m *QueryEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceRequest) GetEvidenceId() uint64 {
+	if m != nil {
+		return m.EvidenceId
+	}
+	return 0
+}
+
+// QueryEvidenceResponse is the response type for the Query/Evidence RPC method.
+type QueryEvidenceResponse struct {
+	// evidence is the evidence of the given ID.
+	Evidence Evidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence"`
+}
+
+func (m *QueryEvidenceResponse) Reset()         { *m = QueryEvidenceResponse{} }
+func (m *QueryEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceResponse) ProtoMessage()    {}
+func (*QueryEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{17}
+}
+func (m *QueryEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceResponse.Merge(m, src)
+}
+func (m *QueryEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceResponse) GetEvidence() Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return Evidence{}
+}
+
+// QueryExcludedMembersRequest is the request type for the Query/ExcludedMembers RPC method.
+type QueryExcludedMembersRequest struct {
+	// pagination defines pagination settings for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExcludedMembersRequest) Reset()         { *m = QueryExcludedMembersRequest{} }
+func (m *QueryExcludedMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExcludedMembersRequest) ProtoMessage()    {}
+func (*QueryExcludedMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{18}
+}
+func (m *QueryExcludedMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExcludedMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExcludedMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExcludedMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExcludedMembersRequest.Merge(m, src)
+}
+func (m *QueryExcludedMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExcludedMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExcludedMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExcludedMembersRequest proto.InternalMessageInfo
+
+func (m *QueryExcludedMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExcludedMembersResponse is the response type for the Query/ExcludedMembers RPC method.
+type QueryExcludedMembersResponse struct {
+	// addresses is the list of excluded member addresses.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExcludedMembersResponse) Reset()         { *m = QueryExcludedMembersResponse{} }
+func (m *QueryExcludedMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExcludedMembersResponse) ProtoMessage()    {}
+func (*QueryExcludedMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{19}
+}
+func (m *QueryExcludedMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExcludedMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExcludedMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExcludedMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExcludedMembersResponse.Merge(m, src)
+}
+func (m *QueryExcludedMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExcludedMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExcludedMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExcludedMembersResponse proto.InternalMessageInfo
+
+func (m *QueryExcludedMembersResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryExcludedMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -831,7 +1134,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d619290a87c09054, []int{14}
+	return fileDescriptor_d619290a87c09054, []int{20}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1173,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d619290a87c09054, []int{15}
+	return fileDescriptor_d619290a87c09054, []int{21}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,6 +1225,12 @@ func init() {
 	proto.RegisterType((*QuerySigningResponse)(nil), "band.bandtss.v1beta1.QuerySigningResponse")
 	proto.RegisterType((*QueryGroupTransitionRequest)(nil), "band.bandtss.v1beta1.QueryGroupTransitionRequest")
 	proto.RegisterType((*QueryGroupTransitionResponse)(nil), "band.bandtss.v1beta1.QueryGroupTransitionResponse")
+	proto.RegisterType((*QueryEvidencesRequest)(nil), "band.bandtss.v1beta1.QueryEvidencesRequest")
+	proto.RegisterType((*QueryEvidencesResponse)(nil), "band.bandtss.v1beta1.QueryEvidencesResponse")
+	proto.RegisterType((*QueryEvidenceRequest)(nil), "band.bandtss.v1beta1.QueryEvidenceRequest")
+	proto.RegisterType((*QueryEvidenceResponse)(nil), "band.bandtss.v1beta1.QueryEvidenceResponse")
+	proto.RegisterType((*QueryExcludedMembersRequest)(nil), "band.bandtss.v1beta1.QueryExcludedMembersRequest")
+	proto.RegisterType((*QueryExcludedMembersResponse)(nil), "band.bandtss.v1beta1.QueryExcludedMembersResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "band.bandtss.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "band.bandtss.v1beta1.QueryParamsResponse")
 }
@@ -929,87 +1238,99 @@ func init() {
 func init() { proto.RegisterFile("band/bandtss/v1beta1/query.proto", fileDescriptor_d619290a87c09054) }
 
 var fileDescriptor_d619290a87c09054 = []byte{
-	// 1268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xb1, 0x6f, 0xdb, 0x56,
-	0x13, 0x17, 0x15, 0x45, 0x8a, 0x9f, 0x9d, 0xd8, 0xdf, 0x8b, 0x3f, 0x54, 0xa6, 0x6d, 0x51, 0x66,
-	0x52, 0x47, 0x31, 0x50, 0xd2, 0x56, 0xda, 0x0e, 0x59, 0xda, 0xc8, 0x91, 0x03, 0x35, 0x75, 0xa0,
-	0x50, 0x4a, 0x86, 0x2c, 0x2a, 0x25, 0x3d, 0xd3, 0x84, 0x25, 0x92, 0xe6, 0x23, 0x8d, 0xba, 0x41,
-	0x3a, 0x04, 0x1d, 0x9a, 0xcd, 0x40, 0x87, 0x0c, 0x1d, 0xb3, 0x75, 0xe8, 0x3f, 0xd1, 0x25, 0x40,
-	0x97, 0x00, 0x5d, 0x3a, 0x39, 0x85, 0xdd, 0xb5, 0x5b, 0xa7, 0x4c, 0x05, 0xdf, 0x3b, 0xca, 0xa2,
-	0xc4, 0xd0, 0xea, 0xde, 0xc5, 0x96, 0xde, 0xdd, 0xbd, 0xfb, 0xdd, 0xdd, 0xbb, 0xdf, 0x9d, 0x50,
-	0xb1, 0xad, 0x5b, 0x5d, 0x35, 0xf8, 0xe3, 0x51, 0xaa, 0x1e, 0x6c, 0xb4, 0x89, 0xa7, 0x6f, 0xa8,
-	0xfb, 0x3e, 0x71, 0x0f, 0x15, 0xc7, 0xb5, 0x3d, 0x1b, 0xcf, 0x07, 0x42, 0x05, 0x34, 0x14, 0xd0,
-	0x10, 0xe7, 0x0d, 0xdb, 0xb0, 0x99, 0x82, 0x1a, 0x7c, 0xe2, 0xba, 0xa2, 0x64, 0xd8, 0xb6, 0xd1,
-	0x23, 0x2a, 0xfb, 0xd6, 0xf6, 0x77, 0x54, 0xcf, 0xec, 0x13, 0xea, 0xe9, 0x7d, 0x07, 0x14, 0xd6,
-	0x3a, 0x36, 0xed, 0xdb, 0x54, 0x6d, 0xeb, 0x94, 0x70, 0x2f, 0x03, 0x9f, 0x8e, 0x6e, 0x98, 0x96,
-	0xee, 0x99, 0xb6, 0x05, 0xba, 0x85, 0x61, 0xdd, 0x50, 0xab, 0x63, 0x9b, 0xa1, 0x7c, 0x09, 0x9c,
-	0xe9, 0x8e, 0xa9, 0xea, 0x96, 0x65, 0x7b, 0xcc, 0x98, 0x82, 0x54, 0x64, 0x81, 0x0d, 0x07, 0x15,
-	0xc0, 0xe7, 0x32, 0x39, 0x36, 0xe8, 0x30, 0xc4, 0x24, 0x1d, 0x83, 0x58, 0x84, 0x9a, 0xa0, 0x23,
-	0xcf, 0x23, 0xfc, 0x30, 0x88, 0x61, 0xd3, 0xf6, 0x2d, 0x8f, 0x6a, 0x64, 0xdf, 0x27, 0xd4, 0x93,
-	0x6f, 0xa3, 0xab, 0x91, 0x53, 0xea, 0xd8, 0x16, 0x25, 0xf8, 0x1a, 0xba, 0x4c, 0x4d, 0xc3, 0x32,
-	0x2d, 0xa3, 0xd5, 0x09, 0x24, 0x79, 0xa1, 0x28, 0x94, 0x32, 0xda, 0x0c, 0x1c, 0x32, 0x6d, 0xf9,
-	0x57, 0x01, 0x8c, 0xb7, 0x49, 0xbf, 0x4d, 0xdc, 0xf0, 0x4e, 0xfc, 0x39, 0xca, 0x52, 0x4f, 0xf7,
-	0x7c, 0xca, 0xac, 0xae, 0x94, 0x4b, 0x4a, 0x5c, 0x55, 0x14, 0x6e, 0xd5, 0x60, 0x9a, 0x5b, 0x66,
-	0xcf, 0x23, 0xae, 0x06, 0x76, 0x78, 0x0d, 0xfd, 0xcf, 0xa4, 0x2d, 0xd3, 0xea, 0xd8, 0xfd, 0x00,
-	0x82, 0xe1, 0xda, 0xbe, 0x93, 0x4f, 0x17, 0x85, 0xd2, 0x25, 0x6d, 0xd6, 0xa4, 0x35, 0x38, 0xbf,
-	0x17, 0x1c, 0xe3, 0x2d, 0x84, 0xce, 0xaa, 0x91, 0xbf, 0x50, 0x14, 0x4a, 0xd3, 0xe5, 0x55, 0x85,
-	0x97, 0x43, 0x09, 0xca, 0xa1, 0xf0, 0x07, 0x12, 0xba, 0xad, 0xeb, 0x06, 0x01, 0xa4, 0xda, 0x90,
-	0xa5, 0xfc, 0x52, 0x40, 0xf3, 0xd1, 0x68, 0x20, 0x17, 0x9f, 0xa2, 0x5c, 0x9f, 0x1f, 0xe5, 0x85,
-	0xe2, 0x85, 0xd2, 0x74, 0x79, 0x29, 0x29, 0x1e, 0x2d, 0x54, 0xc6, 0xf7, 0x22, 0xc0, 0xd2, 0x0c,
-	0xd8, 0x8d, 0x73, 0x81, 0x71, 0xa7, 0x11, 0x64, 0x0a, 0x54, 0x0e, 0x1c, 0x40, 0x96, 0xf3, 0x28,
-	0xa7, 0x77, 0xbb, 0x2e, 0xa1, 0x3c, 0xcd, 0x53, 0x5a, 0xf8, 0x55, 0xfe, 0x25, 0x5a, 0x97, 0x41,
-	0x20, 0x4d, 0x34, 0xdf, 0xf1, 0x5d, 0x97, 0x58, 0x1e, 0xcf, 0x68, 0x8b, 0x23, 0x65, 0xe6, 0xe7,
-	0x44, 0x55, 0xc9, 0xbc, 0x3e, 0x96, 0x52, 0x1a, 0x06, 0x7b, 0x96, 0x79, 0x2e, 0xc1, 0x8f, 0xd1,
-	0xff, 0xa3, 0x85, 0x0a, 0xaf, 0x4d, 0x4f, 0x7c, 0xed, 0x55, 0x73, 0xb8, 0xa2, 0x5c, 0x24, 0x8b,
-	0x28, 0xcf, 0x5f, 0xe6, 0x90, 0xcb, 0xf0, 0xd5, 0xfe, 0x9d, 0x46, 0x0b, 0x31, 0x42, 0x88, 0xf3,
-	0x09, 0xba, 0xc4, 0x81, 0x98, 0x5d, 0xfe, 0x6e, 0x2b, 0x9f, 0x9d, 0x1c, 0x4b, 0x39, 0xa6, 0x54,
-	0xbb, 0xfb, 0xee, 0x58, 0x5a, 0x37, 0x4c, 0x6f, 0xd7, 0x6f, 0x2b, 0x1d, 0xbb, 0xcf, 0x9a, 0x86,
-	0x35, 0x48, 0xc7, 0xee, 0xa9, 0x9d, 0x5d, 0xdd, 0xb4, 0xd4, 0x83, 0x5b, 0xaa, 0xb3, 0x67, 0xb0,
-	0x16, 0x04, 0x1b, 0x2d, 0xc7, 0x2e, 0xac, 0x75, 0x31, 0x46, 0x19, 0x6a, 0x7e, 0x43, 0x58, 0x70,
-	0x19, 0x8d, 0x7d, 0xc6, 0x4b, 0x68, 0xca, 0xdb, 0x75, 0x09, 0xdd, 0xb5, 0x7b, 0x5d, 0xf6, 0x00,
-	0x33, 0xda, 0xd9, 0x01, 0xbe, 0x8f, 0x72, 0x8e, 0xdf, 0x6e, 0xed, 0x91, 0xc3, 0x7c, 0xa6, 0x28,
-	0x94, 0x66, 0x2a, 0xe5, 0x77, 0xc7, 0x92, 0x32, 0x31, 0x82, 0xba, 0x6d, 0x5a, 0x9e, 0x96, 0x75,
-	0xfc, 0xf6, 0x7d, 0x72, 0x88, 0x3f, 0x19, 0xb4, 0xd6, 0x45, 0xd6, 0x5a, 0xcb, 0x3c, 0xbb, 0xc3,
-	0x99, 0x65, 0x90, 0x79, 0x57, 0x0d, 0xfa, 0xa9, 0x8a, 0xa6, 0xf5, 0x8e, 0x67, 0x1e, 0x90, 0x56,
-	0xc0, 0x71, 0xf9, 0x2c, 0xab, 0x8c, 0xa8, 0x70, 0x4e, 0x52, 0x42, 0x02, 0x54, 0x9a, 0x21, 0x01,
-	0x56, 0x2e, 0x05, 0x75, 0x39, 0x7a, 0x2b, 0x09, 0x1a, 0xe2, 0x86, 0x81, 0x48, 0x5e, 0x84, 0xac,
-	0x47, 0x1a, 0x30, 0xac, 0xc9, 0xcf, 0x69, 0x24, 0xc6, 0x49, 0xff, 0x2b, 0x4a, 0x7c, 0x51, 0xe4,
-	0x8f, 0xa1, 0x4b, 0x1b, 0x9c, 0x53, 0xc3, 0xbe, 0x5e, 0x46, 0x28, 0xa4, 0xde, 0x30, 0x55, 0xda,
-	0x14, 0x9c, 0xd4, 0xba, 0xf2, 0x5f, 0x69, 0xa0, 0xa9, 0x81, 0x19, 0x24, 0x78, 0x1f, 0x5d, 0xd9,
-	0x21, 0xa4, 0xe5, 0x10, 0xb7, 0x15, 0x68, 0xb3, 0xbe, 0x0e, 0xd8, 0x6a, 0x21, 0x42, 0x39, 0x21,
-	0xa0, 0x4d, 0xdb, 0xb4, 0x2a, 0xeb, 0x41, 0x95, 0x7f, 0x7a, 0x2b, 0x95, 0x86, 0x02, 0x87, 0x39,
-	0xc6, 0xff, 0x7d, 0x44, 0xbb, 0x7b, 0xaa, 0x77, 0xe8, 0x10, 0xca, 0x0c, 0xa8, 0x36, 0xb3, 0x43,
-	0x48, 0x9d, 0xb8, 0x0d, 0xe6, 0x20, 0xc8, 0xb1, 0xcb, 0x51, 0x43, 0xbb, 0x4f, 0x69, 0x67, 0x07,
-	0xf8, 0x2b, 0xb4, 0x14, 0xa5, 0x9b, 0x30, 0x2c, 0x97, 0x50, 0xbf, 0xe7, 0x01, 0x55, 0x4b, 0xe3,
-	0xc9, 0x3a, 0x8b, 0xcc, 0xef, 0x79, 0xda, 0xc2, 0x30, 0xe7, 0x44, 0x44, 0xb8, 0x8d, 0x96, 0x47,
-	0xa8, 0x67, 0xc4, 0x45, 0x66, 0x32, 0x17, 0x62, 0x84, 0x7f, 0x22, 0x32, 0x79, 0x19, 0x2d, 0xb2,
-	0x74, 0x33, 0x51, 0xd3, 0xd5, 0x2d, 0x6a, 0x06, 0xa4, 0x1c, 0xbe, 0x7a, 0x07, 0x2d, 0xc5, 0x8b,
-	0xa1, 0x2a, 0x75, 0x34, 0xc7, 0x91, 0x79, 0x03, 0x19, 0xf0, 0xed, 0x87, 0xf1, 0xc4, 0x38, 0x7a,
-	0xd1, 0xac, 0x11, 0x3d, 0x18, 0xcc, 0xf1, 0xba, 0xee, 0xea, 0xfd, 0xc1, 0x1c, 0x7f, 0x08, 0x8f,
-	0x29, 0x3c, 0x05, 0xf7, 0xb7, 0x51, 0xd6, 0x61, 0x27, 0xc9, 0x24, 0xcf, 0xad, 0x80, 0x8d, 0xc1,
-	0x62, 0xed, 0x3b, 0x01, 0xe1, 0xf1, 0x19, 0x8d, 0xaf, 0xa3, 0xe2, 0x76, 0x75, 0xbb, 0x52, 0xd5,
-	0x5a, 0x8d, 0xe6, 0x9d, 0xe6, 0xa3, 0x46, 0x6b, 0xab, 0xf6, 0x65, 0xb3, 0xaa, 0xb5, 0x1e, 0x3d,
-	0x68, 0xd4, 0xab, 0x9b, 0xb5, 0xad, 0x5a, 0xf5, 0xee, 0x5c, 0x0a, 0x4b, 0x68, 0x31, 0x56, 0xeb,
-	0xce, 0x66, 0xb3, 0xf6, 0xb8, 0x3a, 0x27, 0xe0, 0x15, 0xb4, 0x1c, 0xab, 0x50, 0x7b, 0x00, 0x2a,
-	0x69, 0x31, 0xf3, 0xfd, 0xab, 0x42, 0xaa, 0xfc, 0x62, 0x0a, 0x5d, 0x64, 0xa1, 0xe1, 0x6f, 0x51,
-	0x96, 0xaf, 0x29, 0xf8, 0x3d, 0x1b, 0xc5, 0xf8, 0x7e, 0x23, 0xde, 0x9c, 0x40, 0x93, 0xe7, 0x4a,
-	0x96, 0x9e, 0xff, 0xf6, 0xe7, 0x0f, 0xe9, 0x05, 0xfc, 0xc1, 0xd8, 0x22, 0xd5, 0xe1, 0x5e, 0x9f,
-	0x0b, 0x28, 0x07, 0xcb, 0x01, 0x4e, 0xba, 0x37, 0xba, 0x0e, 0x89, 0x6b, 0x93, 0xa8, 0x02, 0x86,
-	0x22, 0xc3, 0x20, 0xe2, 0xfc, 0x18, 0x86, 0x70, 0xab, 0x78, 0x21, 0xa0, 0x2c, 0x4c, 0xde, 0xd2,
-	0xb9, 0x17, 0x4f, 0x92, 0x85, 0xe8, 0x92, 0x20, 0xaf, 0x31, 0x04, 0xd7, 0xb1, 0xfc, 0x3e, 0x04,
-	0xea, 0x53, 0xd8, 0x33, 0x9e, 0xe1, 0x97, 0x02, 0x9a, 0x19, 0x9e, 0xc0, 0x58, 0x49, 0xca, 0xf6,
-	0xf8, 0x1c, 0x17, 0xd5, 0x89, 0xf5, 0x01, 0xdd, 0x2a, 0x43, 0x57, 0xc4, 0x85, 0xf1, 0x1a, 0x0d,
-	0x53, 0x0d, 0xfe, 0x51, 0x40, 0x97, 0xa3, 0x6b, 0x62, 0x92, 0xab, 0xb8, 0x79, 0x26, 0xae, 0x4f,
-	0x6e, 0x00, 0xe0, 0x6e, 0x30, 0x70, 0x2b, 0x58, 0x1a, 0x03, 0x17, 0x65, 0x29, 0x7c, 0x24, 0xa0,
-	0x1c, 0xb0, 0x4c, 0xe2, 0x43, 0x8a, 0x4e, 0x86, 0xc4, 0x87, 0x34, 0x32, 0x0d, 0x64, 0x85, 0x61,
-	0x29, 0xe1, 0xd5, 0x31, 0x2c, 0x40, 0x91, 0x54, 0x7d, 0x7a, 0x36, 0x66, 0x9e, 0xe1, 0x57, 0x02,
-	0x9a, 0x1d, 0xa1, 0x1e, 0xbc, 0x91, 0xe0, 0x2f, 0x9e, 0x0e, 0xc5, 0xf2, 0xbf, 0x31, 0x01, 0xa8,
-	0x37, 0x19, 0xd4, 0x6b, 0x78, 0x65, 0xfc, 0x07, 0xcc, 0x08, 0x73, 0x06, 0x0c, 0xc0, 0xa9, 0x2a,
-	0xf1, 0xed, 0x47, 0x98, 0x31, 0xf1, 0xed, 0x47, 0xd9, 0x32, 0x81, 0x01, 0x38, 0x25, 0x56, 0xbe,
-	0x78, 0x7d, 0x52, 0x10, 0xde, 0x9c, 0x14, 0x84, 0x3f, 0x4e, 0x0a, 0xc2, 0xd1, 0x69, 0x21, 0xf5,
-	0xe6, 0xb4, 0x90, 0xfa, 0xfd, 0xb4, 0x90, 0x7a, 0x72, 0xfe, 0xf6, 0xf2, 0xf5, 0xe0, 0x52, 0x36,
-	0x50, 0xdb, 0x59, 0xa6, 0x72, 0xeb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x2c, 0x11, 0xcf,
-	0xd5, 0x0e, 0x00, 0x00,
+	// 1469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x13, 0x57,
+	0x10, 0xcf, 0x1a, 0xe3, 0xc4, 0x93, 0xf0, 0xd1, 0x47, 0x68, 0x9d, 0xc5, 0xb1, 0xcd, 0x42, 0xc1,
+	0x31, 0xed, 0x6e, 0x08, 0xfd, 0x90, 0xb8, 0x14, 0x1c, 0x1c, 0xe4, 0x52, 0x50, 0xd8, 0x04, 0xa4,
+	0x72, 0x71, 0xd7, 0xf6, 0xc3, 0x59, 0x61, 0xef, 0x9a, 0x7d, 0xeb, 0x88, 0x14, 0xc1, 0x01, 0xb5,
+	0x52, 0xdb, 0x13, 0x52, 0x55, 0x71, 0xe8, 0x11, 0xf5, 0xd2, 0x43, 0xff, 0x89, 0x5e, 0x90, 0x7a,
+	0x41, 0x6a, 0x0f, 0x3d, 0x85, 0x2a, 0xf4, 0xda, 0x5b, 0x4f, 0x9c, 0xaa, 0x7d, 0x6f, 0x9e, 0xed,
+	0xb5, 0x8d, 0xb3, 0x20, 0x8e, 0xbd, 0x24, 0xf6, 0xcc, 0x6f, 0xde, 0xfc, 0x66, 0xe6, 0xbd, 0x99,
+	0x31, 0xe4, 0xaa, 0x96, 0x53, 0x37, 0x82, 0x3f, 0x3e, 0x63, 0xc6, 0xe6, 0xe9, 0x2a, 0xf5, 0xad,
+	0xd3, 0xc6, 0xed, 0x0e, 0xf5, 0xb6, 0xf4, 0xb6, 0xe7, 0xfa, 0x2e, 0x99, 0x0d, 0x94, 0x3a, 0x22,
+	0x74, 0x44, 0xa8, 0xb3, 0x0d, 0xb7, 0xe1, 0x72, 0x80, 0x11, 0x7c, 0x12, 0x58, 0x35, 0xdb, 0x70,
+	0xdd, 0x46, 0x93, 0x1a, 0xfc, 0x5b, 0xb5, 0x73, 0xd3, 0xf0, 0xed, 0x16, 0x65, 0xbe, 0xd5, 0x6a,
+	0x23, 0xa0, 0x50, 0x73, 0x59, 0xcb, 0x65, 0x46, 0xd5, 0x62, 0x54, 0x78, 0xe9, 0xfa, 0x6c, 0x5b,
+	0x0d, 0xdb, 0xb1, 0x7c, 0xdb, 0x75, 0x10, 0x9b, 0xe9, 0xc7, 0x4a, 0x54, 0xcd, 0xb5, 0xa5, 0x3e,
+	0x8d, 0xce, 0xac, 0xb6, 0x6d, 0x58, 0x8e, 0xe3, 0xfa, 0xdc, 0x98, 0xa1, 0x56, 0xe5, 0x81, 0xf5,
+	0x07, 0xe5, 0x33, 0xa9, 0xd3, 0x46, 0x06, 0x2d, 0x43, 0x1c, 0x87, 0x69, 0x50, 0x87, 0x32, 0x1b,
+	0x31, 0xda, 0x2c, 0x90, 0xab, 0x41, 0x0c, 0xcb, 0x6e, 0xc7, 0xf1, 0x99, 0x49, 0x6f, 0x77, 0x28,
+	0xf3, 0xb5, 0xb3, 0x70, 0x28, 0x24, 0x65, 0x6d, 0xd7, 0x61, 0x94, 0x1c, 0x83, 0x7d, 0xcc, 0x6e,
+	0x38, 0xb6, 0xd3, 0xa8, 0xd4, 0x02, 0x4d, 0x4a, 0xc9, 0x29, 0xf9, 0xb8, 0x39, 0x83, 0x42, 0x8e,
+	0xd6, 0x7e, 0x53, 0xd0, 0xf8, 0x32, 0x6d, 0x55, 0xa9, 0x27, 0xcf, 0x24, 0xe7, 0x20, 0xc1, 0x7c,
+	0xcb, 0xef, 0x30, 0x6e, 0xb5, 0x7f, 0x29, 0xaf, 0x8f, 0xaa, 0x8a, 0x2e, 0xac, 0xd6, 0x38, 0x72,
+	0xc5, 0x6e, 0xfa, 0xd4, 0x33, 0xd1, 0x8e, 0x14, 0xe0, 0x2d, 0x9b, 0x55, 0x6c, 0xa7, 0xe6, 0xb6,
+	0x02, 0x0a, 0x0d, 0xcf, 0xed, 0xb4, 0x53, 0xb1, 0x9c, 0x92, 0x9f, 0x32, 0x0f, 0xd8, 0xac, 0x8c,
+	0xf2, 0x8b, 0x81, 0x98, 0xac, 0x00, 0xf4, 0xaa, 0x91, 0xda, 0x93, 0x53, 0xf2, 0xd3, 0x4b, 0x27,
+	0x74, 0x51, 0x0e, 0x3d, 0x28, 0x87, 0x2e, 0x2e, 0x88, 0x74, 0xbb, 0x6a, 0x35, 0x28, 0x32, 0x35,
+	0xfb, 0x2c, 0xb5, 0x47, 0x0a, 0xcc, 0x86, 0xa3, 0xc1, 0x5c, 0x7c, 0x04, 0x93, 0x2d, 0x21, 0x4a,
+	0x29, 0xb9, 0x3d, 0xf9, 0xe9, 0xa5, 0xf4, 0xb8, 0x78, 0x4c, 0x09, 0x26, 0x17, 0x43, 0xc4, 0x62,
+	0x9c, 0xd8, 0xc9, 0x5d, 0x89, 0x09, 0xa7, 0x21, 0x66, 0x3a, 0x56, 0x0e, 0x1d, 0x60, 0x96, 0x53,
+	0x30, 0x69, 0xd5, 0xeb, 0x1e, 0x65, 0x22, 0xcd, 0x49, 0x53, 0x7e, 0xd5, 0x7e, 0x0d, 0xd7, 0xa5,
+	0x1b, 0xc8, 0x3a, 0xcc, 0xd6, 0x3a, 0x9e, 0x47, 0x1d, 0x5f, 0x64, 0xb4, 0x22, 0x98, 0x72, 0xf3,
+	0x5d, 0xa2, 0x2a, 0xc6, 0x9f, 0x6c, 0x67, 0x27, 0x4c, 0x82, 0xf6, 0x3c, 0xf3, 0x42, 0x43, 0xae,
+	0xc3, 0xe1, 0x70, 0xa1, 0xe4, 0xb1, 0xb1, 0xc8, 0xc7, 0x1e, 0xb2, 0xfb, 0x2b, 0x2a, 0x54, 0x9a,
+	0x0a, 0x29, 0x71, 0x33, 0xfb, 0x5c, 0xca, 0x5b, 0xfb, 0x6f, 0x0c, 0xe6, 0x46, 0x28, 0x31, 0xce,
+	0x1b, 0x30, 0x25, 0x88, 0xd8, 0x75, 0x71, 0x6f, 0x8b, 0x9f, 0xec, 0x6c, 0x67, 0x27, 0x39, 0xa8,
+	0x7c, 0xe1, 0xc5, 0x76, 0x76, 0xb1, 0x61, 0xfb, 0x1b, 0x9d, 0xaa, 0x5e, 0x73, 0x5b, 0xfc, 0xd1,
+	0xf0, 0x07, 0x52, 0x73, 0x9b, 0x46, 0x6d, 0xc3, 0xb2, 0x1d, 0x63, 0xf3, 0x8c, 0xd1, 0xbe, 0xd5,
+	0xe0, 0x4f, 0x10, 0x6d, 0xcc, 0x49, 0x7e, 0x60, 0xb9, 0x4e, 0x08, 0xc4, 0x99, 0xfd, 0x25, 0xe5,
+	0xc1, 0xc5, 0x4d, 0xfe, 0x99, 0xa4, 0x21, 0xe9, 0x6f, 0x78, 0x94, 0x6d, 0xb8, 0xcd, 0x3a, 0xbf,
+	0x80, 0x71, 0xb3, 0x27, 0x20, 0x97, 0x60, 0xb2, 0xdd, 0xa9, 0x56, 0x6e, 0xd1, 0xad, 0x54, 0x3c,
+	0xa7, 0xe4, 0x67, 0x8a, 0x4b, 0x2f, 0xb6, 0xb3, 0x7a, 0x64, 0x06, 0xab, 0xae, 0xed, 0xf8, 0x66,
+	0xa2, 0xdd, 0xa9, 0x5e, 0xa2, 0x5b, 0xe4, 0xc3, 0xee, 0xd3, 0xda, 0xcb, 0x9f, 0xd6, 0xbc, 0xc8,
+	0x6e, 0x7f, 0x66, 0x39, 0x65, 0xf1, 0xaa, 0xba, 0xef, 0xa9, 0x04, 0xd3, 0x56, 0xcd, 0xb7, 0x37,
+	0x69, 0x25, 0xe8, 0x71, 0xa9, 0x04, 0xaf, 0x8c, 0xaa, 0x8b, 0x9e, 0xa4, 0xcb, 0x06, 0xa8, 0xaf,
+	0xcb, 0x06, 0x58, 0x9c, 0x0a, 0xea, 0xf2, 0xf0, 0x59, 0x56, 0x31, 0x41, 0x18, 0x06, 0x2a, 0xed,
+	0x08, 0x66, 0x3d, 0xf4, 0x00, 0x65, 0x4d, 0x7e, 0x89, 0x81, 0x3a, 0x4a, 0xfb, 0x7f, 0x51, 0x46,
+	0x17, 0x45, 0xfb, 0x00, 0x5f, 0xe9, 0x9a, 0xe8, 0xa9, 0xf2, 0x5d, 0xcf, 0x03, 0xc8, 0xd6, 0x2b,
+	0x53, 0x65, 0x26, 0x51, 0x52, 0xae, 0x6b, 0xff, 0xc4, 0x60, 0x36, 0x6c, 0x86, 0x09, 0xbe, 0x0d,
+	0xfb, 0x6f, 0x52, 0x5a, 0x69, 0x53, 0xaf, 0x12, 0xa0, 0xa9, 0x87, 0xdd, 0x6a, 0x2e, 0xd4, 0x72,
+	0x24, 0xa1, 0x65, 0xd7, 0x76, 0x8a, 0x8b, 0x41, 0x95, 0x7f, 0x7e, 0x96, 0xcd, 0xf7, 0x05, 0x8e,
+	0x73, 0x4c, 0xfc, 0x7b, 0x9f, 0xd5, 0x6f, 0x19, 0xfe, 0x56, 0x9b, 0x32, 0x6e, 0xc0, 0xcc, 0x99,
+	0x9b, 0x94, 0xae, 0x52, 0x6f, 0x8d, 0x3b, 0x08, 0x72, 0xec, 0x09, 0xd6, 0xf8, 0xdc, 0x93, 0x66,
+	0x4f, 0x40, 0xbe, 0x80, 0x74, 0xb8, 0xdd, 0xc8, 0xb0, 0x3c, 0xca, 0x3a, 0x4d, 0x1f, 0x5b, 0x75,
+	0x76, 0x38, 0x59, 0xbd, 0xc8, 0x3a, 0x4d, 0xdf, 0x9c, 0xeb, 0xef, 0x39, 0x21, 0x15, 0xa9, 0xc2,
+	0xfc, 0x40, 0xeb, 0x19, 0x70, 0x11, 0x8f, 0xe6, 0x42, 0x0d, 0xf5, 0x9f, 0x90, 0x4e, 0x9b, 0x87,
+	0x23, 0x3c, 0xdd, 0x5c, 0xb5, 0xee, 0x59, 0x0e, 0xb3, 0x83, 0xa6, 0x2c, 0x6f, 0x7d, 0x1b, 0xd2,
+	0xa3, 0xd5, 0x58, 0x95, 0x55, 0x38, 0x28, 0x98, 0xf9, 0x5d, 0x1d, 0xf6, 0xdb, 0x77, 0x47, 0x37,
+	0xc6, 0xc1, 0x83, 0x0e, 0x34, 0xc2, 0x02, 0x6d, 0x0b, 0x0e, 0x73, 0x8f, 0xa5, 0x4d, 0xbb, 0x4e,
+	0x9d, 0x1a, 0x65, 0xbb, 0x0e, 0x84, 0x81, 0x11, 0x19, 0x7b, 0xed, 0x11, 0xf9, 0x93, 0x02, 0x6f,
+	0x0f, 0xfa, 0xc6, 0x38, 0x8b, 0x90, 0xa4, 0x52, 0x88, 0x17, 0x2f, 0x33, 0x3a, 0x40, 0x69, 0x8b,
+	0xbd, 0xbf, 0x67, 0xf6, 0xe6, 0x06, 0xe6, 0xc7, 0xf8, 0x44, 0xa4, 0x2b, 0x99, 0xa1, 0x2c, 0x4c,
+	0x4b, 0x6f, 0xbd, 0xb7, 0x05, 0x52, 0x54, 0xae, 0x6b, 0x9f, 0x0f, 0xe4, 0xb6, 0x1b, 0xde, 0x39,
+	0x98, 0x92, 0x30, 0x2c, 0x5f, 0xb4, 0xe8, 0xba, 0x56, 0x1a, 0xc5, 0x7b, 0x54, 0xba, 0x53, 0x6b,
+	0x76, 0xea, 0xb4, 0x3e, 0xb0, 0x33, 0x85, 0x4b, 0xa4, 0xbc, 0x76, 0x89, 0xbe, 0x56, 0x20, 0x3d,
+	0xda, 0x0f, 0x46, 0x92, 0x86, 0x24, 0x5e, 0x0b, 0x2c, 0x54, 0xd2, 0xec, 0x09, 0xde, 0x5c, 0x09,
+	0xe4, 0xb6, 0xb9, 0x6a, 0x79, 0x56, 0xab, 0xbb, 0x6d, 0x5e, 0x85, 0x43, 0x21, 0x29, 0x72, 0x3a,
+	0x0b, 0x89, 0x36, 0x97, 0x8c, 0x5f, 0x45, 0x84, 0x15, 0x66, 0x16, 0x2d, 0x0a, 0x5f, 0x29, 0x40,
+	0x86, 0x37, 0x49, 0x72, 0x1c, 0x72, 0x97, 0x4b, 0x97, 0x8b, 0x25, 0xb3, 0xb2, 0xb6, 0x7e, 0x7e,
+	0xfd, 0xda, 0x5a, 0x65, 0xa5, 0xfc, 0xd9, 0x7a, 0xc9, 0xac, 0x5c, 0xbb, 0xb2, 0xb6, 0x5a, 0x5a,
+	0x2e, 0xaf, 0x94, 0x4b, 0x17, 0x0e, 0x4e, 0x90, 0x2c, 0x1c, 0x19, 0x89, 0x3a, 0xbf, 0xbc, 0x5e,
+	0xbe, 0x5e, 0x3a, 0xa8, 0x90, 0xa3, 0x30, 0x3f, 0x12, 0x50, 0xbe, 0x82, 0x90, 0x98, 0x1a, 0xff,
+	0xe6, 0x71, 0x66, 0x62, 0xe9, 0x8f, 0x19, 0xd8, 0xcb, 0x43, 0x23, 0xf7, 0x21, 0x21, 0x96, 0x69,
+	0xf2, 0x92, 0xbd, 0x77, 0x78, 0x0b, 0x57, 0x17, 0x22, 0x20, 0x45, 0xae, 0xb4, 0xec, 0x83, 0xdf,
+	0xff, 0xfe, 0x3e, 0x36, 0x47, 0xde, 0x19, 0x5a, 0xf7, 0x6b, 0xc2, 0xeb, 0x03, 0x05, 0x26, 0xb1,
+	0xe8, 0x64, 0xdc, 0xb9, 0xe1, 0x0b, 0xa8, 0x16, 0xa2, 0x40, 0x91, 0x43, 0x8e, 0x73, 0x50, 0x49,
+	0x6a, 0x88, 0x83, 0xdc, 0x7d, 0xbf, 0x55, 0x20, 0x21, 0xac, 0xc6, 0x66, 0x21, 0xb4, 0xd1, 0xaa,
+	0x0b, 0x11, 0x90, 0xc8, 0xa0, 0xc0, 0x19, 0x1c, 0x27, 0xda, 0xcb, 0x18, 0x18, 0x77, 0xf1, 0x52,
+	0xdf, 0x23, 0x8f, 0x14, 0x98, 0xe9, 0xdf, 0x13, 0x89, 0x3e, 0x2e, 0xdb, 0xc3, 0xdb, 0xa6, 0x6a,
+	0x44, 0xc6, 0x23, 0xbb, 0x13, 0x9c, 0x5d, 0x8e, 0x64, 0x86, 0x6b, 0xd4, 0x3f, 0x10, 0xc9, 0x8f,
+	0x0a, 0xec, 0x0b, 0xff, 0x98, 0x19, 0xe7, 0x6a, 0xd4, 0xd6, 0xa5, 0x2e, 0x46, 0x37, 0x40, 0x72,
+	0x27, 0x39, 0xb9, 0xa3, 0x24, 0x3b, 0x44, 0x2e, 0x3c, 0x4b, 0xc9, 0x43, 0x05, 0x26, 0x71, 0x16,
+	0x8e, 0xbd, 0x48, 0xe1, 0xfd, 0x45, 0x2d, 0x44, 0x81, 0x22, 0x17, 0x9d, 0x73, 0xc9, 0x93, 0x13,
+	0x43, 0x5c, 0x70, 0x90, 0x33, 0xe3, 0x6e, 0x6f, 0x19, 0xba, 0x47, 0x1e, 0x2b, 0x70, 0x60, 0x60,
+	0x40, 0x92, 0xd3, 0x63, 0xfc, 0x8d, 0x1e, 0xda, 0xea, 0xd2, 0xab, 0x98, 0x20, 0xd5, 0x05, 0x4e,
+	0xf5, 0x18, 0x39, 0x3a, 0xfc, 0x33, 0x7b, 0x60, 0xbe, 0x93, 0xef, 0x14, 0x48, 0x76, 0x27, 0x24,
+	0x39, 0x35, 0xc6, 0xd9, 0xe0, 0x0c, 0x57, 0xdf, 0x8b, 0x06, 0x46, 0x4e, 0x1a, 0xe7, 0x94, 0x26,
+	0xea, 0x10, 0xa7, 0xde, 0x50, 0xfd, 0x41, 0x81, 0x29, 0x69, 0x49, 0x0a, 0x11, 0x8e, 0x97, 0x54,
+	0x4e, 0x45, 0xc2, 0x22, 0x93, 0x45, 0xce, 0xa4, 0x40, 0xf2, 0x2f, 0x67, 0x62, 0xdc, 0xed, 0x9b,
+	0xbd, 0xa2, 0x94, 0x03, 0x33, 0x6a, 0x6c, 0x29, 0x47, 0xcf, 0x4d, 0x75, 0xe9, 0x55, 0x4c, 0x76,
+	0x2d, 0x25, 0x45, 0x8b, 0x8a, 0xec, 0x63, 0xf7, 0x21, 0x21, 0xa6, 0xce, 0xd8, 0x36, 0x16, 0x1a,
+	0x72, 0xea, 0x42, 0x04, 0xe4, 0xae, 0xcd, 0x5c, 0x4c, 0xb7, 0xe2, 0xa7, 0x4f, 0x76, 0x32, 0xca,
+	0xd3, 0x9d, 0x8c, 0xf2, 0xd7, 0x4e, 0x46, 0x79, 0xf8, 0x3c, 0x33, 0xf1, 0xf4, 0x79, 0x66, 0xe2,
+	0xcf, 0xe7, 0x99, 0x89, 0x1b, 0xbb, 0xff, 0x5c, 0xba, 0xd3, 0x3d, 0x94, 0x6f, 0xf0, 0xd5, 0x04,
+	0x87, 0x9c, 0xf9, 0x6f, 0x00, 0xfc, 0x74, 0xc9, 0xa6, 0x46, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1038,6 +1359,12 @@ type QueryClient interface {
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(ctx context.Context, in *QueryGroupTransitionRequest, opts ...grpc.CallOption) (*QueryGroupTransitionResponse, error)
+	// Evidences queries the recorded evidences of misbehaving members.
+	Evidences(ctx context.Context, in *QueryEvidencesRequest, opts ...grpc.CallOption) (*QueryEvidencesResponse, error)
+	// Evidence queries the evidence of the given ID.
+	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
+	// ExcludedMembers queries the addresses that are excluded from signing assignments.
+	ExcludedMembers(ctx context.Context, in *QueryExcludedMembersRequest, opts ...grpc.CallOption) (*QueryExcludedMembersResponse, error)
 	// Params queries parameters of bandtss module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1113,6 +1440,33 @@ func (c *queryClient) GroupTransition(ctx context.Context, in *QueryGroupTransit
 	return out, nil
 }
 
+func (c *queryClient) Evidences(ctx context.Context, in *QueryEvidencesRequest, opts ...grpc.CallOption) (*QueryEvidencesResponse, error) {
+	out := new(QueryEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/Evidences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
+	out := new(QueryEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/Evidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExcludedMembers(ctx context.Context, in *QueryExcludedMembersRequest, opts ...grpc.CallOption) (*QueryExcludedMembersResponse, error) {
+	out := new(QueryExcludedMembersResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/ExcludedMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/Params", in, out, opts...)
@@ -1138,6 +1492,12 @@ type QueryServer interface {
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error)
+	// Evidences queries the recorded evidences of misbehaving members.
+	Evidences(context.Context, *QueryEvidencesRequest) (*QueryEvidencesResponse, error)
+	// Evidence queries the evidence of the given ID.
+	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
+	// ExcludedMembers queries the addresses that are excluded from signing assignments.
+	ExcludedMembers(context.Context, *QueryExcludedMembersRequest) (*QueryExcludedMembersResponse, error)
 	// Params queries parameters of bandtss module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1167,6 +1527,15 @@ func (*UnimplementedQueryServer) Signing(ctx context.Context, req *QuerySigningR
 func (*UnimplementedQueryServer) GroupTransition(ctx context.Context, req *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupTransition not implemented")
 }
+func (*UnimplementedQueryServer) Evidences(ctx context.Context, req *QueryEvidencesRequest) (*QueryEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidences not implemented")
+}
+func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+}
+func (*UnimplementedQueryServer) ExcludedMembers(ctx context.Context, req *QueryExcludedMembersRequest) (*QueryExcludedMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExcludedMembers not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1301,6 +1670,60 @@ func _Query_GroupTransition_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Evidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Query/Evidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidences(ctx, req.(*QueryEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Query/Evidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExcludedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExcludedMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExcludedMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Query/ExcludedMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExcludedMembers(ctx, req.(*QueryExcludedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1351,6 +1774,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GroupTransition",
 			Handler:    _Query_GroupTransition_Handler,
 		},
+		{
+			MethodName: "Evidences",
+			Handler:    _Query_Evidences_Handler,
+		},
+		{
+			MethodName: "Evidence",
+			Handler:    _Query_Evidence_Handler,
+		},
+		{
+			MethodName: "ExcludedMembers",
+			Handler:    _Query_ExcludedMembers_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1891,7 +2326,7 @@ func (m *QueryGroupTransitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEvidencesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1901,17 +2336,248 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EvidenceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExcludedMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExcludedMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExcludedMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExcludedMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExcludedMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExcludedMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
 
 func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
@@ -2174,43 +2840,134 @@ func (m *QueryGroupTransitionResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryEvidencesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryEvidencesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		n += 1 + sovQuery(uint64(m.EvidenceId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Evidence.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+
+func (m *QueryExcludedMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExcludedMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -3141,11 +3898,625 @@ func (m *QueryIncomingGroupResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.GroupStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+			}
+			m.SigningId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerSigner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePerSigner = append(m.FeePerSigner, types1.Coin{})
+			if err := m.FeePerSigner[len(m.FeePerSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGroupSigningResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentGroupSigningResult == nil {
+				m.CurrentGroupSigningResult = &types.SigningResult{}
+			}
+			if err := m.CurrentGroupSigningResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingGroupSigningResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncomingGroupSigningResult == nil {
+				m.IncomingGroupSigningResult = &types.SigningResult{}
+			}
+			if err := m.IncomingGroupSigningResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupTransitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupTransitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupTransitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupTransitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupTransition == nil {
+				m.GroupTransition = &GroupTransition{}
+			}
+			if err := m.GroupTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3155,31 +4526,31 @@ func (m *QueryIncomingGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.Evidences = append(m.Evidences, Evidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3189,11 +4560,28 @@ func (m *QueryIncomingGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.GroupStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3215,7 +4603,7 @@ func (m *QueryIncomingGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,17 +4626,17 @@ func (m *QuerySigningRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceId", wireType)
 			}
-			m.SigningId = 0
+			m.EvidenceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3258,7 +4646,7 @@ func (m *QuerySigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigningId |= uint64(b&0x7F) << shift
+				m.EvidenceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3284,7 +4672,7 @@ func (m *QuerySigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3307,117 +4695,15 @@ func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePerSigner", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeePerSigner = append(m.FeePerSigner, types1.Coin{})
-			if err := m.FeePerSigner[len(m.FeePerSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGroupSigningResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CurrentGroupSigningResult == nil {
-				m.CurrentGroupSigningResult = &types.SigningResult{}
-			}
-			if err := m.CurrentGroupSigningResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncomingGroupSigningResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3444,10 +4730,7 @@ func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncomingGroupSigningResult == nil {
-				m.IncomingGroupSigningResult = &types.SigningResult{}
-			}
-			if err := m.IncomingGroupSigningResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3472,7 +4755,7 @@ func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupTransitionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExcludedMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3495,12 +4778,48 @@ func (m *QueryGroupTransitionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupTransitionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExcludedMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExcludedMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3522,7 +4841,7 @@ func (m *QueryGroupTransitionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupTransitionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExcludedMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3545,15 +4864,47 @@ func (m *QueryGroupTransitionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupTransitionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExcludedMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExcludedMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupTransition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3580,10 +4931,10 @@ func (m *QueryGroupTransitionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupTransition == nil {
-				m.GroupTransition = &GroupTransition{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.GroupTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,11 +6,19 @@ import (
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-// GroupTransitionPath is the reserved path for transition group msg
-const GroupTransitionPath = "transition"
+const (
+	// GroupTransitionPath is the reserved path for transition group msg
+	GroupTransitionPath = "transition"
+
+	// BeaconPath is the reserved path for randomness beacon msg
+	BeaconPath = "beacon"
+)
 
 // Implements SignatureRequest Interface
-var _ tsstypes.Content = &GroupTransitionSignatureOrder{}
+var (
+	_ tsstypes.Content = &GroupTransitionSignatureOrder{}
+	_ tsstypes.Content = &BeaconSignatureOrder{}
+)
 
 func NewGroupTransitionSignatureOrder(
 	pubKey []byte,
@@ -35,3 +43,24 @@ func (rs *GroupTransitionSignatureOrder) IsInternal() bool { return true }
 
 // ValidateBasic performs no-op for this type
 func (rs *GroupTransitionSignatureOrder) ValidateBasic() error { return nil }
+
+func NewBeaconSignatureOrder(round uint64, previousValue []byte) *BeaconSignatureOrder {
+	return &BeaconSignatureOrder{
+		Round:         round,
+		PreviousValue: previousValue,
+	}
+}
+
+// OrderRoute returns the order router key
+func (bs *BeaconSignatureOrder) OrderRoute() string { return RouterKey }
+
+// OrderType of BeaconSignatureOrder is "beacon"
+func (bs *BeaconSignatureOrder) OrderType() string {
+	return BeaconPath
+}
+
+// IsInternal returns true for BeaconSignatureOrder (internal module-based request signature).
+func (bs *BeaconSignatureOrder) IsInternal() bool { return true }
+
+// ValidateBasic performs no-op for this type
+func (bs *BeaconSignatureOrder) ValidateBasic() error { return nil }
@@ -516,6 +516,98 @@ func (m *MsgForceTransitionGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransitionGroupResponse proto.InternalMessageInfo
 
+// MsgReadmitMember is the Msg/ReadmitMember request type.
+type MsgReadmitMember struct {
+	// address is the address of the excluded member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgReadmitMember) Reset()         { *m = MsgReadmitMember{} }
+func (m *MsgReadmitMember) String() string { return proto.CompactTextString(m) }
+func (*MsgReadmitMember) ProtoMessage()    {}
+func (*MsgReadmitMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{10}
+}
+func (m *MsgReadmitMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReadmitMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReadmitMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReadmitMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReadmitMember.Merge(m, src)
+}
+func (m *MsgReadmitMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReadmitMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReadmitMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReadmitMember proto.InternalMessageInfo
+
+func (m *MsgReadmitMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgReadmitMember) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgReadmitMemberResponse is the Msg/ReadmitMember response type.
+type MsgReadmitMemberResponse struct {
+}
+
+func (m *MsgReadmitMemberResponse) Reset()         { *m = MsgReadmitMemberResponse{} }
+func (m *MsgReadmitMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReadmitMemberResponse) ProtoMessage()    {}
+func (*MsgReadmitMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{11}
+}
+func (m *MsgReadmitMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReadmitMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReadmitMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReadmitMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReadmitMemberResponse.Merge(m, src)
+}
+func (m *MsgReadmitMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReadmitMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReadmitMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReadmitMemberResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRequestSignature)(nil), "band.bandtss.v1beta1.MsgRequestSignature")
 	proto.RegisterType((*MsgRequestSignatureResponse)(nil), "band.bandtss.v1beta1.MsgRequestSignatureResponse")
@@ -527,68 +619,74 @@ func init() {
 	proto.RegisterType((*MsgTransitionGroupResponse)(nil), "band.bandtss.v1beta1.MsgTransitionGroupResponse")
 	proto.RegisterType((*MsgForceTransitionGroup)(nil), "band.bandtss.v1beta1.MsgForceTransitionGroup")
 	proto.RegisterType((*MsgForceTransitionGroupResponse)(nil), "band.bandtss.v1beta1.MsgForceTransitionGroupResponse")
+	proto.RegisterType((*MsgReadmitMember)(nil), "band.bandtss.v1beta1.MsgReadmitMember")
+	proto.RegisterType((*MsgReadmitMemberResponse)(nil), "band.bandtss.v1beta1.MsgReadmitMemberResponse")
 }
 
 func init() { proto.RegisterFile("band/bandtss/v1beta1/tx.proto", fileDescriptor_1607716805749e77) }
 
 var fileDescriptor_1607716805749e77 = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x31, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0x2d, 0xd5, 0x92, 0xce, 0x05, 0xdc, 0x30, 0x2a, 0x2c, 0x33, 0x8e, 0xe8, 0xa8, 0x28,
-	0xa0, 0x18, 0x10, 0xcf, 0x76, 0xd0, 0x0e, 0xee, 0x50, 0x58, 0x29, 0x1a, 0xb8, 0xa8, 0x80, 0x82,
-	0x49, 0x81, 0x22, 0x8b, 0x40, 0x91, 0xe7, 0xd3, 0x21, 0xe6, 0x1d, 0xcb, 0x3b, 0x19, 0x36, 0xd0,
-	0xa1, 0xe8, 0x54, 0x74, 0xca, 0x4f, 0xe8, 0xdc, 0x2e, 0x2e, 0x9a, 0xa9, 0x53, 0xc7, 0xa0, 0x53,
-	0xd0, 0xa9, 0x93, 0x53, 0xc8, 0x83, 0xff, 0x41, 0x87, 0x4e, 0xc5, 0x1d, 0x8f, 0xb4, 0x4c, 0x51,
-	0x8d, 0x82, 0x2c, 0x24, 0xef, 0xbd, 0xef, 0xdd, 0x7b, 0xdf, 0x77, 0xef, 0x1d, 0xc1, 0xed, 0xa1,
-	0x47, 0x03, 0x28, 0x1f, 0x82, 0x73, 0x78, 0xbc, 0x33, 0x44, 0xc2, 0xdb, 0x81, 0xe2, 0xc4, 0x89,
-	0x62, 0x26, 0x98, 0xd9, 0x90, 0x1e, 0x47, 0xbb, 0x1d, 0xed, 0xb6, 0x1a, 0x98, 0x61, 0xa6, 0x00,
-	0x50, 0x7e, 0x25, 0x58, 0x6b, 0x1d, 0x33, 0x86, 0x8f, 0x10, 0x54, 0xab, 0xe1, 0xf8, 0x10, 0x7a,
-	0xf4, 0x54, 0xbb, 0xec, 0xbc, 0x4b, 0x90, 0x10, 0x71, 0xe1, 0x85, 0x91, 0x06, 0xdc, 0xf0, 0x42,
-	0x42, 0x19, 0x54, 0x4f, 0x6d, 0x6a, 0xf9, 0x8c, 0x87, 0x8c, 0xc3, 0xa1, 0xc7, 0x51, 0x56, 0x98,
-	0xcf, 0x08, 0x4d, 0xd3, 0x25, 0xfe, 0x41, 0x52, 0x47, 0xb2, 0xd0, 0xae, 0x35, 0x1d, 0x1a, 0x72,
-	0x0c, 0x8f, 0x77, 0xe4, 0x4b, 0x3b, 0xda, 0x85, 0x6c, 0x31, 0xa2, 0x88, 0x13, 0x1d, 0xdc, 0xfe,
-	0x75, 0x09, 0xdc, 0xec, 0x73, 0xec, 0xa2, 0xaf, 0xc7, 0x88, 0x8b, 0x87, 0x04, 0x53, 0x4f, 0x8c,
-	0x63, 0x64, 0x7e, 0x04, 0xaa, 0x3e, 0xa3, 0x02, 0x51, 0xd1, 0x34, 0x36, 0x8d, 0xce, 0xca, 0x6e,
-	0xc3, 0x49, 0x58, 0x39, 0x29, 0x2b, 0x67, 0x9f, 0x9e, 0xf6, 0x56, 0xfe, 0x78, 0xd6, 0xad, 0xde,
-	0x4f, 0x80, 0x6e, 0x1a, 0x61, 0x9a, 0xa0, 0x12, 0xa2, 0x90, 0x35, 0x97, 0x36, 0x8d, 0x4e, 0xdd,
-	0x55, 0xdf, 0xe6, 0x08, 0xd4, 0x0f, 0x11, 0x1a, 0x1c, 0x91, 0x90, 0x88, 0x66, 0x79, 0xb3, 0xdc,
-	0x59, 0xd9, 0x5d, 0x77, 0x34, 0x0f, 0x49, 0x3a, 0x95, 0xdb, 0xb9, 0xcf, 0x08, 0xed, 0x6d, 0x3f,
-	0x3f, 0xb7, 0x4b, 0x3f, 0xbd, 0xb4, 0x3b, 0x98, 0x88, 0xd1, 0x78, 0xe8, 0xf8, 0x2c, 0xd4, 0xa4,
-	0xf5, 0xab, 0xcb, 0x83, 0x27, 0x50, 0x9c, 0x46, 0x88, 0xab, 0x00, 0xee, 0xd6, 0x0e, 0x11, 0xfa,
-	0x5c, 0x6e, 0x6e, 0x6e, 0x83, 0x65, 0x8e, 0x68, 0x80, 0xe2, 0x66, 0x45, 0xe6, 0xef, 0x35, 0xff,
-	0x7c, 0xd6, 0x6d, 0xe8, 0x4c, 0xfb, 0x41, 0x10, 0x23, 0xce, 0x1f, 0x8a, 0x98, 0x50, 0xec, 0x6a,
-	0xdc, 0x1e, 0xfc, 0xfe, 0x47, 0xbb, 0xf4, 0xdd, 0xe5, 0xd9, 0x96, 0x36, 0xfc, 0x70, 0x79, 0xb6,
-	0x75, 0x2b, 0xd5, 0xad, 0x40, 0x9d, 0xf6, 0x6d, 0x70, 0xab, 0xc0, 0xec, 0x22, 0x1e, 0x31, 0xca,
-	0x51, 0xfb, 0x77, 0x03, 0xac, 0xf4, 0x39, 0xde, 0xf7, 0x05, 0x39, 0xf6, 0x04, 0x9a, 0xaa, 0xc8,
-	0x58, 0xac, 0x22, 0xf3, 0x31, 0xa8, 0xe1, 0x98, 0x8d, 0xa3, 0x01, 0x09, 0x94, 0x8a, 0x95, 0xde,
-	0xc7, 0x93, 0x73, 0xbb, 0xfa, 0x40, 0xda, 0x0e, 0x3e, 0xf9, 0xf7, 0xdc, 0xde, 0x9e, 0x12, 0x46,
-	0x56, 0xaa, 0x4e, 0xc5, 0x67, 0x47, 0xd0, 0x1f, 0x79, 0x84, 0xc2, 0xe3, 0x7b, 0x30, 0x7a, 0x82,
-	0xa1, 0x6c, 0x63, 0x1d, 0xe3, 0x56, 0xd5, 0x86, 0x07, 0xc1, 0xde, 0x7b, 0x39, 0xa6, 0x37, 0xa7,
-	0x98, 0xa6, 0x25, 0xb7, 0xdf, 0x55, 0x6d, 0x91, 0x2e, 0x33, 0x66, 0xbf, 0x18, 0x60, 0xb5, 0xcf,
-	0xf1, 0x97, 0x51, 0xe0, 0x09, 0xf4, 0x85, 0x17, 0x7b, 0x21, 0x37, 0xf7, 0xc0, 0x72, 0xa4, 0xbe,
-	0x74, 0xa7, 0x6c, 0x38, 0x45, 0x63, 0xe4, 0x24, 0xe8, 0x5e, 0x45, 0x9e, 0xac, 0xab, 0x23, 0xcc,
-	0x0f, 0x41, 0xdd, 0x1b, 0x8b, 0x11, 0x8b, 0x89, 0x38, 0x4d, 0xda, 0xe5, 0x7f, 0xc4, 0xb9, 0x82,
-	0xee, 0x6d, 0x49, 0x0e, 0x57, 0x6b, 0x49, 0x63, 0x6d, 0x8a, 0xc6, 0x74, 0x7d, 0xed, 0x75, 0xb0,
-	0x96, 0x33, 0x65, 0x74, 0xfe, 0x31, 0x80, 0xd9, 0xe7, 0xf8, 0x51, 0xec, 0x51, 0x4e, 0x04, 0x61,
-	0x54, 0x89, 0x65, 0x36, 0x41, 0x35, 0x44, 0xe1, 0x10, 0xc5, 0x92, 0x52, 0xb9, 0x53, 0x77, 0xd3,
-	0xa5, 0xb9, 0x01, 0xea, 0x62, 0x14, 0x23, 0x3e, 0x62, 0x47, 0xfa, 0x60, 0xdc, 0x2b, 0x83, 0xb9,
-	0x0f, 0xea, 0xe8, 0x04, 0xf9, 0x03, 0x39, 0xef, 0xcd, 0xb2, 0x12, 0xc3, 0x9a, 0x19, 0x9b, 0x47,
-	0xe9, 0x65, 0xd0, 0xab, 0x49, 0x29, 0x9e, 0xbe, 0xb4, 0x0d, 0xb7, 0x26, 0xc3, 0xa4, 0xe3, 0xba,
-	0x20, 0x95, 0xc5, 0x05, 0xe9, 0xce, 0x0a, 0x62, 0x4d, 0x09, 0x92, 0x63, 0xd8, 0xde, 0x00, 0xd6,
-	0xac, 0x35, 0x93, 0xe5, 0xb7, 0x25, 0x25, 0xd9, 0xa7, 0x2c, 0xf6, 0x51, 0x5e, 0x1b, 0x0e, 0x6e,
-	0x10, 0xea, 0xb3, 0x90, 0x50, 0x3c, 0xc8, 0x5a, 0xd4, 0x50, 0x2d, 0xfa, 0x60, 0x72, 0x6e, 0xaf,
-	0x1e, 0x68, 0xe7, 0x9b, 0xb4, 0xea, 0x2a, 0xb9, 0xb6, 0x49, 0x4e, 0xd8, 0xa5, 0x37, 0x17, 0xb6,
-	0xbc, 0xb8, 0xb0, 0x70, 0x56, 0xd8, 0x8d, 0x54, 0xd8, 0x22, 0x81, 0xda, 0x77, 0x80, 0x3d, 0x47,
-	0xbb, 0x54, 0xdf, 0xdd, 0x9f, 0x2b, 0xa0, 0xdc, 0xe7, 0xd8, 0x8c, 0xc0, 0x3b, 0x33, 0x17, 0xef,
-	0xdd, 0xe2, 0xe9, 0x29, 0xb8, 0x6e, 0xac, 0x9d, 0x85, 0xa1, 0x69, 0x66, 0xf3, 0x2b, 0x50, 0xcb,
-	0x6e, 0xa5, 0x3b, 0x73, 0xc3, 0x53, 0x88, 0x75, 0xf7, 0x95, 0x90, 0x6c, 0xe7, 0x00, 0xbc, 0x7d,
-	0xed, 0x56, 0x78, 0x7f, 0x6e, 0xe8, 0x34, 0xcc, 0xea, 0x2e, 0x04, 0xcb, 0xb2, 0x84, 0x60, 0x35,
-	0xdf, 0x90, 0x9d, 0xb9, 0x3b, 0xe4, 0x90, 0xd6, 0xf6, 0xa2, 0xc8, 0x2c, 0xdd, 0x37, 0xa0, 0x51,
-	0x38, 0x04, 0xf3, 0xab, 0x2e, 0x82, 0x5b, 0x1f, 0xbc, 0x16, 0x3c, 0xcd, 0x6e, 0xbd, 0xf5, 0xed,
-	0xe5, 0xd9, 0x96, 0xd1, 0xfb, 0xec, 0xf9, 0xa4, 0x65, 0xbc, 0x98, 0xb4, 0x8c, 0xbf, 0x27, 0x2d,
-	0xe3, 0xe9, 0x45, 0xab, 0xf4, 0xe2, 0xa2, 0x55, 0xfa, 0xeb, 0xa2, 0x55, 0x7a, 0xfc, 0xea, 0xc9,
-	0x3a, 0xc9, 0x7e, 0xff, 0xea, 0x5f, 0x39, 0x5c, 0x56, 0x90, 0x7b, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0x0c, 0x12, 0x01, 0x09, 0x09, 0x00, 0x00,
+	// 946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xec, 0x26, 0x99, 0x82, 0xba, 0xeb, 0x06, 0xd5, 0xf5, 0x76, 0xe3, 0xae, 0x11,
+	0x28, 0x5b, 0x14, 0xbb, 0xed, 0x0a, 0x0e, 0xe5, 0x80, 0x9a, 0x45, 0xac, 0x8a, 0x88, 0x84, 0xbc,
+	0x8b, 0x84, 0xf6, 0x12, 0x39, 0xf6, 0x74, 0x32, 0xda, 0x7a, 0xc6, 0x78, 0x26, 0x55, 0x2b, 0x71,
+	0x40, 0x9c, 0x10, 0xa7, 0x3d, 0xf0, 0x03, 0x38, 0xc3, 0xa5, 0x88, 0x3d, 0x71, 0xe2, 0xb8, 0xe2,
+	0xb4, 0xe2, 0xc4, 0xa9, 0x8b, 0xd2, 0x43, 0xff, 0x01, 0x07, 0x4e, 0x68, 0x9c, 0xb1, 0xeb, 0x38,
+	0x0e, 0x0d, 0xec, 0x25, 0xf6, 0xbc, 0xf7, 0xbd, 0x79, 0xef, 0xfb, 0xe6, 0xcd, 0x8b, 0xc1, 0xed,
+	0x81, 0x4b, 0x7c, 0x5b, 0xfc, 0x70, 0xc6, 0xec, 0xa3, 0xed, 0x01, 0xe4, 0xee, 0xb6, 0xcd, 0x8f,
+	0xad, 0x30, 0xa2, 0x9c, 0xaa, 0x4d, 0xe1, 0xb1, 0xa4, 0xdb, 0x92, 0x6e, 0xbd, 0x89, 0x28, 0xa2,
+	0x31, 0xc0, 0x16, 0x6f, 0x13, 0xac, 0xbe, 0x86, 0x28, 0x45, 0x87, 0xd0, 0x8e, 0x57, 0x83, 0xd1,
+	0x81, 0xed, 0x92, 0x13, 0xe9, 0x32, 0xf2, 0x2e, 0x8e, 0x03, 0xc8, 0xb8, 0x1b, 0x84, 0x12, 0x70,
+	0xd3, 0x0d, 0x30, 0xa1, 0x76, 0xfc, 0x2b, 0x4d, 0x2d, 0x8f, 0xb2, 0x80, 0x32, 0x7b, 0xe0, 0x32,
+	0x98, 0x16, 0xe6, 0x51, 0x4c, 0x92, 0x74, 0x13, 0x7f, 0x7f, 0x52, 0xc7, 0x64, 0x21, 0x5d, 0xab,
+	0x32, 0x34, 0x60, 0xc8, 0x3e, 0xda, 0x16, 0x0f, 0xe9, 0x30, 0x0b, 0xd9, 0x22, 0x48, 0x20, 0xc3,
+	0x32, 0xd8, 0xfc, 0xb9, 0x0c, 0x56, 0x7a, 0x0c, 0x39, 0xf0, 0x8b, 0x11, 0x64, 0xfc, 0x21, 0x46,
+	0xc4, 0xe5, 0xa3, 0x08, 0xaa, 0xef, 0x83, 0x9a, 0x47, 0x09, 0x87, 0x84, 0x6b, 0xca, 0x86, 0xd2,
+	0x5e, 0xda, 0x69, 0x5a, 0x13, 0x56, 0x56, 0xc2, 0xca, 0xda, 0x23, 0x27, 0xdd, 0xa5, 0xdf, 0x9e,
+	0x75, 0x6a, 0xf7, 0x27, 0x40, 0x27, 0x89, 0x50, 0x55, 0x50, 0x0d, 0x60, 0x40, 0xb5, 0xf2, 0x86,
+	0xd2, 0x6e, 0x38, 0xf1, 0xbb, 0x3a, 0x04, 0x8d, 0x03, 0x08, 0xfb, 0x87, 0x38, 0xc0, 0x5c, 0xab,
+	0x6c, 0x54, 0xda, 0x4b, 0x3b, 0x6b, 0x96, 0xe4, 0x21, 0x48, 0x27, 0x72, 0x5b, 0xf7, 0x29, 0x26,
+	0xdd, 0xad, 0xe7, 0x67, 0x46, 0xe9, 0x87, 0x97, 0x46, 0x1b, 0x61, 0x3e, 0x1c, 0x0d, 0x2c, 0x8f,
+	0x06, 0x92, 0xb4, 0x7c, 0x74, 0x98, 0xff, 0xc4, 0xe6, 0x27, 0x21, 0x64, 0x71, 0x00, 0x73, 0xea,
+	0x07, 0x10, 0x7e, 0x22, 0x36, 0x57, 0xb7, 0xc0, 0x75, 0x06, 0x89, 0x0f, 0x23, 0xad, 0x2a, 0xf2,
+	0x77, 0xb5, 0xdf, 0x9f, 0x75, 0x9a, 0x32, 0xd3, 0x9e, 0xef, 0x47, 0x90, 0xb1, 0x87, 0x3c, 0xc2,
+	0x04, 0x39, 0x12, 0xb7, 0x6b, 0x7f, 0xf3, 0xbd, 0x51, 0xfa, 0xfa, 0xe2, 0x74, 0x53, 0x1a, 0xbe,
+	0xbd, 0x38, 0xdd, 0xbc, 0x95, 0xe8, 0x56, 0xa0, 0x8e, 0x79, 0x1b, 0xdc, 0x2a, 0x30, 0x3b, 0x90,
+	0x85, 0x94, 0x30, 0x68, 0xfe, 0xaa, 0x80, 0xa5, 0x1e, 0x43, 0x7b, 0x1e, 0xc7, 0x47, 0x2e, 0x87,
+	0x99, 0x8a, 0x94, 0xc5, 0x2a, 0x52, 0x1f, 0x83, 0x3a, 0x8a, 0xe8, 0x28, 0xec, 0x63, 0x3f, 0x56,
+	0xb1, 0xda, 0xfd, 0x60, 0x7c, 0x66, 0xd4, 0x1e, 0x08, 0xdb, 0xfe, 0x87, 0x7f, 0x9f, 0x19, 0x5b,
+	0x19, 0x61, 0x44, 0xa5, 0xf1, 0xa9, 0x78, 0xf4, 0xd0, 0xf6, 0x86, 0x2e, 0x26, 0xf6, 0xd1, 0x3d,
+	0x3b, 0x7c, 0x82, 0x6c, 0xd1, 0xc6, 0x32, 0xc6, 0xa9, 0xc5, 0x1b, 0xee, 0xfb, 0xbb, 0x6f, 0xe6,
+	0x98, 0xae, 0x64, 0x98, 0x26, 0x25, 0x9b, 0x6f, 0x80, 0x95, 0xcc, 0x32, 0x65, 0xf6, 0x93, 0x02,
+	0x96, 0x7b, 0x0c, 0x7d, 0x16, 0xfa, 0x2e, 0x87, 0x9f, 0xba, 0x91, 0x1b, 0x30, 0x75, 0x17, 0x5c,
+	0x0f, 0xe3, 0x37, 0xd9, 0x29, 0xeb, 0x56, 0xd1, 0x35, 0xb2, 0x26, 0xe8, 0x6e, 0x55, 0x9c, 0xac,
+	0x23, 0x23, 0xd4, 0xf7, 0x40, 0xc3, 0x1d, 0xf1, 0x21, 0x8d, 0x30, 0x3f, 0xd1, 0xca, 0x57, 0x88,
+	0x73, 0x09, 0xdd, 0xdd, 0x14, 0x1c, 0x2e, 0xd7, 0x82, 0xc6, 0x6a, 0x86, 0x46, 0xb6, 0x3e, 0x73,
+	0x0d, 0xac, 0xe6, 0x4c, 0x29, 0x9d, 0xbf, 0x14, 0xa0, 0xf6, 0x18, 0x7a, 0x14, 0xb9, 0x84, 0x61,
+	0x8e, 0x29, 0x89, 0xc5, 0x52, 0x35, 0x50, 0x0b, 0x60, 0x30, 0x80, 0x91, 0xa0, 0x54, 0x69, 0x37,
+	0x9c, 0x64, 0xa9, 0xae, 0x83, 0x06, 0x1f, 0x46, 0x90, 0x0d, 0xe9, 0xa1, 0x3c, 0x18, 0xe7, 0xd2,
+	0xa0, 0xee, 0x81, 0x06, 0x3c, 0x86, 0x5e, 0x5f, 0xdc, 0x77, 0xad, 0x12, 0x8b, 0xa1, 0xcf, 0x5c,
+	0x9b, 0x47, 0xc9, 0x30, 0xe8, 0xd6, 0x85, 0x14, 0x4f, 0x5f, 0x1a, 0x8a, 0x53, 0x17, 0x61, 0xc2,
+	0x31, 0x2d, 0x48, 0x75, 0x71, 0x41, 0x3a, 0xb3, 0x82, 0xe8, 0x19, 0x41, 0x72, 0x0c, 0xcd, 0x75,
+	0xa0, 0xcf, 0x5a, 0x53, 0x59, 0x7e, 0x29, 0xc7, 0x92, 0x7d, 0x44, 0x23, 0x0f, 0xe6, 0xb5, 0x61,
+	0xe0, 0x26, 0x26, 0x1e, 0x0d, 0x30, 0x41, 0xfd, 0xb4, 0x45, 0x95, 0xb8, 0x45, 0x1f, 0x8c, 0xcf,
+	0x8c, 0xe5, 0x7d, 0xe9, 0x7c, 0x95, 0x56, 0x5d, 0xc6, 0x53, 0x9b, 0xe4, 0x84, 0x2d, 0xbf, 0xba,
+	0xb0, 0x95, 0xc5, 0x85, 0xb5, 0x67, 0x85, 0x5d, 0x4f, 0x84, 0x2d, 0x12, 0xc8, 0xbc, 0x03, 0x8c,
+	0x39, 0xda, 0xa5, 0xfa, 0xfe, 0xa8, 0x80, 0x1b, 0xf1, 0xfc, 0x70, 0xfd, 0x00, 0xf3, 0x5e, 0xdc,
+	0x5b, 0xea, 0x0e, 0xa8, 0xb9, 0x93, 0x22, 0xae, 0x9c, 0x12, 0x09, 0xf0, 0x7f, 0x5f, 0x9f, 0x77,
+	0x66, 0x49, 0x69, 0x53, 0xf3, 0x2e, 0x53, 0x98, 0xa9, 0x03, 0x2d, 0x6f, 0x4b, 0x98, 0xec, 0x7c,
+	0x77, 0x0d, 0x54, 0x7a, 0x0c, 0xa9, 0x21, 0xb8, 0x31, 0xf3, 0x17, 0x72, 0xb7, 0x78, 0x0e, 0x14,
+	0x0c, 0x4e, 0x7d, 0x7b, 0x61, 0x68, 0x92, 0x59, 0xfd, 0x1c, 0xd4, 0xd3, 0xf9, 0x7a, 0x67, 0x6e,
+	0x78, 0x02, 0xd1, 0xef, 0x5e, 0x09, 0x49, 0x77, 0xf6, 0xc1, 0x6b, 0x53, 0xf3, 0xed, 0xad, 0xb9,
+	0xa1, 0x59, 0x98, 0xde, 0x59, 0x08, 0x96, 0x66, 0x09, 0xc0, 0x72, 0xfe, 0x6a, 0xb5, 0xe7, 0xee,
+	0x90, 0x43, 0xea, 0x5b, 0x8b, 0x22, 0xd3, 0x74, 0x5f, 0x82, 0x66, 0xe1, 0x75, 0x9e, 0x5f, 0x75,
+	0x11, 0x5c, 0x7f, 0xf7, 0x3f, 0xc1, 0xd3, 0xec, 0x08, 0xbc, 0x3e, 0xdd, 0xec, 0x6f, 0xff, 0xcb,
+	0x81, 0x67, 0x70, 0xba, 0xb5, 0x18, 0x2e, 0x49, 0xa4, 0x5f, 0xfb, 0xea, 0xe2, 0x74, 0x53, 0xe9,
+	0x7e, 0xfc, 0x7c, 0xdc, 0x52, 0x5e, 0x8c, 0x5b, 0xca, 0x9f, 0xe3, 0x96, 0xf2, 0xf4, 0xbc, 0x55,
+	0x7a, 0x71, 0xde, 0x2a, 0xfd, 0x71, 0xde, 0x2a, 0x3d, 0xbe, 0x7a, 0x18, 0x1d, 0xa7, 0x5f, 0x4c,
+	0xf1, 0xe7, 0xc5, 0xe0, 0x7a, 0x0c, 0xb9, 0xf7, 0xcf, 0x00, 0xf5, 0x82, 0x15, 0x7a, 0x3c, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -614,6 +712,8 @@ type MsgClient interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(ctx context.Context, in *MsgForceTransitionGroup, opts ...grpc.CallOption) (*MsgForceTransitionGroupResponse, error)
+	// ReadmitMember lifts the exclusion of a member that was penalized for misbehavior.
+	ReadmitMember(ctx context.Context, in *MsgReadmitMember, opts ...grpc.CallOption) (*MsgReadmitMemberResponse, error)
 }
 
 type msgClient struct {
@@ -669,6 +769,15 @@ func (c *msgClient) ForceTransitionGroup(ctx context.Context, in *MsgForceTransi
 	return out, nil
 }
 
+func (c *msgClient) ReadmitMember(ctx context.Context, in *MsgReadmitMember, opts ...grpc.CallOption) (*MsgReadmitMemberResponse, error) {
+	out := new(MsgReadmitMemberResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Msg/ReadmitMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestSignature submits a general message to be signed by a specific group.
@@ -682,6 +791,8 @@ type MsgServer interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error)
+	// ReadmitMember lifts the exclusion of a member that was penalized for misbehavior.
+	ReadmitMember(context.Context, *MsgReadmitMember) (*MsgReadmitMemberResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -703,6 +814,9 @@ func (*UnimplementedMsgServer) TransitionGroup(ctx context.Context, req *MsgTran
 func (*UnimplementedMsgServer) ForceTransitionGroup(ctx context.Context, req *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransitionGroup not implemented")
 }
+func (*UnimplementedMsgServer) ReadmitMember(ctx context.Context, req *MsgReadmitMember) (*MsgReadmitMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadmitMember not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -798,6 +912,24 @@ func _Msg_ForceTransitionGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReadmitMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReadmitMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReadmitMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Msg/ReadmitMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReadmitMember(ctx, req.(*MsgReadmitMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.bandtss.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -822,6 +954,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransitionGroup",
 			Handler:    _Msg_ForceTransitionGroup_Handler,
 		},
+		{
+			MethodName: "ReadmitMember",
+			Handler:    _Msg_ReadmitMember_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/bandtss/v1beta1/tx.proto",
@@ -1175,6 +1311,66 @@ func (m *MsgForceTransitionGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgReadmitMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReadmitMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReadmitMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReadmitMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReadmitMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReadmitMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1331,6 +1527,32 @@ func (m *MsgForceTransitionGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReadmitMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReadmitMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2287,6 +2509,170 @@ func (m *MsgForceTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReadmitMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReadmitMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReadmitMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReadmitMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReadmitMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReadmitMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
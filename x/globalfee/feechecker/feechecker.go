@@ -2,26 +2,34 @@ package feechecker
 
 import (
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
+	feedskeeper "github.com/bandprotocol/chain/v3/x/feeds/keeper"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/globalfee/keeper"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
 )
 
 type FeeChecker struct {
 	GlobalfeeKeeper *keeper.Keeper
 	StakingKeeper   *stakingkeeper.Keeper
+	FeedsKeeper     *feedskeeper.Keeper
 }
 
+// NewFeeChecker returns a new FeeChecker. The alternative fee denoms are not accepted if feedsKeeper is nil.
 func NewFeeChecker(
 	globalfeeKeeper *keeper.Keeper,
 	stakingKeeper *stakingkeeper.Keeper,
+	feedsKeeper *feedskeeper.Keeper,
 ) FeeChecker {
 	return FeeChecker{
 		GlobalfeeKeeper: globalfeeKeeper,
 		StakingKeeper:   stakingKeeper,
+		FeedsKeeper:     feedsKeeper,
 	}
 }
 
@@ -41,7 +49,12 @@ func (fc FeeChecker) CheckTxFee(
 	if err != nil {
 		return nil, 0, err
 	}
-	priority := getTxPriority(feeCoins, int64(gas), bondDenom)
+
+	// The prices of the alternative fee denoms are not charged to the transaction gas.
+	priceCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	pricing := fc.GlobalfeeKeeper.GetParams(priceCtx).FeeDenomPricing
+	rates := fc.GetFeeDenomRates(priceCtx, pricing)
+	priority := getTxPriority(feeCoins, int64(gas), bondDenom, rates)
 
 	// Ensure that the provided fees meet minimum-gas-prices and globalFees,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
@@ -57,6 +70,7 @@ func (fc FeeChecker) CheckTxFee(
 	}
 
 	allGasPrices := CombinedGasPricesRequirement(minGasPrices, globalMinGasPrices)
+	allGasPrices = AddFeeDenomGasPrices(allGasPrices, bondDenom, rates, pricing.MarkupBPS)
 
 	// Calculate all fees from all gas prices
 	var allFees sdk.Coins
@@ -105,3 +119,46 @@ func (fc FeeChecker) DefaultZeroGlobalFee(ctx sdk.Context) ([]sdk.DecCoin, error
 
 	return []sdk.DecCoin{sdk.NewDecCoinFromDec(bondDenom, sdkmath.LegacyNewDec(0))}, nil
 }
+
+// GetFeeDenomRates returns the value of one base unit of each alternative fee denom in the base unit of
+// the bond denom. A fee denom is left out if the price of either denom is unavailable or older than the
+// max price age.
+func (fc FeeChecker) GetFeeDenomRates(ctx sdk.Context, pricing types.FeeDenomPricing) map[string]sdkmath.LegacyDec {
+	if fc.FeedsKeeper == nil || len(pricing.FeeDenoms) == 0 {
+		return nil
+	}
+
+	bondDenomPrice, ok := fc.getFreshPrice(ctx, pricing.BondDenomSignalID, pricing.MaxPriceAge)
+	if !ok {
+		return nil
+	}
+
+	rates := make(map[string]sdkmath.LegacyDec)
+	for _, feeDenom := range pricing.FeeDenoms {
+		price, ok := fc.getFreshPrice(ctx, feeDenom.SignalID, pricing.MaxPriceAge)
+		if !ok {
+			continue
+		}
+
+		rate := getFeeDenomRate(price, feeDenom.Exponent, bondDenomPrice, pricing.BondDenomExponent)
+		if rate.IsPositive() {
+			rates[feeDenom.Denom] = rate
+		}
+	}
+
+	return rates
+}
+
+// getFreshPrice returns the price of the signal id if it is available and not older than maxPriceAge seconds.
+func (fc FeeChecker) getFreshPrice(ctx sdk.Context, signalID string, maxPriceAge int64) (uint64, bool) {
+	price := fc.FeedsKeeper.GetPrice(ctx, signalID)
+	if price.Status != feedstypes.PRICE_STATUS_AVAILABLE || price.Price == 0 {
+		return 0, false
+	}
+
+	if ctx.BlockTime().Unix()-price.Timestamp > maxPriceAge {
+		return 0, false
+	}
+
+	return price.Price, true
+}
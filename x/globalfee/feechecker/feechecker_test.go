@@ -2,6 +2,7 @@ package feechecker_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	protov2 "google.golang.org/protobuf/proto"
@@ -14,9 +15,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/globalfee/feechecker"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -85,6 +89,7 @@ func (suite *FeeCheckerTestSuite) SetupTest() {
 	suite.FeeChecker = feechecker.NewFeeChecker(
 		&app.GlobalFeeKeeper,
 		app.StakingKeeper,
+		&app.FeedsKeeper,
 	)
 }
 
@@ -97,6 +102,84 @@ func (suite *FeeCheckerTestSuite) TestDefaultZeroGlobalFee() {
 	suite.Require().NoError(err)
 }
 
+func (suite *FeeCheckerTestSuite) TestCheckTxFeeWithFeeDenoms() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4)))
+	params.FeeDenomPricing = types.NewFeeDenomPricing(
+		"CS:BAND-USD",
+		6,
+		[]types.FeeDenom{
+			types.NewFeeDenom("uusdc", "CS:USDC-USD", 6),
+			types.NewFeeDenom("uatom", "CS:ATOM-USD", 6),
+		},
+		60,
+		1000,
+	)
+	suite.Require().NoError(suite.FeeChecker.GlobalfeeKeeper.SetParams(ctx, params))
+
+	// 1 uusdc is worth 0.5 uband, so the gas price is 0.0025 * 1.1 / 0.5 = 0.0055 uusdc.
+	suite.FeeChecker.FeedsKeeper.SetPrices(ctx, []feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000000000, 990),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:USDC-USD", 1000000000, 990),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ATOM-USD", 5000000000, 900),
+	})
+
+	testCases := []struct {
+		name      string
+		gasPrices sdk.DecCoins
+		priority  int64
+		expectErr bool
+	}{
+		{
+			name:      "enough fee in bond denom",
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))),
+			priority:  25,
+		},
+		{
+			name:      "enough fee in fee denom",
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdkmath.LegacyNewDecWithPrec(55, 4))),
+			priority:  27,
+		},
+		{
+			name:      "not enough fee in fee denom without markup",
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdkmath.LegacyNewDecWithPrec(5, 3))),
+			expectErr: true,
+		},
+		{
+			name:      "fee denom with stale price",
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDec(1))),
+			expectErr: true,
+		},
+		{
+			name:      "fee denom not in the list",
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", sdkmath.LegacyNewDec(1))),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tx := &StubTx{GasPrices: tc.gasPrices}
+			_, priority, err := suite.FeeChecker.CheckTxFee(ctx, tx)
+			if tc.expectErr {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.priority, priority)
+		})
+	}
+
+	// the fee denom is not accepted once the bond denom price is stale
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	tx := &StubTx{GasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdkmath.LegacyNewDecWithPrec(55, 4)))}
+	_, _, err := suite.FeeChecker.CheckTxFee(ctx, tx)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
+
 func TestFeeCheckerTestSuite(t *testing.T) {
 	suite.Run(t, new(FeeCheckerTestSuite))
 }
@@ -3,20 +3,32 @@ package feechecker
 import (
 	"math"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// getTxPriority returns priority of the provided fee based on gas prices of uband
-func getTxPriority(fee sdk.Coins, gas int64, denom string) int64 {
-	ok, c := fee.Find(denom)
-	if !ok {
+// getTxPriority returns priority of the provided fee based on gas prices of uband. The fees in the
+// alternative fee denoms are converted to uband with the given rates.
+func getTxPriority(fee sdk.Coins, gas int64, denom string, rates map[string]sdkmath.LegacyDec) int64 {
+	value := sdkmath.LegacyZeroDec()
+	for _, c := range fee {
+		if c.Denom == denom {
+			value = value.Add(sdkmath.LegacyNewDecFromInt(c.Amount))
+		} else if rate, ok := rates[c.Denom]; ok {
+			value = value.Add(rate.MulInt(c.Amount))
+		}
+	}
+
+	amount := value.TruncateInt()
+	if !amount.IsPositive() {
 		return 0
 	}
 
 	// multiplied by 10000 first to support our current standard (0.0025) because priority is int64.
 	// otherwise, if gas_price < 1, the priority will be 0.
 	priority := int64(math.MaxInt64)
-	gasPrice := c.Amount.MulRaw(10000).QuoRaw(gas)
+	gasPrice := amount.MulRaw(10000).QuoRaw(gas)
 	if gasPrice.IsInt64() {
 		priority = gasPrice.Int64()
 	}
@@ -54,3 +66,38 @@ func CombinedGasPricesRequirement(globalMinGasPrices, minGasPrices sdk.DecCoins)
 
 	return allGasPrices.Sort()
 }
+
+// getFeeDenomRate returns the value of one base unit of a fee denom in the base unit of the bond denom,
+// given the prices and the exponents of the display units of both denoms.
+func getFeeDenomRate(price uint64, exponent uint32, bondDenomPrice uint64, bondDenomExponent uint32) sdkmath.LegacyDec {
+	value := sdkmath.NewIntFromUint64(price).Mul(sdkmath.NewIntWithDecimal(1, int(bondDenomExponent)))
+	bondDenomValue := sdkmath.NewIntFromUint64(bondDenomPrice).Mul(sdkmath.NewIntWithDecimal(1, int(exponent)))
+
+	return sdkmath.LegacyNewDecFromInt(value).QuoInt(bondDenomValue)
+}
+
+// AddFeeDenomGasPrices adds the gas prices of the alternative fee denoms, converted from the bond denom gas
+// price with the rates plus the markup in basis points, to the gas prices. The gas prices that are already set are kept.
+func AddFeeDenomGasPrices(
+	gasPrices sdk.DecCoins,
+	bondDenom string,
+	rates map[string]sdkmath.LegacyDec,
+	markupBPS uint64,
+) sdk.DecCoins {
+	bondDenomGasPrice := gasPrices.AmountOf(bondDenom)
+	if !bondDenomGasPrice.IsPositive() || len(rates) == 0 {
+		return gasPrices
+	}
+
+	multiplier := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(10000 + markupBPS)).QuoInt64(10000)
+
+	for denom, rate := range rates {
+		if gasPrices.AmountOf(denom).IsPositive() {
+			continue
+		}
+
+		gasPrices = append(gasPrices, sdk.NewDecCoinFromDec(denom, bondDenomGasPrice.Mul(multiplier).Quo(rate)))
+	}
+
+	return gasPrices.Sort()
+}
@@ -125,3 +125,64 @@ func (s *utilsTestSuite) TestCombinedGasPricesRequirement() {
 		})
 	}
 }
+
+func (s *utilsTestSuite) TestAddFeeDenomGasPrices() {
+	bondDenomGasPrice := sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(25, 4))
+	rates := map[string]math.LegacyDec{
+		"uusdc": math.LegacyNewDecWithPrec(5, 1),
+		"uatom": math.LegacyNewDec(10),
+	}
+
+	tests := map[string]struct {
+		gasPrices sdk.DecCoins
+		rates     map[string]math.LegacyDec
+		markupBPS uint64
+		expected  sdk.DecCoins
+	}{
+		"no rates": {
+			gasPrices: sdk.DecCoins{bondDenomGasPrice},
+			rates:     nil,
+			expected:  sdk.DecCoins{bondDenomGasPrice},
+		},
+		"zero bond denom gas price": {
+			gasPrices: sdk.DecCoins{sdk.NewDecCoin("uband", math.ZeroInt())},
+			rates:     rates,
+			expected:  sdk.DecCoins{sdk.NewDecCoin("uband", math.ZeroInt())},
+		},
+		"without markup": {
+			gasPrices: sdk.DecCoins{bondDenomGasPrice},
+			rates:     rates,
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(25, 5)),
+				bondDenomGasPrice,
+				sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(5, 3)),
+			},
+		},
+		"with markup": {
+			gasPrices: sdk.DecCoins{bondDenomGasPrice},
+			rates:     rates,
+			markupBPS: 1000,
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(275, 6)),
+				bondDenomGasPrice,
+				sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(55, 4)),
+			},
+		},
+		"keep existing gas price": {
+			gasPrices: sdk.DecCoins{bondDenomGasPrice, sdk.NewDecCoin("uusdc", math.NewInt(1))},
+			rates:     rates,
+			expected: sdk.DecCoins{
+				sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(25, 5)),
+				bondDenomGasPrice,
+				sdk.NewDecCoin("uusdc", math.NewInt(1)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			gasPrices := feechecker.AddFeeDenomGasPrices(test.gasPrices, "uband", test.rates, test.markupBPS)
+			s.Require().Equal(test.expected, gasPrices)
+		})
+	}
+}
@@ -12,7 +12,21 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Codec)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJSON))
+	assert.JSONEq(
+		t,
+		`{"params":{
+			"minimum_gas_prices":[],
+			"free_tx_quota":{"window":"0","max_txs_per_operator":"0","max_txs_per_grantee":"0"},
+			"fee_denom_pricing":{
+				"bond_denom_signal_id":"",
+				"bond_denom_exponent":0,
+				"fee_denoms":[],
+				"max_price_age":"0",
+				"markup_bps":"0"
+			}
+		}}`,
+		string(gotJSON),
+	)
 }
 
 func TestValidateGenesis(t *testing.T) {
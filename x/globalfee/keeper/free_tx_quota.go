@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+// IsFreeTxQuotaEnabled returns true if the free transaction quota is enabled.
+func (k Keeper) IsFreeTxQuotaEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FreeTxQuota.Window != 0
+}
+
+// CheckFreeTxQuota returns an error if any of the operators or grantees has used up its free transaction
+// quota in the current window.
+func (k Keeper) CheckFreeTxQuota(ctx sdk.Context, operators, grantees []sdk.AccAddress) error {
+	quota := k.GetParams(ctx).FreeTxQuota
+	if quota.Window == 0 {
+		return nil
+	}
+
+	window := getFreeTxWindow(ctx, quota)
+	if err := k.checkFreeTxQuota(ctx, types.FreeTxRoleOperator, operators, window, quota.MaxTxsPerOperator); err != nil {
+		return err
+	}
+
+	return k.checkFreeTxQuota(ctx, types.FreeTxRoleGrantee, grantees, window, quota.MaxTxsPerGrantee)
+}
+
+// UseFreeTxQuota increases the free transaction usage of the operators and grantees in the current window.
+func (k Keeper) UseFreeTxQuota(ctx sdk.Context, operators, grantees []sdk.AccAddress) {
+	quota := k.GetParams(ctx).FreeTxQuota
+	if quota.Window == 0 {
+		return
+	}
+
+	window := getFreeTxWindow(ctx, quota)
+	for _, operator := range operators {
+		k.incrementFreeTxUsage(ctx, types.FreeTxRoleOperator, operator, window)
+	}
+	for _, grantee := range grantees {
+		k.incrementFreeTxUsage(ctx, types.FreeTxRoleGrantee, grantee, window)
+	}
+}
+
+// GetFreeTxUsage returns the number of free transactions of an account in a role in the given window.
+func (k Keeper) GetFreeTxUsage(ctx sdk.Context, role types.FreeTxRole, addr sdk.AccAddress, window uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FreeTxUsageStoreKey(role, addr))
+	if bz == nil || sdk.BigEndianToUint64(bz[:8]) != window {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz[8:])
+}
+
+// checkFreeTxQuota returns an error if any of the accounts in a role has reached the limit in the window.
+func (k Keeper) checkFreeTxQuota(
+	ctx sdk.Context,
+	role types.FreeTxRole,
+	addrs []sdk.AccAddress,
+	window uint64,
+	limit uint64,
+) error {
+	if limit == 0 {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		if usage := k.GetFreeTxUsage(ctx, role, addr, window); usage >= limit {
+			telemetry.IncrCounter(1, types.ModuleName, "free_tx_quota_exceeded", role.String())
+
+			return types.ErrFreeTxQuotaExceeded.Wrapf("%s %s has used %d of %d free txs", role, addr, usage, limit)
+		}
+	}
+
+	return nil
+}
+
+// incrementFreeTxUsage increases the free transaction usage of an account in a role in the window. The
+// usage of a previous window is replaced.
+func (k Keeper) incrementFreeTxUsage(ctx sdk.Context, role types.FreeTxRole, addr sdk.AccAddress, window uint64) {
+	usage := k.GetFreeTxUsage(ctx, role, addr, window)
+
+	bz := append(sdk.Uint64ToBigEndian(window), sdk.Uint64ToBigEndian(usage+1)...)
+	ctx.KVStore(k.storeKey).Set(types.FreeTxUsageStoreKey(role, addr), bz)
+}
+
+// getFreeTxWindow returns the index of the quota window of the current block.
+func getFreeTxWindow(ctx sdk.Context, quota types.FreeTxQuota) uint64 {
+	return uint64(ctx.BlockHeight()) / quota.Window
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+func (s *IntegrationTestSuite) TestFreeTxQuota() {
+	operator := sdk.AccAddress("operator")
+	grantee := sdk.AccAddress("grantee")
+	otherGrantee := sdk.AccAddress("other_grantee")
+
+	// the quota is disabled by default
+	s.Require().False(s.globalfeeKeeper.IsFreeTxQuotaEnabled(s.ctx))
+	s.globalfeeKeeper.UseFreeTxQuota(s.ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee})
+	s.Require().NoError(s.globalfeeKeeper.CheckFreeTxQuota(s.ctx, []sdk.AccAddress{operator}, nil))
+	s.Require().Equal(uint64(0), s.globalfeeKeeper.GetFreeTxUsage(s.ctx, types.FreeTxRoleOperator, operator, 0))
+
+	params := types.DefaultParams()
+	params.FreeTxQuota = types.NewFreeTxQuota(10, 3, 2)
+	s.Require().NoError(s.globalfeeKeeper.SetParams(s.ctx, params))
+	s.Require().True(s.globalfeeKeeper.IsFreeTxQuotaEnabled(s.ctx))
+
+	ctx := s.ctx.WithBlockHeight(15)
+
+	// the grantee reaches its limit first
+	for i := 0; i < 2; i++ {
+		s.Require().NoError(
+			s.globalfeeKeeper.CheckFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee}),
+		)
+		s.globalfeeKeeper.UseFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee})
+	}
+	s.Require().Equal(uint64(2), s.globalfeeKeeper.GetFreeTxUsage(ctx, types.FreeTxRoleOperator, operator, 1))
+	s.Require().Equal(uint64(2), s.globalfeeKeeper.GetFreeTxUsage(ctx, types.FreeTxRoleGrantee, grantee, 1))
+
+	err := s.globalfeeKeeper.CheckFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee})
+	s.Require().ErrorIs(err, types.ErrFreeTxQuotaExceeded)
+	s.Require().ErrorContains(err, "grantee")
+
+	// the operator can still use another grantee until it reaches its own limit
+	s.Require().NoError(
+		s.globalfeeKeeper.CheckFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{otherGrantee}),
+	)
+	s.globalfeeKeeper.UseFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{otherGrantee})
+
+	err = s.globalfeeKeeper.CheckFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{otherGrantee})
+	s.Require().ErrorIs(err, types.ErrFreeTxQuotaExceeded)
+	s.Require().ErrorContains(err, "operator")
+
+	// the usage is reset in the next window
+	ctx = s.ctx.WithBlockHeight(20)
+	s.Require().Equal(uint64(0), s.globalfeeKeeper.GetFreeTxUsage(ctx, types.FreeTxRoleOperator, operator, 2))
+	s.Require().NoError(
+		s.globalfeeKeeper.CheckFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee}),
+	)
+	s.globalfeeKeeper.UseFreeTxQuota(ctx, []sdk.AccAddress{operator}, []sdk.AccAddress{grantee})
+	s.Require().Equal(uint64(1), s.globalfeeKeeper.GetFreeTxUsage(ctx, types.FreeTxRoleOperator, operator, 2))
+}
@@ -131,6 +131,22 @@ func (s *IntegrationTestSuite) TestParams() {
 			},
 			expectErr: "is not sorted",
 		},
+		{
+			name: "set free tx quota",
+			input: types.Params{
+				MinimumGasPrices: sdk.DecCoins(nil),
+				FreeTxQuota:      types.NewFreeTxQuota(100, 50, 0),
+			},
+			expectErr: "",
+		},
+		{
+			name: "set free tx quota without limit",
+			input: types.Params{
+				MinimumGasPrices: sdk.DecCoins(nil),
+				FreeTxQuota:      types.NewFreeTxQuota(100, 0, 0),
+			},
+			expectErr: "without any limit",
+		},
 	}
 
 	for _, tc := range testCases {
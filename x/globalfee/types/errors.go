@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/globalfee module sentinel errors
+var (
+	ErrFreeTxQuotaExceeded = errorsmod.Register(ModuleName, 2, "free tx quota exceeded")
+)
@@ -79,6 +79,12 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// FreeTxQuota limits the number of fee-free transactions of the feeds, TSS and oracle report lanes
+	// that an account can send in a window of blocks.
+	FreeTxQuota FreeTxQuota `protobuf:"bytes,2,opt,name=free_tx_quota,json=freeTxQuota,proto3" json:"free_tx_quota,omitempty" yaml:"free_tx_quota"`
+	// FeeDenomPricing defines the alternative fee denoms whose minimum fees are derived from the bond denom
+	// gas price and the prices in x/feeds.
+	FeeDenomPricing FeeDenomPricing `protobuf:"bytes,3,opt,name=fee_denom_pricing,json=feeDenomPricing,proto3" json:"fee_denom_pricing,omitempty" yaml:"fee_denom_pricing"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,9 +127,242 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetFreeTxQuota() FreeTxQuota {
+	if m != nil {
+		return m.FreeTxQuota
+	}
+	return FreeTxQuota{}
+}
+
+func (m *Params) GetFeeDenomPricing() FeeDenomPricing {
+	if m != nil {
+		return m.FeeDenomPricing
+	}
+	return FeeDenomPricing{}
+}
+
+// FreeTxQuota defines the limits on fee-free transactions per account. A transaction over the quota is
+// not admitted for free and requires the normal fees instead.
+type FreeTxQuota struct {
+	// Window is the number of blocks in a quota window. Zero disables the quota.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// MaxTxsPerOperator is the maximum number of free transactions in a window on behalf of an account,
+	// either signed by the account or executed by its authz grantees. Zero means unlimited.
+	MaxTxsPerOperator uint64 `protobuf:"varint,2,opt,name=max_txs_per_operator,json=maxTxsPerOperator,proto3" json:"max_txs_per_operator,omitempty"`
+	// MaxTxsPerGrantee is the maximum number of free transactions in a window executed by an authz grantee.
+	// Zero means unlimited.
+	MaxTxsPerGrantee uint64 `protobuf:"varint,3,opt,name=max_txs_per_grantee,json=maxTxsPerGrantee,proto3" json:"max_txs_per_grantee,omitempty"`
+}
+
+func (m *FreeTxQuota) Reset()         { *m = FreeTxQuota{} }
+func (m *FreeTxQuota) String() string { return proto.CompactTextString(m) }
+func (*FreeTxQuota) ProtoMessage()    {}
+func (*FreeTxQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{2}
+}
+func (m *FreeTxQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeTxQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeTxQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreeTxQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeTxQuota.Merge(m, src)
+}
+func (m *FreeTxQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeTxQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeTxQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeTxQuota proto.InternalMessageInfo
+
+func (m *FreeTxQuota) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *FreeTxQuota) GetMaxTxsPerOperator() uint64 {
+	if m != nil {
+		return m.MaxTxsPerOperator
+	}
+	return 0
+}
+
+func (m *FreeTxQuota) GetMaxTxsPerGrantee() uint64 {
+	if m != nil {
+		return m.MaxTxsPerGrantee
+	}
+	return 0
+}
+
+// FeeDenomPricing defines the alternative fee denoms priced by x/feeds. The minimum fee in an alternative
+// fee denom is the minimum fee in the bond denom converted with the prices of both denoms, plus the markup.
+type FeeDenomPricing struct {
+	// BondDenomSignalID is the signal id of the price of one display unit of the bond denom.
+	BondDenomSignalID string `protobuf:"bytes,1,opt,name=bond_denom_signal_id,json=bondDenomSignalId,proto3" json:"bond_denom_signal_id,omitempty"`
+	// BondDenomExponent is the number of decimals of the display unit of the bond denom.
+	BondDenomExponent uint32 `protobuf:"varint,2,opt,name=bond_denom_exponent,json=bondDenomExponent,proto3" json:"bond_denom_exponent,omitempty"`
+	// FeeDenoms is the list of alternative fee denoms.
+	FeeDenoms []FeeDenom `protobuf:"bytes,3,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// MaxPriceAge is the maximum age in seconds of a price to be used. The alternative fee denoms are not
+	// accepted while their prices are older.
+	MaxPriceAge int64 `protobuf:"varint,4,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// MarkupBPS is the markup in basis points added to the minimum fees in the alternative fee denoms
+	// against price changes.
+	MarkupBPS uint64 `protobuf:"varint,5,opt,name=markup_bps,json=markupBps,proto3" json:"markup_bps,omitempty"`
+}
+
+func (m *FeeDenomPricing) Reset()         { *m = FeeDenomPricing{} }
+func (m *FeeDenomPricing) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPricing) ProtoMessage()    {}
+func (*FeeDenomPricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{3}
+}
+func (m *FeeDenomPricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPricing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPricing.Merge(m, src)
+}
+func (m *FeeDenomPricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPricing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPricing proto.InternalMessageInfo
+
+func (m *FeeDenomPricing) GetBondDenomSignalID() string {
+	if m != nil {
+		return m.BondDenomSignalID
+	}
+	return ""
+}
+
+func (m *FeeDenomPricing) GetBondDenomExponent() uint32 {
+	if m != nil {
+		return m.BondDenomExponent
+	}
+	return 0
+}
+
+func (m *FeeDenomPricing) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *FeeDenomPricing) GetMaxPriceAge() int64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *FeeDenomPricing) GetMarkupBPS() uint64 {
+	if m != nil {
+		return m.MarkupBPS
+	}
+	return 0
+}
+
+// FeeDenom defines an alternative fee denom priced by x/feeds.
+type FeeDenom struct {
+	// Denom is the denom of the fee.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// SignalID is the signal id of the price of one display unit of the denom.
+	SignalID string `protobuf:"bytes,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// Exponent is the number of decimals of the display unit of the denom.
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{4}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "band.globalfee.v1beta1.Params")
+	proto.RegisterType((*FreeTxQuota)(nil), "band.globalfee.v1beta1.FreeTxQuota")
+	proto.RegisterType((*FeeDenomPricing)(nil), "band.globalfee.v1beta1.FeeDenomPricing")
+	proto.RegisterType((*FeeDenom)(nil), "band.globalfee.v1beta1.FeeDenom")
 }
 
 func init() {
@@ -131,29 +370,52 @@ func init() {
 }
 
 var fileDescriptor_c3b4cca9ed9ac312 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
-	0x18, 0xc7, 0xaf, 0x31, 0x61, 0x38, 0x1c, 0xc8, 0xc5, 0x18, 0x24, 0xa4, 0x67, 0x2e, 0x0e, 0x24,
-	0x6a, 0x1b, 0x60, 0x73, 0x44, 0x13, 0x16, 0x07, 0x82, 0x9b, 0x0b, 0xf6, 0x4a, 0x3d, 0x1a, 0xaf,
-	0xf7, 0x5d, 0x68, 0x21, 0xf2, 0x16, 0x3e, 0x87, 0xcf, 0xe0, 0x2e, 0x23, 0xa3, 0x13, 0x1a, 0xd8,
-	0x1c, 0x7d, 0x02, 0x73, 0xd7, 0x13, 0x48, 0x70, 0x6a, 0x93, 0xfe, 0xbe, 0xff, 0xef, 0xcb, 0xbf,
-	0xee, 0x59, 0xc8, 0x92, 0x21, 0x8d, 0x62, 0x08, 0x59, 0xfc, 0x28, 0x04, 0x9d, 0x36, 0x43, 0x61,
-	0x58, 0x93, 0x46, 0x22, 0x11, 0x5a, 0x6a, 0x92, 0x8e, 0xc1, 0x80, 0x77, 0x9c, 0x51, 0x64, 0x43,
-	0x91, 0x82, 0xaa, 0x1d, 0x45, 0x10, 0x41, 0x8e, 0xd0, 0xec, 0x66, 0xe9, 0x1a, 0xe6, 0xa0, 0x15,
-	0x68, 0x1a, 0x32, 0xbd, 0x0d, 0xe4, 0x20, 0x13, 0xfb, 0x1e, 0x3c, 0xb8, 0x87, 0x5d, 0x1b, 0x7f,
-	0x67, 0x98, 0x11, 0x5e, 0xcf, 0x2d, 0xa5, 0x6c, 0xcc, 0x94, 0xae, 0xa2, 0x53, 0xd4, 0x28, 0xb7,
-	0x30, 0xf9, 0x5f, 0x47, 0x7a, 0x39, 0xd5, 0xa9, 0xce, 0x97, 0xbe, 0xf3, 0xbd, 0xf4, 0x2b, 0x76,
-	0xea, 0x02, 0x94, 0x34, 0x42, 0xa5, 0x66, 0xd6, 0x2f, 0x72, 0x82, 0x77, 0xe4, 0x96, 0x2c, 0xec,
-	0xbd, 0x21, 0xd7, 0x53, 0x32, 0x91, 0x6a, 0xa2, 0x06, 0x11, 0xd3, 0x83, 0x74, 0x2c, 0xb9, 0xc8,
-	0x4c, 0x07, 0x8d, 0x72, 0xab, 0x4e, 0xec, 0xaa, 0x24, 0x5b, 0x75, 0xa3, 0xb9, 0x11, 0xfc, 0x1a,
-	0x64, 0xd2, 0x49, 0x0b, 0x4f, 0x7d, 0x7f, 0x7e, 0xeb, 0xfc, 0x59, 0xfa, 0x27, 0x33, 0xa6, 0xe2,
-	0xab, 0x60, 0x9f, 0x0a, 0x5e, 0x3f, 0xfd, 0xf3, 0x48, 0x9a, 0xd1, 0x24, 0x24, 0x1c, 0x14, 0x2d,
-	0x7a, 0xb1, 0xc7, 0xa5, 0x1e, 0x3e, 0x51, 0x33, 0x4b, 0x85, 0xfe, 0x13, 0xea, 0x7e, 0xa5, 0xc8,
-	0xe8, 0x32, 0xdd, 0xcb, 0x13, 0x3a, 0xb7, 0xf3, 0x15, 0x46, 0x8b, 0x15, 0x46, 0x5f, 0x2b, 0x8c,
-	0x5e, 0xd6, 0xd8, 0x59, 0xac, 0xb1, 0xf3, 0xb1, 0xc6, 0xce, 0x7d, 0x6b, 0x27, 0x38, 0xeb, 0x2b,
-	0xef, 0x96, 0x43, 0x4c, 0xf9, 0x88, 0xc9, 0x84, 0x4e, 0xdb, 0xf4, 0x79, 0xe7, 0x5f, 0x73, 0x51,
-	0x58, 0xca, 0xa1, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x42, 0xa4, 0x5e, 0xf6, 0x01,
-	0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x26, 0x8d, 0x92, 0x4b, 0xa3, 0x36, 0xd7, 0x50, 0x4c, 0xa9, 0x9c, 0xca, 0x20,
+	0x51, 0x44, 0x6b, 0xab, 0xed, 0x80, 0xc4, 0x82, 0x30, 0xfd, 0x23, 0x24, 0x10, 0xc1, 0xed, 0xc4,
+	0x62, 0xce, 0xf1, 0x1b, 0xd7, 0x6a, 0x7c, 0x67, 0x7c, 0x4e, 0xeb, 0x6e, 0x2c, 0xec, 0x0c, 0x7c,
+	0x02, 0x46, 0x3e, 0x03, 0x1f, 0xa0, 0x63, 0x47, 0xa6, 0x00, 0xe9, 0xc6, 0xc8, 0x27, 0x40, 0x3e,
+	0x3b, 0x4e, 0xd2, 0x52, 0x31, 0x25, 0x77, 0xf7, 0xbb, 0xe7, 0x7d, 0xde, 0xc7, 0xaf, 0x8d, 0xee,
+	0xdb, 0x84, 0x3a, 0xba, 0xdb, 0x63, 0x36, 0xe9, 0x75, 0x01, 0xf4, 0x93, 0x4d, 0x1b, 0x22, 0xb2,
+	0xa9, 0xbb, 0x40, 0x81, 0x7b, 0x5c, 0x0b, 0x42, 0x16, 0x31, 0xbc, 0x94, 0x50, 0x5a, 0x4e, 0x69,
+	0x19, 0xb5, 0xdc, 0x74, 0x99, 0xcb, 0x04, 0xa2, 0x27, 0xff, 0x52, 0x7a, 0x59, 0xe9, 0x30, 0xee,
+	0x33, 0xae, 0xdb, 0x84, 0x8f, 0x05, 0x3b, 0xcc, 0xa3, 0xe9, 0xb9, 0xfa, 0x0e, 0xcd, 0xed, 0xa7,
+	0xf2, 0x07, 0x11, 0x89, 0x00, 0xb7, 0x51, 0x39, 0x20, 0x21, 0xf1, 0xb9, 0x2c, 0xad, 0x4a, 0x6b,
+	0xb5, 0x2d, 0x45, 0xfb, 0x77, 0x39, 0xad, 0x2d, 0x28, 0x43, 0x3e, 0x1f, 0xb4, 0x0a, 0xbf, 0x07,
+	0xad, 0x85, 0xf4, 0xd6, 0x3a, 0xf3, 0xbd, 0x08, 0xfc, 0x20, 0x3a, 0x33, 0x33, 0x1d, 0xf5, 0x57,
+	0x11, 0x95, 0x53, 0x18, 0x7f, 0x93, 0x10, 0xf6, 0x3d, 0xea, 0xf9, 0x7d, 0xdf, 0x72, 0x09, 0xb7,
+	0x82, 0xd0, 0xeb, 0x40, 0x52, 0xa9, 0xb8, 0x56, 0xdb, 0x5a, 0xd1, 0x52, 0xab, 0x5a, 0x62, 0x35,
+	0x2f, 0xb3, 0x03, 0x9d, 0xe7, 0xcc, 0xa3, 0x46, 0x90, 0xd5, 0x59, 0xb9, 0x7e, 0x7f, 0x5c, 0xf3,
+	0xcf, 0xa0, 0x75, 0xe7, 0x8c, 0xf8, 0xbd, 0x27, 0xea, 0x75, 0x4a, 0xfd, 0xfa, 0xa3, 0xf5, 0xc8,
+	0xf5, 0xa2, 0xa3, 0xbe, 0xad, 0x75, 0x98, 0xaf, 0x67, 0xb9, 0xa4, 0x3f, 0x1b, 0xdc, 0x39, 0xd6,
+	0xa3, 0xb3, 0x00, 0xf8, 0xa8, 0x20, 0x37, 0x17, 0x32, 0x8d, 0x7d, 0xc2, 0xdb, 0x42, 0x01, 0x7f,
+	0x90, 0x50, 0xbd, 0x1b, 0x02, 0x58, 0x51, 0x6c, 0xbd, 0xef, 0xb3, 0x88, 0xc8, 0x33, 0x22, 0xa3,
+	0x7b, 0x37, 0x65, 0xb4, 0x17, 0x02, 0x1c, 0xc6, 0x6f, 0x12, 0xd4, 0x78, 0x9c, 0x35, 0x70, 0x7b,
+	0x4a, 0x61, 0xca, 0x7b, 0x33, 0xf5, 0x3e, 0x05, 0xa8, 0x66, 0xad, 0x3b, 0x56, 0xc1, 0x9f, 0x25,
+	0xd4, 0xe8, 0x02, 0x58, 0x0e, 0x50, 0xe6, 0x8b, 0xce, 0x3c, 0xea, 0xca, 0x45, 0x61, 0xe3, 0xc1,
+	0x8d, 0x36, 0x00, 0x76, 0x12, 0xbe, 0x9d, 0xe2, 0xc6, 0xd3, 0xcc, 0xca, 0xdd, 0x6b, 0x4a, 0x53,
+	0x76, 0xe4, 0xcc, 0xce, 0x55, 0x48, 0x35, 0xe7, 0xbb, 0xd3, 0x8a, 0xea, 0x47, 0x09, 0xd5, 0x26,
+	0x9a, 0xc5, 0x4b, 0xa8, 0x7c, 0xea, 0x51, 0x87, 0x9d, 0x8a, 0x29, 0x2a, 0x99, 0xd9, 0x0a, 0xeb,
+	0xa8, 0xe9, 0x93, 0xd8, 0x8a, 0x62, 0x6e, 0x05, 0x10, 0x5a, 0x2c, 0x80, 0x90, 0x44, 0x2c, 0x14,
+	0x39, 0x96, 0xcc, 0x86, 0x4f, 0xe2, 0xc3, 0x98, 0xb7, 0x21, 0x7c, 0x9d, 0x1d, 0xe0, 0x0d, 0xb4,
+	0x38, 0x79, 0xc1, 0x0d, 0x09, 0x8d, 0x00, 0x44, 0xc3, 0x25, 0x73, 0x21, 0xe7, 0xf7, 0xd3, 0x7d,
+	0xf5, 0xcb, 0x0c, 0x9a, 0xbf, 0xd2, 0x2d, 0xde, 0x43, 0x4d, 0x9b, 0x51, 0x27, 0xeb, 0x81, 0x7b,
+	0x2e, 0x25, 0x3d, 0xcb, 0x73, 0x84, 0xb3, 0xaa, 0x71, 0x6b, 0x38, 0x68, 0x35, 0x0c, 0x46, 0x1d,
+	0x71, 0xe7, 0x40, 0x9c, 0xbe, 0xd8, 0x31, 0x1b, 0xf6, 0x95, 0x2d, 0x07, 0x6b, 0x68, 0x71, 0x42,
+	0x07, 0xe2, 0x80, 0x51, 0xa0, 0x91, 0xb0, 0x5e, 0x9f, 0xe0, 0x77, 0xb3, 0x03, 0xbc, 0x8b, 0x50,
+	0x1e, 0x1d, 0x97, 0x8b, 0x62, 0xc6, 0x57, 0xff, 0xf7, 0x88, 0x8c, 0x52, 0xf2, 0x6c, 0xcc, 0xea,
+	0x28, 0x60, 0x8e, 0x55, 0x54, 0x4f, 0x12, 0x10, 0x43, 0x6c, 0x11, 0x17, 0xe4, 0xd2, 0xaa, 0xb4,
+	0x56, 0x34, 0x6b, 0x3e, 0x89, 0xc5, 0x58, 0x3e, 0x73, 0x01, 0xaf, 0x23, 0xe4, 0x93, 0xf0, 0xb8,
+	0x1f, 0x58, 0x76, 0xc0, 0xe5, 0xd9, 0x24, 0x1c, 0xa3, 0x3e, 0x1c, 0xb4, 0xaa, 0xaf, 0xc4, 0xae,
+	0xd1, 0x3e, 0x30, 0xab, 0x29, 0x60, 0x04, 0x5c, 0x75, 0x51, 0x65, 0x54, 0x0e, 0x37, 0xd1, 0xac,
+	0x30, 0x98, 0xa6, 0x61, 0xa6, 0x0b, 0xfc, 0x10, 0x55, 0xc7, 0x39, 0xcd, 0x88, 0x9c, 0xe6, 0x86,
+	0x83, 0x56, 0x25, 0x8f, 0xa7, 0xc2, 0x47, 0xa9, 0x2c, 0xa3, 0x4a, 0x1e, 0x45, 0x51, 0x44, 0x91,
+	0xaf, 0x8d, 0x97, 0xe7, 0x43, 0x45, 0xba, 0x18, 0x2a, 0xd2, 0xcf, 0xa1, 0x22, 0x7d, 0xba, 0x54,
+	0x0a, 0x17, 0x97, 0x4a, 0xe1, 0xfb, 0xa5, 0x52, 0x78, 0xbb, 0x35, 0xf1, 0x22, 0x26, 0x89, 0x88,
+	0x6f, 0x51, 0x87, 0xf5, 0xf4, 0xce, 0x11, 0xf1, 0xa8, 0x7e, 0xb2, 0xad, 0xc7, 0x13, 0xdf, 0x41,
+	0xf1, 0x62, 0xda, 0x65, 0x01, 0x6d, 0xff, 0x1d, 0x00, 0x1b, 0x68, 0x3d, 0x8e, 0x26, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +471,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeDenomPricing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FreeTxQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -226,6 +508,145 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreeTxQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeTxQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeTxQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxsPerGrantee != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxsPerGrantee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxsPerOperator != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxsPerOperator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomPricing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPricing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarkupBPS != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MarkupBPS))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BondDenomExponent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BondDenomExponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BondDenomSignalID) > 0 {
+		i -= len(m.BondDenomSignalID)
+		copy(dAtA[i:], m.BondDenomSignalID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenomSignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -260,6 +681,76 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.FreeTxQuota.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeDenomPricing.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FreeTxQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	if m.MaxTxsPerOperator != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxsPerOperator))
+	}
+	if m.MaxTxsPerGrantee != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxsPerGrantee))
+	}
+	return n
+}
+
+func (m *FeeDenomPricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenomSignalID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BondDenomExponent != 0 {
+		n += 1 + sovGenesis(uint64(m.BondDenomExponent))
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPriceAge))
+	}
+	if m.MarkupBPS != 0 {
+		n += 1 + sovGenesis(uint64(m.MarkupBPS))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovGenesis(uint64(m.Exponent))
+	}
 	return n
 }
 
@@ -415,6 +906,485 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTxQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeTxQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDenomPricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeTxQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeTxQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeTxQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerOperator", wireType)
+			}
+			m.MaxTxsPerOperator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerOperator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerGrantee", wireType)
+			}
+			m.MaxTxsPerGrantee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerGrantee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenomSignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenomSignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenomExponent", wireType)
+			}
+			m.BondDenomExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondDenomExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkupBPS", wireType)
+			}
+			m.MarkupBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkupBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
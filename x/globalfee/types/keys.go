@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
@@ -14,4 +19,36 @@ const (
 	RouterKey = ModuleName
 )
 
-var ParamsKeyPrefix = []byte{0x01}
+var (
+	ParamsKeyPrefix = []byte{0x01}
+
+	// FreeTxUsageKeyPrefix is the prefix of the free transaction usage of an account in the current window.
+	FreeTxUsageKeyPrefix = []byte{0x02}
+)
+
+// FreeTxRole is the role of an account in a free transaction, which has its own quota.
+type FreeTxRole byte
+
+const (
+	// FreeTxRoleOperator is the role of an account on whose behalf free messages are executed.
+	FreeTxRoleOperator FreeTxRole = 0x01
+	// FreeTxRoleGrantee is the role of an authz grantee executing free messages.
+	FreeTxRoleGrantee FreeTxRole = 0x02
+)
+
+// String returns the name of the role.
+func (r FreeTxRole) String() string {
+	switch r {
+	case FreeTxRoleOperator:
+		return "operator"
+	case FreeTxRoleGrantee:
+		return "grantee"
+	default:
+		return "unknown"
+	}
+}
+
+// FreeTxUsageStoreKey returns the key to retrieve the free transaction usage of an account in a role.
+func FreeTxUsageStoreKey(role FreeTxRole, addr sdk.AccAddress) []byte {
+	return append(append(FreeTxUsageKeyPrefix, byte(role)), address.MustLengthPrefix(addr)...)
+}
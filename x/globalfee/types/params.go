@@ -5,16 +5,60 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxFeeDenomExponent is the maximum number of decimals of the display unit of a fee denom.
+const MaxFeeDenomExponent = 18
+
 // NewParams returns Params instance with the given values.
-func NewParams(minimumGasPrices sdk.DecCoins) Params {
+func NewParams(minimumGasPrices sdk.DecCoins, freeTxQuota FreeTxQuota, feeDenomPricing FeeDenomPricing) Params {
 	return Params{
 		MinimumGasPrices: minimumGasPrices,
+		FreeTxQuota:      freeTxQuota,
+		FeeDenomPricing:  feeDenomPricing,
+	}
+}
+
+// NewFreeTxQuota returns FreeTxQuota instance with the given values.
+func NewFreeTxQuota(window, maxTxsPerOperator, maxTxsPerGrantee uint64) FreeTxQuota {
+	return FreeTxQuota{
+		Window:            window,
+		MaxTxsPerOperator: maxTxsPerOperator,
+		MaxTxsPerGrantee:  maxTxsPerGrantee,
+	}
+}
+
+// NewFeeDenomPricing returns FeeDenomPricing instance with the given values.
+func NewFeeDenomPricing(
+	bondDenomSignalID string,
+	bondDenomExponent uint32,
+	feeDenoms []FeeDenom,
+	maxPriceAge int64,
+	markupBPS uint64,
+) FeeDenomPricing {
+	return FeeDenomPricing{
+		BondDenomSignalID: bondDenomSignalID,
+		BondDenomExponent: bondDenomExponent,
+		FeeDenoms:         feeDenoms,
+		MaxPriceAge:       maxPriceAge,
+		MarkupBPS:         markupBPS,
+	}
+}
+
+// NewFeeDenom returns FeeDenom instance with the given values.
+func NewFeeDenom(denom string, signalID string, exponent uint32) FeeDenom {
+	return FeeDenom{
+		Denom:    denom,
+		SignalID: signalID,
+		Exponent: exponent,
 	}
 }
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins{}}
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+		FreeTxQuota:      FreeTxQuota{},
+		FeeDenomPricing:  NewFeeDenomPricing("", 0, []FeeDenom{}, 0, 0),
+	}
 }
 
 // this requires the fee non-negative
@@ -27,6 +71,74 @@ func validateMinimumGasPrices(i interface{}) error {
 	return v.Validate()
 }
 
+// this requires a limit to be set if the quota is enabled
+func validateFreeTxQuota(i interface{}) error {
+	v, ok := i.(FreeTxQuota)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("type: %T, expected FreeTxQuota", i)
+	}
+
+	if v.Window != 0 && v.MaxTxsPerOperator == 0 && v.MaxTxsPerGrantee == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("free tx quota window is set without any limit")
+	}
+
+	return nil
+}
+
+// this requires the alternative fee denoms to be priced by signal ids with fresh prices
+func validateFeeDenomPricing(i interface{}) error {
+	v, ok := i.(FeeDenomPricing)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("type: %T, expected FeeDenomPricing", i)
+	}
+
+	if len(v.FeeDenoms) == 0 {
+		return nil
+	}
+
+	if v.BondDenomSignalID == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("bond denom signal id must be set with fee denoms")
+	}
+	if v.BondDenomExponent > MaxFeeDenomExponent {
+		return sdkerrors.ErrInvalidRequest.Wrapf("bond denom exponent must not exceed %d", MaxFeeDenomExponent)
+	}
+	if v.MaxPriceAge <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max price age must be positive with fee denoms")
+	}
+
+	denoms := make(map[string]bool)
+	for _, feeDenom := range v.FeeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+		if denoms[feeDenom.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate fee denom: %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+
+		if feeDenom.SignalID == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("signal id of fee denom %s must be set", feeDenom.Denom)
+		}
+		if feeDenom.Exponent > MaxFeeDenomExponent {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"exponent of fee denom %s must not exceed %d",
+				feeDenom.Denom,
+				MaxFeeDenomExponent,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateFreeTxQuota(p.FreeTxQuota); err != nil {
+		return err
+	}
+
+	return validateFeeDenomPricing(p.FeeDenomPricing)
 }
@@ -13,6 +13,46 @@ import (
 func TestDefaultParams(t *testing.T) {
 	p := DefaultParams()
 	require.EqualValues(t, p.MinimumGasPrices, sdk.DecCoins{})
+	require.EqualValues(t, p.FreeTxQuota, FreeTxQuota{})
+}
+
+func TestValidateFreeTxQuota(t *testing.T) {
+	tests := map[string]struct {
+		quota     interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().FreeTxQuota,
+			false,
+		},
+		"type conversion fails, fail": {
+			uint64(1),
+			true,
+		},
+		"window with operator limit, pass": {
+			NewFreeTxQuota(100, 10, 0),
+			false,
+		},
+		"window with grantee limit, pass": {
+			NewFreeTxQuota(100, 0, 10),
+			false,
+		},
+		"window without limit, fail": {
+			NewFreeTxQuota(100, 0, 0),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateFreeTxQuota(test.quota)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 func TestValidateParams(t *testing.T) {
@@ -62,3 +102,64 @@ func TestValidateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFeeDenomPricing(t *testing.T) {
+	feeDenoms := []FeeDenom{NewFeeDenom("uusdc", "CS:USDC-USD", 6)}
+
+	tests := map[string]struct {
+		pricing   interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().FeeDenomPricing,
+			false,
+		},
+		"type conversion fails, fail": {
+			uint64(1),
+			true,
+		},
+		"valid fee denoms, pass": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, feeDenoms, 60, 100),
+			false,
+		},
+		"missing bond denom signal id, fail": {
+			NewFeeDenomPricing("", 6, feeDenoms, 60, 100),
+			true,
+		},
+		"zero max price age, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, feeDenoms, 0, 100),
+			true,
+		},
+		"bond denom exponent too large, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 19, feeDenoms, 60, 100),
+			true,
+		},
+		"invalid fee denom, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, []FeeDenom{NewFeeDenom("1usdc", "CS:USDC-USD", 6)}, 60, 100),
+			true,
+		},
+		"duplicate fee denoms, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, append(feeDenoms, feeDenoms...), 60, 100),
+			true,
+		},
+		"missing fee denom signal id, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, []FeeDenom{NewFeeDenom("uusdc", "", 6)}, 60, 100),
+			true,
+		},
+		"fee denom exponent too large, fail": {
+			NewFeeDenomPricing("CS:BAND-USD", 6, []FeeDenom{NewFeeDenom("uusdc", "CS:USDC-USD", 19)}, 60, 100),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateFeeDenomPricing(test.pricing)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -29,7 +29,13 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPendingResolveList(ctx, []types.RequestID{})
 	// Lastly, we clean up data requests that are supposed to be expired.
 	k.ProcessExpiredRequests(ctx)
-	// NOTE: We can remove old requests from state to optimize space, using `k.DeleteRequest`
-	// and `k.DeleteReports`. We don't do that now as it is premature optimization at this state.
+	// The fee settlements of expired requests that failed earlier are retried when they are due.
+	k.ProcessFeeSettlementQueue(ctx)
+	// Then, we remove the latest results that are older than the retention period, as well as
+	// the results of expired requests that are out of the result retention.
+	k.PruneLatestResults(ctx)
+	k.PruneResults(ctx)
+	// Finally, subscriptions that are due make their requests, to be reported from the next block.
+	k.ProcessSubscriptions(ctx)
 	return nil
 }
@@ -13,10 +13,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/pkg/obi"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/testing/testdata"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 type AppTestSuite struct {
@@ -109,6 +114,10 @@ func (s *AppTestSuite) TestSuccessRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -296,6 +305,10 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -337,7 +350,213 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		Attributes: []abci.EventAttribute{
 			{Key: types.AttributeKeyValidator, Value: bandtesting.Validators[1].ValAddress.String()},
 		},
+	}, {
+		Type: banktypes.EventTypeCoinSpent,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeySpender, Value: types.FeeEscrowAddress.String()},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: banktypes.EventTypeCoinReceived,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeyReceiver, Value: bandtesting.Validators[0].Address.String()},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: banktypes.EventTypeTransfer,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeyRecipient, Value: bandtesting.Validators[0].Address.String()},
+			{Key: banktypes.AttributeKeySender, Value: types.FeeEscrowAddress.String()},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: sdk.EventTypeMessage,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeySender, Value: types.FeeEscrowAddress.String()},
+		},
+	}, {
+		// No validator reports to the request, so all the escrowed fees are refunded to the requester.
+		Type: types.EventTypeSettleFee,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: fmt.Sprint(resPacket.RequestID)},
+			{Key: types.AttributeKeyPaid, Value: ""},
+			{Key: types.AttributeKeyRefund, Value: "9000000uband"},
+		},
 	}}
 
 	require.Equal(expectEvents, result.Events)
 }
+
+type priceInput struct {
+	Symbols    []string `json:"symbols"`
+	Multiplier uint64   `json:"multiplier"`
+}
+
+func (s *AppTestSuite) TestResolveRequestWithTunnelCallback() {
+	require := s.Require()
+
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	// add an oracle script of the standard price reference schema
+	filename, err := s.app.OracleKeeper.AddOracleScriptFile(testdata.WasmPriceReference)
+	require.NoError(err)
+	osID := s.app.OracleKeeper.AddOracleScript(ctx, types.NewOracleScript(
+		bandtesting.Owner.Address,
+		"price_reference",
+		"",
+		filename,
+		types.StandardPriceReferenceSchema,
+		"",
+	))
+
+	// create an active tunnel of the requester
+	route := tunneltypes.NewTSSRoute("chain-1", "0x1234567890abcdef", feedstypes.ENCODER_FIXED_POINT_ABI)
+	tunnel, err := s.app.TunnelKeeper.AddTunnel(
+		ctx,
+		&route,
+		[]tunneltypes.SignalDeviation{tunneltypes.NewSignalDeviation("BTC", 1000, 1000)},
+		3600,
+		bandtesting.Validators[0].Address,
+	)
+	require.NoError(err)
+	err = s.app.TunnelKeeper.DepositToTunnel(
+		ctx,
+		tunnel.ID,
+		bandtesting.Treasury.Address,
+		s.app.TunnelKeeper.GetParams(ctx).MinDeposit,
+	)
+	require.NoError(err)
+	err = s.app.TunnelKeeper.ActivateTunnel(ctx, tunnel.ID)
+	require.NoError(err)
+	// neither the interval nor the deviation of the tunnel is reached during the test, so the tunnel
+	// produces no packet by itself.
+	s.app.TunnelKeeper.SetLatestPrices(ctx, tunneltypes.NewLatestPrices(tunnel.ID, []feedstypes.Price{}, 1581589790))
+	err = s.app.BankKeeper.SendCoins(
+		ctx,
+		bandtesting.Treasury.Address,
+		sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+		bandtesting.Coins1band,
+	)
+	require.NoError(err)
+
+	requestMsg := types.NewMsgRequestData(
+		osID,
+		obi.MustEncode(priceInput{Symbols: []string{"BTC"}, Multiplier: 1000000000}),
+		3,
+		2,
+		"app_test",
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 9000000)),
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.Validators[0].Address,
+		0,
+	)
+	requestMsg.Callback = types.NewRequestCallback(
+		tunneltypes.RouterKey,
+		tunneltypes.NewOracleCallbackPayload(tunnel.ID),
+		300000,
+	)
+
+	txConfig := moduletestutil.MakeTestTxConfig()
+	acc1 := s.app.AccountKeeper.GetAccount(ctx, bandtesting.Validators[0].Address)
+	acc2 := s.app.AccountKeeper.GetAccount(ctx, bandtesting.Validators[1].Address)
+
+	_, res, _, err := bandtesting.SignCheckDeliver(
+		s.T(),
+		txConfig,
+		s.app.BaseApp,
+		cmtproto.Header{Height: s.app.LastBlockHeight() + 1, Time: time.Unix(1581589790, 0)},
+		[]sdk.Msg{requestMsg},
+		s.app.ChainID(),
+		[]uint64{acc1.GetAccountNumber()},
+		[]uint64{acc1.GetSequence()},
+		true,
+		true,
+		bandtesting.Validators[0].PrivKey,
+	)
+	require.NoError(err)
+	require.NotNil(res)
+
+	reportMsg1 := types.NewMsgReportData(
+		types.RequestID(1),
+		[]types.RawReport{types.NewRawReport(1, 0, []byte("answer"))},
+		bandtesting.Validators[0].ValAddress,
+	)
+	_, res, _, err = bandtesting.SignCheckDeliver(
+		s.T(),
+		txConfig,
+		s.app.BaseApp,
+		cmtproto.Header{Height: s.app.LastBlockHeight() + 1, Time: time.Unix(1581589791, 0)},
+		[]sdk.Msg{reportMsg1},
+		s.app.ChainID(),
+		[]uint64{acc1.GetAccountNumber()},
+		[]uint64{acc1.GetSequence() + 1},
+		true,
+		true,
+		bandtesting.Validators[0].PrivKey,
+	)
+	require.NoError(err)
+	require.NotNil(res)
+
+	reportMsg2 := types.NewMsgReportData(
+		types.RequestID(1),
+		[]types.RawReport{types.NewRawReport(1, 0, []byte("answer"))},
+		bandtesting.Validators[1].ValAddress,
+	)
+	_, res, endBlockEvent, err := bandtesting.SignCheckDeliver(
+		s.T(),
+		txConfig,
+		s.app.BaseApp,
+		cmtproto.Header{Height: s.app.LastBlockHeight() + 1, Time: time.Unix(1581589795, 0)},
+		[]sdk.Msg{reportMsg2},
+		s.app.ChainID(),
+		[]uint64{acc2.GetAccountNumber()},
+		[]uint64{acc2.GetSequence()},
+		true,
+		true,
+		bandtesting.Validators[1].PrivKey,
+	)
+	require.NoError(err)
+	require.NotNil(res)
+
+	ctx = s.app.BaseApp.NewContext(true)
+	result, err := s.app.OracleKeeper.GetResult(ctx, types.RequestID(1))
+	require.NoError(err)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, result.ResolveStatus)
+
+	// the callback of the tunnel runs on the resolved request. The test app has no active group of
+	// bandtss, so the tunnel fails to send the packet and is deactivated.
+	require.True(hasEvent(endBlockEvent, types.EventTypeResultCallback, map[string]string{
+		types.AttributeKeyID:             "1",
+		types.AttributeKeyCallbackModule: tunneltypes.RouterKey,
+	}))
+	require.True(hasEvent(endBlockEvent, tunneltypes.EventTypeProducePacketFail, map[string]string{
+		tunneltypes.AttributeKeyTunnelID:        fmt.Sprint(tunnel.ID),
+		tunneltypes.AttributeKeyOracleRequestID: "1",
+	}))
+
+	tunnelAfter, err := s.app.TunnelKeeper.GetTunnel(ctx, tunnel.ID)
+	require.NoError(err)
+	require.False(tunnelAfter.IsActive)
+}
+
+// hasEvent returns true if the events contain an event of the given type with the given attributes.
+func hasEvent(events []abci.Event, eventType string, attrs map[string]string) bool {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		matched := 0
+		for _, attr := range event.Attributes {
+			if value, ok := attrs[attr.Key]; ok && value == attr.Value {
+				matched++
+			}
+		}
+		if matched == len(attrs) {
+			return true
+		}
+	}
+
+	return false
+}
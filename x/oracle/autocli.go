@@ -78,6 +78,53 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Get current parameters of Bandchain's oracle module",
 				},
+				{
+					RpcMethod: "RequestSearch",
+					Use:       "request-search [oracle-script-id] [calldata] [ask-count] [min-count]",
+					Short:     "Get the latest successful request of given oracle script, calldata, ask count and min count",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_script_id"},
+						{ProtoField: "calldata"},
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+					},
+				},
+				{
+					RpcMethod: "RequestPrice",
+					Use:       "request-price [ask-count] [min-count] [symbol1] [symbol2] ...",
+					Short:     "Get the latest price of given symbols from standard price reference requests",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+						{ProtoField: "symbols", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "Subscription",
+					Use:            "subscription [id]",
+					Short:          "Get a subscription details",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subscription_id"}},
+				},
+				{
+					RpcMethod: "Subscriptions",
+					Use:       "subscriptions",
+					Short:     "Get all subscriptions, optionally filtered by owner",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"owner": {Usage: "Filter the subscriptions by owner"},
+					},
+				},
+				{
+					RpcMethod:      "DataSourceVersions",
+					Use:            "data-source-versions [id]",
+					Short:          "Get the version history of a data source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data_source_id"}},
+				},
+				{
+					RpcMethod:      "OracleScriptVersions",
+					Use:            "oracle-script-versions [id]",
+					Short:          "Get the version history of an oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
+				},
 				{
 					RpcMethod: "RequestVerification",
 					Use:       "verify-request [chain-id] [validator-addr] [request-id] [data-source-external-id] [reporter-pubkey] [reporter-signature-hex]",
@@ -36,6 +36,15 @@ const (
 	flagFee           = "fee"
 	flagTreasury      = "treasury"
 	flagExpiration    = "expiration"
+	flagIntervalUnit  = "interval-unit"
+	flagMaxRuns       = "max-runs"
+	flagDeposit       = "deposit"
+	flagVersion       = "oracle-script-version"
+	flagChangelog     = "changelog"
+
+	flagCallbackModule   = "callback-module"
+	flagCallbackPayload  = "callback-payload"
+	flagCallbackGasLimit = "callback-gas-limit"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -56,6 +65,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdActivate(),
 		GetCmdAddReporters(),
 		GetCmdRemoveReporters(),
+		GetCmdCreateSubscription(),
+		GetCmdUpdateSubscription(),
+		GetCmdCancelSubscription(),
 	)
 
 	return txCmd
@@ -64,7 +76,7 @@ func NewTxCmd() *cobra.Command {
 // GetCmdRequest implements the request command handler.
 func GetCmdRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit])",
+		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--oracle-script-version=[version])",
 		Short: "Make a new data request via an existing oracle script",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
@@ -133,6 +145,11 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				return err
 			}
 
+			oracleScriptVersion, err := cmd.Flags().GetUint64(flagVersion)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRequestData(
 				oracleScriptID,
 				calldata,
@@ -145,6 +162,25 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				clientCtx.GetFromAddress(),
 				types.Encoder(tssEncoder),
 			)
+			msg.OracleScriptVersion = oracleScriptVersion
+
+			callbackModule, err := cmd.Flags().GetString(flagCallbackModule)
+			if err != nil {
+				return err
+			}
+			if callbackModule != "" {
+				callbackPayload, err := cmd.Flags().GetBytesHex(flagCallbackPayload)
+				if err != nil {
+					return err
+				}
+
+				callbackGasLimit, err := cmd.Flags().GetUint64(flagCallbackGasLimit)
+				if err != nil {
+					return err
+				}
+
+				msg.Callback = types.NewRequestCallback(callbackModule, callbackPayload, callbackGasLimit)
+			}
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -162,6 +198,10 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and tss signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to tss (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().Uint64(flagVersion, 0, "The version of the oracle script to be executed (0=latest)")
+	cmd.Flags().String(flagCallbackModule, "", "The module to be called back when the request is resolved, if any")
+	cmd.Flags().BytesHex(flagCallbackPayload, nil, "The payload passed to the callback module")
+	cmd.Flags().Uint64(flagCallbackGasLimit, 200000, "The gas limit of the callback, charged when the request is created")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -267,7 +307,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 // GetCmdEditDataSource implements the edit data source command handler.
 func GetCmdEditDataSource() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee])",
+		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee]) (--changelog [changelog])",
 		Short: "Edit data source",
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
@@ -338,6 +378,11 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				return err
 			}
 
+			changelog, err := cmd.Flags().GetString(flagChangelog)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgEditDataSource(
 				dataSourceID,
 				name,
@@ -348,6 +393,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				owner,
 				clientCtx.GetFromAddress(),
 			)
+			msg.Changelog = changelog
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -363,6 +409,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 	cmd.Flags().String(flagTreasury, "", "Treasury of this data source")
 	cmd.Flags().String(flagFee, "", "Fee of this data source")
 	cmd.Flags().String(flagOwner, "", "Owner of this data source")
+	cmd.Flags().String(flagChangelog, "", "Description of the changes in this version of the data source")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -457,7 +504,7 @@ $ %s tx oracle create-oracle-script --name eth-price --description "Oracle scrip
 // GetCmdEditOracleScript implements the editing of oracle script command handler.
 func GetCmdEditOracleScript() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-oracle-script [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--schema [schema]) (--url [source-code-url])",
+		Use:   "edit-oracle-script [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--schema [schema]) (--url [source-code-url]) (--changelog [changelog])",
 		Short: "Edit an existing oracle script that will be used by data requests.",
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
@@ -520,6 +567,11 @@ $ %s tx oracle edit-oracle-script 1 --name eth-price --description "Oracle scrip
 				return err
 			}
 
+			changelog, err := cmd.Flags().GetString(flagChangelog)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgEditOracleScript(
 				oracleScriptID,
 				name,
@@ -530,6 +582,7 @@ $ %s tx oracle edit-oracle-script 1 --name eth-price --description "Oracle scrip
 				owner,
 				clientCtx.GetFromAddress(),
 			)
+			msg.Changelog = changelog
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -545,6 +598,7 @@ $ %s tx oracle edit-oracle-script 1 --name eth-price --description "Oracle scrip
 	cmd.Flags().String(flagOwner, "", "Owner of this oracle script")
 	cmd.Flags().String(flagSchema, types.DoNotModify, "Schema of this oracle script")
 	cmd.Flags().String(flagSourceCodeURL, types.DoNotModify, "URL for the source code of this oracle script")
+	cmd.Flags().String(flagChangelog, "", "Description of the changes in this version of the oracle script")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -585,6 +639,261 @@ $ %s tx oracle activate --from mykey
 	return cmd
 }
 
+// GetCmdCreateSubscription implements the create subscription command handler.
+func GetCmdCreateSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-subscription [oracle-script-id] [ask-count] [min-count] [interval] (-c [calldata]) (-m [client-id]) (--interval-unit [block|second]) (--max-runs [max-runs]) (--deposit [deposit]) (--fee-limit [fee-limit])",
+		Short: "Create a subscription that makes a request via an existing oracle script on schedule",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a subscription that makes a request every interval, paying the fees from its deposit account.
+Example:
+$ %s tx oracle create-subscription 37 4 3 600 -c 1234abcdef --interval-unit second --deposit 1000000uband --fee-limit 10uband --from mykey
+$ %s tx oracle create-subscription 37 4 3 100 -c 1234abcdef --max-runs 24 --deposit 1000000uband --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			s, err := parseSubscription(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubscription(
+				s.OracleScriptID,
+				s.Calldata,
+				s.AskCount,
+				s.MinCount,
+				s.ClientID,
+				s.FeeLimit,
+				s.PrepareGas,
+				s.ExecuteGas,
+				s.TSSEncoder,
+				s.Interval,
+				s.IntervalUnit,
+				s.MaxRuns,
+				deposit,
+				clientCtx.GetFromAddress(),
+			)
+			msg.OracleScriptVersion = s.OracleScriptVersion
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSubscriptionFlags(cmd)
+	cmd.Flags().String(flagDeposit, "", "The tokens sent to the deposit account of the subscription")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateSubscription implements the update subscription command handler.
+func GetCmdUpdateSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-subscription [subscription-id] [oracle-script-id] [ask-count] [min-count] [interval] (-c [calldata]) (-m [client-id]) (--interval-unit [block|second]) (--max-runs [max-runs]) (--fee-limit [fee-limit])",
+		Short: "Update an existing subscription and resume it if it is paused",
+		Args:  cobra.ExactArgs(5),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the request template and the schedule of an existing subscription. A paused
+subscription becomes active again, so top up its deposit account before updating it.
+Example:
+$ %s tx oracle update-subscription 1 37 4 3 600 -c 1234abcdef --interval-unit second --fee-limit 10uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			s, err := parseSubscription(cmd, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSubscription(
+				types.SubscriptionID(subscriptionID),
+				s.OracleScriptID,
+				s.Calldata,
+				s.AskCount,
+				s.MinCount,
+				s.ClientID,
+				s.FeeLimit,
+				s.PrepareGas,
+				s.ExecuteGas,
+				s.TSSEncoder,
+				s.Interval,
+				s.IntervalUnit,
+				s.MaxRuns,
+				clientCtx.GetFromAddress(),
+			)
+			msg.OracleScriptVersion = s.OracleScriptVersion
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSubscriptionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelSubscription implements the cancel subscription command handler.
+func GetCmdCancelSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription [subscription-id]",
+		Short: "Cancel a subscription and refund its remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a subscription. The remaining deposit is refunded to the owner.
+Example:
+$ %s tx oracle cancel-subscription 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSubscription(types.SubscriptionID(subscriptionID), clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addSubscriptionFlags adds the flags of the request template and the schedule of a subscription.
+func addSubscriptionFlags(cmd *cobra.Command) {
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().StringP(flagClientID, "m", "", "Requester can match up the request with response by clientID")
+	cmd.Flags().Uint64(flagPrepareGas, 50000, "Prepare gas used in fee counting for prepare request")
+	cmd.Flags().Uint64(flagExecuteGas, 300000, "Execute gas used in fee counting for execute request")
+	cmd.Flags().
+		String(flagFeeLimit, "", "The maximum tokens paid to all data source and tss signature providers of each request")
+	cmd.Flags().
+		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to tss (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagIntervalUnit, "block", "The unit of the interval (block or second)")
+	cmd.Flags().Uint64(flagMaxRuns, 0, "The number of requests to be made before the subscription is completed (0=unlimited)")
+	cmd.Flags().Uint64(flagVersion, 0, "The version of the oracle script to be executed (0=latest)")
+}
+
+// parseSubscription parses the [oracle-script-id] [ask-count] [min-count] [interval] arguments and the
+// subscription flags into a subscription template.
+func parseSubscription(cmd *cobra.Command, args []string) (types.Subscription, error) {
+	var s types.Subscription
+
+	oracleScriptID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return s, err
+	}
+	s.OracleScriptID = types.OracleScriptID(oracleScriptID)
+
+	if s.AskCount, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+		return s, err
+	}
+	if s.MinCount, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+		return s, err
+	}
+	if s.Interval, err = strconv.ParseUint(args[3], 10, 64); err != nil {
+		return s, err
+	}
+	if s.Calldata, err = cmd.Flags().GetBytesHex(flagCalldata); err != nil {
+		return s, err
+	}
+	if s.ClientID, err = cmd.Flags().GetString(flagClientID); err != nil {
+		return s, err
+	}
+	if s.PrepareGas, err = cmd.Flags().GetUint64(flagPrepareGas); err != nil {
+		return s, err
+	}
+	if s.ExecuteGas, err = cmd.Flags().GetUint64(flagExecuteGas); err != nil {
+		return s, err
+	}
+	if s.MaxRuns, err = cmd.Flags().GetUint64(flagMaxRuns); err != nil {
+		return s, err
+	}
+	if s.OracleScriptVersion, err = cmd.Flags().GetUint64(flagVersion); err != nil {
+		return s, err
+	}
+
+	coinStr, err := cmd.Flags().GetString(flagFeeLimit)
+	if err != nil {
+		return s, err
+	}
+	if s.FeeLimit, err = sdk.ParseCoinsNormalized(coinStr); err != nil {
+		return s, err
+	}
+
+	tssEncoder, err := cmd.Flags().GetInt32(flagTSSEncoder)
+	if err != nil {
+		return s, err
+	}
+	s.TSSEncoder = types.Encoder(tssEncoder)
+
+	unit, err := cmd.Flags().GetString(flagIntervalUnit)
+	if err != nil {
+		return s, err
+	}
+	switch unit {
+	case "block":
+		s.IntervalUnit = types.INTERVAL_UNIT_BLOCK
+	case "second":
+		s.IntervalUnit = types.INTERVAL_UNIT_SECOND
+	default:
+		return s, fmt.Errorf("invalid interval unit: %s", unit)
+	}
+
+	return s, nil
+}
+
 // GetCmdAddReporters implements the add reporters command handler.
 func GetCmdAddReporters() *cobra.Command {
 	cmd := &cobra.Command{
@@ -19,14 +19,39 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 
 	k.SetDataSourceCount(ctx, 0)
 	k.SetOracleScriptCount(ctx, 0)
-	k.SetRequestCount(ctx, 0)
-	k.SetRequestLastExpired(ctx, 0)
+	// Requests in flight are not exported, so all requests in genesis are considered expired and
+	// the results before the first one in genesis are considered pruned.
+	k.SetRequestCount(ctx, data.RequestCount)
+	k.SetRequestLastExpired(ctx, types.RequestID(data.RequestCount))
+	lastPruned := types.RequestID(data.RequestCount)
+	for _, result := range data.Results {
+		k.SetResult(ctx, result.RequestID, result)
+		if result.RequestID <= lastPruned {
+			lastPruned = result.RequestID - 1
+		}
+	}
+	k.SetResultLastPruned(ctx, lastPruned)
+	for _, receipt := range data.FeeReceipts {
+		k.SetFeeReceipt(ctx, receipt)
+	}
 	for _, dataSource := range data.DataSources {
 		_ = k.AddDataSource(ctx, dataSource)
 	}
 	for _, oracleScript := range data.OracleScripts {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
+	// Version histories in genesis replace the initial versions recorded when adding above.
+	for _, dataSourceVersion := range data.DataSourceVersions {
+		k.SetDataSourceVersion(ctx, dataSourceVersion)
+	}
+	for _, oracleScriptVersion := range data.OracleScriptVersions {
+		k.SetOracleScriptVersion(ctx, oracleScriptVersion)
+	}
+	k.SetSubscriptionCount(ctx, data.SubscriptionCount)
+	for _, subscription := range data.Subscriptions {
+		k.SetSubscription(ctx, subscription)
+		k.EnqueueSubscription(ctx, subscription)
+	}
 
 	k.SetPort(ctx, types.PortID)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -43,9 +68,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	results := k.GetRetainedResults(ctx)
+
+	// Only the settled fee receipts are exported along with the results, as requests in flight
+	// are not exported.
+	feeReceipts := []types.FeeReceipt{}
+	for _, result := range results {
+		receipt, err := k.GetFeeReceipt(ctx, result.RequestID)
+		if err == nil && receipt.Settled {
+			feeReceipts = append(feeReceipts, receipt)
+		}
+	}
+
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		DataSources:   k.GetAllDataSources(ctx),
-		OracleScripts: k.GetAllOracleScripts(ctx),
+		Params:               k.GetParams(ctx),
+		DataSources:          k.GetAllDataSources(ctx),
+		OracleScripts:        k.GetAllOracleScripts(ctx),
+		SubscriptionCount:    k.GetSubscriptionCount(ctx),
+		Subscriptions:        k.GetAllSubscriptions(ctx),
+		DataSourceVersions:   k.GetAllDataSourceVersions(ctx),
+		OracleScriptVersions: k.GetAllOracleScriptVersions(ctx),
+		RequestCount:         k.GetRequestCount(ctx),
+		Results:              results,
+		FeeReceipts:          feeReceipts,
 	}
 }
@@ -102,6 +102,14 @@ func (suite *IBCTestSuite) sendOracleRequestPacket(
 	return packet
 }
 
+func (suite *IBCTestSuite) checkChainBFeeEscrowBalances(expect sdk.Coins) {
+	escrowBalances := suite.bandApp.BankKeeper.GetAllBalances(
+		suite.chainB.GetContext(),
+		oracletypes.FeeEscrowAddress,
+	)
+	suite.Require().Equal(expect, escrowBalances)
+}
+
 func (suite *IBCTestSuite) checkChainBTreasuryBalances(expect sdk.Coins) {
 	treasuryBalances := suite.bandApp.BankKeeper.GetAllBalances(
 		suite.chainB.GetContext(),
@@ -140,8 +148,9 @@ func (suite *IBCTestSuite) TestHandleIBCRequestSuccess() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	// Treasury get fees from relayer
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
+	// Fees are held in escrow until the request expires
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
+	suite.checkChainBTreasuryBalances(sdk.NewCoins())
 
 	raws1 := []oracletypes.RawReport{
 		oracletypes.NewRawReport(1, 0, []byte("data1")),
@@ -697,7 +706,7 @@ func (suite *IBCTestSuite) TestIBCResolveRequestOutOfGas() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws := []oracletypes.RawReport{
@@ -739,6 +748,18 @@ func (suite *IBCTestSuite) TestIBCResolveRequestOutOfGas() {
 	)
 	expectCommitment := channeltypes.CommitPacket(suite.chainB.Codec, responsePacket)
 	suite.Equal(expectCommitment, commitment)
+
+	// Once the request expires, the treasury is paid for the reports of the only reporting validator,
+	// and the fees of the other validator are refunded to the relayer.
+	params := suite.bandApp.OracleKeeper.GetParams(suite.chainB.GetContext())
+	params.ExpirationBlockCount = 1
+	err = suite.bandApp.OracleKeeper.SetParams(suite.chainB.GetContext(), params)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins())
+	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))))
+	suite.checkChainBSenderBalances(expectedSenderBalance.Add(sdk.NewCoin("uband", math.NewInt(3000000))))
 }
 
 func (suite *IBCTestSuite) TestIBCResolveReadNilExternalData() {
@@ -766,7 +787,7 @@ func (suite *IBCTestSuite) TestIBCResolveReadNilExternalData() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws1 := []oracletypes.RawReport{
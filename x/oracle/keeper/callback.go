@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// runResultCallback runs the result callback of the given request, if any, after the request is
+// resolved or expired. The reason is only used for the requests resolved as failure.
+func (k Keeper) runResultCallback(ctx sdk.Context, id types.RequestID, reason string) {
+	req := k.MustGetRequest(ctx, id)
+	if req.Callback == nil {
+		return
+	}
+
+	callback := *req.Callback
+	cb, ok := k.cbRouter.GetRoute(callback.Module)
+	if !ok {
+		k.emitResultCallbackFail(ctx, id, callback, 0, types.ErrCallbackModuleNotFound)
+		return
+	}
+
+	result := k.MustGetResult(ctx, id)
+	gasUsed, err := k.safeRunCallback(ctx, callback.GasLimit, func(ctx sdk.Context) error {
+		switch result.ResolveStatus {
+		case types.RESOLVE_STATUS_SUCCESS:
+			return cb.OnRequestResolved(ctx, result, callback.Payload)
+		case types.RESOLVE_STATUS_FAILURE:
+			return cb.OnRequestFailed(ctx, result, reason, callback.Payload)
+		case types.RESOLVE_STATUS_EXPIRED:
+			return cb.OnRequestExpired(ctx, result, callback.Payload)
+		default:
+			return types.ErrInvalidCallback.Wrapf("unexpected resolve status: %d", result.ResolveStatus)
+		}
+	})
+	if err != nil {
+		k.emitResultCallbackFail(ctx, id, callback, gasUsed, err)
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResultCallback,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, callback.Module),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+	))
+}
+
+// safeRunCallback runs the given hook in a cached context with a gas meter of the given limit.
+// The state changes are written only if the hook succeeds, and a panic including running out of
+// gas is recovered as an error, so that a callback can neither halt the chain nor use the gas of
+// the block.
+func (k Keeper) safeRunCallback(
+	ctx sdk.Context,
+	gasLimit uint64,
+	hook func(ctx sdk.Context) error,
+) (gasUsed uint64, err error) {
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		gasUsed = gasMeter.GasConsumedToLimit()
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = types.ErrCallbackOutOfGas.Wrapf("gas limit: %d", gasLimit)
+				return
+			}
+			ctx.Logger().Error(fmt.Sprintf("Panic recovered: %v", r))
+			err = types.ErrCallbackPanic
+		}
+	}()
+
+	// The gas used is set by the deferred function above.
+	if err = hook(cacheCtx); err != nil {
+		return gasUsed, err
+	}
+
+	writeFn()
+	return gasUsed, nil
+}
+
+// emitResultCallbackFail emits an event of the failed result callback of the given request.
+func (k Keeper) emitResultCallbackFail(
+	ctx sdk.Context,
+	id types.RequestID,
+	callback types.RequestCallback,
+	gasUsed uint64,
+	err error,
+) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResultCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, callback.Module),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+	))
+}
@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const testCallbackModule = "testcallback"
+
+var (
+	testCallbackPayload = []byte("PAYLOAD")
+	testCallbackKey     = []byte("callback")
+)
+
+// testResultCallback is a result callback that records its calls and writes to the store, so
+// that tests can check whether the state changes of the callback are kept.
+type testResultCallback struct {
+	storeKey storetypes.StoreKey
+
+	err      error
+	panicMsg string
+	gas      uint64
+
+	calls   []string
+	results []types.Result
+}
+
+func (cb *testResultCallback) run(ctx sdk.Context, call string, result types.Result) error {
+	cb.calls = append(cb.calls, call)
+	cb.results = append(cb.results, result)
+
+	ctx.KVStore(cb.storeKey).Set(testCallbackKey, []byte(call))
+	ctx.GasMeter().ConsumeGas(cb.gas, "TEST_CALLBACK")
+	if cb.panicMsg != "" {
+		panic(cb.panicMsg)
+	}
+	return cb.err
+}
+
+func (cb *testResultCallback) OnRequestCreated(
+	ctx sdk.Context,
+	requestID types.RequestID,
+	requester sdk.AccAddress,
+	payload []byte,
+) error {
+	cb.calls = append(cb.calls, "created:"+requester.String()+":"+string(payload))
+	return cb.err
+}
+
+func (cb *testResultCallback) OnRequestResolved(ctx sdk.Context, result types.Result, payload []byte) error {
+	return cb.run(ctx, "resolved:"+string(payload), result)
+}
+
+func (cb *testResultCallback) OnRequestFailed(
+	ctx sdk.Context,
+	result types.Result,
+	reason string,
+	payload []byte,
+) error {
+	return cb.run(ctx, "failed:"+reason+":"+string(payload), result)
+}
+
+func (cb *testResultCallback) OnRequestExpired(ctx sdk.Context, result types.Result, payload []byte) error {
+	return cb.run(ctx, "expired:"+string(payload), result)
+}
+
+func requestWithCallback(module string) types.Request {
+	req := defaultRequest() // See report_test.go
+	req.Callback = types.NewRequestCallback(module, testCallbackPayload, 100000)
+	return req
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestWithCallback() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	wrappedGasMeter := bandtesting.NewGasMeterWrapper(ctx.GasMeter())
+	ctx = ctx.WithGasMeter(wrappedGasMeter)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.Callback = types.NewRequestCallback(testCallbackModule, testCallbackPayload, 100000)
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band).
+		Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+	require.Equal(types.RequestID(1), id)
+	require.Equal(msg.Callback, k.MustGetRequest(ctx, id).Callback)
+	require.Equal([]string{"created:" + alice.String() + ":PAYLOAD"}, suite.resultCallback.calls)
+
+	// the gas of the callback is charged when the request is created.
+	require.Equal(1, wrappedGasMeter.CountRecord(100000, "RESULT_CALLBACK_FEE"))
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestCallbackModuleNotFound() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.Callback = types.NewRequestCallback("unknown", testCallbackPayload, 100000)
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrCallbackModuleNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestRejectedByCallback() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.Callback = types.NewRequestCallback(testCallbackModule, testCallbackPayload, 100000)
+	suite.resultCallback.err = errors.New("not allowed")
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band).
+		Times(3)
+
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.EqualError(err, "not allowed")
+}
+
+func (suite *KeeperTestSuite) TestResolveSuccessWithCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, requestWithCallback(testCallbackModule))
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+	k.ResolveSuccess(ctx, 42, defaultRequest().Requester, defaultRequest().FeeLimit, basicResult, 1234, 0)
+
+	result := k.MustGetResult(ctx, 42)
+	require.Equal([]string{"resolved:PAYLOAD"}, suite.resultCallback.calls)
+	require.Equal([]types.Result{result}, suite.resultCallback.results)
+	require.Equal([]byte("resolved:PAYLOAD"), ctx.KVStore(suite.key).Get(testCallbackKey))
+	require.Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResultCallback,
+			sdk.NewAttribute(types.AttributeKeyID, "42"),
+			sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, "2720"),
+		),
+		sdk.NewEvent(
+			types.EventTypeResolve,
+			sdk.NewAttribute(types.AttributeKeyID, "42"),
+			sdk.NewAttribute(types.AttributeKeyResolveStatus, "1"),
+			sdk.NewAttribute(types.AttributeKeyResult, "42415349435f524553554c54"), // BASIC_RESULT
+			sdk.NewAttribute(types.AttributeKeyGasUsed, "1234"),
+		),
+	}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestResolveFailureWithCallbackError() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.resultCallback.err = errors.New("callback error")
+	k.SetRequest(ctx, 42, requestWithCallback(testCallbackModule))
+	k.ResolveFailure(ctx, 42, "REASON")
+
+	// the state changes of the failed callback are discarded.
+	require.Equal([]string{"failed:REASON:PAYLOAD"}, suite.resultCallback.calls)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackKey))
+	require.Equal(types.RESOLVE_STATUS_FAILURE, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Equal(sdk.NewEvent(
+		types.EventTypeResultCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "2870"),
+		sdk.NewAttribute(types.AttributeKeyReason, "callback error"),
+	), ctx.EventManager().Events()[0])
+}
+
+func (suite *KeeperTestSuite) TestResolveExpiredWithCallbackPanic() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.resultCallback.panicMsg = "callback panic"
+	k.SetRequest(ctx, 42, requestWithCallback(testCallbackModule))
+	require.NotPanics(func() { k.ResolveExpired(ctx, 42) })
+
+	require.Equal([]string{"expired:PAYLOAD"}, suite.resultCallback.calls)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackKey))
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Equal(types.EventTypeResultCallbackFail, ctx.EventManager().Events()[0].Type)
+	require.Contains(ctx.EventManager().Events()[0].Attributes, sdk.NewAttribute(
+		types.AttributeKeyReason, types.ErrCallbackPanic.Error(),
+	).ToKVPair())
+}
+
+func (suite *KeeperTestSuite) TestResolveExpiredWithCallbackOutOfGas() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.resultCallback.gas = 200000
+	k.SetRequest(ctx, 42, requestWithCallback(testCallbackModule))
+	require.NotPanics(func() { k.ResolveExpired(ctx, 42) })
+
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackKey))
+	require.Equal(sdk.NewEvent(
+		types.EventTypeResultCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "100000"),
+		sdk.NewAttribute(types.AttributeKeyReason, "gas limit: 100000: callback out of gas"),
+	), ctx.EventManager().Events()[0])
+}
+
+func (suite *KeeperTestSuite) TestResolveWithUnregisteredCallbackModule() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, requestWithCallback("unknown"))
+	k.ResolveExpired(ctx, 42)
+
+	require.Empty(suite.resultCallback.calls)
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Equal(sdk.NewEvent(
+		types.EventTypeResultCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, "unknown"),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "0"),
+		sdk.NewAttribute(types.AttributeKeyReason, types.ErrCallbackModuleNotFound.Error()),
+	), ctx.EventManager().Events()[0])
+}
@@ -91,6 +91,10 @@ func addSimpleDataSourceAndOracleScript(ctx sdk.Context, k keeper.Keeper, dir st
 				treasury,
 			),
 		)
+		k.SetDataSourceVersion(
+			ctx,
+			types.NewDataSourceVersion(types.DataSourceID(i), 1, "filename"+idxStr, owner.String(), 0, ""),
+		)
 	}
 	fc := filecache.New(dir)
 	// Add wasm_1_simple
@@ -100,6 +104,7 @@ func addSimpleDataSourceAndOracleScript(ctx sdk.Context, k keeper.Keeper, dir st
 		types.OracleScriptID(1),
 		types.NewOracleScript(owner, "test os", "testing oracle script", fileName1, "schema", "url"),
 	)
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(1, 1, fileName1, "schema", owner.String(), 0, ""))
 
 	// Add wasm_4_complex
 	fileName4 := fc.AddFile(testdata.Compile(testdata.Wasm4))
@@ -108,6 +113,7 @@ func addSimpleDataSourceAndOracleScript(ctx sdk.Context, k keeper.Keeper, dir st
 		types.OracleScriptID(4),
 		types.NewOracleScript(owner, "test os4", "testing oracle script complex", fileName4, "schema", "url"),
 	)
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(4, 1, fileName4, "schema", owner.String(), 0, ""))
 }
 
 func defaultRequest() types.Request {
@@ -45,11 +45,19 @@ func (k Keeper) SetDataSource(ctx sdk.Context, id types.DataSourceID, dataSource
 func (k Keeper) AddDataSource(ctx sdk.Context, dataSource types.DataSource) types.DataSourceID {
 	id := k.GetNextDataSourceID(ctx)
 	k.SetDataSource(ctx, id, dataSource)
+	k.addDataSourceVersion(ctx, id, dataSource.Filename, dataSource.Owner, "")
 	return id
 }
 
-// MustEditDataSource edits the given data source by id and flushes it to the storage.
-func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new types.DataSource) {
+// MustEditDataSource edits the given data source by id and flushes it to the storage, and appends a new
+// version of the data source with the given editor and changelog. Returns the new version number.
+func (k Keeper) MustEditDataSource(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	new types.DataSource,
+	editor string,
+	changelog string,
+) uint64 {
 	dataSource := k.MustGetDataSource(ctx, id)
 	dataSource.Owner = new.Owner
 	dataSource.Name = modify(dataSource.Name, new.Name)
@@ -58,6 +66,7 @@ func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new t
 	dataSource.Treasury = new.Treasury
 	dataSource.Fee = new.Fee
 	k.SetDataSource(ctx, id, dataSource)
+	return k.addDataSourceVersion(ctx, id, dataSource.Filename, editor, changelog).Version
 }
 
 // GetAllDataSources returns the list of all data sources in the store, or nil if there is none.
@@ -105,7 +105,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 	// Edits the data source. We should get the updated data source.
 	k.MustEditDataSource(ctx, id, types.NewDataSource(
 		owner, dataSource2.Name, dataSource2.Description, dataSource2.Filename, bandtesting.EmptyCoins, treasury,
-	))
+	), bob.String(), "")
 	require.NotEqual(dataSource1, k.MustGetDataSource(ctx, id))
 	require.Equal(dataSource2, k.MustGetDataSource(ctx, id))
 }
@@ -137,7 +137,7 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 	require.Equal(dataSource1, k.MustGetDataSource(ctx, id))
 	require.NotEqual(dataSource2, k.MustGetDataSource(ctx, id))
 	// Edits the data source. We should get the updated data source.
-	k.MustEditDataSource(ctx, id, dataSource2)
+	k.MustEditDataSource(ctx, id, dataSource2, bob.String(), "")
 	dataSourceRes := k.MustGetDataSource(ctx, id)
 	require.NotEqual(dataSourceRes, dataSource1)
 	require.NotEqual(dataSourceRes, dataSource2)
@@ -199,7 +199,7 @@ func (suite *KeeperTestSuite) TestEditDataSourceNonExistentDataSource() {
 			basicFilename,
 			bandtesting.EmptyCoins,
 			treasury,
-		))
+		), owner.String(), "")
 	})
 }
 
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// HasFeeReceipt checks if the fee receipt of the given request ID exists in the storage.
+func (k Keeper) HasFeeReceipt(ctx sdk.Context, id types.RequestID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FeeReceiptStoreKey(id))
+}
+
+// GetFeeReceipt returns the fee receipt of the given request ID or error if not exists.
+func (k Keeper) GetFeeReceipt(ctx sdk.Context, id types.RequestID) (types.FeeReceipt, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeReceiptStoreKey(id))
+	if bz == nil {
+		return types.FeeReceipt{}, types.ErrFeeReceiptNotFound.Wrapf("id: %d", id)
+	}
+	var receipt types.FeeReceipt
+	k.cdc.MustUnmarshal(bz, &receipt)
+	return receipt, nil
+}
+
+// SetFeeReceipt saves the given fee receipt to the storage.
+func (k Keeper) SetFeeReceipt(ctx sdk.Context, receipt types.FeeReceipt) {
+	ctx.KVStore(k.storeKey).Set(types.FeeReceiptStoreKey(receipt.RequestID), k.cdc.MustMarshal(&receipt))
+}
+
+// DeleteFeeReceipt removes the fee receipt of the given request ID from the storage.
+func (k Keeper) DeleteFeeReceipt(ctx sdk.Context, id types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.FeeReceiptStoreKey(id))
+}
+
+// SettleFee pays the escrowed fees of the given request to the data source treasuries for the raw
+// reports that are delivered, and refunds the remainder to the refund address or the payer. Requests
+// without a fee receipt have nothing in escrow, so there is nothing to do.
+func (k Keeper) SettleFee(ctx sdk.Context, id types.RequestID) error {
+	receipt, err := k.GetFeeReceipt(ctx, id)
+	if err != nil {
+		return nil
+	}
+	if receipt.Settled {
+		return types.ErrFeeAlreadySettled.Wrapf("id: %d", id)
+	}
+
+	// the reports are deleted along with the request, so a retried settlement uses the report
+	// counts recorded when the first settlement failed.
+	if k.HasRequest(ctx, id) {
+		receipt = k.countFeeReports(ctx, receipt)
+	}
+
+	totalPaid := sdk.NewCoins()
+	for i, item := range receipt.Items {
+		paid := sdk.NewCoins()
+		for _, c := range item.FeePerReport {
+			c.Amount = c.Amount.Mul(math.NewIntFromUint64(item.ReportCount))
+			paid = paid.Add(c)
+		}
+
+		if !paid.IsZero() {
+			treasury, err := sdk.AccAddressFromBech32(item.Treasury)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoins(ctx, types.FeeEscrowAddress, treasury, paid); err != nil {
+				return err
+			}
+		}
+
+		receipt.Items[i].Paid = paid
+		totalPaid = totalPaid.Add(paid...)
+	}
+
+	refund, hasNeg := receipt.Escrowed.SafeSub(totalPaid...)
+	if hasNeg {
+		return types.ErrInvalidFeeReceipt.Wrapf("paid %s exceeds escrowed %s", totalPaid, receipt.Escrowed)
+	}
+	if !refund.IsZero() {
+		refundAddress := receipt.Payer
+		if receipt.RefundAddress != "" {
+			refundAddress = receipt.RefundAddress
+		}
+		recipient, err := sdk.AccAddressFromBech32(refundAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.FeeEscrowAddress, recipient, refund); err != nil {
+			return err
+		}
+	}
+
+	receipt.Refunded = refund
+	receipt.Settled = true
+	k.SetFeeReceipt(ctx, receipt)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSettleFee,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyPaid, totalPaid.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+	return nil
+}
+
+// countFeeReports records the number of raw reports delivered for each raw request of the receipt.
+func (k Keeper) countFeeReports(ctx sdk.Context, receipt types.FeeReceipt) types.FeeReceipt {
+	reportCounts := make(map[types.ExternalID]uint64)
+	for _, report := range k.GetReports(ctx, receipt.RequestID) {
+		for _, rawReport := range report.RawReports {
+			reportCounts[rawReport.ExternalID]++
+		}
+	}
+
+	for i, item := range receipt.Items {
+		receipt.Items[i].ReportCount = reportCounts[item.ExternalID]
+	}
+	return receipt
+}
+
+// setFeeRefundAddress sets the account that gets the refund of the escrowed fees of the given request.
+func (k Keeper) setFeeRefundAddress(ctx sdk.Context, id types.RequestID, refundAddress string) {
+	receipt, err := k.GetFeeReceipt(ctx, id)
+	if err != nil {
+		return
+	}
+	receipt.RefundAddress = refundAddress
+	k.SetFeeReceipt(ctx, receipt)
+}
+
+// safeSettleFee settles the fee of the given request in a cached context, so that a failed
+// settlement does not leave a partial state. The failure is emitted as an event, and the
+// settlement is queued to be retried, as the fees stay in escrow until it succeeds.
+func (k Keeper) safeSettleFee(ctx sdk.Context, id types.RequestID) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.SettleFee(cacheCtx, id); err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettleFeeFail,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+
+		if receipt, err := k.GetFeeReceipt(ctx, id); err == nil && k.HasRequest(ctx, id) {
+			k.SetFeeReceipt(ctx, k.countFeeReports(ctx, receipt))
+		}
+		ctx.KVStore(k.storeKey).Set(
+			types.FeeSettlementQueueStoreKey(ctx.BlockHeight()+types.FeeSettlementRetryInterval, id),
+			[]byte{},
+		)
+		return
+	}
+	writeFn()
+
+	// the result of the request is pruned while the settlement is pending, so its fee receipt is
+	// removed here instead.
+	if id <= k.GetResultLastPruned(ctx) {
+		k.DeleteFeeReceipt(ctx, id)
+	}
+}
+
+// ProcessFeeSettlementQueue retries the failed fee settlements that are due in this block. At most
+// MaxFeeSettlementRetriesPerBlock settlements are retried; the rest are carried over to the next
+// blocks. A settlement that fails again is queued for another retry.
+func (k Keeper) ProcessFeeSettlementQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.FeeSettlementQueueStoreKeyPrefix,
+		types.FeeSettlementQueueStoreKey(ctx.BlockHeight()+1, 0),
+	)
+
+	var dueKeys [][]byte
+	for ; iterator.Valid() && len(dueKeys) < types.MaxFeeSettlementRetriesPerBlock; iterator.Next() {
+		dueKeys = append(dueKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range dueKeys {
+		store.Delete(key)
+		k.safeSettleFee(ctx, types.RequestID(sdk.BigEndianToUint64(key[len(key)-8:])))
+	}
+}
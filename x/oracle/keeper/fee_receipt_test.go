@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestCollectFeeReceiptItems() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	paidID := k.AddDataSource(ctx, types.NewDataSource(
+		owner, basicName, basicDesc, basicFilename, bandtesting.Coins1band, treasury,
+	))
+	freeID := k.AddDataSource(ctx, types.NewDataSource(
+		owner, basicName, basicDesc, basicFilename, bandtesting.EmptyCoins, treasury,
+	))
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band.MulInt(math.NewInt(2))).
+		Return(nil)
+
+	receipt, err := k.CollectFee(ctx, alice, bandtesting.Coins100band, 2, []types.RawRequest{
+		types.NewRawRequest(1, paidID, nil),
+		types.NewRawRequest(2, freeID, nil),
+	})
+	require.NoError(err)
+	// only the raw requests with a fee are itemized in the receipt.
+	require.Equal(types.NewFeeReceipt(
+		0,
+		alice.String(),
+		bandtesting.Coins1band.MulInt(math.NewInt(2)),
+		[]types.FeeReceiptItem{
+			types.NewFeeReceiptItem(1, paidID, treasury.String(), bandtesting.Coins1band, 0, sdk.NewCoins()),
+		},
+		sdk.NewCoins(),
+		false,
+		"",
+	), receipt)
+}
+
+func (suite *KeeperTestSuite) TestSettleFee() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	q := suite.queryClient
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest())
+	k.SetFeeReceipt(ctx, types.NewFeeReceipt(
+		42,
+		alice.String(),
+		bandtesting.Coins1band.MulInt(math.NewInt(4)),
+		[]types.FeeReceiptItem{
+			types.NewFeeReceiptItem(1, 1, treasury.String(), bandtesting.Coins1band, 0, sdk.NewCoins()),
+			types.NewFeeReceiptItem(2, 2, treasury.String(), bandtesting.Coins1band, 0, sdk.NewCoins()),
+		},
+		sdk.NewCoins(),
+		false,
+		"",
+	))
+
+	// the receipt is available while the request is pending.
+	res, err := q.Request(ctx, &types.QueryRequestRequest{RequestId: 42})
+	require.NoError(err)
+	require.False(res.FeeReceipt.Settled)
+
+	// validator#0 reports both raw requests, while validator#1 only reports the first one.
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1")),
+		types.NewRawReport(2, 0, []byte("data2")),
+	}))
+	k.SetReport(ctx, 42, types.NewReport(validators[1].Address, false, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1")),
+	}))
+
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band.MulInt(math.NewInt(2))).
+			Return(nil),
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band).
+			Return(nil),
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), types.FeeEscrowAddress, alice, bandtesting.Coins1band).
+			Return(nil),
+	)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = k.SettleFee(ctx, 42)
+	require.NoError(err)
+
+	receipt, err := k.GetFeeReceipt(ctx, 42)
+	require.NoError(err)
+	require.Equal(types.NewFeeReceipt(
+		42,
+		alice.String(),
+		bandtesting.Coins1band.MulInt(math.NewInt(4)),
+		[]types.FeeReceiptItem{
+			types.NewFeeReceiptItem(
+				1, 1, treasury.String(), bandtesting.Coins1band, 2, bandtesting.Coins1band.MulInt(math.NewInt(2)),
+			),
+			types.NewFeeReceiptItem(2, 2, treasury.String(), bandtesting.Coins1band, 1, bandtesting.Coins1band),
+		},
+		bandtesting.Coins1band,
+		true,
+		"",
+	), receipt)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeSettleFee,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyPaid, "3000000uband"),
+		sdk.NewAttribute(types.AttributeKeyRefund, "1000000uband"),
+	)}, ctx.EventManager().Events())
+
+	err = k.SettleFee(ctx, 42)
+	require.ErrorIs(err, types.ErrFeeAlreadySettled)
+
+	// requests without data source fees have nothing to settle.
+	require.NoError(k.SettleFee(ctx, 43))
+}
+
+func (suite *KeeperTestSuite) TestProcessExpiredRequestsSettleFeeFail() {
+	suite.activeAllValidators()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.RequestHeight = ctx.BlockHeight()
+	req.RequestTime = ctx.BlockTime().Unix()
+	id := k.AddRequest(ctx, req)
+	k.SetFeeReceipt(ctx, types.NewFeeReceipt(
+		id,
+		alice.String(),
+		bandtesting.Coins1band.MulInt(math.NewInt(2)),
+		[]types.FeeReceiptItem{
+			types.NewFeeReceiptItem(1, 1, treasury.String(), bandtesting.Coins1band, 0, sdk.NewCoins()),
+		},
+		sdk.NewCoins(),
+		false,
+		bob.String(),
+	))
+	k.SetReport(ctx, id, types.NewReport(validators[0].Address, true, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1")),
+	}))
+
+	// validator#0 reports, so the treasury is to be paid, but the payment fails.
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	expiredHeight := ctx.BlockHeight() + int64(k.GetParams(ctx).ExpirationBlockCount)
+	ctx = ctx.WithBlockHeight(expiredHeight).WithEventManager(sdk.NewEventManager())
+	k.ProcessExpiredRequests(ctx)
+
+	require.Equal(id, k.GetRequestLastExpired(ctx))
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSettleFeeFail,
+		sdk.NewAttribute(types.AttributeKeyID, "1"),
+		sdk.NewAttribute(types.AttributeKeyReason, sdkerrors.ErrInsufficientFunds.Error()),
+	))
+
+	// the receipt is kept unsettled, with the report counts of the deleted reports.
+	receipt, err := k.GetFeeReceipt(ctx, id)
+	require.NoError(err)
+	require.False(receipt.Settled)
+	require.True(receipt.Refunded.IsZero())
+	require.Equal(uint64(1), receipt.Items[0].ReportCount)
+
+	// the settlement is not retried before the retry interval.
+	k.ProcessFeeSettlementQueue(ctx.WithBlockHeight(expiredHeight + types.FeeSettlementRetryInterval - 1))
+
+	// the retried settlement pays the treasury and refunds the rest to the refund address.
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band).
+			Return(nil),
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), types.FeeEscrowAddress, bob, bandtesting.Coins1band).
+			Return(nil),
+	)
+	k.ProcessFeeSettlementQueue(ctx.WithBlockHeight(expiredHeight + types.FeeSettlementRetryInterval))
+
+	receipt, err = k.GetFeeReceipt(ctx, id)
+	require.NoError(err)
+	require.True(receipt.Settled)
+	require.Equal(bandtesting.Coins1band, receipt.Refunded)
+
+	// the settled request is removed from the queue.
+	k.ProcessFeeSettlementQueue(ctx.WithBlockHeight(expiredHeight + 2*types.FeeSettlementRetryInterval))
+}
+
+func (suite *KeeperTestSuite) TestPruneResultsWithPendingFeeSettlement() {
+	suite.activeAllValidators()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = uint64(time.Second)
+	params.ResultRetentionCount = 0
+	require.NoError(k.SetParams(ctx, params))
+
+	req := defaultRequest()
+	req.RequestHeight = ctx.BlockHeight()
+	req.RequestTime = ctx.BlockTime().Unix()
+	id := k.AddRequest(ctx, req)
+	k.SetFeeReceipt(ctx, types.NewFeeReceipt(
+		id,
+		alice.String(),
+		bandtesting.Coins1band,
+		[]types.FeeReceiptItem{
+			types.NewFeeReceiptItem(1, 1, treasury.String(), bandtesting.Coins1band, 0, sdk.NewCoins()),
+		},
+		sdk.NewCoins(),
+		false,
+		"",
+	))
+	k.SetReport(ctx, id, types.NewReport(validators[0].Address, true, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1")),
+	}))
+
+	// the treasury payment fails when the request expires.
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	expiredHeight := ctx.BlockHeight() + int64(k.GetParams(ctx).ExpirationBlockCount)
+	ctx = ctx.WithBlockHeight(expiredHeight)
+	k.ProcessExpiredRequests(ctx)
+
+	// the result is pruned, but the unsettled fee receipt is kept for the retry.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Second))
+	k.PruneResults(ctx)
+	require.Equal(id, k.GetResultLastPruned(ctx))
+	require.False(k.HasResult(ctx, id))
+
+	receipt, err := k.GetFeeReceipt(ctx, id)
+	require.NoError(err)
+	require.False(receipt.Settled)
+
+	// the retried settlement pays the treasury and removes the fee receipt of the pruned result.
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), types.FeeEscrowAddress, treasury, bandtesting.Coins1band).
+		Return(nil)
+	k.ProcessFeeSettlementQueue(ctx.WithBlockHeight(expiredHeight + types.FeeSettlementRetryInterval))
+
+	_, err = k.GetFeeReceipt(ctx, id)
+	require.ErrorIs(err, types.ErrFeeReceiptNotFound)
+}
@@ -10,10 +10,14 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/bandprotocol/chain/v3/pkg/obi"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -84,8 +88,22 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	rid := types.RequestID(req.RequestId)
 
+	res, err := k.getRequestResponse(ctx, types.RequestID(req.RequestId))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Decode {
+		k.decodeRequestData(ctx, res)
+	}
+
+	return res, nil
+}
+
+// getRequestResponse returns the request, reports, result, signing and fee receipt of the given
+// request ID.
+func (k Querier) getRequestResponse(ctx sdk.Context, rid types.RequestID) (*types.QueryRequestResponse, error) {
 	// Check if there is a signing ID associated with the request
 	// Note: ignore error because it's possible to not have signing result.
 	var signingResult *types.SigningResult
@@ -96,6 +114,12 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 		signingResult = &sResult
 	}
 
+	// Note: ignore error because requests without data source fees do not have fee receipts.
+	var feeReceipt *types.FeeReceipt
+	if receipt, err := k.GetFeeReceipt(ctx, rid); err == nil {
+		feeReceipt = &receipt
+	}
+
 	request, err := k.GetRequest(ctx, rid)
 	if err != nil {
 		lastExpired := k.GetRequestLastExpired(ctx)
@@ -109,25 +133,112 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 				),
 			)
 		}
-		result := k.MustGetResult(ctx, rid)
-		return &types.QueryRequestResponse{Request: nil, Reports: nil, Result: &result, Signing: signingResult}, nil
+		if lastPruned := k.GetResultLastPruned(ctx); rid <= lastPruned {
+			return nil, status.Error(
+				codes.NotFound,
+				types.ErrResultPruned.Wrapf(
+					"request id (%d) <= last pruned request id (%d)", rid, lastPruned,
+				).Error(),
+			)
+		}
+		result, err := k.GetResult(ctx, rid)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return &types.QueryRequestResponse{
+			Request:    nil,
+			Reports:    nil,
+			Result:     &result,
+			Signing:    signingResult,
+			FeeReceipt: feeReceipt,
+		}, nil
 	}
 
 	reports := k.GetReports(ctx, rid)
 	if !k.HasResult(ctx, rid) {
-		return &types.QueryRequestResponse{Request: &request, Reports: reports, Result: nil, Signing: nil}, nil
+		return &types.QueryRequestResponse{
+			Request:    &request,
+			Reports:    reports,
+			Result:     nil,
+			Signing:    nil,
+			FeeReceipt: feeReceipt,
+		}, nil
 	}
 
 	result := k.MustGetResult(ctx, rid)
 
 	return &types.QueryRequestResponse{
-		Request: &request,
-		Reports: reports,
-		Result:  &result,
-		Signing: signingResult,
+		Request:    &request,
+		Reports:    reports,
+		Result:     &result,
+		Signing:    signingResult,
+		FeeReceipt: feeReceipt,
 	}, nil
 }
 
+// decodeRequestData sets the calldata and the result of the request in the response as JSON decoded
+// with the schema of the oracle script version pinned on the request. The current schema of the
+// oracle script is used if no version is pinned, or the request is already removed from the store.
+// They are left empty if the schema cannot decode them.
+func (k Querier) decodeRequestData(ctx sdk.Context, res *types.QueryRequestResponse) {
+	var (
+		oracleScriptID types.OracleScriptID
+		version        uint64
+		calldata       []byte
+	)
+	switch {
+	case res.Request != nil:
+		oracleScriptID, calldata = res.Request.OracleScriptID, res.Request.Calldata
+		version = res.Request.OracleScriptVersion
+	case res.Result != nil:
+		oracleScriptID, calldata = res.Result.OracleScriptID, res.Result.Calldata
+	default:
+		return
+	}
+
+	schema, err := k.getOracleScriptSchema(ctx, oracleScriptID, version)
+	if err != nil {
+		return
+	}
+
+	input, output, err := obi.ParseScriptSchema(schema)
+	if err != nil {
+		return
+	}
+
+	if decoded, err := input.DecodeJSON(calldata); err == nil {
+		res.DecodedCalldata = string(decoded)
+	}
+
+	if res.Result != nil && res.Result.ResolveStatus == types.RESOLVE_STATUS_SUCCESS {
+		if decoded, err := output.DecodeJSON(res.Result.Result); err == nil {
+			res.DecodedResult = string(decoded)
+		}
+	}
+}
+
+// getOracleScriptSchema returns the schema of the given version of an oracle script, or its current
+// schema if the version is zero.
+func (k Querier) getOracleScriptSchema(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (string, error) {
+	if version == 0 {
+		oracleScript, err := k.GetOracleScript(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return oracleScript.Schema, nil
+	}
+
+	oracleScriptVersion, err := k.GetOracleScriptVersion(ctx, id, version)
+	if err != nil {
+		return "", err
+	}
+	return oracleScriptVersion.Schema, nil
+}
+
 func (k Querier) PendingRequests(
 	c context.Context,
 	req *types.QueryPendingRequestsRequest,
@@ -315,7 +426,33 @@ func (k Querier) RequestSearch(
 	c context.Context,
 	req *types.QueryRequestSearchRequest,
 ) (*types.QueryRequestSearchResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	calldata, err := hex.DecodeString(req.Calldata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid calldata: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rid, err := k.GetLatestRequestID(
+		ctx,
+		types.OracleScriptID(req.OracleScriptId),
+		calldata,
+		req.AskCount,
+		req.MinCount,
+	)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	request, err := k.Request(c, &types.QueryRequestRequest{RequestId: uint64(rid)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRequestSearchResponse{Request: request}, nil
 }
 
 // RequestPrice queries the latest price on standard price reference oracle
@@ -324,7 +461,25 @@ func (k Querier) RequestPrice(
 	c context.Context,
 	req *types.QueryRequestPriceRequest,
 ) (*types.QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Symbols) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "symbols must not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceResults := make([]*types.PriceResult, 0, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		price, err := k.GetPriceResult(ctx, symbol, req.AskCount, req.MinCount)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		priceResults = append(priceResults, &price)
+	}
+
+	return &types.QueryRequestPriceResponse{PriceResults: priceResults}, nil
 }
 
 // RequestVerification verifies oracle request for validation before executing data sources
@@ -484,3 +639,126 @@ func (k Querier) RequestVerification(
 		IsDelay:      false,
 	}, nil
 }
+
+// Subscription queries a subscription by its ID.
+func (k Querier) Subscription(
+	c context.Context,
+	req *types.QuerySubscriptionRequest,
+) (*types.QuerySubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	subscription, err := k.GetSubscription(ctx, types.SubscriptionID(req.SubscriptionId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySubscriptionResponse{Subscription: subscription}, nil
+}
+
+// Subscriptions queries all subscriptions, optionally filtered by owner.
+func (k Querier) Subscriptions(
+	c context.Context,
+	req *types.QuerySubscriptionsRequest,
+) (*types.QuerySubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid owner: %s", err))
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubscriptionStoreKeyPrefix)
+	filteredSubscriptions, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		req.Pagination,
+		func(key []byte, s *types.Subscription) (*types.Subscription, error) {
+			if req.Owner != "" && s.Owner != req.Owner {
+				return nil, nil
+			}
+			return s, nil
+		}, func() *types.Subscription {
+			return &types.Subscription{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	subscriptions := make([]types.Subscription, 0, len(filteredSubscriptions))
+	for _, s := range filteredSubscriptions {
+		subscriptions = append(subscriptions, *s)
+	}
+
+	return &types.QuerySubscriptionsResponse{Subscriptions: subscriptions, Pagination: pageRes}, nil
+}
+
+// DataSourceVersions queries the version history of a data source.
+func (k Querier) DataSourceVersions(
+	c context.Context,
+	req *types.QueryDataSourceVersionsRequest,
+) (*types.QueryDataSourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.DataSourceID(req.DataSourceId)
+	if !k.HasDataSource(ctx, id) {
+		return nil, status.Error(codes.NotFound, types.ErrDataSourceNotFound.Wrapf("id: %d", id).Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataSourceVersionsPrefixKey(id))
+	var versions []types.DataSourceVersion
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var version types.DataSourceVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDataSourceVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// OracleScriptVersions queries the version history of an oracle script.
+func (k Querier) OracleScriptVersions(
+	c context.Context,
+	req *types.QueryOracleScriptVersionsRequest,
+) (*types.QueryOracleScriptVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.OracleScriptID(req.OracleScriptId)
+	if !k.HasOracleScript(ctx, id) {
+		return nil, status.Error(codes.NotFound, types.ErrOracleScriptNotFound.Wrapf("id: %d", id).Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleScriptVersionsPrefixKey(id))
+	var versions []types.OracleScriptVersion
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var version types.OracleScriptVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOracleScriptVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
@@ -722,3 +722,59 @@ func (suite *KeeperTestSuite) TestPendingRequestsSuccess() {
 	require.Equal(&types.QueryPendingRequestsResponse{RequestIDs: []uint64{3}}, r)
 	require.NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestQueryRequestDecode() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	q := suite.queryClient
+	require := suite.Require()
+
+	suite.savePriceResult(42, []string{"BTC", "ETH"}, []uint64{60000, 3000}, 1000)
+
+	res, err := q.Request(ctx, &types.QueryRequestRequest{RequestId: 42})
+	require.NoError(err)
+	require.Empty(res.DecodedCalldata)
+	require.Empty(res.DecodedResult)
+
+	res, err = q.Request(ctx, &types.QueryRequestRequest{RequestId: 42, Decode: true})
+	require.NoError(err)
+	require.Equal(`{"symbols":["BTC","ETH"],"multiplier":1000000000}`, res.DecodedCalldata)
+	require.Equal(`{"rates":[60000,3000]}`, res.DecodedResult)
+
+	// the decoded data is left empty if the schema of the oracle script cannot decode it.
+	k.SetOracleScript(ctx, 1, types.NewOracleScript(
+		owner, basicName, basicDesc, basicFilename, basicSchema, basicSourceCodeURL,
+	))
+	k.SetRequest(ctx, 43, defaultRequest())
+
+	res, err = q.Request(ctx, &types.QueryRequestRequest{RequestId: 43, Decode: true})
+	require.NoError(err)
+	require.NotNil(res.Request)
+	require.Empty(res.DecodedCalldata)
+	require.Empty(res.DecodedResult)
+
+	// a request pinned to a version is decoded with the schema of that version, even if the schema of
+	// the oracle script is edited later, while an unpinned request uses the current schema.
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(
+		2, 1, "filename", types.StandardPriceReferenceSchema, owner.String(), 0, "",
+	))
+	req := k.MustGetRequest(ctx, 42)
+	req.OracleScriptVersion = 1
+	k.SetRequest(ctx, 42, req)
+	k.SetOracleScript(ctx, 2, types.NewOracleScript(
+		owner, "price", "standard price reference", "filename", basicSchema, "url",
+	))
+
+	res, err = q.Request(ctx, &types.QueryRequestRequest{RequestId: 42, Decode: true})
+	require.NoError(err)
+	require.Equal(`{"symbols":["BTC","ETH"],"multiplier":1000000000}`, res.DecodedCalldata)
+	require.Equal(`{"rates":[60000,3000]}`, res.DecodedResult)
+
+	req.OracleScriptVersion = 0
+	k.SetRequest(ctx, 42, req)
+
+	res, err = q.Request(ctx, &types.QueryRequestRequest{RequestId: 42, Decode: true})
+	require.NoError(err)
+	require.Empty(res.DecodedCalldata)
+	require.Empty(res.DecodedResult)
+}
@@ -40,6 +40,7 @@ type Keeper struct {
 	rollingseedKepper types.RollingseedKeeper
 	bandtssKeeper     types.BandtssKeeper
 	scopedKeeper      capabilitykeeper.ScopedKeeper
+	cbRouter          *types.CallbackRouter
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -50,7 +51,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
-	fileDir string,
+	fileCache filecache.Cache,
 	feeCollectorName string,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -62,13 +63,14 @@ func NewKeeper(
 	rollingseedKepper types.RollingseedKeeper,
 	bandtssKeeper types.BandtssKeeper,
 	scopeKeeper capabilitykeeper.ScopedKeeper,
+	cbRouter *types.CallbackRouter,
 	owasmVM *owasm.Vm,
 	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:          key,
 		cdc:               cdc,
-		fileCache:         filecache.New(fileDir),
+		fileCache:         fileCache,
 		feeCollectorName:  feeCollectorName,
 		owasmVM:           owasmVM,
 		authKeeper:        authKeeper,
@@ -81,6 +83,7 @@ func NewKeeper(
 		rollingseedKepper: rollingseedKepper,
 		bandtssKeeper:     bandtssKeeper,
 		scopedKeeper:      scopeKeeper,
+		cbRouter:          cbRouter,
 		authority:         authority,
 	}
 }
@@ -121,6 +124,22 @@ func (k Keeper) GetRequestLastExpired(ctx sdk.Context) types.RequestID {
 	return types.RequestID(binary.BigEndian.Uint64(bz))
 }
 
+// SetResultLastPruned sets the ID of the last request whose result is pruned.
+func (k Keeper) SetResultLastPruned(ctx sdk.Context, id types.RequestID) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(id))
+	ctx.KVStore(k.storeKey).Set(types.ResultLastPrunedStoreKey, bz)
+}
+
+// GetResultLastPruned returns the ID of the last request whose result is pruned, or 0 if none.
+func (k Keeper) GetResultLastPruned(ctx sdk.Context) types.RequestID {
+	bz := ctx.KVStore(k.storeKey).Get(types.ResultLastPrunedStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return types.RequestID(binary.BigEndian.Uint64(bz))
+}
+
 // GetNextRequestID increments and returns the current number of requests.
 func (k Keeper) GetNextRequestID(ctx sdk.Context) types.RequestID {
 	requestNumber := k.GetRequestCount(ctx)
@@ -173,6 +192,30 @@ func (k Keeper) GetFile(name string) []byte {
 	return k.fileCache.MustGetFile(name)
 }
 
+// FileCache returns the file storage of data source executables and compiled oracle scripts.
+func (k Keeper) FileCache() filecache.Cache {
+	return k.fileCache
+}
+
+// GetReferencedFilenames returns the set of files referenced by oracle scripts, data sources
+// and their versions. These files must be kept in the file storage.
+func (k Keeper) GetReferencedFilenames(ctx sdk.Context) map[string]bool {
+	filenames := make(map[string]bool)
+	for _, oracleScript := range k.GetAllOracleScripts(ctx) {
+		filenames[oracleScript.Filename] = true
+	}
+	for _, oracleScriptVersion := range k.GetAllOracleScriptVersions(ctx) {
+		filenames[oracleScriptVersion.Filename] = true
+	}
+	for _, dataSource := range k.GetAllDataSources(ctx) {
+		filenames[dataSource.Filename] = true
+	}
+	for _, dataSourceVersion := range k.GetAllDataSourceVersions(ctx) {
+		filenames[dataSourceVersion.Filename] = true
+	}
+	return filenames
+}
+
 // IsBound checks if the oracle module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
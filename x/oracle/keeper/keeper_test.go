@@ -26,6 +26,7 @@ import (
 
 	owasm "github.com/bandprotocol/go-owasm/api"
 
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletestutil "github.com/bandprotocol/chain/v3/x/oracle/testutil"
@@ -45,6 +46,9 @@ type KeeperTestSuite struct {
 	rollingseedKeeper *oracletestutil.MockRollingseedKeeper
 	bandtssKeeper     *oracletestutil.MockBandtssKeeper
 
+	cbRouter       *types.CallbackRouter
+	resultCallback *testResultCallback
+
 	key         storetypes.StoreKey
 	queryClient types.QueryClient
 	msgServer   types.MsgServer
@@ -75,6 +79,10 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.rollingseedKeeper = oracletestutil.NewMockRollingseedKeeper(ctrl)
 	suite.bandtssKeeper = oracletestutil.NewMockBandtssKeeper(ctrl)
 
+	suite.resultCallback = &testResultCallback{storeKey: key}
+	suite.cbRouter = types.NewCallbackRouter().AddRoute(testCallbackModule, suite.resultCallback)
+	suite.cbRouter.Seal()
+
 	suite.key = key
 	suite.homeDir = testutil.GetTempDir(suite.T())
 	suite.fileDir = filepath.Join(suite.homeDir, "files")
@@ -86,7 +94,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.oracleKeeper = keeper.NewKeeper(
 		encCfg.Codec,
 		key,
-		suite.fileDir,
+		filecache.New(suite.fileDir),
 		authtypes.FeeCollectorName,
 		suite.authKeeper,
 		suite.bankKeeper,
@@ -98,6 +106,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.rollingseedKeeper,
 		suite.bandtssKeeper,
 		capabilitykeeper.ScopedKeeper{},
+		suite.cbRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -233,6 +242,31 @@ func (suite *KeeperTestSuite) TestGetNextOracleScriptID() {
 	require.Equal(types.OracleScriptID(initialID+3), k.GetNextOracleScriptID(ctx))
 }
 
+func (suite *KeeperTestSuite) TestGetReferencedFilenames() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+
+	// Files of previous versions are still referenced after edits.
+	k.MustEditDataSource(ctx, 1, types.NewDataSource(
+		owner, "name1", "desc1", "new_filename1", bandtesting.Coins1band, treasury,
+	), owner.String(), "")
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, "test os", "testing oracle script", "new_os_filename", "schema", "url",
+	), owner.String(), "")
+
+	filenames := k.GetReferencedFilenames(ctx)
+	for _, filename := range []string{
+		"filename1", "new_filename1", "filename2", "filename3", oracleScript.Filename, "new_os_filename",
+	} {
+		require.True(filenames[filename], filename)
+	}
+	require.False(filenames["not_referenced"])
+}
+
 func (suite *KeeperTestSuite) TestGetSetRequestLastExpiredID() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
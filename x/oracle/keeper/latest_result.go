@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetLatestRequest sets the latest successful request of the given search parameters, along with its resolve time.
+func (k Keeper) SetLatestRequest(
+	ctx sdk.Context,
+	oid types.OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	reqID types.RequestID,
+	resolveTime int64,
+) {
+	key := types.LatestRequestStoreKey(oid, calldata, askCount, minCount)
+	bz := append(sdk.Uint64ToBigEndian(uint64(reqID)), sdk.Uint64ToBigEndian(uint64(resolveTime))...)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	store.Set(types.LatestResultExpirationStoreKey(resolveTime, key), []byte{})
+}
+
+// GetLatestRequestID returns the ID of the latest successful request of the given search parameters.
+func (k Keeper) GetLatestRequestID(
+	ctx sdk.Context,
+	oid types.OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+) (types.RequestID, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LatestRequestStoreKey(oid, calldata, askCount, minCount))
+	if bz == nil {
+		return 0, types.ErrLatestRequestNotFound.Wrapf(
+			"oracle script id: %d, ask count: %d, min count: %d",
+			oid,
+			askCount,
+			minCount,
+		)
+	}
+
+	return types.RequestID(binary.BigEndian.Uint64(bz[:8])), nil
+}
+
+// SetPriceResult sets the latest price result of the symbol for the given ask and min count.
+func (k Keeper) SetPriceResult(ctx sdk.Context, askCount, minCount uint64, price types.PriceResult) {
+	key := types.PriceResultStoreKey(price.Symbol, askCount, minCount)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&price))
+	store.Set(types.LatestResultExpirationStoreKey(price.ResolveTime, key), []byte{})
+}
+
+// GetPriceResult returns the latest price result of the symbol for the given ask and min count.
+func (k Keeper) GetPriceResult(ctx sdk.Context, symbol string, askCount, minCount uint64) (types.PriceResult, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceResultStoreKey(symbol, askCount, minCount))
+	if bz == nil {
+		return types.PriceResult{}, types.ErrPriceResultNotFound.Wrapf(
+			"symbol: %s, ask count: %d, min count: %d",
+			symbol,
+			askCount,
+			minCount,
+		)
+	}
+
+	var price types.PriceResult
+	k.cdc.MustUnmarshal(bz, &price)
+
+	return price, nil
+}
+
+// indexLatestResult indexes the successful result by its search parameters and, if the request is made
+// to a standard price reference oracle script, by the symbols of its prices.
+func (k Keeper) indexLatestResult(ctx sdk.Context, result types.Result) {
+	if result.ResolveStatus != types.RESOLVE_STATUS_SUCCESS || k.GetParams(ctx).LatestResultRetention == 0 {
+		return
+	}
+
+	k.SetLatestRequest(
+		ctx,
+		result.OracleScriptID,
+		result.Calldata,
+		result.AskCount,
+		result.MinCount,
+		result.RequestID,
+		result.ResolveTime,
+	)
+
+	oracleScript, err := k.GetOracleScript(ctx, result.OracleScriptID)
+	if err != nil || !types.IsStandardPriceReference(oracleScript.Schema) {
+		return
+	}
+
+	// the result doesn't follow the schema; there is no price to index.
+	prices, err := types.DecodePriceResults(result.Calldata, result.Result, result.RequestID, result.ResolveTime)
+	if err != nil {
+		return
+	}
+
+	for _, price := range prices {
+		k.SetPriceResult(ctx, result.AskCount, result.MinCount, price)
+	}
+}
+
+// unindexLatestResult removes the latest result indexes that still point to the given result, so that
+// they don't outlive the result when it is pruned. The indexes already replaced by newer results are kept.
+func (k Keeper) unindexLatestResult(ctx sdk.Context, result types.Result) {
+	if result.ResolveStatus != types.RESOLVE_STATUS_SUCCESS {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.LatestRequestStoreKey(result.OracleScriptID, result.Calldata, result.AskCount, result.MinCount)
+	if rid, err := k.GetLatestRequestID(
+		ctx,
+		result.OracleScriptID,
+		result.Calldata,
+		result.AskCount,
+		result.MinCount,
+	); err == nil && rid == result.RequestID {
+		store.Delete(key)
+	}
+
+	prices, err := types.DecodePriceResults(result.Calldata, result.Result, result.RequestID, result.ResolveTime)
+	if err != nil {
+		return
+	}
+
+	for _, price := range prices {
+		indexed, err := k.GetPriceResult(ctx, price.Symbol, result.AskCount, result.MinCount)
+		if err == nil && indexed.RequestID == result.RequestID {
+			store.Delete(types.PriceResultStoreKey(price.Symbol, result.AskCount, result.MinCount))
+		}
+	}
+}
+
+// PruneLatestResults removes the latest results that were resolved before the retention period, in
+// the order of their resolve time and up to the maximum number of results per block. It continues
+// from the last pruned index, so the remaining results are pruned in the next blocks.
+func (k Keeper) PruneLatestResults(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	retention := int64(params.LatestResultRetention / uint64(time.Second))
+	cutoff := ctx.BlockTime().Unix() - retention
+	if cutoff <= 0 {
+		return
+	}
+
+	start := types.LatestResultExpirationStoreKeyPrefix
+	if lastPruned := k.GetLatestResultLastPruned(ctx); lastPruned != nil {
+		start = lastPruned
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, types.LatestResultExpirationStoreKey(cutoff, nil))
+	defer iterator.Close()
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid() && uint64(len(expiredKeys)) < params.MaxResultPrunesPerBlock; iterator.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iterator.Key()...))
+	}
+
+	for _, expiredKey := range expiredKeys {
+		resolveTime := int64(binary.BigEndian.Uint64(expiredKey[1:9]))
+		key := expiredKey[9:]
+
+		// the entry is kept if it was replaced by a newer result after this index was written.
+		if k.getLatestResultResolveTime(store, key) == resolveTime {
+			store.Delete(key)
+		}
+		store.Delete(expiredKey)
+	}
+
+	if len(expiredKeys) > 0 {
+		k.SetLatestResultLastPruned(ctx, expiredKeys[len(expiredKeys)-1])
+	}
+}
+
+// SetLatestResultLastPruned sets the last pruned resolve time index of the latest results.
+func (k Keeper) SetLatestResultLastPruned(ctx sdk.Context, key []byte) {
+	ctx.KVStore(k.storeKey).Set(types.LatestResultLastPrunedStoreKey, key)
+}
+
+// GetLatestResultLastPruned returns the last pruned resolve time index of the latest results, or nil if none.
+func (k Keeper) GetLatestResultLastPruned(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.LatestResultLastPrunedStoreKey)
+}
+
+// getLatestResultResolveTime returns the resolve time of the latest result stored at the given key,
+// or -1 if there is none.
+func (k Keeper) getLatestResultResolveTime(store storetypes.KVStore, key []byte) int64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return -1
+	}
+
+	if key[0] == types.PriceResultStoreKeyPrefix[0] {
+		var price types.PriceResult
+		k.cdc.MustUnmarshal(bz, &price)
+		return price.ResolveTime
+	}
+
+	return int64(binary.BigEndian.Uint64(bz[8:16]))
+}
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type priceInput struct {
+	Symbols    []string `json:"symbols"`
+	Multiplier uint64   `json:"multiplier"`
+}
+
+type priceOutput struct {
+	Rates []uint64 `json:"rates"`
+}
+
+// savePriceResult saves a successful result of a standard price reference request with the given rates.
+func (suite *KeeperTestSuite) savePriceResult(
+	rid types.RequestID,
+	symbols []string,
+	rates []uint64,
+	resolveTime int64,
+) []byte {
+	ctx := suite.ctx.WithBlockTime(time.Unix(resolveTime, 0))
+	k := suite.oracleKeeper
+
+	k.SetOracleScript(ctx, 2, types.NewOracleScript(
+		owner, "price", "standard price reference", "filename", types.StandardPriceReferenceSchema, "url",
+	))
+
+	calldata := obi.MustEncode(priceInput{Symbols: symbols, Multiplier: 1000000000})
+	req := defaultRequest()
+	req.OracleScriptID = 2
+	req.Calldata = calldata
+	k.SetRequest(ctx, rid, req)
+	k.SaveResult(ctx, rid, types.RESOLVE_STATUS_SUCCESS, obi.MustEncode(priceOutput{Rates: rates}))
+
+	return calldata
+}
+
+func (suite *KeeperTestSuite) TestSaveResultIndexesLatestResult() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	calldata := suite.savePriceResult(42, []string{"BTC", "ETH"}, []uint64{60000, 3000}, 1000)
+
+	rid, err := k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(42), rid)
+
+	price, err := k.GetPriceResult(ctx, "ETH", 2, 2)
+	require.NoError(err)
+	require.Equal(types.NewPriceResult("ETH", 1000000000, 3000, 42, 1000), price)
+
+	// a newer result replaces the older one.
+	suite.savePriceResult(43, []string{"BTC", "ETH"}, []uint64{61000, 3100}, 1010)
+	rid, err = k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(43), rid)
+
+	price, err = k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.NoError(err)
+	require.Equal(types.NewPriceResult("BTC", 1000000000, 61000, 43, 1010), price)
+
+	// other ask and min counts are not affected.
+	_, err = k.GetPriceResult(ctx, "BTC", 4, 3)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSaveResultNotIndexFailedResult() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest())
+	k.SaveResult(ctx, 42, types.RESOLVE_STATUS_FAILURE, nil)
+
+	_, err := k.GetLatestRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.ErrorIs(err, types.ErrLatestRequestNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSaveResultNotIndexWhenRetentionIsZero() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.LatestResultRetention = 0
+	require.NoError(k.SetParams(ctx, params))
+
+	calldata := suite.savePriceResult(42, []string{"BTC"}, []uint64{60000}, 1000)
+
+	_, err := k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.ErrorIs(err, types.ErrLatestRequestNotFound)
+	_, err = k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPruneLatestResults() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.LatestResultRetention = uint64(100 * time.Second)
+	require.NoError(k.SetParams(ctx, params))
+
+	calldata := suite.savePriceResult(42, []string{"BTC", "ETH"}, []uint64{60000, 3000}, 1000)
+	suite.savePriceResult(43, []string{"ETH"}, []uint64{3100}, 1050)
+
+	// nothing is pruned within the retention period.
+	k.PruneLatestResults(ctx.WithBlockTime(time.Unix(1100, 0)))
+	_, err := k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.NoError(err)
+
+	// results resolved before the cutoff are pruned, but the newer ETH price is kept.
+	k.PruneLatestResults(ctx.WithBlockTime(time.Unix(1101, 0)))
+	_, err = k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.ErrorIs(err, types.ErrLatestRequestNotFound)
+	_, err = k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+
+	price, err := k.GetPriceResult(ctx, "ETH", 2, 2)
+	require.NoError(err)
+	require.Equal(types.RequestID(43), price.RequestID)
+
+	k.PruneLatestResults(ctx.WithBlockTime(time.Unix(1151, 0)))
+	_, err = k.GetPriceResult(ctx, "ETH", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPruneLatestResultsLimitPerBlock() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.LatestResultRetention = uint64(100 * time.Second)
+	params.MaxResultPrunesPerBlock = 2
+	require.NoError(k.SetParams(ctx, params))
+
+	// the request and its two prices are indexed.
+	calldata := suite.savePriceResult(42, []string{"BTC", "ETH"}, []uint64{60000, 3000}, 1000)
+
+	// only two indexes are pruned in a block; the rest is pruned in the next block.
+	ctx = ctx.WithBlockTime(time.Unix(1101, 0))
+	k.PruneLatestResults(ctx)
+	require.NotNil(k.GetLatestResultLastPruned(ctx))
+
+	var found int
+	if _, err := k.GetLatestRequestID(ctx, 2, calldata, 2, 2); err == nil {
+		found++
+	}
+	for _, symbol := range []string{"BTC", "ETH"} {
+		if _, err := k.GetPriceResult(ctx, symbol, 2, 2); err == nil {
+			found++
+		}
+	}
+	require.Equal(1, found)
+
+	k.PruneLatestResults(ctx)
+	_, err := k.GetLatestRequestID(ctx, 2, calldata, 2, 2)
+	require.ErrorIs(err, types.ErrLatestRequestNotFound)
+	_, err = k.GetPriceResult(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+	_, err = k.GetPriceResult(ctx, "ETH", 2, 2)
+	require.ErrorIs(err, types.ErrPriceResultNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPruneResultsUnindexesLatestResults() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	q := suite.queryClient
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = 0
+	params.ResultRetentionCount = 1
+	params.LatestResultRetention = uint64(1000 * time.Second)
+	require.NoError(k.SetParams(ctx, params))
+
+	calldata1 := suite.savePriceResult(1, []string{"BTC", "ETH"}, []uint64{60000, 3000}, 1000)
+	calldata2 := suite.savePriceResult(2, []string{"ETH"}, []uint64{3100}, 1010)
+	k.SetRequestCount(ctx, 2)
+	k.SetRequestLastExpired(ctx, 2)
+
+	// request#1 is pruned along with the indexes that still point to it.
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(1), k.GetResultLastPruned(ctx))
+
+	_, err := q.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 2,
+		Calldata:       hex.EncodeToString(calldata1),
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.ErrorContains(err, types.ErrLatestRequestNotFound.Error())
+
+	_, err = q.RequestPrice(ctx, &types.QueryRequestPriceRequest{Symbols: []string{"BTC"}, AskCount: 2, MinCount: 2})
+	require.ErrorContains(err, types.ErrPriceResultNotFound.Error())
+
+	// the indexes replaced by request#2 are kept.
+	res, err := q.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 2,
+		Calldata:       hex.EncodeToString(calldata2),
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.NoError(err)
+	require.Equal(types.RequestID(2), res.Request.Result.RequestID)
+
+	price, err := q.RequestPrice(ctx, &types.QueryRequestPriceRequest{Symbols: []string{"ETH"}, AskCount: 2, MinCount: 2})
+	require.NoError(err)
+	require.Equal(types.RequestID(2), price.PriceResults[0].RequestID)
+	require.Equal(uint64(3100), price.PriceResults[0].Px)
+
+	// the expiration indexes of the removed entries are cleaned up without touching the newer ones.
+	k.PruneLatestResults(ctx.WithBlockTime(time.Unix(2001, 0)))
+	_, err = k.GetLatestRequestID(ctx, 2, calldata2, 2, 2)
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestSearch() {
+	ctx := suite.ctx
+	q := suite.queryClient
+	require := suite.Require()
+
+	calldata := suite.savePriceResult(42, []string{"BTC"}, []uint64{60000}, 1000)
+
+	res, err := q.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 2,
+		Calldata:       hex.EncodeToString(calldata),
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.NoError(err)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, res.Request.Result.ResolveStatus)
+	require.Equal(uint64(42), uint64(res.Request.Result.RequestID))
+
+	_, err = q.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 2,
+		Calldata:       hex.EncodeToString(calldata),
+		AskCount:       3,
+		MinCount:       2,
+	})
+	require.ErrorContains(err, types.ErrLatestRequestNotFound.Error())
+
+	_, err = q.RequestSearch(ctx, &types.QueryRequestSearchRequest{OracleScriptId: 2, Calldata: "zz"})
+	require.ErrorContains(err, "invalid calldata")
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestPrice() {
+	ctx := suite.ctx
+	q := suite.queryClient
+	require := suite.Require()
+
+	suite.savePriceResult(42, []string{"BTC", "ETH"}, []uint64{60000, 3000}, bandtesting.ParseTime(10).Unix())
+
+	res, err := q.RequestPrice(ctx, &types.QueryRequestPriceRequest{
+		Symbols:  []string{"ETH", "BTC"},
+		AskCount: 2,
+		MinCount: 2,
+	})
+	require.NoError(err)
+	require.Len(res.PriceResults, 2)
+	require.Equal("ETH", res.PriceResults[0].Symbol)
+	require.Equal(uint64(3000), res.PriceResults[0].Px)
+	require.Equal("BTC", res.PriceResults[1].Symbol)
+	require.Equal(uint64(60000), res.PriceResults[1].Px)
+
+	_, err = q.RequestPrice(ctx, &types.QueryRequestPriceRequest{
+		Symbols:  []string{"BTC", "BAND"},
+		AskCount: 2,
+		MinCount: 2,
+	})
+	require.ErrorContains(err, types.ErrPriceResultNotFound.Error())
+
+	_, err = q.RequestPrice(ctx, &types.QueryRequestPriceRequest{AskCount: 2, MinCount: 2})
+	require.ErrorContains(err, "symbols must not be empty")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bandprotocol/chain/v3/x/oracle/exported"
 	v2 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v2"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -27,3 +28,10 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it records the current executables of all data sources
+// and oracle scripts as their first versions.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -167,13 +167,14 @@ func (k msgServer) EditDataSource(
 	}
 
 	// Can safely use MustEdit here, as we already checked that the data source exists above.
-	k.MustEditDataSource(ctx, msg.DataSourceID, types.NewDataSource(
+	version := k.MustEditDataSource(ctx, msg.DataSourceID, types.NewDataSource(
 		newOwner, msg.Name, msg.Description, k.AddExecutableFile(msg.Executable), msg.Fee, treasury,
-	))
+	), msg.Sender, msg.Changelog)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditDataSource,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", msg.DataSourceID)),
+		sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprintf("%d", version)),
 	))
 
 	return &types.MsgEditDataSourceResponse{}, nil
@@ -260,13 +261,14 @@ func (k msgServer) EditOracleScript(
 		return nil, err
 	}
 
-	k.MustEditOracleScript(ctx, msg.OracleScriptID, types.NewOracleScript(
+	version := k.MustEditOracleScript(ctx, msg.OracleScriptID, types.NewOracleScript(
 		newOwner, msg.Name, msg.Description, filename, msg.Schema, msg.SourceCodeURL,
-	))
+	), msg.Sender, msg.Changelog)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeEditOracleScript,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", msg.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyVersion, fmt.Sprintf("%d", version)),
 	))
 
 	return &types.MsgEditOracleScriptResponse{}, nil
@@ -290,6 +292,148 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivate) (*typ
 	return &types.MsgActivateResponse{}, nil
 }
 
+func (k msgServer) CreateSubscription(
+	goCtx context.Context,
+	msg *types.MsgCreateSubscription,
+) (*types.MsgCreateSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateSubscriptionTemplate(
+		ctx, msg.OracleScriptID, msg.OracleScriptVersion, msg.AskCount,
+	); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := k.AddSubscription(ctx, types.NewSubscription(
+		0, msg.Sender, "", msg.OracleScriptID, msg.Calldata, msg.AskCount, msg.MinCount, msg.ClientID,
+		msg.FeeLimit, msg.PrepareGas, msg.ExecuteGas, msg.TSSEncoder, msg.Interval, msg.IntervalUnit,
+		msg.MaxRuns, 0, 0, types.SUBSCRIPTION_STATUS_UNSPECIFIED, msg.OracleScriptVersion,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.InitialDeposit.IsZero() {
+		depositAccount := sdk.MustAccAddressFromBech32(subscription.DepositAccount)
+		if err := k.bankKeeper.SendCoins(ctx, sender, depositAccount, msg.InitialDeposit); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+		sdk.NewAttribute(types.AttributeKeyOwner, subscription.Owner),
+		sdk.NewAttribute(types.AttributeKeyDepositAccount, subscription.DepositAccount),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", subscription.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", subscription.Interval)),
+		sdk.NewAttribute(types.AttributeKeyIntervalUnit, subscription.IntervalUnit.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxRuns, fmt.Sprintf("%d", subscription.MaxRuns)),
+	))
+
+	return &types.MsgCreateSubscriptionResponse{SubscriptionID: subscription.ID}, nil
+}
+
+func (k msgServer) UpdateSubscription(
+	goCtx context.Context,
+	msg *types.MsgUpdateSubscription,
+) (*types.MsgUpdateSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscription, err := k.GetSubscription(ctx, msg.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of subscription
+	if subscription.Owner != msg.Sender {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	if err := k.validateSubscriptionTemplate(
+		ctx, msg.OracleScriptID, msg.OracleScriptVersion, msg.AskCount,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateSubscription(ctx, msg.SubscriptionID, types.NewSubscription(
+		0, "", "", msg.OracleScriptID, msg.Calldata, msg.AskCount, msg.MinCount, msg.ClientID,
+		msg.FeeLimit, msg.PrepareGas, msg.ExecuteGas, msg.TSSEncoder, msg.Interval, msg.IntervalUnit,
+		msg.MaxRuns, 0, 0, types.SUBSCRIPTION_STATUS_UNSPECIFIED, msg.OracleScriptVersion,
+	)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", msg.SubscriptionID)),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", msg.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", msg.Interval)),
+		sdk.NewAttribute(types.AttributeKeyIntervalUnit, msg.IntervalUnit.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxRuns, fmt.Sprintf("%d", msg.MaxRuns)),
+	))
+
+	return &types.MsgUpdateSubscriptionResponse{}, nil
+}
+
+func (k msgServer) CancelSubscription(
+	goCtx context.Context,
+	msg *types.MsgCancelSubscription,
+) (*types.MsgCancelSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscription, err := k.GetSubscription(ctx, msg.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of subscription
+	if subscription.Owner != msg.Sender {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	refund, err := k.CloseSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", msg.SubscriptionID)),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+
+	return &types.MsgCancelSubscriptionResponse{}, nil
+}
+
+// validateSubscriptionTemplate checks the parts of the request template of a subscription that depend
+// on the state, so that an invalid subscription is rejected upfront instead of failing every run.
+func (k msgServer) validateSubscriptionTemplate(
+	ctx sdk.Context,
+	oid types.OracleScriptID,
+	version uint64,
+	askCount uint64,
+) error {
+	if !k.HasOracleScript(ctx, oid) {
+		return types.ErrOracleScriptNotFound.Wrapf("id: %d", oid)
+	}
+	if version != 0 && !k.HasOracleScriptVersion(ctx, oid, version) {
+		return types.ErrOracleScriptVersionNotFound.Wrapf("id: %d, version: %d", oid, version)
+	}
+
+	maxAskCount := k.GetParams(ctx).MaxAskCount
+	if askCount > maxAskCount {
+		return types.WrapMaxError(types.ErrInvalidAskCount, int(askCount), int(maxAskCount))
+	}
+
+	return nil
+}
+
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
 	msg *types.MsgUpdateParams,
@@ -45,11 +45,19 @@ func (k Keeper) SetOracleScript(ctx sdk.Context, id types.OracleScriptID, oracle
 func (k Keeper) AddOracleScript(ctx sdk.Context, oracleScript types.OracleScript) types.OracleScriptID {
 	id := k.GetNextOracleScriptID(ctx)
 	k.SetOracleScript(ctx, id, oracleScript)
+	k.addOracleScriptVersion(ctx, id, oracleScript.Filename, oracleScript.Schema, oracleScript.Owner, "")
 	return id
 }
 
-// MustEditOracleScript edits the given oracle script by id and flushes it to the storage. Panic if not exists.
-func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, new types.OracleScript) {
+// MustEditOracleScript edits the given oracle script by id and flushes it to the storage, and appends a new
+// version of the oracle script with the given editor and changelog. Returns the new version number. Panic if not exists.
+func (k Keeper) MustEditOracleScript(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	new types.OracleScript,
+	editor string,
+	changelog string,
+) uint64 {
 	oracleScript := k.MustGetOracleScript(ctx, id)
 	oracleScript.Owner = new.Owner
 	oracleScript.Name = modify(oracleScript.Name, new.Name)
@@ -58,6 +66,7 @@ func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, n
 	oracleScript.Schema = modify(oracleScript.Schema, new.Schema)
 	oracleScript.SourceCodeURL = modify(oracleScript.SourceCodeURL, new.SourceCodeURL)
 	k.SetOracleScript(ctx, id, oracleScript)
+	return k.addOracleScriptVersion(ctx, id, oracleScript.Filename, oracleScript.Schema, editor, changelog).Version
 }
 
 // GetAllOracleScripts returns the list of all oracle scripts in the store, or nil if there is none.
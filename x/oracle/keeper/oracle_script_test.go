@@ -79,7 +79,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptBasic() {
 		k.MustEditOracleScript(ctx, id, types.NewOracleScript(
 			owner, oracleScript2.Name, oracleScript2.Description, oracleScript2.Filename,
 			oracleScript2.Schema, oracleScript2.SourceCodeURL,
-		))
+		), oracleScript2.Owner, "")
 	})
 	require.NotEqual(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.Equal(oracleScript2, k.MustGetOracleScript(ctx, id))
@@ -103,7 +103,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptDoNotModify() {
 	require.Equal(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.NotEqual(oracleScript2, k.MustGetOracleScript(ctx, id))
 	// Edits the oracle script. We should get the updated oracle script.
-	require.NotPanics(func() { k.MustEditOracleScript(ctx, id, oracleScript2) })
+	require.NotPanics(func() { k.MustEditOracleScript(ctx, id, oracleScript2, oracleScript2.Owner, "") })
 	oracleScriptRes := k.MustGetOracleScript(ctx, id)
 	require.NotEqual(oracleScriptRes, oracleScript1)
 	require.NotEqual(oracleScriptRes, oracleScript2)
@@ -144,7 +144,7 @@ func (suite *KeeperTestSuite) TestEditNonExistentOracleScript() {
 	require.Panics(func() {
 		k.MustEditOracleScript(ctx, 42, types.NewOracleScript(
 			bandtesting.Owner.Address, basicName, basicDesc, basicFilename, basicSchema, basicSourceCodeURL,
-		))
+		), bandtesting.Owner.Address.String(), "")
 	})
 }
 
@@ -50,7 +50,7 @@ func (k Keeper) GetRandomValidators(ctx sdk.Context, size int, id uint64) ([]sdk
 		return nil, types.ErrInsufficientValidators.Wrapf("%d < %d", len(valOperators), size)
 	}
 	rng, err := bandrng.NewRng(
-		k.rollingseedKepper.GetRollingSeed(ctx),
+		k.rollingseedKepper.GetRandomSeed(ctx),
 		sdk.Uint64ToBigEndian(id),
 		[]byte(ctx.ChainID()),
 	)
@@ -118,10 +118,26 @@ func (k Keeper) PrepareRequest(
 		int64(params.MaxRawRequestCount),
 		int64(k.GetSpanSize(ctx)),
 	)
-	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	if !k.HasOracleScript(ctx, req.OracleScriptID) {
+		return 0, types.ErrOracleScriptNotFound.Wrapf("id: %d", req.OracleScriptID)
+	}
+	script, err := k.resolveOracleScriptVersion(ctx, req.OracleScriptID, r.GetOracleScriptVersion())
 	if err != nil {
 		return 0, err
 	}
+	req.OracleScriptVersion = script.Version
+
+	// The result callback is run at the end block, so its gas is charged upfront like the execute gas.
+	if callback := r.GetCallback(); callback != nil {
+		if err := callback.Validate(); err != nil {
+			return 0, err
+		}
+		if !k.cbRouter.HasRoute(callback.Module) {
+			return 0, types.ErrCallbackModuleNotFound.Wrapf("module: %s", callback.Module)
+		}
+		ctx.GasMeter().ConsumeGas(callback.GasLimit, "RESULT_CALLBACK_FEE")
+		req.Callback = callback
+	}
 
 	// Consume fee and execute owasm code
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
@@ -138,14 +154,34 @@ func (k Keeper) PrepareRequest(
 		return 0, types.ErrEmptyRawRequests
 	}
 	// Collect ds fee
-	totalFees, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
+	receipt, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
 	if err != nil {
 		return 0, err
 	}
+	totalFees := receipt.Escrowed
+	// Record the data source versions to be executed, so that later edits do not affect this request.
+	dataSourceVersions := make([]types.DataSourceVersion, len(req.RawRequests))
+	for i, rawReq := range req.RawRequests {
+		dataSourceVersions[i], err = k.GetLatestDataSourceVersion(ctx, rawReq.DataSourceID)
+		if err != nil {
+			return 0, err
+		}
+		req.RawRequests[i].DataSourceVersion = dataSourceVersions[i].Version
+	}
 
 	// We now have everything we need to the request, so let's add it to the store.
 	req.FeeLimit = req.FeeLimit.Sub(totalFees...)
 	id := k.AddRequest(ctx, req)
+	if !totalFees.IsZero() {
+		receipt.RequestID = id
+		k.SetFeeReceipt(ctx, receipt)
+	}
+	if req.Callback != nil {
+		cb, _ := k.cbRouter.GetRoute(req.Callback.Module)
+		if err := cb.OnRequestCreated(ctx, id, feePayer, req.Callback.Payload); err != nil {
+			return 0, err
+		}
+	}
 
 	// Emit an event describing a data request and asked validators.
 	event := sdk.NewEvent(types.EventTypeRequest)
@@ -153,12 +189,16 @@ func (k Keeper) PrepareRequest(
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
 		sdk.NewAttribute(types.AttributeKeyClientID, req.ClientID),
 		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", req.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptVersion, fmt.Sprintf("%d", req.OracleScriptVersion)),
 		sdk.NewAttribute(types.AttributeKeyCalldata, hex.EncodeToString(req.Calldata)),
 		sdk.NewAttribute(types.AttributeKeyAskCount, fmt.Sprintf("%d", askCount)),
 		sdk.NewAttribute(types.AttributeKeyMinCount, fmt.Sprintf("%d", req.MinCount)),
 		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", output.GasUsed)),
 		sdk.NewAttribute(types.AttributeKeyTotalFees, totalFees.String()),
 	)
+	if req.Callback != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyCallbackModule, req.Callback.Module))
+	}
 	for _, val := range req.RequestedValidators {
 		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyValidator, val))
 	}
@@ -169,7 +209,7 @@ func (k Keeper) PrepareRequest(
 	ctx.GasMeter().ConsumeGas(r.GetExecuteGas(), "OWASM_EXECUTE_FEE")
 
 	// Emit an event for each of the raw data requests.
-	for _, rawReq := range env.GetRawRequests() {
+	for i, rawReq := range req.RawRequests {
 		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
 		if err != nil {
 			return 0, err
@@ -177,7 +217,8 @@ func (k Keeper) PrepareRequest(
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeRawRequest,
 			sdk.NewAttribute(types.AttributeKeyDataSourceID, fmt.Sprintf("%d", rawReq.DataSourceID)),
-			sdk.NewAttribute(types.AttributeKeyDataSourceHash, ds.Filename),
+			sdk.NewAttribute(types.AttributeKeyDataSourceVersion, fmt.Sprintf("%d", rawReq.DataSourceVersion)),
+			sdk.NewAttribute(types.AttributeKeyDataSourceHash, dataSourceVersions[i].Filename),
 			sdk.NewAttribute(types.AttributeKeyExternalID, fmt.Sprintf("%d", rawReq.ExternalID)),
 			sdk.NewAttribute(types.AttributeKeyCalldata, string(rawReq.Calldata)),
 			sdk.NewAttribute(types.AttributeKeyFee, ds.Fee.String()),
@@ -191,8 +232,7 @@ func (k Keeper) PrepareRequest(
 func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	req := k.MustGetRequest(ctx, reqID)
 	env := types.NewExecuteEnv(req, k.GetReports(ctx, reqID), ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
-	script := k.MustGetOracleScript(ctx, req.OracleScriptID)
-	code := k.GetFile(script.Filename)
+	code := k.GetFile(k.getRequestOracleScriptFilename(ctx, req))
 	output, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.GetExecuteGas()), env)
 
 	if err != nil {
@@ -204,20 +244,35 @@ func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	}
 }
 
-// CollectFee subtract fee from fee payer and send them to treasury
+// getRequestOracleScriptFilename returns the filename of the oracle script version recorded in the
+// given request. Requests made before versions were recorded use the current oracle script.
+func (k Keeper) getRequestOracleScriptFilename(ctx sdk.Context, req types.Request) string {
+	if req.OracleScriptVersion == 0 {
+		return k.MustGetOracleScript(ctx, req.OracleScriptID).Filename
+	}
+	script, err := k.GetOracleScriptVersion(ctx, req.OracleScriptID, req.OracleScriptVersion)
+	if err != nil {
+		panic(err)
+	}
+	return script.Filename
+}
+
+// CollectFee subtracts the data source fees of all the asked validators from the fee payer and holds
+// them in escrow. It returns the fee receipt of the escrowed fees, to be settled when the request expires.
 func (k Keeper) CollectFee(
 	ctx sdk.Context,
 	payer sdk.AccAddress,
 	feeLimit sdk.Coins,
 	askCount uint64,
 	rawRequests []types.RawRequest,
-) (sdk.Coins, error) {
+) (types.FeeReceipt, error) {
 	collector := newFeeCollector(k.bankKeeper, feeLimit, payer)
+	items := []types.FeeReceiptItem{}
 
 	for _, r := range rawRequests {
 		ds, err := k.GetDataSource(ctx, r.DataSourceID)
 		if err != nil {
-			return nil, err
+			return types.FeeReceipt{}, err
 		}
 
 		if ds.Fee.Empty() {
@@ -230,15 +285,17 @@ func (k Keeper) CollectFee(
 			fee = fee.Add(c)
 		}
 
-		treasury, err := sdk.AccAddressFromBech32(ds.Treasury)
-		if err != nil {
-			return nil, err
+		if _, err := sdk.AccAddressFromBech32(ds.Treasury); err != nil {
+			return types.FeeReceipt{}, err
 		}
 
-		if err := collector.Collect(ctx, fee, treasury); err != nil {
-			return nil, err
+		if err := collector.Collect(ctx, fee); err != nil {
+			return types.FeeReceipt{}, err
 		}
+		items = append(items, types.NewFeeReceiptItem(
+			r.ExternalID, r.DataSourceID, ds.Treasury, ds.Fee, 0, sdk.NewCoins(),
+		))
 	}
 
-	return collector.Collected(), nil
+	return types.NewFeeReceipt(0, payer.String(), collector.Collected(), items, sdk.NewCoins(), false, ""), nil
 }
@@ -59,7 +59,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsSuccessActivateAll() {
 	// Getting 3 validators using ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 	vals, err := k.GetRandomValidators(ctx, 3, 1)
 	require.NoError(err)
@@ -75,7 +75,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsSuccessActivateAll() {
 	// Getting 3 validators using ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
 	vals, err = k.GetRandomValidators(ctx, 3, 1)
 	require.NoError(err)
@@ -91,7 +91,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsSuccessActivateAll() {
 	// Getting 3 validators using ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY again should return the same result as the first one.
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 	vals, err = k.GetRandomValidators(ctx, 3, 1)
 	require.NoError(err)
@@ -107,7 +107,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsSuccessActivateAll() {
 	// Getting 3 validators using ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY but for a different request ID.
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 	vals, err = k.GetRandomValidators(ctx, 3, 42)
 	require.NoError(err)
@@ -130,7 +130,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsTooBigSize() {
 
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
@@ -155,7 +155,7 @@ func (suite *KeeperTestSuite) TestGetRandomValidatorsNotEnoughEntropy() {
 
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte(""))
 
 	_, err := k.GetRandomValidators(ctx, 3, 1)
@@ -192,18 +192,18 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
 
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band)
 
 	id, err := k.PrepareRequest(ctx, msg, alice, nil)
 	require.NoError(err)
 	require.Equal(types.RequestID(1), id)
 
-	require.Equal(types.NewRequest(
+	expectRequest := types.NewRequest(
 		1,
 		basicCalldata,
 		[]sdk.ValAddress{validators[0].Address},
@@ -221,7 +221,13 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 		0,
 		alice.String(),
 		sdk.NewCoins(sdk.NewInt64Coin("uband", 97000000)),
-	), k.MustGetRequest(ctx, 1))
+	)
+	// The latest versions of the oracle script and data sources are recorded.
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
+	require.Equal(expectRequest, k.MustGetRequest(ctx, 1))
 
 	// assert gas consumption
 	params := k.GetParams(ctx)
@@ -261,7 +267,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestOracleScriptNotFound() {
 
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	m := types.NewMsgRequestData(
@@ -290,12 +296,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughMaxFee() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
@@ -384,11 +390,11 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughFund() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.Alice.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(errorsmod.Wrapf(
 			sdkerrors.ErrInsufficientFunds,
 			"spendable balance %s is smaller than %s",
@@ -423,7 +429,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughPrepareGas() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
@@ -463,11 +469,11 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidAskCountFail() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -546,12 +552,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestBaseOwasmFeePanic() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -592,12 +598,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestPerValidatorRequestFeePanic() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -650,7 +656,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestEmptyCalldata() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
@@ -681,7 +687,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestBadWasmExecutionFail() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
@@ -711,7 +717,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestWithEmptyRawRequest() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
@@ -741,12 +747,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestUnknownDataSource() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(4, obi.MustEncode(testdata.Wasm4Input{
@@ -767,12 +773,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidDataSourceCount() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -804,12 +810,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooMuchWasmGas() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -853,12 +859,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooLargeCalldata() {
 	// Define expected mock keeper
 	suite.rollingseedKeeper.
 		EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -1243,21 +1249,21 @@ func (suite *KeeperTestSuite) TestCollectFeeEmptyFee() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 1, raws)
+	receipt, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 1, raws)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(receipt.Escrowed)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
+	receipt, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(receipt.Escrowed)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 2, raws)
+	receipt, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 2, raws)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(receipt.Escrowed)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 2, raws)
+	receipt, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 2, raws)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(receipt.Escrowed)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
@@ -1267,10 +1273,10 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(nil),
 	)
 
@@ -1282,9 +1288,9 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 1, raws)
+	receipt, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 1, raws)
 	require.NoError(err)
-	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))), coins)
+	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))), receipt.Escrowed)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
@@ -1294,10 +1300,10 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
 			Return(nil),
 	)
 
@@ -1309,9 +1315,9 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 4, raws)
+	receipt, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 4, raws)
 	require.NoError(err)
-	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))), coins)
+	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))), receipt.Escrowed)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
@@ -1320,7 +1326,7 @@ func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
 	require := suite.Require()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 		Return(nil)
 
 	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
@@ -1331,13 +1337,13 @@ func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.EmptyCoins, 1, raws)
+	receipt, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.EmptyCoins, 1, raws)
 	require.ErrorIs(err, types.ErrNotEnoughFee)
-	require.Nil(coins)
+	require.Empty(receipt.Escrowed)
 
-	coins, err = k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins1band, 1, raws)
+	receipt, err = k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins1band, 1, raws)
 	require.ErrorIs(err, types.ErrNotEnoughFee)
-	require.Nil(coins)
+	require.Empty(receipt.Escrowed)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance() {
@@ -1347,10 +1353,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoins(gomock.Any(), bandtesting.Alice.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoins(gomock.Any(), bandtesting.Alice.Address, types.FeeEscrowAddress, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"spendable balance %s is smaller than %s",
@@ -1366,8 +1372,8 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
-	require.Nil(coins)
+	receipt, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
+	require.Empty(receipt.Escrowed)
 	// MAX is 100m but have only 1m in account
 	// First ds collect 1m so there no balance enough for next ds but it doesn't touch limit
 	require.EqualError(err, "spendable balance 0uband is smaller than 2band: insufficient funds")
@@ -1380,10 +1386,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
+			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, types.FeeEscrowAddress, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
 			Return(nil),
 	)
 
@@ -1395,7 +1401,7 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(
+	receipt, err := k.CollectFee(
 		ctx,
 		bandtesting.FeePayer.Address,
 		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(1000000000)), sdk.NewCoin("uabc", math.NewInt(1000000))),
@@ -1407,6 +1413,6 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 	// Coins sum is correct
 	require.True(
 		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000)), sdk.NewCoin("uabc", math.NewInt(1000000))).
-			Equal(coins),
+			Equal(receipt.Escrowed),
 	)
 }
@@ -80,6 +80,11 @@ func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 			}
 		}
 
+		// Pay the data source treasuries for the delivered reports and refund the rest of the
+		// escrowed fees. This is done at expiration rather than at resolve, so that the reports
+		// delivered after the request is resolved are paid for as well.
+		k.safeSettleFee(ctx, currentReqID)
+
 		// Cleanup request and reports
 		k.DeleteRequest(ctx, currentReqID)
 		k.DeleteReports(ctx, currentReqID)
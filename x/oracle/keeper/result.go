@@ -9,6 +9,7 @@ import (
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,6 +31,11 @@ func (k Keeper) SetResult(ctx sdk.Context, reqID types.RequestID, result types.R
 	ctx.KVStore(k.storeKey).Set(types.ResultStoreKey(reqID), k.cdc.MustMarshal(&result))
 }
 
+// DeleteResult removes the result of the given request ID from the store.
+func (k Keeper) DeleteResult(ctx sdk.Context, reqID types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.ResultStoreKey(reqID))
+}
+
 // MarshalResult marshal the result
 func (k Keeper) MarshalResult(ctx sdk.Context, result types.Result) ([]byte, error) {
 	return k.cdc.Marshal(&result)
@@ -66,6 +72,7 @@ func (k Keeper) ResolveSuccess(
 	encoder types.Encoder,
 ) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_SUCCESS, result)
+	k.runResultCallback(ctx, id, "")
 
 	event := sdk.NewEvent(
 		types.EventTypeResolve,
@@ -163,6 +170,7 @@ func (k Keeper) handleCreateSigningFailed(
 // ResolveFailure resolves the given request as failure with the given reason.
 func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason string) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_FAILURE, []byte{})
+	k.runResultCallback(ctx, id, reason)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -174,6 +182,7 @@ func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason strin
 // ResolveExpired resolves the given request as expired.
 func (k Keeper) ResolveExpired(ctx sdk.Context, id types.RequestID) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_EXPIRED, []byte{})
+	k.runResultCallback(ctx, id, "")
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -187,7 +196,7 @@ func (k Keeper) SaveResult(
 ) {
 	r := k.MustGetRequest(ctx, id)
 	reportCount := k.GetReportCount(ctx, id)
-	k.SetResult(ctx, id, types.NewResult(
+	res := types.NewResult(
 		r.ClientID,                         // ClientID
 		r.OracleScriptID,                   // OracleScriptID
 		r.Calldata,                         // Calldata
@@ -199,7 +208,9 @@ func (k Keeper) SaveResult(
 		ctx.BlockTime().Unix(),             // ResolveTime
 		status,                             // ResolveStatus
 		result,                             // Result
-	))
+	)
+	k.SetResult(ctx, id, res)
+	k.indexLatestResult(ctx, res)
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -234,3 +245,79 @@ func (k Keeper) SaveResult(
 		}
 	}
 }
+
+// PruneResults removes the results of expired requests that are out of the retention, in the order
+// of their request IDs and up to the maximum number of results per block. The signing results of
+// the requests and their settled fee receipts are removed along with them, and so are the latest result
+// indexes that still point to them. An unsettled fee receipt is kept for its pending settlement retry,
+// which removes it once the fee is settled.
+func (k Keeper) PruneResults(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	requestCount := k.GetRequestCount(ctx)
+	lastExpired := k.GetRequestLastExpired(ctx)
+
+	currentReqID := k.GetResultLastPruned(ctx) + 1
+	for pruned := uint64(0); pruned < params.MaxResultPrunesPerBlock && currentReqID <= lastExpired; pruned++ {
+		// Results are resolved roughly in the order of their request IDs, so we stop at the first
+		// result that is still retained and leave the later ones for the next blocks.
+		if result, err := k.GetResult(ctx, currentReqID); err == nil {
+			if k.isResultRetained(ctx, params, requestCount, result) {
+				break
+			}
+			k.unindexLatestResult(ctx, result)
+		}
+
+		k.DeleteResult(ctx, currentReqID)
+		k.DeleteSigningResult(ctx, currentReqID)
+		if receipt, err := k.GetFeeReceipt(ctx, currentReqID); err == nil && receipt.Settled {
+			k.DeleteFeeReceipt(ctx, currentReqID)
+		}
+		k.SetResultLastPruned(ctx, currentReqID)
+		currentReqID++
+	}
+}
+
+// GetRetainedResults returns the results in the store that are within the retention, including
+// the ones that are not yet pruned because of the limit of pruning per block.
+func (k Keeper) GetRetainedResults(ctx sdk.Context) []types.Result {
+	params := k.GetParams(ctx)
+	requestCount := k.GetRequestCount(ctx)
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.ResultStoreKey(k.GetResultLastPruned(ctx)+1),
+		storetypes.PrefixEndBytes(types.ResultStoreKeyPrefix),
+	)
+	defer iterator.Close()
+
+	results := []types.Result{}
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.Result
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		if k.isResultRetained(ctx, params, requestCount, result) {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// isResultRetained checks if the given result is within both the retention period and the
+// retention count. A zero retention period or count means the result is kept regardless of it.
+func (k Keeper) isResultRetained(
+	ctx sdk.Context,
+	params types.Params,
+	requestCount uint64,
+	result types.Result,
+) bool {
+	if params.ResultRetentionPeriod != 0 {
+		retention := int64(params.ResultRetentionPeriod / uint64(time.Second))
+		if result.ResolveTime+retention < ctx.BlockTime().Unix() {
+			return false
+		}
+	}
+
+	if params.ResultRetentionCount != 0 && uint64(result.RequestID)+params.ResultRetentionCount <= requestCount {
+		return false
+	}
+
+	return true
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"go.uber.org/mock/gomock"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -233,3 +235,124 @@ func (suite *KeeperTestSuite) TestResolveExpired() {
 		sdk.NewAttribute(types.AttributeKeyResolveStatus, "3"),
 	)}, ctx.EventManager().Events())
 }
+
+func (suite *KeeperTestSuite) setResultsForPruning(ctx sdk.Context, resolveTimes ...int64) {
+	k := suite.oracleKeeper
+	for idx, resolveTime := range resolveTimes {
+		rid := types.RequestID(idx + 1)
+		k.SetResult(ctx, rid, types.NewResult(
+			basicClientID, 1, basicCalldata, 1, 1, rid, 1, resolveTime, resolveTime,
+			types.RESOLVE_STATUS_SUCCESS, basicResult,
+		))
+		k.SetSigningResult(ctx, rid, types.SigningResult{SigningID: bandtsstypes.SigningID(rid)})
+	}
+	k.SetRequestCount(ctx, uint64(len(resolveTimes)))
+}
+
+func (suite *KeeperTestSuite) TestPruneResultsByAge() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1000))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = uint64(100 * time.Second)
+	params.ResultRetentionCount = 0
+	params.MaxResultPrunesPerBlock = 2
+	require.NoError(k.SetParams(ctx, params))
+
+	suite.setResultsForPruning(ctx, 100, 200, 300, 950, 100)
+	// request#5 is out of the retention period, but it is not yet expired.
+	k.SetRequestLastExpired(ctx, 4)
+
+	// only two results are pruned per block.
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(2), k.GetResultLastPruned(ctx))
+	require.False(k.HasResult(ctx, 1))
+	require.False(k.HasResult(ctx, 2))
+	require.True(k.HasResult(ctx, 3))
+	_, err := k.GetSigningResult(ctx, 1)
+	require.ErrorIs(err, types.ErrSigningResultNotFound)
+
+	// request#4 is still within the retention period, so the pruning stops there.
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(3), k.GetResultLastPruned(ctx))
+	require.True(k.HasResult(ctx, 4))
+	require.True(k.HasResult(ctx, 5))
+
+	k.PruneResults(ctx.WithBlockTime(bandtesting.ParseTime(1051)))
+	require.Equal(types.RequestID(4), k.GetResultLastPruned(ctx))
+	require.True(k.HasResult(ctx, 5))
+}
+
+func (suite *KeeperTestSuite) TestPruneResultsByCount() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1000))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = 0
+	params.ResultRetentionCount = 2
+	params.MaxResultPrunesPerBlock = 10
+	require.NoError(k.SetParams(ctx, params))
+
+	suite.setResultsForPruning(ctx, 100, 200, 300, 400, 500)
+	k.SetRequestLastExpired(ctx, 5)
+
+	// only the results of the two most recent requests are kept.
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(3), k.GetResultLastPruned(ctx))
+	require.False(k.HasResult(ctx, 3))
+	require.True(k.HasResult(ctx, 4))
+	require.True(k.HasResult(ctx, 5))
+
+	// disabling both retentions keeps all the results.
+	params.ResultRetentionCount = 0
+	require.NoError(k.SetParams(ctx, params))
+	k.SetRequestCount(ctx, 100)
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(3), k.GetResultLastPruned(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetRetainedResults() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1000))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = uint64(500 * time.Second)
+	params.ResultRetentionCount = 3
+	require.NoError(k.SetParams(ctx, params))
+
+	suite.setResultsForPruning(ctx, 100, 600, 700, 400, 800)
+	k.SetRequestLastExpired(ctx, 5)
+
+	// request#1 is out of both retentions, request#2 is out of the retention count and request#4
+	// is out of the retention period, although none of them are pruned yet.
+	results := k.GetRetainedResults(ctx)
+	require.Len(results, 2)
+	require.Equal(types.RequestID(3), results[0].RequestID)
+	require.Equal(types.RequestID(5), results[1].RequestID)
+}
+
+func (suite *KeeperTestSuite) TestQueryPrunedRequest() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1000))
+	k := suite.oracleKeeper
+	q := suite.queryClient
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.ResultRetentionPeriod = 0
+	params.ResultRetentionCount = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	suite.setResultsForPruning(ctx, 100, 200)
+	k.SetRequestLastExpired(ctx, 2)
+	k.PruneResults(ctx)
+
+	_, err := q.Request(ctx, &types.QueryRequestRequest{RequestId: 1})
+	require.ErrorContains(err, types.ErrResultPruned.Error())
+
+	res, err := q.Request(ctx, &types.QueryRequestRequest{RequestId: 2})
+	require.NoError(err)
+	require.Equal(types.RequestID(2), res.Result.RequestID)
+}
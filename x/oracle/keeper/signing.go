@@ -24,3 +24,8 @@ func (k Keeper) GetSigningResult(ctx sdk.Context, rid types.RequestID) (types.Si
 	k.cdc.MustUnmarshal(bz, &result)
 	return result, nil
 }
+
+// DeleteSigningResult removes the signing result associated with the given request ID from the store.
+func (k Keeper) DeleteSigningResult(ctx sdk.Context, rid types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.SigningResultStoreKey(rid))
+}
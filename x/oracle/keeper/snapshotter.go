@@ -72,6 +72,21 @@ func (os *OracleSnapshotter) SnapshotExtension(height uint64, payloadWriter snap
 		}
 	}
 
+	// write all earlier versions of oracle scripts and data sources to snapshot, as pending requests
+	// and requests of a pinned version still execute them
+	oracleScriptVersions := os.keeper.GetAllOracleScriptVersions(ctx)
+	for _, oracleScriptVersion := range oracleScriptVersions {
+		if err := writeFileToSnapshot(payloadWriter, oracleScriptVersion.Filename, os.keeper, seenBefore); err != nil {
+			return err
+		}
+	}
+	dataSourceVersions := os.keeper.GetAllDataSourceVersions(ctx)
+	for _, dataSourceVersion := range dataSourceVersions {
+		if err := writeFileToSnapshot(payloadWriter, dataSourceVersion.Filename, os.keeper, seenBefore); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -110,6 +125,14 @@ func (os *OracleSnapshotter) processAllItems(
 	for _, dataSource := range dataSources {
 		foundCode[dataSource.Filename] = false
 	}
+	oracleScriptVersions := os.keeper.GetAllOracleScriptVersions(ctx)
+	for _, oracleScriptVersion := range oracleScriptVersions {
+		foundCode[oracleScriptVersion.Filename] = false
+	}
+	dataSourceVersions := os.keeper.GetAllDataSourceVersions(ctx)
+	for _, dataSourceVersion := range dataSourceVersions {
+		foundCode[dataSourceVersion.Filename] = false
+	}
 
 	for {
 		payload, err := payloadReader()
@@ -13,6 +13,7 @@ import (
 
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
 func TestSnapshotter(t *testing.T) {
@@ -22,6 +23,20 @@ func TestSnapshotter(t *testing.T) {
 	srcCtx := srcApp.BaseApp.NewUncachedContext(false, cmtproto.Header{})
 	srcKeeper := srcApp.OracleKeeper
 
+	// add versions whose files are no longer used by the current data sources and oracle scripts
+	srcKeeper.SetDataSourceVersion(srcCtx, types.NewDataSourceVersion(
+		1, 2, srcKeeper.AddExecutableFile([]byte("data source version 2")), bandtesting.Owner.Address.String(), 1, "",
+	))
+	srcKeeper.SetOracleScriptVersion(srcCtx, types.NewOracleScriptVersion(
+		1,
+		2,
+		srcKeeper.AddExecutableFile([]byte("oracle script version 2")),
+		"",
+		bandtesting.Owner.Address.String(),
+		1,
+		"",
+	))
+
 	// create snapshot
 	_, err := srcApp.Commit()
 	require.NoError(t, err)
@@ -66,6 +81,12 @@ func getMappingHashToCode(ctx sdk.Context, keeper *keeper.Keeper) map[string][]b
 	for _, dataSource := range dataSources {
 		hashToCode[dataSource.Filename] = keeper.GetFile(dataSource.Filename)
 	}
+	for _, oracleScriptVersion := range keeper.GetAllOracleScriptVersions(ctx) {
+		hashToCode[oracleScriptVersion.Filename] = keeper.GetFile(oracleScriptVersion.Filename)
+	}
+	for _, dataSourceVersion := range keeper.GetAllDataSourceVersions(ctx) {
+		hashToCode[dataSourceVersion.Filename] = keeper.GetFile(dataSourceVersion.Filename)
+	}
 
 	return hashToCode
 }
@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// GetSubscriptionCount returns the number of subscriptions that have been created.
+func (k Keeper) GetSubscriptionCount(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.SubscriptionCountStoreKey))
+}
+
+// SetSubscriptionCount sets the number of subscriptions to the given value.
+func (k Keeper) SetSubscriptionCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.SubscriptionCountStoreKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetNextSubscriptionID increments and returns the current number of subscriptions.
+func (k Keeper) GetNextSubscriptionID(ctx sdk.Context) types.SubscriptionID {
+	subscriptionCount := k.GetSubscriptionCount(ctx)
+	k.SetSubscriptionCount(ctx, subscriptionCount+1)
+	return types.SubscriptionID(subscriptionCount + 1)
+}
+
+// HasSubscription checks if the subscription of this ID exists in the storage.
+func (k Keeper) HasSubscription(ctx sdk.Context, id types.SubscriptionID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.SubscriptionStoreKey(id))
+}
+
+// GetSubscription returns the subscription struct for the given ID or error if not exists.
+func (k Keeper) GetSubscription(ctx sdk.Context, id types.SubscriptionID) (types.Subscription, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SubscriptionStoreKey(id))
+	if bz == nil {
+		return types.Subscription{}, types.ErrSubscriptionNotFound.Wrapf("id: %d", id)
+	}
+	var subscription types.Subscription
+	k.cdc.MustUnmarshal(bz, &subscription)
+	return subscription, nil
+}
+
+// SetSubscription saves the given subscription to the storage without performing validation.
+func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
+	ctx.KVStore(k.storeKey).Set(types.SubscriptionStoreKey(subscription.ID), k.cdc.MustMarshal(&subscription))
+}
+
+// DeleteSubscription removes the subscription and its schedule queue entry from the storage.
+func (k Keeper) DeleteSubscription(ctx sdk.Context, subscription types.Subscription) {
+	k.dequeueSubscription(ctx, subscription)
+	ctx.KVStore(k.storeKey).Delete(types.SubscriptionStoreKey(subscription.ID))
+}
+
+// GetAllSubscriptions returns the list of all subscriptions in the store, or nil if there is none.
+func (k Keeper) GetAllSubscriptions(ctx sdk.Context) (subscriptions []types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SubscriptionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subscription types.Subscription
+		k.cdc.MustUnmarshal(iterator.Value(), &subscription)
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions
+}
+
+// EnqueueSubscription adds the active subscription to the schedule queue at its next run.
+func (k Keeper) EnqueueSubscription(ctx sdk.Context, subscription types.Subscription) {
+	if subscription.Status != types.SUBSCRIPTION_STATUS_ACTIVE {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(
+		types.SubscriptionQueueStoreKey(subscription.IntervalUnit, subscription.NextRun, subscription.ID),
+		[]byte{},
+	)
+}
+
+// dequeueSubscription removes the subscription from the schedule queue.
+func (k Keeper) dequeueSubscription(ctx sdk.Context, subscription types.Subscription) {
+	ctx.KVStore(k.storeKey).Delete(
+		types.SubscriptionQueueStoreKey(subscription.IntervalUnit, subscription.NextRun, subscription.ID),
+	)
+}
+
+// AddSubscription creates a new active subscription owned by the given owner with a newly generated
+// deposit account. The first request of the subscription is made at the end of the current block.
+func (k Keeper) AddSubscription(ctx sdk.Context, subscription types.Subscription) (types.Subscription, error) {
+	id := k.GetNextSubscriptionID(ctx)
+
+	depositAccount, err := k.GenerateSubscriptionAccount(ctx, fmt.Sprintf("%d", id))
+	if err != nil {
+		return types.Subscription{}, err
+	}
+
+	subscription.ID = id
+	subscription.DepositAccount = depositAccount.String()
+	subscription.Runs = 0
+	subscription.Status = types.SUBSCRIPTION_STATUS_ACTIVE
+	subscription.NextRun = subscription.CurrentRun(ctx)
+
+	k.SetSubscription(ctx, subscription)
+	k.EnqueueSubscription(ctx, subscription)
+
+	return subscription, nil
+}
+
+// UpdateSubscription replaces the request template and the schedule of the subscription. The
+// subscription becomes active and makes its next request at the end of the current block.
+func (k Keeper) UpdateSubscription(ctx sdk.Context, id types.SubscriptionID, new types.Subscription) error {
+	subscription, err := k.GetSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if new.MaxRuns != 0 && new.MaxRuns <= subscription.Runs {
+		return types.ErrInvalidMaxRuns.Wrapf("max runs %d must exceed runs %d", new.MaxRuns, subscription.Runs)
+	}
+
+	k.dequeueSubscription(ctx, subscription)
+
+	new.ID = subscription.ID
+	new.Owner = subscription.Owner
+	new.DepositAccount = subscription.DepositAccount
+	new.Runs = subscription.Runs
+	new.Status = types.SUBSCRIPTION_STATUS_ACTIVE
+	new.NextRun = new.CurrentRun(ctx)
+
+	k.SetSubscription(ctx, new)
+	k.EnqueueSubscription(ctx, new)
+
+	return nil
+}
+
+// CloseSubscription removes the subscription and refunds the remaining deposit to its owner.
+func (k Keeper) CloseSubscription(ctx sdk.Context, subscription types.Subscription) (sdk.Coins, error) {
+	depositAccount, err := sdk.AccAddressFromBech32(subscription.DepositAccount)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(subscription.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := k.bankKeeper.GetAllBalances(ctx, depositAccount)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, depositAccount, owner, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteSubscription(ctx, subscription)
+	return refund, nil
+}
+
+// ProcessSubscriptions makes the requests of the subscriptions that are due in this block. At most
+// MaxSubscriptionRunsPerBlock subscriptions are processed; the rest are carried over to the next blocks.
+func (k Keeper) ProcessSubscriptions(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxSubscriptionRunsPerBlock
+
+	var dueIDs []types.SubscriptionID
+	for _, unit := range []types.IntervalUnit{types.INTERVAL_UNIT_BLOCK, types.INTERVAL_UNIT_SECOND} {
+		current := ctx.BlockHeight()
+		if unit == types.INTERVAL_UNIT_SECOND {
+			current = ctx.BlockTime().Unix()
+		}
+
+		dueIDs = append(dueIDs, k.getDueSubscriptionIDs(ctx, unit, current, limit-uint64(len(dueIDs)))...)
+	}
+
+	for _, id := range dueIDs {
+		subscription, err := k.GetSubscription(ctx, id)
+		if err != nil {
+			// this should not happen; the queue entry is removed along with the subscription.
+			continue
+		}
+
+		k.dequeueSubscription(ctx, subscription)
+		k.runSubscription(ctx, subscription)
+	}
+}
+
+// getDueSubscriptionIDs returns the IDs of at most limit subscriptions of the given interval unit
+// whose next run is at or before current.
+func (k Keeper) getDueSubscriptionIDs(
+	ctx sdk.Context,
+	unit types.IntervalUnit,
+	current int64,
+	limit uint64,
+) []types.SubscriptionID {
+	if limit == 0 || current < 0 {
+		return nil
+	}
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.SubscriptionQueueUnitPrefixKey(unit),
+		types.SubscriptionQueueStoreKey(unit, current+1, 0),
+	)
+	defer iterator.Close()
+
+	var ids []types.SubscriptionID
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, types.SubscriptionID(sdk.BigEndianToUint64(key[len(key)-8:])))
+	}
+	return ids
+}
+
+// runSubscription makes a request of the subscription paid from its deposit account and schedules
+// the next one. The request is made without a transaction, so the gas it uses is also paid from the
+// deposit at the subscription gas prices. The subscription is paused if the deposit cannot cover the
+// fees or the request cannot be made, and is closed once it has made all of its requests.
+func (k Keeper) runSubscription(ctx sdk.Context, subscription types.Subscription) {
+	depositAccount := sdk.MustAccAddressFromBech32(subscription.DepositAccount)
+
+	// the request is made in a cache context so that a failed request leaves no trace in the state.
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	var fee sdk.Coins
+	reqID, err := k.PrepareRequest(cacheCtx, &subscription, depositAccount, nil)
+	gasUsed := gasMeter.GasConsumed()
+	if err == nil {
+		// the deposit is refunded to the owner when the subscription is closed, so is the unused
+		// data source fees of its requests.
+		k.setFeeRefundAddress(cacheCtx, reqID, subscription.Owner)
+		fee, err = k.chargeSubscriptionGas(cacheCtx, depositAccount, gasUsed)
+	}
+
+	switch {
+	case err == nil:
+		writeCache()
+		subscription.Runs++
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubscriptionRequest,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", reqID)),
+			sdk.NewAttribute(types.AttributeKeyRuns, fmt.Sprintf("%d", subscription.Runs)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		))
+	case errors.Is(err, types.ErrInsufficientValidators):
+		// not enough active validators is transient, so only this run is skipped.
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubscriptionFail,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+	default:
+		// other failures, e.g. insufficient funds or a fee limit that is too low, fail every run until
+		// the owner tops up the deposit or updates the subscription, so the subscription is paused.
+		subscription.Status = types.SUBSCRIPTION_STATUS_PAUSED
+		k.SetSubscription(ctx, subscription)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePauseSubscription,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	if subscription.IsCompleted() {
+		refund, err := k.CloseSubscription(ctx, subscription)
+		if err != nil {
+			// keep the subscription; the owner can still cancel it to get the deposit back.
+			k.Logger(ctx).Error(fmt.Sprintf("failed to close subscription %d: %s", subscription.ID, err))
+			subscription.Status = types.SUBSCRIPTION_STATUS_PAUSED
+			k.SetSubscription(ctx, subscription)
+			return
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCompleteSubscription,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", subscription.ID)),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		))
+		return
+	}
+
+	subscription.NextRun = subscription.CurrentRun(ctx) + int64(subscription.Interval)
+	k.SetSubscription(ctx, subscription)
+	k.EnqueueSubscription(ctx, subscription)
+}
+
+// chargeSubscriptionGas sends the fee of the given gas at the subscription gas prices from the deposit
+// account to the fee collector.
+func (k Keeper) chargeSubscriptionGas(ctx sdk.Context, depositAccount sdk.AccAddress, gas uint64) (sdk.Coins, error) {
+	var fee sdk.Coins
+	for _, gasPrice := range k.GetParams(ctx).SubscriptionGasPrices {
+		amount := gasPrice.Amount.MulInt64(int64(gas)).Ceil().RoundInt()
+		fee = fee.Add(sdk.NewCoin(gasPrice.Denom, amount))
+	}
+	if fee.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositAccount, k.feeCollectorName, fee); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// GenerateSubscriptionAccount generates a new deposit account for a subscription.
+func (k Keeper) GenerateSubscriptionAccount(ctx sdk.Context, key string) (sdk.AccAddress, error) {
+	header := ctx.BlockHeader()
+
+	buf := []byte(key)
+	buf = append(buf, header.AppHash...)
+	buf = append(buf, header.DataHash...)
+
+	moduleCred, err := authtypes.NewModuleCredential(types.ModuleName, []byte(types.SubscriptionAccountsKey), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr := sdk.AccAddress(moduleCred.Address())
+	if acc := k.authKeeper.GetAccount(ctx, accAddr); acc != nil {
+		// this should not happen
+		return nil, types.ErrAccountAlreadyExist.Wrapf(
+			"existing account for newly generated key account address %s",
+			accAddr.String(),
+		)
+	}
+
+	acc, err := authtypes.NewBaseAccountWithPubKey(moduleCred)
+	if err != nil {
+		return nil, err
+	}
+
+	k.authKeeper.NewAccount(ctx, acc)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	return accAddr, nil
+}
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// addSubscription adds a subscription of oracle script#1 made every 10 blocks with the given max runs.
+func (suite *KeeperTestSuite) addSubscription(ctx sdk.Context, maxRuns uint64) types.Subscription {
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil)
+	suite.authKeeper.EXPECT().NewAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, acc sdk.AccountI) sdk.AccountI { return acc },
+	)
+	suite.authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+
+	subscription, err := suite.oracleKeeper.AddSubscription(ctx, types.NewSubscription(
+		0, alice.String(), "", 1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, 10, types.INTERVAL_UNIT_BLOCK, maxRuns, 0, 0,
+		types.SUBSCRIPTION_STATUS_UNSPECIFIED, 0,
+	))
+	suite.Require().NoError(err)
+
+	return subscription
+}
+
+func (suite *KeeperTestSuite) TestAddSubscription() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	subscription := suite.addSubscription(ctx, 0)
+	require.Equal(types.SubscriptionID(1), subscription.ID)
+	require.Equal(uint64(1), k.GetSubscriptionCount(ctx))
+	require.Equal(types.SUBSCRIPTION_STATUS_ACTIVE, subscription.Status)
+	require.Equal(int64(42), subscription.NextRun)
+	require.NotEmpty(subscription.DepositAccount)
+
+	got, err := k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(subscription, got)
+
+	_, err = k.GetSubscription(ctx, 2)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptions() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	subscription := suite.addSubscription(ctx, 2)
+	depositAccount := sdk.MustAccAddressFromBech32(subscription.DepositAccount)
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), depositAccount, types.FeeEscrowAddress, bandtesting.Coins1band).Times(6)
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), depositAccount, authtypes.FeeCollectorName, gomock.Any()).
+		Times(2)
+
+	// the first request is made at the block the subscription is created.
+	k.ProcessSubscriptions(ctx)
+	req := k.MustGetRequest(ctx, 1)
+	require.Equal(subscription.DepositAccount, req.Requester)
+	require.Equal(basicClientID, req.ClientID)
+
+	// the unused fees of the request are refunded to the owner rather than the deposit account.
+	receipt, err := k.GetFeeReceipt(ctx, 1)
+	require.NoError(err)
+	require.Equal(subscription.DepositAccount, receipt.Payer)
+	require.Equal(alice.String(), receipt.RefundAddress)
+
+	subscription, err = k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), subscription.Runs)
+	require.Equal(int64(52), subscription.NextRun)
+
+	// the gas used depends on the size of the validator statuses, which store the activation time.
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(types.EventTypeSubscriptionRequest, event.Type)
+	require.Equal([]abci.EventAttribute{
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, "1").ToKVPair(),
+		sdk.NewAttribute(types.AttributeKeyID, "1").ToKVPair(),
+		sdk.NewAttribute(types.AttributeKeyRuns, "1").ToKVPair(),
+	}, event.Attributes[:3])
+	require.Equal(types.AttributeKeyGasUsed, event.Attributes[3].Key)
+	require.Equal(sdk.NewAttribute(types.AttributeKeyFee, "1212uband").ToKVPair(), event.Attributes[4])
+
+	// nothing is due before the next run.
+	k.ProcessSubscriptions(ctx.WithBlockHeight(51))
+	require.Equal(uint64(1), k.GetRequestCount(ctx))
+
+	// the last run completes the subscription and refunds the remaining deposit.
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), depositAccount).Return(bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), depositAccount, alice, bandtesting.Coins1band)
+
+	k.ProcessSubscriptions(ctx.WithBlockHeight(52))
+	require.Equal(uint64(2), k.GetRequestCount(ctx))
+	require.False(k.HasSubscription(ctx, 1))
+
+	k.ProcessSubscriptions(ctx.WithBlockHeight(62))
+	require.Equal(uint64(2), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsPauseOnInsufficientFunds() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	subscription := suite.addSubscription(ctx, 0)
+	depositAccount := sdk.MustAccAddressFromBech32(subscription.DepositAccount)
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), depositAccount, types.FeeEscrowAddress, bandtesting.Coins1band).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	k.ProcessSubscriptions(ctx)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	subscription, err := k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.SUBSCRIPTION_STATUS_PAUSED, subscription.Status)
+	require.Equal(uint64(0), subscription.Runs)
+
+	// a paused subscription is no longer scheduled.
+	k.ProcessSubscriptions(ctx.WithBlockHeight(100))
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	// updating the subscription makes it active again.
+	err = k.UpdateSubscription(ctx.WithBlockHeight(100), 1, subscription)
+	require.NoError(err)
+
+	subscription, err = k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.SUBSCRIPTION_STATUS_ACTIVE, subscription.Status)
+	require.Equal(int64(100), subscription.NextRun)
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsPauseOnRequestError() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.MaxCalldataSize = 1
+	params.MaxReportDataSize = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	// the calldata is too large, so the request would fail at every run.
+	suite.addSubscription(ctx, 0)
+	k.ProcessSubscriptions(ctx)
+
+	subscription, err := k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.SUBSCRIPTION_STATUS_PAUSED, subscription.Status)
+	require.Equal(types.EventTypePauseSubscription, ctx.EventManager().Events()[0].Type)
+
+	k.ProcessSubscriptions(ctx.WithBlockHeight(52))
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessSubscriptionsLimitPerBlock() {
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.MaxSubscriptionRunsPerBlock = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	// no validator is active, so every run fails but is still rescheduled.
+	suite.addSubscription(ctx, 0)
+	suite.addSubscription(ctx, 0)
+
+	k.ProcessSubscriptions(ctx)
+
+	first, err := k.GetSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(int64(52), first.NextRun)
+
+	second, err := k.GetSubscription(ctx, 2)
+	require.NoError(err)
+	require.Equal(int64(42), second.NextRun)
+
+	// the remaining subscription is carried over to the next block.
+	k.ProcessSubscriptions(ctx.WithBlockHeight(43))
+	second, err = k.GetSubscription(ctx, 2)
+	require.NoError(err)
+	require.Equal(int64(53), second.NextRun)
+}
+
+func (suite *KeeperTestSuite) TestUpdateSubscriptionInvalidMaxRuns() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	subscription := suite.addSubscription(ctx, 0)
+	subscription.Runs = 3
+	k.SetSubscription(ctx, subscription)
+
+	subscription.MaxRuns = 3
+	err := k.UpdateSubscription(ctx, 1, subscription)
+	require.ErrorIs(err, types.ErrInvalidMaxRuns)
+
+	subscription.MaxRuns = 4
+	err = k.UpdateSubscription(ctx, 1, subscription)
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCloseSubscription() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	subscription := suite.addSubscription(ctx, 0)
+	depositAccount := sdk.MustAccAddressFromBech32(subscription.DepositAccount)
+
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), depositAccount).Return(bandtesting.Coins100band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), depositAccount, alice, bandtesting.Coins100band)
+
+	refund, err := k.CloseSubscription(ctx, subscription)
+	require.NoError(err)
+	require.Equal(bandtesting.Coins100band, refund)
+	require.False(k.HasSubscription(ctx, 1))
+	require.Empty(k.GetAllSubscriptions(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQuerySubscriptions() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	q := suite.queryClient
+	require := suite.Require()
+
+	suite.addSubscription(ctx, 0)
+	subscription := suite.addSubscription(ctx, 0)
+	subscription.Owner = owner.String()
+	suite.oracleKeeper.SetSubscription(ctx, subscription)
+
+	res, err := q.Subscription(ctx, &types.QuerySubscriptionRequest{SubscriptionId: 2})
+	require.NoError(err)
+	require.Equal(subscription, res.Subscription)
+
+	_, err = q.Subscription(ctx, &types.QuerySubscriptionRequest{SubscriptionId: 3})
+	require.ErrorContains(err, types.ErrSubscriptionNotFound.Error())
+
+	all, err := q.Subscriptions(ctx, &types.QuerySubscriptionsRequest{})
+	require.NoError(err)
+	require.Len(all.Subscriptions, 2)
+
+	owned, err := q.Subscriptions(ctx, &types.QuerySubscriptionsRequest{Owner: owner.String()})
+	require.NoError(err)
+	require.Equal([]types.Subscription{subscription}, owned.Subscriptions)
+}
+
+func (suite *KeeperTestSuite) TestGenerateSubscriptionAccount() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(authtypes.NewBaseAccountWithAddress(alice))
+
+	_, err := k.GenerateSubscriptionAccount(ctx, "1")
+	require.ErrorIs(err, types.ErrAccountAlreadyExist)
+}
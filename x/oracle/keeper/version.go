@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// HasDataSourceVersion checks if the given version of a data source exists in the storage.
+func (k Keeper) HasDataSourceVersion(ctx sdk.Context, id types.DataSourceID, version uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DataSourceVersionStoreKey(id, version))
+}
+
+// GetDataSourceVersion returns the given version of a data source or error if not exists.
+func (k Keeper) GetDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DataSourceVersionStoreKey(id, version))
+	if bz == nil {
+		return types.DataSourceVersion{}, types.ErrDataSourceVersionNotFound.Wrapf("id: %d, version: %d", id, version)
+	}
+	var dataSourceVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(bz, &dataSourceVersion)
+	return dataSourceVersion, nil
+}
+
+// GetLatestDataSourceVersion returns the latest version of a data source or error if there is none.
+func (k Keeper) GetLatestDataSourceVersion(ctx sdk.Context, id types.DataSourceID) (types.DataSourceVersion, error) {
+	iterator := storetypes.KVStoreReversePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.DataSourceVersionsPrefixKey(id),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.DataSourceVersion{}, types.ErrDataSourceVersionNotFound.Wrapf("id: %d", id)
+	}
+	var dataSourceVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &dataSourceVersion)
+	return dataSourceVersion, nil
+}
+
+// SetDataSourceVersion saves the given version of a data source to the storage.
+func (k Keeper) SetDataSourceVersion(ctx sdk.Context, dataSourceVersion types.DataSourceVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.DataSourceVersionStoreKey(dataSourceVersion.DataSourceID, dataSourceVersion.Version),
+		k.cdc.MustMarshal(&dataSourceVersion),
+	)
+}
+
+// GetAllDataSourceVersions returns the list of all versions of all data sources in the store.
+func (k Keeper) GetAllDataSourceVersions(ctx sdk.Context) (dataSourceVersions []types.DataSourceVersion) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DataSourceVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var dataSourceVersion types.DataSourceVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &dataSourceVersion)
+		dataSourceVersions = append(dataSourceVersions, dataSourceVersion)
+	}
+	return dataSourceVersions
+}
+
+// addDataSourceVersion appends a new version of a data source with the given filename.
+func (k Keeper) addDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	filename string,
+	editor string,
+	changelog string,
+) types.DataSourceVersion {
+	version := uint64(1)
+	if latest, err := k.GetLatestDataSourceVersion(ctx, id); err == nil {
+		version = latest.Version + 1
+	}
+	dataSourceVersion := types.NewDataSourceVersion(id, version, filename, editor, ctx.BlockHeight(), changelog)
+	k.SetDataSourceVersion(ctx, dataSourceVersion)
+	return dataSourceVersion
+}
+
+// HasOracleScriptVersion checks if the given version of an oracle script exists in the storage.
+func (k Keeper) HasOracleScriptVersion(ctx sdk.Context, id types.OracleScriptID, version uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.OracleScriptVersionStoreKey(id, version))
+}
+
+// GetOracleScriptVersion returns the given version of an oracle script or error if not exists.
+func (k Keeper) GetOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OracleScriptVersionStoreKey(id, version))
+	if bz == nil {
+		return types.OracleScriptVersion{}, types.ErrOracleScriptVersionNotFound.Wrapf(
+			"id: %d, version: %d", id, version,
+		)
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(bz, &oracleScriptVersion)
+	return oracleScriptVersion, nil
+}
+
+// GetLatestOracleScriptVersion returns the latest version of an oracle script or error if there is none.
+func (k Keeper) GetLatestOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+) (types.OracleScriptVersion, error) {
+	iterator := storetypes.KVStoreReversePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.OracleScriptVersionsPrefixKey(id),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.OracleScriptVersion{}, types.ErrOracleScriptVersionNotFound.Wrapf("id: %d", id)
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &oracleScriptVersion)
+	return oracleScriptVersion, nil
+}
+
+// SetOracleScriptVersion saves the given version of an oracle script to the storage.
+func (k Keeper) SetOracleScriptVersion(ctx sdk.Context, oracleScriptVersion types.OracleScriptVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.OracleScriptVersionStoreKey(oracleScriptVersion.OracleScriptID, oracleScriptVersion.Version),
+		k.cdc.MustMarshal(&oracleScriptVersion),
+	)
+}
+
+// GetAllOracleScriptVersions returns the list of all versions of all oracle scripts in the store.
+func (k Keeper) GetAllOracleScriptVersions(ctx sdk.Context) (oracleScriptVersions []types.OracleScriptVersion) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OracleScriptVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var oracleScriptVersion types.OracleScriptVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &oracleScriptVersion)
+		oracleScriptVersions = append(oracleScriptVersions, oracleScriptVersion)
+	}
+	return oracleScriptVersions
+}
+
+// addOracleScriptVersion appends a new version of an oracle script with the given filename and schema.
+func (k Keeper) addOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	filename string,
+	schema string,
+	editor string,
+	changelog string,
+) types.OracleScriptVersion {
+	version := uint64(1)
+	if latest, err := k.GetLatestOracleScriptVersion(ctx, id); err == nil {
+		version = latest.Version + 1
+	}
+	oracleScriptVersion := types.NewOracleScriptVersion(
+		id,
+		version,
+		filename,
+		schema,
+		editor,
+		ctx.BlockHeight(),
+		changelog,
+	)
+	k.SetOracleScriptVersion(ctx, oracleScriptVersion)
+	return oracleScriptVersion
+}
+
+// resolveOracleScriptVersion returns the pinned version of an oracle script, or its latest version
+// if the given version is zero.
+func (k Keeper) resolveOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	if version == 0 {
+		return k.GetLatestOracleScriptVersion(ctx, id)
+	}
+	return k.GetOracleScriptVersion(ctx, id, version)
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestAddEditDataSourceVersions() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	id := k.AddDataSource(ctx, types.NewDataSource(
+		alice, basicName, basicDesc, "FILENAME1", bandtesting.EmptyCoins, treasury,
+	))
+	version, err := k.GetLatestDataSourceVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 1, "FILENAME1", alice.String(), 10, ""), version)
+
+	// every edit appends a new version, and the older version is kept as is.
+	ctx = ctx.WithBlockHeight(20)
+	newVersion := k.MustEditDataSource(ctx, id, types.NewDataSource(
+		alice, types.DoNotModify, types.DoNotModify, "FILENAME2", bandtesting.EmptyCoins, treasury,
+	), bob.String(), "fix parser")
+	require.Equal(uint64(2), newVersion)
+
+	version, err = k.GetLatestDataSourceVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 2, "FILENAME2", bob.String(), 20, "fix parser"), version)
+
+	version, err = k.GetDataSourceVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal("FILENAME1", version.Filename)
+
+	// editing other fields keeps the current filename in the new version.
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		alice, "NEW_NAME", types.DoNotModify, types.DoNotModify, bandtesting.EmptyCoins, treasury,
+	), alice.String(), "")
+	version, err = k.GetDataSourceVersion(ctx, id, 3)
+	require.NoError(err)
+	require.Equal("FILENAME2", version.Filename)
+
+	_, err = k.GetDataSourceVersion(ctx, id, 4)
+	require.ErrorIs(err, types.ErrDataSourceVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestAddEditOracleScriptVersions() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	id := k.AddOracleScript(ctx, types.NewOracleScript(
+		alice, basicName, basicDesc, "FILENAME1", basicSchema, basicSourceCodeURL,
+	))
+	newVersion := k.MustEditOracleScript(ctx.WithBlockHeight(20), id, types.NewOracleScript(
+		alice, types.DoNotModify, types.DoNotModify, "FILENAME2", types.DoNotModify, types.DoNotModify,
+	), alice.String(), "use median")
+	require.Equal(uint64(2), newVersion)
+
+	version, err := k.GetOracleScriptVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(types.NewOracleScriptVersion(id, 1, "FILENAME1", basicSchema, alice.String(), 10, ""), version)
+
+	version, err = k.GetLatestOracleScriptVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(types.NewOracleScriptVersion(id, 2, "FILENAME2", basicSchema, alice.String(), 20, "use median"), version)
+
+	_, err = k.GetLatestOracleScriptVersion(ctx, id+1)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestPinnedOracleScriptVersion() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	// the latest version of oracle script#1 points to the code of oracle script#4, which fails to
+	// prepare with the basic calldata.
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, k.MustGetOracleScript(ctx, 4).Filename,
+		types.DoNotModify, types.DoNotModify,
+	), owner.String(), "")
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, types.FeeEscrowAddress, bandtesting.Coins1band).Times(3)
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrBadWasmExecution)
+
+	// pinning the first version runs the original code.
+	msg.OracleScriptVersion = 1
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+
+	req := k.MustGetRequest(ctx, id)
+	require.Equal(uint64(1), req.OracleScriptVersion)
+	for _, rawReq := range req.RawRequests {
+		require.Equal(uint64(1), rawReq.DataSourceVersion)
+	}
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRawRequest,
+		sdk.NewAttribute(types.AttributeKeyDataSourceID, "1"),
+		sdk.NewAttribute(types.AttributeKeyDataSourceVersion, "1"),
+		sdk.NewAttribute(types.AttributeKeyDataSourceHash, "filename1"),
+		sdk.NewAttribute(types.AttributeKeyExternalID, "1"),
+		sdk.NewAttribute(types.AttributeKeyCalldata, "test"),
+		sdk.NewAttribute(types.AttributeKeyFee, "1000000uband"),
+	))
+
+	msg.OracleScriptVersion = 3
+	_, err = k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestResolveRequestPinnedOracleScriptVersion() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589890))
+	req := types.NewRequest(
+		1,
+		basicCalldata,
+		[]sdk.ValAddress{validators[0].Address, validators[1].Address},
+		1,
+		42,
+		bandtesting.ParseTime(1581589790),
+		basicClientID,
+		[]types.RawRequest{types.NewRawRequest(1, 1, []byte("test"))},
+		nil,
+		testDefaultExecuteGas,
+		0,
+		bandtesting.FeePayer.Address.String(),
+		bandtesting.Coins100band,
+	)
+	req.OracleScriptVersion = 1
+	k.SetRequest(ctx, 42, req)
+	k.SetReport(ctx, 42, types.NewReport(
+		validators[0].Address, true, []types.RawReport{types.NewRawReport(1, 0, []byte("test"))},
+	))
+
+	// editing the oracle script after the request is made does not affect its execution.
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, "NEW_FILENAME", types.DoNotModify, types.DoNotModify,
+	), owner.String(), "")
+
+	k.ResolveRequest(ctx, 42)
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, k.MustGetResult(ctx, 42).ResolveStatus)
+}
+
+func (suite *KeeperTestSuite) TestQueryVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	q := suite.queryClient
+	require := suite.Require()
+
+	dsID := k.AddDataSource(ctx, types.NewDataSource(
+		alice, basicName, basicDesc, "FILENAME1", bandtesting.EmptyCoins, treasury,
+	))
+	k.MustEditDataSource(ctx, dsID, types.NewDataSource(
+		alice, types.DoNotModify, types.DoNotModify, "FILENAME2", bandtesting.EmptyCoins, treasury,
+	), alice.String(), "v2")
+
+	dsRes, err := q.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: uint64(dsID)})
+	require.NoError(err)
+	require.Len(dsRes.Versions, 2)
+	require.Equal("FILENAME1", dsRes.Versions[0].Filename)
+	require.Equal("v2", dsRes.Versions[1].Changelog)
+
+	_, err = q.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: 999})
+	require.ErrorContains(err, types.ErrDataSourceNotFound.Error())
+
+	osID := k.AddOracleScript(ctx, types.NewOracleScript(
+		alice, basicName, basicDesc, "FILENAME1", basicSchema, basicSourceCodeURL,
+	))
+	osRes, err := q.OracleScriptVersions(ctx, &types.QueryOracleScriptVersionsRequest{OracleScriptId: uint64(osID)})
+	require.NoError(err)
+	require.Equal([]types.OracleScriptVersion{
+		types.NewOracleScriptVersion(osID, 1, "FILENAME1", basicSchema, alice.String(), ctx.BlockHeight(), ""),
+	}, osRes.Versions)
+
+	_, err = q.OracleScriptVersions(ctx, &types.QueryOracleScriptVersionsRequest{OracleScriptId: 999})
+	require.ErrorContains(err, types.ErrOracleScriptNotFound.Error())
+}
@@ -0,0 +1,66 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	ModuleName = "oracle"
+)
+
+// Migrate migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it records the current executable of every data source
+// and oracle script as their first version, so that later edits are appended to
+// the version history.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	dataSourceIterator := storetypes.KVStorePrefixIterator(store, types.DataSourceStoreKeyPrefix)
+	defer dataSourceIterator.Close()
+	for ; dataSourceIterator.Valid(); dataSourceIterator.Next() {
+		var dataSource types.DataSource
+		if err := cdc.Unmarshal(dataSourceIterator.Value(), &dataSource); err != nil {
+			return err
+		}
+
+		id := types.DataSourceID(sdk.BigEndianToUint64(dataSourceIterator.Key()[len(types.DataSourceStoreKeyPrefix):]))
+		version := types.NewDataSourceVersion(id, 1, dataSource.Filename, dataSource.Owner, ctx.BlockHeight(), "")
+		bz, err := cdc.Marshal(&version)
+		if err != nil {
+			return err
+		}
+		store.Set(types.DataSourceVersionStoreKey(id, 1), bz)
+	}
+
+	oracleScriptIterator := storetypes.KVStorePrefixIterator(store, types.OracleScriptStoreKeyPrefix)
+	defer oracleScriptIterator.Close()
+	for ; oracleScriptIterator.Valid(); oracleScriptIterator.Next() {
+		var oracleScript types.OracleScript
+		if err := cdc.Unmarshal(oracleScriptIterator.Value(), &oracleScript); err != nil {
+			return err
+		}
+
+		id := types.OracleScriptID(
+			sdk.BigEndianToUint64(oracleScriptIterator.Key()[len(types.OracleScriptStoreKeyPrefix):]),
+		)
+		version := types.NewOracleScriptVersion(
+			id,
+			1,
+			oracleScript.Filename,
+			oracleScript.Schema,
+			oracleScript.Owner,
+			ctx.BlockHeight(),
+			"",
+		)
+		bz, err := cdc.Marshal(&version)
+		if err != nil {
+			return err
+		}
+		store.Set(types.OracleScriptVersionStoreKey(id, 1), bz)
+	}
+
+	return nil
+}
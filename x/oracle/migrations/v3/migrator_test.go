@@ -0,0 +1,41 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockHeight(10)
+	store := ctx.KVStore(storeKey)
+
+	dataSource := types.DataSource{Owner: "owner", Filename: "ds-file"}
+	store.Set(types.DataSourceStoreKey(3), cdc.MustMarshal(&dataSource))
+	oracleScript := types.OracleScript{Owner: "owner", Filename: "os-file", Schema: "os-schema"}
+	store.Set(types.OracleScriptStoreKey(5), cdc.MustMarshal(&oracleScript))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var dataSourceVersion types.DataSourceVersion
+	require.NoError(t, cdc.Unmarshal(store.Get(types.DataSourceVersionStoreKey(3, 1)), &dataSourceVersion))
+	require.Equal(t, types.NewDataSourceVersion(3, 1, "ds-file", "owner", 10, ""), dataSourceVersion)
+
+	var oracleScriptVersion types.OracleScriptVersion
+	require.NoError(t, cdc.Unmarshal(store.Get(types.OracleScriptVersionStoreKey(5, 1)), &oracleScriptVersion))
+	require.Equal(t, types.NewOracleScriptVersion(5, 1, "os-file", "os-schema", "owner", 10, ""), oracleScriptVersion)
+}
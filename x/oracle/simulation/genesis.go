@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -66,6 +69,38 @@ func GenIBCRequestEnabled(r *rand.Rand) bool {
 	return r.Int63n(100) < 50
 }
 
+// GenLatestResultRetention returns randomized LatestResultRetention
+func GenLatestResultRetention(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 1000000000000))
+}
+
+// GenMaxSubscriptionRunsPerBlock returns randomized MaxSubscriptionRunsPerBlock
+func GenMaxSubscriptionRunsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenResultRetentionPeriod returns randomized ResultRetentionPeriod
+func GenResultRetentionPeriod(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 1000000000000))
+}
+
+// GenResultRetentionCount returns randomized ResultRetentionCount
+func GenResultRetentionCount(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 1000))
+}
+
+// GenMaxResultPrunesPerBlock returns randomized MaxResultPrunesPerBlock
+func GenMaxResultPrunesPerBlock(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenSubscriptionGasPrices returns randomized SubscriptionGasPrices
+func GenSubscriptionGasPrices(r *rand.Rand) sdk.DecCoins {
+	return sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 4)),
+	)
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxRawRequestCount uint64
@@ -134,6 +169,42 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ibcRequestEnabled = GenIBCRequestEnabled(r) },
 	)
 
+	var latestResultRetention uint64
+	simState.AppParams.GetOrGenerate(
+		"LatestResultRetention", &latestResultRetention, simState.Rand,
+		func(r *rand.Rand) { latestResultRetention = GenLatestResultRetention(r) },
+	)
+
+	var maxSubscriptionRunsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		"MaxSubscriptionRunsPerBlock", &maxSubscriptionRunsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxSubscriptionRunsPerBlock = GenMaxSubscriptionRunsPerBlock(r) },
+	)
+
+	var resultRetentionPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		"ResultRetentionPeriod", &resultRetentionPeriod, simState.Rand,
+		func(r *rand.Rand) { resultRetentionPeriod = GenResultRetentionPeriod(r) },
+	)
+
+	var resultRetentionCount uint64
+	simState.AppParams.GetOrGenerate(
+		"ResultRetentionCount", &resultRetentionCount, simState.Rand,
+		func(r *rand.Rand) { resultRetentionCount = GenResultRetentionCount(r) },
+	)
+
+	var maxResultPrunesPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		"MaxResultPrunesPerBlock", &maxResultPrunesPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxResultPrunesPerBlock = GenMaxResultPrunesPerBlock(r) },
+	)
+
+	var subscriptionGasPrices sdk.DecCoins
+	simState.AppParams.GetOrGenerate(
+		"SubscriptionGasPrices", &subscriptionGasPrices, simState.Rand,
+		func(r *rand.Rand) { subscriptionGasPrices = GenSubscriptionGasPrices(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.NewParams(
 			maxRawRequestCount,
@@ -147,9 +218,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			latestResultRetention,
+			maxSubscriptionRunsPerBlock,
+			resultRetentionPeriod,
+			resultRetentionCount,
+			maxResultPrunesPerBlock,
+			subscriptionGasPrices,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
+		0,
+		[]types.Subscription{},
+		[]types.DataSourceVersion{},
+		[]types.OracleScriptVersion{},
+		0,
+		[]types.Result{},
+		[]types.FeeReceipt{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
@@ -51,6 +51,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, uint64(74), oracleGenesis.Params.OracleRewardPercentage)
 	require.Equal(t, uint64(265472644968), oracleGenesis.Params.InactivePenaltyDuration)
 	require.Equal(t, false, oracleGenesis.Params.IBCRequestEnabled)
+	require.Equal(t, uint64(610539110790), oracleGenesis.Params.LatestResultRetention)
 	require.Equal(t, []types.DataSource{}, oracleGenesis.DataSources)
 	require.Equal(t, []types.OracleScript{}, oracleGenesis.OracleScripts)
 }
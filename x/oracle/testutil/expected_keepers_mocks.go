@@ -76,6 +76,32 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAccount(ctx, moduleName any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAccount), ctx, moduleName)
 }
 
+// NewAccount mocks base method.
+func (m *MockAccountKeeper) NewAccount(ctx context.Context, acc types1.AccountI) types1.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAccount", ctx, acc)
+	ret0, _ := ret[0].(types1.AccountI)
+	return ret0
+}
+
+// NewAccount indicates an expected call of NewAccount.
+func (mr *MockAccountKeeperMockRecorder) NewAccount(ctx, acc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAccount", reflect.TypeOf((*MockAccountKeeper)(nil).NewAccount), ctx, acc)
+}
+
+// SetAccount mocks base method.
+func (m *MockAccountKeeper) SetAccount(ctx context.Context, acc types1.AccountI) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAccount", ctx, acc)
+}
+
+// SetAccount indicates an expected call of SetAccount.
+func (mr *MockAccountKeeperMockRecorder) SetAccount(ctx, acc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).SetAccount), ctx, acc)
+}
+
 // MockBankKeeper is a mock of BankKeeper interface.
 type MockBankKeeper struct {
 	ctrl     *gomock.Controller
@@ -128,6 +154,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoins(ctx, from, to, amt any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendCoins), ctx, from, to, amt)
 }
 
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types1.AccAddress, recipientModule string, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
 // SendCoinsFromModuleToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
 	m.ctrl.T.Helper()
@@ -465,18 +505,18 @@ func (m *MockRollingseedKeeper) EXPECT() *MockRollingseedKeeperMockRecorder {
 	return m.recorder
 }
 
-// GetRollingSeed mocks base method.
-func (m *MockRollingseedKeeper) GetRollingSeed(ctx types1.Context) []byte {
+// GetRandomSeed mocks base method.
+func (m *MockRollingseedKeeper) GetRandomSeed(ctx types1.Context) []byte {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRollingSeed", ctx)
+	ret := m.ctrl.Call(m, "GetRandomSeed", ctx)
 	ret0, _ := ret[0].([]byte)
 	return ret0
 }
 
-// GetRollingSeed indicates an expected call of GetRollingSeed.
-func (mr *MockRollingseedKeeperMockRecorder) GetRollingSeed(ctx any) *gomock.Call {
+// GetRandomSeed indicates an expected call of GetRandomSeed.
+func (mr *MockRollingseedKeeperMockRecorder) GetRandomSeed(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollingSeed", reflect.TypeOf((*MockRollingseedKeeper)(nil).GetRollingSeed), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRandomSeed", reflect.TypeOf((*MockRollingseedKeeper)(nil).GetRandomSeed), ctx)
 }
 
 // MockBandtssKeeper is a mock of BandtssKeeper interface.
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallbackRouter is a struct that holds a map of ResultCallback objects for each module.
+type CallbackRouter struct {
+	routes map[string]ResultCallback
+	sealed bool
+}
+
+// NewCallbackRouter creates a new CallbackRouter instance.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]ResultCallback),
+	}
+}
+
+// Seal seals the CallbackRouter which prohibits any subsequent ResultCallback to be added.
+// Seal will panic if called more than once.
+func (cbr *CallbackRouter) Seal() {
+	if cbr.sealed {
+		panic(errors.New("callback router is already sealed"))
+	}
+	cbr.sealed = true
+}
+
+// Sealed returns whether the CallbackRouter can be changed or not.
+func (cbr CallbackRouter) Sealed() bool {
+	return cbr.sealed
+}
+
+// AddRoute adds ResultCallback for a given module name. It returns the CallbackRouter
+// so that the function can be chained. It will panic if the CallbackRouter is sealed.
+func (cbr *CallbackRouter) AddRoute(module string, cbs ResultCallback) *CallbackRouter {
+	if cbr.sealed {
+		panic(fmt.Errorf("callback router sealed; cannot register %s route callbacks", module))
+	}
+	if !sdk.IsAlphaNumeric(module) {
+		panic(errors.New("callback route expressions can only contain alphanumeric characters"))
+	}
+	if cbr.HasRoute(module) {
+		panic(fmt.Errorf("route %s has already been registered", module))
+	}
+
+	cbr.routes[module] = cbs
+	return cbr
+}
+
+// HasRoute returns whether the given module is registered.
+func (cbr *CallbackRouter) HasRoute(module string) bool {
+	_, ok := cbr.routes[module]
+	return ok
+}
+
+// GetRoute returns a ResultCallback for a given module.
+func (cbr *CallbackRouter) GetRoute(module string) (ResultCallback, bool) {
+	if !cbr.HasRoute(module) {
+		return nil, false
+	}
+	return cbr.routes[module], true
+}
+
+// ResultCallback defines the expected interface for a callback object that registered
+// in the callbackRouter. The hooks after a request is resolved or expired are run in a
+// cached context limited to the gas limit of the callback; the state changes are
+// discarded if they return an error or panic.
+type ResultCallback interface {
+	// Must be called when a request with a callback to the module is created. The request
+	// is rejected if it returns an error, e.g. the requester is not allowed to use the payload.
+	OnRequestCreated(ctx sdk.Context, requestID RequestID, requester sdk.AccAddress, payload []byte) error
+
+	// Must be called after a request is successfully resolved with its result.
+	OnRequestResolved(ctx sdk.Context, result Result, payload []byte) error
+
+	// Must be called after a request is resolved as failure with the given reason.
+	OnRequestFailed(ctx sdk.Context, result Result, reason string, payload []byte) error
+
+	// Must be called after a request is expired without being resolved.
+	OnRequestExpired(ctx sdk.Context, result Result, payload []byte) error
+}
+
+// NewRequestCallback creates a new RequestCallback instance.
+func NewRequestCallback(module string, payload []byte, gasLimit uint64) *RequestCallback {
+	return &RequestCallback{
+		Module:   module,
+		Payload:  payload,
+		GasLimit: gasLimit,
+	}
+}
+
+// Validate validates the request callback.
+func (c RequestCallback) Validate() error {
+	if !sdk.IsAlphaNumeric(c.Module) {
+		return ErrInvalidCallback.Wrapf("invalid module: %q", c.Module)
+	}
+	if len(c.Payload) > MaxCallbackPayloadSize {
+		return ErrInvalidCallback.Wrapf(
+			"payload size: %d exceeds max: %d", len(c.Payload), MaxCallbackPayloadSize,
+		)
+	}
+	if c.GasLimit == 0 || c.GasLimit > MaxCallbackGasLimit {
+		return ErrInvalidCallback.Wrapf(
+			"gas limit: %d must be between 1 and %d", c.GasLimit, MaxCallbackGasLimit,
+		)
+	}
+	return nil
+}
@@ -27,6 +27,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateOracleScript{}, "oracle/CreateOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgEditOracleScript{}, "oracle/EditOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "oracle/Activate")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSubscription{}, "oracle/CreateSubscription")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSubscription{}, "oracle/UpdateSubscription")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelSubscription{}, "oracle/CancelSubscription")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "oracle/UpdateParams")
 
 	cdc.RegisterConcrete(&OracleResultSignatureOrder{}, "oracle/OracleResultSignatureOrder", nil)
@@ -43,6 +46,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateOracleScript{},
 		&MsgEditOracleScript{},
 		&MsgActivate{},
+		&MsgCreateSubscription{},
+		&MsgUpdateSubscription{},
+		&MsgCancelSubscription{},
 		&MsgUpdateParams{},
 	)
 
@@ -8,12 +8,18 @@ const (
 	MaxClientIDLength    = 128
 	MaxSchemaLength      = 512
 	MaxURLLength         = 128
+	MaxChangelogLength   = 512
 
 	MaxExecutableSize       = 8 * 1024        // 8kB
 	MaxWasmCodeSize         = 512 * 1024      // 512kB
 	MaxCompiledWasmCodeSize = 1 * 1024 * 1024 // 1MB
 
 	MaximumOwasmGas = 8000000
+
+	MaxSubscriptionInterval = 365 * 24 * 60 * 60 // 1 year in seconds
+
+	MaxCallbackPayloadSize = 1024
+	MaxCallbackGasLimit    = 1000000
 )
 
 var DoNotModifyBytes = []byte(DoNotModify)
@@ -16,3 +16,16 @@ func NewDataSource(
 		Fee:         fee,
 	}
 }
+
+func NewDataSourceVersion(
+	dataSourceID DataSourceID, version uint64, filename, editor string, height int64, changelog string,
+) DataSourceVersion {
+	return DataSourceVersion{
+		DataSourceID: dataSourceID,
+		Version:      version,
+		Filename:     filename,
+		Editor:       editor,
+		Height:       height,
+		Changelog:    changelog,
+	}
+}
@@ -16,6 +16,17 @@ const (
 	EventTypeSendPacketFail        = "send_packet_fail"
 	EventTypeUpdateParams          = "update_params"
 	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeCreateSubscription    = "create_subscription"
+	EventTypeUpdateSubscription    = "update_subscription"
+	EventTypeCancelSubscription    = "cancel_subscription"
+	EventTypePauseSubscription     = "pause_subscription"
+	EventTypeCompleteSubscription  = "complete_subscription"
+	EventTypeSubscriptionRequest   = "subscription_request"
+	EventTypeSubscriptionFail      = "subscription_request_fail"
+	EventTypeSettleFee             = "settle_fee"
+	EventTypeSettleFeeFail         = "settle_fee_fail"
+	EventTypeResultCallback        = "result_callback"
+	EventTypeResultCallbackFail    = "result_callback_fail"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +49,17 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeySubscriptionID      = "subscription_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyDepositAccount      = "deposit_account"
+	AttributeKeyInterval            = "interval"
+	AttributeKeyIntervalUnit        = "interval_unit"
+	AttributeKeyMaxRuns             = "max_runs"
+	AttributeKeyRuns                = "runs"
+	AttributeKeyRefund              = "refund"
+	AttributeKeyPaid                = "paid"
+	AttributeKeyVersion             = "version"
+	AttributeKeyOracleScriptVersion = "oracle_script_version"
+	AttributeKeyDataSourceVersion   = "data_source_version"
+	AttributeKeyCallbackModule      = "callback_module"
 )
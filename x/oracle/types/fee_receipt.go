@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// FeeEscrowAddress is the address of the account that holds the data source fees of requests
+// until they are settled.
+var FeeEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte(FeeEscrowKey)))
+
+const (
+	// FeeSettlementRetryInterval is the number of blocks to wait before a failed fee settlement is retried.
+	FeeSettlementRetryInterval = int64(100)
+	// MaxFeeSettlementRetriesPerBlock is the maximum number of failed fee settlements retried in a block.
+	MaxFeeSettlementRetriesPerBlock = 20
+)
+
+// NewFeeReceipt creates a new FeeReceipt instance.
+func NewFeeReceipt(
+	requestID RequestID,
+	payer string,
+	escrowed sdk.Coins,
+	items []FeeReceiptItem,
+	refunded sdk.Coins,
+	settled bool,
+	refundAddress string,
+) FeeReceipt {
+	return FeeReceipt{
+		RequestID:     requestID,
+		Payer:         payer,
+		Escrowed:      escrowed,
+		Items:         items,
+		Refunded:      refunded,
+		Settled:       settled,
+		RefundAddress: refundAddress,
+	}
+}
+
+// NewFeeReceiptItem creates a new FeeReceiptItem instance.
+func NewFeeReceiptItem(
+	externalID ExternalID,
+	dataSourceID DataSourceID,
+	treasury string,
+	feePerReport sdk.Coins,
+	reportCount uint64,
+	paid sdk.Coins,
+) FeeReceiptItem {
+	return FeeReceiptItem{
+		ExternalID:   externalID,
+		DataSourceID: dataSourceID,
+		Treasury:     treasury,
+		FeePerReport: feePerReport,
+		ReportCount:  reportCount,
+		Paid:         paid,
+	}
+}
@@ -2,25 +2,49 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
-func NewGenesisState(params Params, dataSources []DataSource, oracleScripts []OracleScript) *GenesisState {
+func NewGenesisState(
+	params Params,
+	dataSources []DataSource,
+	oracleScripts []OracleScript,
+	subscriptionCount uint64,
+	subscriptions []Subscription,
+	dataSourceVersions []DataSourceVersion,
+	oracleScriptVersions []OracleScriptVersion,
+	requestCount uint64,
+	results []Result,
+	feeReceipts []FeeReceipt,
+) *GenesisState {
 	return &GenesisState{
-		Params:        params,
-		DataSources:   dataSources,
-		OracleScripts: oracleScripts,
+		Params:               params,
+		DataSources:          dataSources,
+		OracleScripts:        oracleScripts,
+		SubscriptionCount:    subscriptionCount,
+		Subscriptions:        subscriptions,
+		DataSourceVersions:   dataSourceVersions,
+		OracleScriptVersions: oracleScriptVersions,
+		RequestCount:         requestCount,
+		Results:              results,
+		FeeReceipts:          feeReceipts,
 	}
 }
 
 // DefaultGenesisState returns the default oracle genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		DataSources:   []DataSource{},
-		OracleScripts: []OracleScript{},
+		Params:               DefaultParams(),
+		DataSources:          []DataSource{},
+		OracleScripts:        []OracleScript{},
+		Subscriptions:        []Subscription{},
+		DataSourceVersions:   []DataSourceVersion{},
+		OracleScriptVersions: []OracleScriptVersion{},
+		Results:              []Result{},
+		FeeReceipts:          []FeeReceipt{},
 	}
 }
 
@@ -37,4 +61,74 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
-func (g GenesisState) Validate() error { return nil }
+func (g GenesisState) Validate() error {
+	ids := make(map[SubscriptionID]bool)
+	for _, subscription := range g.Subscriptions {
+		if subscription.ID == 0 || uint64(subscription.ID) > g.SubscriptionCount {
+			return fmt.Errorf("subscription id %d is out of range of subscription count %d",
+				subscription.ID, g.SubscriptionCount)
+		}
+		if ids[subscription.ID] {
+			return fmt.Errorf("duplicate subscription id %d", subscription.ID)
+		}
+		ids[subscription.ID] = true
+	}
+
+	dataSourceVersions := make(map[string]bool)
+	for _, v := range g.DataSourceVersions {
+		if v.DataSourceID == 0 || int(v.DataSourceID) > len(g.DataSources) {
+			return fmt.Errorf("data source id %d of version %d does not exist", v.DataSourceID, v.Version)
+		}
+		if v.Version == 0 {
+			return fmt.Errorf("invalid version 0 of data source %d", v.DataSourceID)
+		}
+		key := fmt.Sprintf("%d:%d", v.DataSourceID, v.Version)
+		if dataSourceVersions[key] {
+			return fmt.Errorf("duplicate version %d of data source %d", v.Version, v.DataSourceID)
+		}
+		dataSourceVersions[key] = true
+	}
+
+	oracleScriptVersions := make(map[string]bool)
+	for _, v := range g.OracleScriptVersions {
+		if v.OracleScriptID == 0 || int(v.OracleScriptID) > len(g.OracleScripts) {
+			return fmt.Errorf("oracle script id %d of version %d does not exist", v.OracleScriptID, v.Version)
+		}
+		if v.Version == 0 {
+			return fmt.Errorf("invalid version 0 of oracle script %d", v.OracleScriptID)
+		}
+		key := fmt.Sprintf("%d:%d", v.OracleScriptID, v.Version)
+		if oracleScriptVersions[key] {
+			return fmt.Errorf("duplicate version %d of oracle script %d", v.Version, v.OracleScriptID)
+		}
+		oracleScriptVersions[key] = true
+	}
+
+	results := make(map[RequestID]bool)
+	for _, result := range g.Results {
+		if result.RequestID == 0 || uint64(result.RequestID) > g.RequestCount {
+			return fmt.Errorf("result of request id %d is out of range of request count %d",
+				result.RequestID, g.RequestCount)
+		}
+		if results[result.RequestID] {
+			return fmt.Errorf("duplicate result of request id %d", result.RequestID)
+		}
+		results[result.RequestID] = true
+	}
+
+	feeReceipts := make(map[RequestID]bool)
+	for _, receipt := range g.FeeReceipts {
+		if !results[receipt.RequestID] {
+			return fmt.Errorf("fee receipt of request id %d does not have a result", receipt.RequestID)
+		}
+		if !receipt.Settled {
+			return fmt.Errorf("fee receipt of request id %d is not settled", receipt.RequestID)
+		}
+		if feeReceipts[receipt.RequestID] {
+			return fmt.Errorf("duplicate fee receipt of request id %d", receipt.RequestID)
+		}
+		feeReceipts[receipt.RequestID] = true
+	}
+
+	return nil
+}
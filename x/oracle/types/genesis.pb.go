@@ -31,6 +31,20 @@ type GenesisState struct {
 	DataSources []DataSource `protobuf:"bytes,2,rep,name=data_sources,json=dataSources,proto3" json:"data_sources"`
 	// OracleScripts are list of oracle scripts to be installed during genesis phase.
 	OracleScripts []OracleScript `protobuf:"bytes,3,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts"`
+	// SubscriptionCount is the number of subscriptions that have been created.
+	SubscriptionCount uint64 `protobuf:"varint,4,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"`
+	// Subscriptions are the subscriptions that are not yet cancelled or completed.
+	Subscriptions []Subscription `protobuf:"bytes,5,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// DataSourceVersions are the version histories of the data sources.
+	DataSourceVersions []DataSourceVersion `protobuf:"bytes,6,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions"`
+	// OracleScriptVersions are the version histories of the oracle scripts.
+	OracleScriptVersions []OracleScriptVersion `protobuf:"bytes,7,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions"`
+	// RequestCount is the number of requests that have been made.
+	RequestCount uint64 `protobuf:"varint,8,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Results are the results of the requests that are within the retention.
+	Results []Result `protobuf:"bytes,9,rep,name=results,proto3" json:"results"`
+	// FeeReceipts are the settled fee receipts of the results.
+	FeeReceipts []FeeReceipt `protobuf:"bytes,10,rep,name=fee_receipts,json=feeReceipts,proto3" json:"fee_receipts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +101,55 @@ func (m *GenesisState) GetOracleScripts() []OracleScript {
 	return nil
 }
 
+func (m *GenesisState) GetSubscriptionCount() uint64 {
+	if m != nil {
+		return m.SubscriptionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataSourceVersions() []DataSourceVersion {
+	if m != nil {
+		return m.DataSourceVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleScriptVersions() []OracleScriptVersion {
+	if m != nil {
+		return m.OracleScriptVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRequestCount() uint64 {
+	if m != nil {
+		return m.RequestCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetResults() []Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeReceipts() []FeeReceipt {
+	if m != nil {
+		return m.FeeReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.oracle.v1.GenesisState")
 }
@@ -94,25 +157,35 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/genesis.proto", fileDescriptor_b23429f682cd4ce7) }
 
 var fileDescriptor_b23429f682cd4ce7 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0x80, 0x73, 0x56, 0x3a, 0xa4, 0xb5, 0x43, 0x10, 0x09, 0xb1, 0x9c, 0xc5, 0xa9, 0xd3, 0x1d,
-	0x6d, 0xfd, 0x05, 0x55, 0x90, 0x4e, 0x8a, 0xd9, 0x5c, 0xca, 0xcb, 0xe5, 0x48, 0x03, 0x6d, 0x2e,
-	0xdc, 0xbb, 0x06, 0xfd, 0x17, 0xfe, 0xac, 0x8e, 0x1d, 0x1c, 0x9c, 0x44, 0x92, 0x3f, 0x22, 0xb9,
-	0x44, 0xd1, 0x6c, 0xc7, 0xfb, 0xbe, 0xf7, 0x1d, 0x3c, 0x77, 0x1c, 0x41, 0x16, 0x73, 0xa5, 0x41,
-	0x6c, 0x25, 0x2f, 0x66, 0x3c, 0x91, 0x99, 0xc4, 0x14, 0x59, 0xae, 0x95, 0x51, 0xde, 0xa8, 0xa6,
-	0xac, 0xa1, 0xac, 0x98, 0x05, 0xe7, 0x89, 0x4a, 0x94, 0x45, 0xbc, 0x7e, 0x35, 0x56, 0x70, 0xd9,
-	0x69, 0xb4, 0xbe, 0x85, 0xd7, 0xef, 0xc4, 0x1d, 0xde, 0x37, 0xd1, 0xd0, 0x80, 0x91, 0xde, 0x8d,
-	0xdb, 0xcf, 0x41, 0xc3, 0x0e, 0x7d, 0x32, 0x21, 0xd3, 0xc1, 0xfc, 0x82, 0xfd, 0xff, 0x84, 0x3d,
-	0x5a, 0xba, 0x3c, 0x3d, 0x7c, 0x5e, 0x39, 0x4f, 0xad, 0xeb, 0xdd, 0xba, 0xc3, 0x18, 0x0c, 0xac,
-	0x51, 0xed, 0xb5, 0x90, 0xe8, 0x9f, 0x4c, 0x7a, 0xd3, 0xc1, 0x3c, 0xe8, 0xee, 0xde, 0x81, 0x81,
-	0xd0, 0x2a, 0xed, 0xfe, 0x20, 0xfe, 0x9d, 0xa0, 0xb7, 0x72, 0x47, 0x8d, 0xba, 0x46, 0xa1, 0xd3,
-	0xdc, 0xa0, 0xdf, 0xb3, 0x99, 0x71, 0x37, 0xf3, 0x60, 0x5f, 0xa1, 0x95, 0xda, 0xd0, 0x99, 0xfa,
-	0x33, 0xc3, 0xe5, 0xea, 0x50, 0x52, 0x72, 0x2c, 0x29, 0xf9, 0x2a, 0x29, 0x79, 0xab, 0xa8, 0x73,
-	0xac, 0xa8, 0xf3, 0x51, 0x51, 0xe7, 0x99, 0x27, 0xa9, 0xd9, 0xec, 0x23, 0x26, 0xd4, 0x8e, 0xd7,
-	0x59, 0x7b, 0x06, 0xa1, 0xb6, 0x5c, 0x6c, 0x20, 0xcd, 0x78, 0xb1, 0xe0, 0x2f, 0x3f, 0xb7, 0x32,
-	0xaf, 0xb9, 0xc4, 0xa8, 0x6f, 0x8d, 0xc5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x92, 0xdd,
-	0xef, 0x8b, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0x92, 0xa6, 0x30, 0x49, 0x2a, 0x31, 0x8a, 0x2a, 0x2b, 0x54, 0x26, 0xd0, 0x4d,
+	0x36, 0x78, 0xd4, 0x16, 0x71, 0x80, 0x16, 0x01, 0x5d, 0x81, 0x12, 0x09, 0x09, 0x36, 0xd6, 0x64,
+	0xf2, 0x9a, 0x5a, 0x4a, 0x3d, 0x66, 0xde, 0xd8, 0x82, 0x5b, 0x70, 0x0f, 0x2e, 0xd2, 0x65, 0x97,
+	0xac, 0x10, 0x4a, 0x2e, 0x82, 0x3c, 0x33, 0x0e, 0xb6, 0x09, 0xdd, 0x8d, 0xde, 0xff, 0xbf, 0xef,
+	0x49, 0xbf, 0x7f, 0x93, 0xa3, 0x39, 0x4f, 0x16, 0x4c, 0x2a, 0x2e, 0x56, 0xc0, 0xf2, 0x13, 0xb6,
+	0x84, 0x04, 0x30, 0xc6, 0x30, 0x55, 0x52, 0x4b, 0x7a, 0x50, 0xa8, 0xa1, 0x55, 0xc3, 0xfc, 0x64,
+	0x34, 0x5c, 0xca, 0xa5, 0x34, 0x12, 0x2b, 0x5e, 0xd6, 0x35, 0x7a, 0xd2, 0x60, 0x38, 0xbf, 0x11,
+	0x9f, 0xff, 0xd8, 0x23, 0xfd, 0xb7, 0x16, 0x3a, 0xd3, 0x5c, 0x03, 0x7d, 0x49, 0xba, 0x29, 0x57,
+	0xfc, 0x06, 0x7d, 0x6f, 0xec, 0x4d, 0x7a, 0xa7, 0x87, 0x61, 0xfd, 0x48, 0xf8, 0xc1, 0xa8, 0xe7,
+	0x9d, 0xdb, 0x5f, 0x4f, 0x5b, 0x53, 0xe7, 0xa5, 0x17, 0xa4, 0xbf, 0xe0, 0x9a, 0x47, 0x28, 0x33,
+	0x25, 0x00, 0xfd, 0x07, 0xe3, 0xf6, 0xa4, 0x77, 0x3a, 0x6a, 0xee, 0xbe, 0xe6, 0x9a, 0xcf, 0x8c,
+	0xc5, 0xed, 0xf7, 0x16, 0xdb, 0x09, 0xd2, 0x4b, 0x72, 0x60, 0xad, 0x11, 0x0a, 0x15, 0xa7, 0x1a,
+	0xfd, 0xb6, 0xc1, 0x1c, 0x35, 0x31, 0xef, 0xcd, 0x6b, 0x66, 0x4c, 0x0e, 0x34, 0x90, 0x95, 0x19,
+	0xd2, 0x17, 0x84, 0x62, 0x36, 0xb7, 0x98, 0x58, 0x26, 0x91, 0x90, 0x59, 0xa2, 0xfd, 0xce, 0xd8,
+	0x9b, 0x74, 0xa6, 0x8f, 0xab, 0xca, 0x45, 0x21, 0xd0, 0x77, 0x64, 0x50, 0x1d, 0xa2, 0xbf, 0xb7,
+	0xfb, 0xf0, 0xac, 0x62, 0x2a, 0x0f, 0xd7, 0x16, 0xe9, 0x27, 0x32, 0xac, 0x04, 0x11, 0xe5, 0xa0,
+	0xd0, 0x00, 0xbb, 0x06, 0xf8, 0xec, 0xff, 0x81, 0x7c, 0xb4, 0x4e, 0x47, 0xa5, 0x8b, 0xa6, 0x80,
+	0x34, 0x22, 0x87, 0xb5, 0x78, 0xfe, 0xc2, 0xf7, 0x0d, 0xfc, 0xf8, 0xbe, 0x98, 0xea, 0xf8, 0xa1,
+	0xfc, 0x57, 0x42, 0x7a, 0x4c, 0x06, 0x0a, 0xbe, 0x64, 0x80, 0xda, 0xe5, 0xf5, 0xd0, 0xe4, 0xd5,
+	0x77, 0x43, 0x1b, 0xd5, 0x2b, 0xb2, 0xaf, 0x00, 0xb3, 0x95, 0x46, 0xff, 0xd1, 0xb8, 0xbd, 0xab,
+	0x20, 0x53, 0x23, 0xbb, 0x4b, 0xa5, 0xb9, 0x68, 0xc8, 0x15, 0x40, 0xa4, 0x40, 0x80, 0xf9, 0xb4,
+	0x64, 0x77, 0x43, 0xde, 0x00, 0x4c, 0xad, 0xa5, 0x6c, 0xc8, 0xd5, 0x76, 0x82, 0xe7, 0x97, 0xb7,
+	0xeb, 0xc0, 0xbb, 0x5b, 0x07, 0xde, 0xef, 0x75, 0xe0, 0x7d, 0xdf, 0x04, 0xad, 0xbb, 0x4d, 0xd0,
+	0xfa, 0xb9, 0x09, 0x5a, 0x9f, 0xd9, 0x32, 0xd6, 0xd7, 0xd9, 0x3c, 0x14, 0xf2, 0x86, 0x15, 0x48,
+	0xd3, 0x6e, 0x21, 0x57, 0x4c, 0x5c, 0xf3, 0x38, 0x61, 0xf9, 0x19, 0xfb, 0x5a, 0xfe, 0x02, 0xfa,
+	0x5b, 0x0a, 0x38, 0xef, 0x1a, 0xc7, 0xd9, 0x9f, 0x01, 0x00, 0xeb, 0xd3, 0xe6, 0x7b, 0x62, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +208,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeReceipts) > 0 {
+		for iNdEx := len(m.FeeReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RequestCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RequestCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OracleScriptVersions) > 0 {
+		for iNdEx := len(m.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleScriptVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DataSourceVersions) > 0 {
+		for iNdEx := len(m.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSourceVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SubscriptionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubscriptionCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.OracleScripts) > 0 {
 		for iNdEx := len(m.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +360,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SubscriptionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SubscriptionCount))
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataSourceVersions) > 0 {
+		for _, e := range m.DataSourceVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleScriptVersions) > 0 {
+		for _, e := range m.OracleScriptVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RequestCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RequestCount))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeReceipts) > 0 {
+		for _, e := range m.FeeReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +535,214 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionCount", wireType)
+			}
+			m.SubscriptionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSourceVersions = append(m.DataSourceVersions, DataSourceVersion{})
+			if err := m.DataSourceVersions[len(m.DataSourceVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleScriptVersions = append(m.OracleScriptVersions, OracleScriptVersion{})
+			if err := m.OracleScriptVersions[len(m.OracleScriptVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+			}
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeReceipts = append(m.FeeReceipts, FeeReceipt{})
+			if err := m.FeeReceipts[len(m.FeeReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,3 +11,6 @@ type RequestID uint64
 
 // ExternalID is the type-safe unique identifier type for raw data requests.
 type ExternalID uint64
+
+// SubscriptionID is the type-safe unique identifier type for subscriptions.
+type SubscriptionID uint64
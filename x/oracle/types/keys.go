@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +24,12 @@ const (
 
 	// PortID is the default port id that oracle module binds to.
 	PortID = ModuleName
+
+	// SubscriptionAccountsKey is the key used to derive the deposit accounts of subscriptions.
+	SubscriptionAccountsKey = "subscription-accounts"
+
+	// FeeEscrowKey is the key used to derive the account that holds the data source fees in escrow.
+	FeeEscrowKey = "fee-escrow"
 )
 
 var (
@@ -33,12 +41,18 @@ var (
 	RequestCountStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestCount")...)
 	// RequestLastExpiredStoreKey is the key that keeps the ID of the last expired request, or 0 if none.
 	RequestLastExpiredStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestLastExpired")...)
+	// ResultLastPrunedStoreKey is the key that keeps the ID of the last request whose result is pruned, or 0 if none.
+	ResultLastPrunedStoreKey = append(GlobalStoreKeyPrefix, []byte("ResultLastPruned")...)
+	// LatestResultLastPrunedStoreKey is the key that keeps the last pruned resolve time index of the latest results.
+	LatestResultLastPrunedStoreKey = append(GlobalStoreKeyPrefix, []byte("LatestResultLastPruned")...)
 	// PendingResolveListStoreKey is the key that keeps the list of pending-resolve requests.
 	PendingResolveListStoreKey = append(GlobalStoreKeyPrefix, []byte("PendingList")...)
 	// DataSourceCountStoreKey is the key that keeps the total data source count.
 	DataSourceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("DataSourceCount")...)
 	// OracleScriptCountStoreKey is the key that keeps the total oracle script count.
 	OracleScriptCountStoreKey = append(GlobalStoreKeyPrefix, []byte("OracleScriptCount")...)
+	// SubscriptionCountStoreKey is the key that keeps the total subscription count.
+	SubscriptionCountStoreKey = append(GlobalStoreKeyPrefix, []byte("SubscriptionCount")...)
 
 	// RequestStoreKeyPrefix is the prefix for request store.
 	RequestStoreKeyPrefix = []byte{0x01}
@@ -54,6 +68,24 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// LatestRequestStoreKeyPrefix is the prefix for the latest successful request of a request search key.
+	LatestRequestStoreKeyPrefix = []byte{0x08}
+	// PriceResultStoreKeyPrefix is the prefix for the latest price result of a symbol.
+	PriceResultStoreKeyPrefix = []byte{0x09}
+	// LatestResultExpirationStoreKeyPrefix is the prefix for the resolve time index of the latest results.
+	LatestResultExpirationStoreKeyPrefix = []byte{0x0a}
+	// SubscriptionStoreKeyPrefix is the prefix for subscription store.
+	SubscriptionStoreKeyPrefix = []byte{0x0b}
+	// SubscriptionQueueStoreKeyPrefix is the prefix for the schedule queue of active subscriptions.
+	SubscriptionQueueStoreKeyPrefix = []byte{0x0c}
+	// DataSourceVersionStoreKeyPrefix is the prefix for the version history of data sources.
+	DataSourceVersionStoreKeyPrefix = []byte{0x0d}
+	// OracleScriptVersionStoreKeyPrefix is the prefix for the version history of oracle scripts.
+	OracleScriptVersionStoreKeyPrefix = []byte{0x0e}
+	// FeeReceiptStoreKeyPrefix is the prefix for the fee receipts of requests.
+	FeeReceiptStoreKeyPrefix = []byte{0x0f}
+	// FeeSettlementQueueStoreKeyPrefix is the prefix for the retry queue of failed fee settlements.
+	FeeSettlementQueueStoreKeyPrefix = []byte{0x10}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -81,6 +113,26 @@ func OracleScriptStoreKey(oracleScriptID OracleScriptID) []byte {
 	return append(OracleScriptStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
 }
 
+// DataSourceVersionsPrefixKey returns the prefix key to get all versions of a data source.
+func DataSourceVersionsPrefixKey(dataSourceID DataSourceID) []byte {
+	return append(DataSourceVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(dataSourceID))...)
+}
+
+// DataSourceVersionStoreKey returns the key to retrieve a specific version of a data source from the store.
+func DataSourceVersionStoreKey(dataSourceID DataSourceID, version uint64) []byte {
+	return append(DataSourceVersionsPrefixKey(dataSourceID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// OracleScriptVersionsPrefixKey returns the prefix key to get all versions of an oracle script.
+func OracleScriptVersionsPrefixKey(oracleScriptID OracleScriptID) []byte {
+	return append(OracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
+}
+
+// OracleScriptVersionStoreKey returns the key to retrieve a specific version of an oracle script from the store.
+func OracleScriptVersionStoreKey(oracleScriptID OracleScriptID, version uint64) []byte {
+	return append(OracleScriptVersionsPrefixKey(oracleScriptID), sdk.Uint64ToBigEndian(version)...)
+}
+
 // ValidatorStatusStoreKey returns the key to a validator's status.
 func ValidatorStatusStoreKey(v sdk.ValAddress) []byte {
 	return append(ValidatorStatusKeyPrefix, v.Bytes()...)
@@ -96,9 +148,63 @@ func ResultStoreKey(requestID RequestID) []byte {
 	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
 }
 
+// FeeReceiptStoreKey returns the key to the fee receipt of a request in the store.
+func FeeReceiptStoreKey(requestID RequestID) []byte {
+	return append(FeeReceiptStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// FeeSettlementQueueStoreKey returns the key to the retry queue entry of the fee settlement of a
+// request that is due at the given block height.
+func FeeSettlementQueueStoreKey(retryHeight int64, requestID RequestID) []byte {
+	buf := append(FeeSettlementQueueStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(retryHeight))...)
+	return append(buf, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
 // ReportsOfValidatorPrefixKey returns the prefix key to get all reports for a request from a validator.
 func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf := append(ReportStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
 	buf = append(buf, val.Bytes()...)
 	return buf
 }
+
+// LatestRequestStoreKey returns the key to the latest successful request of the given search parameters.
+// The parameters are hashed to keep the key size bounded regardless of the calldata size.
+func LatestRequestStoreKey(oid OracleScriptID, calldata []byte, askCount uint64, minCount uint64) []byte {
+	buf := sdk.Uint64ToBigEndian(uint64(oid))
+	buf = append(buf, sdk.Uint64ToBigEndian(askCount)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	buf = append(buf, calldata...)
+	hash := sha256.Sum256(buf)
+
+	return append(LatestRequestStoreKeyPrefix, hash[:]...)
+}
+
+// PriceResultStoreKey returns the key to the latest price result of the given symbol.
+func PriceResultStoreKey(symbol string, askCount uint64, minCount uint64) []byte {
+	buf := append(PriceResultStoreKeyPrefix, sdk.Uint64ToBigEndian(askCount)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	return append(buf, []byte(symbol)...)
+}
+
+// LatestResultExpirationStoreKey returns the key to the resolve time index of the given latest result key.
+func LatestResultExpirationStoreKey(resolveTime int64, key []byte) []byte {
+	buf := append(LatestResultExpirationStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(resolveTime))...)
+	return append(buf, key...)
+}
+
+// SubscriptionStoreKey returns the key to retrieve a specific subscription from the store.
+func SubscriptionStoreKey(subscriptionID SubscriptionID) []byte {
+	return append(SubscriptionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(subscriptionID))...)
+}
+
+// SubscriptionQueueUnitPrefixKey returns the prefix key of the schedule queue of the given interval unit.
+func SubscriptionQueueUnitPrefixKey(unit IntervalUnit) []byte {
+	return append(SubscriptionQueueStoreKeyPrefix, byte(unit))
+}
+
+// SubscriptionQueueStoreKey returns the key to the schedule queue entry of a subscription that is due
+// at the given block height or unix timestamp, depending on the interval unit.
+func SubscriptionQueueStoreKey(unit IntervalUnit, nextRun int64, subscriptionID SubscriptionID) []byte {
+	buf := append(SubscriptionQueueUnitPrefixKey(unit), sdk.Uint64ToBigEndian(uint64(nextRun))...)
+	return append(buf, sdk.Uint64ToBigEndian(uint64(subscriptionID))...)
+}
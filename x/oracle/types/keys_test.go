@@ -50,3 +50,23 @@ func TestReportsOfValidatorPrefixKey(t *testing.T) {
 	expect, _ := hex.DecodeString("020000000000000014b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	require.Equal(t, expect, ReportsOfValidatorPrefixKey(20, val))
 }
+
+func TestLatestRequestStoreKey(t *testing.T) {
+	key := LatestRequestStoreKey(1, []byte("calldata"), 4, 3)
+	require.Len(t, key, 33)
+	require.Equal(t, LatestRequestStoreKeyPrefix, key[:1])
+	require.Equal(t, key, LatestRequestStoreKey(1, []byte("calldata"), 4, 3))
+	require.NotEqual(t, key, LatestRequestStoreKey(1, []byte("calldata"), 4, 2))
+	require.NotEqual(t, key, LatestRequestStoreKey(2, []byte("calldata"), 4, 3))
+	require.NotEqual(t, key, LatestRequestStoreKey(1, []byte("calldatb"), 4, 3))
+}
+
+func TestPriceResultStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0900000000000000040000000000000003425443")
+	require.Equal(t, expect, PriceResultStoreKey("BTC", 4, 3))
+}
+
+func TestLatestResultExpirationStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0a00000000000003e8ffee")
+	require.Equal(t, expect, LatestResultExpirationStoreKey(1000, []byte{0xff, 0xee}))
+}
@@ -17,6 +17,9 @@ var (
 	_ sdk.Msg = (*MsgCreateOracleScript)(nil)
 	_ sdk.Msg = (*MsgEditOracleScript)(nil)
 	_ sdk.Msg = (*MsgActivate)(nil)
+	_ sdk.Msg = (*MsgCreateSubscription)(nil)
+	_ sdk.Msg = (*MsgUpdateSubscription)(nil)
+	_ sdk.Msg = (*MsgCancelSubscription)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRequestData)(nil)
@@ -26,6 +29,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgCreateOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgEditOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelSubscription)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
@@ -93,6 +99,12 @@ func (m MsgRequestData) ValidateBasic() error {
 		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", m.TSSEncoder)
 	}
 
+	if m.Callback != nil {
+		if err := m.Callback.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -236,6 +248,9 @@ func (m MsgEditDataSource) ValidateBasic() error {
 	if len(m.Description) > MaxDescriptionLength {
 		return WrapMaxError(ErrTooLongDescription, len(m.Description), MaxDescriptionLength)
 	}
+	if len(m.Changelog) > MaxChangelogLength {
+		return WrapMaxError(ErrTooLongChangelog, len(m.Changelog), MaxChangelogLength)
+	}
 	if !m.Fee.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(m.Fee.String())
 	}
@@ -350,6 +365,9 @@ func (m MsgEditOracleScript) ValidateBasic() error {
 	if len(m.SourceCodeURL) > MaxURLLength {
 		return WrapMaxError(ErrTooLongURL, len(m.SourceCodeURL), MaxURLLength)
 	}
+	if len(m.Changelog) > MaxChangelogLength {
+		return WrapMaxError(ErrTooLongChangelog, len(m.Changelog), MaxChangelogLength)
+	}
 	if len(m.Code) == 0 {
 		return ErrEmptyWasmCode
 	}
@@ -378,6 +396,139 @@ func (m MsgActivate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgCreateSubscription creates a new MsgCreateSubscription instance
+func NewMsgCreateSubscription(
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	interval uint64,
+	intervalUnit IntervalUnit,
+	maxRuns uint64,
+	initialDeposit sdk.Coins,
+	sender sdk.AccAddress,
+) *MsgCreateSubscription {
+	return &MsgCreateSubscription{
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		Interval:       interval,
+		IntervalUnit:   intervalUnit,
+		MaxRuns:        maxRuns,
+		InitialDeposit: initialDeposit,
+		Sender:         sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCreateSubscription instance (sdk.Msg interface).
+func (m MsgCreateSubscription) ValidateBasic() error {
+	// the request template is validated the same way as a one-off request.
+	request := MsgRequestData{
+		OracleScriptID: m.OracleScriptID,
+		Calldata:       m.Calldata,
+		AskCount:       m.AskCount,
+		MinCount:       m.MinCount,
+		ClientID:       m.ClientID,
+		FeeLimit:       m.FeeLimit,
+		PrepareGas:     m.PrepareGas,
+		ExecuteGas:     m.ExecuteGas,
+		Sender:         m.Sender,
+		TSSEncoder:     m.TSSEncoder,
+	}
+	if err := request.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := validateSchedule(m.Interval, m.IntervalUnit); err != nil {
+		return err
+	}
+	if !m.InitialDeposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.InitialDeposit.String())
+	}
+	return nil
+}
+
+// NewMsgUpdateSubscription creates a new MsgUpdateSubscription instance
+func NewMsgUpdateSubscription(
+	subscriptionID SubscriptionID,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	interval uint64,
+	intervalUnit IntervalUnit,
+	maxRuns uint64,
+	sender sdk.AccAddress,
+) *MsgUpdateSubscription {
+	return &MsgUpdateSubscription{
+		SubscriptionID: subscriptionID,
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		Interval:       interval,
+		IntervalUnit:   intervalUnit,
+		MaxRuns:        maxRuns,
+		Sender:         sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgUpdateSubscription instance (sdk.Msg interface).
+func (m MsgUpdateSubscription) ValidateBasic() error {
+	request := MsgRequestData{
+		OracleScriptID: m.OracleScriptID,
+		Calldata:       m.Calldata,
+		AskCount:       m.AskCount,
+		MinCount:       m.MinCount,
+		ClientID:       m.ClientID,
+		FeeLimit:       m.FeeLimit,
+		PrepareGas:     m.PrepareGas,
+		ExecuteGas:     m.ExecuteGas,
+		Sender:         m.Sender,
+		TSSEncoder:     m.TSSEncoder,
+	}
+	if err := request.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateSchedule(m.Interval, m.IntervalUnit)
+}
+
+// NewMsgCancelSubscription creates a new MsgCancelSubscription instance
+func NewMsgCancelSubscription(subscriptionID SubscriptionID, sender sdk.AccAddress) *MsgCancelSubscription {
+	return &MsgCancelSubscription{
+		SubscriptionID: subscriptionID,
+		Sender:         sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCancelSubscription instance (sdk.Msg interface).
+func (m MsgCancelSubscription) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
+	}
+	return nil
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
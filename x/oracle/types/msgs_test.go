@@ -361,6 +361,24 @@ func TestMsgEditOracleScriptValidation(t *testing.T) {
 	})
 }
 
+func TestMsgEditChangelogValidation(t *testing.T) {
+	editDataSource := NewMsgEditDataSource(
+		1, "name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr,
+	)
+	editDataSource.Changelog = strings.Repeat("x", MaxChangelogLength)
+	require.NoError(t, editDataSource.ValidateBasic())
+	editDataSource.Changelog = strings.Repeat("x", MaxChangelogLength+1)
+	require.ErrorIs(t, editDataSource.ValidateBasic(), ErrTooLongChangelog)
+
+	editOracleScript := NewMsgEditOracleScript(
+		1, "name", "desc", "schema", "url", []byte("code"), GoodTestAddr, GoodTestAddr,
+	)
+	editOracleScript.Changelog = strings.Repeat("x", MaxChangelogLength)
+	require.NoError(t, editOracleScript.ValidateBasic())
+	editOracleScript.Changelog = strings.Repeat("x", MaxChangelogLength+1)
+	require.ErrorIs(t, editOracleScript.ValidateBasic(), ErrTooLongChangelog)
+}
+
 func TestMsgRequestDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0)},
@@ -374,6 +392,53 @@ func TestMsgRequestDataValidation(t *testing.T) {
 	})
 }
 
+func TestMsgRequestDataCallbackValidation(t *testing.T) {
+	withCallback := func(callback *RequestCallback) *MsgRequestData {
+		msg := NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0)
+		msg.Callback = callback
+		return msg
+	}
+
+	performValidateTests(t, []validateTestCase{
+		{true, withCallback(NewRequestCallback("tunnel", []byte("payload"), 100000))},
+		{true, withCallback(NewRequestCallback("tunnel", nil, MaxCallbackGasLimit))},
+		{false, withCallback(NewRequestCallback("", []byte("payload"), 100000))},
+		{false, withCallback(NewRequestCallback("tunnel/v1", []byte("payload"), 100000))},
+		{false, withCallback(NewRequestCallback("tunnel", make([]byte, MaxCallbackPayloadSize+1), 100000))},
+		{false, withCallback(NewRequestCallback("tunnel", []byte("payload"), 0))},
+		{false, withCallback(NewRequestCallback("tunnel", []byte("payload"), MaxCallbackGasLimit+1))},
+	})
+}
+
+func TestMsgCreateSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, GoodCoins, GoodTestAddr)},
+		{true, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 60, INTERVAL_UNIT_SECOND, 5, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 2, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 0, INTERVAL_UNIT_BLOCK, 0, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_UNSPECIFIED, 0, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, MaxSubscriptionInterval+1, INTERVAL_UNIT_SECOND, 0, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, BadCoins, GoodTestAddr)},
+		{false, NewMsgCreateSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, GoodCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgUpdateSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgUpdateSubscription(1, 1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, GoodTestAddr)},
+		{false, NewMsgUpdateSubscription(1, 1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 0, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, GoodTestAddr)},
+		{false, NewMsgUpdateSubscription(1, 1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 0, INTERVAL_UNIT_SECOND, 0, GoodTestAddr)},
+		{false, NewMsgUpdateSubscription(1, 1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, INTERVAL_UNIT_BLOCK, 0, EmptyAddr)},
+	})
+}
+
+func TestMsgCancelSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCancelSubscription(1, GoodTestAddr)},
+		{false, NewMsgCancelSubscription(1, EmptyAddr)},
+	})
+}
+
 func TestMsgReportDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, GoodTestValAddr)},
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_x_bandtss_types "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -104,6 +105,74 @@ func (Encoder) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9714783eaff1514b, []int{1}
 }
 
+// IntervalUnit is an enumerator that defines the unit of a subscription
+// interval.
+type IntervalUnit int32
+
+const (
+	// INTERVAL_UNIT_UNSPECIFIED is for unspecified value
+	INTERVAL_UNIT_UNSPECIFIED IntervalUnit = 0
+	// INTERVAL_UNIT_BLOCK is for an interval in blocks
+	INTERVAL_UNIT_BLOCK IntervalUnit = 1
+	// INTERVAL_UNIT_SECOND is for an interval in seconds
+	INTERVAL_UNIT_SECOND IntervalUnit = 2
+)
+
+var IntervalUnit_name = map[int32]string{
+	0: "INTERVAL_UNIT_UNSPECIFIED",
+	1: "INTERVAL_UNIT_BLOCK",
+	2: "INTERVAL_UNIT_SECOND",
+}
+
+var IntervalUnit_value = map[string]int32{
+	"INTERVAL_UNIT_UNSPECIFIED": 0,
+	"INTERVAL_UNIT_BLOCK":       1,
+	"INTERVAL_UNIT_SECOND":      2,
+}
+
+func (x IntervalUnit) String() string {
+	return proto.EnumName(IntervalUnit_name, int32(x))
+}
+
+func (IntervalUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{2}
+}
+
+// SubscriptionStatus is an enumerator that defines the status of a
+// subscription.
+type SubscriptionStatus int32
+
+const (
+	// SUBSCRIPTION_STATUS_UNSPECIFIED is for unspecified value
+	SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	// SUBSCRIPTION_STATUS_ACTIVE is for a subscription that makes requests on
+	// schedule
+	SUBSCRIPTION_STATUS_ACTIVE SubscriptionStatus = 1
+	// SUBSCRIPTION_STATUS_PAUSED is for a subscription that stopped making
+	// requests because its deposit ran out
+	SUBSCRIPTION_STATUS_PAUSED SubscriptionStatus = 2
+)
+
+var SubscriptionStatus_name = map[int32]string{
+	0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+	1: "SUBSCRIPTION_STATUS_ACTIVE",
+	2: "SUBSCRIPTION_STATUS_PAUSED",
+}
+
+var SubscriptionStatus_value = map[string]int32{
+	"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+	"SUBSCRIPTION_STATUS_ACTIVE":      1,
+	"SUBSCRIPTION_STATUS_PAUSED":      2,
+}
+
+func (x SubscriptionStatus) String() string {
+	return proto.EnumName(SubscriptionStatus_name, int32(x))
+}
+
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{3}
+}
+
 // DataSource is the data structure for storing data sources in the storage.
 type DataSource struct {
 	// Owner is an address of the account who own the data source
@@ -293,6 +362,199 @@ func (m *OracleScript) GetSourceCodeURL() string {
 	return ""
 }
 
+// DataSourceVersion is an immutable record of a data source executable, which
+// is appended every time the data source is created or edited.
+type DataSourceVersion struct {
+	// DataSourceID is the ID of the data source
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Editor is the address of the account who created this version
+	Editor string `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Changelog is an optional description of the changes in this version
+	Changelog string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
+}
+
+func (m *DataSourceVersion) Reset()         { *m = DataSourceVersion{} }
+func (m *DataSourceVersion) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersion) ProtoMessage()    {}
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{2}
+}
+func (m *DataSourceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSourceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersion.Merge(m, src)
+}
+func (m *DataSourceVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersion proto.InternalMessageInfo
+
+func (m *DataSourceVersion) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DataSourceVersion) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *DataSourceVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
+// OracleScriptVersion is an immutable record of a compiled oracle script,
+// which is appended every time the oracle script is created or edited.
+type OracleScriptVersion struct {
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script at this version
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Editor is the address of the account who created this version
+	Editor string `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Changelog is an optional description of the changes in this version
+	Changelog string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// Schema is the OBI schema of the oracle script at this version
+	Schema string `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *OracleScriptVersion) Reset()         { *m = OracleScriptVersion{} }
+func (m *OracleScriptVersion) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptVersion) ProtoMessage()    {}
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{3}
+}
+func (m *OracleScriptVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleScriptVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptVersion.Merge(m, src)
+}
+func (m *OracleScriptVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptVersion proto.InternalMessageInfo
+
+func (m *OracleScriptVersion) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	// ExternalID is an ID of the raw request
@@ -302,13 +564,15 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source to be executed
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (m *RawRequest) Reset()         { *m = RawRequest{} }
 func (m *RawRequest) String() string { return proto.CompactTextString(m) }
 func (*RawRequest) ProtoMessage()    {}
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{2}
+	return fileDescriptor_9714783eaff1514b, []int{4}
 }
 func (m *RawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,6 +622,13 @@ func (m *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (m *RawRequest) GetDataSourceVersion() uint64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	// ExternalID is an ID of the raw request
@@ -376,7 +647,7 @@ func (m *RawReport) Reset()         { *m = RawReport{} }
 func (m *RawReport) String() string { return proto.CompactTextString(m) }
 func (*RawReport) ProtoMessage()    {}
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{3}
+	return fileDescriptor_9714783eaff1514b, []int{5}
 }
 func (m *RawReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,13 +731,19 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// Callback is the result callback to run when the request is resolved or
+	// expired, if any.
+	Callback *RequestCallback `protobuf:"bytes,15,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{4}
+	return fileDescriptor_9714783eaff1514b, []int{6}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,6 +863,86 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Request) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func (m *Request) GetCallback() *RequestCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
+// RequestCallback is the callback of a module on BandChain that is run when a
+// request is resolved or expired.
+type RequestCallback struct {
+	// Module is the name of the module registered in the result callback router.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Payload is the arbitrary data passed to the callback of the module.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// GasLimit is the maximum amount of gas that the callback can consume. It is
+	// charged when the request is created.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *RequestCallback) Reset()         { *m = RequestCallback{} }
+func (m *RequestCallback) String() string { return proto.CompactTextString(m) }
+func (*RequestCallback) ProtoMessage()    {}
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{7}
+}
+func (m *RequestCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCallback.Merge(m, src)
+}
+func (m *RequestCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCallback proto.InternalMessageInfo
+
+func (m *RequestCallback) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *RequestCallback) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *RequestCallback) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	// Validator is a validator address who submit the report
@@ -602,7 +959,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{5}
+	return fileDescriptor_9714783eaff1514b, []int{8}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,13 +1038,16 @@ type OracleRequestPacketData struct {
 	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,10,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData{} }
 func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketData) ProtoMessage()    {}
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{6}
+	return fileDescriptor_9714783eaff1514b, []int{9}
 }
 func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,6 +1139,13 @@ func (m *OracleRequestPacketData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *OracleRequestPacketData) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
 // OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
 // send back to requester chain.
 type OracleRequestPacketAcknowledgement struct {
@@ -790,7 +1157,7 @@ func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleReques
 func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{7}
+	return fileDescriptor_9714783eaff1514b, []int{10}
 }
 func (m *OracleRequestPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1223,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{8}
+	return fileDescriptor_9714783eaff1514b, []int{11}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1342,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{9}
+	return fileDescriptor_9714783eaff1514b, []int{12}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,28 +1448,41 @@ func (m *Result) GetResult() []byte {
 	return nil
 }
 
-// SigningResult encodes a result of signing of request
-type SigningResult struct {
-	// signing_id is the id of the bandtss signing
-	SigningID github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/x/bandtss/types.SigningID" json:"signing_id,omitempty"`
-	// error_codespace is the codespace of the error
-	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
-	// error_code is the code in the codespace of the error
-	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
-}
-
-func (m *SigningResult) Reset()         { *m = SigningResult{} }
-func (m *SigningResult) String() string { return proto.CompactTextString(m) }
-func (*SigningResult) ProtoMessage()    {}
-func (*SigningResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{10}
+// FeeReceipt is the itemized breakdown of the data source fees of a request.
+// The fees are held in escrow when the request is made, and they are settled
+// when the request expires: the treasuries are paid for the raw reports that
+// are actually delivered and the remainder is refunded to the payer.
+type FeeReceipt struct {
+	// RequestID is the ID of the request that the fees are paid for.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// Payer is the address of the account that pays the fees and gets the
+	// refund, unless a refund address is set.
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Escrowed is the total fees that are held in escrow for the request.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// Items are the fees of the raw requests that have a fee.
+	Items []FeeReceiptItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items"`
+	// Refunded is the fees that are refunded to the payer.
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+	// Settled is a flag indicating whether the fees have been settled.
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
+	// RefundAddress is the address of the account that gets the refund instead
+	// of the payer, e.g. the owner of the subscription that makes the request.
+	RefundAddress string `protobuf:"bytes,7,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *FeeReceipt) Reset()         { *m = FeeReceipt{} }
+func (m *FeeReceipt) String() string { return proto.CompactTextString(m) }
+func (*FeeReceipt) ProtoMessage()    {}
+func (*FeeReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
-func (m *SigningResult) XXX_Unmarshal(b []byte) error {
+func (m *FeeReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SigningResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SigningResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeReceipt.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,54 +1492,237 @@ func (m *SigningResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SigningResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SigningResult.Merge(m, src)
+func (m *FeeReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReceipt.Merge(m, src)
 }
-func (m *SigningResult) XXX_Size() int {
+func (m *FeeReceipt) XXX_Size() int {
 	return m.Size()
 }
-func (m *SigningResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_SigningResult.DiscardUnknown(m)
+func (m *FeeReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReceipt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SigningResult proto.InternalMessageInfo
+var xxx_messageInfo_FeeReceipt proto.InternalMessageInfo
 
-func (m *SigningResult) GetSigningID() github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID {
+func (m *FeeReceipt) GetRequestID() RequestID {
 	if m != nil {
-		return m.SigningID
+		return m.RequestID
 	}
 	return 0
 }
 
-func (m *SigningResult) GetErrorCodespace() string {
+func (m *FeeReceipt) GetPayer() string {
 	if m != nil {
-		return m.ErrorCodespace
+		return m.Payer
 	}
 	return ""
 }
 
-func (m *SigningResult) GetErrorCode() uint64 {
+func (m *FeeReceipt) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.ErrorCode
+		return m.Escrowed
 	}
-	return 0
-}
-
-// ValidatorStatus maintains whether a validator is an active oracle provider.
-type ValidatorStatus struct {
-	// IsActive is a boolean indicating active status of validator.
-	// The validator will be deactivated when they are unable to send reports
-	// to fulfill oracle request before the request expired.
-	IsActive bool `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// Since is a block timestamp when validator has been activated/deactivated
-	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+	return nil
+}
+
+func (m *FeeReceipt) GetItems() []FeeReceiptItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *FeeReceipt) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+// FeeReceiptItem is the fee of a raw request in a fee receipt.
+type FeeReceiptItem struct {
+	// ExternalID is the external ID of the raw request.
+	ExternalID ExternalID `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
+	// DataSourceID is the ID of the data source of the raw request.
+	DataSourceID DataSourceID `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Treasury is the address of the data source treasury at the request time.
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// FeePerReport is the fee paid to the treasury for each raw report.
+	FeePerReport github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee_per_report,json=feePerReport,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_report"`
+	// ReportCount is the number of raw reports delivered for the raw request.
+	ReportCount uint64 `protobuf:"varint,5,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// Paid is the fees paid to the treasury.
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+}
+
+func (m *FeeReceiptItem) Reset()         { *m = FeeReceiptItem{} }
+func (m *FeeReceiptItem) String() string { return proto.CompactTextString(m) }
+func (*FeeReceiptItem) ProtoMessage()    {}
+func (*FeeReceiptItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{14}
+}
+func (m *FeeReceiptItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeReceiptItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeReceiptItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeReceiptItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReceiptItem.Merge(m, src)
+}
+func (m *FeeReceiptItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeReceiptItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReceiptItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReceiptItem proto.InternalMessageInfo
+
+func (m *FeeReceiptItem) GetExternalID() ExternalID {
+	if m != nil {
+		return m.ExternalID
+	}
+	return 0
+}
+
+func (m *FeeReceiptItem) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *FeeReceiptItem) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *FeeReceiptItem) GetFeePerReport() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerReport
+	}
+	return nil
+}
+
+func (m *FeeReceiptItem) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *FeeReceiptItem) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+// SigningResult encodes a result of signing of request
+type SigningResult struct {
+	// signing_id is the id of the bandtss signing
+	SigningID github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/x/bandtss/types.SigningID" json:"signing_id,omitempty"`
+	// error_codespace is the codespace of the error
+	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
+	// error_code is the code in the codespace of the error
+	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+}
+
+func (m *SigningResult) Reset()         { *m = SigningResult{} }
+func (m *SigningResult) String() string { return proto.CompactTextString(m) }
+func (*SigningResult) ProtoMessage()    {}
+func (*SigningResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{15}
+}
+func (m *SigningResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningResult.Merge(m, src)
+}
+func (m *SigningResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningResult proto.InternalMessageInfo
+
+func (m *SigningResult) GetSigningID() github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID {
+	if m != nil {
+		return m.SigningID
+	}
+	return 0
+}
+
+func (m *SigningResult) GetErrorCodespace() string {
+	if m != nil {
+		return m.ErrorCodespace
+	}
+	return ""
+}
+
+func (m *SigningResult) GetErrorCode() uint64 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+// ValidatorStatus maintains whether a validator is an active oracle provider.
+type ValidatorStatus struct {
+	// IsActive is a boolean indicating active status of validator.
+	// The validator will be deactivated when they are unable to send reports
+	// to fulfill oracle request before the request expired.
+	IsActive bool `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Since is a block timestamp when validator has been activated/deactivated
+	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
 }
 
 func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{11}
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1777,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{17}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1853,36 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// LatestResultRetention is the duration that the latest successful result of
+	// a request search key or a price symbol is kept in the index after it is
+	// resolved. Zero disables the index.
+	LatestResultRetention uint64 `protobuf:"varint,12,opt,name=latest_result_retention,json=latestResultRetention,proto3" json:"latest_result_retention,omitempty"`
+	// MaxSubscriptionRunsPerBlock is the maximum number of subscriptions that
+	// can make their requests in a block. The remaining due subscriptions are
+	// carried over to the next blocks. Zero stops all subscriptions from making
+	// requests.
+	MaxSubscriptionRunsPerBlock uint64 `protobuf:"varint,13,opt,name=max_subscription_runs_per_block,json=maxSubscriptionRunsPerBlock,proto3" json:"max_subscription_runs_per_block,omitempty"`
+	// ResultRetentionPeriod is the duration that the result of an expired
+	// request is kept in the state after it is resolved. Zero disables pruning
+	// by age.
+	ResultRetentionPeriod uint64 `protobuf:"varint,14,opt,name=result_retention_period,json=resultRetentionPeriod,proto3" json:"result_retention_period,omitempty"`
+	// ResultRetentionCount is the number of the most recent requests whose
+	// results are kept in the state. Zero disables pruning by count.
+	ResultRetentionCount uint64 `protobuf:"varint,15,opt,name=result_retention_count,json=resultRetentionCount,proto3" json:"result_retention_count,omitempty"`
+	// MaxResultPrunesPerBlock is the maximum number of results that can be
+	// pruned in a block. The remaining results are pruned in the next blocks.
+	MaxResultPrunesPerBlock uint64 `protobuf:"varint,16,opt,name=max_result_prunes_per_block,json=maxResultPrunesPerBlock,proto3" json:"max_result_prunes_per_block,omitempty"`
+	// SubscriptionGasPrices is the gas prices of the gas used by the requests of
+	// subscriptions. The requests are made in the end block without a
+	// transaction, so the fee is paid from the deposit of the subscription.
+	SubscriptionGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,17,rep,name=subscription_gas_prices,json=subscriptionGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"subscription_gas_prices"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +1988,48 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetLatestResultRetention() uint64 {
+	if m != nil {
+		return m.LatestResultRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubscriptionRunsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSubscriptionRunsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetResultRetentionPeriod() uint64 {
+	if m != nil {
+		return m.ResultRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetResultRetentionCount() uint64 {
+	if m != nil {
+		return m.ResultRetentionCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxResultPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxResultPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetSubscriptionGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.SubscriptionGasPrices
+	}
+	return nil
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +2040,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{19}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +2091,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +2153,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{21}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +2238,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{22}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +2314,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{23}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1715,177 +2343,452 @@ func (m *OracleResultSignatureOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OracleResultSignatureOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("band.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
-	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
-	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
-	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
-	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
-	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
-	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
-	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
-	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
-	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
-	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
-	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
-	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
-	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
-	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
-	proto.RegisterType((*Params)(nil), "band.oracle.v1.Params")
-	proto.RegisterType((*PendingResolveList)(nil), "band.oracle.v1.PendingResolveList")
-	proto.RegisterType((*IBCChannel)(nil), "band.oracle.v1.IBCChannel")
-	proto.RegisterType((*RequestVerification)(nil), "band.oracle.v1.RequestVerification")
-	proto.RegisterType((*PriceResult)(nil), "band.oracle.v1.PriceResult")
-	proto.RegisterType((*OracleResultSignatureOrder)(nil), "band.oracle.v1.OracleResultSignatureOrder")
+// Subscription is a recurring oracle request that is made on schedule and paid
+// from its deposit account.
+type Subscription struct {
+	// ID is the unique identifier of this subscription.
+	ID SubscriptionID `protobuf:"varint,1,opt,name=id,proto3,casttype=SubscriptionID" json:"id,omitempty"`
+	// Owner is the account address who is allowed to update and cancel the
+	// subscription.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// DepositAccount is the account address that pays the fees of the requests.
+	DepositAccount string `protobuf:"bytes,3,opt,name=deposit_account,json=depositAccount,proto3" json:"deposit_account,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,4,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,5,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,6,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,7,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientID string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,10,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,11,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,12,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,14,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,15,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Runs is the number of requests that have been made.
+	Runs uint64 `protobuf:"varint,16,opt,name=runs,proto3" json:"runs,omitempty"`
+	// NextRun is the block height or the unix timestamp, depending on the
+	// interval unit, at which the next request is due.
+	NextRun int64 `protobuf:"varint,17,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	// Status is the status of the subscription.
+	Status SubscriptionStatus `protobuf:"varint,18,opt,name=status,proto3,enum=band.oracle.v1.SubscriptionStatus" json:"status,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,19,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{24}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
 
-var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xa9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xb1, 0x6d, 0x99, 0xe4, 0x14, 0x00, 0x00,
+func (m *Subscription) GetID() SubscriptionID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
 }
 
-func (this *DataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Subscription) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
+	return ""
+}
 
-	that1, ok := that.(*DataSource)
-	if !ok {
-		that2, ok := that.(DataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Subscription) GetDepositAccount() string {
+	if m != nil {
+		return m.DepositAccount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Subscription) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *Subscription) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *Subscription) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *Subscription) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *Subscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *Subscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *Subscription) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
+}
+
+func (m *Subscription) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *Subscription) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *Subscription) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Subscription) GetIntervalUnit() IntervalUnit {
+	if m != nil {
+		return m.IntervalUnit
+	}
+	return INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (m *Subscription) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *Subscription) GetRuns() uint64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+func (m *Subscription) GetNextRun() int64 {
+	if m != nil {
+		return m.NextRun
+	}
+	return 0
+}
+
+func (m *Subscription) GetStatus() SubscriptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (m *Subscription) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("band.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
+	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
+	proto.RegisterEnum("band.oracle.v1.IntervalUnit", IntervalUnit_name, IntervalUnit_value)
+	proto.RegisterEnum("band.oracle.v1.SubscriptionStatus", SubscriptionStatus_name, SubscriptionStatus_value)
+	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
+	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
+	proto.RegisterType((*DataSourceVersion)(nil), "band.oracle.v1.DataSourceVersion")
+	proto.RegisterType((*OracleScriptVersion)(nil), "band.oracle.v1.OracleScriptVersion")
+	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
+	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
+	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
+	proto.RegisterType((*RequestCallback)(nil), "band.oracle.v1.RequestCallback")
+	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
+	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
+	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
+	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
+	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
+	proto.RegisterType((*FeeReceipt)(nil), "band.oracle.v1.FeeReceipt")
+	proto.RegisterType((*FeeReceiptItem)(nil), "band.oracle.v1.FeeReceiptItem")
+	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
+	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
+	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
+	proto.RegisterType((*Params)(nil), "band.oracle.v1.Params")
+	proto.RegisterType((*PendingResolveList)(nil), "band.oracle.v1.PendingResolveList")
+	proto.RegisterType((*IBCChannel)(nil), "band.oracle.v1.IBCChannel")
+	proto.RegisterType((*RequestVerification)(nil), "band.oracle.v1.RequestVerification")
+	proto.RegisterType((*PriceResult)(nil), "band.oracle.v1.PriceResult")
+	proto.RegisterType((*OracleResultSignatureOrder)(nil), "band.oracle.v1.OracleResultSignatureOrder")
+	proto.RegisterType((*Subscription)(nil), "band.oracle.v1.Subscription")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
+
+var fileDescriptor_9714783eaff1514b = []byte{
+	// 2796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x6f, 0x24, 0x47,
+	0xd9, 0xf3, 0xb0, 0x67, 0xe6, 0x9b, 0xf1, 0xd8, 0x2e, 0x7b, 0xd7, 0xbd, 0xb3, 0x1b, 0x8f, 0x31,
+	0x01, 0x96, 0x85, 0x78, 0xb2, 0x1b, 0x14, 0x91, 0x4d, 0x90, 0x98, 0xd7, 0x26, 0x4d, 0x2c, 0x7b,
+	0x54, 0x63, 0xaf, 0x10, 0x12, 0x6a, 0xd5, 0x74, 0x97, 0xc7, 0x1d, 0xf7, 0x74, 0x4f, 0xaa, 0x7a,
+	0xfc, 0xc8, 0x05, 0x71, 0x0b, 0x39, 0x85, 0x2b, 0x52, 0xa4, 0xa0, 0xdc, 0x38, 0x21, 0x40, 0xfc,
+	0x05, 0xc2, 0x89, 0x88, 0x13, 0x12, 0x92, 0x83, 0x1c, 0x21, 0xf1, 0x07, 0xb8, 0x84, 0x03, 0xa8,
+	0x1e, 0xdd, 0xf3, 0xd8, 0xd9, 0xf5, 0x3e, 0x9c, 0x28, 0xe2, 0xe4, 0xfe, 0x5e, 0x55, 0xf5, 0xbd,
+	0xeb, 0xab, 0x31, 0x5c, 0xef, 0x10, 0xdf, 0xa9, 0x04, 0x8c, 0xd8, 0x1e, 0xad, 0x1c, 0xdd, 0xd6,
+	0x5f, 0x9b, 0x7d, 0x16, 0x84, 0x01, 0x2a, 0x0a, 0xe2, 0xa6, 0x46, 0x1d, 0xdd, 0x2e, 0xad, 0x74,
+	0x83, 0x6e, 0x20, 0x49, 0x15, 0xf1, 0xa5, 0xb8, 0x4a, 0xe5, 0x6e, 0x10, 0x74, 0x3d, 0x5a, 0x91,
+	0x50, 0x67, 0xb0, 0x5f, 0x09, 0xdd, 0x1e, 0xe5, 0x21, 0xe9, 0xf5, 0x35, 0xc3, 0x9a, 0x1d, 0xf0,
+	0x5e, 0xc0, 0x2b, 0x1d, 0xc2, 0xc5, 0x1e, 0x1d, 0x1a, 0x92, 0xdb, 0x15, 0x3b, 0x70, 0x7d, 0x4d,
+	0xbf, 0xa6, 0xe8, 0x96, 0x5a, 0x59, 0x01, 0x8a, 0xb4, 0xf1, 0xef, 0x04, 0x40, 0x83, 0x84, 0xa4,
+	0x1d, 0x0c, 0x98, 0x4d, 0xd1, 0x0a, 0xcc, 0x06, 0xc7, 0x3e, 0x65, 0x46, 0x62, 0x3d, 0x71, 0x33,
+	0x87, 0x15, 0x80, 0x10, 0xa4, 0x7d, 0xd2, 0xa3, 0x46, 0x52, 0x22, 0xe5, 0x37, 0x5a, 0x87, 0xbc,
+	0x43, 0xb9, 0xcd, 0xdc, 0x7e, 0xe8, 0x06, 0xbe, 0x91, 0x92, 0xa4, 0x51, 0x14, 0x2a, 0x41, 0x76,
+	0xdf, 0xf5, 0xa8, 0x94, 0x4c, 0x4b, 0x72, 0x0c, 0x0b, 0x5a, 0xc8, 0x28, 0xe1, 0x03, 0x76, 0x6a,
+	0xcc, 0x2a, 0x5a, 0x04, 0xa3, 0x9f, 0x42, 0x6a, 0x9f, 0x52, 0x63, 0x6e, 0x3d, 0x75, 0x33, 0x7f,
+	0xe7, 0xda, 0xa6, 0x3e, 0xae, 0xd0, 0x6d, 0x53, 0xeb, 0xb6, 0x59, 0x0f, 0x5c, 0xbf, 0xf6, 0xe2,
+	0xc7, 0x67, 0xe5, 0x99, 0xdf, 0x7c, 0x5a, 0xbe, 0xd9, 0x75, 0xc3, 0x83, 0x41, 0x67, 0xd3, 0x0e,
+	0x7a, 0x5a, 0x37, 0xfd, 0xe7, 0x05, 0xee, 0x1c, 0x56, 0xc2, 0xd3, 0x3e, 0xe5, 0x52, 0x80, 0x63,
+	0xb1, 0xee, 0xdd, 0xf4, 0xbf, 0x3e, 0x2c, 0x27, 0x36, 0xfe, 0x92, 0x80, 0xc2, 0x8e, 0xb4, 0x7b,
+	0x5b, 0x1e, 0xf8, 0x4b, 0xd3, 0xfc, 0x2a, 0xcc, 0x71, 0xfb, 0x80, 0xf6, 0x88, 0xd6, 0x5b, 0x43,
+	0xe8, 0x15, 0x58, 0xe0, 0xd2, 0x07, 0x96, 0x1d, 0x38, 0xd4, 0x1a, 0x30, 0xcf, 0x98, 0x13, 0x0c,
+	0xb5, 0xa5, 0xf3, 0xb3, 0xf2, 0xbc, 0x72, 0x4f, 0x3d, 0x70, 0xe8, 0x1e, 0xde, 0xc2, 0xf3, 0x7c,
+	0x08, 0x32, 0x4f, 0x6b, 0xf4, 0xdf, 0x04, 0x2c, 0x0d, 0x3d, 0x79, 0x9f, 0x32, 0x2e, 0x8e, 0x72,
+	0x0f, 0x8a, 0x0e, 0x09, 0x89, 0xa5, 0xd7, 0x76, 0x1d, 0xa9, 0x5f, 0xba, 0xb6, 0x7e, 0x7e, 0x56,
+	0x2e, 0x0c, 0xd9, 0xcd, 0xc6, 0xe7, 0x13, 0x30, 0x2e, 0x38, 0x43, 0xc8, 0x41, 0x06, 0x64, 0x8e,
+	0xd4, 0x92, 0xd2, 0x16, 0x69, 0x1c, 0x81, 0x63, 0xca, 0xa6, 0x26, 0x94, 0x7d, 0x11, 0xe6, 0xa8,
+	0xe3, 0x86, 0x01, 0x53, 0x66, 0xa8, 0x19, 0x7f, 0xfd, 0xc3, 0x0b, 0x2b, 0xda, 0xa1, 0x55, 0xc7,
+	0x61, 0x94, 0xf3, 0x76, 0xc8, 0x5c, 0xbf, 0x8b, 0x35, 0x9f, 0x30, 0xcf, 0x01, 0x75, 0xbb, 0x07,
+	0xa1, 0x34, 0x4f, 0x0a, 0x6b, 0x08, 0xdd, 0x80, 0x9c, 0x7d, 0x40, 0xfc, 0x2e, 0xf5, 0x82, 0xae,
+	0x32, 0x0c, 0x1e, 0x22, 0xb4, 0x05, 0x7e, 0x9d, 0x84, 0xe5, 0x51, 0x9f, 0x46, 0x36, 0xd8, 0x86,
+	0x45, 0x95, 0x62, 0x96, 0x72, 0xd1, 0xd0, 0x0a, 0xcf, 0x9f, 0x9f, 0x95, 0x8b, 0xa3, 0x22, 0xd2,
+	0x0e, 0x13, 0x18, 0x5c, 0x0c, 0x46, 0xe1, 0xaf, 0xb8, 0x2d, 0x46, 0x02, 0x2c, 0x33, 0x1a, 0x60,
+	0xda, 0x46, 0xff, 0x4c, 0x00, 0x60, 0x72, 0x8c, 0xe9, 0xdb, 0x03, 0xca, 0x43, 0xf4, 0x03, 0xc8,
+	0xd3, 0x93, 0x90, 0x32, 0x9f, 0x78, 0x43, 0xab, 0xdc, 0x38, 0x3f, 0x2b, 0x43, 0x53, 0xa3, 0xa5,
+	0x45, 0x46, 0x20, 0x0c, 0x91, 0x80, 0xe9, 0x4c, 0x89, 0xae, 0xe4, 0x53, 0x45, 0x57, 0x09, 0xb2,
+	0x36, 0xf1, 0x3c, 0x81, 0x93, 0x76, 0x2b, 0xe0, 0x18, 0x46, 0x9b, 0xb0, 0x3c, 0xba, 0x47, 0x64,
+	0xf9, 0xb4, 0xb4, 0xfc, 0x92, 0x33, 0x19, 0xf1, 0x5a, 0xcf, 0x9f, 0x27, 0x20, 0x27, 0xf5, 0xec,
+	0x07, 0xec, 0x99, 0xd5, 0xbc, 0x0e, 0x39, 0x7a, 0xe2, 0x86, 0x32, 0x33, 0xa5, 0x86, 0xf3, 0x38,
+	0x2b, 0x10, 0x22, 0x01, 0x45, 0x89, 0x18, 0x39, 0xb7, 0xfc, 0xd6, 0x67, 0xf8, 0xe3, 0x1c, 0x64,
+	0x22, 0x43, 0x5f, 0x76, 0x0c, 0x8e, 0x5a, 0x2c, 0x39, 0x61, 0xb1, 0xdb, 0xb0, 0xc2, 0xd4, 0xb6,
+	0xd4, 0xb1, 0x8e, 0x88, 0xe7, 0x3a, 0x24, 0x0c, 0x18, 0x37, 0x52, 0xeb, 0xa9, 0x9b, 0x39, 0xbc,
+	0x1c, 0xd3, 0xee, 0xc7, 0x24, 0xa1, 0x61, 0xcf, 0xf5, 0x2d, 0x3b, 0x18, 0xf8, 0xa1, 0x36, 0x6d,
+	0xb6, 0xe7, 0xfa, 0x75, 0x01, 0xa3, 0x6f, 0x40, 0x51, 0xcb, 0x58, 0x63, 0xf1, 0x38, 0xaf, 0xb1,
+	0x6f, 0xa8, 0xb0, 0xfc, 0x1a, 0x14, 0x22, 0x36, 0xd1, 0xa0, 0x64, 0x64, 0xa6, 0x70, 0x5e, 0xe3,
+	0x76, 0xdd, 0x1e, 0x45, 0xdf, 0x86, 0x9c, 0xed, 0xb9, 0xd4, 0x97, 0xea, 0xcb, 0xf0, 0xac, 0x15,
+	0xce, 0xcf, 0xca, 0xd9, 0xba, 0x44, 0x9a, 0x0d, 0x9c, 0x55, 0x64, 0xd3, 0x41, 0x75, 0x28, 0x30,
+	0x72, 0x6c, 0x69, 0x69, 0x6e, 0x64, 0x65, 0x3b, 0x28, 0x6d, 0x8e, 0x77, 0xcc, 0xcd, 0x61, 0x2c,
+	0xd7, 0xd2, 0xa2, 0x1f, 0xe0, 0x3c, 0x8b, 0x31, 0x1c, 0xbd, 0x09, 0x79, 0xb7, 0x63, 0x5b, 0x22,
+	0x39, 0x7c, 0xea, 0x19, 0xb9, 0xf5, 0xc4, 0xb4, 0x35, 0xcc, 0x5a, 0xbd, 0xae, 0x38, 0x6a, 0x45,
+	0x11, 0x13, 0x43, 0x18, 0x83, 0xdb, 0xb1, 0xf5, 0x37, 0x2a, 0x8b, 0x20, 0xa2, 0xf6, 0x20, 0xa4,
+	0x56, 0x97, 0x70, 0x03, 0xa4, 0x95, 0x40, 0xa3, 0x5e, 0x27, 0x1c, 0xbd, 0x01, 0xf9, 0x90, 0x73,
+	0x8b, 0xfa, 0x22, 0x4e, 0x98, 0x91, 0x5f, 0x4f, 0xdc, 0x2c, 0xde, 0x59, 0x9d, 0xdc, 0xad, 0xa9,
+	0xc8, 0x6a, 0xab, 0xdd, 0x76, 0x5b, 0xc3, 0x18, 0x42, 0xce, 0xf5, 0xb7, 0xc8, 0xf0, 0xc8, 0x4b,
+	0xcc, 0x28, 0xa8, 0x0c, 0x8f, 0x11, 0xe8, 0x00, 0x72, 0xfb, 0x94, 0x5a, 0x9e, 0xdb, 0x73, 0x43,
+	0x63, 0xfe, 0xf2, 0xdb, 0x64, 0x76, 0x9f, 0xd2, 0x2d, 0xb1, 0x38, 0xba, 0x03, 0x57, 0xc6, 0xa3,
+	0x36, 0xca, 0xbe, 0xa2, 0x54, 0x7e, 0x39, 0x98, 0x52, 0x6d, 0x5f, 0x55, 0x91, 0xd9, 0x21, 0xf6,
+	0xa1, 0xb1, 0x20, 0x0d, 0x5e, 0x7e, 0xc0, 0x69, 0x4a, 0x95, 0xba, 0x66, 0xc3, 0xb1, 0x80, 0x4e,
+	0x9c, 0x7d, 0x58, 0x98, 0x60, 0x11, 0x55, 0xad, 0x17, 0x38, 0x03, 0x8f, 0xea, 0xfe, 0xac, 0x21,
+	0x51, 0x8b, 0xfb, 0xe4, 0xd4, 0x0b, 0x88, 0xa3, 0xd3, 0x20, 0x02, 0x45, 0x48, 0x77, 0x09, 0xd7,
+	0x56, 0x4a, 0xa9, 0x90, 0xee, 0x12, 0x2e, 0x15, 0xd3, 0xfb, 0xfc, 0x2a, 0x01, 0x73, 0xba, 0x42,
+	0xdc, 0x80, 0x5c, 0x9c, 0x29, 0x7a, 0x8b, 0x21, 0x02, 0xdd, 0x82, 0x25, 0xd7, 0xb7, 0x3a, 0x74,
+	0x3f, 0x60, 0xd4, 0x62, 0x94, 0x07, 0xde, 0x91, 0x2a, 0x04, 0x59, 0xbc, 0xe0, 0xfa, 0x35, 0x89,
+	0xc7, 0x0a, 0x8d, 0x7e, 0x08, 0x79, 0x15, 0xb8, 0x62, 0x5d, 0x95, 0x74, 0xc2, 0x3f, 0xd3, 0xe2,
+	0x56, 0x70, 0xe8, 0xb0, 0x05, 0x16, 0x21, 0x78, 0x74, 0xb8, 0x34, 0xac, 0xaa, 0x22, 0xa0, 0x6d,
+	0xd1, 0x22, 0xf6, 0x21, 0x0d, 0x45, 0x15, 0x1d, 0xcf, 0xa3, 0xc4, 0x23, 0xf3, 0x68, 0x5a, 0xe1,
+	0x49, 0x5e, 0x52, 0xe1, 0x99, 0x2c, 0xd5, 0xd7, 0x21, 0x47, 0xf8, 0xe1, 0x78, 0x15, 0x21, 0xfc,
+	0x50, 0x55, 0x91, 0xb1, 0x12, 0x33, 0x3b, 0x51, 0x62, 0xc6, 0x42, 0x7a, 0xee, 0x8b, 0x0c, 0xe9,
+	0x32, 0xe4, 0xfb, 0x8c, 0xf6, 0x09, 0x53, 0x59, 0x9c, 0x51, 0x59, 0xac, 0x51, 0x22, 0x8b, 0x27,
+	0xd2, 0x3c, 0x7b, 0x51, 0x9a, 0xe7, 0x9e, 0x3e, 0xcd, 0x1f, 0x9a, 0x5e, 0xf0, 0xd0, 0xf4, 0xd2,
+	0xc1, 0x41, 0x61, 0x63, 0x4a, 0x6c, 0x54, 0xed, 0x43, 0x3f, 0x38, 0xf6, 0xa8, 0xd3, 0xa5, 0x3d,
+	0xea, 0x87, 0xe8, 0x15, 0x80, 0xa8, 0x22, 0xc7, 0xed, 0xa6, 0x74, 0x7e, 0x56, 0xce, 0x69, 0x29,
+	0xe9, 0xf0, 0x21, 0x10, 0xd7, 0x18, 0xd3, 0xd1, 0xdb, 0xfc, 0x29, 0x09, 0x46, 0xb4, 0x0f, 0xef,
+	0x07, 0x3e, 0xa7, 0x4f, 0x17, 0x84, 0xe3, 0x07, 0x49, 0x3e, 0xc1, 0x41, 0x64, 0x4c, 0xf9, 0x5c,
+	0x87, 0x8d, 0x4e, 0x63, 0xe2, 0x73, 0x15, 0x36, 0x93, 0x2d, 0x27, 0xfd, 0x60, 0xcb, 0x91, 0x2c,
+	0x32, 0x33, 0x15, 0xcb, 0x6c, 0xc4, 0x22, 0x71, 0x92, 0xa5, 0x01, 0x45, 0x0d, 0x5a, 0x3c, 0x24,
+	0xe1, 0x80, 0xcb, 0xd6, 0x55, 0xbc, 0xf3, 0xdc, 0x83, 0x75, 0x4b, 0x72, 0xb5, 0x25, 0x93, 0x68,
+	0x7f, 0x23, 0xa0, 0xa8, 0x50, 0x8c, 0xf2, 0x81, 0x17, 0xca, 0x98, 0x2a, 0x60, 0x0d, 0x69, 0x4b,
+	0xfe, 0x3d, 0x25, 0x4a, 0x8d, 0x40, 0xfc, 0xff, 0x25, 0xef, 0xb8, 0x77, 0xe7, 0x9e, 0xda, 0xbb,
+	0x99, 0x0b, 0xbc, 0x9b, 0xbd, 0xd8, 0xbb, 0xb9, 0xc7, 0xf1, 0x2e, 0x3c, 0x93, 0x77, 0xf3, 0x53,
+	0xbc, 0xfb, 0xbb, 0x14, 0xc0, 0x3d, 0x4a, 0x31, 0xb5, 0xa9, 0xdb, 0x9f, 0x34, 0xc8, 0x93, 0xe4,
+	0x9d, 0x18, 0x43, 0xfb, 0xe4, 0x94, 0x32, 0x3d, 0x71, 0x2a, 0x00, 0x75, 0x21, 0x2b, 0xc6, 0xcb,
+	0xe0, 0x98, 0x3a, 0x71, 0x43, 0xb9, 0xcc, 0xea, 0x18, 0x2d, 0x8e, 0xee, 0xc2, 0xac, 0x1b, 0xd2,
+	0x1e, 0x37, 0xd2, 0x72, 0x97, 0xb5, 0x49, 0x1b, 0x0d, 0x95, 0x34, 0x43, 0xda, 0xd3, 0xbd, 0x4b,
+	0x89, 0x88, 0x43, 0x32, 0xba, 0x3f, 0xf0, 0x1d, 0xea, 0x18, 0xb3, 0x5f, 0xc0, 0x21, 0xa3, 0xc5,
+	0x45, 0xcf, 0xe7, 0x34, 0x0c, 0x3d, 0xaa, 0x82, 0x2d, 0x8b, 0x23, 0x50, 0xdd, 0x54, 0x05, 0x97,
+	0x45, 0xd4, 0x44, 0xa5, 0x67, 0xa0, 0x79, 0x85, 0xd5, 0x63, 0x96, 0x76, 0xda, 0x6f, 0x53, 0x50,
+	0x1c, 0xd7, 0xe7, 0x2b, 0x34, 0x0e, 0xc5, 0xaf, 0x23, 0xa9, 0x89, 0xd7, 0x91, 0xb7, 0xa1, 0x28,
+	0x3a, 0x65, 0x9f, 0x32, 0x7d, 0xc5, 0x30, 0xd2, 0x97, 0x6f, 0xeb, 0xc2, 0x3e, 0xa5, 0x2d, 0xca,
+	0xf4, 0xdd, 0x48, 0x26, 0x99, 0xf8, 0x1a, 0xcb, 0xff, 0xbc, 0xc2, 0xa9, 0x54, 0xb5, 0x20, 0xdd,
+	0x27, 0x32, 0xf9, 0x2f, 0xfd, 0x2c, 0x72, 0x61, 0xed, 0xb2, 0x3f, 0x27, 0x60, 0xbe, 0xed, 0x76,
+	0x7d, 0x31, 0x25, 0xab, 0x62, 0xfa, 0x16, 0x00, 0x57, 0x88, 0xa1, 0xc3, 0xde, 0x14, 0xa9, 0xa6,
+	0xd9, 0xa4, 0xad, 0xef, 0x8e, 0x6c, 0x26, 0x02, 0x5a, 0x3e, 0x7d, 0xd9, 0x81, 0x57, 0xb1, 0x0f,
+	0x88, 0xeb, 0x57, 0x8e, 0x5e, 0xaa, 0x9c, 0x48, 0x7c, 0xc8, 0xb9, 0xde, 0x3a, 0x96, 0xc6, 0x39,
+	0xbd, 0xbc, 0xe9, 0xa0, 0x6f, 0xc1, 0x02, 0x65, 0x2c, 0x60, 0x72, 0x0e, 0xe4, 0x7d, 0x62, 0x47,
+	0xef, 0x42, 0x45, 0x89, 0xae, 0x47, 0x58, 0xf4, 0x1c, 0xc0, 0x90, 0x51, 0x37, 0xad, 0x5c, 0xcc,
+	0xa3, 0x75, 0xe9, 0xc3, 0x42, 0x3c, 0x80, 0xe9, 0x22, 0x73, 0x1d, 0x72, 0x2e, 0xb7, 0x88, 0x1d,
+	0xba, 0x47, 0xea, 0x9e, 0x9b, 0xc5, 0x59, 0x97, 0x57, 0x25, 0x2c, 0x52, 0x93, 0xbb, 0xbe, 0xde,
+	0x53, 0x4c, 0x31, 0xea, 0x55, 0x70, 0x33, 0x7a, 0x15, 0xdc, 0xdc, 0x8d, 0x5e, 0x05, 0x6b, 0x59,
+	0x61, 0xe4, 0xf7, 0x3f, 0x2d, 0x27, 0xb0, 0x12, 0xd1, 0x3b, 0x56, 0x61, 0x41, 0xad, 0x15, 0xef,
+	0x2b, 0x52, 0x29, 0xca, 0x14, 0x75, 0xe9, 0x8d, 0x40, 0x59, 0x88, 0x82, 0x63, 0x5d, 0x88, 0xd2,
+	0x58, 0x01, 0x1b, 0x9f, 0x67, 0x60, 0xae, 0x45, 0x18, 0xe9, 0x71, 0x74, 0x1b, 0xae, 0xf4, 0xc8,
+	0x89, 0x35, 0x32, 0xa4, 0xe9, 0xf0, 0x90, 0x4e, 0xc0, 0xa8, 0x47, 0x4e, 0x86, 0xc3, 0x99, 0x8a,
+	0x92, 0x0d, 0x98, 0x17, 0x22, 0xc3, 0x36, 0xa3, 0xd6, 0xce, 0xf7, 0xc8, 0x49, 0x35, 0xea, 0x34,
+	0xb7, 0x60, 0x49, 0xf0, 0x44, 0x6d, 0xc9, 0xe2, 0xee, 0x3b, 0x91, 0x09, 0x17, 0x7a, 0xe4, 0xa4,
+	0xae, 0xf1, 0x6d, 0xf7, 0x1d, 0x8a, 0x2a, 0xb0, 0x22, 0x8f, 0xa0, 0x82, 0x73, 0xc8, 0xae, 0xdf,
+	0x06, 0xc4, 0x09, 0x24, 0xa9, 0x11, 0x09, 0x7c, 0x0f, 0xae, 0xd2, 0x93, 0xbe, 0xcb, 0x88, 0x78,
+	0xa6, 0xb3, 0x3a, 0x5e, 0x60, 0x1f, 0x8e, 0xc5, 0xf4, 0xca, 0x90, 0x5a, 0x13, 0x44, 0x75, 0xa4,
+	0xe7, 0xa1, 0x28, 0x02, 0xd9, 0x0a, 0x8e, 0x09, 0xef, 0xc9, 0x4b, 0xa1, 0xec, 0x71, 0xb8, 0x20,
+	0xb0, 0x3b, 0x02, 0x29, 0xae, 0x85, 0xaf, 0xc0, 0x35, 0x91, 0x94, 0xf1, 0xd0, 0x10, 0x5b, 0x65,
+	0x78, 0xcd, 0xbc, 0xda, 0xa7, 0x2c, 0xb6, 0xbd, 0xb6, 0x8c, 0x10, 0xfd, 0x2e, 0x20, 0x4e, 0x7a,
+	0x7d, 0x4f, 0x44, 0x71, 0xc8, 0x4e, 0xf5, 0x91, 0xd4, 0xcd, 0x73, 0x31, 0xa2, 0xec, 0xb2, 0x53,
+	0x75, 0x9c, 0xef, 0x83, 0xa1, 0x2f, 0x05, 0x8c, 0x1e, 0x13, 0xe6, 0x88, 0x5a, 0x60, 0x53, 0x3f,
+	0x24, 0x5d, 0xd5, 0xff, 0xd2, 0xf8, 0x6a, 0xa0, 0xef, 0x6c, 0x82, 0xdc, 0x8a, 0xa9, 0xe8, 0x2e,
+	0x5c, 0x73, 0x7d, 0x15, 0x5e, 0x56, 0x9f, 0xfa, 0xc4, 0x0b, 0x4f, 0x2d, 0x67, 0xa0, 0xf4, 0xd5,
+	0x77, 0xce, 0xd5, 0x88, 0xa1, 0xa5, 0xe8, 0x0d, 0x4d, 0x46, 0x4d, 0x58, 0x16, 0xa3, 0x74, 0xa4,
+	0x14, 0xf5, 0x49, 0x47, 0x14, 0x60, 0xd1, 0x0d, 0xb3, 0xb5, 0x2b, 0xe7, 0x67, 0xe5, 0x25, 0xb3,
+	0x56, 0xd7, 0x3a, 0x35, 0x15, 0x11, 0x2f, 0xb9, 0x1d, 0x7b, 0x1c, 0x85, 0x5e, 0x86, 0x55, 0x8f,
+	0x84, 0x62, 0x05, 0xd5, 0x40, 0x2d, 0x46, 0x43, 0xea, 0xcb, 0x03, 0x14, 0xe4, 0x01, 0xae, 0x28,
+	0xb2, 0x4a, 0x6f, 0x1c, 0x11, 0x51, 0x03, 0xca, 0xc2, 0xd5, 0x7c, 0xd0, 0x89, 0x9f, 0x59, 0x2d,
+	0x36, 0xf0, 0xb9, 0x2c, 0x84, 0xd2, 0x91, 0xc6, 0xbc, 0x94, 0xbf, 0xde, 0x23, 0x27, 0xed, 0x11,
+	0x2e, 0x3c, 0xf0, 0x79, 0x8b, 0x32, 0xe9, 0x4e, 0xb1, 0xfb, 0xe4, 0xb6, 0x62, 0x01, 0x37, 0x70,
+	0xf4, 0x44, 0x7b, 0x85, 0x8d, 0xef, 0xdb, 0x92, 0x44, 0x11, 0x37, 0x0f, 0xc8, 0x29, 0x27, 0x2d,
+	0xa8, 0xb8, 0x99, 0x10, 0x53, 0x8e, 0x7a, 0x0d, 0xae, 0xab, 0xf0, 0x94, 0x92, 0x7d, 0x36, 0xf0,
+	0xe9, 0xe8, 0x79, 0x17, 0x95, 0xc1, 0x65, 0x94, 0x0a, 0x8e, 0x96, 0x64, 0x88, 0xcf, 0xfa, 0x8b,
+	0x04, 0xac, 0x8e, 0xa9, 0x2b, 0xa6, 0xd9, 0x3e, 0x73, 0x6d, 0xca, 0x8d, 0x25, 0x59, 0x66, 0x6f,
+	0x4c, 0x2d, 0xb3, 0x0d, 0x6a, 0xcb, 0x4a, 0xfb, 0x92, 0xae, 0xb4, 0xdf, 0x79, 0x8c, 0x4a, 0xab,
+	0x65, 0x38, 0xbe, 0x32, 0xba, 0xe3, 0xeb, 0x84, 0xb7, 0xe4, 0x7e, 0xba, 0x7e, 0xbc, 0x0a, 0xa8,
+	0x45, 0x7d, 0x47, 0x15, 0x5f, 0x71, 0x37, 0xda, 0x72, 0xb9, 0x1c, 0xa8, 0x86, 0x97, 0x1d, 0x51,
+	0x46, 0x52, 0x62, 0x5e, 0x8a, 0x6f, 0x34, 0x91, 0xf0, 0x8f, 0x60, 0xe4, 0x5d, 0x05, 0xad, 0x42,
+	0x46, 0xe6, 0x6c, 0x74, 0x03, 0xc6, 0x73, 0x02, 0x34, 0x1d, 0x51, 0x3a, 0xf5, 0x6b, 0x4d, 0xd4,
+	0x3e, 0xf5, 0xe3, 0xa6, 0x4f, 0xbd, 0x78, 0x2c, 0xf9, 0x28, 0x09, 0xcb, 0x3a, 0xae, 0xee, 0x53,
+	0xe6, 0xee, 0xbb, 0xb6, 0x8a, 0xd1, 0x6f, 0x42, 0x56, 0x56, 0xf4, 0xe1, 0xc5, 0x3a, 0x7f, 0x7e,
+	0x56, 0xce, 0xd4, 0x05, 0xce, 0x6c, 0xe0, 0x8c, 0x24, 0x9a, 0xce, 0xf8, 0xb0, 0x9f, 0x9c, 0x1c,
+	0xf6, 0xc7, 0x6f, 0x6f, 0xa9, 0x27, 0xb9, 0xbd, 0x4d, 0xdc, 0x1f, 0xd2, 0xcf, 0x7c, 0x7f, 0x98,
+	0x7d, 0x9a, 0xfb, 0x83, 0xb6, 0xd2, 0xef, 0x13, 0x90, 0x97, 0xfe, 0xd3, 0xad, 0x52, 0x3c, 0x0c,
+	0x9f, 0xf6, 0x3a, 0x81, 0x17, 0x99, 0x5c, 0x41, 0x68, 0x0d, 0xa0, 0x37, 0xf0, 0x42, 0xb7, 0xef,
+	0xb9, 0x71, 0xb9, 0x1f, 0xc1, 0xa0, 0x22, 0x24, 0xfb, 0x27, 0xba, 0x04, 0x27, 0xfb, 0x27, 0x13,
+	0xf6, 0x49, 0x3f, 0x89, 0x7d, 0x2e, 0x1e, 0xc6, 0x36, 0xde, 0x4f, 0x40, 0x29, 0x1e, 0x39, 0x07,
+	0x5e, 0x28, 0x3a, 0x31, 0x09, 0x07, 0x8c, 0xee, 0x30, 0x31, 0x32, 0x3f, 0xc3, 0xd5, 0xfa, 0x36,
+	0x64, 0xa2, 0x99, 0x3d, 0xf9, 0xc8, 0x99, 0x1d, 0x47, 0x7c, 0x77, 0xd3, 0xef, 0x7e, 0x58, 0x9e,
+	0xd9, 0xf8, 0x65, 0x06, 0x0a, 0xa3, 0x25, 0x05, 0xdd, 0x84, 0x64, 0xbc, 0xb9, 0x71, 0x7e, 0x56,
+	0x4e, 0xaa, 0xe1, 0x6b, 0x94, 0xc7, 0x6c, 0xe0, 0xa4, 0xeb, 0xa0, 0xcd, 0xe8, 0x57, 0xa5, 0xe4,
+	0x05, 0x6f, 0xfe, 0x8a, 0x0d, 0x55, 0x61, 0xc1, 0xa1, 0xfd, 0x80, 0xbb, 0xa1, 0x45, 0xec, 0xe1,
+	0xcc, 0xfb, 0x28, 0xc9, 0xa2, 0x16, 0xa8, 0x2a, 0xfe, 0xa9, 0x33, 0x63, 0xfa, 0x92, 0x66, 0xc6,
+	0xd9, 0x47, 0xcd, 0x8c, 0x73, 0x8f, 0x9a, 0x19, 0x33, 0x13, 0x33, 0xe3, 0xd8, 0x10, 0x9c, 0x7d,
+	0xe4, 0x10, 0x3c, 0xf6, 0x36, 0x94, 0xfb, 0x12, 0xdf, 0x86, 0xe0, 0xa2, 0xb7, 0xa1, 0xfc, 0x45,
+	0x6f, 0x43, 0x85, 0xa7, 0x7f, 0x1b, 0x2a, 0x41, 0xd6, 0xf5, 0x43, 0xca, 0x8e, 0x88, 0xa7, 0x3b,
+	0x5b, 0x0c, 0xa3, 0x2a, 0xcc, 0x47, 0xdf, 0xd6, 0xc0, 0x77, 0x43, 0xd9, 0xbc, 0x8a, 0x77, 0x6e,
+	0x4c, 0xee, 0x63, 0x6a, 0xa6, 0x3d, 0xdf, 0x0d, 0x71, 0xc1, 0x1d, 0x81, 0xd0, 0x35, 0xc8, 0xca,
+	0xde, 0x34, 0xf0, 0xb9, 0xee, 0x61, 0x19, 0xd1, 0x88, 0x06, 0x3e, 0x17, 0x3f, 0x68, 0x48, 0xb4,
+	0xea, 0x4f, 0xf2, 0x5b, 0xb0, 0xfb, 0xf4, 0x24, 0x14, 0xfc, 0xc6, 0x92, 0x4c, 0xda, 0x8c, 0x80,
+	0xf1, 0xc0, 0x47, 0x77, 0x61, 0x4e, 0xcf, 0xd5, 0x48, 0x9e, 0x62, 0x63, 0xf2, 0x14, 0xa3, 0x69,
+	0xa1, 0x87, 0x6b, 0x2d, 0xf1, 0xf0, 0x07, 0xb0, 0xe5, 0x0b, 0x1e, 0xc0, 0x6e, 0xfd, 0x27, 0x01,
+	0xf3, 0x63, 0x03, 0x3b, 0x7a, 0x0d, 0xca, 0xb8, 0xd9, 0xde, 0xd9, 0xba, 0xdf, 0xb4, 0xda, 0xbb,
+	0xd5, 0xdd, 0xbd, 0xb6, 0xb5, 0xd3, 0x6a, 0x6e, 0x5b, 0x7b, 0xdb, 0xed, 0x56, 0xb3, 0x6e, 0xde,
+	0x33, 0x9b, 0x8d, 0xc5, 0x99, 0xd2, 0xea, 0x7b, 0x1f, 0xac, 0x2f, 0x4f, 0x61, 0x43, 0x2f, 0xc3,
+	0xd5, 0x09, 0x74, 0x7b, 0xaf, 0x5e, 0x6f, 0xb6, 0xdb, 0x8b, 0x89, 0x52, 0xe9, 0xbd, 0x0f, 0xd6,
+	0x1f, 0x42, 0x9d, 0x22, 0x77, 0xaf, 0x6a, 0x6e, 0xed, 0xe1, 0xe6, 0x62, 0x72, 0xaa, 0x9c, 0xa6,
+	0x4e, 0x91, 0x6b, 0xfe, 0xb8, 0x65, 0xe2, 0x66, 0x63, 0x31, 0x35, 0x55, 0x4e, 0x53, 0x4b, 0xe9,
+	0x77, 0x3f, 0x5a, 0x9b, 0xb9, 0xf5, 0x16, 0x64, 0xa2, 0x38, 0x59, 0x85, 0xe5, 0xe6, 0x76, 0x7d,
+	0xa7, 0xd1, 0xc4, 0xe3, 0xaa, 0xa2, 0x25, 0x98, 0x8f, 0x08, 0x2d, 0xbc, 0xb3, 0xbb, 0xb3, 0x98,
+	0x40, 0x2b, 0xb0, 0x18, 0xa1, 0xee, 0xed, 0x6d, 0x6d, 0x59, 0xd5, 0x9a, 0xb9, 0x98, 0x1c, 0x5d,
+	0xa1, 0x55, 0xc5, 0xbb, 0x66, 0x55, 0x11, 0x52, 0x7a, 0xaf, 0x7d, 0x28, 0x8c, 0xc6, 0x11, 0x7a,
+	0x0e, 0xae, 0x99, 0xdb, 0xbb, 0x4d, 0x7c, 0xbf, 0xba, 0x65, 0xed, 0x6d, 0x9b, 0xbb, 0x13, 0xdb,
+	0xae, 0xc2, 0xf2, 0x38, 0xb9, 0xb6, 0xb5, 0x53, 0x7f, 0x73, 0x31, 0x81, 0x0c, 0x58, 0x19, 0x27,
+	0xb4, 0x9b, 0xf5, 0x9d, 0xed, 0xc6, 0x62, 0x52, 0xef, 0xf3, 0x33, 0x40, 0x0f, 0x46, 0x0a, 0xfa,
+	0x3a, 0x94, 0xdb, 0x7b, 0xb5, 0x76, 0x1d, 0x9b, 0xad, 0x5d, 0x73, 0x67, 0x3b, 0x32, 0xc7, 0xf8,
+	0x9e, 0x6b, 0x50, 0x9a, 0xc6, 0x54, 0xad, 0xef, 0x9a, 0xf7, 0x9b, 0x8b, 0x89, 0x87, 0xd1, 0x5b,
+	0xd5, 0xbd, 0x76, 0x33, 0x3e, 0x40, 0xcd, 0xfc, 0xf8, 0x7c, 0x2d, 0xf1, 0xc9, 0xf9, 0x5a, 0xe2,
+	0x1f, 0xe7, 0x6b, 0x89, 0xf7, 0x3f, 0x5b, 0x9b, 0xf9, 0xe4, 0xb3, 0xb5, 0x99, 0xbf, 0x7d, 0xb6,
+	0x36, 0xf3, 0x93, 0xca, 0x63, 0xcc, 0x8f, 0xfa, 0x3f, 0x3c, 0x64, 0x61, 0xe9, 0xcc, 0x49, 0x8e,
+	0x97, 0xfe, 0x37, 0x00, 0x9a, 0x8d, 0x9e, 0xe2, 0xfd, 0x21, 0x00, 0x00,
+}
+
+func (this *DataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSource)
+	if !ok {
+		that2, ok := that.(DataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
 	if this.Owner != that1.Owner {
 		return false
@@ -1951,6 +2854,87 @@ func (this *OracleScript) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DataSourceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceVersion)
+	if !ok {
+		that2, ok := that.(DataSourceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Editor != that1.Editor {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Changelog != that1.Changelog {
+		return false
+	}
+	return true
+}
+func (this *OracleScriptVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleScriptVersion)
+	if !ok {
+		that2, ok := that.(OracleScriptVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Editor != that1.Editor {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Changelog != that1.Changelog {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	return true
+}
 func (this *RawRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1979,6 +2963,9 @@ func (this *RawRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
+		return false
+	}
 	return true
 }
 func (this *RawReport) Equal(that interface{}) bool {
@@ -2084,6 +3071,42 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	if !this.Callback.Equal(that1.Callback) {
+		return false
+	}
+	return true
+}
+func (this *RequestCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestCallback)
+	if !ok {
+		that2, ok := that.(RequestCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
 	return true
 }
 func (this *Report) Equal(that interface{}) bool {
@@ -2172,6 +3195,9 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
 	return true
 }
 func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
@@ -2294,14 +3320,14 @@ func (this *Result) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SigningResult) Equal(that interface{}) bool {
+func (this *FeeReceipt) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SigningResult)
+	that1, ok := that.(*FeeReceipt)
 	if !ok {
-		that2, ok := that.(SigningResult)
+		that2, ok := that.(FeeReceipt)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2313,28 +3339,134 @@ func (this *SigningResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SigningID != that1.SigningID {
+	if this.RequestID != that1.RequestID {
 		return false
 	}
-	if this.ErrorCodespace != that1.ErrorCodespace {
+	if this.Payer != that1.Payer {
 		return false
 	}
-	if this.ErrorCode != that1.ErrorCode {
+	if len(this.Escrowed) != len(that1.Escrowed) {
 		return false
 	}
-	return true
-}
-func (this *ValidatorStatus) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ValidatorStatus)
-	if !ok {
-		that2, ok := that.(ValidatorStatus)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.Escrowed {
+		if !this.Escrowed[i].Equal(&that1.Escrowed[i]) {
+			return false
+		}
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(&that1.Items[i]) {
+			return false
+		}
+	}
+	if len(this.Refunded) != len(that1.Refunded) {
+		return false
+	}
+	for i := range this.Refunded {
+		if !this.Refunded[i].Equal(&that1.Refunded[i]) {
+			return false
+		}
+	}
+	if this.Settled != that1.Settled {
+		return false
+	}
+	if this.RefundAddress != that1.RefundAddress {
+		return false
+	}
+	return true
+}
+func (this *FeeReceiptItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeReceiptItem)
+	if !ok {
+		that2, ok := that.(FeeReceiptItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ExternalID != that1.ExternalID {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if len(this.FeePerReport) != len(that1.FeePerReport) {
+		return false
+	}
+	for i := range this.FeePerReport {
+		if !this.FeePerReport[i].Equal(&that1.FeePerReport[i]) {
+			return false
+		}
+	}
+	if this.ReportCount != that1.ReportCount {
+		return false
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SigningResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigningResult)
+	if !ok {
+		that2, ok := that.(SigningResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SigningID != that1.SigningID {
+		return false
+	}
+	if this.ErrorCodespace != that1.ErrorCodespace {
+		return false
+	}
+	if this.ErrorCode != that1.ErrorCode {
+		return false
+	}
+	return true
+}
+func (this *ValidatorStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorStatus)
+	if !ok {
+		that2, ok := that.(ValidatorStatus)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
@@ -2403,6 +3535,29 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
 		return false
 	}
+	if this.LatestResultRetention != that1.LatestResultRetention {
+		return false
+	}
+	if this.MaxSubscriptionRunsPerBlock != that1.MaxSubscriptionRunsPerBlock {
+		return false
+	}
+	if this.ResultRetentionPeriod != that1.ResultRetentionPeriod {
+		return false
+	}
+	if this.ResultRetentionCount != that1.ResultRetentionCount {
+		return false
+	}
+	if this.MaxResultPrunesPerBlock != that1.MaxResultPrunesPerBlock {
+		return false
+	}
+	if len(this.SubscriptionGasPrices) != len(that1.SubscriptionGasPrices) {
+		return false
+	}
+	for i := range this.SubscriptionGasPrices {
+		if !this.SubscriptionGasPrices[i].Equal(&that1.SubscriptionGasPrices[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PendingResolveList) Equal(that interface{}) bool {
@@ -2497,6 +3652,89 @@ func (this *RequestVerification) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Subscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Subscription)
+	if !ok {
+		that2, ok := that.(Subscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.DepositAccount != that1.DepositAccount {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.IntervalUnit != that1.IntervalUnit {
+		return false
+	}
+	if this.MaxRuns != that1.MaxRuns {
+		return false
+	}
+	if this.Runs != that1.Runs {
+		return false
+	}
+	if this.NextRun != that1.NextRun {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	return true
+}
 func (m *DataSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2634,7 +3872,7 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RawRequest) Marshal() (dAtA []byte, err error) {
+func (m *DataSourceVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2644,37 +3882,56 @@ func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSourceVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSourceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.DataSourceID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RawReport) Marshal() (dAtA []byte, err error) {
+func (m *OracleScriptVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2684,37 +3941,63 @@ func (m *RawReport) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleScriptVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2724,22 +4007,124 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintOracle(dAtA, i, uint64(size))
@@ -2837,6 +4222,48 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Report) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2911,6 +4338,11 @@ func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -3146,7 +4578,7 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SigningResult) Marshal() (dAtA []byte, err error) {
+func (m *FeeReceipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3156,37 +4588,91 @@ func (m *SigningResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeReceipt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ErrorCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RefundAddress)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x3a
 	}
-	if len(m.ErrorCodespace) > 0 {
-		i -= len(m.ErrorCodespace)
-		copy(dAtA[i:], m.ErrorCodespace)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ErrorCodespace)))
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Payer)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.SigningID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SigningID))
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorStatus) Marshal() (dAtA []byte, err error) {
+func (m *FeeReceiptItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3196,38 +4682,70 @@ func (m *ValidatorStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeReceiptItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeReceiptItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
-	if err2 != nil {
-		return 0, err2
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	if m.IsActive {
+	if m.ReportCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReportCount))
 		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x28
+	}
+	if len(m.FeePerReport) > 0 {
+		for iNdEx := len(m.FeePerReport) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerReport[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
+func (m *SigningResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3237,31 +4755,112 @@ func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActiveValidator) MarshalTo(dAtA []byte) (int, error) {
+func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActiveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Power != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+	if m.ErrorCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+	if len(m.ErrorCodespace) > 0 {
+		i -= len(m.ErrorCodespace)
+		copy(dAtA[i:], m.ErrorCodespace)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ErrorCodespace)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
+	if m.SigningID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SigningID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3282,6 +4881,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubscriptionGasPrices) > 0 {
+		for iNdEx := len(m.SubscriptionGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.MaxResultPrunesPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxResultPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ResultRetentionCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResultRetentionCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ResultRetentionPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResultRetentionPeriod))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxSubscriptionRunsPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxSubscriptionRunsPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LatestResultRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LatestResultRetention))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.IBCRequestEnabled {
 		i--
 		if m.IBCRequestEnabled {
@@ -3366,20 +5008,20 @@ func (m *PendingResolveList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.RequestIds) > 0 {
-		dAtA4 := make([]byte, len(m.RequestIds)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.RequestIds)*10)
+		var j4 int
 		for _, num := range m.RequestIds {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintOracle(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintOracle(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3558,6 +5200,149 @@ func (m *OracleResultSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Status != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.NextRun != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NextRun))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Runs != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.IntervalUnit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IntervalUnit))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Interval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DepositAccount) > 0 {
+		i -= len(m.DepositAccount)
+		copy(dAtA[i:], m.DepositAccount)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.DepositAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -3637,64 +5422,131 @@ func (m *OracleScript) Size() (n int) {
 	return n
 }
 
-func (m *RawRequest) Size() (n int) {
+func (m *DataSourceVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExternalID != 0 {
-		n += 1 + sovOracle(uint64(m.ExternalID))
-	}
 	if m.DataSourceID != 0 {
 		n += 1 + sovOracle(uint64(m.DataSourceID))
 	}
-	l = len(m.Calldata)
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = len(m.Changelog)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *RawReport) Size() (n int) {
+func (m *OracleScriptVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExternalID != 0 {
-		n += 1 + sovOracle(uint64(m.ExternalID))
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovOracle(uint64(m.ExitCode))
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
 	}
-	l = len(m.Data)
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = len(m.Changelog)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Schema)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *Request) Size() (n int) {
+func (m *RawRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.OracleScriptID != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
 	}
 	l = len(m.Calldata)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	if len(m.RequestedValidators) > 0 {
-		for _, s := range m.RequestedValidators {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceVersion))
 	}
-	if m.MinCount != 0 {
+	return n
+}
+
+func (m *RawReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovOracle(uint64(m.ExitCode))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RequestedValidators) > 0 {
+		for _, s := range m.RequestedValidators {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MinCount != 0 {
 		n += 1 + sovOracle(uint64(m.MinCount))
 	}
 	if m.RequestHeight != 0 {
@@ -3733,6 +5585,33 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovOracle(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -3796,6 +5675,9 @@ func (m *OracleRequestPacketData) Size() (n int) {
 	if m.TSSEncoder != 0 {
 		n += 1 + sovOracle(uint64(m.TSSEncoder))
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
 	return n
 }
 
@@ -3888,6 +5770,81 @@ func (m *Result) Size() (n int) {
 	return n
 }
 
+func (m *FeeReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Settled {
+		n += 2
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeReceiptItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeePerReport) > 0 {
+		for _, e := range m.FeePerReport {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.ReportCount != 0 {
+		n += 1 + sovOracle(uint64(m.ReportCount))
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SigningResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3976,6 +5933,27 @@ func (m *Params) Size() (n int) {
 	if m.IBCRequestEnabled {
 		n += 2
 	}
+	if m.LatestResultRetention != 0 {
+		n += 1 + sovOracle(uint64(m.LatestResultRetention))
+	}
+	if m.MaxSubscriptionRunsPerBlock != 0 {
+		n += 1 + sovOracle(uint64(m.MaxSubscriptionRunsPerBlock))
+	}
+	if m.ResultRetentionPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.ResultRetentionPeriod))
+	}
+	if m.ResultRetentionCount != 0 {
+		n += 1 + sovOracle(uint64(m.ResultRetentionCount))
+	}
+	if m.MaxResultPrunesPerBlock != 0 {
+		n += 2 + sovOracle(uint64(m.MaxResultPrunesPerBlock))
+	}
+	if len(m.SubscriptionGasPrices) > 0 {
+		for _, e := range m.SubscriptionGasPrices {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4078,6 +6056,79 @@ func (m *OracleResultSignatureOrder) Size() (n int) {
 	return n
 }
 
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOracle(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.DepositAccount)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOracle(uint64(m.Interval))
+	}
+	if m.IntervalUnit != 0 {
+		n += 1 + sovOracle(uint64(m.IntervalUnit))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovOracle(uint64(m.MaxRuns))
+	}
+	if m.Runs != 0 {
+		n += 2 + sovOracle(uint64(m.Runs))
+	}
+	if m.NextRun != 0 {
+		n += 2 + sovOracle(uint64(m.NextRun))
+	}
+	if m.Status != 0 {
+		n += 2 + sovOracle(uint64(m.Status))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 2 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4570,7 +6621,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawRequest) Unmarshal(dAtA []byte) error {
+func (m *DataSourceVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,17 +6644,17 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.ExternalID = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4613,16 +6664,16 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.DataSourceID = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4632,16 +6683,16 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4651,100 +6702,61 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RawReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
-			}
-			m.ExternalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ExitCode = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4754,16 +6766,16 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4773,25 +6785,23 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Changelog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4814,7 +6824,7 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *OracleScriptVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4837,10 +6847,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4863,10 +6873,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var byteLen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4876,29 +6886,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4926,13 +6921,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
 			}
-			m.MinCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4942,35 +6937,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.RequestHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.RequestTime = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4980,14 +6969,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5015,13 +7004,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Changelog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5031,67 +7020,79 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawRequests = append(m.RawRequests, RawRequest{})
-			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IBCChannel == nil {
-				m.IBCChannel = &IBCChannel{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			m.ExecuteGas = 0
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5101,16 +7102,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.TSSEncoder = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5120,16 +7121,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5139,29 +7140,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
 			}
-			var msglen int
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+			}
+			m.DataSourceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5171,26 +7174,11 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataSourceVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5212,7 +7200,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Report) Unmarshal(dAtA []byte) error {
+func (m *RawReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5235,17 +7223,17 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			var stringLen uint64
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5255,29 +7243,16 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			var v int
+			m.ExitCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5287,17 +7262,16 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExitCode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InBeforeResolve = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5307,24 +7281,24 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5348,7 +7322,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5371,17 +7345,17 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			var stringLen uint64
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5391,29 +7365,16 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			m.OracleScriptID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5423,16 +7384,31 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5442,31 +7418,29 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
+			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			m.AskCount = 0
+			m.MinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5476,16 +7450,16 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.MinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
 			}
-			m.MinCount = 0
+			m.RequestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5495,14 +7469,65 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.RequestHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5529,16 +7554,16 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RawRequests = append(m.RawRequests, RawRequest{})
+			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
 			}
-			m.PrepareGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5548,12 +7573,29 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCChannel == nil {
+				m.IBCChannel = &IBCChannel{}
+			}
+			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
@@ -5572,7 +7614,7 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
@@ -5591,61 +7633,77 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 			}
-			m.RequestID = 0
+			m.OracleScriptVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5655,11 +7713,47 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &RequestCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5681,7 +7775,7 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+func (m *RequestCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,15 +7798,15 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5740,32 +7834,13 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			m.AnsCount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5775,73 +7850,31 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.RequestTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ResolveTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
-		case 6:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-			}
-			m.ResolveStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var byteLen int
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5851,26 +7884,11 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5892,7 +7910,7 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *Report) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5915,15 +7933,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5951,13 +7969,13 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
 			}
-			m.OracleScriptID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5967,7 +7985,143 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InBeforeResolve = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6045,10 +8199,10 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			m.RequestID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6058,16 +8212,31 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			m.AnsCount = 0
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6077,16 +8246,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			m.RequestTime = 0
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6096,16 +8265,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.ResolveTime = 0
+			m.TSSEncoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6115,35 +8284,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-			}
-			m.ResolveStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var byteLen int
+			m.OracleScriptVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6153,26 +8303,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6194,7 +8329,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningResult) Unmarshal(dAtA []byte) error {
+func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6217,17 +8352,17 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.SigningID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6237,61 +8372,1824 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
 			}
-			m.ErrorCode = 0
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, FeeReceiptItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeReceiptItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeReceiptItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeReceiptItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePerReport = append(m.FeePerReport, types.Coin{})
+			if err := m.FeePerReport[len(m.FeePerReport)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+			}
+			m.ReportCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+			}
+			m.SigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+			}
+			m.MaxRawRequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+			}
+			m.MaxAskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+			}
+			m.MaxCalldataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalldataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+			}
+			m.MaxReportDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReportDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+			}
+			m.ExpirationBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+			}
+			m.BaseOwasmGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseOwasmGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+			}
+			m.PerValidatorRequestGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+			}
+			m.SamplingTryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SamplingTryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+			}
+			m.OracleRewardPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+			}
+			m.InactivePenaltyDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestResultRetention", wireType)
+			}
+			m.LatestResultRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestResultRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionRunsPerBlock", wireType)
+			}
+			m.MaxSubscriptionRunsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptionRunsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionPeriod", wireType)
+			}
+			m.ResultRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionCount", wireType)
+			}
+			m.ResultRetentionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultRetentionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultPrunesPerBlock", wireType)
+			}
+			m.MaxResultPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResultPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionGasPrices = append(m.SubscriptionGasPrices, types.DecCoin{})
+			if err := m.SubscriptionGasPrices[len(m.SubscriptionGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ErrorCode |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6314,7 +10212,7 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6337,17 +10235,17 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6357,17 +10255,29 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6377,24 +10287,23 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6417,7 +10326,7 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
+func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6440,15 +10349,15 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6476,13 +10385,13 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6492,66 +10401,29 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.MaxRawRequestCount = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6561,16 +10433,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			m.MaxAskCount = 0
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6580,16 +10452,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAskCount |= uint64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.MaxCalldataSize = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6599,54 +10471,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCalldataSize |= uint64(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxReportDataSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxReportDataSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExpirationBlockCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BaseOwasmGas = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6656,35 +10540,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseOwasmGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.PerValidatorRequestGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			m.SamplingTryCount = 0
+			m.Multiplier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6694,16 +10572,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SamplingTryCount |= uint64(b&0x7F) << shift
+				m.Multiplier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
 			}
-			m.OracleRewardPercentage = 0
+			m.Px = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6713,16 +10591,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
+				m.Px |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.InactivePenaltyDuration = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6732,16 +10610,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
 			}
-			var v int
+			m.ResolveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6751,12 +10629,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ResolveTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IBCRequestEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6778,7 +10655,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
+func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6801,87 +10678,49 @@ func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				m.RequestIds = append(m.RequestIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOracle
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOracle
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIds) == 0 {
-					m.RequestIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIds = append(m.RequestIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6904,7 +10743,7 @@ func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCChannel) Unmarshal(dAtA []byte) error {
+func (m *Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6927,15 +10766,34 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= SubscriptionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6963,11 +10821,11 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6995,61 +10853,102 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.DepositAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestVerification) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7077,13 +10976,13 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7093,29 +10992,31 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			m.RequestID = 0
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7125,16 +11026,16 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			m.ExternalID = 0
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7144,16 +11045,16 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.DataSourceID = 0
+			m.TSSEncoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7163,66 +11064,16 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7232,29 +11083,16 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
 			}
-			m.Multiplier = 0
+			m.IntervalUnit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7264,16 +11102,16 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Multiplier |= uint64(b&0x7F) << shift
+				m.IntervalUnit |= IntervalUnit(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
 			}
-			m.Px = 0
+			m.MaxRuns = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7283,16 +11121,16 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Px |= uint64(b&0x7F) << shift
+				m.MaxRuns |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			m.RequestID = 0
+			m.Runs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7302,16 +11140,16 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.Runs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRun", wireType)
 			}
-			m.ResolveTime = 0
+			m.NextRun = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7321,66 +11159,16 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.NextRun |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.RequestID = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7390,16 +11178,16 @@ func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.Status |= SubscriptionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
 			}
-			m.Encoder = 0
+			m.OracleScriptVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7409,7 +11197,7 @@ func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Encoder |= Encoder(b&0x7F) << shift
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21,3 +21,23 @@ func NewOracleScript(
 		SourceCodeURL: sourceCodeURL,
 	}
 }
+
+func NewOracleScriptVersion(
+	oracleScriptID OracleScriptID,
+	version uint64,
+	filename string,
+	schema string,
+	editor string,
+	height int64,
+	changelog string,
+) OracleScriptVersion {
+	return OracleScriptVersion{
+		OracleScriptID: oracleScriptID,
+		Version:        version,
+		Filename:       filename,
+		Editor:         editor,
+		Height:         height,
+		Changelog:      changelog,
+		Schema:         schema,
+	}
+}
@@ -60,6 +60,11 @@ func (p OracleRequestPacketData) ValidateBasic() error {
 	return nil
 }
 
+// GetCallback returns nil since requests from other chains cannot have result callbacks.
+func (p OracleRequestPacketData) GetCallback() *RequestCallback {
+	return nil
+}
+
 // GetBytes is a helper for serialising
 func (p OracleRequestPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
@@ -34,7 +34,7 @@ func TestGetBytesRequestPacket(t *testing.T) {
 	require.Equal(
 		t,
 		[]byte(
-			`{"ask_count":"1","calldata":"AwAAAEJUQ2QAAAAAAAAA","client_id":"test","execute_gas":"100","fee_limit":[{"amount":"10000","denom":"uband"}],"min_count":"1","oracle_script_id":"1","prepare_gas":"100","tss_encoder":"ENCODER_UNSPECIFIED"}`,
+			`{"ask_count":"1","calldata":"AwAAAEJUQ2QAAAAAAAAA","client_id":"test","execute_gas":"100","fee_limit":[{"amount":"10000","denom":"uband"}],"min_count":"1","oracle_script_id":"1","oracle_script_version":"0","prepare_gas":"100","tss_encoder":"ENCODER_UNSPECIFIED"}`,
 		),
 		req.GetBytes(),
 	)
@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -19,13 +23,24 @@ const (
 	DefaultOracleRewardPercentage  = uint64(70)
 	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
 	DefaultIBCRequestEnabled       = true
+	DefaultLatestResultRetention   = uint64(7 * 24 * time.Hour)
+	DefaultMaxSubscriptionRuns     = uint64(20)
+	DefaultResultRetentionPeriod   = uint64(30 * 24 * time.Hour)
+	DefaultResultRetentionCount    = uint64(0)
+	DefaultMaxResultPrunes         = uint64(100)
 )
 
+// DefaultSubscriptionGasPrices is the default gas prices of the requests of subscriptions.
+var DefaultSubscriptionGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(25, 4)))
+
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
 	ibcRequestEnabled bool,
+	latestResultRetention, maxSubscriptionRunsPerBlock uint64,
+	resultRetentionPeriod, resultRetentionCount, maxResultPrunesPerBlock uint64,
+	subscriptionGasPrices sdk.DecCoins,
 ) Params {
 	return Params{
 		MaxRawRequestCount:      maxRawRequestCount,
@@ -39,6 +54,13 @@ func NewParams(
 		OracleRewardPercentage:  oracleRewardPercentage,
 		InactivePenaltyDuration: inactivePenaltyDuration,
 		IBCRequestEnabled:       ibcRequestEnabled,
+		LatestResultRetention:   latestResultRetention,
+
+		MaxSubscriptionRunsPerBlock: maxSubscriptionRunsPerBlock,
+		ResultRetentionPeriod:       resultRetentionPeriod,
+		ResultRetentionCount:        resultRetentionCount,
+		MaxResultPrunesPerBlock:     maxResultPrunesPerBlock,
+		SubscriptionGasPrices:       subscriptionGasPrices,
 	}
 }
 
@@ -56,6 +78,12 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultLatestResultRetention,
+		DefaultMaxSubscriptionRuns,
+		DefaultResultRetentionPeriod,
+		DefaultResultRetentionCount,
+		DefaultMaxResultPrunes,
+		DefaultSubscriptionGasPrices,
 	)
 }
 
@@ -94,6 +122,24 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := validateUint64("latest result retention", false)(p.LatestResultRetention); err != nil {
+		return err
+	}
+	if err := validateUint64("max subscription runs per block", false)(p.MaxSubscriptionRunsPerBlock); err != nil {
+		return err
+	}
+	if err := validateUint64("result retention period", false)(p.ResultRetentionPeriod); err != nil {
+		return err
+	}
+	if err := validateUint64("result retention count", false)(p.ResultRetentionCount); err != nil {
+		return err
+	}
+	if err := validateUint64("max result prunes per block", false)(p.MaxResultPrunesPerBlock); err != nil {
+		return err
+	}
+	if err := p.SubscriptionGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid subscription gas prices: %w", err)
+	}
 
 	return nil
 }
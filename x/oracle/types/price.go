@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+// StandardPriceReferenceSchema is the OBI schema of the standard price reference oracle script. The
+// results of oracle scripts with this schema are indexed by symbol for the RequestPrice query.
+const StandardPriceReferenceSchema = "{symbols:[string],multiplier:u64}/{rates:[u64]}"
+
+// IsStandardPriceReference checks whether the oracle script schema is the standard price reference schema.
+func IsStandardPriceReference(schema string) bool {
+	return strings.Join(strings.Fields(schema), "") == StandardPriceReferenceSchema
+}
+
+// NewPriceResult creates a new PriceResult instance.
+func NewPriceResult(symbol string, multiplier, px uint64, requestID RequestID, resolveTime int64) PriceResult {
+	return PriceResult{
+		Symbol:      symbol,
+		Multiplier:  multiplier,
+		Px:          px,
+		RequestID:   requestID,
+		ResolveTime: resolveTime,
+	}
+}
+
+// DecodePriceResults decodes the calldata and the result of a standard price reference request
+// into a price result of each symbol.
+func DecodePriceResults(calldata, result []byte, requestID RequestID, resolveTime int64) ([]PriceResult, error) {
+	// decode manually so that a malformed length cannot allocate more than the data can hold
+	length, rem, err := obi.DecodeUnsigned32(calldata)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(length)*4 > uint64(len(rem)) {
+		return nil, errors.New("invalid number of symbols")
+	}
+
+	symbols := make([]string, length)
+	for i := range symbols {
+		if symbols[i], rem, err = obi.DecodeString(rem); err != nil {
+			return nil, err
+		}
+	}
+
+	multiplier, rem, err := obi.DecodeUnsigned64(rem)
+	if err != nil {
+		return nil, err
+	}
+	if len(rem) != 0 {
+		return nil, errors.New("not all calldata was consumed while decoding")
+	}
+
+	length, rem, err = obi.DecodeUnsigned32(result)
+	if err != nil {
+		return nil, err
+	}
+	if int(length) != len(symbols) || len(rem) != len(symbols)*8 {
+		return nil, errors.New("number of rates does not match number of symbols")
+	}
+
+	prices := make([]PriceResult, 0, len(symbols))
+	for _, symbol := range symbols {
+		var rate uint64
+		if rate, rem, err = obi.DecodeUnsigned64(rem); err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, NewPriceResult(symbol, multiplier, rate, requestID, resolveTime))
+	}
+
+	return prices, nil
+}
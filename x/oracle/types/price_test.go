@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+func TestIsStandardPriceReference(t *testing.T) {
+	require.True(t, IsStandardPriceReference(StandardPriceReferenceSchema))
+	require.True(t, IsStandardPriceReference("{symbols: [string], multiplier: u64}/{rates: [u64]}"))
+	require.False(t, IsStandardPriceReference("{symbols:[string],multiplier:u64}/{rates:[u32]}"))
+}
+
+func TestDecodePriceResults(t *testing.T) {
+	calldata := append(
+		obi.MustEncode([]string{"BTC", "ETH"}),
+		obi.EncodeUnsigned64(1000000000)...,
+	)
+	result := obi.MustEncode([]uint64{60000, 3000})
+
+	prices, err := DecodePriceResults(calldata, result, 1, 1000)
+	require.NoError(t, err)
+	require.Equal(t, []PriceResult{
+		NewPriceResult("BTC", 1000000000, 60000, 1, 1000),
+		NewPriceResult("ETH", 1000000000, 3000, 1, 1000),
+	}, prices)
+
+	// the number of rates must match the number of symbols
+	_, err = DecodePriceResults(calldata, obi.MustEncode([]uint64{60000}), 1, 1000)
+	require.Error(t, err)
+
+	// calldata with trailing bytes
+	_, err = DecodePriceResults(append(calldata, 0x00), result, 1, 1000)
+	require.Error(t, err)
+
+	// a length prefix that the data cannot hold
+	_, err = DecodePriceResults(obi.EncodeUnsigned32(1<<31), result, 1, 1000)
+	require.Error(t, err)
+}
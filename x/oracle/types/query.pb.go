@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -414,6 +415,9 @@ func (m *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
 type QueryRequestRequest struct {
 	// RequestID is ID of an oracle request
 	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Decode is a flag to render the calldata and the result as JSON decoded with the schema of the
+	// oracle script
+	Decode bool `protobuf:"varint,2,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
 func (m *QueryRequestRequest) Reset()         { *m = QueryRequestRequest{} }
@@ -456,6 +460,13 @@ func (m *QueryRequestRequest) GetRequestId() uint64 {
 	return 0
 }
 
+func (m *QueryRequestRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 // QueryRequestResponse is response type for the Query/Request RPC method.
 type QueryRequestResponse struct {
 	// Request is an oracle request
@@ -467,6 +478,15 @@ type QueryRequestResponse struct {
 	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	// Signing is the signing detail in the bandtss module.
 	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+	// FeeReceipt is the breakdown of the data source fees of the request, if it
+	// has any.
+	FeeReceipt *FeeReceipt `protobuf:"bytes,5,opt,name=fee_receipt,json=feeReceipt,proto3" json:"fee_receipt,omitempty"`
+	// DecodedCalldata is the calldata as JSON decoded with the input schema of
+	// the oracle script, if decode is requested and the schema can decode it.
+	DecodedCalldata string `protobuf:"bytes,6,opt,name=decoded_calldata,json=decodedCalldata,proto3" json:"decoded_calldata,omitempty"`
+	// DecodedResult is the result as JSON decoded with the output schema of the
+	// oracle script, if decode is requested and the schema can decode it.
+	DecodedResult string `protobuf:"bytes,7,opt,name=decoded_result,json=decodedResult,proto3" json:"decoded_result,omitempty"`
 }
 
 func (m *QueryRequestResponse) Reset()         { *m = QueryRequestResponse{} }
@@ -530,6 +550,27 @@ func (m *QueryRequestResponse) GetSigning() *SigningResult {
 	return nil
 }
 
+func (m *QueryRequestResponse) GetFeeReceipt() *FeeReceipt {
+	if m != nil {
+		return m.FeeReceipt
+	}
+	return nil
+}
+
+func (m *QueryRequestResponse) GetDecodedCalldata() string {
+	if m != nil {
+		return m.DecodedCalldata
+	}
+	return ""
+}
+
+func (m *QueryRequestResponse) GetDecodedResult() string {
+	if m != nil {
+		return m.DecodedResult
+	}
+	return ""
+}
+
 // QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
 // method.
 type QueryPendingRequestsRequest struct {
@@ -1519,1267 +1560,1343 @@ func (m *QueryRequestVerificationResponse) GetIsDelay() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryCountsRequest)(nil), "band.oracle.v1.QueryCountsRequest")
-	proto.RegisterType((*QueryCountsResponse)(nil), "band.oracle.v1.QueryCountsResponse")
-	proto.RegisterType((*QueryDataRequest)(nil), "band.oracle.v1.QueryDataRequest")
-	proto.RegisterType((*QueryDataResponse)(nil), "band.oracle.v1.QueryDataResponse")
-	proto.RegisterType((*QueryDataSourceRequest)(nil), "band.oracle.v1.QueryDataSourceRequest")
-	proto.RegisterType((*QueryDataSourceResponse)(nil), "band.oracle.v1.QueryDataSourceResponse")
-	proto.RegisterType((*QueryOracleScriptRequest)(nil), "band.oracle.v1.QueryOracleScriptRequest")
-	proto.RegisterType((*QueryOracleScriptResponse)(nil), "band.oracle.v1.QueryOracleScriptResponse")
-	proto.RegisterType((*QueryRequestRequest)(nil), "band.oracle.v1.QueryRequestRequest")
-	proto.RegisterType((*QueryRequestResponse)(nil), "band.oracle.v1.QueryRequestResponse")
-	proto.RegisterType((*QueryPendingRequestsRequest)(nil), "band.oracle.v1.QueryPendingRequestsRequest")
-	proto.RegisterType((*QueryPendingRequestsResponse)(nil), "band.oracle.v1.QueryPendingRequestsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryValidatorRequest)(nil), "band.oracle.v1.QueryValidatorRequest")
-	proto.RegisterType((*QueryValidatorResponse)(nil), "band.oracle.v1.QueryValidatorResponse")
-	proto.RegisterType((*QueryIsReporterRequest)(nil), "band.oracle.v1.QueryIsReporterRequest")
-	proto.RegisterType((*QueryIsReporterResponse)(nil), "band.oracle.v1.QueryIsReporterResponse")
-	proto.RegisterType((*QueryReportersRequest)(nil), "band.oracle.v1.QueryReportersRequest")
-	proto.RegisterType((*QueryReportersResponse)(nil), "band.oracle.v1.QueryReportersResponse")
-	proto.RegisterType((*QueryActiveValidatorsRequest)(nil), "band.oracle.v1.QueryActiveValidatorsRequest")
-	proto.RegisterType((*QueryActiveValidatorsResponse)(nil), "band.oracle.v1.QueryActiveValidatorsResponse")
-	proto.RegisterType((*QueryRequestSearchRequest)(nil), "band.oracle.v1.QueryRequestSearchRequest")
-	proto.RegisterType((*QueryRequestSearchResponse)(nil), "band.oracle.v1.QueryRequestSearchResponse")
-	proto.RegisterType((*QueryRequestPriceRequest)(nil), "band.oracle.v1.QueryRequestPriceRequest")
-	proto.RegisterType((*QueryRequestPriceResponse)(nil), "band.oracle.v1.QueryRequestPriceResponse")
-	proto.RegisterType((*QueryRequestVerificationRequest)(nil), "band.oracle.v1.QueryRequestVerificationRequest")
-	proto.RegisterType((*QueryRequestVerificationResponse)(nil), "band.oracle.v1.QueryRequestVerificationResponse")
+// QuerySubscriptionRequest is request type for the Query/Subscription RPC
+// method.
+type QuerySubscriptionRequest struct {
+	// SubscriptionID is ID of a subscription
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/query.proto", fileDescriptor_e351f430ef3842d0) }
-
-var fileDescriptor_e351f430ef3842d0 = []byte{
-	// 1538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xae, 0x3f, 0x5e, 0x9c, 0xaf, 0x49, 0x68, 0x9d, 0x75, 0xe2, 0xa4, 0x9b, 0xd2,
-	0x3a, 0xfd, 0xf0, 0x36, 0x6d, 0x45, 0xa5, 0x82, 0x5a, 0x9a, 0xe6, 0x80, 0x85, 0xd4, 0x0f, 0x47,
-	0xea, 0x01, 0x01, 0x66, 0x62, 0x2f, 0xce, 0xaa, 0xf6, 0xae, 0xbb, 0xb3, 0xb6, 0x12, 0x55, 0xe1,
-	0xc0, 0x01, 0x09, 0x09, 0x04, 0x12, 0x02, 0x09, 0xf5, 0xc4, 0x81, 0x23, 0xb7, 0x5e, 0xe0, 0x2f,
-	0xe8, 0xb1, 0x2a, 0x17, 0x4e, 0x15, 0x4a, 0x39, 0xf1, 0x4f, 0x80, 0x76, 0xe6, 0xcd, 0x7a, 0x77,
-	0xbd, 0x76, 0xcd, 0xa1, 0x07, 0x6e, 0xd9, 0x79, 0xbf, 0xf7, 0xde, 0x6f, 0xde, 0x9b, 0x79, 0xf3,
-	0x73, 0x40, 0xdd, 0xa5, 0x56, 0x43, 0xb7, 0x1d, 0x5a, 0x6f, 0x19, 0x7a, 0x6f, 0x53, 0x7f, 0xd8,
-	0x35, 0x9c, 0x83, 0x72, 0xc7, 0xb1, 0x5d, 0x9b, 0xcc, 0x78, 0xb6, 0xb2, 0xb0, 0x95, 0x7b, 0x9b,
-	0xea, 0x62, 0xd3, 0x6e, 0xda, 0xdc, 0xa4, 0x7b, 0x7f, 0x09, 0x94, 0xba, 0xdc, 0xb4, 0xed, 0x66,
-	0xcb, 0xd0, 0x69, 0xc7, 0xd4, 0xa9, 0x65, 0xd9, 0x2e, 0x75, 0x4d, 0xdb, 0x62, 0x68, 0x5d, 0xaa,
-	0xdb, 0xac, 0x6d, 0xb3, 0x9a, 0x70, 0x13, 0x1f, 0x68, 0x2a, 0x44, 0x52, 0x63, 0x22, 0x6e, 0xd4,
-	0x16, 0x81, 0xdc, 0xf3, 0xa8, 0xdc, 0xb2, 0xbb, 0x96, 0xcb, 0xaa, 0xc6, 0xc3, 0xae, 0xc1, 0x5c,
-	0xed, 0x07, 0x05, 0x16, 0x42, 0xcb, 0xac, 0x63, 0x5b, 0xcc, 0x20, 0x67, 0x61, 0xbe, 0x41, 0x5d,
-	0x5a, 0x63, 0x76, 0xd7, 0xa9, 0x1b, 0xb5, 0xba, 0x67, 0xcd, 0x2b, 0x6b, 0x4a, 0x29, 0x59, 0x9d,
-	0xf5, 0x0c, 0x3b, 0x7c, 0x9d, 0x3b, 0x91, 0x32, 0x2c, 0x88, 0x4c, 0x35, 0x56, 0x77, 0xcc, 0x8e,
-	0x8b, 0xe8, 0x04, 0x47, 0xcf, 0x0b, 0xd3, 0x0e, 0xb7, 0x08, 0xfc, 0x3a, 0x4c, 0x3b, 0x22, 0x3d,
-	0x22, 0x27, 0x39, 0x32, 0x87, 0x8b, 0x1c, 0xa4, 0xe9, 0x30, 0xc7, 0x79, 0x6d, 0x53, 0x97, 0x22,
-	0x59, 0x52, 0x80, 0x2c, 0x27, 0xb5, 0x47, 0xd9, 0x1e, 0x27, 0x93, 0xad, 0x66, 0xbc, 0x85, 0xf7,
-	0x28, 0xdb, 0xd3, 0xce, 0xc0, 0x7c, 0xc0, 0x01, 0xb7, 0x41, 0x20, 0xe9, 0x01, 0x38, 0x38, 0x57,
-	0xe5, 0x7f, 0x6b, 0xd7, 0xe1, 0xb8, 0x0f, 0x14, 0xdb, 0x90, 0xf1, 0x4f, 0xc1, 0x4c, 0x70, 0xd3,
-	0x66, 0x03, 0x77, 0x9c, 0xeb, 0xef, 0xb8, 0xd2, 0xd0, 0xee, 0xc3, 0x89, 0x01, 0x7f, 0x4c, 0xf7,
-	0x36, 0x4c, 0x05, 0x02, 0x70, 0xef, 0xa9, 0x4b, 0x6a, 0x39, 0xdc, 0xf5, 0x72, 0xc0, 0x11, 0xfa,
-	0x91, 0xb5, 0x6d, 0xc8, 0xf3, 0xb8, 0x77, 0x02, 0x05, 0x93, 0xcc, 0x4a, 0x30, 0x17, 0x2e, 0xb1,
-	0xcf, 0x6d, 0x26, 0x58, 0xdf, 0x4a, 0x43, 0xfb, 0x18, 0x96, 0x62, 0xa2, 0x20, 0xbf, 0x9b, 0x30,
-	0x1d, 0x0a, 0x83, 0x0c, 0x97, 0xa3, 0x0c, 0x43, 0xce, 0xb9, 0x60, 0x06, 0xed, 0x0a, 0x9e, 0x17,
-	0x64, 0x26, 0x09, 0xae, 0x00, 0xc8, 0x9e, 0xfa, 0xd4, 0xb2, 0xb8, 0x52, 0x69, 0x68, 0x7f, 0x2b,
-	0xb0, 0x18, 0x76, 0x43, 0x46, 0x9b, 0x90, 0x46, 0x14, 0x72, 0x39, 0x11, 0xe5, 0x22, 0x3d, 0x24,
-	0x8e, 0xbc, 0xe5, 0xb9, 0x74, 0x6c, 0xc7, 0x65, 0xf9, 0xc4, 0xda, 0x64, 0x69, 0xea, 0xd2, 0xf1,
-	0x41, 0x17, 0xcf, 0xbc, 0x95, 0x7c, 0xfa, 0x62, 0x75, 0xa2, 0x2a, 0xc1, 0xa4, 0x0c, 0x29, 0xc7,
-	0x60, 0xdd, 0x96, 0x38, 0x6f, 0xb1, 0x6e, 0x9e, 0xb5, 0x8a, 0x28, 0x72, 0x15, 0xd2, 0xcc, 0x6c,
-	0x5a, 0xa6, 0xd5, 0xcc, 0x27, 0xb9, 0xc3, 0x4a, 0xd4, 0x61, 0x47, 0x98, 0xd1, 0x4f, 0xa2, 0xb5,
-	0x36, 0x14, 0xf8, 0x5e, 0xef, 0x1a, 0x56, 0x83, 0x9b, 0x39, 0x6f, 0x79, 0xe5, 0xc8, 0x6d, 0x98,
-	0xef, 0xd1, 0x96, 0xd9, 0xa0, 0xae, 0xed, 0xd4, 0x68, 0xa3, 0xe1, 0x18, 0x8c, 0x89, 0xd3, 0xbc,
-	0x75, 0xf2, 0xf9, 0x93, 0x0b, 0x2b, 0x78, 0xa5, 0xef, 0x4b, 0xcc, 0x4d, 0x01, 0xd9, 0x71, 0x1d,
-	0x2f, 0xd8, 0x5c, 0x2f, 0xb2, 0xae, 0xdd, 0x81, 0xe5, 0xf8, 0x74, 0x58, 0x62, 0x1d, 0xa6, 0xfa,
-	0xad, 0xf1, 0x32, 0x4d, 0x96, 0x92, 0x5b, 0x33, 0x47, 0x2f, 0x56, 0x01, 0xa1, 0x95, 0x6d, 0x56,
-	0x05, 0xbf, 0x57, 0xcc, 0x9f, 0x14, 0x77, 0xa9, 0x43, 0xdb, 0xfe, 0xa4, 0x78, 0x1f, 0x1b, 0x2f,
-	0x57, 0x31, 0xfa, 0x15, 0x48, 0x75, 0xf8, 0x0a, 0xf6, 0x6f, 0xa0, 0xaa, 0x02, 0x8f, 0xcd, 0x40,
-	0xac, 0xd6, 0x84, 0x37, 0x78, 0x30, 0x7f, 0x93, 0xaf, 0xab, 0x38, 0xf7, 0xf0, 0xb2, 0x07, 0x12,
-	0x21, 0xf1, 0xab, 0x90, 0x62, 0x2e, 0x75, 0xbb, 0x92, 0xf8, 0x6a, 0x94, 0xb8, 0xef, 0xb2, 0xc3,
-	0x61, 0x55, 0x84, 0x6b, 0xbf, 0x28, 0x18, 0xb3, 0xc2, 0xc4, 0x41, 0x33, 0x5e, 0x17, 0x7b, 0x72,
-	0x0b, 0xe6, 0x1c, 0x4c, 0xe1, 0x87, 0x4b, 0xf0, 0x70, 0xf9, 0xe7, 0x4f, 0x2e, 0x2c, 0x62, 0xb8,
-	0x70, 0x94, 0x59, 0xe9, 0x21, 0x4b, 0x70, 0x0d, 0xe7, 0x55, 0x90, 0x2e, 0xd6, 0x60, 0x15, 0xa6,
-	0x4c, 0x56, 0x93, 0x0e, 0x9c, 0x69, 0xa6, 0x0a, 0xa6, 0x0f, 0xf4, 0xfb, 0x24, 0x17, 0x5e, 0xdb,
-	0x21, 0xbe, 0x8d, 0x35, 0x0d, 0x24, 0xf2, 0x0f, 0x58, 0x26, 0x40, 0x70, 0x72, 0xe4, 0xde, 0x7d,
-	0xa4, 0x56, 0xc4, 0x4b, 0x71, 0xb3, 0xee, 0x9a, 0x3d, 0xc3, 0xa7, 0xe1, 0x9f, 0xe6, 0x4f, 0x60,
-	0x65, 0x88, 0x1d, 0xd3, 0xde, 0x00, 0xf0, 0x49, 0x8a, 0x4b, 0x13, 0x73, 0x44, 0x22, 0xde, 0xd5,
-	0x80, 0x8b, 0xf6, 0x58, 0xc1, 0x49, 0x8c, 0x29, 0x77, 0x0c, 0xea, 0xd4, 0xf7, 0xfe, 0xf3, 0x40,
-	0x27, 0x2a, 0x64, 0xea, 0xb4, 0xd5, 0xe2, 0xcf, 0x58, 0x42, 0xbc, 0x79, 0xf2, 0xdb, 0x7b, 0x10,
-	0x29, 0x7b, 0x10, 0x7a, 0x45, 0x33, 0x94, 0x3d, 0x10, 0xcf, 0x6c, 0x01, 0xb2, 0x6d, 0xd3, 0x42,
-	0x63, 0x52, 0x18, 0xdb, 0xa6, 0x25, 0x9e, 0xd7, 0x0f, 0x41, 0x8d, 0x23, 0x87, 0x9b, 0xbf, 0x1e,
-	0x9d, 0xca, 0xa7, 0xa2, 0x3b, 0x8f, 0x1b, 0xe6, 0xfe, 0x88, 0xd6, 0x2c, 0x7c, 0xca, 0x10, 0x70,
-	0xd7, 0x31, 0xfb, 0x8f, 0x6c, 0x1e, 0xd2, 0xec, 0xa0, 0xbd, 0x6b, 0xb7, 0x44, 0x55, 0xb3, 0x55,
-	0xf9, 0x19, 0xde, 0x4d, 0x62, 0xd4, 0x6e, 0x26, 0x23, 0xbb, 0xf9, 0x28, 0x5c, 0x6a, 0xcc, 0x87,
-	0x9b, 0x79, 0x17, 0xa6, 0x3b, 0xde, 0x42, 0x4d, 0xcc, 0x75, 0xd9, 0xcc, 0xc2, 0xc0, 0xa0, 0x42,
-	0x2f, 0x6f, 0x96, 0xe7, 0x3a, 0xfd, 0x0f, 0xa6, 0xfd, 0x96, 0x80, 0xd5, 0x60, 0xfc, 0xfb, 0x86,
-	0x63, 0x7e, 0x6a, 0xd6, 0xb9, 0x2c, 0x93, 0xdb, 0x5a, 0x82, 0x4c, 0x7d, 0x8f, 0x9a, 0x96, 0x6c,
-	0x64, 0xb6, 0x9a, 0xe6, 0xdf, 0x95, 0x06, 0xb9, 0x01, 0x59, 0xff, 0x5c, 0xe0, 0xf5, 0x1d, 0xe3,
-	0x8e, 0xf4, 0x7d, 0x22, 0x8f, 0xeb, 0x64, 0xe4, 0x71, 0xf5, 0x6e, 0xb1, 0xb1, 0xef, 0x1a, 0x8e,
-	0x45, 0x5b, 0x9e, 0x5d, 0xb4, 0x1a, 0xe4, 0x52, 0xa5, 0x11, 0xa3, 0x6b, 0x8e, 0x0d, 0xea, 0x1a,
-	0xef, 0xa0, 0xf9, 0x17, 0x2d, 0x25, 0x0e, 0x9a, 0xfc, 0x26, 0xcb, 0x90, 0xf5, 0x5e, 0x37, 0xea,
-	0x76, 0x1d, 0x23, 0x9f, 0xe6, 0x62, 0xaa, 0xbf, 0xc0, 0x7b, 0x43, 0xf7, 0x6b, 0x0d, 0xa3, 0x45,
-	0x0f, 0xf2, 0x19, 0xec, 0x0d, 0xdd, 0xdf, 0xf6, 0xbe, 0xb5, 0x7f, 0x14, 0x58, 0x1b, 0x5e, 0x3c,
-	0xec, 0xd1, 0xff, 0xbf, 0x7a, 0x4b, 0x90, 0x31, 0x19, 0x96, 0x20, 0xc5, 0xe7, 0x68, 0xda, 0x64,
-	0xbc, 0x02, 0x97, 0x7e, 0x9d, 0x85, 0x63, 0xbc, 0x02, 0xa4, 0x05, 0x29, 0xa1, 0xb3, 0x89, 0x16,
-	0x7b, 0xa1, 0x42, 0xda, 0x5c, 0x5d, 0x1f, 0x89, 0x11, 0x95, 0xd3, 0x96, 0x3e, 0xff, 0xfd, 0xaf,
-	0xef, 0x12, 0x0b, 0x64, 0x3e, 0xa0, 0xfb, 0xeb, 0x22, 0x47, 0x07, 0x92, 0x9e, 0xd4, 0x24, 0x6b,
-	0xb1, 0x71, 0x02, 0xc2, 0x5a, 0x3d, 0x39, 0x02, 0x81, 0x79, 0xd6, 0x79, 0x9e, 0x15, 0x52, 0x08,
-	0xe4, 0xf1, 0x0a, 0xa0, 0x3f, 0xf2, 0x25, 0xf9, 0x21, 0xf9, 0x46, 0x01, 0xe8, 0xab, 0x5b, 0x72,
-	0x7a, 0x68, 0xd8, 0x90, 0xee, 0x56, 0xcf, 0xbc, 0x12, 0x87, 0x24, 0x2e, 0x72, 0x12, 0x67, 0x49,
-	0x29, 0x42, 0x02, 0x7b, 0xc3, 0x90, 0x8c, 0xdf, 0xa9, 0x43, 0xf2, 0xa3, 0x02, 0xb9, 0xa0, 0x9a,
-	0x25, 0xa5, 0xd8, 0x5c, 0x31, 0x9a, 0x5b, 0xdd, 0x18, 0x03, 0x89, 0xbc, 0xae, 0x70, 0x5e, 0x65,
-	0x72, 0x7e, 0xe0, 0xc7, 0x17, 0x8e, 0x77, 0xa6, 0x3f, 0x8a, 0x8e, 0xfb, 0x43, 0xf2, 0x19, 0xa4,
-	0xe5, 0xf4, 0x58, 0x1f, 0x3d, 0x5f, 0x05, 0xa1, 0xb1, 0x86, 0xb0, 0x56, 0xe2, 0x5c, 0x34, 0xb2,
-	0x16, 0xe0, 0x82, 0xa7, 0x9d, 0xe9, 0x8f, 0xfa, 0x37, 0xe1, 0x90, 0xfc, 0xac, 0xc0, 0x6c, 0x44,
-	0x34, 0x92, 0x73, 0xb1, 0x39, 0xe2, 0x95, 0xac, 0x7a, 0x7e, 0x3c, 0x30, 0x12, 0xbb, 0xca, 0x89,
-	0x6d, 0x12, 0x3d, 0x40, 0xac, 0x23, 0xb0, 0xb5, 0x3e, 0xc1, 0x01, 0x55, 0x71, 0x48, 0xbe, 0x56,
-	0x20, 0xeb, 0x5f, 0x73, 0xf2, 0x66, 0x6c, 0xd2, 0xa8, 0x90, 0x54, 0x4f, 0xbf, 0x0a, 0x86, 0xac,
-	0x36, 0x39, 0xab, 0x73, 0x64, 0x23, 0xc0, 0xaa, 0xff, 0x8a, 0xc7, 0xf2, 0xf9, 0x49, 0x01, 0xe8,
-	0x8b, 0xa9, 0x21, 0xa7, 0x7c, 0x40, 0x1c, 0x0e, 0x39, 0xe5, 0x83, 0xaa, 0x4c, 0xdb, 0xe2, 0x94,
-	0xde, 0x21, 0xd7, 0x42, 0x1d, 0x14, 0xa0, 0x38, 0x42, 0x5e, 0x57, 0xc3, 0x22, 0xf1, 0x90, 0x7c,
-	0xa5, 0x40, 0xd6, 0xd7, 0x52, 0x43, 0x6a, 0x16, 0x15, 0x75, 0x43, 0x6a, 0x36, 0x20, 0xc9, 0x62,
-	0xaf, 0xa1, 0xa4, 0x10, 0x5f, 0xb2, 0xef, 0x15, 0x98, 0x8b, 0x4a, 0x2d, 0x12, 0x7f, 0x7c, 0x86,
-	0x28, 0x36, 0xf5, 0xc2, 0x98, 0x68, 0xe4, 0x78, 0x8a, 0x73, 0x2c, 0x92, 0xe5, 0x00, 0x47, 0xca,
-	0xc1, 0xb5, 0x7e, 0x7b, 0xbd, 0x81, 0x2c, 0x7e, 0x9f, 0x0c, 0x19, 0xc8, 0xa1, 0x9f, 0x40, 0x43,
-	0x06, 0x72, 0xf8, 0x07, 0x51, 0xec, 0x40, 0x16, 0xbf, 0x7a, 0xc8, 0x97, 0x0a, 0x4c, 0x87, 0x04,
-	0x17, 0xd9, 0x18, 0x75, 0xa5, 0x43, 0x8a, 0x51, 0x3d, 0x3b, 0x0e, 0x14, 0x39, 0x9c, 0xe4, 0x1c,
-	0x0a, 0x64, 0x69, 0x70, 0x06, 0xd4, 0x98, 0xc8, 0xfc, 0x85, 0x02, 0xb9, 0xa0, 0x5c, 0x1a, 0x32,
-	0x18, 0x63, 0x14, 0x9c, 0xba, 0x31, 0x06, 0x72, 0x0c, 0x22, 0x5c, 0x62, 0x31, 0xf2, 0x58, 0x81,
-	0x85, 0x18, 0x69, 0x40, 0xf4, 0x51, 0x59, 0x62, 0x14, 0x98, 0x7a, 0x71, 0x7c, 0x87, 0x11, 0xec,
-	0x7a, 0x1e, 0xf0, 0x40, 0x0e, 0xa4, 0xad, 0xca, 0xd3, 0xa3, 0xa2, 0xf2, 0xec, 0xa8, 0xa8, 0xfc,
-	0x79, 0x54, 0x54, 0xbe, 0x7d, 0x59, 0x9c, 0x78, 0xf6, 0xb2, 0x38, 0xf1, 0xc7, 0xcb, 0xe2, 0xc4,
-	0x07, 0x7a, 0xd3, 0x74, 0xf7, 0xba, 0xbb, 0xe5, 0xba, 0xdd, 0xd6, 0xbd, 0xc4, 0xfc, 0xbf, 0x6c,
-	0x75, 0xbb, 0xa5, 0x73, 0xe1, 0xa2, 0xf7, 0x2e, 0xeb, 0xfb, 0x32, 0xac, 0x7b, 0xd0, 0x31, 0xd8,
-	0x6e, 0x8a, 0x23, 0x2e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x69, 0x17, 0x2e, 0x0f, 0x21, 0x14,
-	0x00, 0x00,
+func (m *QuerySubscriptionRequest) Reset()         { *m = QuerySubscriptionRequest{} }
+func (m *QuerySubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionRequest) ProtoMessage()    {}
+func (*QuerySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{28}
+}
+func (m *QuerySubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionRequest.Merge(m, src)
+}
+func (m *QuerySubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySubscriptionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Counts queries the number of existing data sources, oracle scripts, and
-	// requests.
-	Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error)
-	// Data queries content of the data source or oracle script for given SHA256
-	// file hash.
-	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
-	// DataSource queries data source summary info for given data source id.
-	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
-	// OracleScript queries oracle script summary info for given oracle script id.
-	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
-	// Request queries request info for given request id.
-	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
-	// PendingRequests queries list of pending request IDs assigned to given
-	// validator.
-	PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error)
-	// Validator queries properties of given validator address.
-	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
-	// IsReporter queries grant of account on this validator.
-	IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error)
-	// Reporters queries all reporters associated with given validator address.
-	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
-	// ActiveValidators queries all active oracle validators.
-	ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error)
-	// Params queries parameters used for running BandChain network.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// RequestSearch queries the latest request that match search criteria.
-	RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error)
-	// RequestPrice queries the latest price on standard price reference oracle
-	// script.
-	RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error)
-	// RequestVerification verifies a request to make sure that
-	// all information that will be used to report the data is valid
-	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+func (m *QuerySubscriptionRequest) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QuerySubscriptionResponse is response type for the Query/Subscription RPC
+// method.
+type QuerySubscriptionResponse struct {
+	// Subscription is summary information of a subscription
+	Subscription Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySubscriptionResponse) Reset()         { *m = QuerySubscriptionResponse{} }
+func (m *QuerySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionResponse) ProtoMessage()    {}
+func (*QuerySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{29}
 }
-
-func (c *queryClient) Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error) {
-	out := new(QueryCountsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Counts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
-	out := new(QueryDataResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Data", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubscriptionResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionResponse) GetSubscription() Subscription {
+	if m != nil {
+		return m.Subscription
 	}
-	return out, nil
+	return Subscription{}
 }
 
-func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error) {
-	out := new(QueryDataSourceResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySubscriptionsRequest is request type for the Query/Subscriptions RPC
+// method.
+type QuerySubscriptionsRequest struct {
+	// Owner is an optional account address to filter the subscriptions by
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
-	out := new(QueryOracleScriptResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScript", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionsRequest) Reset()         { *m = QuerySubscriptionsRequest{} }
+func (m *QuerySubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionsRequest) ProtoMessage()    {}
+func (*QuerySubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{30}
+}
+func (m *QuerySubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionsRequest.Merge(m, src)
+}
+func (m *QuerySubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
-	out := new(QueryRequestResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Request", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubscriptionsRequest proto.InternalMessageInfo
+
+func (m *QuerySubscriptionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error) {
-	out := new(QueryPendingRequestsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/PendingRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
-	out := new(QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySubscriptionsResponse is response type for the Query/Subscriptions RPC
+// method.
+type QuerySubscriptionsResponse struct {
+	// Subscriptions is a list of subscriptions
+	Subscriptions []Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error) {
-	out := new(QueryIsReporterResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/IsReporter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionsResponse) Reset()         { *m = QuerySubscriptionsResponse{} }
+func (m *QuerySubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionsResponse) ProtoMessage()    {}
+func (*QuerySubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{31}
+}
+func (m *QuerySubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionsResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
-	out := new(QueryReportersResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Reporters", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionsResponse) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error) {
-	out := new(QueryActiveValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ActiveValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDataSourceVersionsRequest is request type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsRequest struct {
+	// DataSourceID is ID of a data source
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error) {
-	out := new(QueryRequestSearchResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestSearch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDataSourceVersionsRequest) Reset()         { *m = QueryDataSourceVersionsRequest{} }
+func (m *QueryDataSourceVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSourceVersionsRequest) ProtoMessage()    {}
+func (*QueryDataSourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{32}
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSourceVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSourceVersionsRequest.Merge(m, src)
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSourceVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSourceVersionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error) {
-	out := new(QueryRequestPriceResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataSourceVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryDataSourceVersionsRequest) GetDataSourceId() uint64 {
+	if m != nil {
+		return m.DataSourceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error) {
-	out := new(QueryRequestVerificationResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDataSourceVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Counts queries the number of existing data sources, oracle scripts, and
-	// requests.
-	Counts(context.Context, *QueryCountsRequest) (*QueryCountsResponse, error)
-	// Data queries content of the data source or oracle script for given SHA256
-	// file hash.
-	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
-	// DataSource queries data source summary info for given data source id.
-	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
-	// OracleScript queries oracle script summary info for given oracle script id.
-	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
-	// Request queries request info for given request id.
-	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
-	// PendingRequests queries list of pending request IDs assigned to given
-	// validator.
-	PendingRequests(context.Context, *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error)
-	// Validator queries properties of given validator address.
-	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
-	// IsReporter queries grant of account on this validator.
-	IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error)
-	// Reporters queries all reporters associated with given validator address.
-	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
-	// ActiveValidators queries all active oracle validators.
-	ActiveValidators(context.Context, *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error)
-	// Params queries parameters used for running BandChain network.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// RequestSearch queries the latest request that match search criteria.
-	RequestSearch(context.Context, *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error)
-	// RequestPrice queries the latest price on standard price reference oracle
-	// script.
-	RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error)
-	// RequestVerification verifies a request to make sure that
-	// all information that will be used to report the data is valid
-	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+// QueryDataSourceVersionsResponse is response type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsResponse struct {
+	// Versions is a list of versions of the data source, from the oldest
+	Versions []DataSourceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDataSourceVersionsResponse) Reset()         { *m = QueryDataSourceVersionsResponse{} }
+func (m *QueryDataSourceVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSourceVersionsResponse) ProtoMessage()    {}
+func (*QueryDataSourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{33}
 }
-
-func (*UnimplementedQueryServer) Counts(ctx context.Context, req *QueryCountsRequest) (*QueryCountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Counts not implemented")
+func (m *QueryDataSourceVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
+func (m *QueryDataSourceVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSourceVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DataSource(ctx context.Context, req *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
+func (m *QueryDataSourceVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSourceVersionsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OracleScript(ctx context.Context, req *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
+func (m *QueryDataSourceVersionsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryRequestRequest) (*QueryRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+func (m *QueryDataSourceVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSourceVersionsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PendingRequests(ctx context.Context, req *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingRequests not implemented")
+
+var xxx_messageInfo_QueryDataSourceVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryDataSourceVersionsResponse) GetVersions() []DataSourceVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+
+func (m *QueryDataSourceVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) IsReporter(ctx context.Context, req *QueryIsReporterRequest) (*QueryIsReporterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsReporter not implemented")
+
+// QueryOracleScriptVersionsRequest is request type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsRequest struct {
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+
+func (m *QueryOracleScriptVersionsRequest) Reset()         { *m = QueryOracleScriptVersionsRequest{} }
+func (m *QueryOracleScriptVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleScriptVersionsRequest) ProtoMessage()    {}
+func (*QueryOracleScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{34}
 }
-func (*UnimplementedQueryServer) ActiveValidators(ctx context.Context, req *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveValidators not implemented")
+func (m *QueryOracleScriptVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryOracleScriptVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleScriptVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RequestSearch(ctx context.Context, req *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestSearch not implemented")
+func (m *QueryOracleScriptVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleScriptVersionsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RequestPrice(ctx context.Context, req *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestPrice not implemented")
+func (m *QueryOracleScriptVersionsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RequestVerification(ctx context.Context, req *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
+func (m *QueryOracleScriptVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleScriptVersionsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryOracleScriptVersionsRequest proto.InternalMessageInfo
 
-func _Query_Counts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Counts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Counts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Counts(ctx, req.(*QueryCountsRequest))
+func (m *QueryOracleScriptVersionsRequest) GetOracleScriptId() uint64 {
+	if m != nil {
+		return m.OracleScriptId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Data(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Data",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+func (m *QueryOracleScriptVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/DataSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataSource(ctx, req.(*QueryDataSourceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOracleScriptVersionsResponse is response type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsResponse struct {
+	// Versions is a list of versions of the oracle script, from the oldest
+	Versions []OracleScriptVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleScriptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleScript(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/OracleScript",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleScript(ctx, req.(*QueryOracleScriptRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOracleScriptVersionsResponse) Reset()         { *m = QueryOracleScriptVersionsResponse{} }
+func (m *QueryOracleScriptVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleScriptVersionsResponse) ProtoMessage()    {}
+func (*QueryOracleScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{35}
 }
-
-func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Request(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Request",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Request(ctx, req.(*QueryRequestRequest))
+func (m *QueryOracleScriptVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleScriptVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleScriptVersionsResponse.Merge(m, src)
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleScriptVersionsResponse.DiscardUnknown(m)
 }
 
-func _Query_PendingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/PendingRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingRequests(ctx, req.(*QueryPendingRequestsRequest))
+var xxx_messageInfo_QueryOracleScriptVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleScriptVersionsResponse) GetVersions() []OracleScriptVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+func (m *QueryOracleScriptVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IsReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsReporterRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryCountsRequest)(nil), "band.oracle.v1.QueryCountsRequest")
+	proto.RegisterType((*QueryCountsResponse)(nil), "band.oracle.v1.QueryCountsResponse")
+	proto.RegisterType((*QueryDataRequest)(nil), "band.oracle.v1.QueryDataRequest")
+	proto.RegisterType((*QueryDataResponse)(nil), "band.oracle.v1.QueryDataResponse")
+	proto.RegisterType((*QueryDataSourceRequest)(nil), "band.oracle.v1.QueryDataSourceRequest")
+	proto.RegisterType((*QueryDataSourceResponse)(nil), "band.oracle.v1.QueryDataSourceResponse")
+	proto.RegisterType((*QueryOracleScriptRequest)(nil), "band.oracle.v1.QueryOracleScriptRequest")
+	proto.RegisterType((*QueryOracleScriptResponse)(nil), "band.oracle.v1.QueryOracleScriptResponse")
+	proto.RegisterType((*QueryRequestRequest)(nil), "band.oracle.v1.QueryRequestRequest")
+	proto.RegisterType((*QueryRequestResponse)(nil), "band.oracle.v1.QueryRequestResponse")
+	proto.RegisterType((*QueryPendingRequestsRequest)(nil), "band.oracle.v1.QueryPendingRequestsRequest")
+	proto.RegisterType((*QueryPendingRequestsResponse)(nil), "band.oracle.v1.QueryPendingRequestsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorRequest)(nil), "band.oracle.v1.QueryValidatorRequest")
+	proto.RegisterType((*QueryValidatorResponse)(nil), "band.oracle.v1.QueryValidatorResponse")
+	proto.RegisterType((*QueryIsReporterRequest)(nil), "band.oracle.v1.QueryIsReporterRequest")
+	proto.RegisterType((*QueryIsReporterResponse)(nil), "band.oracle.v1.QueryIsReporterResponse")
+	proto.RegisterType((*QueryReportersRequest)(nil), "band.oracle.v1.QueryReportersRequest")
+	proto.RegisterType((*QueryReportersResponse)(nil), "band.oracle.v1.QueryReportersResponse")
+	proto.RegisterType((*QueryActiveValidatorsRequest)(nil), "band.oracle.v1.QueryActiveValidatorsRequest")
+	proto.RegisterType((*QueryActiveValidatorsResponse)(nil), "band.oracle.v1.QueryActiveValidatorsResponse")
+	proto.RegisterType((*QueryRequestSearchRequest)(nil), "band.oracle.v1.QueryRequestSearchRequest")
+	proto.RegisterType((*QueryRequestSearchResponse)(nil), "band.oracle.v1.QueryRequestSearchResponse")
+	proto.RegisterType((*QueryRequestPriceRequest)(nil), "band.oracle.v1.QueryRequestPriceRequest")
+	proto.RegisterType((*QueryRequestPriceResponse)(nil), "band.oracle.v1.QueryRequestPriceResponse")
+	proto.RegisterType((*QueryRequestVerificationRequest)(nil), "band.oracle.v1.QueryRequestVerificationRequest")
+	proto.RegisterType((*QueryRequestVerificationResponse)(nil), "band.oracle.v1.QueryRequestVerificationResponse")
+	proto.RegisterType((*QuerySubscriptionRequest)(nil), "band.oracle.v1.QuerySubscriptionRequest")
+	proto.RegisterType((*QuerySubscriptionResponse)(nil), "band.oracle.v1.QuerySubscriptionResponse")
+	proto.RegisterType((*QuerySubscriptionsRequest)(nil), "band.oracle.v1.QuerySubscriptionsRequest")
+	proto.RegisterType((*QuerySubscriptionsResponse)(nil), "band.oracle.v1.QuerySubscriptionsResponse")
+	proto.RegisterType((*QueryDataSourceVersionsRequest)(nil), "band.oracle.v1.QueryDataSourceVersionsRequest")
+	proto.RegisterType((*QueryDataSourceVersionsResponse)(nil), "band.oracle.v1.QueryDataSourceVersionsResponse")
+	proto.RegisterType((*QueryOracleScriptVersionsRequest)(nil), "band.oracle.v1.QueryOracleScriptVersionsRequest")
+	proto.RegisterType((*QueryOracleScriptVersionsResponse)(nil), "band.oracle.v1.QueryOracleScriptVersionsResponse")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/query.proto", fileDescriptor_e351f430ef3842d0) }
+
+var fileDescriptor_e351f430ef3842d0 = []byte{
+	// 1953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x8f, 0x1d, 0x7b, 0xe6, 0x79, 0xfc, 0x55, 0x36, 0xc9, 0xb8, 0x6d, 0x8f, 0xed, 0x76,
+	0x36, 0xfe, 0xd8, 0x64, 0x3a, 0xe3, 0x2c, 0x44, 0x2c, 0xb0, 0x4b, 0xec, 0x10, 0x76, 0x04, 0xda,
+	0xcd, 0xb6, 0xa5, 0x1c, 0x10, 0x30, 0xd4, 0x74, 0x77, 0xc6, 0xad, 0x1d, 0x77, 0xcf, 0x76, 0xf5,
+	0x0c, 0xb1, 0x2c, 0x73, 0xe0, 0x80, 0x40, 0xe2, 0x4b, 0x5a, 0x3e, 0x84, 0xf6, 0xc4, 0x81, 0x23,
+	0x02, 0x89, 0x3d, 0xed, 0x5f, 0xb0, 0x27, 0xb4, 0x5a, 0x2e, 0x9c, 0x56, 0x28, 0xe1, 0x9f, 0xe0,
+	0x04, 0xea, 0xaa, 0xd7, 0x3d, 0xd5, 0x3d, 0x3d, 0x1f, 0x91, 0xe2, 0x03, 0xb7, 0xe9, 0xaa, 0xdf,
+	0x7b, 0xef, 0x57, 0xaf, 0x5e, 0xbd, 0x7a, 0xaf, 0x06, 0xd4, 0x06, 0x75, 0x2d, 0xdd, 0xf3, 0xa9,
+	0xd9, 0xb2, 0xf5, 0x6e, 0x55, 0x7f, 0xbf, 0x63, 0xfb, 0x67, 0x95, 0xb6, 0xef, 0x05, 0x1e, 0x99,
+	0x0b, 0xe7, 0x2a, 0x62, 0xae, 0xd2, 0xad, 0xaa, 0xcb, 0x4d, 0xaf, 0xe9, 0xf1, 0x29, 0x3d, 0xfc,
+	0x25, 0x50, 0xea, 0x5a, 0xd3, 0xf3, 0x9a, 0x2d, 0x5b, 0xa7, 0x6d, 0x47, 0xa7, 0xae, 0xeb, 0x05,
+	0x34, 0x70, 0x3c, 0x97, 0xe1, 0xec, 0x8a, 0xe9, 0xb1, 0x53, 0x8f, 0xd5, 0x85, 0x98, 0xf8, 0xc0,
+	0xa9, 0x7d, 0xf1, 0xa5, 0x37, 0x28, 0xb3, 0x85, 0x5d, 0xbd, 0x5b, 0x6d, 0xd8, 0x01, 0xad, 0xea,
+	0x6d, 0xda, 0x74, 0x5c, 0xae, 0x07, 0xb1, 0xab, 0x29, 0x9a, 0x48, 0x8a, 0x4f, 0x6a, 0xcb, 0x40,
+	0xde, 0x0d, 0xc5, 0x8f, 0xbc, 0x8e, 0x1b, 0x30, 0xc3, 0x7e, 0xbf, 0x63, 0xb3, 0x40, 0xfb, 0x9d,
+	0x02, 0x4b, 0x89, 0x61, 0xd6, 0xf6, 0x5c, 0x66, 0x93, 0x7d, 0x58, 0xb4, 0x68, 0x40, 0xeb, 0xcc,
+	0xeb, 0xf8, 0xa6, 0x5d, 0x37, 0xc3, 0xd9, 0x92, 0xb2, 0xa9, 0xec, 0x4e, 0x1a, 0xf3, 0xe1, 0xc4,
+	0x31, 0x1f, 0xe7, 0x42, 0xa4, 0x02, 0x4b, 0xc2, 0x52, 0x9d, 0x99, 0xbe, 0xd3, 0x0e, 0x10, 0x9d,
+	0xe3, 0xe8, 0x45, 0x31, 0x75, 0xcc, 0x67, 0x04, 0x7e, 0x1b, 0x66, 0x7d, 0x61, 0x1e, 0x91, 0x13,
+	0x1c, 0x59, 0xc4, 0x41, 0x0e, 0xd2, 0x74, 0x58, 0xe0, 0xbc, 0x1e, 0xd0, 0x80, 0x22, 0x59, 0xb2,
+	0x0a, 0x05, 0x4e, 0xea, 0x84, 0xb2, 0x13, 0x4e, 0xa6, 0x60, 0xe4, 0xc3, 0x81, 0xb7, 0x28, 0x3b,
+	0xd1, 0x76, 0x60, 0x51, 0x12, 0xc0, 0x65, 0x10, 0x98, 0x0c, 0x01, 0x1c, 0x5c, 0x34, 0xf8, 0x6f,
+	0xed, 0x0d, 0xb8, 0x16, 0x03, 0xc5, 0x32, 0x22, 0xfd, 0x37, 0x60, 0x4e, 0x5e, 0xb4, 0x63, 0xe1,
+	0x8a, 0x8b, 0xbd, 0x15, 0xd7, 0x2c, 0xed, 0x31, 0x5c, 0xef, 0x93, 0x47, 0x73, 0x5f, 0x81, 0x19,
+	0x49, 0x01, 0x97, 0x9e, 0x39, 0x50, 0x2b, 0xc9, 0x08, 0xa9, 0x48, 0x82, 0xd0, 0xd3, 0xac, 0x3d,
+	0x80, 0x12, 0xd7, 0xfb, 0x8e, 0xe4, 0xb0, 0x88, 0xd9, 0x2e, 0x2c, 0x24, 0x5d, 0x1c, 0x73, 0x9b,
+	0x93, 0xfd, 0x5b, 0xb3, 0xb4, 0xef, 0xc3, 0x4a, 0x86, 0x16, 0xe4, 0x77, 0x1f, 0x66, 0x13, 0x6a,
+	0x90, 0xe1, 0x5a, 0x9a, 0x61, 0x42, 0xb8, 0x28, 0x5b, 0xd0, 0xbe, 0x8d, 0xf1, 0x82, 0xcc, 0x22,
+	0x82, 0xeb, 0x00, 0xd1, 0x9e, 0xc6, 0xd4, 0x0a, 0x38, 0x52, 0xb3, 0xc8, 0x35, 0x98, 0xb2, 0x6c,
+	0xd3, 0xb3, 0x6c, 0x1e, 0x15, 0x79, 0x03, 0xbf, 0xb4, 0xff, 0xe4, 0x60, 0x39, 0xa9, 0x0e, 0x99,
+	0x56, 0x61, 0x1a, 0xa5, 0x91, 0xe3, 0xf5, 0x34, 0xc7, 0x48, 0x22, 0xc2, 0x91, 0x2f, 0x85, 0x22,
+	0x6d, 0xcf, 0x0f, 0x58, 0x29, 0xb7, 0x39, 0xb1, 0x3b, 0x73, 0x70, 0xad, 0x5f, 0x24, 0x9c, 0x3e,
+	0x9c, 0xfc, 0xe4, 0xf3, 0x8d, 0x2b, 0x46, 0x04, 0x26, 0x15, 0x98, 0xf2, 0x6d, 0xd6, 0x69, 0x89,
+	0x38, 0xcc, 0x14, 0x0b, 0x67, 0x0d, 0x44, 0x91, 0x7b, 0x30, 0xcd, 0x9c, 0xa6, 0xeb, 0xb8, 0xcd,
+	0xd2, 0x24, 0x17, 0x58, 0x4f, 0x0b, 0x1c, 0x8b, 0x69, 0x94, 0x8b, 0xd0, 0x61, 0x74, 0x3c, 0xb1,
+	0xed, 0xba, 0x6f, 0x9b, 0x76, 0xe8, 0xfb, 0xab, 0xd9, 0xd1, 0xf1, 0xd0, 0xb6, 0x0d, 0x81, 0x30,
+	0xe0, 0x49, 0xfc, 0x9b, 0xec, 0xc1, 0x82, 0xf0, 0x99, 0x55, 0x37, 0x69, 0xab, 0xc5, 0xa3, 0x7a,
+	0x8a, 0x1f, 0x81, 0x79, 0x1c, 0x3f, 0xc2, 0x61, 0xf2, 0x0a, 0xcc, 0x45, 0x50, 0x5c, 0xd8, 0x34,
+	0x07, 0xce, 0xe2, 0xa8, 0xe0, 0xa5, 0x9d, 0xc2, 0x2a, 0x77, 0xfd, 0x23, 0xdb, 0xb5, 0x38, 0x5b,
+	0xee, 0xc6, 0x28, 0x33, 0x90, 0xb7, 0x61, 0xb1, 0x4b, 0x5b, 0x8e, 0x45, 0x03, 0xcf, 0xaf, 0x53,
+	0xcb, 0xf2, 0x6d, 0xc6, 0xc4, 0xa1, 0x3b, 0xdc, 0xfa, 0xec, 0xa3, 0xdb, 0xeb, 0x98, 0xa5, 0x1e,
+	0x47, 0x98, 0xfb, 0x02, 0x72, 0x1c, 0xf8, 0xa1, 0xb2, 0x85, 0x6e, 0x6a, 0x5c, 0x7b, 0x07, 0xd6,
+	0xb2, 0xcd, 0xe1, 0x8e, 0xeb, 0x30, 0xd3, 0x8b, 0xa0, 0xd0, 0xd2, 0xc4, 0xee, 0xe4, 0xe1, 0xdc,
+	0xb3, 0xcf, 0x37, 0x00, 0xa1, 0xb5, 0x07, 0xcc, 0x80, 0x38, 0xa4, 0x58, 0x9c, 0xd0, 0x1e, 0x51,
+	0x9f, 0x9e, 0xc6, 0x09, 0xed, 0x5b, 0xb0, 0x94, 0x18, 0x45, 0xed, 0xaf, 0xc1, 0x54, 0x9b, 0x8f,
+	0x94, 0x94, 0xec, 0x4d, 0x16, 0x78, 0x8c, 0x0d, 0xc4, 0x6a, 0x4d, 0xf8, 0x02, 0x57, 0x16, 0x2f,
+	0xf2, 0xb2, 0x9c, 0xf3, 0x2e, 0xe6, 0x24, 0xc9, 0x10, 0x12, 0xbf, 0x07, 0x53, 0x2c, 0xa0, 0x41,
+	0x27, 0x22, 0xbe, 0x91, 0x26, 0x1e, 0x8b, 0x1c, 0x73, 0x98, 0x81, 0x70, 0xed, 0xcf, 0x0a, 0xea,
+	0xac, 0x31, 0x11, 0xf7, 0xf6, 0x65, 0xb1, 0x27, 0x47, 0xb0, 0xe0, 0xa3, 0x89, 0x58, 0x5d, 0x8e,
+	0xab, 0x2b, 0x7d, 0xf6, 0xd1, 0xed, 0x65, 0x54, 0x97, 0xd4, 0x32, 0x1f, 0x49, 0x44, 0x2e, 0x78,
+	0x1d, 0xae, 0xf7, 0xd1, 0x45, 0x1f, 0x6c, 0xc0, 0x8c, 0xc3, 0xea, 0x91, 0x00, 0x67, 0x9a, 0x37,
+	0xc0, 0x89, 0x81, 0xf1, 0x3e, 0x45, 0x03, 0x97, 0x16, 0xc4, 0x6f, 0xc3, 0xb5, 0xb4, 0xa1, 0x38,
+	0xc0, 0xf2, 0x12, 0xc1, 0x89, 0xa1, 0x6b, 0x8f, 0x91, 0x5a, 0x19, 0x0f, 0xc5, 0x7d, 0x33, 0x70,
+	0xba, 0x76, 0x4c, 0x23, 0x8e, 0xe6, 0x1f, 0xc0, 0xfa, 0x80, 0x79, 0x34, 0xfb, 0x26, 0x40, 0x4c,
+	0x52, 0x1c, 0x9a, 0x8c, 0x10, 0x49, 0x49, 0x1b, 0x92, 0x88, 0xf6, 0xa1, 0x82, 0x17, 0x06, 0x9a,
+	0x3c, 0xb6, 0xa9, 0x6f, 0x9e, 0xbc, 0xf0, 0xbd, 0x43, 0x54, 0xc8, 0xc7, 0x79, 0x29, 0x27, 0xae,
+	0xe6, 0xe8, 0x3b, 0xbc, 0xb7, 0x29, 0x7b, 0x2f, 0x71, 0xd9, 0xe7, 0x29, 0x7b, 0x4f, 0x54, 0x03,
+	0xab, 0x50, 0x38, 0x75, 0x5c, 0x9c, 0x9c, 0x14, 0x93, 0xa7, 0x8e, 0xcb, 0x27, 0xb5, 0xef, 0x82,
+	0x9a, 0x45, 0x0e, 0x17, 0xff, 0x46, 0xfa, 0x92, 0xb8, 0x91, 0x5e, 0x79, 0xd6, 0xdd, 0x12, 0xdf,
+	0x18, 0x9a, 0x0b, 0x25, 0x19, 0xf0, 0xc8, 0x77, 0x7a, 0xb5, 0x40, 0x09, 0xa6, 0xd9, 0xd9, 0x69,
+	0xc3, 0x6b, 0x09, 0xaf, 0x16, 0x8c, 0xe8, 0x33, 0xb9, 0x9a, 0xdc, 0xb0, 0xd5, 0x4c, 0xa4, 0x56,
+	0xf3, 0x3d, 0x58, 0xc9, 0xb0, 0x87, 0x8b, 0xf9, 0x3a, 0xcc, 0xb6, 0xc3, 0x01, 0xcc, 0xd9, 0xd1,
+	0x66, 0xae, 0xf6, 0x25, 0x2a, 0x94, 0x0a, 0xaf, 0x96, 0x62, 0xbb, 0xf7, 0xc1, 0xb4, 0x8f, 0x73,
+	0xb0, 0x21, 0xeb, 0x7f, 0x6c, 0xfb, 0xce, 0x13, 0xc7, 0xe4, 0x15, 0x62, 0xb4, 0xac, 0x15, 0xc8,
+	0x9b, 0x27, 0xd4, 0x71, 0xa3, 0x8d, 0x2c, 0x18, 0xd3, 0xfc, 0xbb, 0x66, 0x91, 0x37, 0xa1, 0x10,
+	0xc7, 0x45, 0x29, 0x37, 0xee, 0x19, 0xe9, 0xc9, 0xa4, 0x6a, 0x80, 0x89, 0x74, 0x0d, 0xb0, 0x01,
+	0x33, 0xf6, 0xd3, 0xc0, 0xf6, 0x5d, 0xda, 0x0a, 0xe7, 0xc5, 0x56, 0x43, 0x34, 0x54, 0xb3, 0x32,
+	0xca, 0xaf, 0xab, 0xfd, 0xe5, 0x57, 0x18, 0x68, 0xf1, 0x41, 0x13, 0x17, 0x60, 0xfc, 0x4d, 0xd6,
+	0xa0, 0x10, 0x5e, 0xb6, 0x34, 0xe8, 0xf8, 0x36, 0xbf, 0xf4, 0x8a, 0x46, 0x6f, 0x80, 0xef, 0x0d,
+	0x7d, 0x5a, 0xb7, 0xec, 0x16, 0x3d, 0x2b, 0xe5, 0x71, 0x6f, 0xe8, 0xd3, 0x07, 0xe1, 0xb7, 0xf6,
+	0x5f, 0x05, 0x36, 0x07, 0x3b, 0x0f, 0xf7, 0xe8, 0xff, 0xdf, 0x7b, 0x2b, 0x90, 0x77, 0x18, 0xba,
+	0x60, 0x8a, 0xe7, 0xd1, 0x69, 0x87, 0x09, 0x0f, 0x1c, 0xe1, 0x69, 0x38, 0xee, 0x34, 0xc4, 0x61,
+	0x97, 0xc2, 0x66, 0x07, 0xe6, 0x99, 0x34, 0x2c, 0xa5, 0x01, 0x79, 0xb8, 0x66, 0x69, 0x26, 0xac,
+	0x64, 0x28, 0x41, 0xf7, 0x3d, 0x84, 0xa2, 0x0c, 0x1f, 0x54, 0x7d, 0xca, 0xb2, 0x78, 0x21, 0x27,
+	0xe4, 0xb4, 0x0f, 0x94, 0x0c, 0x2b, 0x71, 0xce, 0xaf, 0xc0, 0x55, 0xef, 0x87, 0x2e, 0xde, 0x13,
+	0xc3, 0xd2, 0xb0, 0x80, 0x91, 0x87, 0x00, 0xbd, 0x4e, 0x8a, 0x6f, 0xdd, 0xcc, 0xc1, 0xcd, 0x0a,
+	0x4a, 0x34, 0x28, 0xb3, 0x2b, 0xa2, 0xdd, 0xc3, 0xb6, 0xab, 0xf2, 0x88, 0x36, 0xa3, 0x2c, 0x61,
+	0x48, 0x92, 0xda, 0x5f, 0x14, 0x50, 0xb3, 0x58, 0xe1, 0xe2, 0xdf, 0x82, 0x59, 0x79, 0x11, 0xd1,
+	0xf9, 0x1e, 0x67, 0xf5, 0x49, 0x41, 0xf2, 0xcd, 0x0c, 0xc2, 0x3b, 0x23, 0x09, 0x63, 0xf2, 0x93,
+	0x19, 0xff, 0x52, 0x81, 0x72, 0xaa, 0x95, 0x79, 0x6c, 0xfb, 0x4c, 0x76, 0xe6, 0x58, 0x2d, 0xd1,
+	0xcb, 0x74, 0xe1, 0xc6, 0x40, 0x42, 0xe8, 0xc7, 0x23, 0xc8, 0x77, 0x71, 0x0c, 0x5d, 0xb8, 0x35,
+	0xb8, 0xc1, 0x42, 0x69, 0xf4, 0x63, 0x2c, 0xf8, 0xf2, 0x5c, 0xf8, 0x9b, 0x28, 0x6d, 0xc8, 0x2d,
+	0x53, 0xda, 0x89, 0xe3, 0xdf, 0xa2, 0x2f, 0xcb, 0x91, 0x7f, 0x53, 0x60, 0x6b, 0x08, 0x2d, 0x74,
+	0xe5, 0x37, 0xfa, 0x5c, 0xb9, 0x3d, 0xac, 0x13, 0xbc, 0x6c, 0x67, 0x1e, 0xfc, 0x7d, 0x19, 0xae,
+	0x72, 0xd6, 0xa4, 0x05, 0x53, 0xe2, 0x41, 0x82, 0x68, 0x99, 0x57, 0x7a, 0xe2, 0x11, 0x43, 0xdd,
+	0x1e, 0x8a, 0x11, 0x86, 0xb4, 0x95, 0x1f, 0xff, 0xe3, 0xdf, 0x1f, 0xe4, 0x96, 0xc8, 0xa2, 0xf4,
+	0x40, 0x62, 0x0a, 0x1b, 0x6d, 0x98, 0x0c, 0x43, 0x86, 0x6c, 0x66, 0xea, 0x91, 0x5e, 0x20, 0xd4,
+	0xad, 0x21, 0x08, 0xb4, 0xb3, 0xcd, 0xed, 0xac, 0x93, 0x55, 0xc9, 0x4e, 0x78, 0x58, 0xf4, 0xf3,
+	0xf8, 0xed, 0xe2, 0x82, 0xfc, 0x4a, 0x01, 0xe8, 0x45, 0x29, 0xb9, 0x39, 0x50, 0x6d, 0xe2, 0x81,
+	0x42, 0xdd, 0x19, 0x89, 0x43, 0x12, 0x77, 0x38, 0x89, 0x7d, 0xb2, 0x9b, 0x22, 0x81, 0xe7, 0x98,
+	0xe9, 0xe7, 0xd2, 0x57, 0xdd, 0xb1, 0x2e, 0xc8, 0x1f, 0x14, 0x28, 0xca, 0x9b, 0x4d, 0x76, 0x33,
+	0x6d, 0x65, 0x3c, 0x4e, 0xa8, 0x7b, 0x63, 0x20, 0x91, 0xd7, 0x6b, 0x9c, 0x57, 0x85, 0xdc, 0xea,
+	0x7b, 0xa5, 0xc2, 0xa3, 0xc1, 0xf4, 0xf3, 0xf4, 0x51, 0xb9, 0x20, 0x3f, 0x82, 0xe9, 0xe8, 0x28,
+	0x6d, 0x0f, 0xaf, 0xf0, 0x04, 0xa1, 0xb1, 0xca, 0x40, 0x6d, 0x97, 0x73, 0xd1, 0xc8, 0xa6, 0xc4,
+	0x05, 0xef, 0x5b, 0xa6, 0x9f, 0xf7, 0xee, 0xe2, 0x0b, 0xf2, 0x27, 0x05, 0xe6, 0x53, 0x6d, 0x2b,
+	0x79, 0x35, 0xd3, 0x46, 0x76, 0x2f, 0xad, 0xde, 0x1a, 0x0f, 0x8c, 0xc4, 0xee, 0x71, 0x62, 0x55,
+	0xa2, 0x4b, 0xc4, 0xda, 0x02, 0x5b, 0xef, 0x11, 0xec, 0xeb, 0x6b, 0x2e, 0xc8, 0x2f, 0x14, 0x28,
+	0xc4, 0x85, 0x06, 0x79, 0x25, 0xd3, 0x68, 0xba, 0x95, 0x55, 0x6f, 0x8e, 0x82, 0x21, 0xab, 0x2a,
+	0x67, 0xf5, 0x2a, 0xd9, 0x93, 0x58, 0xc5, 0x1c, 0xb2, 0xf9, 0xfc, 0x51, 0x01, 0xe8, 0xb5, 0x73,
+	0x03, 0xa2, 0xbc, 0xaf, 0x3d, 0x55, 0x77, 0x46, 0xe2, 0x90, 0xd2, 0x21, 0xa7, 0xf4, 0x55, 0xf2,
+	0x7a, 0x62, 0x07, 0x05, 0x28, 0x8b, 0x90, 0x7e, 0x1e, 0xcd, 0xf6, 0x38, 0xfe, 0x5c, 0x81, 0x42,
+	0xa4, 0x98, 0x0d, 0xf0, 0x59, 0xba, 0xad, 0x54, 0x6f, 0x8e, 0x82, 0x0d, 0x39, 0x86, 0x11, 0x85,
+	0x6c, 0x97, 0xfd, 0x56, 0x81, 0x85, 0x74, 0xb3, 0x47, 0xb2, 0xc3, 0x67, 0x40, 0xcf, 0xa8, 0xde,
+	0x1e, 0x13, 0x8d, 0x1c, 0x6f, 0x70, 0x8e, 0x65, 0xb2, 0x26, 0x71, 0xa4, 0x1c, 0x5c, 0xef, 0x6d,
+	0x6f, 0x98, 0x90, 0xc5, 0x0b, 0xc9, 0x80, 0x84, 0x9c, 0x78, 0x84, 0x51, 0xb7, 0x87, 0x62, 0x86,
+	0x24, 0x64, 0xf1, 0xee, 0x42, 0x7e, 0xa6, 0xc0, 0x6c, 0xa2, 0xe5, 0x23, 0x7b, 0xc3, 0x8e, 0x74,
+	0xa2, 0x67, 0x55, 0xf7, 0xc7, 0x81, 0x22, 0x87, 0x2d, 0xce, 0x61, 0x95, 0xac, 0xf4, 0xe7, 0x80,
+	0x3a, 0x13, 0x96, 0x7f, 0xa2, 0x40, 0x51, 0x6e, 0xd8, 0x06, 0x24, 0xc6, 0x8c, 0x1e, 0x52, 0xdd,
+	0x1b, 0x03, 0x39, 0x06, 0x11, 0xde, 0xe4, 0x31, 0xf2, 0xa1, 0x02, 0x4b, 0x19, 0xcd, 0x09, 0xd1,
+	0x87, 0x59, 0xc9, 0xe8, 0x01, 0xd5, 0x3b, 0xe3, 0x0b, 0x0c, 0x61, 0xd7, 0x0d, 0x81, 0x67, 0x51,
+	0x42, 0x22, 0xbf, 0x57, 0xa0, 0x28, 0x97, 0xae, 0x03, 0xdc, 0x94, 0xd1, 0x5c, 0xa8, 0x7b, 0x63,
+	0x20, 0x91, 0xc8, 0x01, 0x27, 0x72, 0x8b, 0xec, 0x4b, 0x44, 0x12, 0xc5, 0xb1, 0x7e, 0x9e, 0xea,
+	0x53, 0x2e, 0xc8, 0x4f, 0x15, 0x98, 0x95, 0x95, 0x31, 0x32, 0xda, 0x20, 0x1b, 0x1e, 0x4c, 0x99,
+	0x15, 0xbe, 0xb6, 0xc9, 0xc9, 0xa9, 0xa4, 0x34, 0x88, 0x1c, 0xf9, 0xab, 0x02, 0xa4, 0xbf, 0xb4,
+	0x25, 0x95, 0x11, 0xd7, 0x7a, 0xaa, 0x9e, 0x54, 0xf5, 0xb1, 0xf1, 0xc8, 0xec, 0xcb, 0x9c, 0xd9,
+	0x5d, 0x52, 0x1d, 0xb7, 0x1c, 0xd0, 0xe3, 0xe2, 0xee, 0x63, 0x05, 0x96, 0xb3, 0x8a, 0x48, 0x72,
+	0x67, 0xe4, 0xad, 0x9f, 0xa6, 0x5d, 0x7d, 0x01, 0x09, 0x24, 0xfe, 0x35, 0x4e, 0xfc, 0x1e, 0xf9,
+	0xe2, 0x8b, 0xd4, 0x0b, 0x31, 0xf9, 0xc3, 0xda, 0x27, 0xcf, 0xca, 0xca, 0xa7, 0xcf, 0xca, 0xca,
+	0xbf, 0x9e, 0x95, 0x95, 0x5f, 0x3f, 0x2f, 0x5f, 0xf9, 0xf4, 0x79, 0xf9, 0xca, 0x3f, 0x9f, 0x97,
+	0xaf, 0x7c, 0x47, 0x6f, 0x3a, 0xc1, 0x49, 0xa7, 0x51, 0x31, 0xbd, 0x53, 0x3d, 0x64, 0xc5, 0xff,
+	0x23, 0x33, 0xbd, 0x96, 0xce, 0xfb, 0x79, 0xbd, 0x7b, 0x57, 0x7f, 0x1a, 0x99, 0x0c, 0xce, 0xda,
+	0x36, 0x6b, 0x4c, 0x71, 0xc4, 0xdd, 0xff, 0x0d, 0x00, 0x3b, 0xf5, 0xc3, 0x69, 0x0b, 0x1c, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Counts queries the number of existing data sources, oracle scripts, and
+	// requests.
+	Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error)
+	// Data queries content of the data source or oracle script for given SHA256
+	// file hash.
+	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
+	// DataSource queries data source summary info for given data source id.
+	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
+	// OracleScript queries oracle script summary info for given oracle script id.
+	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
+	// Request queries request info for given request id.
+	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
+	// PendingRequests queries list of pending request IDs assigned to given
+	// validator.
+	PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error)
+	// Validator queries properties of given validator address.
+	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// IsReporter queries grant of account on this validator.
+	IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error)
+	// Reporters queries all reporters associated with given validator address.
+	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
+	// ActiveValidators queries all active oracle validators.
+	ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error)
+	// Params queries parameters used for running BandChain network.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RequestSearch queries the latest request that match search criteria.
+	RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error)
+	// RequestPrice queries the latest price on standard price reference oracle
+	// script.
+	RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error)
+	// RequestVerification verifies a request to make sure that
+	// all information that will be used to report the data is valid
+	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+	// Subscription queries a subscription by its ID.
+	Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	// Subscriptions queries all subscriptions, optionally filtered by owner.
+	Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error) {
+	out := new(QueryCountsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Counts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsReporter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/IsReporter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsReporter(ctx, req.(*QueryIsReporterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReportersRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
+	out := new(QueryDataResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Data", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Reporters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Reporters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ActiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveValidatorsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error) {
+	out := new(QueryDataSourceResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSource", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/ActiveValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveValidators(ctx, req.(*QueryActiveValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
+	out := new(QueryOracleScriptResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScript", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RequestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestSearchRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
+	out := new(QueryRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Request", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestSearch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestSearch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestSearch(ctx, req.(*QueryRequestSearchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RequestPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestPriceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error) {
+	out := new(QueryPendingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/PendingRequests", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestPrice(ctx, req.(*QueryRequestPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestVerificationRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
+	out := new(QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Validator", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestVerification",
+	return out, nil
+}
+
+func (c *queryClient) IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error) {
+	out := new(QueryIsReporterResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/IsReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestVerification(ctx, req.(*QueryRequestVerificationRequest))
+	return out, nil
+}
+
+func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
+	out := new(QueryReportersResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Reporters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Counts",
-			Handler:    _Query_Counts_Handler,
-		},
-		{
-			MethodName: "Data",
-			Handler:    _Query_Data_Handler,
-		},
-		{
-			MethodName: "DataSource",
-			Handler:    _Query_DataSource_Handler,
-		},
-		{
-			MethodName: "OracleScript",
-			Handler:    _Query_OracleScript_Handler,
-		},
-		{
-			MethodName: "Request",
-			Handler:    _Query_Request_Handler,
-		},
-		{
-			MethodName: "PendingRequests",
-			Handler:    _Query_PendingRequests_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "IsReporter",
-			Handler:    _Query_IsReporter_Handler,
-		},
-		{
-			MethodName: "Reporters",
-			Handler:    _Query_Reporters_Handler,
-		},
-		{
-			MethodName: "ActiveValidators",
-			Handler:    _Query_ActiveValidators_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RequestSearch",
-			Handler:    _Query_RequestSearch_Handler,
-		},
-		{
-			MethodName: "RequestPrice",
-			Handler:    _Query_RequestPrice_Handler,
-		},
-		{
-			MethodName: "RequestVerification",
-			Handler:    _Query_RequestVerification_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/oracle/v1/query.proto",
-}
-
-func (m *QueryCountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error) {
+	out := new(QueryActiveValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ActiveValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OracleScriptCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DataSourceCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceCount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error) {
+	out := new(QueryRequestSearchResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestSearch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataHash) > 0 {
-		i -= len(m.DataHash)
-		copy(dAtA[i:], m.DataHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error) {
+	out := new(QueryRequestPriceResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error) {
+	out := new(QueryRequestVerificationResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestVerification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryDataSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Subscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Subscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Subscriptions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryDataSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataSource != nil {
-		{
-			size, err := m.DataSource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error) {
+	out := new(QueryDataSourceVersionsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSourceVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error) {
+	out := new(QueryOracleScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScriptVersions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleScriptId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Counts queries the number of existing data sources, oracle scripts, and
+	// requests.
+	Counts(context.Context, *QueryCountsRequest) (*QueryCountsResponse, error)
+	// Data queries content of the data source or oracle script for given SHA256
+	// file hash.
+	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
+	// DataSource queries data source summary info for given data source id.
+	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
+	// OracleScript queries oracle script summary info for given oracle script id.
+	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
+	// Request queries request info for given request id.
+	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
+	// PendingRequests queries list of pending request IDs assigned to given
+	// validator.
+	PendingRequests(context.Context, *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error)
+	// Validator queries properties of given validator address.
+	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// IsReporter queries grant of account on this validator.
+	IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error)
+	// Reporters queries all reporters associated with given validator address.
+	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
+	// ActiveValidators queries all active oracle validators.
+	ActiveValidators(context.Context, *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error)
+	// Params queries parameters used for running BandChain network.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RequestSearch queries the latest request that match search criteria.
+	RequestSearch(context.Context, *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error)
+	// RequestPrice queries the latest price on standard price reference oracle
+	// script.
+	RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error)
+	// RequestVerification verifies a request to make sure that
+	// all information that will be used to report the data is valid
+	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+	// Subscription queries a subscription by its ID.
+	Subscription(context.Context, *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error)
+	// Subscriptions queries all subscriptions, optionally filtered by owner.
+	Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error)
 }
 
-func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Counts(ctx context.Context, req *QueryCountsRequest) (*QueryCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Counts not implemented")
 }
-
-func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleScript != nil {
-		{
-			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
 }
-
-func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) DataSource(ctx context.Context, req *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
 }
-
-func (m *QueryRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) OracleScript(ctx context.Context, req *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
 }
-
-func (m *QueryRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryRequestRequest) (*QueryRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
 }
-
-func (m *QueryRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) PendingRequests(ctx context.Context, req *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRequests not implemented")
+}
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedQueryServer) IsReporter(ctx context.Context, req *QueryIsReporterRequest) (*QueryIsReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReporter not implemented")
+}
+func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+}
+func (*UnimplementedQueryServer) ActiveValidators(ctx context.Context, req *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveValidators not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RequestSearch(ctx context.Context, req *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSearch not implemented")
+}
+func (*UnimplementedQueryServer) RequestPrice(ctx context.Context, req *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPrice not implemented")
+}
+func (*UnimplementedQueryServer) RequestVerification(ctx context.Context, req *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
+}
+func (*UnimplementedQueryServer) Subscription(ctx context.Context, req *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscription not implemented")
+}
+func (*UnimplementedQueryServer) Subscriptions(ctx context.Context, req *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (*UnimplementedQueryServer) DataSourceVersions(ctx context.Context, req *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSourceVersions not implemented")
+}
+func (*UnimplementedQueryServer) OracleScriptVersions(ctx context.Context, req *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScriptVersions not implemented")
 }
 
-func (m *QueryRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Signing != nil {
-		{
-			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func _Query_Counts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).Counts(ctx, in)
 	}
-	if len(m.Reports) > 0 {
-		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Counts",
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Counts(ctx, req.(*QueryCountsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Data(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Data",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/DataSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSource(ctx, req.(*QueryDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/OracleScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScript(ctx, req.(*QueryOracleScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestIDs) > 0 {
-		dAtA7 := make([]byte, len(m.RequestIDs)*10)
-		var j6 int
-		for _, num := range m.RequestIDs {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Request(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Request",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Request(ctx, req.(*QueryRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/PendingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRequests(ctx, req.(*QueryPendingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IsReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/IsReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsReporter(ctx, req.(*QueryIsReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Reporters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Reporters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveValidatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/ActiveValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveValidators(ctx, req.(*QueryActiveValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_RequestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RequestSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestSearch(ctx, req.(*QueryRequestSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RequestPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RequestPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestPrice(ctx, req.(*QueryRequestPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestVerification(ctx, req.(*QueryRequestVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Subscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscription(ctx, req.(*QuerySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReporterRequest) Marshal() (dAtA []byte, err error) {
+func _Query_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Subscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscriptions(ctx, req.(*QuerySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataSourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/DataSourceVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSourceVersions(ctx, req.(*QueryDataSourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/OracleScriptVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScriptVersions(ctx, req.(*QueryOracleScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Counts",
+			Handler:    _Query_Counts_Handler,
+		},
+		{
+			MethodName: "Data",
+			Handler:    _Query_Data_Handler,
+		},
+		{
+			MethodName: "DataSource",
+			Handler:    _Query_DataSource_Handler,
+		},
+		{
+			MethodName: "OracleScript",
+			Handler:    _Query_OracleScript_Handler,
+		},
+		{
+			MethodName: "Request",
+			Handler:    _Query_Request_Handler,
+		},
+		{
+			MethodName: "PendingRequests",
+			Handler:    _Query_PendingRequests_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "IsReporter",
+			Handler:    _Query_IsReporter_Handler,
+		},
+		{
+			MethodName: "Reporters",
+			Handler:    _Query_Reporters_Handler,
+		},
+		{
+			MethodName: "ActiveValidators",
+			Handler:    _Query_ActiveValidators_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RequestSearch",
+			Handler:    _Query_RequestSearch_Handler,
+		},
+		{
+			MethodName: "RequestPrice",
+			Handler:    _Query_RequestPrice_Handler,
+		},
+		{
+			MethodName: "RequestVerification",
+			Handler:    _Query_RequestVerification_Handler,
+		},
+		{
+			MethodName: "Subscription",
+			Handler:    _Query_Subscription_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _Query_Subscriptions_Handler,
+		},
+		{
+			MethodName: "DataSourceVersions",
+			Handler:    _Query_DataSourceVersions_Handler,
+		},
+		{
+			MethodName: "OracleScriptVersions",
+			Handler:    _Query_OracleScriptVersions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/oracle/v1/query.proto",
+}
+
+func (m *QueryCountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2789,34 +2906,20 @@ func (m *QueryIsReporterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReporterAddress) > 0 {
-		i -= len(m.ReporterAddress)
-		copy(dAtA[i:], m.ReporterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReporterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReporterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2826,30 +2929,35 @@ func (m *QueryIsReporterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsReporter {
+	if m.RequestCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestCount))
 		i--
-		if m.IsReporter {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x18
+	}
+	if m.OracleScriptCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataSourceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceCount))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2859,27 +2967,27 @@ func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2889,29 +2997,27 @@ func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reporter) > 0 {
-		for iNdEx := len(m.Reporter) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reporter[iNdEx])
-			copy(dAtA[i:], m.Reporter[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2921,20 +3027,25 @@ func (m *QueryActiveValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2944,34 +3055,32 @@ func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.DataSource != nil {
+		{
+			size, err := m.DataSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2981,33 +3090,16 @@ func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.OracleScriptId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
 		i--
@@ -3016,7 +3108,7 @@ func (m *QueryRequestSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3026,19 +3118,19 @@ func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.OracleScript != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3051,7 +3143,7 @@ func (m *QueryRequestSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3061,39 +3153,35 @@ func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRequestRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
+	if m.Decode {
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Symbols) > 0 {
-		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Symbols[iNdEx])
-			copy(dAtA[i:], m.Symbols[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbols[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRequestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3103,20 +3191,70 @@ func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRequestResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PriceResults) > 0 {
-		for iNdEx := len(m.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DecodedResult) > 0 {
+		i -= len(m.DecodedResult)
+		copy(dAtA[i:], m.DecodedResult)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecodedResult)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DecodedCalldata) > 0 {
+		i -= len(m.DecodedCalldata)
+		copy(dAtA[i:], m.DecodedCalldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecodedCalldata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FeeReceipt != nil {
+		{
+			size, err := m.FeeReceipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Signing != nil {
+		{
+			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PriceResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3124,13 +3262,25 @@ func (m *QueryRequestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRequestsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3140,68 +3290,27 @@ func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDelay != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelay))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRequestsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3211,511 +3320,2202 @@ func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsDelay {
-		i--
-		if m.IsDelay {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.RequestIDs) > 0 {
+		dAtA8 := make([]byte, len(m.RequestIDs)*10)
+		var j7 int
+		for _, num := range m.RequestIDs {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataSourceCount != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceCount))
-	}
-	if m.OracleScriptCount != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptCount))
-	}
-	if m.RequestCount != 0 {
-		n += 1 + sovQuery(uint64(m.RequestCount))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DataHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDataSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDataSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataSource != nil {
-		l = m.DataSource.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOracleScriptRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OracleScriptId != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptId))
-	}
-	return n
+func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOracleScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScript != nil {
-		l = m.OracleScript.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Reports) > 0 {
-		for _, e := range m.Reports {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Signing != nil {
-		l = m.Signing.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIsReporterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RequestIDs) > 0 {
-		l = 0
-		for _, e := range m.RequestIDs {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	return n
+func (m *QueryIsReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.ReporterAddress) > 0 {
+		i -= len(m.ReporterAddress)
+		copy(dAtA[i:], m.ReporterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReporterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIsReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.IsReporter {
+		i--
+		if m.IsReporter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReporterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ReporterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsReporterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsReporter {
-		n += 2
-	}
-	return n
+func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReportersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Reporter) > 0 {
-		for _, s := range m.Reporter {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Reporter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reporter[iNdEx])
+			copy(dAtA[i:], m.Reporter[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptId != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptId))
-	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MinCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.AskCount != 0 {
-		n += 1 + sovQuery(uint64(m.AskCount))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovQuery(uint64(m.MinCount))
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRequestPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRequestSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.AskCount != 0 {
-		n += 1 + sovQuery(uint64(m.AskCount))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.MinCount != 0 {
-		n += 1 + sovQuery(uint64(m.MinCount))
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.AskCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Symbols[iNdEx])
+			copy(dAtA[i:], m.Symbols[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbols[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.PriceResults) > 0 {
-		for _, e := range m.PriceResults {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelay))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.ExternalId != 0 {
-		n += 1 + sovQuery(uint64(m.ExternalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDelay {
+		i--
+		if m.IsDelay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExternalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MaxDelay != 0 {
-		n += 1 + sovQuery(uint64(m.MaxDelay))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataSourceVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataSourceVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSourceVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataSourceVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataSourceVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSourceVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceCount != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceCount))
+	}
+	if m.OracleScriptCount != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptCount))
+	}
+	if m.RequestCount != 0 {
+		n += 1 + sovQuery(uint64(m.RequestCount))
+	}
+	return n
+}
+
+func (m *QueryDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	return n
+}
+
+func (m *QueryDataSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSource != nil {
+		l = m.DataSource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScript != nil {
+		l = m.OracleScript.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.Decode {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Signing != nil {
+		l = m.Signing.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeReceipt != nil {
+		l = m.FeeReceipt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DecodedCalldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DecodedResult)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIDs) > 0 {
+		l = 0
+		for _, e := range m.RequestIDs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReporterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReporterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsReporter {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReportersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		for _, s := range m.Reporter {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequestSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovQuery(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinCount))
+	}
+	return n
+}
+
+func (m *QueryRequestSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovQuery(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinCount))
+	}
+	return n
+}
+
+func (m *QueryRequestPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceResults) > 0 {
+		for _, e := range m.PriceResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequestVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.ExternalId != 0 {
+		n += 1 + sovQuery(uint64(m.ExternalId))
+	}
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxDelay != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDelay))
+	}
+	return n
+}
+
+func (m *QueryRequestVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.ExternalId != 0 {
+		n += 1 + sovQuery(uint64(m.ExternalId))
+	}
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	if m.IsDelay {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovQuery(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subscription.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSourceVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSourceVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceCount", wireType)
+			}
+			m.DataSourceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptCount", wireType)
+			}
+			m.OracleScriptCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+			}
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			}
+			m.DataSourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSource == nil {
+				m.DataSource = &DataSource{}
+			}
+			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OracleScript == nil {
+				m.OracleScript = &OracleScript{}
+			}
+			if err := m.OracleScript.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryRequestVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
-	}
-	if m.ExternalId != 0 {
-		n += 1 + sovQuery(uint64(m.ExternalId))
-	}
-	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
-	}
-	if m.IsDelay {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3738,12 +5538,51 @@ func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3765,7 +5604,7 @@ func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3784,21 +5623,163 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceCount", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &Request{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, Report{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signing == nil {
+				m.Signing = &SigningResult{}
+			}
+			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DataSourceCount = 0
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReceipt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3808,16 +5789,33 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.OracleScriptCount = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeReceipt == nil {
+				m.FeeReceipt = &FeeReceipt{}
+			}
+			if err := m.FeeReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalldata", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3827,16 +5825,29 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.RequestCount = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalldata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedResult", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3846,11 +5857,24 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3872,7 +5896,7 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3895,15 +5919,15 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3931,7 +5955,7 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataHash = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3954,7 +5978,7 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3977,46 +6001,138 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.RequestIDs = append(m.RequestIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIDs) == 0 {
+					m.RequestIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIDs = append(m.RequestIDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4038,7 +6154,7 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4061,17 +6177,17 @@ func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.DataSourceId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4081,11 +6197,25 @@ func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4107,7 +6237,7 @@ func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,17 +6260,17 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4150,27 +6280,23 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataSource == nil {
-				m.DataSource = &DataSource{}
-			}
-			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4193,7 +6319,7 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4216,17 +6342,17 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.OracleScriptId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4236,11 +6362,28 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ValidatorStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4262,7 +6405,7 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4285,17 +6428,17 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4305,27 +6448,55 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleScript == nil {
-				m.OracleScript = &OracleScript{}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
 			}
-			if err := m.OracleScript.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ReporterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4348,7 +6519,7 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,17 +6542,17 @@ func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
 			}
-			m.RequestId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4391,11 +6562,12 @@ func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsReporter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4417,7 +6589,7 @@ func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4440,17 +6612,17 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4460,103 +6632,79 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &Request{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reports = append(m.Reports, Report{})
-			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4566,27 +6714,23 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signing == nil {
-				m.Signing = &SigningResult{}
-			}
-			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reporter = append(m.Reporter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4609,7 +6753,7 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4632,44 +6776,12 @@ func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4691,7 +6803,7 @@ func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4714,88 +6826,46 @@ func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RequestIDs = append(m.RequestIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIDs) == 0 {
-					m.RequestIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIDs = append(m.RequestIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ActiveValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4817,7 +6887,7 @@ func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4836,16 +6906,105 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4867,7 +7026,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4890,15 +7049,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4925,7 +7084,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &QueryRequestResponse{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4950,7 +7112,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4973,15 +7135,15 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5009,8 +7171,46 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5032,7 +7232,7 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5055,15 +7255,15 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5090,10 +7290,8 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceResults = append(m.PriceResults, &PriceResult{})
+			if err := m.PriceResults[len(m.PriceResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5118,7 +7316,7 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5141,15 +7339,15 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5177,11 +7375,11 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5209,63 +7407,32 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReporterAddress = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			var v int
+			m.ExternalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5275,67 +7442,67 @@ func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExternalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReporter = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.DataSourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5345,24 +7512,45 @@ func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			}
+			m.MaxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5384,7 +7572,7 @@ func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5407,15 +7595,15 @@ func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5443,113 +7631,13 @@ func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = append(m.Reporter, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5559,81 +7647,29 @@ func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &ActiveValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			m.OracleScriptId = 0
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5643,16 +7679,16 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptId |= uint64(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			var stringLen uint64
+			m.ExternalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5662,29 +7698,16 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExternalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Calldata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 			}
-			m.AskCount = 0
+			m.DataSourceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5694,16 +7717,16 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.DataSourceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
 			}
-			m.MinCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5713,11 +7736,12 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsDelay = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5739,7 +7763,7 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5762,17 +7786,17 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-			var msglen int
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5782,28 +7806,11 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &QueryRequestResponse{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5825,7 +7832,7 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5848,17 +7855,17 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5868,62 +7875,25 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-			}
-			m.AskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-			}
-			m.MinCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5945,7 +7915,7 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5968,15 +7938,47 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6003,8 +8005,10 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceResults = append(m.PriceResults, &PriceResult{})
-			if err := m.PriceResults[len(m.PriceResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6029,7 +8033,7 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6052,17 +8056,17 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6072,29 +8076,31 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6104,86 +8110,83 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DataSourceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 			}
-			var stringLen uint64
+			m.DataSourceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6193,29 +8196,16 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataSourceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reporter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6225,45 +8215,28 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.MaxDelay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxDelay |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6285,7 +8258,7 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataSourceVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6308,17 +8281,17 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataSourceVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataSourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6328,29 +8301,31 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, DataSourceVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6360,29 +8335,83 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 			}
-			m.RequestId = 0
+			m.OracleScriptId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6392,16 +8421,16 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.OracleScriptId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ExternalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6411,16 +8440,83 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.DataSourceId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6430,16 +8526,31 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, OracleScriptVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6449,12 +8560,28 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDelay = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22,6 +22,8 @@ type RequestSpec interface {
 	GetExecuteGas() uint64
 	GetFeeLimit() sdk.Coins
 	GetTSSEncoder() Encoder
+	GetOracleScriptVersion() uint64
+	GetCallback() *RequestCallback
 }
 
 func NewRawRequest(
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ RequestSpec = &Subscription{}
+
+// NewSubscription creates a new Subscription instance.
+func NewSubscription(
+	id SubscriptionID,
+	owner string,
+	depositAccount string,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	interval uint64,
+	intervalUnit IntervalUnit,
+	maxRuns, runs uint64,
+	nextRun int64,
+	status SubscriptionStatus,
+	oracleScriptVersion uint64,
+) Subscription {
+	return Subscription{
+		ID:                  id,
+		Owner:               owner,
+		DepositAccount:      depositAccount,
+		OracleScriptID:      oracleScriptID,
+		Calldata:            calldata,
+		AskCount:            askCount,
+		MinCount:            minCount,
+		ClientID:            clientID,
+		FeeLimit:            feeLimit,
+		PrepareGas:          prepareGas,
+		ExecuteGas:          executeGas,
+		TSSEncoder:          tssEncoder,
+		Interval:            interval,
+		IntervalUnit:        intervalUnit,
+		MaxRuns:             maxRuns,
+		Runs:                runs,
+		NextRun:             nextRun,
+		Status:              status,
+		OracleScriptVersion: oracleScriptVersion,
+	}
+}
+
+// IsCompleted returns true if the subscription has made all of its requests.
+func (s Subscription) IsCompleted() bool {
+	return s.MaxRuns != 0 && s.Runs >= s.MaxRuns
+}
+
+// CurrentRun returns the current block height or unix timestamp, depending on the interval unit of
+// the subscription.
+func (s Subscription) CurrentRun(ctx sdk.Context) int64 {
+	if s.IntervalUnit == INTERVAL_UNIT_SECOND {
+		return ctx.BlockTime().Unix()
+	}
+	return ctx.BlockHeight()
+}
+
+// GetCallback returns nil since requests of subscriptions do not have result callbacks.
+func (s Subscription) GetCallback() *RequestCallback {
+	return nil
+}
+
+// validateSchedule checks whether the given schedule of a subscription is valid.
+func validateSchedule(interval uint64, intervalUnit IntervalUnit) error {
+	if intervalUnit != INTERVAL_UNIT_BLOCK && intervalUnit != INTERVAL_UNIT_SECOND {
+		return ErrInvalidInterval.Wrapf("invalid interval unit: %d", intervalUnit)
+	}
+	if interval == 0 {
+		return ErrInvalidInterval.Wrap("interval must be positive")
+	}
+	if interval > MaxSubscriptionInterval {
+		return WrapMaxError(ErrInvalidInterval, int(interval), MaxSubscriptionInterval)
+	}
+	return nil
+}
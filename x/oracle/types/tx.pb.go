@@ -58,6 +58,12 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,11,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// Callback is the result callback of a module on BandChain to run when the
+	// request is resolved or expired, if any.
+	Callback *RequestCallback `protobuf:"bytes,12,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -163,6 +169,20 @@ func (m *MsgRequestData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *MsgRequestData) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func (m *MsgRequestData) GetCallback() *RequestCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 }
@@ -466,6 +486,9 @@ type MsgEditDataSource struct {
 	// Sender is the signer of this message. Must be the current data source's
 	// owner.
 	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Changelog is an optional description of the changes, which is recorded
+	// in the new version.
+	Changelog string `protobuf:"bytes,9,opt,name=changelog,proto3" json:"changelog,omitempty"`
 }
 
 func (m *MsgEditDataSource) Reset()         { *m = MsgEditDataSource{} }
@@ -557,6 +580,13 @@ func (m *MsgEditDataSource) GetSender() string {
 	return ""
 }
 
+func (m *MsgEditDataSource) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
 // MsgEditDataSourceResponse is response data for MsgEditDataSource message
 type MsgEditDataSourceResponse struct {
 }
@@ -755,6 +785,9 @@ type MsgEditOracleScript struct {
 	// Sender is an account address who sign this message. Must be the current
 	// oracle script's owner.
 	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Changelog is an optional description of the changes, which is recorded
+	// in the new version.
+	Changelog string `protobuf:"bytes,9,opt,name=changelog,proto3" json:"changelog,omitempty"`
 }
 
 func (m *MsgEditOracleScript) Reset()         { *m = MsgEditOracleScript{} }
@@ -846,6 +879,13 @@ func (m *MsgEditOracleScript) GetSender() string {
 	return ""
 }
 
+func (m *MsgEditOracleScript) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
 // MsgEditOracleScriptResponse is response data for MsgEditOracleScript message
 type MsgEditOracleScriptResponse struct {
 }
@@ -969,30 +1009,57 @@ func (m *MsgActivateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgActivateResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/oracle parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-}
-
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+// MsgCreateSubscription is a message for creating a new subscription.
+type MsgCreateSubscription struct {
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientID string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,11,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,12,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// InitialDeposit is the amount of tokens sent from the sender to the
+	// deposit account of the subscription.
+	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
+	// Sender is the signer of this message and the owner of the subscription.
+	Sender string `protobuf:"bytes,14,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (m *MsgCreateSubscription) Reset()         { *m = MsgCreateSubscription{} }
+func (m *MsgCreateSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscription) ProtoMessage()    {}
+func (*MsgCreateSubscription) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{14}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSubscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,51 +1069,142 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCreateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscription.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCreateSubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCreateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSubscription proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCreateSubscription) GetOracleScriptID() OracleScriptID {
 	if m != nil {
-		return m.Authority
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *MsgCreateSubscription) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCreateSubscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Params
+		return m.FeeLimit
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgCreateSubscription) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSubscription) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *MsgCreateSubscription) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetIntervalUnit() IntervalUnit {
+	if m != nil {
+		return m.IntervalUnit
+	}
+	return INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (m *MsgCreateSubscription) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetInitialDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgCreateSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreateSubscription) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+// MsgCreateSubscriptionResponse is response data for MsgCreateSubscription
+// message
+type MsgCreateSubscriptionResponse struct {
+	// SubscriptionID is the ID of the created subscription.
+	SubscriptionID SubscriptionID `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,casttype=SubscriptionID" json:"subscription_id,omitempty"`
+}
+
+func (m *MsgCreateSubscriptionResponse) Reset()         { *m = MsgCreateSubscriptionResponse{} }
+func (m *MsgCreateSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCreateSubscriptionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{15}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSubscriptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1056,279 +1214,731 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreateSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreateSubscriptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreateSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSubscriptionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
-	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
-	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
-	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
-	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
-	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
-	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
-	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
-	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
-	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
-	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
-	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
-	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
-	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+func (m *MsgCreateSubscriptionResponse) GetSubscriptionID() SubscriptionID {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
-
-var fileDescriptor_3ffde65d794f19e2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xad, 0x8f, 0x88, 0x23, 0x59, 0x49, 0x98, 0x0f, 0xc9, 0x34, 0x2c, 0x2a, 0xca, 0xc7,
-	0xab, 0x24, 0xb0, 0x98, 0x38, 0xc1, 0x0b, 0xc4, 0x97, 0x36, 0x72, 0xd2, 0xd6, 0x40, 0xd4, 0x16,
-	0x74, 0x53, 0x14, 0x05, 0x5a, 0x61, 0x45, 0x6e, 0x68, 0x36, 0x12, 0xa9, 0x72, 0x57, 0x4a, 0x72,
-	0x2b, 0x7a, 0xec, 0xa5, 0xed, 0x3f, 0xe8, 0xad, 0x40, 0x81, 0x02, 0x39, 0xe4, 0x2f, 0x14, 0xc8,
-	0x31, 0x48, 0x2f, 0x3d, 0xa9, 0x85, 0x72, 0x48, 0xef, 0x3d, 0x14, 0xe8, 0xa9, 0xd8, 0xe5, 0x8a,
-	0x22, 0x65, 0xca, 0x76, 0x8c, 0x34, 0x17, 0x9b, 0xb3, 0xcf, 0x33, 0xb3, 0xc3, 0x79, 0x66, 0x96,
-	0x2b, 0x28, 0x75, 0x90, 0x6b, 0xe9, 0x9e, 0x8f, 0xcc, 0x2e, 0xd6, 0x87, 0x57, 0x75, 0xfa, 0xb0,
-	0xd1, 0xf7, 0x3d, 0xea, 0x29, 0x45, 0x06, 0x34, 0x02, 0xa0, 0x31, 0xbc, 0xaa, 0x9e, 0xb4, 0x3d,
-	0xdb, 0xe3, 0x90, 0xce, 0x9e, 0x02, 0x96, 0xba, 0x32, 0xe3, 0x2e, 0xf8, 0x01, 0x58, 0x31, 0x3d,
-	0xd2, 0xf3, 0x88, 0xde, 0x41, 0x84, 0x81, 0x1d, 0x4c, 0xd1, 0x55, 0xdd, 0xf4, 0x1c, 0x57, 0xe0,
-	0xcb, 0x01, 0xde, 0x0e, 0xa2, 0x06, 0x86, 0x80, 0x4a, 0xc2, 0xb5, 0x47, 0x6c, 0x16, 0xb6, 0x47,
-	0x6c, 0x01, 0x1c, 0x47, 0x3d, 0xc7, 0xf5, 0x74, 0xfe, 0x37, 0x58, 0xaa, 0xfd, 0x9c, 0x86, 0x62,
-	0x8b, 0xd8, 0x06, 0xfe, 0x72, 0x80, 0x09, 0xbd, 0x85, 0x28, 0x52, 0xde, 0x87, 0x63, 0x41, 0x26,
-	0x6d, 0x62, 0xfa, 0x4e, 0x9f, 0xb6, 0x1d, 0xab, 0x2c, 0x55, 0xa5, 0x7a, 0xba, 0x79, 0x6e, 0x3c,
-	0xd2, 0x8a, 0x1f, 0x70, 0x6c, 0x9b, 0x43, 0x5b, 0xb7, 0xfe, 0xd9, 0xb5, 0x62, 0x14, 0xbd, 0xa8,
-	0x6d, 0x29, 0x2a, 0xe4, 0x4c, 0xd4, 0xed, 0x5a, 0x88, 0xa2, 0xf2, 0x62, 0x55, 0xaa, 0x17, 0x8c,
-	0xd0, 0x56, 0x56, 0x40, 0x46, 0xe4, 0x7e, 0xdb, 0xf4, 0x06, 0x2e, 0x2d, 0xa7, 0xd8, 0x26, 0x46,
-	0x0e, 0x91, 0xfb, 0x9b, 0xcc, 0x66, 0x60, 0xcf, 0x71, 0x05, 0x98, 0x0e, 0xc0, 0x9e, 0xe3, 0x06,
-	0xe0, 0x45, 0x90, 0xcd, 0xae, 0x83, 0x5d, 0x9e, 0x5e, 0xa6, 0x2a, 0xd5, 0xe5, 0x66, 0x61, 0x3c,
-	0xd2, 0x72, 0x9b, 0x7c, 0x71, 0xeb, 0x96, 0x91, 0x0b, 0xe0, 0x2d, 0x4b, 0xd9, 0x01, 0xf9, 0x1e,
-	0xc6, 0xed, 0xae, 0xd3, 0x73, 0x68, 0x39, 0x5b, 0x4d, 0xd5, 0xf3, 0xeb, 0xcb, 0x0d, 0x51, 0x31,
-	0x56, 0xde, 0x86, 0x28, 0x6f, 0x63, 0xd3, 0x73, 0xdc, 0xe6, 0x95, 0xa7, 0x23, 0x6d, 0xe1, 0xa7,
-	0xdf, 0xb5, 0xba, 0xed, 0xd0, 0x9d, 0x41, 0xa7, 0x61, 0x7a, 0x3d, 0x51, 0x5e, 0xf1, 0x6f, 0x8d,
-	0x58, 0xf7, 0x75, 0xfa, 0xa8, 0x8f, 0x09, 0x77, 0x20, 0x46, 0xee, 0x1e, 0xc6, 0x77, 0x58, 0x70,
-	0x45, 0x83, 0x7c, 0xdf, 0xc7, 0x7d, 0xe4, 0xe3, 0xb6, 0x8d, 0x48, 0xf9, 0x08, 0xcf, 0x19, 0xc4,
-	0xd2, 0xbb, 0x88, 0x30, 0x02, 0x7e, 0x88, 0xcd, 0x01, 0x0d, 0x08, 0xb9, 0x80, 0x20, 0x96, 0x18,
-	0xe1, 0x0a, 0x64, 0x09, 0x76, 0x2d, 0xec, 0x97, 0x65, 0xfe, 0x4e, 0xe5, 0xe7, 0x4f, 0xd6, 0x4e,
-	0x8a, 0x5c, 0x6f, 0x5a, 0x96, 0x8f, 0x09, 0xd9, 0xa6, 0xbe, 0xe3, 0xda, 0x86, 0xe0, 0x29, 0xef,
-	0x41, 0x9e, 0x12, 0xd2, 0xc6, 0xae, 0xe9, 0x31, 0x37, 0xa8, 0x4a, 0xf5, 0xe2, 0x7a, 0xa9, 0x11,
-	0xef, 0xc0, 0xc6, 0xed, 0x00, 0x6e, 0x16, 0xc7, 0x23, 0x0d, 0x3e, 0xda, 0xde, 0x16, 0xb6, 0x01,
-	0x94, 0x10, 0xf1, 0xbc, 0x51, 0xfb, 0xf3, 0x07, 0x4d, 0xfa, 0xfa, 0xe5, 0xe3, 0x4b, 0x22, 0xf4,
-	0x37, 0x2f, 0x1f, 0x5f, 0x12, 0x62, 0xea, 0xa2, 0x41, 0x6a, 0x65, 0x38, 0x1d, 0x6f, 0x17, 0x03,
-	0x93, 0xbe, 0xe7, 0x12, 0x5c, 0xfb, 0x4b, 0x82, 0x25, 0x0e, 0xf5, 0x3d, 0x3f, 0x68, 0xa4, 0x1b,
-	0x00, 0x7e, 0x40, 0x9c, 0xb6, 0x90, 0x3a, 0x1e, 0x69, 0xb2, 0x70, 0xe7, 0xdd, 0x33, 0x35, 0x0c,
-	0x59, 0xb0, 0xb7, 0x2c, 0xe5, 0x6d, 0xc8, 0xfb, 0xe8, 0x41, 0xdb, 0xe7, 0xc1, 0x48, 0x79, 0x51,
-	0x88, 0x36, 0xf3, 0x52, 0x06, 0x7a, 0x10, 0x6c, 0xd7, 0x4c, 0x33, 0xd1, 0x0c, 0xf0, 0x27, 0x0b,
-	0x44, 0x79, 0x0b, 0xe4, 0x21, 0xea, 0x3a, 0x16, 0xa2, 0x9e, 0xcf, 0x3b, 0x4b, 0x6e, 0x9e, 0x79,
-	0xfe, 0x64, 0x6d, 0x55, 0xd4, 0xf2, 0xe3, 0x09, 0x16, 0x2f, 0xea, 0xd4, 0x67, 0xe3, 0xdc, 0xa4,
-	0x1a, 0xd3, 0x35, 0x56, 0x90, 0xa5, 0xb0, 0x20, 0x6c, 0x9f, 0x5a, 0x09, 0x4e, 0xc5, 0x5e, 0x3a,
-	0x2c, 0xc7, 0xb7, 0x29, 0x38, 0xd1, 0x22, 0xf6, 0xa6, 0x8f, 0x11, 0xc5, 0x0c, 0xd9, 0xf6, 0x06,
-	0xbe, 0x89, 0x15, 0x05, 0xd2, 0x2e, 0xea, 0x61, 0x5e, 0x0e, 0xd9, 0xe0, 0xcf, 0x4a, 0x15, 0xf2,
-	0x16, 0x0e, 0x86, 0xcd, 0xf1, 0x5c, 0x3e, 0x24, 0xb2, 0x11, 0x5d, 0x52, 0x2a, 0x20, 0x9a, 0x04,
-	0x75, 0xba, 0x98, 0xbf, 0x4e, 0xc1, 0x88, 0xac, 0x28, 0x9f, 0x41, 0xea, 0x1e, 0xc6, 0xe5, 0xf4,
-	0xeb, 0x6f, 0x6e, 0x16, 0x57, 0xb9, 0x0e, 0x39, 0xea, 0x63, 0x44, 0x06, 0xfe, 0x23, 0x31, 0x6b,
-	0xf3, 0xfb, 0x32, 0x64, 0x2a, 0x0d, 0xc8, 0x78, 0x0f, 0x5c, 0xec, 0x97, 0xb3, 0xfb, 0xb8, 0x04,
-	0xb4, 0x48, 0xef, 0x1f, 0x39, 0x58, 0xef, 0x6f, 0x5c, 0x4e, 0xe8, 0xd8, 0x92, 0x10, 0x68, 0xb6,
-	0xf2, 0xb5, 0x55, 0x58, 0x49, 0x10, 0x24, 0x14, 0xec, 0xd7, 0x14, 0x1c, 0x6f, 0x11, 0xfb, 0xb6,
-	0xe5, 0xd0, 0x88, 0x5c, 0xef, 0x40, 0x91, 0x1d, 0x54, 0x6d, 0xc2, 0xcd, 0x69, 0x1f, 0x57, 0xc7,
-	0x23, 0xad, 0x30, 0xe5, 0xf1, 0x56, 0x8e, 0xd9, 0x46, 0xc1, 0x9a, 0x5a, 0x56, 0x28, 0xfb, 0xe2,
-	0x7c, 0xd9, 0x53, 0xfb, 0xc9, 0x9e, 0x9e, 0x27, 0x7b, 0xe6, 0x0d, 0xc8, 0x9e, 0x7d, 0x75, 0xd9,
-	0x8f, 0xbc, 0xaa, 0xec, 0xb9, 0x03, 0xca, 0x7e, 0x31, 0x41, 0xf6, 0x53, 0x42, 0xf6, 0xb8, 0x7e,
-	0xb5, 0x15, 0x58, 0xde, 0x25, 0x6a, 0x28, 0xf9, 0x2f, 0x8b, 0x7c, 0x7a, 0x83, 0x96, 0x88, 0x7e,
-	0xc5, 0x0e, 0x39, 0xa5, 0xa7, 0x21, 0x4b, 0xcc, 0x1d, 0xdc, 0x43, 0x42, 0x4b, 0x61, 0x29, 0x37,
-	0xe0, 0xa8, 0xe8, 0x1f, 0x76, 0xd0, 0xb6, 0x07, 0x7e, 0x97, 0x6b, 0x29, 0x37, 0x8f, 0x8f, 0x47,
-	0xda, 0x52, 0x90, 0xd4, 0xa6, 0x67, 0xe1, 0xbb, 0xc6, 0x1d, 0x63, 0x89, 0x4c, 0x4d, 0xbf, 0xcb,
-	0x12, 0x61, 0x3e, 0x7c, 0xea, 0x0a, 0x06, 0x7f, 0x7e, 0x03, 0x73, 0xb5, 0x96, 0x50, 0xe0, 0xe5,
-	0xd8, 0x5c, 0x45, 0xab, 0x55, 0xd3, 0x60, 0x35, 0xb1, 0x8c, 0x61, 0xa1, 0xbf, 0x0f, 0x0e, 0x43,
-	0x26, 0x43, 0xac, 0xcc, 0xaf, 0xfb, 0xaa, 0x71, 0xb8, 0x29, 0x9b, 0xca, 0x96, 0xde, 0x4f, 0xb6,
-	0xcc, 0x2b, 0xca, 0x96, 0x4d, 0x92, 0xed, 0x3f, 0x9b, 0x8b, 0xbd, 0x8e, 0xc3, 0xd9, 0xda, 0x8b,
-	0xe3, 0x70, 0x76, 0x39, 0x94, 0x6c, 0x08, 0xf9, 0x16, 0xb1, 0x6f, 0x9a, 0xd4, 0x19, 0x22, 0x8a,
-	0xe3, 0x9f, 0x53, 0xe9, 0x10, 0x9f, 0xd3, 0x0b, 0xc9, 0x9f, 0xd3, 0xa3, 0x22, 0xbd, 0xc9, 0x46,
-	0xb5, 0x53, 0xbc, 0x53, 0x26, 0x66, 0x98, 0xce, 0x8f, 0x12, 0x1c, 0x6d, 0x11, 0xfb, 0x6e, 0xdf,
-	0x42, 0x14, 0x7f, 0x88, 0x7c, 0xd4, 0x23, 0xca, 0xff, 0x41, 0x46, 0x03, 0xba, 0xe3, 0xf9, 0x0e,
-	0x7d, 0x24, 0x72, 0x9a, 0x5f, 0xa3, 0x29, 0x55, 0xb9, 0x0e, 0xd9, 0x3e, 0x8f, 0xc0, 0xfb, 0x24,
-	0xbf, 0x7e, 0x7a, 0xf6, 0x5e, 0x11, 0xc4, 0x17, 0x97, 0x0a, 0xc1, 0xdd, 0xb8, 0xc0, 0x93, 0x0f,
-	0xa3, 0xb0, 0xe4, 0x4f, 0x88, 0xe4, 0xa3, 0x59, 0xd5, 0x96, 0xa1, 0x34, 0x93, 0xe8, 0xe4, 0x25,
-	0xd6, 0xff, 0xce, 0x40, 0xaa, 0x45, 0x6c, 0xe5, 0x2e, 0xe4, 0xa3, 0x17, 0xee, 0xca, 0xec, 0xfe,
-	0xf1, 0x1b, 0x96, 0x7a, 0x61, 0x6f, 0x7c, 0x12, 0x5e, 0x31, 0x00, 0x22, 0xb7, 0xaf, 0xd5, 0x44,
-	0xaf, 0x09, 0xac, 0x9e, 0xdf, 0x13, 0x0e, 0x63, 0x5a, 0x70, 0x6c, 0xd7, 0x15, 0xe6, 0x6c, 0x82,
-	0xeb, 0x2c, 0x49, 0xbd, 0x7c, 0x00, 0x52, 0xb8, 0xcb, 0xe7, 0x50, 0x9c, 0xf9, 0xee, 0x9e, 0x49,
-	0x70, 0x8f, 0x53, 0xd4, 0x8b, 0xfb, 0x52, 0xc2, 0xf8, 0x5f, 0x80, 0x92, 0x70, 0xc8, 0x9f, 0x9f,
-	0x9b, 0x62, 0x94, 0xa6, 0xae, 0x1d, 0x88, 0x16, 0xad, 0xd8, 0xae, 0x73, 0xee, 0xec, 0x9c, 0x54,
-	0x63, 0xfb, 0x5c, 0x3e, 0x00, 0x29, 0xdc, 0xe5, 0x0e, 0xe4, 0xc2, 0xd9, 0x5c, 0x49, 0x70, 0x9c,
-	0x80, 0xea, 0xd9, 0x3d, 0xc0, 0x30, 0xda, 0x27, 0x50, 0x88, 0x4d, 0x96, 0x96, 0xe0, 0x14, 0x25,
-	0xa8, 0xff, 0xdb, 0x87, 0x30, 0x89, 0xac, 0x66, 0xbe, 0x7a, 0xf9, 0xf8, 0x92, 0xd4, 0xdc, 0x7a,
-	0x3a, 0xae, 0x48, 0xcf, 0xc6, 0x15, 0xe9, 0x8f, 0x71, 0x45, 0xfa, 0xee, 0x45, 0x65, 0xe1, 0xd9,
-	0x8b, 0xca, 0xc2, 0x6f, 0x2f, 0x2a, 0x0b, 0x9f, 0xea, 0x91, 0x2b, 0x09, 0x8b, 0xc9, 0x7f, 0x96,
-	0x9a, 0x5e, 0x57, 0x37, 0x77, 0x90, 0xe3, 0xea, 0xc3, 0x6b, 0xfa, 0xc3, 0xc9, 0x4f, 0x64, 0x7e,
-	0x3f, 0xe9, 0x64, 0x39, 0xe3, 0xda, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xab, 0x06, 0x21,
-	0x7d, 0x0f, 0x00, 0x00,
+// MsgUpdateSubscription is a message for updating an existing subscription. A
+// paused subscription becomes active again once it is updated.
+type MsgUpdateSubscription struct {
+	// SubscriptionID is the unique identifier of the subscription to be updated.
+	SubscriptionID SubscriptionID `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,casttype=SubscriptionID" json:"subscription_id,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientID string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks or seconds between two requests.
+	Interval uint64 `protobuf:"varint,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	// IntervalUnit is the unit of the interval.
+	IntervalUnit IntervalUnit `protobuf:"varint,12,opt,name=interval_unit,json=intervalUnit,proto3,enum=band.oracle.v1.IntervalUnit" json:"interval_unit,omitempty"`
+	// MaxRuns is the number of requests to be made before the subscription is
+	// completed. Zero means unlimited.
+	MaxRuns uint64 `protobuf:"varint,13,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Sender is the signer of this message. It must be the owner of the
+	// subscription.
+	Sender string `protobuf:"bytes,14,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be executed.
+	// Zero means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,15,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+}
+
+func (m *MsgUpdateSubscription) Reset()         { *m = MsgUpdateSubscription{} }
+func (m *MsgUpdateSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubscription) ProtoMessage()    {}
+func (*MsgUpdateSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{16}
+}
+func (m *MsgUpdateSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubscription.Merge(m, src)
+}
+func (m *MsgUpdateSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubscription.DiscardUnknown(m)
 }
 
-func (this *MsgRequestData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_MsgUpdateSubscription proto.InternalMessageInfo
+
+func (m *MsgUpdateSubscription) GetSubscriptionID() SubscriptionID {
+	if m != nil {
+		return m.SubscriptionID
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgRequestData)
-	if !ok {
-		that2, ok := that.(MsgRequestData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MsgUpdateSubscription) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
+	return nil
+}
+
+func (m *MsgUpdateSubscription) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
 	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
 	}
-	if this.AskCount != that1.AskCount {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
-	if this.MinCount != that1.MinCount {
-		return false
+	return ""
+}
+
+func (m *MsgUpdateSubscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
 	}
-	if this.ClientID != that1.ClientID {
-		return false
+	return nil
+}
+
+func (m *MsgUpdateSubscription) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
 	}
-	if len(this.FeeLimit) != len(that1.FeeLimit) {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
 	}
-	for i := range this.FeeLimit {
-		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
 	}
-	if this.PrepareGas != that1.PrepareGas {
-		return false
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *MsgUpdateSubscription) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	if this.ExecuteGas != that1.ExecuteGas {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetIntervalUnit() IntervalUnit {
+	if m != nil {
+		return m.IntervalUnit
 	}
-	if this.Sender != that1.Sender {
-		return false
+	return INTERVAL_UNIT_UNSPECIFIED
+}
+
+func (m *MsgUpdateSubscription) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
 	}
-	if this.TSSEncoder != that1.TSSEncoder {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return true
+	return ""
 }
-func (this *MsgReportData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgUpdateSubscription) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgReportData)
-	if !ok {
-		that2, ok := that.(MsgReportData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgUpdateSubscriptionResponse is response data for MsgUpdateSubscription
+// message
+type MsgUpdateSubscriptionResponse struct {
+}
+
+func (m *MsgUpdateSubscriptionResponse) Reset()         { *m = MsgUpdateSubscriptionResponse{} }
+func (m *MsgUpdateSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubscriptionResponse) ProtoMessage()    {}
+func (*MsgUpdateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{17}
+}
+func (m *MsgUpdateSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RequestID != that1.RequestID {
-		return false
-	}
-	if len(this.RawReports) != len(that1.RawReports) {
-		return false
-	}
-	for i := range this.RawReports {
-		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
-			return false
+}
+func (m *MsgUpdateSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgUpdateSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSubscriptionResponse proto.InternalMessageInfo
+
+// MsgCancelSubscription is a message for cancelling an existing subscription.
+// The remaining deposit is refunded to the owner.
+type MsgCancelSubscription struct {
+	// SubscriptionID is the unique identifier of the subscription to be
+	// cancelled.
+	SubscriptionID SubscriptionID `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,casttype=SubscriptionID" json:"subscription_id,omitempty"`
+	// Sender is the signer of this message. It must be the owner of the
+	// subscription.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelSubscription) Reset()         { *m = MsgCancelSubscription{} }
+func (m *MsgCancelSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscription) ProtoMessage()    {}
+func (*MsgCancelSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{18}
+}
+func (m *MsgCancelSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Validator != that1.Validator {
-		return false
+}
+func (m *MsgCancelSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscription.Merge(m, src)
+}
+func (m *MsgCancelSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSubscription proto.InternalMessageInfo
+
+func (m *MsgCancelSubscription) GetSubscriptionID() SubscriptionID {
+	if m != nil {
+		return m.SubscriptionID
 	}
-	return true
+	return 0
 }
-func (this *MsgCreateDataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgCancelSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgCreateDataSource)
-	if !ok {
-		that2, ok := that.(MsgCreateDataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgCancelSubscriptionResponse is response data for MsgCancelSubscription
+// message
+type MsgCancelSubscriptionResponse struct {
+}
+
+func (m *MsgCancelSubscriptionResponse) Reset()         { *m = MsgCancelSubscriptionResponse{} }
+func (m *MsgCancelSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{19}
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if !bytes.Equal(this.Executable, that1.Executable) {
-		return false
-	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
-	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSubscriptionResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/oracle parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Treasury != that1.Treasury {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
 }
-func (this *MsgEditDataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgEditDataSource)
-	if !ok {
-		that2, ok := that.(MsgEditDataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
+	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
+	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
+	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
+	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
+	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
+	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
+	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
+	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
+	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
+	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
+	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
+	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
+	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCreateSubscription)(nil), "band.oracle.v1.MsgCreateSubscription")
+	proto.RegisterType((*MsgCreateSubscriptionResponse)(nil), "band.oracle.v1.MsgCreateSubscriptionResponse")
+	proto.RegisterType((*MsgUpdateSubscription)(nil), "band.oracle.v1.MsgUpdateSubscription")
+	proto.RegisterType((*MsgUpdateSubscriptionResponse)(nil), "band.oracle.v1.MsgUpdateSubscriptionResponse")
+	proto.RegisterType((*MsgCancelSubscription)(nil), "band.oracle.v1.MsgCancelSubscription")
+	proto.RegisterType((*MsgCancelSubscriptionResponse)(nil), "band.oracle.v1.MsgCancelSubscriptionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
+
+var fileDescriptor_3ffde65d794f19e2 = []byte{
+	// 1644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0xe2, 0x1f, 0xb1, 0x9f, 0x1d, 0xa7, 0x55, 0x9b, 0x46, 0x51, 0x1a, 0xdb, 0x75, 0x7f,
+	0x7c, 0xd3, 0x76, 0x6c, 0xb7, 0x69, 0xbf, 0xdf, 0x99, 0xe6, 0x7b, 0x80, 0x3a, 0x29, 0x90, 0x99,
+	0x06, 0x18, 0x85, 0x74, 0x18, 0x66, 0xc0, 0xb3, 0x96, 0xb6, 0x8e, 0x88, 0x2d, 0x19, 0xad, 0xec,
+	0x26, 0x37, 0x86, 0x23, 0x17, 0xf8, 0x0b, 0x18, 0x6e, 0x30, 0x5c, 0xe8, 0xa1, 0x37, 0xb8, 0x32,
+	0xf4, 0xd8, 0xe9, 0x89, 0x53, 0x60, 0xdc, 0x43, 0x7b, 0x65, 0xb8, 0x71, 0x62, 0xb4, 0xbb, 0x96,
+	0x25, 0x59, 0x8e, 0x9d, 0x10, 0xda, 0x0b, 0x97, 0x44, 0xbb, 0xef, 0xf3, 0xf6, 0xed, 0xbe, 0xcf,
+	0x7b, 0x6f, 0x9f, 0x64, 0x98, 0xab, 0x21, 0x43, 0x2b, 0x9b, 0x16, 0x52, 0x1b, 0xb8, 0xdc, 0xb9,
+	0x5e, 0xb6, 0x77, 0x4b, 0x2d, 0xcb, 0xb4, 0x4d, 0x31, 0xe3, 0x08, 0x4a, 0x4c, 0x50, 0xea, 0x5c,
+	0x97, 0x4f, 0xd7, 0xcd, 0xba, 0x49, 0x45, 0x65, 0xe7, 0x89, 0xa1, 0xe4, 0x85, 0x80, 0x3a, 0xc7,
+	0x33, 0x61, 0x56, 0x35, 0x49, 0xd3, 0x24, 0xe5, 0x1a, 0x22, 0x8e, 0xb0, 0x86, 0x6d, 0x74, 0xbd,
+	0xac, 0x9a, 0xba, 0xc1, 0xe5, 0xf3, 0x4c, 0x5e, 0x65, 0xab, 0xb2, 0x01, 0x17, 0xcd, 0x71, 0xd5,
+	0x26, 0xa9, 0x3b, 0xcb, 0x36, 0x49, 0x9d, 0x0b, 0x4e, 0xa2, 0xa6, 0x6e, 0x98, 0x65, 0xfa, 0x97,
+	0x4d, 0x15, 0xbe, 0x8a, 0x41, 0x66, 0x83, 0xd4, 0x15, 0xfc, 0x49, 0x1b, 0x13, 0x7b, 0x0d, 0xd9,
+	0x48, 0x7c, 0x1b, 0x4e, 0xb0, 0x9d, 0x54, 0x89, 0x6a, 0xe9, 0x2d, 0xbb, 0xaa, 0x6b, 0x92, 0x90,
+	0x17, 0x96, 0xa2, 0x95, 0x0b, 0xdd, 0xfd, 0x5c, 0xe6, 0x1d, 0x2a, 0xdb, 0xa4, 0xa2, 0xf5, 0xb5,
+	0x3f, 0x07, 0x66, 0x94, 0x8c, 0xe9, 0x1d, 0x6b, 0xa2, 0x0c, 0x09, 0x15, 0x35, 0x1a, 0x1a, 0xb2,
+	0x91, 0x34, 0x99, 0x17, 0x96, 0xd2, 0x8a, 0x3b, 0x16, 0x17, 0x20, 0x89, 0xc8, 0x4e, 0x55, 0x35,
+	0xdb, 0x86, 0x2d, 0x45, 0x1c, 0x23, 0x4a, 0x02, 0x91, 0x9d, 0x55, 0x67, 0xec, 0x08, 0x9b, 0xba,
+	0xc1, 0x85, 0x51, 0x26, 0x6c, 0xea, 0x06, 0x13, 0x5e, 0x86, 0xa4, 0xda, 0xd0, 0xb1, 0x41, 0xb7,
+	0x17, 0xcb, 0x0b, 0x4b, 0xc9, 0x4a, 0xba, 0xbb, 0x9f, 0x4b, 0xac, 0xd2, 0xc9, 0xf5, 0x35, 0x25,
+	0xc1, 0xc4, 0xeb, 0x9a, 0xb8, 0x0d, 0xc9, 0xfb, 0x18, 0x57, 0x1b, 0x7a, 0x53, 0xb7, 0xa5, 0x78,
+	0x3e, 0xb2, 0x94, 0x5a, 0x9e, 0x2f, 0x71, 0x8f, 0x39, 0xee, 0x2d, 0x71, 0xf7, 0x96, 0x56, 0x4d,
+	0xdd, 0xa8, 0x5c, 0x7b, 0xbc, 0x9f, 0x9b, 0xf8, 0xee, 0xd7, 0xdc, 0x52, 0x5d, 0xb7, 0xb7, 0xdb,
+	0xb5, 0x92, 0x6a, 0x36, 0xb9, 0x7b, 0xf9, 0xbf, 0x22, 0xd1, 0x76, 0xca, 0xf6, 0x5e, 0x0b, 0x13,
+	0xaa, 0x40, 0x94, 0xc4, 0x7d, 0x8c, 0xef, 0x3a, 0x8b, 0x8b, 0x39, 0x48, 0xb5, 0x2c, 0xdc, 0x42,
+	0x16, 0xae, 0xd6, 0x11, 0x91, 0xa6, 0xe8, 0x9e, 0x81, 0x4f, 0xbd, 0x89, 0x88, 0x03, 0xc0, 0xbb,
+	0x58, 0x6d, 0xdb, 0x0c, 0x90, 0x60, 0x00, 0x3e, 0xe5, 0x00, 0xae, 0x41, 0x9c, 0x60, 0x43, 0xc3,
+	0x96, 0x94, 0xa4, 0x67, 0x92, 0x9e, 0x3e, 0x2a, 0x9e, 0xe6, 0x7b, 0xbd, 0xad, 0x69, 0x16, 0x26,
+	0x64, 0xd3, 0xb6, 0x74, 0xa3, 0xae, 0x70, 0x9c, 0xf8, 0x16, 0xa4, 0x6c, 0x42, 0xaa, 0xd8, 0x50,
+	0x4d, 0x47, 0x0d, 0xf2, 0xc2, 0x52, 0x66, 0x79, 0xae, 0xe4, 0x8f, 0xc0, 0xd2, 0x1d, 0x26, 0xae,
+	0x64, 0xba, 0xfb, 0x39, 0x78, 0x6f, 0x73, 0x93, 0x8f, 0x15, 0xb0, 0x09, 0xe1, 0xcf, 0xe2, 0x32,
+	0xcc, 0xfa, 0x89, 0xef, 0x60, 0x8b, 0xe8, 0xa6, 0x21, 0xa5, 0xe8, 0x36, 0x4f, 0x79, 0x79, 0xbd,
+	0xc7, 0x44, 0xe2, 0xff, 0x19, 0xb9, 0x35, 0xa4, 0xee, 0x48, 0xe9, 0xbc, 0xb0, 0x94, 0x5a, 0xce,
+	0x05, 0x4d, 0xf3, 0xd8, 0x5a, 0xe5, 0x30, 0xc5, 0x55, 0x58, 0x29, 0xbc, 0xf8, 0x3a, 0x27, 0x7c,
+	0xf6, 0xfc, 0xe1, 0x15, 0x7e, 0x96, 0xcf, 0x9f, 0x3f, 0xbc, 0xc2, 0xa3, 0xa7, 0xcc, 0xb5, 0x0a,
+	0x12, 0x9c, 0xf1, 0xc7, 0xa7, 0x82, 0x49, 0xcb, 0x34, 0x08, 0x2e, 0xfc, 0x21, 0xc0, 0x34, 0x15,
+	0xb5, 0x4c, 0x8b, 0x45, 0xee, 0x2d, 0x00, 0x8b, 0x01, 0xfb, 0x31, 0x2b, 0x77, 0xf7, 0x73, 0x49,
+	0xae, 0x4e, 0xc3, 0xb5, 0x3f, 0x50, 0x92, 0x1c, 0xbd, 0xae, 0x89, 0xaf, 0x43, 0xca, 0x42, 0x0f,
+	0xaa, 0x16, 0x5d, 0x8c, 0x48, 0x93, 0x3c, 0x4a, 0x82, 0x47, 0x41, 0x0f, 0x98, 0xb9, 0x4a, 0xd4,
+	0x89, 0x12, 0x05, 0xac, 0xde, 0x04, 0x11, 0x5f, 0x83, 0x64, 0x07, 0x35, 0x74, 0x0d, 0xd9, 0xa6,
+	0x45, 0x43, 0x39, 0x59, 0x39, 0xf7, 0xf4, 0x51, 0x71, 0x91, 0x93, 0x77, 0xaf, 0x27, 0xf3, 0xb3,
+	0xd8, 0xd7, 0x59, 0xb9, 0xd0, 0xf3, 0x46, 0x7f, 0xce, 0x71, 0xc8, 0xb4, 0xeb, 0x10, 0xc7, 0x4e,
+	0x61, 0x0e, 0x66, 0x7d, 0x87, 0x76, 0xdd, 0xf1, 0x45, 0x04, 0x4e, 0x6d, 0x90, 0xfa, 0xaa, 0x85,
+	0x91, 0x8d, 0x1d, 0xc9, 0xa6, 0xd9, 0xb6, 0x54, 0x2c, 0x8a, 0x10, 0x35, 0x50, 0x13, 0x53, 0x77,
+	0x24, 0x15, 0xfa, 0x2c, 0xe6, 0x21, 0xa5, 0x61, 0x46, 0xb2, 0xc3, 0xef, 0x24, 0x15, 0x79, 0xa7,
+	0xc4, 0x2c, 0xf0, 0xa8, 0x44, 0xb5, 0x06, 0xa6, 0xc7, 0x49, 0x2b, 0x9e, 0x19, 0xf1, 0x43, 0x88,
+	0xdc, 0xc7, 0x58, 0x8a, 0x1e, 0x7f, 0x36, 0x39, 0xeb, 0x8a, 0x37, 0x21, 0x61, 0x5b, 0x18, 0x91,
+	0xb6, 0xb5, 0x27, 0xc5, 0x46, 0x24, 0x82, 0x8b, 0x14, 0x4b, 0x10, 0x33, 0x1f, 0x18, 0xd8, 0x92,
+	0xe2, 0x23, 0x54, 0x18, 0xcc, 0x93, 0x6c, 0x53, 0xe3, 0x25, 0xdb, 0xca, 0xd5, 0x90, 0x88, 0x9d,
+	0xe3, 0x04, 0x05, 0x3d, 0x5f, 0x58, 0x84, 0x85, 0x10, 0x42, 0x5c, 0xc2, 0x7e, 0x8f, 0xc0, 0xc9,
+	0x0d, 0x52, 0xbf, 0xa3, 0xe9, 0xb6, 0x87, 0xae, 0x37, 0x20, 0xe3, 0x54, 0xc6, 0x2a, 0xa1, 0xc3,
+	0x7e, 0x1c, 0xe7, 0xbb, 0xfb, 0xb9, 0x74, 0x1f, 0x47, 0x43, 0xd9, 0x37, 0x56, 0xd2, 0x5a, 0x7f,
+	0xa4, 0xb9, 0xb4, 0x4f, 0x0e, 0xa7, 0x3d, 0x32, 0x8a, 0xf6, 0xe8, 0x30, 0xda, 0x63, 0x2f, 0x81,
+	0xf6, 0xf8, 0xe1, 0x69, 0x9f, 0x3a, 0x2c, 0xed, 0x89, 0x31, 0x6b, 0xec, 0x59, 0x48, 0xaa, 0xdb,
+	0xc8, 0xa8, 0xe3, 0x86, 0x59, 0x67, 0x85, 0x59, 0xe9, 0x4f, 0xac, 0x5c, 0x0e, 0x09, 0x0a, 0x5e,
+	0x49, 0xcb, 0x7e, 0x76, 0x0b, 0x0b, 0x30, 0x3f, 0x40, 0xb9, 0x1b, 0x10, 0x3f, 0x4d, 0xc2, 0xac,
+	0x1b, 0x30, 0xde, 0x4b, 0xf5, 0x88, 0x39, 0x7c, 0x06, 0xe2, 0x44, 0xdd, 0xc6, 0x4d, 0xc4, 0x99,
+	0xe6, 0x23, 0xf1, 0x16, 0xcc, 0xf0, 0xe8, 0x72, 0xea, 0x7e, 0xb5, 0x6d, 0x35, 0x28, 0xd3, 0xc9,
+	0xca, 0xc9, 0xee, 0x7e, 0x6e, 0x9a, 0x6d, 0x6a, 0xd5, 0xd4, 0xf0, 0x96, 0x72, 0x57, 0x99, 0x26,
+	0xfd, 0xa1, 0xd5, 0x70, 0x36, 0xe2, 0xe8, 0xd0, 0x9c, 0x4c, 0x2b, 0xf4, 0xf9, 0x25, 0x64, 0x5d,
+	0x31, 0xc4, 0xc1, 0xf3, 0xbe, 0xac, 0xf3, 0x7a, 0xab, 0x90, 0x83, 0xc5, 0x50, 0x37, 0xba, 0x8e,
+	0xfe, 0x9e, 0x95, 0x4a, 0x87, 0x06, 0x9f, 0x9b, 0x8f, 0xbb, 0xf3, 0x39, 0x5a, 0x0e, 0xf6, 0x69,
+	0x8b, 0x8e, 0xa2, 0x2d, 0x76, 0x48, 0xda, 0xe2, 0x61, 0xb4, 0xbd, 0xa2, 0xac, 0x39, 0xa8, 0x94,
+	0x06, 0x99, 0xe1, 0xa5, 0x34, 0x38, 0xed, 0x12, 0xda, 0x81, 0xd4, 0x06, 0xa9, 0xdf, 0x56, 0x6d,
+	0xbd, 0x83, 0x6c, 0xec, 0xbf, 0x8a, 0x85, 0x23, 0x5c, 0xc5, 0x97, 0xc2, 0xaf, 0xe2, 0x19, 0xbe,
+	0xbd, 0x9e, 0xa1, 0xc2, 0x2c, 0x8d, 0xa3, 0xde, 0xd0, 0xdd, 0xce, 0x8b, 0xb8, 0x27, 0x91, 0x37,
+	0xdb, 0xb5, 0x3e, 0xb7, 0xff, 0xf6, 0xd6, 0xaf, 0xb0, 0xb7, 0x0e, 0x74, 0xca, 0xc9, 0xa3, 0x77,
+	0xca, 0x32, 0x24, 0x74, 0xc3, 0xc6, 0x56, 0x07, 0x35, 0x68, 0xc3, 0x1d, 0x55, 0xdc, 0xb1, 0x78,
+	0x1b, 0xa6, 0x7b, 0xcf, 0xd5, 0xb6, 0xa1, 0xdb, 0xb4, 0x7b, 0xce, 0x2c, 0x9f, 0x0d, 0xda, 0x59,
+	0xe7, 0xa0, 0x2d, 0x43, 0xb7, 0x95, 0xb4, 0xee, 0x19, 0x89, 0xf3, 0x90, 0x68, 0xa2, 0xdd, 0xaa,
+	0xd5, 0x36, 0x08, 0x6d, 0xaa, 0xa3, 0xca, 0x54, 0x13, 0xed, 0x2a, 0x6d, 0x83, 0x88, 0x7b, 0x30,
+	0xa3, 0x1b, 0xba, 0xad, 0xa3, 0x46, 0x55, 0xc3, 0x2d, 0x93, 0xe8, 0xb6, 0x34, 0x3d, 0xca, 0xeb,
+	0xff, 0x3d, 0xac, 0xd7, 0xbf, 0x7d, 0xfe, 0xf0, 0x8a, 0xa0, 0x64, 0xb8, 0xa1, 0x35, 0x66, 0xc7,
+	0x53, 0x00, 0x32, 0x63, 0x16, 0x80, 0xa1, 0x2f, 0x14, 0x33, 0x43, 0x5f, 0x28, 0xc6, 0xa8, 0xf5,
+	0xde, 0x84, 0x2a, 0x18, 0x9e, 0x5a, 0xef, 0x15, 0xf4, 0x72, 0x51, 0xdc, 0x80, 0x19, 0xe2, 0x99,
+	0x0f, 0x24, 0x9c, 0x57, 0x85, 0x25, 0x9c, 0x7f, 0x46, 0xc9, 0x78, 0x95, 0xd7, 0xb5, 0xc2, 0x8f,
+	0x2c, 0xb5, 0xb7, 0x5a, 0x5a, 0x30, 0xb5, 0x8f, 0xd7, 0x50, 0x68, 0xa5, 0x98, 0x3c, 0xa6, 0x4a,
+	0x11, 0x39, 0xa8, 0x52, 0x44, 0x0f, 0xaa, 0x14, 0xb1, 0x83, 0x2a, 0x45, 0x7c, 0xfc, 0x4a, 0x31,
+	0xf5, 0x12, 0x2b, 0x45, 0x62, 0x54, 0xa5, 0x48, 0x8e, 0xaa, 0x14, 0x70, 0x3c, 0x95, 0x22, 0x35,
+	0xaa, 0x52, 0xa4, 0xff, 0x56, 0xa5, 0x98, 0xf6, 0x57, 0x8a, 0x57, 0x9f, 0xae, 0x83, 0x49, 0xc2,
+	0x5b, 0xb3, 0x41, 0x81, 0x7b, 0x75, 0xfe, 0x2c, 0xb0, 0xab, 0x13, 0x19, 0x2a, 0x6e, 0xfc, 0x93,
+	0xf9, 0xd5, 0x77, 0xcf, 0xe4, 0x71, 0x74, 0xa1, 0x03, 0xfb, 0xed, 0x75, 0xa1, 0x03, 0x02, 0xf7,
+	0xa8, 0xdf, 0x08, 0x30, 0xe3, 0x3a, 0xe3, 0x5d, 0x64, 0xa1, 0x26, 0x11, 0xff, 0x07, 0x49, 0xd4,
+	0xb6, 0xb7, 0x4d, 0x4b, 0xb7, 0xf7, 0x24, 0x61, 0xc4, 0xc6, 0xfa, 0x50, 0xf1, 0x26, 0xc4, 0x5b,
+	0x74, 0x05, 0x7a, 0x9a, 0xd4, 0xf2, 0x99, 0x60, 0x0c, 0xb1, 0xf5, 0xf9, 0x67, 0x0b, 0x8e, 0x5d,
+	0xb9, 0x44, 0x5b, 0x1c, 0x77, 0x15, 0xe7, 0x40, 0xa7, 0x7c, 0xdc, 0x31, 0xad, 0xc2, 0x3c, 0xcc,
+	0x05, 0x36, 0xda, 0x3b, 0xc4, 0xf2, 0x0f, 0x09, 0x88, 0x6c, 0x90, 0xba, 0xb8, 0x05, 0x29, 0xef,
+	0x37, 0xc4, 0x6c, 0xd0, 0xbe, 0xff, 0x1b, 0x8e, 0x7c, 0xe9, 0x60, 0xb9, 0x5b, 0xbd, 0x15, 0x00,
+	0xcf, 0xf7, 0x9d, 0xc5, 0x50, 0xad, 0x9e, 0x58, 0xbe, 0x78, 0xa0, 0xd8, 0x5d, 0x53, 0x83, 0x13,
+	0x03, 0x1f, 0x49, 0xce, 0x87, 0xa8, 0x06, 0x41, 0xf2, 0xd5, 0x31, 0x40, 0xae, 0x95, 0x8f, 0x20,
+	0x13, 0x78, 0xb3, 0x3f, 0x17, 0xa2, 0xee, 0x87, 0xc8, 0x97, 0x47, 0x42, 0xdc, 0xf5, 0x3f, 0x06,
+	0x31, 0xe4, 0x45, 0xf1, 0xe2, 0xd0, 0x2d, 0x7a, 0x61, 0x72, 0x71, 0x2c, 0x98, 0xd7, 0x63, 0x03,
+	0xef, 0x4a, 0xe7, 0x87, 0x6c, 0xd5, 0x67, 0xe7, 0xea, 0x18, 0x20, 0xd7, 0xca, 0x5d, 0x48, 0xb8,
+	0x1d, 0xfc, 0x42, 0x88, 0x62, 0x4f, 0x28, 0x9f, 0x3f, 0x40, 0x38, 0xe8, 0x1f, 0x5f, 0x11, 0x19,
+	0xee, 0x1f, 0x2f, 0x4c, 0x2e, 0x8e, 0x05, 0xf3, 0xda, 0x0a, 0x69, 0x08, 0xc2, 0x6c, 0x0d, 0xc2,
+	0xe4, 0xe2, 0x58, 0x30, 0xdf, 0xb9, 0x06, 0x8b, 0x63, 0xe8, 0xb9, 0x06, 0x60, 0x72, 0x71, 0x2c,
+	0x98, 0x6b, 0xeb, 0x7d, 0x48, 0xfb, 0xaa, 0x53, 0x6e, 0xe8, 0x56, 0x19, 0x40, 0xfe, 0xcf, 0x08,
+	0x40, 0x6f, 0x65, 0x39, 0xf6, 0xa9, 0xd3, 0x62, 0x56, 0xd6, 0x1f, 0x77, 0xb3, 0xc2, 0x93, 0x6e,
+	0x56, 0xf8, 0xad, 0x9b, 0x15, 0xbe, 0x7c, 0x96, 0x9d, 0x78, 0xf2, 0x2c, 0x3b, 0xf1, 0xcb, 0xb3,
+	0xec, 0xc4, 0x07, 0x65, 0xcf, 0xbd, 0xef, 0xac, 0x49, 0x7f, 0xad, 0x50, 0xcd, 0x46, 0x59, 0xdd,
+	0x46, 0xba, 0x51, 0xee, 0xdc, 0x28, 0xef, 0xf6, 0x7e, 0x39, 0xa1, 0x4d, 0x40, 0x2d, 0x4e, 0x11,
+	0x37, 0xfe, 0x1a, 0x00, 0xc8, 0x7d, 0xff, 0x5e, 0x94, 0x19, 0x00, 0x00,
+}
+
+func (this *MsgRequestData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRequestData)
+	if !ok {
+		that2, ok := that.(MsgRequestData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	if !this.Callback.Equal(that1.Callback) {
+		return false
+	}
+	return true
+}
+func (this *MsgReportData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReportData)
+	if !ok {
+		that2, ok := that.(MsgReportData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if len(this.RawReports) != len(that1.RawReports) {
+		return false
+	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *MsgCreateDataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateDataSource)
+	if !ok {
+		that2, ok := that.(MsgCreateDataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Executable, that1.Executable) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgEditDataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgEditDataSource)
+	if !ok {
+		that2, ok := that.(MsgEditDataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	if this.DataSourceID != that1.DataSourceID {
@@ -1360,6 +1970,9 @@ func (this *MsgEditDataSource) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if this.Changelog != that1.Changelog {
+		return false
+	}
 	return true
 }
 func (this *MsgCreateOracleScript) Equal(that interface{}) bool {
@@ -1447,6 +2060,9 @@ func (this *MsgEditOracleScript) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if this.Changelog != that1.Changelog {
+		return false
+	}
 	return true
 }
 func (this *MsgActivate) Equal(that interface{}) bool {
@@ -1473,33 +2089,215 @@ func (this *MsgActivate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCreateSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RequestData defines a method for submitting a new request.
-	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
-	// ReportData defines a method for reporting a data to resolve the request.
-	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
-	// CreateDataSource defines a method for creating a new data source.
-	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
-	// EditDataSource defines a method for editing an existing data source.
-	EditDataSource(ctx context.Context, in *MsgEditDataSource, opts ...grpc.CallOption) (*MsgEditDataSourceResponse, error)
-	// CreateOracleScript defines a method for creating a new oracle script.
-	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
-	// EditOracleScript defines a method for editing an existing oracle script.
-	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
+	that1, ok := that.(*MsgCreateSubscription)
+	if !ok {
+		that2, ok := that.(MsgCreateSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.IntervalUnit != that1.IntervalUnit {
+		return false
+	}
+	if this.MaxRuns != that1.MaxRuns {
+		return false
+	}
+	if len(this.InitialDeposit) != len(that1.InitialDeposit) {
+		return false
+	}
+	for i := range this.InitialDeposit {
+		if !this.InitialDeposit[i].Equal(&that1.InitialDeposit[i]) {
+			return false
+		}
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateSubscription)
+	if !ok {
+		that2, ok := that.(MsgUpdateSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubscriptionID != that1.SubscriptionID {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.IntervalUnit != that1.IntervalUnit {
+		return false
+	}
+	if this.MaxRuns != that1.MaxRuns {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelSubscription)
+	if !ok {
+		that2, ok := that.(MsgCancelSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubscriptionID != that1.SubscriptionID {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RequestData defines a method for submitting a new request.
+	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
+	// ReportData defines a method for reporting a data to resolve the request.
+	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
+	// CreateDataSource defines a method for creating a new data source.
+	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
+	// EditDataSource defines a method for editing an existing data source.
+	EditDataSource(ctx context.Context, in *MsgEditDataSource, opts ...grpc.CallOption) (*MsgEditDataSourceResponse, error)
+	// CreateOracleScript defines a method for creating a new oracle script.
+	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
+	// EditOracleScript defines a method for editing an existing oracle script.
+	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateSubscription defines a method for creating a new subscription that
+	// makes recurring requests.
+	CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error)
+	// UpdateSubscription defines a method for updating an existing subscription.
+	UpdateSubscription(ctx context.Context, in *MsgUpdateSubscription, opts ...grpc.CallOption) (*MsgUpdateSubscriptionResponse, error)
+	// CancelSubscription defines a method for cancelling an existing
+	// subscription.
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -1578,6 +2376,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error) {
+	out := new(MsgCreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSubscription(ctx context.Context, in *MsgUpdateSubscription, opts ...grpc.CallOption) (*MsgUpdateSubscriptionResponse, error) {
+	out := new(MsgUpdateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CancelSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateParams", in, out, opts...)
@@ -1603,6 +2428,14 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateSubscription defines a method for creating a new subscription that
+	// makes recurring requests.
+	CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error)
+	// UpdateSubscription defines a method for updating an existing subscription.
+	UpdateSubscription(context.Context, *MsgUpdateSubscription) (*MsgUpdateSubscriptionResponse, error)
+	// CancelSubscription defines a method for cancelling an existing
+	// subscription.
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -1635,6 +2468,15 @@ func (*UnimplementedMsgServer) EditOracleScript(ctx context.Context, req *MsgEdi
 func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (*UnimplementedMsgServer) CreateSubscription(ctx context.Context, req *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSubscription(ctx context.Context, req *MsgUpdateSubscription) (*MsgUpdateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (*UnimplementedMsgServer) CancelSubscription(ctx context.Context, req *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1769,6 +2611,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubscription(ctx, req.(*MsgCreateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSubscription(ctx, req.(*MsgUpdateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CancelSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1819,6 +2715,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Msg_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _Msg_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1848,6 +2756,23 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -2133,6 +3058,13 @@ func (m *MsgEditDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2335,6 +3267,13 @@ func (m *MsgEditOracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2468,7 +3407,7 @@ func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2478,37 +3417,119 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.MaxRuns != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRuns))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x60
+	}
+	if m.IntervalUnit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalUnit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSubscriptionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2518,121 +3539,390 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRequestData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptID != 0 {
-		n += 1 + sovTx(uint64(m.OracleScriptID))
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.AskCount != 0 {
-		n += 1 + sovTx(uint64(m.AskCount))
+	if m.MaxRuns != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovTx(uint64(m.MinCount))
+	if m.IntervalUnit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalUnit))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x40
 	}
 	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovTx(uint64(m.PrepareGas))
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovTx(uint64(m.ExecuteGas))
+	if m.MinCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AskCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TSSEncoder != 0 {
-		n += 1 + sovTx(uint64(m.TSSEncoder))
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgReportData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
-	}
-	if len(m.RawReports) > 0 {
-		for _, e := range m.RawReports {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReportDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDataSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgCancelSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRequestData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptVersion))
+	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if len(m.RawReports) > 0 {
+		for _, e := range m.RawReports {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -2714,6 +4004,10 @@ func (m *MsgEditDataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Changelog)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2809,6 +4103,10 @@ func (m *MsgEditOracleScript) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Changelog)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2843,70 +4141,923 @@ func (m *MsgActivateResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgCreateSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.IntervalUnit != 0 {
+		n += 1 + sovTx(uint64(m.IntervalUnit))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovTx(uint64(m.MaxRuns))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
+func (m *MsgCreateSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	return n
+}
+
+func (m *MsgUpdateSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.IntervalUnit != 0 {
+		n += 1 + sovTx(uint64(m.IntervalUnit))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovTx(uint64(m.MaxRuns))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
+func (m *MsgUpdateSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &RequestCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.OracleScriptID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2916,16 +5067,31 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2935,31 +5101,61 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.AskCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2969,16 +5165,129 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.MinCount = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2988,14 +5297,14 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3023,13 +5332,13 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3039,31 +5348,29 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
-			m.PrepareGas = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3073,16 +5380,31 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ExecuteGas = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3092,14 +5414,29 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3127,13 +5464,13 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Treasury = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.TSSEncoder = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3143,135 +5480,29 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgReportData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3281,29 +5512,27 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,7 +5560,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Changelog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3354,7 +5583,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3377,10 +5606,10 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3404,7 +5633,7 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,10 +5656,10 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3499,9 +5728,9 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3511,31 +5740,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
-			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3545,31 +5772,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3579,23 +5804,25 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -3682,7 +5909,7 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3705,10 +5932,10 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3732,7 +5959,7 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3755,17 +5982,17 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.DataSourceID = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3775,7 +6002,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3846,7 +6073,71 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3873,16 +6164,16 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3892,29 +6183,27 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3942,11 +6231,11 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3974,11 +6263,111 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Changelog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4006,7 +6395,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4029,7 +6418,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4052,10 +6441,10 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4079,7 +6468,7 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4102,17 +6491,36 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4122,27 +6530,67 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4170,13 +6618,13 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4186,29 +6634,31 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			var stringLen uint64
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4218,29 +6668,54 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var byteLen int
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4250,31 +6725,54 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.IntervalUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalUnit |= IntervalUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4284,25 +6782,27 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -4334,6 +6834,25 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4355,7 +6874,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4374,16 +6893,35 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= SubscriptionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4405,7 +6943,7 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,13 +6966,32 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= SubscriptionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
@@ -4453,11 +7010,11 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4467,29 +7024,31 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			var stringLen uint64
+			m.AskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4499,27 +7058,33 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AskCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4547,13 +7112,13 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4563,29 +7128,31 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			var byteLen int
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4595,31 +7162,54 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4629,25 +7219,50 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.IntervalUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalUnit |= IntervalUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -4679,6 +7294,25 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4700,7 +7334,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,10 +7357,10 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4750,7 +7384,7 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,15 +7407,34 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= SubscriptionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4809,7 +7462,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4832,7 +7485,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4855,10 +7508,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
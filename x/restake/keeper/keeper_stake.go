@@ -22,6 +22,90 @@ func (k Keeper) GetStakedPower(ctx sdk.Context, stakerAddr sdk.AccAddress) sdkma
 	return power
 }
 
+// SlashStake slashes the given fraction of the coins staked in the module by the address and
+// transfers the slashed coins to the recipient module. The locked powers of the address are capped
+// at its new total power. It returns the slashed coins.
+func (k Keeper) SlashStake(
+	ctx sdk.Context,
+	stakerAddr sdk.AccAddress,
+	fraction sdkmath.LegacyDec,
+	recipientModule string,
+) (sdk.Coins, error) {
+	stake := k.GetStake(ctx, stakerAddr)
+
+	slashedCoins := sdk.NewCoins()
+	for _, coin := range stake.Coins {
+		amount := fraction.MulInt(coin.Amount).TruncateInt()
+		slashedCoins = slashedCoins.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	if slashedCoins.IsZero() {
+		return slashedCoins, nil
+	}
+
+	stake.Coins = stake.Coins.Sub(slashedCoins...)
+	if !stake.Coins.IsZero() {
+		k.SetStake(ctx, stake)
+	} else {
+		k.DeleteStake(ctx, stakerAddr)
+	}
+
+	if err := k.capLockedPower(ctx, stakerAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.ModuleName,
+		recipientModule,
+		slashedCoins,
+	); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashStake,
+			sdk.NewAttribute(types.AttributeKeyStaker, stakerAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCoins, slashedCoins.String()),
+		),
+	)
+
+	return slashedCoins, nil
+}
+
+// capLockedPower reduces the locked powers of the address that exceed its total power to the total power.
+func (k Keeper) capLockedPower(ctx sdk.Context, stakerAddr sdk.AccAddress) error {
+	locks := k.GetLocksByAddress(ctx, stakerAddr)
+	if len(locks) == 0 {
+		return nil
+	}
+
+	totalPower, err := k.GetTotalPower(ctx, stakerAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, lock := range locks {
+		if lock.Power.LTE(totalPower) {
+			continue
+		}
+
+		lock.Power = totalPower
+		k.SetLock(ctx, lock)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLockPower,
+				sdk.NewAttribute(types.AttributeKeyStaker, stakerAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyKey, lock.Key),
+				sdk.NewAttribute(types.AttributeKeyPower, totalPower.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
 // GetStakesIterator gets iterator of stake store.
 func (k Keeper) GetStakesIterator(ctx sdk.Context) storetypes.Iterator {
 	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StakeStoreKeyPrefix)
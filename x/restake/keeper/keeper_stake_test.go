@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"go.uber.org/mock/gomock"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +27,43 @@ func (suite *KeeperTestSuite) TestGetStakedPower() {
 	suite.Require().Equal(expPower, power)
 }
 
+func (suite *KeeperTestSuite) TestSlashStake() {
+	ctx := suite.ctx
+	suite.setupState()
+
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(
+			gomock.Any(),
+			types.ModuleName,
+			"recipient",
+			sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(5))),
+		).
+		Return(nil)
+	suite.stakingKeeper.EXPECT().
+		GetDelegatorBonded(gomock.Any(), ValidAddress1).
+		Return(sdkmath.NewInt(50), nil)
+
+	fraction := sdkmath.LegacyNewDecWithPrec(10, 2)
+	slashedCoins, err := suite.restakeKeeper.SlashStake(ctx, ValidAddress1, fraction, "recipient")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(5))), slashedCoins)
+	suite.Require().Equal(sdkmath.NewInt(45), suite.restakeKeeper.GetStakedPower(ctx, ValidAddress1))
+
+	// the lock over the new total power (50 delegated + 45 staked) is capped, while the other is kept.
+	lock, found := suite.restakeKeeper.GetLock(ctx, ValidAddress1, ActiveVaultKey)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(95), lock.Power)
+
+	lock, found = suite.restakeKeeper.GetLock(ctx, ValidAddress1, InactiveVaultKey)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(50), lock.Power)
+
+	// nothing is slashed from the address without stake.
+	slashedCoins, err = suite.restakeKeeper.SlashStake(ctx, ValidAddress2, fraction, "recipient")
+	suite.Require().NoError(err)
+	suite.Require().True(slashedCoins.IsZero())
+}
+
 func (suite *KeeperTestSuite) TestGetSetStake() {
 	ctx := suite.ctx
 
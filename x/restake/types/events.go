@@ -7,6 +7,7 @@ const (
 	EventTypeDeactivateVault = "deactivate_vault"
 	EventTypeStake           = "stake"
 	EventTypeUnstake         = "unstake"
+	EventTypeSlashStake      = "slash_stake"
 
 	AttributeKeyStaker = "staker"
 	AttributeKeyKey    = "key"
@@ -31,6 +31,7 @@ type BankKeeper interface {
 		recipientModule string,
 		amt sdk.Coins,
 	) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // StakingKeeper defines the expected staking keeper.
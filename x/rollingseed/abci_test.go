@@ -12,8 +12,10 @@ import (
 	"cosmossdk.io/core/header"
 
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/rollingseed/types"
 )
 
 func fromHex(hexStr string) []byte {
@@ -68,3 +70,45 @@ func TestRollingSeedCorrect(t *testing.T) {
 		app.RollingseedKeeper.GetRollingSeed(ctx),
 	)
 }
+
+func TestRandomValidatorsNotAffectedByBlockHash(t *testing.T) {
+	dir := sdktestutil.GetTempDir(t)
+	app := bandtesting.SetupWithCustomHome(false, dir)
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{ChainID: bandtesting.ChainID})
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+
+	for _, v := range bandtesting.Validators {
+		require.NoError(t, app.OracleKeeper.Activate(ctx, v.ValAddress))
+	}
+	app.RollingseedKeeper.SetBeacon(ctx, types.Beacon{
+		Round:  1,
+		Value:  fromHex("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		Height: 1,
+	})
+
+	sample := func() [][]sdk.ValAddress {
+		var vals [][]sdk.ValAddress
+		for id := uint64(1); id <= 10; id++ {
+			chosen, err := app.OracleKeeper.GetRandomValidators(ctx, 1, id)
+			require.NoError(t, err)
+			vals = append(vals, chosen)
+		}
+		return vals
+	}
+	expected := sample()
+
+	// The block hashes change the rolling seed, but not the validators sampled with the beacon value.
+	for _, hash := range []string{
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"ff00000000000000000000000000000000000000000000000000000000000000",
+		"7f00000000000000000000000000000000000000000000000000000000000000",
+	} {
+		rollingSeed := app.RollingseedKeeper.GetRollingSeed(ctx)
+		_, err = app.BeginBlocker(ctx.WithHeaderInfo(header.Info{Hash: fromHex(hash)}))
+		require.NoError(t, err)
+		require.NotEqual(t, rollingSeed, app.RollingseedKeeper.GetRollingSeed(ctx))
+
+		require.Equal(t, expected, sample())
+	}
+}
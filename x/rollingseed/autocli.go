@@ -0,0 +1,28 @@
+package rollingseed
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	rollingseedv1beta1 "github.com/bandprotocol/chain/v3/api/band/rollingseed/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: rollingseedv1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Beacon",
+					Use:       "beacon",
+					Short:     "Get the latest value of the randomness beacon",
+				},
+				{
+					RpcMethod: "RandomSeed",
+					Use:       "random-seed",
+					Short:     "Get the seed currently used for random sampling",
+				},
+			},
+		},
+	}
+}
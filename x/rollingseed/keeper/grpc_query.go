@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/rollingseed/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+// Beacon returns the latest value of the randomness beacon.
+func (q Querier) Beacon(stdCtx context.Context, _ *types.QueryBeaconRequest) (*types.QueryBeaconResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	beacon, found := q.GetBeacon(ctx)
+	if !found {
+		return nil, types.ErrBeaconNotFound
+	}
+
+	return &types.QueryBeaconResponse{Beacon: beacon}, nil
+}
+
+// RandomSeed returns the seed currently used for random sampling.
+func (q Querier) RandomSeed(
+	stdCtx context.Context,
+	_ *types.QueryRandomSeedRequest,
+) (*types.QueryRandomSeedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	if beacon, found := q.GetBeacon(ctx); found {
+		return &types.QueryRandomSeedResponse{Seed: beacon.Value, BeaconRound: beacon.Round}, nil
+	}
+
+	return &types.QueryRandomSeedResponse{Seed: q.GetRollingSeed(ctx)}, nil
+}
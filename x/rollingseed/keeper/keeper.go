@@ -3,6 +3,7 @@ package keeper
 import (
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/x/rollingseed/types"
@@ -10,14 +11,17 @@ import (
 
 // Keeper stores related components of the x/rollingseed store
 type Keeper struct {
+	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 }
 
 // NewKeeper creates a new rollingseed Keeper instance.
 func NewKeeper(
+	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 ) Keeper {
 	return Keeper{
+		cdc:      cdc,
 		storeKey: storeKey,
 	}
 }
@@ -31,3 +35,36 @@ func (k Keeper) SetRollingSeed(ctx sdk.Context, rollingSeed []byte) {
 func (k Keeper) GetRollingSeed(ctx sdk.Context) []byte {
 	return ctx.KVStore(k.storeKey).Get(types.RollingSeedStoreKey)
 }
+
+// SetBeacon sets the latest value of the randomness beacon.
+func (k Keeper) SetBeacon(ctx sdk.Context, beacon types.Beacon) {
+	ctx.KVStore(k.storeKey).Set(types.BeaconStoreKey, k.cdc.MustMarshal(&beacon))
+}
+
+// GetBeacon returns the latest value of the randomness beacon, if any.
+func (k Keeper) GetBeacon(ctx sdk.Context) (beacon types.Beacon, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BeaconStoreKey)
+	if bz == nil {
+		return beacon, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &beacon)
+	return beacon, true
+}
+
+// GetRandomSeed returns the seed for random sampling. It is the latest beacon value, or the rolling
+// seed if no beacon value has been published yet. The latest beacon value is kept as the seed until
+// the next round is published, even if its signing is delayed or the beacon is disabled, so that the
+// block proposer never controls the seed once the first round is published. Callers derive distinct
+// randomness from it with their own nonce, e.g. the request ID or the signing ID.
+//
+// Threshold Schnorr signatures are not unique: the last member to submit its partial signature can
+// compute the beacon value beforehand and withhold it, and the signing retried at the next interval
+// produces a different value. Such a member is only penalized for a missed signing.
+func (k Keeper) GetRandomSeed(ctx sdk.Context) []byte {
+	if beacon, found := k.GetBeacon(ctx); found {
+		return beacon.Value
+	}
+
+	return k.GetRollingSeed(ctx)
+}
@@ -12,6 +12,8 @@ import (
 
 	band "github.com/bandprotocol/chain/v3/app"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/rollingseed/keeper"
+	"github.com/bandprotocol/chain/v3/x/rollingseed/types"
 )
 
 type AppTestSuite struct {
@@ -41,6 +43,50 @@ func (s *AppTestSuite) TestGetSetRollingSeed() {
 	s.Require().Equal(rollingSeed, gotSeed)
 }
 
+func (s *AppTestSuite) TestGetSetBeacon() {
+	ctx, k := s.ctx, s.app.RollingseedKeeper
+
+	_, found := k.GetBeacon(ctx)
+	s.Require().False(found)
+
+	beacon := types.Beacon{Round: 1, Value: []byte("sample-beacon-value"), Height: 10}
+	k.SetBeacon(ctx, beacon)
+
+	gotBeacon, found := k.GetBeacon(ctx)
+	s.Require().True(found)
+	s.Require().Equal(beacon, gotBeacon)
+}
+
+func (s *AppTestSuite) TestGetRandomSeed() {
+	ctx, k := s.ctx, s.app.RollingseedKeeper
+	rollingSeed := []byte("sample-rolling-seed")
+	k.SetRollingSeed(ctx, rollingSeed)
+
+	// Use the rolling seed until a beacon value is published.
+	s.Require().Equal(rollingSeed, k.GetRandomSeed(ctx))
+
+	querier := keeper.Querier{Keeper: k}
+	res, err := querier.RandomSeed(ctx, &types.QueryRandomSeedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryRandomSeedResponse{Seed: rollingSeed}, res)
+
+	_, err = querier.Beacon(ctx, &types.QueryBeaconRequest{})
+	s.Require().ErrorIs(err, types.ErrBeaconNotFound)
+
+	// Use the latest beacon value once it is published.
+	beacon := types.Beacon{Round: 2, Value: []byte("sample-beacon-value"), Height: 10}
+	k.SetBeacon(ctx, beacon)
+	s.Require().Equal([]byte(beacon.Value), k.GetRandomSeed(ctx))
+
+	res, err = querier.RandomSeed(ctx, &types.QueryRandomSeedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryRandomSeedResponse{Seed: beacon.Value, BeaconRound: 2}, res)
+
+	beaconRes, err := querier.Beacon(ctx, &types.QueryBeaconRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryBeaconResponse{Beacon: beacon}, beaconRes)
+}
+
 func TestAppTestSuite(t *testing.T) {
 	suite.Run(t, new(AppTestSuite))
 }
@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/rollingseed module sentinel errors
+var (
+	ErrBeaconNotFound = errorsmod.Register(ModuleName, 2, "beacon not found")
+)
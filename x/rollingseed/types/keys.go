@@ -17,4 +17,5 @@ var (
 
 	// global store keys
 	RollingSeedStoreKey = []byte{0x00}
+	BeaconStoreKey      = []byte{0x01}
 )
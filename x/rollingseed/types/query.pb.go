@@ -0,0 +1,915 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: band/rollingseed/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBeaconRequest is request type for the Query/Beacon RPC method.
+type QueryBeaconRequest struct {
+}
+
+func (m *QueryBeaconRequest) Reset()         { *m = QueryBeaconRequest{} }
+func (m *QueryBeaconRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBeaconRequest) ProtoMessage()    {}
+func (*QueryBeaconRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e076897988edeb35, []int{0}
+}
+func (m *QueryBeaconRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeaconRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeaconRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeaconRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeaconRequest.Merge(m, src)
+}
+func (m *QueryBeaconRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeaconRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeaconRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeaconRequest proto.InternalMessageInfo
+
+// QueryBeaconResponse is response type for the Query/Beacon RPC method.
+type QueryBeaconResponse struct {
+	// beacon is the latest value of the randomness beacon.
+	Beacon Beacon `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon"`
+}
+
+func (m *QueryBeaconResponse) Reset()         { *m = QueryBeaconResponse{} }
+func (m *QueryBeaconResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBeaconResponse) ProtoMessage()    {}
+func (*QueryBeaconResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e076897988edeb35, []int{1}
+}
+func (m *QueryBeaconResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeaconResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeaconResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeaconResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeaconResponse.Merge(m, src)
+}
+func (m *QueryBeaconResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeaconResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeaconResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeaconResponse proto.InternalMessageInfo
+
+func (m *QueryBeaconResponse) GetBeacon() Beacon {
+	if m != nil {
+		return m.Beacon
+	}
+	return Beacon{}
+}
+
+// QueryRandomSeedRequest is request type for the Query/RandomSeed RPC method.
+type QueryRandomSeedRequest struct {
+}
+
+func (m *QueryRandomSeedRequest) Reset()         { *m = QueryRandomSeedRequest{} }
+func (m *QueryRandomSeedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomSeedRequest) ProtoMessage()    {}
+func (*QueryRandomSeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e076897988edeb35, []int{2}
+}
+func (m *QueryRandomSeedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomSeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomSeedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRandomSeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomSeedRequest.Merge(m, src)
+}
+func (m *QueryRandomSeedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomSeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomSeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRandomSeedRequest proto.InternalMessageInfo
+
+// QueryRandomSeedResponse is response type for the Query/RandomSeed RPC method.
+type QueryRandomSeedResponse struct {
+	// seed is the seed currently used for random sampling.
+	Seed github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=seed,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"seed,omitempty"`
+	// beacon_round is the round of the beacon value used as the seed, or zero if the rolling seed is used.
+	BeaconRound uint64 `protobuf:"varint,2,opt,name=beacon_round,json=beaconRound,proto3" json:"beacon_round,omitempty"`
+}
+
+func (m *QueryRandomSeedResponse) Reset()         { *m = QueryRandomSeedResponse{} }
+func (m *QueryRandomSeedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomSeedResponse) ProtoMessage()    {}
+func (*QueryRandomSeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e076897988edeb35, []int{3}
+}
+func (m *QueryRandomSeedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomSeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomSeedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRandomSeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomSeedResponse.Merge(m, src)
+}
+func (m *QueryRandomSeedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomSeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomSeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRandomSeedResponse proto.InternalMessageInfo
+
+func (m *QueryRandomSeedResponse) GetSeed() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *QueryRandomSeedResponse) GetBeaconRound() uint64 {
+	if m != nil {
+		return m.BeaconRound
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryBeaconRequest)(nil), "band.rollingseed.v1beta1.QueryBeaconRequest")
+	proto.RegisterType((*QueryBeaconResponse)(nil), "band.rollingseed.v1beta1.QueryBeaconResponse")
+	proto.RegisterType((*QueryRandomSeedRequest)(nil), "band.rollingseed.v1beta1.QueryRandomSeedRequest")
+	proto.RegisterType((*QueryRandomSeedResponse)(nil), "band.rollingseed.v1beta1.QueryRandomSeedResponse")
+}
+
+func init() {
+	proto.RegisterFile("band/rollingseed/v1beta1/query.proto", fileDescriptor_e076897988edeb35)
+}
+
+var fileDescriptor_e076897988edeb35 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x45, 0x21, 0xc3, 0xb5, 0xd3, 0x51, 0x81, 0x65, 0xc0, 0x35, 0x86, 0x21, 0x42, 0xe0,
+	0x6b, 0xda, 0xce, 0x0c, 0x9e, 0x3a, 0x21, 0x61, 0xc4, 0xc2, 0x52, 0xdd, 0xd9, 0x87, 0x6b, 0xc9,
+	0xb9, 0xe7, 0xfa, 0xce, 0x55, 0xb3, 0x32, 0x30, 0x30, 0x21, 0x31, 0xf2, 0x2f, 0xf8, 0x15, 0x1d,
+	0x23, 0xb1, 0x30, 0x45, 0x28, 0xe1, 0x57, 0x30, 0xa1, 0x3b, 0x1b, 0x25, 0x51, 0x12, 0x41, 0xb7,
+	0xa7, 0xef, 0xbe, 0xf7, 0x7d, 0xdf, 0x7b, 0xf7, 0xf0, 0x53, 0xce, 0x64, 0x46, 0x6b, 0x28, 0xcb,
+	0x42, 0xe6, 0x4a, 0x88, 0x8c, 0x5e, 0x8d, 0xb8, 0xd0, 0x6c, 0x44, 0x2f, 0x1b, 0x51, 0x4f, 0xa2,
+	0xaa, 0x06, 0x0d, 0xc4, 0x35, 0xac, 0x68, 0x85, 0x15, 0x75, 0x2c, 0xef, 0x20, 0x87, 0x1c, 0x2c,
+	0x89, 0x9a, 0xaa, 0xe5, 0x7b, 0x0f, 0x73, 0x80, 0xbc, 0x14, 0x94, 0x55, 0x05, 0x65, 0x52, 0x82,
+	0x66, 0xba, 0x00, 0xa9, 0xba, 0xd7, 0x67, 0x3b, 0x3d, 0x57, 0x1d, 0x2c, 0x37, 0x3c, 0xc0, 0xe4,
+	0xb5, 0x09, 0x12, 0x0b, 0x96, 0x82, 0x4c, 0xc4, 0x65, 0x23, 0x94, 0x0e, 0xdf, 0xe2, 0xbb, 0x6b,
+	0xa8, 0xaa, 0x40, 0x2a, 0x41, 0x5e, 0xe2, 0x01, 0xb7, 0x88, 0x8b, 0x02, 0x34, 0xdc, 0x3b, 0x0e,
+	0xa2, 0x5d, 0xb9, 0xa3, 0xb6, 0x33, 0xee, 0xdf, 0xcc, 0x0e, 0x9d, 0xa4, 0xeb, 0x0a, 0x5d, 0x7c,
+	0xcf, 0xca, 0x26, 0x4c, 0x66, 0x30, 0x7e, 0x23, 0x44, 0xf6, 0xd7, 0xf0, 0x23, 0xc2, 0xf7, 0x37,
+	0x9e, 0x3a, 0xd7, 0x33, 0xdc, 0x37, 0xd2, 0xd6, 0x73, 0x3f, 0x3e, 0xfd, 0x3d, 0x3b, 0x3c, 0xca,
+	0x0b, 0x7d, 0xd1, 0xf0, 0x28, 0x85, 0x31, 0x4d, 0x61, 0x2c, 0x34, 0x7f, 0xaf, 0x97, 0x45, 0x59,
+	0x70, 0x45, 0xf9, 0x44, 0x0b, 0x15, 0x9d, 0x89, 0xeb, 0xd8, 0x14, 0x89, 0x55, 0x20, 0x8f, 0xf1,
+	0x7e, 0x9b, 0xe4, 0xbc, 0x86, 0x46, 0x66, 0x6e, 0x2f, 0x40, 0xc3, 0x7e, 0xb2, 0xd7, 0x62, 0x89,
+	0x81, 0x8e, 0xbf, 0xf5, 0xf0, 0x1d, 0x1b, 0x84, 0x7c, 0x42, 0x78, 0xd0, 0x4e, 0x41, 0x9e, 0xef,
+	0x9e, 0x73, 0x73, 0x79, 0xde, 0x8b, 0xff, 0x64, 0xb7, 0xe3, 0x85, 0x4f, 0x3e, 0x7c, 0xff, 0xf5,
+	0xa5, 0xf7, 0x88, 0x3c, 0xd8, 0xfa, 0x63, 0x6d, 0x36, 0xf2, 0x15, 0x61, 0xbc, 0x5c, 0x0d, 0x39,
+	0xfa, 0x87, 0xc5, 0xc6, 0x82, 0xbd, 0xd1, 0x2d, 0x3a, 0xba, 0x60, 0x43, 0x1b, 0x2c, 0x24, 0xc1,
+	0xf6, 0x53, 0xb2, 0x0d, 0xe7, 0x06, 0x8b, 0x5f, 0xdd, 0xcc, 0x7d, 0x34, 0x9d, 0xfb, 0xe8, 0xe7,
+	0xdc, 0x47, 0x9f, 0x17, 0xbe, 0x33, 0x5d, 0xf8, 0xce, 0x8f, 0x85, 0xef, 0xbc, 0x3b, 0x5d, 0xf9,
+	0x29, 0x13, 0xc0, 0x1e, 0x5d, 0x0a, 0x25, 0x4d, 0x2f, 0x58, 0x21, 0xe9, 0xd5, 0x09, 0xbd, 0x5e,
+	0x53, 0xd7, 0x93, 0x4a, 0x28, 0x3e, 0xb0, 0xb4, 0x93, 0x3f, 0x03, 0x00, 0xc4, 0x34, 0x75, 0xee,
+	0x3d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Beacon queries the latest value of the randomness beacon.
+	Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error)
+	// RandomSeed queries the seed currently used for random sampling.
+	RandomSeed(ctx context.Context, in *QueryRandomSeedRequest, opts ...grpc.CallOption) (*QueryRandomSeedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error) {
+	out := new(QueryBeaconResponse)
+	err := c.cc.Invoke(ctx, "/band.rollingseed.v1beta1.Query/Beacon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RandomSeed(ctx context.Context, in *QueryRandomSeedRequest, opts ...grpc.CallOption) (*QueryRandomSeedResponse, error) {
+	out := new(QueryRandomSeedResponse)
+	err := c.cc.Invoke(ctx, "/band.rollingseed.v1beta1.Query/RandomSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Beacon queries the latest value of the randomness beacon.
+	Beacon(context.Context, *QueryBeaconRequest) (*QueryBeaconResponse, error)
+	// RandomSeed queries the seed currently used for random sampling.
+	RandomSeed(context.Context, *QueryRandomSeedRequest) (*QueryRandomSeedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Beacon(ctx context.Context, req *QueryBeaconRequest) (*QueryBeaconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Beacon not implemented")
+}
+func (*UnimplementedQueryServer) RandomSeed(ctx context.Context, req *QueryRandomSeedRequest) (*QueryRandomSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomSeed not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Beacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeaconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Beacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.rollingseed.v1beta1.Query/Beacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Beacon(ctx, req.(*QueryBeaconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.rollingseed.v1beta1.Query/RandomSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomSeed(ctx, req.(*QueryRandomSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.rollingseed.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Beacon",
+			Handler:    _Query_Beacon_Handler,
+		},
+		{
+			MethodName: "RandomSeed",
+			Handler:    _Query_RandomSeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/rollingseed/v1beta1/query.proto",
+}
+
+func (m *QueryBeaconRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeaconRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeaconRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeaconResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeaconResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeaconResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Beacon.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomSeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomSeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomSeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomSeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BeaconRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeaconRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBeaconRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBeaconResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Beacon.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRandomSeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRandomSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BeaconRound != 0 {
+		n += 1 + sovQuery(uint64(m.BeaconRound))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBeaconRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeaconRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeaconRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeaconResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeaconResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconRound", wireType)
+			}
+			m.BeaconRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -478,7 +478,7 @@ func (s *KeeperTestSuite) TestGRPCQuerySigning() {
 	mockSig := []byte("signatures")
 
 	s.rollingseedKeeper.EXPECT().
-		GetRollingSeed(gomock.Any()).
+		GetRandomSeed(gomock.Any()).
 		Return([]byte("long_enough_rolling_seed")).
 		AnyTimes()
 
@@ -28,16 +28,19 @@ func (k Keeper) ProcessComplaint(
 		var complaintStatus types.ComplaintStatus
 		var eventComplainStatus string
 		var maliciousMemberID tss.MemberID
+		var misbehaviorType types.MisbehaviorType
 
 		// Verify complaint, if error mark complainant as malicious
 		if err := k.VerifyComplaint(ctx, groupID, c); err != nil {
 			maliciousMemberID = c.Complainant
 			complaintStatus = types.COMPLAINT_STATUS_FAILED
 			eventComplainStatus = types.EventTypeComplainFailed
+			misbehaviorType = types.MISBEHAVIOR_TYPE_INVALID_COMPLAINT
 		} else {
 			maliciousMemberID = c.Respondent
 			complaintStatus = types.COMPLAINT_STATUS_SUCCESS
 			eventComplainStatus = types.EventTypeComplainSuccess
+			misbehaviorType = types.MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE
 		}
 
 		// mark user as malicious
@@ -45,6 +48,11 @@ func (k Keeper) ProcessComplaint(
 			return nil, err
 		}
 
+		// report the misbehavior to the owner module of the group
+		if err := k.HandleMemberMisbehavior(ctx, groupID, maliciousMemberID, misbehaviorType, 0); err != nil {
+			return nil, err
+		}
+
 		// Add complaint status
 		complaintsWithStatus = append(complaintsWithStatus, types.NewComplaintWithStatus(c, complaintStatus))
 
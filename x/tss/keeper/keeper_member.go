@@ -86,9 +86,9 @@ func (k Keeper) GetRandomMembers(
 		)
 	}
 
-	// Create a deterministic random number generator (DRBG) using the rolling seed, signingID, and chain ID.
+	// Create a deterministic random number generator (DRBG) using the random seed, signingID, and chain ID.
 	rng, err := bandrng.NewRng(
-		k.rollingseedKeeper.GetRollingSeed(ctx),
+		k.rollingseedKeeper.GetRandomSeed(ctx),
 		nonce,
 		[]byte(ctx.ChainID()),
 	)
@@ -176,6 +176,47 @@ func (k Keeper) MarkMemberMalicious(ctx sdk.Context, groupID tss.GroupID, member
 	return nil
 }
 
+// HandleMemberMisbehavior emits an event and notifies the owner module of the group that the
+// given member is proven to misbehave, so that the owner module can apply its penalty.
+func (k Keeper) HandleMemberMisbehavior(
+	ctx sdk.Context,
+	groupID tss.GroupID,
+	memberID tss.MemberID,
+	misbehaviorType types.MisbehaviorType,
+	signingID tss.SigningID,
+) error {
+	member, err := k.GetMember(ctx, groupID, memberID)
+	if err != nil {
+		return err
+	}
+
+	group, err := k.GetGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if cb, ok := k.cbRouter.GetRoute(group.ModuleOwner); ok {
+		cb.OnMemberMisbehaved(
+			ctx,
+			groupID,
+			sdk.MustAccAddressFromBech32(member.Address),
+			misbehaviorType,
+			signingID,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMemberMisbehaved,
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		sdk.NewAttribute(types.AttributeKeyMemberID, fmt.Sprintf("%d", memberID)),
+		sdk.NewAttribute(types.AttributeKeyAddress, member.Address),
+		sdk.NewAttribute(types.AttributeKeyMisbehavior, misbehaviorType.String()),
+		sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", signingID)),
+	))
+
+	return nil
+}
+
 // =====================================
 // Member activation
 // =====================================
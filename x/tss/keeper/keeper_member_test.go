@@ -120,7 +120,7 @@ func (s *KeeperTestSuite) TestGetRandomMembersSuccess() {
 	// create a group context and mock the result.
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	// call GetRandomMembers and check results
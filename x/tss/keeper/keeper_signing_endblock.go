@@ -105,9 +105,11 @@ func (k Keeper) HandleFailedSigning(ctx sdk.Context, signingID tss.SigningID, re
 	)
 }
 
-// DeleteInterimSigningData deletes the interim signing data from the store.
+// DeleteInterimSigningData deletes the interim signing data from the store. The misbehavior reports
+// of the signing are deleted as well, as the next attempt, if any, is only created after this.
 func (k Keeper) DeleteInterimSigningData(ctx sdk.Context, signingID tss.SigningID, attempt uint64) {
 	k.DeletePartialSignatures(ctx, signingID, attempt)
+	k.DeleteMisbehaviorReports(ctx, signingID)
 	k.DeletePartialSignatureCount(ctx, signingID, attempt)
 	k.DeleteSigningAttempt(ctx, signingID, attempt)
 }
@@ -34,7 +34,7 @@ func (s *KeeperTestSuite) TestAggregatePartialSignatures() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	originator := types.NewDirectOriginator(
@@ -75,7 +75,7 @@ func (s *KeeperTestSuite) TestHandleFailedSigning() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	originator := types.NewDirectOriginator(
@@ -137,7 +137,7 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockMembersSubmitSignature() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
 
 	originator := types.NewDirectOriginator(
@@ -211,7 +211,7 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockTimeoutSigning() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
 
 	originator := types.NewDirectOriginator(
@@ -227,6 +227,7 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockTimeoutSigning() {
 		&types.TextSignatureOrder{Message: []byte("test")},
 	)
 	s.Require().NoError(err)
+	k.SetMisbehaviorReported(ctx, signingID, 1)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(k.GetParams(ctx).SigningPeriod))
 
@@ -239,6 +240,9 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockTimeoutSigning() {
 	s.Require().Nil(signing.Signature)
 	s.Require().Equal(uint64(2), signing.CurrentAttempt)
 
+	// the misbehavior reports are deleted along with the interim data of the expired attempt.
+	s.Require().False(k.IsMisbehaviorReported(ctx, signingID, 1))
+
 	// check signingID interim data
 	sa, err := k.GetSigningAttempt(ctx, signing.ID, signing.CurrentAttempt)
 	s.Require().NoError(err)
@@ -263,7 +267,7 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockFailAggregate() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
 
 	originator := types.NewDirectOriginator(
@@ -341,7 +345,7 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockFailAggregateAndExpired() {
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
 
 	originator := types.NewDirectOriginator(
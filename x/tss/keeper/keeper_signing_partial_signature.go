@@ -170,3 +170,29 @@ func (k Keeper) AddPartialSignatureCount(ctx sdk.Context, signingID tss.SigningI
 func (k Keeper) DeletePartialSignatureCount(ctx sdk.Context, signingID tss.SigningID, attempt uint64) {
 	ctx.KVStore(k.storeKey).Delete(types.PartialSignatureCountStoreKey(signingID, attempt))
 }
+
+// ==================================
+// Misbehavior report Store
+// ==================================
+
+// SetMisbehaviorReported marks that the misbehavior of the given member on the signing is reported,
+// so that the same member is not penalized twice for the same signing.
+func (k Keeper) SetMisbehaviorReported(ctx sdk.Context, signingID tss.SigningID, memberID tss.MemberID) {
+	ctx.KVStore(k.storeKey).Set(types.MisbehaviorReportStoreKey(signingID, memberID), []byte{0x01})
+}
+
+// IsMisbehaviorReported checks whether the misbehavior of the given member on the signing is reported.
+func (k Keeper) IsMisbehaviorReported(ctx sdk.Context, signingID tss.SigningID, memberID tss.MemberID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MisbehaviorReportStoreKey(signingID, memberID))
+}
+
+// DeleteMisbehaviorReports deletes all misbehavior reports of the signing from the store.
+func (k Keeper) DeleteMisbehaviorReports(ctx sdk.Context, signingID tss.SigningID) {
+	prefixKey := types.MisbehaviorReportsStoreKey(signingID)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ctx.KVStore(k.storeKey).Delete(iterator.Key())
+	}
+}
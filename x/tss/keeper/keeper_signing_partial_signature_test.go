@@ -183,3 +183,17 @@ func (s *KeeperTestSuite) TestDeletePartialSignatures() {
 	_, err = k.GetPartialSignature(ctx, 1, 2, 1)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestDeleteMisbehaviorReports() {
+	ctx, k := s.ctx, s.keeper
+
+	k.SetMisbehaviorReported(ctx, 1, 1)
+	k.SetMisbehaviorReported(ctx, 1, 2)
+	k.SetMisbehaviorReported(ctx, 2, 1)
+
+	k.DeleteMisbehaviorReports(ctx, 1)
+
+	s.Require().False(k.IsMisbehaviorReported(ctx, 1, 1))
+	s.Require().False(k.IsMisbehaviorReported(ctx, 1, 2))
+	s.Require().True(k.IsMisbehaviorReported(ctx, 2, 1))
+}
@@ -16,7 +16,7 @@ func (s *KeeperTestSuite) TestAssignedMembers() {
 	// create a group context and mock the result.
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	DEQueuesBeforePolling := []types.DEQueue{}
@@ -48,7 +48,7 @@ func (s *KeeperTestSuite) TestInitiateNewSigningRoundForFirstRound() {
 	// create a group context and mock the result.
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	signingID, err := k.CreateSigning(ctx, groupCtx.GroupID, []byte("originator"), []byte("message"))
@@ -87,7 +87,7 @@ func (s *KeeperTestSuite) TestInitiateNewSigningRoundForThirdRound() {
 	// create a group context and mock the result.
 	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
 	s.Require().NoError(err)
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	signingID, err := k.CreateSigning(ctx, groupCtx.GroupID, []byte("originator"), []byte("message"))
@@ -154,7 +154,7 @@ func (s *KeeperTestSuite) TestRequestSigning() {
 	groupID := groupCtx.GroupID
 	s.Require().NoError(err)
 
-	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+	s.rollingseedKeeper.EXPECT().GetRandomSeed(gomock.Any()).
 		Return([]byte("RandomStringThatShouldBeLongEnough"))
 
 	// Create a new request for the request signature
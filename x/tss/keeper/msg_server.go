@@ -372,6 +372,15 @@ func (k msgServer) SubmitSignature(
 		)
 	}
 
+	// Check member is already reported as misbehaving on this signing
+	if k.Keeper.IsMisbehaviorReported(ctx, req.SigningID, req.MemberID) {
+		return nil, types.ErrMisbehaviorAlreadyReported.Wrapf(
+			"member ID %d already reported on signing ID: %d",
+			req.MemberID,
+			req.SigningID,
+		)
+	}
+
 	// Verify signature R
 	if !assignedMembers.VerifySignatureR(req.MemberID, req.Signature.R()) {
 		return nil, types.ErrSubmitSigningSignatureFailed.Wrapf(
@@ -397,9 +406,22 @@ func (k msgServer) SubmitSignature(
 		req.Signature,
 		am.PubKey,
 	); err != nil {
-		return nil, types.ErrSubmitSigningSignatureFailed.Wrapf(
-			"failed to verify signing signature: %v", err,
+		// The partial signature is submitted by the assigned member itself, so it is a proof of
+		// misbehavior. Report it instead of rejecting the message so that the evidence is kept;
+		// the partial signature is not stored and the attempt will eventually time out.
+		k.Keeper.SetMisbehaviorReported(ctx, req.SigningID, req.MemberID)
+		err = k.Keeper.HandleMemberMisbehavior(
+			ctx,
+			signing.GroupID,
+			req.MemberID,
+			types.MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE,
+			req.SigningID,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSubmitSignatureResponse{}, nil
 	}
 
 	// Add partial signature
@@ -591,7 +591,7 @@ func (s *KeeperTestSuite) TestSuccessSubmitSignatureReq() {
 	for i, tc := range testutil.TestCases {
 		s.rollingseedKeeper.
 			EXPECT().
-			GetRollingSeed(gomock.Any()).
+			GetRandomSeed(gomock.Any()).
 			Return([]byte("RandomStringThatShouldBeLongEnough")).
 			AnyTimes()
 
@@ -669,6 +669,87 @@ func (s *KeeperTestSuite) TestSuccessSubmitSignatureReq() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSubmitInvalidPartialSignature() {
+	ctx, msgSrvr, k := s.ctx, s.msgServer, s.keeper
+	tc := testutil.TestCases[0]
+
+	s.rollingseedKeeper.
+		EXPECT().
+		GetRandomSeed(gomock.Any()).
+		Return([]byte("RandomStringThatShouldBeLongEnough")).
+		AnyTimes()
+
+	// Setup group to GROUP_STATUS_ACTIVE
+	s.SetupWithPreparedTestCase(0, types.GROUP_STATUS_ACTIVE)
+
+	originator := types.NewDirectOriginator(
+		"targetChain",
+		"band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs",
+		"test",
+	)
+
+	signingID, err := k.RequestSigning(ctx, tc.Group.ID, &originator, types.NewTextSignatureOrder([]byte("msg")))
+	s.Require().NoError(err)
+
+	signing, err := k.GetSigning(ctx, signingID)
+	s.Require().NoError(err)
+
+	group, err := k.GetGroup(ctx, tc.Group.ID)
+	s.Require().NoError(err)
+
+	sa, err := k.GetSigningAttempt(ctx, signing.ID, signing.CurrentAttempt)
+	s.Require().NoError(err)
+	assignedMembers := types.AssignedMembers(sa.AssignedMembers)
+	am := assignedMembers[0]
+
+	lgc, err := tss.ComputeLagrangeCoefficient(am.MemberID, assignedMembers.MemberIDs())
+	s.Require().NoError(err)
+
+	pn, err := tss.ComputeOwnPrivNonce(PrivD, PrivE, am.BindingFactor)
+	s.Require().NoError(err)
+
+	// Sign a different message with the correct nonce, so that only the signature verification fails.
+	signature, err := tss.SignSigning(
+		signing.GroupPubNonce,
+		group.PubKey,
+		[]byte("wrong message"),
+		lgc,
+		pn,
+		tc.Group.GetMember(am.MemberID).PrivKey,
+	)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgSrvr.SubmitSignature(ctx, &types.MsgSubmitSignature{
+		SigningID: signingID,
+		MemberID:  am.MemberID,
+		Signature: signature,
+		Signer:    sdk.AccAddress(tc.Group.GetMember(am.MemberID).PubKey()).String(),
+	})
+	s.Require().NoError(err)
+
+	// The invalid partial signature is not stored but the misbehavior is reported.
+	s.Require().False(k.HasPartialSignature(ctx, signingID, signing.CurrentAttempt, am.MemberID))
+	s.Require().Equal(uint64(0), k.GetPartialSignatureCount(ctx, signingID, signing.CurrentAttempt))
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMemberMisbehaved {
+			found = true
+		}
+	}
+	s.Require().True(found)
+
+	// Resubmitting the same invalid partial signature is rejected instead of being reported again.
+	_, err = msgSrvr.SubmitSignature(ctx, &types.MsgSubmitSignature{
+		SigningID: signingID,
+		MemberID:  am.MemberID,
+		Signature: signature,
+		Signer:    sdk.AccAddress(tc.Group.GetMember(am.MemberID).PubKey()).String(),
+	})
+	s.Require().ErrorIs(err, types.ErrMisbehaviorAlreadyReported)
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	k, msgSrvr := s.keeper, s.msgServer
 
@@ -96,16 +96,16 @@ func (m *MockRollingseedKeeper) EXPECT() *MockRollingseedKeeperMockRecorder {
 	return m.recorder
 }
 
-// GetRollingSeed mocks base method.
-func (m *MockRollingseedKeeper) GetRollingSeed(ctx types.Context) []byte {
+// GetRandomSeed mocks base method.
+func (m *MockRollingseedKeeper) GetRandomSeed(ctx types.Context) []byte {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRollingSeed", ctx)
+	ret := m.ctrl.Call(m, "GetRandomSeed", ctx)
 	ret0, _ := ret[0].([]byte)
 	return ret0
 }
 
-// GetRollingSeed indicates an expected call of GetRollingSeed.
-func (mr *MockRollingseedKeeperMockRecorder) GetRollingSeed(ctx any) *gomock.Call {
+// GetRandomSeed indicates an expected call of GetRandomSeed.
+func (mr *MockRollingseedKeeperMockRecorder) GetRandomSeed(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollingSeed", reflect.TypeOf((*MockRollingseedKeeper)(nil).GetRollingSeed), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRandomSeed", reflect.TypeOf((*MockRollingseedKeeper)(nil).GetRandomSeed), ctx)
 }
@@ -87,4 +87,14 @@ type TSSCallback interface {
 
 	// Must be called before a retry that occurs due to expiration of the signing process.
 	OnSigningTimeout(ctx sdk.Context, signingID tss.SigningID, idleMembers []sdk.AccAddress)
+
+	// Must be called after a member is proven to misbehave. The signingID is zero if the
+	// misbehavior is not related to any signing.
+	OnMemberMisbehaved(
+		ctx sdk.Context,
+		groupID tss.GroupID,
+		address sdk.AccAddress,
+		misbehaviorType MisbehaviorType,
+		signingID tss.SigningID,
+	)
 }
@@ -55,4 +55,5 @@ var (
 	ErrInvalidGroup                 = errorsmod.Register(ModuleName, 46, "invalid group")
 	ErrInvalidSigning               = errorsmod.Register(ModuleName, 47, "invalid signing")
 	ErrCreateSigningFailed          = errorsmod.Register(ModuleName, 48, "failed to create signing")
+	ErrMisbehaviorAlreadyReported   = errorsmod.Register(ModuleName, 49, "misbehavior already reported")
 )
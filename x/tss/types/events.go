@@ -19,6 +19,7 @@ const (
 	EventTypeSubmitSignature   = "submit_signature"
 	EventTypeDEDeleted         = "de_deleted"
 	EventTypeSetMemberIsActive = "set_member_is_active"
+	EventTypeMemberMisbehaved  = "member_misbehaved"
 
 	AttributeKeyGroupID        = "group_id"
 	AttributeKeyMemberID       = "member_id"
@@ -50,4 +51,5 @@ const (
 	AttributeKeyOriginator     = "originator"
 	AttributeKeyOriginatorType = "originator_type"
 	AttributeKeyMemberStatus   = "member_status"
+	AttributeKeyMisbehavior    = "misbehavior"
 )
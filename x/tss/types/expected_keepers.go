@@ -26,5 +26,5 @@ type AuthzKeeper interface {
 
 // RollingseedKeeper defines the expected rollingseed keeper
 type RollingseedKeeper interface {
-	GetRollingSeed(ctx sdk.Context) []byte
+	GetRandomSeed(ctx sdk.Context) []byte
 }
@@ -55,6 +55,7 @@ var (
 	PartialSignatureCountStoreKeyPrefix = []byte{0x1e}
 	PartialSignatureStoreKeyPrefix      = []byte{0x1f}
 	SigningAttemptStoreKeyPrefix        = []byte{0x20}
+	MisbehaviorReportStoreKeyPrefix     = []byte{0x21}
 
 	// param store key
 	ParamsKey = []byte{0x90}
@@ -221,6 +222,17 @@ func SigningAttemptStoreKey(signingID tss.SigningID, attempt uint64) []byte {
 	return append(SigningAttemptsStoreKey(signingID), sdk.Uint64ToBigEndian(attempt)...)
 }
 
+// MisbehaviorReportsStoreKey returns the prefix key for MisbehaviorReportStoreKey.
+func MisbehaviorReportsStoreKey(signingID tss.SigningID) []byte {
+	return append(MisbehaviorReportStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(signingID))...)
+}
+
+// MisbehaviorReportStoreKey returns the key for storing whether the misbehavior of a given member
+// on the signing is already reported.
+func MisbehaviorReportStoreKey(signingID tss.SigningID, memberID tss.MemberID) []byte {
+	return append(MisbehaviorReportsStoreKey(signingID), sdk.Uint64ToBigEndian(uint64(memberID))...)
+}
+
 // MemberIDFromPartialSignatureStoreKey returns the memberID that is retrieved from the key.
 func MemberIDFromPartialSignatureStoreKey(key []byte) tss.MemberID {
 	kv.AssertKeyLength(key, 1+3*uint64Len)
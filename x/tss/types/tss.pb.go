@@ -147,6 +147,50 @@ func (ComplaintStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_26231ff63bcc8f4b, []int{2}
 }
 
+// MisbehaviorType is an enumeration of the provable misbehaviors of a group member.
+type MisbehaviorType int32
+
+const (
+	// MISBEHAVIOR_TYPE_UNSPECIFIED represents an undefined misbehavior.
+	MISBEHAVIOR_TYPE_UNSPECIFIED MisbehaviorType = 0
+	// MISBEHAVIOR_TYPE_INVALID_COMPLAINT represents a complaint that fails verification, which
+	// proves that the complainant accused an honest member.
+	MISBEHAVIOR_TYPE_INVALID_COMPLAINT MisbehaviorType = 1
+	// MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE represents a successful complaint, which proves that
+	// the respondent sent an invalid encrypted secret share.
+	MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE MisbehaviorType = 2
+	// MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE represents a partial signature that fails
+	// verification against the member's public key.
+	MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE MisbehaviorType = 3
+	// MISBEHAVIOR_TYPE_NON_PARTICIPATION represents a member that repeatedly fails to submit
+	// its partial signature before the signing expires.
+	MISBEHAVIOR_TYPE_NON_PARTICIPATION MisbehaviorType = 4
+)
+
+var MisbehaviorType_name = map[int32]string{
+	0: "MISBEHAVIOR_TYPE_UNSPECIFIED",
+	1: "MISBEHAVIOR_TYPE_INVALID_COMPLAINT",
+	2: "MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE",
+	3: "MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE",
+	4: "MISBEHAVIOR_TYPE_NON_PARTICIPATION",
+}
+
+var MisbehaviorType_value = map[string]int32{
+	"MISBEHAVIOR_TYPE_UNSPECIFIED":               0,
+	"MISBEHAVIOR_TYPE_INVALID_COMPLAINT":         1,
+	"MISBEHAVIOR_TYPE_INVALID_SECRET_SHARE":      2,
+	"MISBEHAVIOR_TYPE_INVALID_PARTIAL_SIGNATURE": 3,
+	"MISBEHAVIOR_TYPE_NON_PARTICIPATION":         4,
+}
+
+func (x MisbehaviorType) String() string {
+	return proto.EnumName(MisbehaviorType_name, int32(x))
+}
+
+func (MisbehaviorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_26231ff63bcc8f4b, []int{3}
+}
+
 // Group is a type representing a participant group in a Distributed Key Generation or signing process.
 type Group struct {
 	// id is the unique identifier of the group.
@@ -1714,6 +1758,7 @@ func init() {
 	proto.RegisterEnum("band.tss.v1beta1.SigningStatus", SigningStatus_name, SigningStatus_value)
 	proto.RegisterEnum("band.tss.v1beta1.GroupStatus", GroupStatus_name, GroupStatus_value)
 	proto.RegisterEnum("band.tss.v1beta1.ComplaintStatus", ComplaintStatus_name, ComplaintStatus_value)
+	proto.RegisterEnum("band.tss.v1beta1.MisbehaviorType", MisbehaviorType_name, MisbehaviorType_value)
 	proto.RegisterType((*Group)(nil), "band.tss.v1beta1.Group")
 	proto.RegisterType((*GroupResult)(nil), "band.tss.v1beta1.GroupResult")
 	proto.RegisterType((*Round1Info)(nil), "band.tss.v1beta1.Round1Info")
@@ -1742,133 +1787,139 @@ func init() {
 func init() { proto.RegisterFile("band/tss/v1beta1/tss.proto", fileDescriptor_26231ff63bcc8f4b) }
 
 var fileDescriptor_26231ff63bcc8f4b = []byte{
-	// 2007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0xd9, 0x92, 0x9e, 0x64, 0x5b, 0x3b, 0x71, 0x62, 0xd9, 0x71, 0x25, 0x87, 0xc5,
-	0x76, 0x8d, 0x45, 0x6b, 0xc5, 0x72, 0xb7, 0xdd, 0x6e, 0x16, 0x48, 0x25, 0x4b, 0xf1, 0x6a, 0xe3,
-	0xd8, 0x0e, 0x25, 0x27, 0x5b, 0x17, 0x5b, 0x82, 0x22, 0xc7, 0x12, 0x61, 0x91, 0x54, 0x39, 0x23,
-	0xc7, 0xee, 0xa5, 0xd7, 0x1c, 0x73, 0xda, 0x73, 0x81, 0xf6, 0x50, 0xec, 0xb9, 0xfd, 0x0e, 0x41,
-	0x7b, 0x59, 0xa0, 0x28, 0xb0, 0xbd, 0x28, 0x85, 0x82, 0xa2, 0xe8, 0xad, 0x67, 0x9f, 0x0a, 0x0e,
-	0x87, 0xa4, 0x64, 0xc9, 0x59, 0x47, 0x4a, 0x82, 0xde, 0x38, 0xef, 0xbd, 0xf9, 0xcd, 0x9b, 0x37,
-	0xef, 0xaf, 0x04, 0xcb, 0x75, 0xc5, 0xd4, 0x72, 0x94, 0x90, 0xdc, 0xc9, 0x46, 0x1d, 0x53, 0x65,
-	0xc3, 0xf9, 0x5e, 0x6f, 0xdb, 0x16, 0xb5, 0x50, 0xca, 0xe1, 0xad, 0x3b, 0x6b, 0xce, 0x5b, 0x5e,
-	0x68, 0x58, 0x0d, 0x8b, 0x31, 0x73, 0xce, 0x97, 0x2b, 0xb7, 0xbc, 0xa4, 0x5a, 0xc4, 0xb0, 0x88,
-	0xec, 0x32, 0xdc, 0x05, 0x67, 0x65, 0x1b, 0x96, 0xd5, 0x68, 0xe1, 0x1c, 0x5b, 0xd5, 0x3b, 0x47,
-	0x39, 0xaa, 0x1b, 0x98, 0x50, 0xc5, 0x68, 0xbb, 0x02, 0xe2, 0xdf, 0x42, 0x30, 0xbd, 0x6d, 0x5b,
-	0x9d, 0x36, 0xfa, 0x1c, 0x42, 0xba, 0x96, 0x16, 0x56, 0x85, 0xb5, 0x48, 0xf1, 0x93, 0x5e, 0x37,
-	0x1b, 0xaa, 0x94, 0xce, 0xbb, 0xd9, 0xdb, 0x0d, 0x9d, 0x36, 0x3b, 0xf5, 0x75, 0xd5, 0x32, 0x72,
-	0x8e, 0x3a, 0x6c, 0x97, 0x6a, 0xb5, 0x72, 0x6a, 0x53, 0xd1, 0xcd, 0xdc, 0xc9, 0x66, 0xae, 0x7d,
-	0xdc, 0x60, 0x3a, 0x33, 0x94, 0x4a, 0x49, 0x0a, 0xe9, 0x1a, 0x42, 0x10, 0x21, 0xfa, 0x6f, 0x70,
-	0x3a, 0xe4, 0xa0, 0x49, 0xec, 0x1b, 0xad, 0x40, 0x9c, 0x36, 0x6d, 0x4c, 0x9a, 0x56, 0x4b, 0x4b,
-	0x87, 0x19, 0x23, 0x20, 0xa0, 0xfb, 0x10, 0x6d, 0x77, 0xea, 0xf2, 0x31, 0x3e, 0x4b, 0x47, 0x56,
-	0x85, 0xb5, 0x64, 0x31, 0x7f, 0xde, 0xcd, 0xae, 0x5f, 0xf9, 0xf0, 0x7d, 0x4b, 0x37, 0xa9, 0x34,
-	0xd3, 0xee, 0xd4, 0xef, 0xe3, 0x33, 0xf4, 0x11, 0xcc, 0x10, 0xaa, 0xd0, 0x0e, 0x49, 0x4f, 0xaf,
-	0x0a, 0x6b, 0x73, 0xf9, 0xef, 0xad, 0x5f, 0xb4, 0xa4, 0xab, 0x6d, 0x95, 0x09, 0x49, 0x5c, 0x18,
-	0xbd, 0x0f, 0x73, 0xaa, 0x8d, 0x15, 0x8a, 0x35, 0xb9, 0x89, 0xf5, 0x46, 0x93, 0xa6, 0x67, 0x98,
-	0x9a, 0xb3, 0x9c, 0xfa, 0x19, 0x23, 0xa2, 0x5b, 0x90, 0x34, 0x2c, 0xad, 0xd3, 0xc2, 0xb2, 0xf5,
-	0xc4, 0xc4, 0x76, 0x3a, 0xba, 0x2a, 0xac, 0xc5, 0xa5, 0x84, 0x4b, 0xdb, 0x73, 0x48, 0xe2, 0xb3,
-	0x08, 0x24, 0xd8, 0x09, 0x12, 0x26, 0x9d, 0x16, 0x45, 0x9b, 0x30, 0xdd, 0x70, 0x96, 0xcc, 0xbc,
-	0x89, 0xfc, 0xe2, 0x25, 0xfa, 0x14, 0x23, 0xcf, 0xbb, 0xd9, 0x29, 0xc9, 0x95, 0x45, 0x2a, 0x24,
-	0xb4, 0xe3, 0x86, 0xac, 0x5a, 0x26, 0xc5, 0xa7, 0x94, 0xd9, 0x32, 0x59, 0x2c, 0xf6, 0xba, 0x59,
-	0x28, 0xdd, 0xdf, 0xde, 0x72, 0xa9, 0x17, 0x5e, 0x48, 0xb5, 0x0c, 0x4c, 0xeb, 0x47, 0x34, 0xf8,
-	0x68, 0xe9, 0x75, 0x92, 0xab, 0x9f, 0x51, 0x4c, 0xd6, 0x3f, 0xc3, 0xa7, 0x45, 0xe7, 0x43, 0x02,
-	0xed, 0xb8, 0xc1, 0xf7, 0xa3, 0x8f, 0x21, 0x6a, 0x60, 0xa3, 0x8e, 0x6d, 0x92, 0x0e, 0xaf, 0x86,
-	0xd7, 0x12, 0xf9, 0xf4, 0xb0, 0x6e, 0x0f, 0x98, 0x00, 0x57, 0xce, 0x13, 0x47, 0x65, 0x48, 0xda,
-	0x56, 0xc7, 0xd4, 0x36, 0x64, 0xdd, 0x3c, 0xb2, 0x48, 0x3a, 0xc2, 0xb6, 0xaf, 0x0c, 0x6f, 0x97,
-	0x98, 0x54, 0xc5, 0x3c, 0xb2, 0x38, 0x44, 0xc2, 0xf6, 0x29, 0x01, 0x4c, 0x9e, 0xc3, 0x4c, 0xbf,
-	0x12, 0x26, 0x3f, 0x04, 0x93, 0x77, 0x61, 0xea, 0x70, 0x43, 0xb5, 0x8c, 0x76, 0x4b, 0xd1, 0x4d,
-	0x4a, 0xe4, 0x27, 0x3a, 0x6d, 0xca, 0xdc, 0x05, 0x66, 0x18, 0xe0, 0x0f, 0x86, 0x01, 0xb7, 0x7c,
-	0xf9, 0xc7, 0x3a, 0x6d, 0xba, 0xbe, 0xc0, 0xa1, 0x17, 0xd4, 0x11, 0x3c, 0x74, 0x07, 0x62, 0xaa,
-	0x65, 0x1e, 0xe9, 0xb6, 0x41, 0xd2, 0x51, 0x86, 0xba, 0x34, 0x0a, 0x95, 0x49, 0x70, 0x20, 0x7f,
-	0x83, 0xf8, 0xdf, 0x30, 0x40, 0x60, 0x09, 0xf4, 0x2b, 0x88, 0xbb, 0x86, 0x94, 0xfd, 0xa0, 0x2b,
-	0xf4, 0xba, 0xd9, 0x98, 0x6b, 0x6b, 0x16, 0x7a, 0x1b, 0x57, 0xf6, 0x7e, 0x6f, 0x93, 0x14, 0x73,
-	0x31, 0x2b, 0x1a, 0xd2, 0xe0, 0x9a, 0x6a, 0xe1, 0xa3, 0x23, 0x5d, 0xd5, 0xb1, 0x49, 0x65, 0xd5,
-	0x32, 0x0c, 0x9d, 0x92, 0x74, 0x68, 0x35, 0xbc, 0x96, 0x2c, 0x6e, 0x7e, 0xfd, 0x22, 0x9b, 0x7b,
-	0xbd, 0xd8, 0x22, 0x12, 0xea, 0xc3, 0xdb, 0x72, 0xe1, 0xd0, 0x2f, 0x21, 0x65, 0x99, 0x58, 0x76,
-	0x92, 0x8a, 0xec, 0x85, 0x6f, 0x78, 0xec, 0xf0, 0x9d, 0xb5, 0x4c, 0x5c, 0xd3, 0x0d, 0xbc, 0xef,
-	0x46, 0xf1, 0x2f, 0x20, 0xa9, 0xdc, 0x96, 0x89, 0xde, 0x30, 0x15, 0xda, 0xb1, 0x31, 0xcf, 0x0b,
-	0x3f, 0x39, 0xef, 0x66, 0xf3, 0x57, 0x06, 0xae, 0x7a, 0xbb, 0xa5, 0x84, 0x72, 0xdb, 0x5f, 0x20,
-	0x0d, 0x90, 0xaf, 0x77, 0x70, 0xc0, 0xf4, 0x44, 0x07, 0xa4, 0xb8, 0xf6, 0x3e, 0x45, 0xec, 0x09,
-	0xfc, 0xc9, 0xf3, 0xef, 0xe4, 0xc9, 0x29, 0x2c, 0x62, 0x53, 0xb5, 0xcf, 0xda, 0x4e, 0x02, 0x23,
-	0x58, 0xb5, 0x31, 0x95, 0x49, 0x53, 0xb1, 0xb1, 0xf7, 0xec, 0x9f, 0x7e, 0xfd, 0x22, 0xfb, 0xf1,
-	0x95, 0x4f, 0x28, 0x9b, 0x6a, 0x95, 0x81, 0x54, 0x19, 0x86, 0x74, 0xdd, 0x07, 0xef, 0x27, 0x8b,
-	0x5f, 0x09, 0x10, 0x2a, 0x95, 0xd1, 0x36, 0x4c, 0x3b, 0x0e, 0xe0, 0x5e, 0x6c, 0xbc, 0xe7, 0x8f,
-	0xb4, 0x3b, 0xf5, 0x92, 0x07, 0x84, 0x79, 0xbe, 0x1b, 0x17, 0xa8, 0x2c, 0x6e, 0x40, 0xb4, 0x54,
-	0x7e, 0xd8, 0xc1, 0x1d, 0xec, 0x94, 0xa3, 0x26, 0x56, 0xb8, 0xd1, 0x25, 0xf6, 0xed, 0xd0, 0xa8,
-	0xa2, 0xb7, 0xbc, 0x12, 0xe5, 0x7c, 0x8b, 0xff, 0x99, 0x86, 0xa8, 0xf3, 0x7c, 0xba, 0xd9, 0x40,
-	0x3b, 0x7d, 0xe5, 0xf0, 0x53, 0xbf, 0x1c, 0xbe, 0x9e, 0x63, 0xe8, 0x66, 0x83, 0x17, 0xc4, 0x0f,
-	0x60, 0x5e, 0xed, 0xd8, 0xb6, 0x13, 0x8a, 0x0a, 0xa5, 0xd8, 0x68, 0x53, 0x7e, 0xf0, 0x1c, 0x27,
-	0x17, 0x5c, 0x2a, 0x3a, 0x84, 0x18, 0xcb, 0xfe, 0x8e, 0x8f, 0xb0, 0x22, 0x59, 0xbc, 0xdb, 0xeb,
-	0x66, 0xa3, 0xbc, 0xb8, 0x8e, 0x55, 0x90, 0xa3, 0x0c, 0xb0, 0xa2, 0xa1, 0x47, 0x30, 0xeb, 0x62,
-	0x4f, 0x5e, 0x69, 0x13, 0x0c, 0x88, 0x07, 0xea, 0xae, 0x53, 0x43, 0x08, 0x51, 0x1a, 0x5e, 0x08,
-	0xfd, 0x78, 0xac, 0xb2, 0xe4, 0x81, 0xa0, 0x43, 0x98, 0x0f, 0xf4, 0x34, 0x2d, 0x53, 0xc5, 0xac,
-	0x10, 0x8f, 0x99, 0x54, 0x3c, 0x4d, 0x77, 0x1d, 0x20, 0x54, 0x83, 0x78, 0x10, 0xf0, 0xd1, 0x89,
-	0x02, 0x3e, 0x00, 0x42, 0x3f, 0xf5, 0x1b, 0x8e, 0x18, 0x6b, 0x38, 0xb2, 0xc3, 0x75, 0x81, 0xfb,
-	0xc3, 0x77, 0xb6, 0x1c, 0xf1, 0x51, 0x2d, 0xc7, 0x43, 0x78, 0xcf, 0x13, 0xf3, 0x1b, 0xb8, 0x34,
-	0xb0, 0x5e, 0x62, 0x79, 0xdd, 0x6d, 0xf1, 0xd6, 0xbd, 0x16, 0x6f, 0xbd, 0xe6, 0x49, 0x14, 0x63,
-	0x4e, 0x0d, 0x7a, 0xf6, 0x22, 0x2b, 0x48, 0x29, 0xbe, 0xdd, 0xe7, 0x89, 0x4f, 0x43, 0x30, 0xc7,
-	0x75, 0xf2, 0x7c, 0xaf, 0x0e, 0x40, 0x5c, 0x4a, 0x90, 0xa1, 0xb6, 0x7a, 0xdd, 0x6c, 0xdc, 0xf7,
-	0xe5, 0x31, 0x23, 0x20, 0xce, 0x61, 0x2b, 0x1a, 0x4a, 0x43, 0x74, 0x30, 0x00, 0xbc, 0xa5, 0x63,
-	0x0a, 0x7c, 0xda, 0xd6, 0xed, 0xc0, 0x14, 0x6e, 0x93, 0x38, 0xcb, 0xa9, 0xbe, 0x29, 0x52, 0x0a,
-	0x71, 0xf0, 0xb0, 0x26, 0x7b, 0x9d, 0x8b, 0xdb, 0x7a, 0xac, 0x0e, 0x1b, 0xbd, 0xc0, 0x25, 0x07,
-	0x3a, 0x98, 0x79, 0x65, 0x80, 0x4a, 0xc4, 0xaf, 0x22, 0x30, 0x37, 0x28, 0xf9, 0xd6, 0x73, 0xb5,
-	0x63, 0x06, 0x4d, 0xb3, 0x31, 0x21, 0xcc, 0x0c, 0x71, 0xc9, 0x5b, 0xf6, 0x37, 0xc2, 0xe1, 0x89,
-	0x1b, 0x61, 0x3f, 0x2b, 0x47, 0xde, 0x54, 0x56, 0x9e, 0x9e, 0x2c, 0x2b, 0xa3, 0x43, 0x98, 0xab,
-	0xeb, 0xa6, 0xe6, 0xf8, 0xd8, 0x91, 0xa2, 0x52, 0xcb, 0xe6, 0xa1, 0xbd, 0x79, 0xde, 0x7d, 0x8d,
-	0x96, 0xa4, 0xaa, 0x2a, 0x2d, 0xc5, 0x96, 0x66, 0x39, 0xd4, 0x3d, 0x86, 0x84, 0xf6, 0x20, 0x1e,
-	0x64, 0x8c, 0xe8, 0xd8, 0x8a, 0xc6, 0xda, 0x3c, 0x59, 0x88, 0x79, 0x98, 0xdf, 0xc7, 0xec, 0x04,
-	0xee, 0xcb, 0x04, 0x65, 0x21, 0x11, 0xc4, 0x08, 0x49, 0x0b, 0xab, 0xe1, 0xb5, 0x88, 0x04, 0xbe,
-	0x7f, 0x13, 0xf1, 0xdb, 0x10, 0xcc, 0x70, 0x27, 0xba, 0xdf, 0x57, 0x42, 0xee, 0xf8, 0x25, 0x64,
-	0x0c, 0xbf, 0x71, 0x2a, 0x48, 0x7f, 0x61, 0x08, 0xbd, 0xe1, 0xc2, 0xd0, 0xe7, 0x8d, 0xe1, 0x4b,
-	0xbd, 0x71, 0xf2, 0xb1, 0xec, 0x16, 0x24, 0x75, 0x22, 0x1b, 0x4a, 0x4b, 0x57, 0x75, 0x8b, 0x0f,
-	0x67, 0x31, 0x29, 0xa1, 0x93, 0x07, 0x1e, 0x09, 0xdd, 0x84, 0xb8, 0x4e, 0x64, 0x45, 0xa5, 0xfa,
-	0x89, 0x9b, 0xf4, 0x63, 0x52, 0x4c, 0x27, 0x05, 0xb6, 0x16, 0x9f, 0x0b, 0x10, 0xe5, 0xed, 0xf5,
-	0x5b, 0x0f, 0xd0, 0x2f, 0x61, 0xde, 0x7a, 0x62, 0x7a, 0x95, 0xd2, 0x69, 0x12, 0x79, 0x43, 0x32,
-	0x6e, 0xb5, 0x48, 0x5a, 0x4f, 0x4c, 0xb7, 0x5e, 0x56, 0xf5, 0x86, 0xf8, 0xef, 0x10, 0xc4, 0xfd,
-	0xf9, 0x03, 0x3d, 0x86, 0x84, 0x37, 0x70, 0x28, 0x26, 0xe5, 0xd7, 0xf9, 0x68, 0xbc, 0x2b, 0xf4,
-	0x23, 0xa1, 0x03, 0x00, 0x1b, 0x93, 0xb6, 0x65, 0x6a, 0xd8, 0xe4, 0x09, 0x77, 0x5c, 0xdc, 0x3e,
-	0x20, 0xc7, 0x2b, 0x98, 0x51, 0xce, 0x8c, 0x49, 0x72, 0xd4, 0x31, 0x3e, 0xab, 0x9e, 0x19, 0xe8,
-	0xcb, 0xfe, 0x8a, 0xec, 0x3a, 0xd9, 0xdd, 0xf3, 0x6e, 0xf6, 0xce, 0x95, 0xe1, 0x7c, 0x3b, 0x8e,
-	0x2a, 0xcd, 0xe2, 0x1f, 0x04, 0xb8, 0xe6, 0x4b, 0xf4, 0x0d, 0x73, 0x77, 0x21, 0xee, 0x0f, 0x79,
-	0x7c, 0x2c, 0xbf, 0xf9, 0x8a, 0x19, 0x91, 0xd7, 0x8e, 0x60, 0x0f, 0xda, 0x81, 0x94, 0xbf, 0xf0,
-	0x66, 0xcd, 0x10, 0xab, 0xfe, 0xb7, 0x5e, 0x81, 0xc3, 0xeb, 0xff, 0xbc, 0x3a, 0x48, 0x10, 0xff,
-	0x21, 0xc0, 0xc2, 0xa8, 0x81, 0xf4, 0xad, 0x3b, 0xba, 0x72, 0xe9, 0xe0, 0x1c, 0x62, 0x55, 0xf5,
-	0xfd, 0x57, 0x5c, 0xe6, 0x6a, 0x73, 0xb3, 0xd8, 0x81, 0x05, 0x9e, 0x46, 0xf7, 0x6d, 0x4b, 0xc5,
-	0x84, 0xb0, 0x04, 0x44, 0x9c, 0x97, 0xf7, 0x52, 0x1a, 0xcf, 0xa4, 0xc5, 0x9f, 0x3b, 0x57, 0xe3,
-	0xf9, 0x89, 0x8c, 0x95, 0xd4, 0x62, 0x3c, 0xa9, 0x11, 0xf1, 0xb7, 0x70, 0x63, 0xf0, 0x58, 0x3f,
-	0x89, 0xe3, 0x11, 0x49, 0xbc, 0x58, 0xea, 0x75, 0xb3, 0xe0, 0xf7, 0x2c, 0x64, 0xcc, 0x56, 0xa7,
-	0xbf, 0x14, 0xfc, 0x3d, 0x04, 0xa9, 0x7d, 0xc5, 0xa6, 0xba, 0xd2, 0x0a, 0x46, 0xcf, 0x77, 0xd1,
-	0x64, 0x7d, 0x00, 0xf3, 0xde, 0x19, 0x17, 0xa6, 0x0d, 0x32, 0xd8, 0xf1, 0x0d, 0x38, 0x57, 0xf8,
-	0xcd, 0x3b, 0x57, 0x6d, 0x38, 0xb6, 0x27, 0xef, 0xb6, 0xc5, 0x5f, 0x03, 0xaa, 0xe1, 0xd3, 0x20,
-	0xdc, 0xf7, 0x6c, 0x0d, 0xdb, 0xfd, 0x53, 0x88, 0xf0, 0x06, 0xa6, 0x90, 0x4f, 0x12, 0x7f, 0xf9,
-	0xd3, 0x8f, 0xa2, 0xec, 0x67, 0x32, 0x93, 0x8a, 0x7f, 0x16, 0x20, 0x59, 0x7e, 0xf4, 0x20, 0x78,
-	0xc6, 0x87, 0x10, 0xb7, 0x65, 0xaf, 0x68, 0x4e, 0x72, 0x5e, 0xcc, 0x2e, 0xf0, 0x5a, 0x2b, 0xf5,
-	0x1b, 0x2b, 0x34, 0x01, 0x64, 0x9f, 0xa9, 0xfe, 0x15, 0x82, 0x59, 0xee, 0x22, 0xfc, 0xa7, 0xc8,
-	0x9f, 0x41, 0x94, 0x3b, 0x01, 0xcf, 0x7a, 0x4b, 0x97, 0xce, 0x2a, 0xde, 0x2f, 0x7e, 0x5c, 0x1e,
-	0x7d, 0x01, 0x8b, 0xde, 0x10, 0x3b, 0xca, 0xbd, 0x46, 0x76, 0xe0, 0x83, 0x23, 0x86, 0x74, 0x9d,
-	0x03, 0x5c, 0x98, 0x3c, 0x0e, 0x60, 0x16, 0x9f, 0x18, 0x7d, 0x3f, 0xc5, 0x84, 0x19, 0x5e, 0x66,
-	0x18, 0xaf, 0xff, 0x11, 0x8a, 0xa9, 0x5e, 0x37, 0x3b, 0xf0, 0x2c, 0x52, 0x12, 0x9f, 0x18, 0xc1,
-	0x23, 0x35, 0xe1, 0xa6, 0x8d, 0x55, 0xac, 0x9f, 0x60, 0x4d, 0x6e, 0xbb, 0x81, 0x18, 0x9c, 0xe1,
-	0x8d, 0x0d, 0xe2, 0xf0, 0x21, 0x17, 0x83, 0x96, 0x1b, 0x62, 0xc9, 0x03, 0xbb, 0xc8, 0x27, 0xe2,
-	0xef, 0x04, 0x78, 0x8f, 0xdf, 0xa9, 0xec, 0x8c, 0x2b, 0x0a, 0xd5, 0x2d, 0xf3, 0xff, 0x2a, 0xd6,
-	0xc5, 0x36, 0xa0, 0x21, 0x0d, 0x09, 0x3a, 0x84, 0x6b, 0xde, 0x76, 0x1c, 0x90, 0x59, 0x4a, 0x4c,
-	0xe4, 0xbf, 0x7f, 0xe9, 0x7b, 0x06, 0x10, 0xdc, 0x36, 0x88, 0x0c, 0x61, 0x7f, 0xf8, 0x54, 0xf0,
-	0x9d, 0x8f, 0x17, 0xb3, 0x0c, 0x2c, 0x57, 0x2b, 0xdb, 0xbb, 0x95, 0xdd, 0x6d, 0xb9, 0x5a, 0x2b,
-	0xd4, 0x0e, 0xaa, 0xf2, 0xc1, 0x6e, 0x75, 0xbf, 0xbc, 0x55, 0xb9, 0x57, 0x29, 0x97, 0x52, 0x53,
-	0x68, 0x19, 0x6e, 0x5c, 0xe0, 0x3f, 0x2e, 0x54, 0x6a, 0x95, 0xdd, 0xed, 0x94, 0x30, 0x82, 0x57,
-	0x3d, 0xd8, 0xda, 0x2a, 0x57, 0xab, 0xa9, 0x10, 0x5a, 0x82, 0xeb, 0x17, 0x78, 0xf7, 0x0a, 0x3b,
-	0x3b, 0xe5, 0xdd, 0x54, 0x78, 0x39, 0xf2, 0xf4, 0xf7, 0x99, 0xa9, 0x0f, 0xff, 0x2a, 0xf0, 0x1f,
-	0xe4, 0xb9, 0x22, 0x2b, 0x90, 0xde, 0x96, 0xf6, 0x0e, 0xf6, 0x47, 0xab, 0x91, 0x86, 0x85, 0x01,
-	0xae, 0xb4, 0x77, 0xb0, 0x5b, 0x92, 0x37, 0x52, 0xc2, 0x25, 0x9c, 0x7c, 0x2a, 0x74, 0x09, 0x67,
-	0x33, 0x15, 0x46, 0x8b, 0x70, 0x6d, 0x80, 0x53, 0xd8, 0xaa, 0x55, 0x1e, 0x95, 0x53, 0x91, 0xa1,
-	0x2d, 0xe5, 0x2f, 0xf6, 0x2b, 0x52, 0xb9, 0x94, 0x9a, 0x1e, 0xda, 0xc2, 0x6f, 0x33, 0xc3, 0x6f,
-	0x43, 0x61, 0xfe, 0x42, 0x43, 0x81, 0x56, 0x61, 0x65, 0x6b, 0xef, 0xc1, 0xfe, 0x4e, 0xa1, 0xb2,
-	0x5b, 0x1b, 0x7d, 0xa9, 0x15, 0x48, 0x0f, 0x49, 0x78, 0x16, 0x14, 0xd0, 0x4d, 0x58, 0x1c, 0xe2,
-	0xde, 0x2b, 0x54, 0x76, 0xca, 0xa5, 0x54, 0xc8, 0x3d, 0xb5, 0xf8, 0xf9, 0x1f, 0x7b, 0x19, 0xe1,
-	0x79, 0x2f, 0x23, 0x7c, 0xd3, 0xcb, 0x08, 0xff, 0xec, 0x65, 0x84, 0x67, 0x2f, 0x33, 0x53, 0xdf,
-	0xbc, 0xcc, 0x4c, 0x7d, 0xfb, 0x32, 0x33, 0x75, 0xf8, 0xc3, 0xef, 0x74, 0xe3, 0x53, 0xf6, 0x37,
-	0x17, 0x3d, 0x6b, 0x63, 0x52, 0x9f, 0x61, 0xec, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0x02, 0x06, 0x92, 0xff, 0x1a, 0x00, 0x00,
+	// 2109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x29, 0xd9, 0x92, 0x9e, 0xfc, 0xc1, 0x9d, 0x64, 0x37, 0x8c, 0x93, 0x5a, 0x0e, 0x8b,
+	0xec, 0xba, 0x41, 0x6b, 0xc5, 0x72, 0xb7, 0xdd, 0x6e, 0x16, 0x48, 0xf5, 0xc1, 0x38, 0xdc, 0xd8,
+	0xb2, 0x42, 0xc9, 0xc9, 0x6e, 0x8a, 0x2d, 0x41, 0x91, 0x63, 0x89, 0x88, 0x44, 0xaa, 0x1c, 0xca,
+	0x89, 0x7b, 0xe9, 0x35, 0xc7, 0x9c, 0xf6, 0x5c, 0xa0, 0x3d, 0x14, 0x7b, 0x6e, 0xff, 0x87, 0xa0,
+	0xbd, 0x2c, 0x50, 0x14, 0xd8, 0x5e, 0x94, 0x42, 0x69, 0x51, 0xf4, 0xd6, 0x73, 0x4e, 0x05, 0x87,
+	0x43, 0x4a, 0xb2, 0xe4, 0xac, 0x23, 0x25, 0x8b, 0xde, 0x38, 0xef, 0xbd, 0xf9, 0xcd, 0x9b, 0x37,
+	0xef, 0x53, 0x82, 0xd5, 0xba, 0x6e, 0x9b, 0x59, 0x8f, 0x90, 0xec, 0xd1, 0x56, 0x1d, 0x7b, 0xfa,
+	0x96, 0xff, 0xbd, 0xd9, 0x71, 0x1d, 0xcf, 0x41, 0x82, 0xcf, 0xdb, 0xf4, 0xd7, 0x8c, 0xb7, 0x7a,
+	0xbe, 0xe1, 0x34, 0x1c, 0xca, 0xcc, 0xfa, 0x5f, 0x81, 0xdc, 0xea, 0x45, 0xc3, 0x21, 0x6d, 0x87,
+	0x68, 0x01, 0x23, 0x58, 0x30, 0x56, 0xa6, 0xe1, 0x38, 0x8d, 0x16, 0xce, 0xd2, 0x55, 0xbd, 0x7b,
+	0x98, 0xf5, 0xac, 0x36, 0x26, 0x9e, 0xde, 0xee, 0x04, 0x02, 0xd2, 0x5f, 0x79, 0x98, 0xdf, 0x71,
+	0x9d, 0x6e, 0x07, 0x7d, 0x0a, 0xbc, 0x65, 0x8a, 0xdc, 0x3a, 0xb7, 0x11, 0x2f, 0x7c, 0xdc, 0xef,
+	0x65, 0x78, 0xa5, 0xf4, 0xb2, 0x97, 0xb9, 0xde, 0xb0, 0xbc, 0x66, 0xb7, 0xbe, 0x69, 0x38, 0xed,
+	0xac, 0xaf, 0x0e, 0xdd, 0x65, 0x38, 0xad, 0xac, 0xd1, 0xd4, 0x2d, 0x3b, 0x7b, 0xb4, 0x9d, 0xed,
+	0x3c, 0x6c, 0x50, 0x9d, 0x29, 0x8a, 0x52, 0x52, 0x79, 0xcb, 0x44, 0x08, 0xe2, 0xc4, 0xfa, 0x35,
+	0x16, 0x79, 0x1f, 0x4d, 0xa5, 0xdf, 0xe8, 0x32, 0xa4, 0xbc, 0xa6, 0x8b, 0x49, 0xd3, 0x69, 0x99,
+	0x62, 0x8c, 0x32, 0x06, 0x04, 0x74, 0x07, 0x12, 0x9d, 0x6e, 0x5d, 0x7b, 0x88, 0x8f, 0xc5, 0xf8,
+	0x3a, 0xb7, 0xb1, 0x58, 0xc8, 0xbd, 0xec, 0x65, 0x36, 0xcf, 0x7c, 0x78, 0xc5, 0xb1, 0x6c, 0x4f,
+	0x5d, 0xe8, 0x74, 0xeb, 0x77, 0xf0, 0x31, 0xfa, 0x10, 0x16, 0x88, 0xa7, 0x7b, 0x5d, 0x22, 0xce,
+	0xaf, 0x73, 0x1b, 0xcb, 0xb9, 0xef, 0x6d, 0x9e, 0xb4, 0x64, 0xa0, 0x6d, 0x95, 0x0a, 0xa9, 0x4c,
+	0x18, 0x5d, 0x85, 0x65, 0xc3, 0xc5, 0xba, 0x87, 0x4d, 0xad, 0x89, 0xad, 0x46, 0xd3, 0x13, 0x17,
+	0xa8, 0x9a, 0x4b, 0x8c, 0x7a, 0x9b, 0x12, 0xd1, 0x15, 0x58, 0x6c, 0x3b, 0x66, 0xb7, 0x85, 0x35,
+	0xe7, 0x91, 0x8d, 0x5d, 0x31, 0xb1, 0xce, 0x6d, 0xa4, 0xd4, 0x74, 0x40, 0xdb, 0xf7, 0x49, 0xd2,
+	0xd3, 0x38, 0xa4, 0xe9, 0x09, 0x2a, 0x26, 0xdd, 0x96, 0x87, 0xb6, 0x61, 0xbe, 0xe1, 0x2f, 0xa9,
+	0x79, 0xd3, 0xb9, 0x0b, 0xa7, 0xe8, 0x53, 0x88, 0x3f, 0xeb, 0x65, 0xe6, 0xd4, 0x40, 0x16, 0x19,
+	0x90, 0x36, 0x1f, 0x36, 0x34, 0xc3, 0xb1, 0x3d, 0xfc, 0xd8, 0xa3, 0xb6, 0x5c, 0x2c, 0x14, 0xfa,
+	0xbd, 0x0c, 0x94, 0xee, 0xec, 0x14, 0x03, 0xea, 0x89, 0x17, 0x32, 0x9c, 0x36, 0xf6, 0xea, 0x87,
+	0xde, 0xe0, 0xa3, 0x65, 0xd5, 0x49, 0xb6, 0x7e, 0xec, 0x61, 0xb2, 0x79, 0x1b, 0x3f, 0x2e, 0xf8,
+	0x1f, 0x2a, 0x98, 0x0f, 0x1b, 0x6c, 0x3f, 0xfa, 0x08, 0x12, 0x6d, 0xdc, 0xae, 0x63, 0x97, 0x88,
+	0xb1, 0xf5, 0xd8, 0x46, 0x3a, 0x27, 0x8e, 0xeb, 0xb6, 0x47, 0x05, 0x98, 0x72, 0xa1, 0x38, 0x92,
+	0x61, 0xd1, 0x75, 0xba, 0xb6, 0xb9, 0xa5, 0x59, 0xf6, 0xa1, 0x43, 0xc4, 0x38, 0xdd, 0x7e, 0x79,
+	0x7c, 0xbb, 0x4a, 0xa5, 0x14, 0xfb, 0xd0, 0x61, 0x10, 0x69, 0x37, 0xa2, 0x0c, 0x60, 0x72, 0x0c,
+	0x66, 0xfe, 0x95, 0x30, 0xb9, 0x31, 0x98, 0x5c, 0x00, 0x53, 0x87, 0xf7, 0x0c, 0xa7, 0xdd, 0x69,
+	0xe9, 0x96, 0xed, 0x11, 0xed, 0x91, 0xe5, 0x35, 0x35, 0xe6, 0x02, 0x0b, 0x14, 0xf0, 0xfd, 0x71,
+	0xc0, 0x62, 0x24, 0x7f, 0xdf, 0xf2, 0x9a, 0x81, 0x2f, 0x30, 0xe8, 0xf3, 0xc6, 0x04, 0x1e, 0xba,
+	0x01, 0x49, 0xc3, 0xb1, 0x0f, 0x2d, 0xb7, 0x4d, 0xc4, 0x04, 0x45, 0xbd, 0x38, 0x09, 0x95, 0x4a,
+	0x30, 0xa0, 0x68, 0x83, 0xf4, 0xdf, 0x18, 0xc0, 0xc0, 0x12, 0xe8, 0x97, 0x90, 0x0a, 0x0c, 0xa9,
+	0x45, 0x41, 0x97, 0xef, 0xf7, 0x32, 0xc9, 0xc0, 0xd6, 0x34, 0xf4, 0xb6, 0xce, 0xec, 0xfd, 0xe1,
+	0x26, 0x35, 0x19, 0x60, 0x2a, 0x26, 0x32, 0xe1, 0x9c, 0xe1, 0xe0, 0xc3, 0x43, 0xcb, 0xb0, 0xb0,
+	0xed, 0x69, 0x86, 0xd3, 0x6e, 0x5b, 0x1e, 0x11, 0xf9, 0xf5, 0xd8, 0xc6, 0x62, 0x61, 0xfb, 0xab,
+	0xe7, 0x99, 0xec, 0xeb, 0xc5, 0x16, 0x51, 0xd1, 0x10, 0x5e, 0x31, 0x80, 0x43, 0xbf, 0x00, 0xc1,
+	0xb1, 0xb1, 0xe6, 0x27, 0x15, 0x2d, 0x0c, 0xdf, 0xd8, 0xd4, 0xe1, 0xbb, 0xe4, 0xd8, 0xb8, 0x66,
+	0xb5, 0x71, 0x25, 0x88, 0xe2, 0xcf, 0x61, 0x51, 0xbf, 0xae, 0x11, 0xab, 0x61, 0xeb, 0x5e, 0xd7,
+	0xc5, 0x2c, 0x2f, 0xfc, 0xe4, 0x65, 0x2f, 0x93, 0x3b, 0x33, 0x70, 0x35, 0xdc, 0xad, 0xa6, 0xf5,
+	0xeb, 0xd1, 0x02, 0x99, 0x80, 0x22, 0xbd, 0x07, 0x07, 0xcc, 0xcf, 0x74, 0x80, 0xc0, 0xb4, 0x8f,
+	0x28, 0x52, 0x9f, 0x63, 0x4f, 0x9e, 0xfb, 0x4e, 0x9e, 0xdc, 0x83, 0x0b, 0xd8, 0x36, 0xdc, 0xe3,
+	0x8e, 0x9f, 0xc0, 0x08, 0x36, 0x5c, 0xec, 0x69, 0xa4, 0xa9, 0xbb, 0x38, 0x7c, 0xf6, 0x4f, 0xbe,
+	0x7a, 0x9e, 0xf9, 0xe8, 0xcc, 0x27, 0xc8, 0xb6, 0x51, 0xa5, 0x20, 0x55, 0x8a, 0xa1, 0xbe, 0x1b,
+	0x81, 0x0f, 0x93, 0xa5, 0x2f, 0x39, 0xe0, 0x4b, 0x32, 0xda, 0x81, 0x79, 0xdf, 0x01, 0x82, 0x8b,
+	0x4d, 0xf7, 0xfc, 0xf1, 0x4e, 0xb7, 0x5e, 0x0a, 0x81, 0xb0, 0xc8, 0xcf, 0x04, 0x24, 0x4b, 0x5b,
+	0x90, 0x28, 0xc9, 0x77, 0xbb, 0xb8, 0x8b, 0xfd, 0x72, 0xd4, 0xc4, 0x3a, 0x33, 0xba, 0x4a, 0xbf,
+	0x7d, 0x9a, 0xa7, 0x5b, 0xad, 0xb0, 0x44, 0xf9, 0xdf, 0xd2, 0x7f, 0xe6, 0x21, 0xe1, 0x3f, 0x9f,
+	0x65, 0x37, 0xd0, 0xee, 0x50, 0x39, 0xfc, 0x24, 0x2a, 0x87, 0xaf, 0xe7, 0x18, 0x96, 0xdd, 0x60,
+	0x05, 0xf1, 0x03, 0x58, 0x31, 0xba, 0xae, 0xeb, 0x87, 0xa2, 0xee, 0x79, 0xb8, 0xdd, 0xf1, 0xd8,
+	0xc1, 0xcb, 0x8c, 0x9c, 0x0f, 0xa8, 0xe8, 0x01, 0x24, 0x69, 0xf6, 0xf7, 0x7d, 0x84, 0x16, 0xc9,
+	0xc2, 0xcd, 0x7e, 0x2f, 0x93, 0x60, 0xc5, 0x75, 0xaa, 0x82, 0x9c, 0xa0, 0x80, 0x8a, 0x89, 0xee,
+	0xc1, 0x52, 0x80, 0x3d, 0x7b, 0xa5, 0x4d, 0x53, 0x20, 0x16, 0xa8, 0x65, 0xbf, 0x86, 0x10, 0xa2,
+	0x37, 0xc2, 0x10, 0xfa, 0xf1, 0x54, 0x65, 0x29, 0x04, 0x41, 0x0f, 0x60, 0x65, 0xa0, 0xa7, 0xed,
+	0xd8, 0x06, 0x16, 0x17, 0xa6, 0xd6, 0x74, 0x29, 0xd4, 0xb4, 0xec, 0x03, 0xa1, 0x1a, 0xa4, 0x06,
+	0x01, 0x9f, 0x98, 0x29, 0xe0, 0x07, 0x40, 0xe8, 0xa7, 0x51, 0xc3, 0x91, 0xa4, 0x0d, 0x47, 0x66,
+	0xbc, 0x2e, 0x30, 0x7f, 0xf8, 0xd6, 0x96, 0x23, 0x35, 0xa9, 0xe5, 0xb8, 0x0b, 0xef, 0x84, 0x62,
+	0x51, 0x03, 0x27, 0x02, 0xed, 0x25, 0x56, 0x37, 0x83, 0x16, 0x6f, 0x33, 0x6c, 0xf1, 0x36, 0x6b,
+	0xa1, 0x44, 0x21, 0xe9, 0xd7, 0xa0, 0xa7, 0xcf, 0x33, 0x9c, 0x2a, 0xb0, 0xed, 0x11, 0x4f, 0x7a,
+	0xc2, 0xc3, 0x32, 0xd3, 0x29, 0xf4, 0xbd, 0x3a, 0x00, 0x09, 0x28, 0x83, 0x0c, 0x55, 0xec, 0xf7,
+	0x32, 0xa9, 0xc8, 0x97, 0xa7, 0x8c, 0x80, 0x14, 0x83, 0x55, 0x4c, 0x24, 0x42, 0x62, 0x34, 0x00,
+	0xc2, 0xa5, 0x6f, 0x0a, 0xfc, 0xb8, 0x63, 0xb9, 0x03, 0x53, 0x04, 0x4d, 0xe2, 0x12, 0xa3, 0x46,
+	0xa6, 0x10, 0x74, 0xe2, 0xe3, 0x61, 0x53, 0x0b, 0x3b, 0x97, 0xa0, 0xf5, 0x58, 0x1f, 0x37, 0x7a,
+	0x9e, 0x49, 0x8e, 0x74, 0x30, 0x2b, 0xfa, 0x08, 0x95, 0x48, 0x5f, 0xc6, 0x61, 0x79, 0x54, 0xf2,
+	0xad, 0xe7, 0x6a, 0xdf, 0x0c, 0xa6, 0xe9, 0x62, 0x42, 0xa8, 0x19, 0x52, 0x6a, 0xb8, 0x1c, 0x6e,
+	0x84, 0x63, 0x33, 0x37, 0xc2, 0x51, 0x56, 0x8e, 0xbf, 0xa9, 0xac, 0x3c, 0x3f, 0x5b, 0x56, 0x46,
+	0x0f, 0x60, 0xb9, 0x6e, 0xd9, 0xa6, 0xef, 0x63, 0x87, 0xba, 0xe1, 0x39, 0x2e, 0x0b, 0xed, 0xed,
+	0x97, 0xbd, 0xd7, 0x68, 0x49, 0xaa, 0x86, 0xde, 0xd2, 0x5d, 0x75, 0x89, 0x41, 0xdd, 0xa2, 0x48,
+	0x68, 0x1f, 0x52, 0x83, 0x8c, 0x91, 0x98, 0x5a, 0xd1, 0x64, 0x87, 0x25, 0x0b, 0x29, 0x07, 0x2b,
+	0x15, 0x4c, 0x4f, 0x60, 0xbe, 0x4c, 0x50, 0x06, 0xd2, 0x83, 0x18, 0x21, 0x22, 0xb7, 0x1e, 0xdb,
+	0x88, 0xab, 0x10, 0xf9, 0x37, 0x91, 0xbe, 0xe1, 0x61, 0x81, 0x39, 0xd1, 0x9d, 0xa1, 0x12, 0x72,
+	0x23, 0x2a, 0x21, 0x53, 0xf8, 0x8d, 0x5f, 0x41, 0x86, 0x0b, 0x03, 0xff, 0x86, 0x0b, 0xc3, 0x90,
+	0x37, 0xc6, 0x4e, 0xf5, 0xc6, 0xd9, 0xc7, 0xb2, 0x2b, 0xb0, 0x68, 0x11, 0xad, 0xad, 0xb7, 0x2c,
+	0xc3, 0x72, 0xd8, 0x70, 0x96, 0x54, 0xd3, 0x16, 0xd9, 0x0b, 0x49, 0xe8, 0x12, 0xa4, 0x2c, 0xa2,
+	0xe9, 0x86, 0x67, 0x1d, 0x05, 0x49, 0x3f, 0xa9, 0x26, 0x2d, 0x92, 0xa7, 0x6b, 0xe9, 0x19, 0x07,
+	0x09, 0xd6, 0x5e, 0xbf, 0xf5, 0x00, 0xfd, 0x02, 0x56, 0x9c, 0x47, 0x76, 0x58, 0x29, 0xfd, 0x26,
+	0x51, 0xe4, 0x67, 0xaa, 0x16, 0x8b, 0xce, 0x23, 0x3b, 0xa8, 0x97, 0x55, 0xab, 0x21, 0xfd, 0x9b,
+	0x87, 0x54, 0x34, 0x7f, 0xa0, 0xfb, 0x90, 0x0e, 0x07, 0x0e, 0xdd, 0xf6, 0xd8, 0x75, 0x3e, 0x9c,
+	0xee, 0x0a, 0xc3, 0x48, 0xe8, 0x00, 0xc0, 0xc5, 0xa4, 0xe3, 0xd8, 0x26, 0xb6, 0x59, 0xc2, 0x9d,
+	0x16, 0x77, 0x08, 0xc8, 0xf7, 0x0a, 0x6a, 0x94, 0xe3, 0xf6, 0x2c, 0x39, 0xea, 0x21, 0x3e, 0xae,
+	0x1e, 0xb7, 0xd1, 0x17, 0xc3, 0x15, 0x39, 0x70, 0xb2, 0x9b, 0x2f, 0x7b, 0x99, 0x1b, 0x67, 0x86,
+	0x8b, 0xec, 0x38, 0xa9, 0x34, 0x4b, 0xbf, 0xe7, 0xe0, 0x5c, 0x24, 0x31, 0x34, 0xcc, 0xdd, 0x84,
+	0x54, 0x34, 0xe4, 0xb1, 0xb1, 0xfc, 0xd2, 0x2b, 0x66, 0x44, 0x56, 0x3b, 0x06, 0x7b, 0xd0, 0x2e,
+	0x08, 0xd1, 0x22, 0x9c, 0x35, 0x79, 0x5a, 0xfd, 0xaf, 0xbc, 0x02, 0x87, 0xd5, 0xff, 0x15, 0x63,
+	0x94, 0x20, 0xfd, 0x9d, 0x83, 0xf3, 0x93, 0x06, 0xd2, 0xb7, 0xee, 0xe8, 0xfa, 0xa9, 0x83, 0x33,
+	0x4f, 0xab, 0xea, 0xd5, 0x57, 0x5c, 0xe6, 0x6c, 0x73, 0xb3, 0xd4, 0x85, 0xf3, 0x2c, 0x8d, 0x56,
+	0x5c, 0xc7, 0xc0, 0x84, 0xd0, 0x04, 0x44, 0xfc, 0x97, 0x0f, 0x53, 0x1a, 0xcb, 0xa4, 0x85, 0x9f,
+	0xfb, 0x57, 0x63, 0xf9, 0x89, 0x4c, 0x95, 0xd4, 0x92, 0x2c, 0xa9, 0x11, 0xe9, 0x37, 0xf0, 0xde,
+	0xe8, 0xb1, 0x51, 0x12, 0xc7, 0x13, 0x92, 0x78, 0xa1, 0xe4, 0xff, 0xb2, 0x12, 0xf5, 0x2c, 0x64,
+	0xca, 0x56, 0x67, 0xb8, 0x14, 0xfc, 0x8d, 0x07, 0xa1, 0xa2, 0xbb, 0x9e, 0xa5, 0xb7, 0x06, 0xa3,
+	0xe7, 0x77, 0xd1, 0x64, 0x7d, 0x00, 0x2b, 0xe1, 0x19, 0x27, 0xa6, 0x0d, 0x32, 0xda, 0xf1, 0x8d,
+	0x38, 0x57, 0xec, 0xcd, 0x3b, 0x57, 0x6d, 0x3c, 0xb6, 0x67, 0xef, 0xb6, 0xa5, 0x5f, 0x01, 0xaa,
+	0xe1, 0xc7, 0x83, 0x70, 0xdf, 0x77, 0x4d, 0xec, 0x0e, 0x4f, 0x21, 0xdc, 0x1b, 0x98, 0x42, 0x3e,
+	0x4e, 0xff, 0xf9, 0x8f, 0x3f, 0x4a, 0xd0, 0x9f, 0xc9, 0x6c, 0x4f, 0xfa, 0x13, 0x07, 0x8b, 0xf2,
+	0xbd, 0xbd, 0xc1, 0x33, 0xde, 0x85, 0x94, 0xab, 0x85, 0x45, 0x73, 0x96, 0xf3, 0x92, 0x6e, 0x9e,
+	0xd5, 0x5a, 0x75, 0xd8, 0x58, 0xfc, 0x0c, 0x90, 0x43, 0xa6, 0xfa, 0x17, 0x0f, 0x4b, 0xcc, 0x45,
+	0xd8, 0x4f, 0x91, 0x3f, 0x83, 0x04, 0x73, 0x02, 0x96, 0xf5, 0x2e, 0x9e, 0x3a, 0xab, 0x84, 0xbf,
+	0xf8, 0x31, 0x79, 0xf4, 0x19, 0x5c, 0x08, 0x87, 0xd8, 0x49, 0xee, 0x35, 0xb1, 0x03, 0x1f, 0x1d,
+	0x31, 0xd4, 0x77, 0x19, 0xc0, 0x28, 0x19, 0x1d, 0xc0, 0x12, 0x3e, 0x6a, 0x0f, 0xfd, 0x14, 0x13,
+	0xa3, 0x78, 0x6b, 0xe3, 0x78, 0xc3, 0x8f, 0x50, 0x10, 0xfa, 0xbd, 0xcc, 0xc8, 0xb3, 0xa8, 0x8b,
+	0xf8, 0xa8, 0x3d, 0x78, 0xa4, 0x26, 0x5c, 0x72, 0xb1, 0x81, 0xad, 0x23, 0x6c, 0x6a, 0x9d, 0x20,
+	0x10, 0x07, 0x67, 0x84, 0x63, 0x83, 0x34, 0x7e, 0xc8, 0xc9, 0xa0, 0x65, 0x86, 0xb8, 0x18, 0x82,
+	0x9d, 0xe4, 0x13, 0xe9, 0xb7, 0x1c, 0xbc, 0xc3, 0xee, 0x24, 0xfb, 0xe3, 0x8a, 0xee, 0x59, 0x8e,
+	0xfd, 0x7f, 0x15, 0xeb, 0x52, 0x07, 0xd0, 0x98, 0x86, 0x04, 0x3d, 0x80, 0x73, 0xe1, 0x76, 0x3c,
+	0x20, 0xd3, 0x94, 0x98, 0xce, 0x7d, 0xff, 0xd4, 0xf7, 0x1c, 0x40, 0x30, 0xdb, 0x20, 0x32, 0x86,
+	0x7d, 0xed, 0x09, 0x17, 0x39, 0x1f, 0x2b, 0x66, 0x6b, 0xb0, 0x5a, 0x55, 0x76, 0xca, 0x4a, 0x79,
+	0x47, 0xab, 0xd6, 0xf2, 0xb5, 0x83, 0xaa, 0x76, 0x50, 0xae, 0x56, 0xe4, 0xa2, 0x72, 0x4b, 0x91,
+	0x4b, 0xc2, 0x1c, 0x5a, 0x85, 0xf7, 0x4e, 0xf0, 0xef, 0xe7, 0x95, 0x9a, 0x52, 0xde, 0x11, 0xb8,
+	0x09, 0xbc, 0xea, 0x41, 0xb1, 0x28, 0x57, 0xab, 0x02, 0x8f, 0x2e, 0xc2, 0xbb, 0x27, 0x78, 0xb7,
+	0xf2, 0xbb, 0xbb, 0x72, 0x59, 0x88, 0xad, 0xc6, 0x9f, 0xfc, 0x6e, 0x6d, 0xee, 0xda, 0x5f, 0x38,
+	0xf6, 0x83, 0x3c, 0x53, 0xe4, 0x32, 0x88, 0x3b, 0xea, 0xfe, 0x41, 0x65, 0xb2, 0x1a, 0x22, 0x9c,
+	0x1f, 0xe1, 0xaa, 0xfb, 0x07, 0xe5, 0x92, 0xb6, 0x25, 0x70, 0xa7, 0x70, 0x72, 0x02, 0x7f, 0x0a,
+	0x67, 0x5b, 0x88, 0xa1, 0x0b, 0x70, 0x6e, 0x84, 0x93, 0x2f, 0xd6, 0x94, 0x7b, 0xb2, 0x10, 0x1f,
+	0xdb, 0x22, 0x7f, 0x56, 0x51, 0x54, 0xb9, 0x24, 0xcc, 0x8f, 0x6d, 0x61, 0xb7, 0x59, 0x60, 0xb7,
+	0xf1, 0x60, 0xe5, 0x44, 0x43, 0x81, 0xd6, 0xe1, 0x72, 0x71, 0x7f, 0xaf, 0xb2, 0x9b, 0x57, 0xca,
+	0xb5, 0xc9, 0x97, 0xba, 0x0c, 0xe2, 0x98, 0x44, 0x68, 0x41, 0x0e, 0x5d, 0x82, 0x0b, 0x63, 0xdc,
+	0x5b, 0x79, 0x65, 0x57, 0x2e, 0x09, 0x3c, 0x3b, 0xf5, 0x9f, 0x1c, 0xac, 0xec, 0x59, 0xa4, 0x8e,
+	0x9b, 0xfa, 0x91, 0xe5, 0xb8, 0xb5, 0xe3, 0x0e, 0xf6, 0x8f, 0xdd, 0x53, 0xaa, 0x05, 0xf9, 0x76,
+	0xfe, 0x9e, 0xb2, 0xaf, 0x6a, 0xb5, 0xcf, 0x2b, 0xf2, 0x89, 0x63, 0xdf, 0x07, 0x69, 0x4c, 0x42,
+	0x29, 0xdf, 0xcb, 0xef, 0x2a, 0x25, 0x2d, 0x3a, 0x51, 0xe0, 0xd0, 0x0f, 0xe0, 0xea, 0xa9, 0x72,
+	0x55, 0xb9, 0xa8, 0xca, 0x35, 0xad, 0x7a, 0x3b, 0xaf, 0xca, 0x02, 0x8f, 0x36, 0xe1, 0xda, 0xa9,
+	0xa2, 0x95, 0xbc, 0x5a, 0x53, 0xf2, 0xbb, 0x9a, 0xef, 0x0e, 0xf9, 0xda, 0x81, 0x2a, 0x0b, 0xb1,
+	0x89, 0x2a, 0x94, 0xf7, 0xcb, 0x81, 0x6c, 0x51, 0xa9, 0xe4, 0x6b, 0xca, 0x7e, 0x59, 0x88, 0x07,
+	0xd7, 0x2c, 0x7c, 0xfa, 0x87, 0xfe, 0x1a, 0xf7, 0xac, 0xbf, 0xc6, 0x7d, 0xdd, 0x5f, 0xe3, 0xfe,
+	0xd1, 0x5f, 0xe3, 0x9e, 0xbe, 0x58, 0x9b, 0xfb, 0xfa, 0xc5, 0xda, 0xdc, 0x37, 0x2f, 0xd6, 0xe6,
+	0x1e, 0xfc, 0xf0, 0x5b, 0xa3, 0xf5, 0x31, 0xfd, 0x37, 0xcf, 0x3b, 0xee, 0x60, 0x52, 0x5f, 0xa0,
+	0xec, 0xed, 0xff, 0x0d, 0x00, 0x67, 0x87, 0x55, 0x81, 0xe6, 0x1b, 0x00, 0x00,
 }
 
 func (this *Group) Equal(that interface{}) bool {
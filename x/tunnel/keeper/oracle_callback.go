@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+var _ oracletypes.ResultCallback = OracleCallback{}
+
+// OracleCallback forwards the results of oracle requests to tunnels. The payload of the callback is
+// the ID of the tunnel, and the prices of a successful standard price reference request are sent
+// to the destination route of the tunnel as a packet.
+type OracleCallback struct {
+	k Keeper
+}
+
+// NewOracleCallback creates a new OracleCallback instance.
+func NewOracleCallback(k Keeper) OracleCallback {
+	return OracleCallback{k}
+}
+
+// OnRequestCreated checks that the tunnel exists and the requester is its creator, as the packets
+// of the tunnel are paid by its fee payer.
+func (cb OracleCallback) OnRequestCreated(
+	ctx sdk.Context,
+	_ oracletypes.RequestID,
+	requester sdk.AccAddress,
+	payload []byte,
+) error {
+	tunnelID, err := types.DecodeOracleCallbackPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tunnel, err := cb.k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+	if requester.String() != tunnel.Creator {
+		return types.ErrInvalidTunnelCreator.Wrapf("requester %s, tunnelID %d", requester, tunnelID)
+	}
+
+	return nil
+}
+
+// OnRequestResolved sends the prices of the result to the destination route of the tunnel. If the
+// packet cannot be produced, the tunnel is deactivated like the tunnels that fail to produce their
+// periodic packets.
+func (cb OracleCallback) OnRequestResolved(ctx sdk.Context, result oracletypes.Result, payload []byte) error {
+	tunnelID, err := types.DecodeOracleCallbackPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tunnel, err := cb.k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+	if !tunnel.IsActive {
+		return types.ErrInactiveTunnel.Wrapf("tunnelID: %d", tunnelID)
+	}
+
+	prices, err := types.NewPricesFromOracleResult(result)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	packet, err := cb.sendPacket(cacheCtx, tunnelID, prices)
+	if err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeProducePacketFail,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+			sdk.NewAttribute(types.AttributeKeyOracleRequestID, fmt.Sprintf("%d", result.RequestID)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+
+		return cb.k.DeactivateTunnel(ctx, tunnelID)
+	}
+	writeFn()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProducePacketSuccess,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyOracleRequestID, fmt.Sprintf("%d", result.RequestID)),
+	))
+
+	return nil
+}
+
+// OnRequestFailed does nothing, as a failed request has no price to forward.
+func (cb OracleCallback) OnRequestFailed(sdk.Context, oracletypes.Result, string, []byte) error {
+	return nil
+}
+
+// OnRequestExpired does nothing, as an expired request has no price to forward.
+func (cb OracleCallback) OnRequestExpired(sdk.Context, oracletypes.Result, []byte) error {
+	return nil
+}
+
+// sendPacket creates a packet of the given prices and sends it to the destination route of the tunnel.
+func (cb OracleCallback) sendPacket(
+	ctx sdk.Context,
+	tunnelID uint64,
+	prices []feedstypes.Price,
+) (types.Packet, error) {
+	packet, err := cb.k.CreatePacket(ctx, tunnelID, prices)
+	if err != nil {
+		return types.Packet{}, err
+	}
+
+	if err := cb.k.SendPacket(ctx, packet); err != nil {
+		return types.Packet{}, sdkerrors.Wrapf(err, "failed to send packet for tunnel %d", tunnelID)
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/keeper"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+type priceInput struct {
+	Symbols    []string `json:"symbols"`
+	Multiplier uint64   `json:"multiplier"`
+}
+
+type priceOutput struct {
+	Rates []uint64 `json:"rates"`
+}
+
+// newPriceResult returns the result of a successful standard price reference request.
+func newPriceResult(symbols []string, rates []uint64) oracletypes.Result {
+	return oracletypes.NewResult(
+		"", 1, obi.MustEncode(priceInput{Symbols: symbols, Multiplier: 1000000000}), 2, 2,
+		42, 2, 1000, 1010, oracletypes.RESOLVE_STATUS_SUCCESS, obi.MustEncode(priceOutput{Rates: rates}),
+	)
+}
+
+func (s *KeeperTestSuite) TestOracleCallbackOnRequestCreated() {
+	ctx := s.ctx
+	cb := keeper.NewOracleCallback(s.keeper)
+
+	tunnel := s.AddSampleTunnel(true)
+	creator := sdk.AccAddress([]byte("creator_address"))
+
+	err := cb.OnRequestCreated(ctx, 1, creator, []byte("invalid"))
+	s.Require().ErrorIs(err, types.ErrInvalidOracleCallback)
+
+	err = cb.OnRequestCreated(ctx, 1, creator, types.NewOracleCallbackPayload(2))
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+
+	err = cb.OnRequestCreated(ctx, 1, sdk.AccAddress([]byte("other_address")), types.NewOracleCallbackPayload(tunnel.ID))
+	s.Require().ErrorIs(err, types.ErrInvalidTunnelCreator)
+
+	err = cb.OnRequestCreated(ctx, 1, creator, types.NewOracleCallbackPayload(tunnel.ID))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestOracleCallbackOnRequestResolved() {
+	ctx, k := s.ctx, s.keeper
+	cb := keeper.NewOracleCallback(k)
+
+	tunnel := s.AddSampleTunnel(true)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	tssFee := sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20)))
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		Return(nil)
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(tssFee, nil)
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		tunnel.ID,
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		feePayer,
+		tssFee,
+	).Return(bandtsstypes.SigningID(1), nil)
+
+	err := cb.OnRequestResolved(
+		ctx,
+		newPriceResult([]string{"BTC", "ETH"}, []uint64{60000, 3000}),
+		types.NewOracleCallbackPayload(tunnel.ID),
+	)
+	s.Require().NoError(err)
+
+	packet, err := k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "BTC", 60000, 1010),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "ETH", 3000, 1010),
+	}, packet.Prices)
+
+	receipt, err := packet.GetReceiptValue()
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewTSSPacketReceipt(1), receipt)
+}
+
+func (s *KeeperTestSuite) TestOracleCallbackOnRequestResolvedSendPacketFail() {
+	ctx, k := s.ctx, s.keeper
+	cb := keeper.NewOracleCallback(k)
+
+	tunnel := s.AddSampleTunnel(true)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	// the tunnel is deactivated and no packet is created.
+	err := cb.OnRequestResolved(
+		ctx,
+		newPriceResult([]string{"BTC"}, []uint64{60000}),
+		types.NewOracleCallbackPayload(tunnel.ID),
+	)
+	s.Require().NoError(err)
+
+	deactivated, err := k.GetTunnel(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().False(deactivated.IsActive)
+	s.Require().Equal(uint64(0), deactivated.Sequence)
+
+	_, err = k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().ErrorIs(err, types.ErrPacketNotFound)
+
+	// the results are no longer forwarded to the inactive tunnel.
+	err = cb.OnRequestResolved(
+		ctx,
+		newPriceResult([]string{"BTC"}, []uint64{60000}),
+		types.NewOracleCallbackPayload(tunnel.ID),
+	)
+	s.Require().ErrorIs(err, types.ErrInactiveTunnel)
+}
+
+func (s *KeeperTestSuite) TestOracleCallbackOnRequestResolvedNotPriceResult() {
+	ctx := s.ctx
+	cb := keeper.NewOracleCallback(s.keeper)
+
+	tunnel := s.AddSampleTunnel(true)
+	result := newPriceResult([]string{"BTC"}, []uint64{60000})
+	result.Result = []byte("test")
+
+	err := cb.OnRequestResolved(ctx, result, types.NewOracleCallbackPayload(tunnel.ID))
+	s.Require().ErrorIs(err, types.ErrInvalidOracleCallback)
+}
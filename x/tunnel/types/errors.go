@@ -31,4 +31,5 @@ var (
 	ErrSendPacketPanic           = errorsmod.Register(ModuleName, 24, "panic in sending packet")
 	ErrInvalidChannelID          = errorsmod.Register(ModuleName, 25, "invalid channel id")
 	ErrInvalidPortID             = errorsmod.Register(ModuleName, 26, "invalid port id")
+	ErrInvalidOracleCallback     = errorsmod.Register(ModuleName, 27, "invalid oracle callback")
 )
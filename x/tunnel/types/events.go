@@ -31,4 +31,5 @@ const (
 	AttributeKeyWithdrawer       = "withdrawer"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyReason           = "reason"
+	AttributeKeyOracleRequestID  = "oracle_request_id"
 )
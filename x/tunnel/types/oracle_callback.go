@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// NewOracleCallbackPayload returns the payload of an oracle request callback that forwards the
+// result of the request to the given tunnel.
+func NewOracleCallbackPayload(tunnelID uint64) []byte {
+	return sdk.Uint64ToBigEndian(tunnelID)
+}
+
+// DecodeOracleCallbackPayload decodes the tunnel ID from the payload of an oracle request callback.
+func DecodeOracleCallbackPayload(payload []byte) (uint64, error) {
+	if len(payload) != 8 {
+		return 0, ErrInvalidOracleCallback.Wrapf("payload length: %d, expected: 8", len(payload))
+	}
+
+	return sdk.BigEndianToUint64(payload), nil
+}
+
+// NewPricesFromOracleResult converts the result of a standard price reference request into the
+// prices of a tunnel packet. The signal ID of a price is the symbol of the request and the price is
+// the rate of the symbol multiplied by the multiplier of the request.
+func NewPricesFromOracleResult(result oracletypes.Result) ([]feedstypes.Price, error) {
+	priceResults, err := oracletypes.DecodePriceResults(
+		result.Calldata,
+		result.Result,
+		result.RequestID,
+		result.ResolveTime,
+	)
+	if err != nil {
+		return nil, ErrInvalidOracleCallback.Wrapf("result is not a standard price reference: %s", err)
+	}
+
+	prices := make([]feedstypes.Price, 0, len(priceResults))
+	for _, pr := range priceResults {
+		prices = append(prices, feedstypes.NewPrice(
+			feedstypes.PRICE_STATUS_AVAILABLE,
+			pr.Symbol,
+			pr.Px,
+			pr.ResolveTime,
+		))
+	}
+
+	return prices, nil
+}
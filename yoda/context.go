@@ -4,15 +4,16 @@ import (
 	"sync/atomic"
 	"time"
 
-	rpcclient "github.com/cometbft/cometbft/rpc/client"
-
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/app/params"
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/pkg/rpcpool"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+	"github.com/bandprotocol/chain/v3/yoda/throttle"
 )
 
 type FeeEstimationData struct {
@@ -32,29 +33,40 @@ type ReportMsgWithKey struct {
 
 type Context struct {
 	encodingConfig   params.EncodingConfig
-	client           rpcclient.Client
+	client           *rpcpool.Pool
 	validator        sdk.ValAddress
 	gasPrices        string
 	keys             []*keyring.Record
 	executor         executor.Executor
 	fileCache        filecache.Cache
+	journal          *journal.Journal
 	broadcastTimeout time.Duration
 	maxTry           uint64
 	rpcPollInterval  time.Duration
 	maxReport        uint64
 
+	throttler            *throttle.Throttler
+	expirationBlockCount uint64
+	blockInterval        time.Duration
+
 	pendingMsgs        chan ReportMsgWithKey
 	freeKeys           chan int64
 	keyRoundRobinIndex int64 // Must use in conjunction with sync/atomic
 
 	pendingRequests map[types.RequestID]bool
 
-	metricsEnabled bool
-	handlingGauge  int64
-	pendingGauge   int64
-	errorCount     int64
-	submittedCount int64
-	home           string
+	metricsEnabled       bool
+	handlingGauge        int64
+	pendingGauge         int64
+	errorCount           int64
+	submittedCount       int64
+	disagreeCount        int64
+	noConsensusCount     int64
+	queuedGauge          int64
+	throttleWaitCount    int64
+	throttleWaitNanos    int64
+	throttleTimeoutCount int64
+	home                 string
 }
 
 func (c *Context) nextKeyIndex() int64 {
@@ -85,3 +97,34 @@ func (c *Context) updateSubmittedCount(amount int64) {
 		atomic.AddInt64(&c.submittedCount, amount)
 	}
 }
+
+func (c *Context) updateDisagreeCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.disagreeCount, amount)
+	}
+}
+
+func (c *Context) updateNoConsensusCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.noConsensusCount, amount)
+	}
+}
+
+func (c *Context) updateQueuedGauge(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.queuedGauge, amount)
+	}
+}
+
+func (c *Context) updateThrottleWait(waited time.Duration) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.throttleWaitCount, 1)
+		atomic.AddInt64(&c.throttleWaitNanos, int64(waited))
+	}
+}
+
+func (c *Context) updateThrottleTimeoutCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.throttleTimeoutCount, amount)
+	}
+}
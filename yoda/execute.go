@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
 )
 
 func signAndBroadcast(
@@ -164,6 +165,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			}
 			// Transaction passed CheckTx process and wait to include in block.
 			txHash = txResp.TxHash
+			updateJournal(c, l, ids, journal.StatusBroadcast, txHash)
 			break
 		}
 		if txHash == "" {
@@ -183,6 +185,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			if txResp.Code == 0 {
 				l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", txHash)
 				c.updateSubmittedCount(int64(len(reports)))
+				updateJournal(c, l, ids, journal.StatusCommitted, txHash)
 				return
 			}
 			if txResp.Codespace == sdkerrors.RootCodespace &&
@@ -194,6 +197,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 				break FindTx
 			} else {
 				l.Error(":exploding_head: Tx returned nonzero code %d with log: %s, tx hash: %s", c, txResp.Code, txResp.RawLog, txResp.TxHash)
+				updateJournal(c, l, ids, journal.StatusFailed, txHash)
 				return
 			}
 		}
@@ -238,18 +242,34 @@ func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 	return resValue, nil
 }
 
-// GetDataSourceHash fetches data source hash by id
-func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID) (string, error) {
-	res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceStoreKey(id))
+// GetDataSourceHash fetches data source hash by id and version. Zero version means the data source
+// hash is taken from the current data source, which is the case of requests made before versions are recorded.
+func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID, version uint64) (string, error) {
+	if version == 0 {
+		res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceStoreKey(id))
+		if err != nil {
+			l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
+			return "", err
+		}
+
+		var d types.DataSource
+		c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &d)
+
+		return d.Filename, nil
+	}
+
+	res, err := abciQuery(
+		c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceVersionStoreKey(id, version),
+	)
 	if err != nil {
-		l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
+		l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
 		return "", err
 	}
 
-	var d types.DataSource
-	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &d)
+	var v types.DataSourceVersion
+	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &v)
 
-	return d.Filename, nil
+	return v.Filename, nil
 }
 
 // GetRequest fetches request by id
@@ -281,3 +301,18 @@ func abciQuery(c *Context, l *Logger, path string, data []byte) (*ctypes.ResultA
 	}
 	return nil, lastErr
 }
+
+// GetParams fetches the parameters of the oracle module
+func GetParams(c *Context, l *Logger) (types.Params, error) {
+	bz := c.encodingConfig.Codec.MustMarshal(&types.QueryParamsRequest{})
+	res, err := abciQuery(c, l, "/band.oracle.v1.Query/Params", bz)
+	if err != nil {
+		l.Error(":exploding_head: Failed to get oracle params with error: %s", c, err.Error())
+		return types.Params{}, err
+	}
+
+	var pr types.QueryParamsResponse
+	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &pr)
+
+	return pr.Params, nil
+}
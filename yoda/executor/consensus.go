@@ -0,0 +1,88 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoConsensus is returned when not enough underlying executors agree on the result.
+var ErrNoConsensus = errors.New("executors do not reach consensus")
+
+// Disagreement describes an execution in which the underlying executors do not all return the same result.
+type Disagreement struct {
+	Results   []ExecResult // Results of the underlying executors, in the same order as the executors.
+	Errs      []error      // Errors of the underlying executors, in the same order as the executors.
+	Agreed    int          // Number of executors in the largest group of agreeing results.
+	Consensus bool         // Whether the largest group reaches the threshold, i.e. its result is returned.
+}
+
+// ConsensusExec is a higher-order executor that runs the same execution on all the underlying executors
+// and returns the result only if at least threshold of them return the same exit code and output.
+type ConsensusExec struct {
+	execs          []Executor         // The underlying executors.
+	threshold      int                // Minimum number of executors that must agree on the result.
+	onDisagreement func(Disagreement) // Callback to be called when the executors disagree (can be nil).
+}
+
+// NewConsensusExec creates a new ConsensusExec instance.
+func NewConsensusExec(execs []Executor, threshold int, onDisagreement func(Disagreement)) (*ConsensusExec, error) {
+	if threshold <= 0 || threshold > len(execs) {
+		return nil, fmt.Errorf("invalid consensus threshold: %d, must be between 1 and %d", threshold, len(execs))
+	}
+	return &ConsensusExec{execs: execs, threshold: threshold, onDisagreement: onDisagreement}, nil
+}
+
+// Exec implements Executor interface for ConsensusExec.
+func (e *ConsensusExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	results := make([]ExecResult, len(e.execs))
+	errs := make([]error, len(e.execs))
+
+	var wg sync.WaitGroup
+	for idx, each := range e.execs {
+		wg.Add(1)
+		go func(idx int, each Executor) {
+			defer wg.Done()
+			results[idx], errs[idx] = each.Exec(code, arg, env)
+		}(idx, each)
+	}
+	wg.Wait()
+
+	// Group the successful results by exit code and output. Ties are broken by the order of the executors.
+	counts := make(map[string]int)
+	firsts := make(map[string]int)
+	best, agreed := -1, 0
+	for idx, res := range results {
+		if errs[idx] != nil {
+			continue
+		}
+		key := resultKey(res)
+		if _, ok := firsts[key]; !ok {
+			firsts[key] = idx
+		}
+		counts[key]++
+		if counts[key] > agreed {
+			best, agreed = firsts[key], counts[key]
+		}
+	}
+
+	if agreed != len(e.execs) && e.onDisagreement != nil {
+		e.onDisagreement(Disagreement{
+			Results:   results,
+			Errs:      errs,
+			Agreed:    agreed,
+			Consensus: agreed >= e.threshold,
+		})
+	}
+	if agreed < e.threshold {
+		return ExecResult{}, fmt.Errorf(
+			"%w: %d of %d executors agree, %d required", ErrNoConsensus, agreed, len(e.execs), e.threshold,
+		)
+	}
+	return results[best], nil
+}
+
+// resultKey returns the key used to compare the results of the underlying executors.
+func resultKey(res ExecResult) string {
+	return fmt.Sprintf("%d:%s", res.Code, res.Output)
+}
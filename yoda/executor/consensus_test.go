@@ -0,0 +1,81 @@
+package executor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusExecBadThreshold(t *testing.T) {
+	exec1 := newMockExec([]byte("output"), 0, nil)
+	_, err := NewConsensusExec([]Executor{exec1}, 0, nil)
+	require.EqualError(t, err, "invalid consensus threshold: 0, must be between 1 and 1")
+	_, err = NewConsensusExec([]Executor{exec1}, 2, nil)
+	require.EqualError(t, err, "invalid consensus threshold: 2, must be between 1 and 1")
+}
+
+func TestConsensusExecAllAgree(t *testing.T) {
+	exec1 := newMockExec([]byte("output"), 0, nil)
+	exec2 := newMockExec([]byte("output"), 0, nil)
+	disagreements := 0
+	exec, err := NewConsensusExec([]Executor{exec1, exec2}, 2, func(Disagreement) { disagreements++ })
+	require.NoError(t, err)
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output"), Code: 0}, result)
+	require.Equal(t, 1, exec1.called)
+	require.Equal(t, 1, exec2.called)
+	require.Equal(t, 0, disagreements)
+}
+
+func TestConsensusExecMajority(t *testing.T) {
+	exec1 := newMockExec([]byte("output1"), 0, nil)
+	exec2 := newMockExec([]byte("output2"), 0, nil)
+	exec3 := newMockExec(nil, 0, errors.New("error3"))
+	exec4 := newMockExec([]byte("output2"), 0, nil)
+	var disagreements []Disagreement
+	exec, err := NewConsensusExec(
+		[]Executor{exec1, exec2, exec3, exec4}, 2, func(d Disagreement) { disagreements = append(disagreements, d) },
+	)
+	require.NoError(t, err)
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	require.Equal(t, []Disagreement{{
+		Results: []ExecResult{
+			{Output: []byte("output1")},
+			{Output: []byte("output2")},
+			{},
+			{Output: []byte("output2")},
+		},
+		Errs:      []error{nil, nil, exec3.err, nil},
+		Agreed:    2,
+		Consensus: true,
+	}}, disagreements)
+}
+
+func TestConsensusExecExitCodeMismatch(t *testing.T) {
+	exec1 := newMockExec([]byte("output"), 0, nil)
+	exec2 := newMockExec([]byte("output"), 1, nil)
+	var disagreements []Disagreement
+	exec, err := NewConsensusExec(
+		[]Executor{exec1, exec2}, 2, func(d Disagreement) { disagreements = append(disagreements, d) },
+	)
+	require.NoError(t, err)
+	_, err = exec.Exec(nil, "", nil)
+	require.ErrorIs(t, err, ErrNoConsensus)
+	require.EqualError(t, err, "executors do not reach consensus: 1 of 2 executors agree, 2 required")
+	require.Len(t, disagreements, 1)
+	require.Equal(t, 1, disagreements[0].Agreed)
+	require.False(t, disagreements[0].Consensus)
+}
+
+func TestConsensusExecAllErrors(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec2 := newMockExec(nil, 0, errors.New("error2"))
+	exec, err := NewConsensusExec([]Executor{exec1, exec2}, 1, nil)
+	require.NoError(t, err)
+	_, err = exec.Exec(nil, "", nil)
+	require.EqualError(t, err, "executors do not reach consensus: 0 of 2 executors agree, 1 required")
+}
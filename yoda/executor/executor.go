@@ -40,6 +40,11 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	switch name {
 	case "rest":
 		exec = NewRestExec(base, timeout)
+	case "local":
+		exec, err = NewLocalExec(base, timeout)
+		if err != nil {
+			return nil, err
+		}
 	case "docker":
 		return nil, fmt.Errorf("docker executor is currently not supported")
 	default:
@@ -67,6 +72,28 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	return exec, nil
 }
 
+// NewExecutors returns executor from the comma-separated executor names and URLs. If there is more than
+// one executor, they are tried in order until one of them succeeds when consensus is 0. Otherwise, all of
+// them are run and the result is returned only if at least consensus of them agree on it.
+func NewExecutors(executors string, consensus int, onDisagreement func(Disagreement)) (Executor, error) {
+	var execs []Executor
+	for _, executor := range strings.Split(executors, ",") {
+		exec, err := NewExecutor(strings.TrimSpace(executor))
+		if err != nil {
+			return nil, err
+		}
+		execs = append(execs, exec)
+	}
+
+	if consensus != 0 {
+		return NewConsensusExec(execs, consensus, onDisagreement)
+	}
+	if len(execs) == 1 {
+		return execs[0], nil
+	}
+	return NewMultiExec(execs, "order")
+}
+
 // parseExecutor splits the executor string in the form of "name:base?timeout=" into parts.
 func parseExecutor(executorStr string) (name string, base string, timeout time.Duration, err error) {
 	executor := strings.SplitN(executorStr, ":", 2)
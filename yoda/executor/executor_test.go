@@ -41,3 +41,8 @@ func TestParseExecutorInvalidTimeoutError(t *testing.T) {
 	_, _, _, err := parseExecutor("test:www.bandprotocol.com?timeout=test")
 	require.EqualError(t, err, "invalid timeout, cannot parse duration with error: time: invalid duration \"test\"")
 }
+
+func TestNewExecutorsInvalidExecutor(t *testing.T) {
+	_, err := NewExecutors("test:www.bandprotocol.com", 0, nil)
+	require.EqualError(t, err, "invalid timeout, executor requires query timeout")
+}
@@ -0,0 +1,256 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	flagQueryCPU      = "cpu"
+	flagQueryMemory   = "memory"
+	flagQueryFiles    = "files"
+	flagQueryFileSize = "filesize"
+	flagQueryOutput   = "output"
+	flagQueryNetwork  = "network"
+	flagQueryProxy    = "proxy"
+
+	defaultMemoryLimit   = 256 // MiB
+	defaultFilesLimit    = 64
+	defaultFileSizeLimit = 8 // MiB
+
+	networkHost = "host"
+	networkNone = "none"
+
+	// localVersion is the version reported by the local executor.
+	localVersion = "local"
+
+	// timeoutExitCode is the exit code reported when the execution times out, same as the one
+	// returned by the REST executor.
+	timeoutExitCode = 111
+)
+
+// LocalExec is an executor that runs data sources as child processes of yoda, each in its own
+// temporary directory with limited resources.
+type LocalExec struct {
+	workDir        string        // Directory to create the temporary directories of executions in.
+	timeout        time.Duration // Wall-clock time limit of an execution.
+	cpuLimit       uint64        // CPU time limit in seconds.
+	memoryLimit    uint64        // Virtual memory limit in MiB.
+	filesLimit     uint64        // Maximum number of open files.
+	fileSizeLimit  uint64        // Maximum size of a written file in MiB.
+	outputLimit    int           // Maximum size of the output in bytes.
+	isolateNetwork bool          // Whether to run in an isolated network namespace.
+	proxy          string        // Proxy that the outbound HTTP(S) requests are routed through.
+}
+
+// NewLocalExec creates a local executor from the base in the form of "workdir?option=value", where
+// the options are cpu (seconds), memory (MiB), files, filesize (MiB), output (bytes), network
+// ("host" or "none") and proxy (URL).
+func NewLocalExec(base string, timeout time.Duration) (*LocalExec, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local executor base: %s", err.Error())
+	}
+
+	workDir := u.Path
+	if workDir == "" {
+		workDir = os.TempDir()
+	}
+
+	query := u.Query()
+	e := &LocalExec{
+		workDir:     workDir,
+		timeout:     timeout,
+		cpuLimit:    uint64(timeout.Round(time.Second).Seconds()),
+		outputLimit: int(types.DefaultMaxReportDataSize),
+		proxy:       query.Get(flagQueryProxy),
+	}
+	if e.cpuLimit == 0 {
+		e.cpuLimit = 1
+	}
+
+	for flag, target := range map[string]*uint64{
+		flagQueryCPU:      &e.cpuLimit,
+		flagQueryMemory:   &e.memoryLimit,
+		flagQueryFiles:    &e.filesLimit,
+		flagQueryFileSize: &e.fileSizeLimit,
+	} {
+		if err := parseLimit(query, flag, target); err != nil {
+			return nil, err
+		}
+	}
+	if e.memoryLimit == 0 {
+		e.memoryLimit = defaultMemoryLimit
+	}
+	if e.filesLimit == 0 {
+		e.filesLimit = defaultFilesLimit
+	}
+	if e.fileSizeLimit == 0 {
+		e.fileSizeLimit = defaultFileSizeLimit
+	}
+
+	if outputStr := query.Get(flagQueryOutput); outputStr != "" {
+		output, err := strconv.Atoi(outputStr)
+		if err != nil || output <= 0 {
+			return nil, fmt.Errorf("invalid %s, must be a positive integer: %s", flagQueryOutput, outputStr)
+		}
+		e.outputLimit = output
+	}
+
+	switch network := query.Get(flagQueryNetwork); network {
+	case "", networkHost:
+	case networkNone:
+		if e.proxy != "" {
+			return nil, fmt.Errorf("proxy cannot be used with network %s", networkNone)
+		}
+		e.isolateNetwork = true
+	default:
+		return nil, fmt.Errorf("invalid %s, must be %s or %s: %s", flagQueryNetwork, networkHost, networkNone, network)
+	}
+
+	return e, nil
+}
+
+// parseLimit parses the positive integer of the given flag in the query into the target, if set.
+func parseLimit(query url.Values, flag string, target *uint64) error {
+	valueStr := query.Get(flag)
+	if valueStr == "" {
+		return nil
+	}
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil || value == 0 {
+		return fmt.Errorf("invalid %s, must be a positive integer: %s", flag, valueStr)
+	}
+	*target = value
+	return nil
+}
+
+// Exec implements Executor interface for LocalExec.
+func (e *LocalExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	dir, err := os.MkdirTemp(e.workDir, "yoda-exec-")
+	if err != nil {
+		return ExecResult{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	execPath := filepath.Join(dir, "exec")
+	if err := os.WriteFile(execPath, code, 0o700); err != nil {
+		return ExecResult{}, err
+	}
+
+	args, err := shlex.Split(arg)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	envs, err := e.environ(dir, env)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	// The resource limits are applied by the shell, which then replaces itself with the data source.
+	script := fmt.Sprintf(
+		`ulimit -t %d && ulimit -v %d && ulimit -n %d && ulimit -f %d && exec "$0" "$@"`,
+		e.cpuLimit,
+		e.memoryLimit*1024,     // KiB
+		e.filesLimit,           // files
+		e.fileSizeLimit*1024*2, // 512-byte blocks
+	)
+	cmd := exec.CommandContext(ctx, "/bin/sh", append([]string{"-c", script, execPath}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = envs
+	if err := configureSandbox(cmd, e.isolateNetwork); err != nil {
+		return ExecResult{}, err
+	}
+	cmd.Cancel = func() error { return killSandbox(cmd) }
+	cmd.WaitDelay = time.Second
+
+	stdout := &limitedBuffer{limit: e.outputLimit}
+	stderr := &limitedBuffer{limit: e.outputLimit}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return ExecResult{Output: []byte{}, Code: timeoutExitCode, Version: localVersion}, nil
+	}
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return ExecResult{}, err
+		}
+	}
+
+	exitCode := exitCodeOf(cmd.ProcessState)
+	if exitCode == 0 {
+		return ExecResult{Output: stdout.Bytes(), Code: 0, Version: localVersion}, nil
+	}
+	return ExecResult{Output: stderr.Bytes(), Code: exitCode, Version: localVersion}, nil
+}
+
+// environ returns the environment variables of an execution in the given directory. The environment
+// of yoda is not inherited except PATH, so that the data sources cannot read the secrets in it.
+func (e *LocalExec) environ(dir string, env interface{}) ([]string, error) {
+	envs := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+	}
+	if e.proxy != "" {
+		for _, key := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+			envs = append(envs, key+"="+e.proxy)
+		}
+	}
+
+	// The variables are encoded the same way as they are sent to the REST executor.
+	bz, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]interface{}{}
+	if err := json.Unmarshal(bz, &vars); err != nil {
+		return nil, err
+	}
+	for key, value := range vars {
+		if s, ok := value.(string); ok {
+			envs = append(envs, key+"="+s)
+		} else {
+			envs = append(envs, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+	return envs, nil
+}
+
+// limitedBuffer is a writer that keeps only the first limit bytes written to it and discards the
+// rest without failing the writer.
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+// Write implements io.Writer interface for limitedBuffer.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); remaining > 0 {
+		b.buf.Write(p[:min(len(p), remaining)])
+	}
+	return len(p), nil
+}
+
+// Bytes returns the bytes kept in the buffer.
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
@@ -0,0 +1,140 @@
+//go:build linux
+
+package executor
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecutorLocal(t *testing.T) {
+	_, err := NewExecutor("local:?timeout=5s")
+	require.NoError(t, err)
+}
+
+func TestNewLocalExecInvalidOptions(t *testing.T) {
+	_, err := NewLocalExec("?memory=0", time.Second)
+	require.EqualError(t, err, "invalid memory, must be a positive integer: 0")
+
+	_, err = NewLocalExec("?output=abc", time.Second)
+	require.EqualError(t, err, "invalid output, must be a positive integer: abc")
+
+	_, err = NewLocalExec("?network=bridge", time.Second)
+	require.EqualError(t, err, "invalid network, must be host or none: bridge")
+
+	_, err = NewLocalExec("?network=none&proxy=http://localhost:3128", time.Second)
+	require.EqualError(t, err, "proxy cannot be used with network none")
+}
+
+func TestLocalExecSuccess(t *testing.T) {
+	e, err := NewLocalExec("", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\necho \"$1 $2\" \"$BAND_CHAIN_ID\""), "hello 'big world'", map[string]interface{}{
+		"BAND_CHAIN_ID": "test-chain-id",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, "hello big world test-chain-id\n", string(res.Output))
+	require.Equal(t, localVersion, res.Version)
+}
+
+func TestLocalExecFail(t *testing.T) {
+	e, err := NewLocalExec("", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\necho out\necho err >&2\nexit 3"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.Code)
+	require.Equal(t, "err\n", string(res.Output))
+}
+
+func TestLocalExecTimeout(t *testing.T) {
+	e, err := NewLocalExec("", 500*time.Millisecond)
+	require.NoError(t, err)
+
+	start := time.Now()
+	res, err := e.Exec([]byte("#!/bin/sh\nsleep 10 &\nsleep 10"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(timeoutExitCode), res.Code)
+	require.Empty(t, res.Output)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestLocalExecOutputLimit(t *testing.T) {
+	e, err := NewLocalExec("?output=10", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\nhead -c 100000 /dev/zero | tr '\\0' 'a'"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, strings.Repeat("a", 10), string(res.Output))
+}
+
+func TestLocalExecEnvironmentNotInherited(t *testing.T) {
+	t.Setenv("YODA_SECRET", "secret")
+
+	e, err := NewLocalExec("", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\necho \"[$YODA_SECRET]\" && pwd && echo \"$HOME\""), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	lines := strings.Split(strings.TrimSpace(string(res.Output)), "\n")
+	require.Equal(t, "[]", lines[0])
+	// the data source runs in its own temporary directory, which is removed afterwards.
+	require.Equal(t, lines[1], lines[2])
+	require.NoDirExists(t, lines[1])
+}
+
+func TestLocalExecMemoryLimit(t *testing.T) {
+	e, err := NewLocalExec("?memory=64", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec(
+		[]byte("#!/usr/bin/env python3\nbuf = bytearray(256 * 1024 * 1024)\nprint(len(buf))"),
+		"",
+		nil,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Contains(t, string(res.Output), "MemoryError")
+}
+
+func TestLocalExecNetworkNone(t *testing.T) {
+	if _, err := os.Stat("/proc/self/ns/user"); err != nil {
+		t.Skip("user namespaces are not available")
+	}
+	e, err := NewLocalExec("?network=none", 5*time.Second)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\ncat /proc/net/dev | tail -n +3 | cut -d: -f1"), "", nil)
+	if err != nil {
+		t.Skipf("user namespaces are not permitted: %s", err)
+	}
+	require.Equal(t, uint32(0), res.Code)
+	// only the loopback interface exists in the isolated network namespace.
+	require.Equal(t, "lo", strings.TrimSpace(string(res.Output)))
+}
+
+func TestNewExecutorsLocal(t *testing.T) {
+	exec, err := NewExecutors("local:?timeout=5s", 0, nil)
+	require.NoError(t, err)
+	require.IsType(t, &LocalExec{}, exec)
+
+	exec, err = NewExecutors("local:?timeout=5s, local:?timeout=5s&memory=128", 0, nil)
+	require.NoError(t, err)
+	require.IsType(t, &MultiExec{}, exec)
+
+	exec, err = NewExecutors("local:?timeout=5s, local:?timeout=5s&memory=128", 2, nil)
+	require.NoError(t, err)
+	require.IsType(t, &ConsensusExec{}, exec)
+
+	_, err = NewExecutors("local:?timeout=5s, local:?timeout=5s", 3, nil)
+	require.EqualError(t, err, "invalid consensus threshold: 3, must be between 1 and 2")
+}
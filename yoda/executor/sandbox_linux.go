@@ -0,0 +1,38 @@
+//go:build linux
+
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// configureSandbox makes the command run in its own process group, so that all of its processes
+// can be killed together, and in a new network namespace without any interface if the network is
+// to be isolated. The network namespace is created in a new user namespace so that it does not
+// require root privileges.
+func configureSandbox(cmd *exec.Cmd, isolateNetwork bool) error {
+	attr := &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+	if isolateNetwork {
+		attr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET
+		attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1}}
+		attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+	}
+	cmd.SysProcAttr = attr
+	return nil
+}
+
+// killSandbox kills all the processes in the process group of the command.
+func killSandbox(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// exitCodeOf returns the exit code of the process, or 128 plus the signal number if the process
+// is killed by a signal, e.g. when it exceeds the CPU time limit.
+func exitCodeOf(state *os.ProcessState) uint32 {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return uint32(128 + status.Signal())
+	}
+	return uint32(state.ExitCode())
+}
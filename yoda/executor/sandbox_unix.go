@@ -0,0 +1,34 @@
+//go:build unix && !linux
+
+package executor
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// configureSandbox makes the command run in its own process group, so that all of its processes
+// can be killed together. Network isolation is only supported on Linux.
+func configureSandbox(cmd *exec.Cmd, isolateNetwork bool) error {
+	if isolateNetwork {
+		return errors.New("network isolation is only supported on linux")
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return nil
+}
+
+// killSandbox kills all the processes in the process group of the command.
+func killSandbox(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// exitCodeOf returns the exit code of the process, or 128 plus the signal number if the process
+// is killed by a signal, e.g. when it exceeds the CPU time limit.
+func exitCodeOf(state *os.ProcessState) uint32 {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return uint32(128 + status.Signal())
+	}
+	return uint32(state.ExitCode())
+}
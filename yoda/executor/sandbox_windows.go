@@ -0,0 +1,25 @@
+//go:build windows
+
+package executor
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// configureSandbox always fails, as the local executor relies on a POSIX shell to limit the
+// resources of the data sources.
+func configureSandbox(_ *exec.Cmd, _ bool) error {
+	return errors.New("local executor is not supported on windows")
+}
+
+// killSandbox kills the process of the command.
+func killSandbox(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+// exitCodeOf returns the exit code of the process.
+func exitCodeOf(state *os.ProcessState) uint32 {
+	return uint32(state.ExitCode())
+}
@@ -1,16 +1,26 @@
 package yoda
 
 import (
+	"context"
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+	"github.com/bandprotocol/chain/v3/yoda/throttle"
 )
 
 type processingResult struct {
@@ -83,7 +93,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// prepare raw requests
 	for _, raw := range req.RawRequests {
-		hash, err := GetDataSourceHash(c, l, raw.DataSourceID)
+		hash, err := GetDataSourceHash(c, l, raw.DataSourceID, raw.DataSourceVersion)
 		if err != nil {
 			l.Error(":skull: Failed to get data source hash with error: %s", c, err.Error())
 			return
@@ -97,8 +107,23 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 		})
 	}
 
-	// process raw requests
-	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
+	var reports []types.RawReport
+	var execVersions []string
+	entry, found, done := checkJournal(c, l, id)
+	switch {
+	case done:
+		return
+	case found:
+		l.Info(":floppy_disk: Resubmitting reports from journal with status: %s", entry.Status)
+		reports, execVersions = entry.Reports, entry.ExecVersions
+	default:
+		// process raw requests before the request expires
+		deadline := throttle.Deadline(req.RequestTime, c.expirationBlockCount, c.blockInterval)
+		reports, execVersions = handleRawRequests(c, l, id, deadline, rawRequests, key)
+		if err := c.journal.Record(id, reports, execVersions); err != nil {
+			l.Error(":skull: Failed to record reports in journal with error: %s", c, err.Error())
+		}
+	}
 
 	c.pendingMsgs <- ReportMsgWithKey{
 		msg:         types.NewMsgReportData(id, reports, c.validator),
@@ -118,6 +143,7 @@ func handleRawRequests(
 	c *Context,
 	l *Logger,
 	id types.RequestID,
+	deadline time.Time,
 	reqs []rawRequest,
 	key *keyring.Record,
 ) (reports []types.RawReport, execVersions []string) {
@@ -129,6 +155,7 @@ func handleRawRequests(
 			req,
 			key,
 			id,
+			deadline,
 			resultsChan,
 		)
 	}
@@ -156,6 +183,7 @@ func handleRawRequest(
 	req rawRequest,
 	key *keyring.Record,
 	id types.RequestID,
+	deadline time.Time,
 	processingResultCh chan processingResult,
 ) {
 	c.updateHandlingGauge(1)
@@ -184,15 +212,18 @@ func handleRawRequest(
 		return
 	}
 
-	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
-		"BAND_CHAIN_ID":       vmsg.ChainID,
-		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
-		"BAND_VALIDATOR":      vmsg.Validator,
-		"BAND_REQUEST_ID":     strconv.Itoa(int(vmsg.RequestID)),
-		"BAND_EXTERNAL_ID":    strconv.Itoa(int(vmsg.ExternalID)),
-		"BAND_REPORTER":       hex.EncodeToString(pubkey.Bytes()),
-		"BAND_SIGNATURE":      sig,
-	})
+	release, err := waitForExecution(c, req.dataSourceID, deadline)
+	if err != nil {
+		l.Error(":hourglass: Failed to start data source execution before the request expires: %s", c, err.Error())
+		processingResultCh <- processingResult{
+			rawReport: types.NewRawReport(req.externalID, 255, nil),
+			err:       err,
+		}
+		return
+	}
+	defer release()
+
+	result, err := c.executor.Exec(exec, req.calldata, executionEnv(vmsg, pubkey, sig))
 
 	if err != nil {
 		l.Error(":skull: Failed to execute data source script: %s", c, err.Error())
@@ -212,3 +243,98 @@ func handleRawRequest(
 		}
 	}
 }
+
+// executionEnv returns the environment variables of a data source execution, which let the data source
+// provider verify that the execution is for a request assigned to the validator.
+func executionEnv(vmsg types.RequestVerification, pubkey cryptotypes.PubKey, sig []byte) map[string]interface{} {
+	return map[string]interface{}{
+		"BAND_CHAIN_ID":       vmsg.ChainID,
+		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
+		"BAND_VALIDATOR":      vmsg.Validator,
+		"BAND_REQUEST_ID":     strconv.Itoa(int(vmsg.RequestID)),
+		"BAND_EXTERNAL_ID":    strconv.Itoa(int(vmsg.ExternalID)),
+		"BAND_REPORTER":       hex.EncodeToString(pubkey.Bytes()),
+		"BAND_SIGNATURE":      sig,
+	}
+}
+
+// checkJournal checks the journal for the work done on the request, e.g. before yoda restarts. It returns the
+// journal entry if its reports are yet to be committed, or done if there is nothing left to do for the request.
+func checkJournal(c *Context, l *Logger, id types.RequestID) (entry journal.Entry, found bool, done bool) {
+	entry, found, err := c.journal.Get(id)
+	if err != nil {
+		l.Error(":skull: Failed to get journal entry with error: %s", c, err.Error())
+		return journal.Entry{}, false, false
+	}
+	if !found {
+		return journal.Entry{}, false, false
+	}
+
+	// A failed report transaction is treated like an executed entry, so its reports are resubmitted.
+	switch entry.Status {
+	case journal.StatusCommitted:
+		l.Info(":floppy_disk: Skip request already handled with status: %s", entry.Status)
+		return entry, true, true
+	case journal.StatusBroadcast:
+		clientCtx := client.Context{
+			Client:            c.client,
+			TxConfig:          c.encodingConfig.TxConfig,
+			InterfaceRegistry: c.encodingConfig.InterfaceRegistry,
+		}
+		txResp, err := authtx.QueryTx(clientCtx, entry.TxHash)
+		if err == nil && txResp.Code == 0 {
+			l.Info(":floppy_disk: Skip request already reported in tx with hash: %s", entry.TxHash)
+			updateJournal(c, l, []types.RequestID{id}, journal.StatusCommitted, entry.TxHash)
+			return entry, true, true
+		}
+	}
+	return entry, true, false
+}
+
+// updateJournal updates the status of the journal entries of the given requests.
+func updateJournal(c *Context, l *Logger, ids []types.RequestID, status journal.Status, txHash string) {
+	if err := c.journal.SetStatus(ids, status, txHash); err != nil {
+		l.Error(":skull: Failed to update journal with error: %s", c, err.Error())
+	}
+}
+
+// waitForExecution waits until an execution of the data source is allowed by the throttler, or fails if it is
+// not allowed before the given deadline.
+func waitForExecution(c *Context, id types.DataSourceID, deadline time.Time) (release func(), err error) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	c.updateQueuedGauge(1)
+	defer c.updateQueuedGauge(-1)
+
+	start := time.Now()
+	release, err = c.throttler.Acquire(ctx, id)
+	if err != nil {
+		c.updateThrottleTimeoutCount(1)
+		return nil, err
+	}
+	c.updateThrottleWait(time.Since(start))
+	return release, nil
+}
+
+// handleExecutorDisagreement logs the results of the executors that disagree on an execution and updates
+// the metrics. The execution itself is logged by the handler of the raw request.
+func handleExecutorDisagreement(c *Context, l *Logger, d executor.Disagreement) {
+	c.updateDisagreeCount(1)
+	if !d.Consensus {
+		c.updateNoConsensusCount(1)
+	}
+
+	results := make([]string, len(d.Results))
+	for idx, res := range d.Results {
+		if d.Errs[idx] != nil {
+			results[idx] = fmt.Sprintf("#%d error: %s", idx, d.Errs[idx].Error())
+		} else {
+			results[idx] = fmt.Sprintf("#%d exitCode: %d, output: %q", idx, res.Code, res.Output)
+		}
+	}
+	l.Info(
+		":warning: Executors disagree (%d agreed, consensus: %t): %s",
+		d.Agreed, d.Consensus, strings.Join(results, "; "),
+	)
+}
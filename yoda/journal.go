@@ -0,0 +1,100 @@
+package yoda
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+)
+
+const (
+	flagLimit = "limit"
+)
+
+func journalCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "journal",
+		Aliases: []string{"j"},
+		Short:   "Inspect the work journal of the oracle process",
+	}
+	cmd.AddCommand(
+		journalListCmd(c),
+		journalShowCmd(c),
+	)
+	return cmd
+}
+
+func journalListCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l"},
+		Short:   "List the most recently updated journal entries",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+			entries, err := journal.New(filepath.Join(c.home, "journal")).List()
+			if err != nil {
+				return err
+			}
+			if limit != 0 && uint64(len(entries)) > limit {
+				entries = entries[:limit]
+			}
+
+			for _, entry := range entries {
+				txHash := entry.TxHash
+				if txHash == "" {
+					txHash = "-"
+				}
+				fmt.Printf(
+					"%s request: %d status: %s reports: %d tx: %s\n",
+					entry.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+					entry.RequestID,
+					entry.Status,
+					len(entry.Reports),
+					txHash,
+				)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagLimit, 20, "The maximum number of entries to list, or 0 to list all")
+	return cmd
+}
+
+func journalShowCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show [request-id]",
+		Aliases: []string{"s"},
+		Short:   "Show the journal entry of the request",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			entry, ok, err := journal.New(filepath.Join(c.home, "journal")).Get(types.RequestID(id))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("journal entry of request %d not found", id)
+			}
+
+			bz, err := json.MarshalIndent(entry, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,174 @@
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/diskv"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Status is the progress of the work on a request.
+type Status string
+
+const (
+	// StatusExecuted means the data sources are executed, but the report is not yet accepted by a node.
+	StatusExecuted Status = "executed"
+	// StatusBroadcast means the report transaction is accepted by a node, but not yet committed.
+	StatusBroadcast Status = "broadcast"
+	// StatusCommitted means the report transaction is successfully committed.
+	StatusCommitted Status = "committed"
+	// StatusFailed means the report transaction cannot be committed, so the reports are to be resubmitted.
+	StatusFailed Status = "failed"
+)
+
+// Entry is the record of the work on a request.
+type Entry struct {
+	RequestID    types.RequestID   `json:"request_id"`
+	Status       Status            `json:"status"`
+	Reports      []types.RawReport `json:"reports"`
+	ExecVersions []string          `json:"exec_versions"`
+	TxHash       string            `json:"tx_hash,omitempty"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// Journal is a file-backed record of the work on requests, keyed by request ID.
+type Journal struct {
+	mtx   sync.Mutex // Serializes the read-modify-write updates.
+	store *diskv.Diskv
+}
+
+// New creates and returns a new journal in the given directory.
+func New(basePath string) *Journal {
+	return &Journal{
+		store: diskv.New(diskv.Options{
+			BasePath:     basePath,
+			TempDir:      filepath.Join(basePath, ".tmp"), // Writes are atomic with a temporary directory.
+			Transform:    func(s string) []string { return []string{} },
+			CacheSizeMax: 0,
+		}),
+	}
+}
+
+// Get returns the entry of the given request ID, or false if there is no entry.
+func (j *Journal) Get(id types.RequestID) (Entry, bool, error) {
+	bz, err := j.store.Read(key(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return Entry{}, false, err
+	}
+	return entry, true, nil
+}
+
+// Record saves the executed reports of the given request, replacing the existing entry if any.
+func (j *Journal) Record(id types.RequestID, reports []types.RawReport, execVersions []string) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	return j.put(Entry{
+		RequestID:    id,
+		Status:       StatusExecuted,
+		Reports:      reports,
+		ExecVersions: execVersions,
+	})
+}
+
+// SetStatus updates the status and the report transaction hash of the existing entries of the given requests.
+func (j *Journal) SetStatus(ids []types.RequestID, status Status, txHash string) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	for _, id := range ids {
+		entry, ok, err := j.Get(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		entry.Status = status
+		entry.TxHash = txHash
+		if err := j.put(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns all the entries, most recently updated first.
+func (j *Journal) List() ([]Entry, error) {
+	var entries []Entry
+	for k := range j.store.Keys(nil) {
+		id, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			continue // Not an entry.
+		}
+		entry, ok, err := j.Get(types.RequestID(id))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, k int) bool {
+		if entries[i].UpdatedAt.Equal(entries[k].UpdatedAt) {
+			return entries[i].RequestID > entries[k].RequestID
+		}
+		return entries[i].UpdatedAt.After(entries[k].UpdatedAt)
+	})
+	return entries, nil
+}
+
+// Prune removes the entries that are last updated before the given time, and returns the number of them.
+func (j *Journal) Prune(before time.Time) (int, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	entries, err := j.List()
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for _, entry := range entries {
+		if !entry.UpdatedAt.Before(before) {
+			continue
+		}
+		if err := j.store.Erase(key(entry.RequestID)); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// put saves the given entry with the current time as its update time.
+func (j *Journal) put(entry Entry) error {
+	entry.UpdatedAt = time.Now().UTC()
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return j.store.Write(key(entry.RequestID), bz)
+}
+
+// key returns the key of the entry of the given request ID.
+func key(id types.RequestID) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
@@ -0,0 +1,76 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestRecordAndGet(t *testing.T) {
+	j := New(t.TempDir())
+
+	_, ok, err := j.Get(1)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	reports := []types.RawReport{types.NewRawReport(1, 0, []byte("data")), types.NewRawReport(2, 255, nil)}
+	require.NoError(t, j.Record(1, reports, []string{"v1"}))
+
+	entry, ok, err := j.Get(1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, types.RequestID(1), entry.RequestID)
+	require.Equal(t, StatusExecuted, entry.Status)
+	require.Equal(t, reports, entry.Reports)
+	require.Equal(t, []string{"v1"}, entry.ExecVersions)
+	require.Empty(t, entry.TxHash)
+	require.False(t, entry.UpdatedAt.IsZero())
+}
+
+func TestSetStatus(t *testing.T) {
+	j := New(t.TempDir())
+	require.NoError(t, j.Record(1, nil, nil))
+	require.NoError(t, j.Record(2, nil, nil))
+
+	// entries that do not exist are skipped.
+	require.NoError(t, j.SetStatus([]types.RequestID{1, 2, 3}, StatusBroadcast, "HASH"))
+
+	for _, id := range []types.RequestID{1, 2} {
+		entry, ok, err := j.Get(id)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, StatusBroadcast, entry.Status)
+		require.Equal(t, "HASH", entry.TxHash)
+	}
+	_, ok, err := j.Get(3)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestListAndPrune(t *testing.T) {
+	j := New(t.TempDir())
+	require.NoError(t, j.Record(1, nil, nil))
+	time.Sleep(10 * time.Millisecond)
+	cutoff := time.Now()
+	require.NoError(t, j.Record(2, nil, nil))
+	require.NoError(t, j.Record(3, nil, nil))
+
+	entries, err := j.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, types.RequestID(1), entries[2].RequestID)
+
+	pruned, err := j.Prune(cutoff)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	entries, err = j.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	_, ok, err := j.Get(1)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -23,28 +23,44 @@ import (
 )
 
 const (
-	flagValidator        = "validator"
-	flagLogLevel         = "log-level"
-	flagExecutor         = "executor"
-	flagBroadcastTimeout = "broadcast-timeout"
-	flagRPCPollInterval  = "rpc-poll-interval"
-	flagMaxTry           = "max-try"
-	flagMaxReport        = "max-report"
+	flagValidator           = "validator"
+	flagLogLevel            = "log-level"
+	flagExecutor            = "executor"
+	flagExecutorConsensus   = "executor-consensus"
+	flagBroadcastTimeout    = "broadcast-timeout"
+	flagRPCPollInterval     = "rpc-poll-interval"
+	flagMaxTry              = "max-try"
+	flagMaxReport           = "max-report"
+	flagThrottle            = "throttle"
+	flagDataSourceThrottle  = "data-source-throttle"
+	flagDataSourceThrottles = "data-source-throttles"
+	flagBlockInterval       = "block-interval"
+	flagJournalRetention    = "journal-retention"
+	flagFileCacheSize       = "file-cache-size"
+	flagFileCacheDiskLimit  = "file-cache-disk-limit"
 )
 
 // Config data structure for yoda daemon.
 type Config struct {
-	ChainID           string `mapstructure:"chain-id"`            // ChainID of the target chain
-	NodeURI           string `mapstructure:"node"`                // Remote RPC URI of BandChain node to connect to
-	Validator         string `mapstructure:"validator"`           // The validator address that I'm responsible for
-	GasPrices         string `mapstructure:"gas-prices"`          // Gas prices of the transaction
-	LogLevel          string `mapstructure:"log-level"`           // Log level of the logger
-	Executor          string `mapstructure:"executor"`            // Executor name and URL (example: "Executor name:URL")
-	BroadcastTimeout  string `mapstructure:"broadcast-timeout"`   // The time that Yoda will wait for tx commit
-	RPCPollInterval   string `mapstructure:"rpc-poll-interval"`   // The duration of rpc poll interval
-	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
-	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
-	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	ChainID             string `mapstructure:"chain-id"`              // ChainID of the target chain
+	NodeURI             string `mapstructure:"node"`                  // Comma-separated remote RPC URIs of BandChain nodes to connect to
+	Validator           string `mapstructure:"validator"`             // The validator address that I'm responsible for
+	GasPrices           string `mapstructure:"gas-prices"`            // Gas prices of the transaction
+	LogLevel            string `mapstructure:"log-level"`             // Log level of the logger
+	Executor            string `mapstructure:"executor"`              // Comma-separated executor names and URLs (example: "Executor name:URL")
+	ExecutorConsensus   uint64 `mapstructure:"executor-consensus"`    // The number of executors that must agree on a result, or 0 to fail over
+	BroadcastTimeout    string `mapstructure:"broadcast-timeout"`     // The time that Yoda will wait for tx commit
+	RPCPollInterval     string `mapstructure:"rpc-poll-interval"`     // The duration of rpc poll interval
+	MaxTry              uint64 `mapstructure:"max-try"`               // The maximum number of tries to submit a report transaction
+	MaxReport           uint64 `mapstructure:"max-report"`            // The maximum number of reports in one transaction
+	MetricsListenAddr   string `mapstructure:"metrics-listen-addr"`   // Address to listen on for prometheus metrics
+	Throttle            string `mapstructure:"throttle"`              // Global limit of executions in the form of "concurrency:rate:burst"
+	DataSourceThrottle  string `mapstructure:"data-source-throttle"`  // Limit of executions of each data source
+	DataSourceThrottles string `mapstructure:"data-source-throttles"` // Comma-separated limits of specific data sources (example: "1=2:5:5")
+	BlockInterval       string `mapstructure:"block-interval"`        // The expected interval between blocks to estimate request expiration
+	JournalRetention    string `mapstructure:"journal-retention"`     // The duration to keep the journal entries of handled requests
+	FileCacheSize       uint64 `mapstructure:"file-cache-size"`       // Size in megabytes of data source executables to cache in memory
+	FileCacheDiskLimit  uint64 `mapstructure:"file-cache-disk-limit"` // Size limit in megabytes of cached data source executables on disk
 }
 
 // Global instances.
@@ -84,8 +100,10 @@ func Main() {
 
 	rootCmd.AddCommand(
 		configCmd(),
+		journalCmd(ctx),
 		keysCmd(),
 		runCmd(ctx),
+		testDataSourceCmd(ctx),
 		version.NewVersionCommand(),
 	)
 	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
@@ -15,6 +15,15 @@ type yodaCollector struct {
 	reportsPendingGaugeDesc   *prometheus.Desc
 	reportsErrorCountDesc     *prometheus.Desc
 	reportsSubmittedCountDesc *prometheus.Desc
+	executorDisagreeCountDesc *prometheus.Desc
+	executorNoConsensusDesc   *prometheus.Desc
+	executionsQueuedGaugeDesc *prometheus.Desc
+	throttleWaitDesc          *prometheus.Desc
+	throttleTimeoutCountDesc  *prometheus.Desc
+	fileCacheHitCountDesc     *prometheus.Desc
+	fileCacheMissCountDesc    *prometheus.Desc
+	fileCacheEvictCountDesc   *prometheus.Desc
+	fileCacheSizeGaugeDesc    *prometheus.Desc
 }
 
 func NewYodaCollector(c *Context) prometheus.Collector {
@@ -36,6 +45,42 @@ func NewYodaCollector(c *Context) prometheus.Collector {
 			"yoda_reports_submitted_total",
 			"Number of reports submitted since last yoda restart",
 			nil, nil),
+		executorDisagreeCountDesc: prometheus.NewDesc(
+			"yoda_executor_disagreement_total",
+			"Number of executions with disagreeing executor results since last yoda restart",
+			nil, nil),
+		executorNoConsensusDesc: prometheus.NewDesc(
+			"yoda_executor_no_consensus_total",
+			"Number of executions without enough agreeing executor results since last yoda restart",
+			nil, nil),
+		executionsQueuedGaugeDesc: prometheus.NewDesc(
+			"yoda_executions_queued_count",
+			"Number of data source executions currently waiting for the concurrency and rate limits",
+			nil, nil),
+		throttleWaitDesc: prometheus.NewDesc(
+			"yoda_throttle_wait_seconds",
+			"Time data source executions waited for the concurrency and rate limits since last yoda restart",
+			nil, nil),
+		throttleTimeoutCountDesc: prometheus.NewDesc(
+			"yoda_throttle_timeout_total",
+			"Number of data source executions that could not start before the request expires since last yoda restart",
+			nil, nil),
+		fileCacheHitCountDesc: prometheus.NewDesc(
+			"yoda_file_cache_hit_total",
+			"Number of data source executables found in the file cache since last yoda restart",
+			nil, nil),
+		fileCacheMissCountDesc: prometheus.NewDesc(
+			"yoda_file_cache_miss_total",
+			"Number of data source executables fetched from BandChain since last yoda restart",
+			nil, nil),
+		fileCacheEvictCountDesc: prometheus.NewDesc(
+			"yoda_file_cache_eviction_total",
+			"Number of data source executables evicted from the file cache since last yoda restart",
+			nil, nil),
+		fileCacheSizeGaugeDesc: prometheus.NewDesc(
+			"yoda_file_cache_size_bytes",
+			"Total size of data source executables currently in the file cache",
+			nil, nil),
 	}
 }
 
@@ -44,6 +89,15 @@ func (collector yodaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- collector.reportsPendingGaugeDesc
 	ch <- collector.reportsErrorCountDesc
 	ch <- collector.reportsSubmittedCountDesc
+	ch <- collector.executorDisagreeCountDesc
+	ch <- collector.executorNoConsensusDesc
+	ch <- collector.executionsQueuedGaugeDesc
+	ch <- collector.throttleWaitDesc
+	ch <- collector.throttleTimeoutCountDesc
+	ch <- collector.fileCacheHitCountDesc
+	ch <- collector.fileCacheMissCountDesc
+	ch <- collector.fileCacheEvictCountDesc
+	ch <- collector.fileCacheSizeGaugeDesc
 }
 
 func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +109,28 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.errorCount)))
 	ch <- prometheus.MustNewConstMetric(collector.reportsSubmittedCountDesc, prometheus.CounterValue,
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
+	ch <- prometheus.MustNewConstMetric(collector.executorDisagreeCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.disagreeCount)))
+	ch <- prometheus.MustNewConstMetric(collector.executorNoConsensusDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.noConsensusCount)))
+	ch <- prometheus.MustNewConstMetric(collector.executionsQueuedGaugeDesc, prometheus.GaugeValue,
+		float64(atomic.LoadInt64(&collector.context.queuedGauge)))
+	ch <- prometheus.MustNewConstSummary(collector.throttleWaitDesc,
+		uint64(atomic.LoadInt64(&collector.context.throttleWaitCount)),
+		time.Duration(atomic.LoadInt64(&collector.context.throttleWaitNanos)).Seconds(),
+		nil)
+	ch <- prometheus.MustNewConstMetric(collector.throttleTimeoutCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.throttleTimeoutCount)))
+
+	fileCacheStats := collector.context.fileCache.Stats()
+	ch <- prometheus.MustNewConstMetric(collector.fileCacheHitCountDesc, prometheus.CounterValue,
+		float64(fileCacheStats.Hits))
+	ch <- prometheus.MustNewConstMetric(collector.fileCacheMissCountDesc, prometheus.CounterValue,
+		float64(fileCacheStats.Misses))
+	ch <- prometheus.MustNewConstMetric(collector.fileCacheEvictCountDesc, prometheus.CounterValue,
+		float64(fileCacheStats.Evictions))
+	ch <- prometheus.MustNewConstMetric(collector.fileCacheSizeGaugeDesc, prometheus.GaugeValue,
+		float64(fileCacheStats.DiskSize))
 }
 
 func metricsListen(listenAddr string, c *Context) {
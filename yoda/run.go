@@ -9,7 +9,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
-	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/log"
@@ -18,8 +17,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/pkg/rpcpool"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+	"github.com/bandprotocol/chain/v3/yoda/throttle"
 )
 
 const (
@@ -57,6 +60,12 @@ func runImpl(c *Context, l *Logger) error {
 		waitingMsgs[i] = []ReportMsgWithKey{}
 	}
 
+	params, err := GetParams(c, l)
+	if err != nil {
+		return err
+	}
+	c.expirationBlockCount = params.ExpirationBlockCount
+
 	bz := c.encodingConfig.Codec.MustMarshal(&types.QueryPendingRequestsRequest{
 		ValidatorAddress: c.validator.String(),
 	})
@@ -135,22 +144,53 @@ func runCmd(c *Context) *cobra.Command {
 				return err
 			}
 			l := NewLogger(allowLevel)
-			c.executor, err = executor.NewExecutor(cfg.Executor)
+			c.executor, err = executor.NewExecutors(
+				cfg.Executor,
+				int(cfg.ExecutorConsensus),
+				func(d executor.Disagreement) { handleExecutorDisagreement(c, l, d) },
+			)
+			if err != nil {
+				return err
+			}
+			l.Info(":star: Creating HTTP clients with node URIs: %s", cfg.NodeURI)
+			c.client, err = rpcpool.New(rpcpool.ParseNodeURIs(cfg.NodeURI), logger.NewLogger(allowLevel))
 			if err != nil {
 				return err
 			}
-			l.Info(":star: Creating HTTP client with node URI: %s", cfg.NodeURI)
-			c.client, err = httpclient.New(cfg.NodeURI, "/websocket")
+			c.fileCache = newFileCache(c.home)
+			c.journal = journal.New(filepath.Join(c.home, "journal"))
+			journalRetention, err := time.ParseDuration(cfg.JournalRetention)
 			if err != nil {
 				return err
 			}
-			c.fileCache = filecache.New(filepath.Join(c.home, "files"))
+			pruned, err := c.journal.Prune(time.Now().Add(-journalRetention))
+			if err != nil {
+				return err
+			}
+			l.Info(":wastebasket: Pruned %d journal entries older than %s", pruned, journalRetention)
 			c.broadcastTimeout, err = time.ParseDuration(cfg.BroadcastTimeout)
 			if err != nil {
 				return err
 			}
 			c.maxTry = cfg.MaxTry
 			c.maxReport = cfg.MaxReport
+			globalLimit, err := throttle.ParseLimit(cfg.Throttle)
+			if err != nil {
+				return err
+			}
+			dataSourceLimit, err := throttle.ParseLimit(cfg.DataSourceThrottle)
+			if err != nil {
+				return err
+			}
+			dataSourceLimits, err := throttle.ParseDataSourceLimits(cfg.DataSourceThrottles)
+			if err != nil {
+				return err
+			}
+			c.throttler = throttle.New(globalLimit, dataSourceLimit, dataSourceLimits)
+			c.blockInterval, err = time.ParseDuration(cfg.BlockInterval)
+			if err != nil {
+				return err
+			}
 			c.rpcPollInterval, err = time.ParseDuration(cfg.RPCPollInterval)
 			if err != nil {
 				return err
@@ -164,25 +204,67 @@ func runCmd(c *Context) *cobra.Command {
 		},
 	}
 	cmd.Flags().String(flags.FlagChainID, "", "chain ID of BandChain network")
-	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to BandChain node")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "comma-separated RPC urls to BandChain nodes")
 	cmd.Flags().String(flagValidator, "", "validator address")
-	cmd.Flags().String(flagExecutor, "", "executor name and url for executing the data source script")
+	cmd.Flags().String(flagExecutor, "", "comma-separated executor names and urls for executing the data source script")
+	cmd.Flags().Uint64(
+		flagExecutorConsensus,
+		0,
+		"The number of executors that must agree on a result, or 0 to fail over to the next executor on error",
+	)
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for report transaction")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
 	cmd.Flags().String(flagBroadcastTimeout, "5m", "The time that Yoda will wait for tx commit")
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a report transaction")
 	cmd.Flags().Uint64(flagMaxReport, 10, "The maximum number of reports in one transaction")
+	cmd.Flags().String(
+		flagThrottle,
+		"",
+		"The global limit of data source executions in the form of concurrency:rate:burst, where 0 is unlimited",
+	)
+	cmd.Flags().String(flagDataSourceThrottle, "", "The limit of data source executions of each data source ID")
+	cmd.Flags().String(
+		flagDataSourceThrottles,
+		"",
+		"Comma-separated limits of specific data source IDs in the form of id=concurrency:rate:burst",
+	)
+	cmd.Flags().String(flagBlockInterval, "3s", "The expected interval between blocks to estimate request expiration")
+	cmd.Flags().String(flagJournalRetention, "24h", "The duration to keep the journal entries of handled requests")
+	cmd.Flags().Uint64(flagFileCacheSize, 32, "Size in megabytes of data source executables to cache in memory")
+	cmd.Flags().Uint64(
+		flagFileCacheDiskLimit,
+		1024,
+		"Size limit in megabytes of data source executables to cache on disk, where 0 is unlimited",
+	)
 	_ = viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	_ = viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	_ = viper.BindPFlag(flags.FlagGasPrices, cmd.Flags().Lookup(flags.FlagGasPrices))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	_ = viper.BindPFlag(flagExecutor, cmd.Flags().Lookup(flagExecutor))
+	_ = viper.BindPFlag(flagExecutorConsensus, cmd.Flags().Lookup(flagExecutorConsensus))
 	_ = viper.BindPFlag(flagBroadcastTimeout, cmd.Flags().Lookup(flagBroadcastTimeout))
 	_ = viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	_ = viper.BindPFlag(flagMaxReport, cmd.Flags().Lookup(flagMaxReport))
+	_ = viper.BindPFlag(flagThrottle, cmd.Flags().Lookup(flagThrottle))
+	_ = viper.BindPFlag(flagDataSourceThrottle, cmd.Flags().Lookup(flagDataSourceThrottle))
+	_ = viper.BindPFlag(flagDataSourceThrottles, cmd.Flags().Lookup(flagDataSourceThrottles))
+	_ = viper.BindPFlag(flagBlockInterval, cmd.Flags().Lookup(flagBlockInterval))
+	_ = viper.BindPFlag(flagJournalRetention, cmd.Flags().Lookup(flagJournalRetention))
+	_ = viper.BindPFlag(flagFileCacheSize, cmd.Flags().Lookup(flagFileCacheSize))
+	_ = viper.BindPFlag(flagFileCacheDiskLimit, cmd.Flags().Lookup(flagFileCacheDiskLimit))
 
 	return cmd
 }
+
+// newFileCache creates the cache of data source executables with the configured sizes. The executables
+// can be fetched again from BandChain, so the least recently used ones are evicted to keep the cache
+// under the disk size limit.
+func newFileCache(home string) filecache.Cache {
+	return filecache.NewWithOptions(filepath.Join(home, "files"), filecache.Options{
+		MemoryCacheSize: cfg.FileCacheSize * 1024 * 1024,
+		DiskSizeLimit:   cfg.FileCacheDiskLimit * 1024 * 1024,
+	})
+}
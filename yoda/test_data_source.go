@@ -0,0 +1,178 @@
+package yoda
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kyokomi/emoji"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/pkg/rpcpool"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+)
+
+// ErrDataSourceTestFailed is returned when the result of a data source test would not be accepted on-chain.
+var ErrDataSourceTestFailed = errors.New("data source test failed")
+
+func testDataSourceCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-data-source [file-or-id] [calldata]",
+		Short: "Run a data source script through the executor the same way as the oracle process",
+		Long: `Run a data source script through the executor the same way as the oracle process, with the
+verification environment variables signed by a throwaway key. The script is read from the given file, or fetched
+by data source ID from the node if the file does not exist. The result is checked against the on-chain limits,
+which are fetched from the node if it is set.`,
+		Args:         cobra.RangeArgs(1, 2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executorStr, err := flagOrConfig(cmd, flagExecutor, cfg.Executor)
+			if err != nil {
+				return err
+			}
+			nodeURI, err := flagOrConfig(cmd, flags.FlagNode, cfg.NodeURI)
+			if err != nil {
+				return err
+			}
+			chainID, err := flagOrConfig(cmd, flags.FlagChainID, cfg.ChainID)
+			if err != nil {
+				return err
+			}
+			calldata := ""
+			if len(args) == 2 {
+				calldata = args[1]
+			}
+
+			// The errors are returned to the user instead of being logged.
+			discardAll := func(_, _ string) bool { return true }
+			l := NewLogger(discardAll)
+			c.maxTry = 1
+			if nodeURI != "" {
+				c.client, err = rpcpool.New(rpcpool.ParseNodeURIs(nodeURI), logger.NewLogger(discardAll))
+				if err != nil {
+					return err
+				}
+				c.fileCache = newFileCache(c.home)
+			}
+
+			params := types.DefaultParams()
+			if c.client != nil {
+				if params, err = GetParams(c, l); err != nil {
+					emoji.Printf(":warning: Failed to get params from node, using the default limits: %s\n", err.Error())
+					params = types.DefaultParams()
+				}
+			}
+
+			exec, id, err := loadDataSource(c, l, args[0])
+			if err != nil {
+				return err
+			}
+
+			e, err := executor.NewExecutors(executorStr, 0, nil)
+			if err != nil {
+				return err
+			}
+
+			// The verification environment is signed by a throwaway key, as there is no real request to verify.
+			privKey := secp256k1.GenPrivKey()
+			validator := sdk.ValAddress(privKey.PubKey().Address())
+			if cfg.Validator != "" {
+				validator, err = sdk.ValAddressFromBech32(cfg.Validator)
+				if err != nil {
+					return err
+				}
+			}
+			vmsg := types.NewRequestVerification(chainID, validator, 1, 1, id)
+			sig, err := privKey.Sign(vmsg.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			result, err := e.Exec(exec, calldata, executionEnv(vmsg, privKey.PubKey(), sig))
+			elapsed := time.Since(start)
+			if err != nil {
+				// The oracle process reports an execution error as exit code 255 without output.
+				emoji.Printf(":skull: Execution failed with error: %s\n", err.Error())
+				result = executor.ExecResult{Code: 255}
+			}
+
+			fmt.Printf("Exit code: %d\n", result.Code)
+			fmt.Printf("Executor version: %s\n", result.Version)
+			fmt.Printf("Time: %s\n", elapsed)
+			fmt.Printf("Output (%d bytes):\n%s\n", len(result.Output), result.Output)
+
+			ok := checkLimit("calldata size", len(calldata), params.MaxCalldataSize)
+			ok = checkLimit("report data size", len(result.Output), params.MaxReportDataSize) && ok
+			if !ok || result.Code != 0 {
+				return ErrDataSourceTestFailed
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagExecutor, "", "executor name and url, or the configured executor if not set")
+	cmd.Flags().String(flags.FlagNode, "", "RPC url to BandChain node, or the configured node if not set")
+	cmd.Flags().String(flags.FlagChainID, "", "chain ID of BandChain network, or the configured chain ID if not set")
+	return cmd
+}
+
+// flagOrConfig returns the value of the flag if it is set, or the configured value otherwise. The flags are not
+// bound to the configuration, as the same keys are bound to the flags of the run command.
+func flagOrConfig(cmd *cobra.Command, flag string, configured string) (string, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return "", err
+	}
+	if value == "" {
+		return configured, nil
+	}
+	return value, nil
+}
+
+// loadDataSource reads the data source script from the file, or fetches it by data source ID from the node if
+// the file does not exist. The data source ID is 0 for a script read from a file.
+func loadDataSource(c *Context, l *Logger, fileOrID string) ([]byte, types.DataSourceID, error) {
+	exec, err := os.ReadFile(fileOrID)
+	if err == nil {
+		return exec, 0, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, 0, err
+	}
+
+	id, parseErr := strconv.ParseUint(fileOrID, 10, 64)
+	if parseErr != nil {
+		return nil, 0, err
+	}
+	if c.client == nil {
+		return nil, 0, fmt.Errorf("node is required to fetch data source %d", id)
+	}
+
+	hash, err := GetDataSourceHash(c, l, types.DataSourceID(id), 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	exec, err = GetExecutable(c, l, hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return exec, types.DataSourceID(id), nil
+}
+
+// checkLimit prints whether the size is within the on-chain limit and returns the result.
+func checkLimit(name string, size int, limit uint64) bool {
+	if uint64(size) > limit {
+		emoji.Printf(":x: %s %d exceeds the on-chain limit %d\n", name, size, limit)
+		return false
+	}
+	emoji.Printf(":white_check_mark: %s %d is within the on-chain limit %d\n", name, size, limit)
+	return true
+}
@@ -0,0 +1,206 @@
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Limit is the configuration of a limiter. Zero values mean unlimited.
+type Limit struct {
+	Concurrency uint64  // Maximum number of concurrent executions.
+	Rate        float64 // Maximum number of executions per second.
+	Burst       int     // Maximum number of executions that can be started at once within the rate.
+}
+
+// ParseLimit parses the limit in the form of "concurrency:rate:burst", where the trailing parts can be omitted.
+func ParseLimit(limitStr string) (Limit, error) {
+	var limit Limit
+	if limitStr == "" {
+		return limit, nil
+	}
+
+	parts := strings.Split(limitStr, ":")
+	if len(parts) > 3 {
+		return Limit{}, fmt.Errorf("invalid limit, must be in the form of concurrency:rate:burst: %s", limitStr)
+	}
+
+	var err error
+	if limit.Concurrency, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return Limit{}, fmt.Errorf("invalid concurrency of limit %s: %s", limitStr, err.Error())
+	}
+	if len(parts) > 1 {
+		limit.Rate, err = strconv.ParseFloat(parts[1], 64)
+		if err != nil || limit.Rate < 0 {
+			return Limit{}, fmt.Errorf("invalid rate of limit %s, must be a non-negative number", limitStr)
+		}
+	}
+	if len(parts) > 2 {
+		limit.Burst, err = strconv.Atoi(parts[2])
+		if err != nil || limit.Burst < 0 {
+			return Limit{}, fmt.Errorf("invalid burst of limit %s, must be a non-negative integer", limitStr)
+		}
+	}
+	return limit, nil
+}
+
+// ParseDataSourceLimits parses the comma-separated limits of data sources in the form of "id=limit", where
+// limit is in the form accepted by ParseLimit.
+func ParseDataSourceLimits(limitsStr string) (map[types.DataSourceID]Limit, error) {
+	limits := make(map[types.DataSourceID]Limit)
+	if limitsStr == "" {
+		return limits, nil
+	}
+
+	for _, each := range strings.Split(limitsStr, ",") {
+		idStr, limitStr, ok := strings.Cut(strings.TrimSpace(each), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid data source limit, must be in the form of id=limit: %s", each)
+		}
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data source ID of limit %s: %s", each, err.Error())
+		}
+		if _, ok := limits[types.DataSourceID(id)]; ok {
+			return nil, fmt.Errorf("duplicate limit of data source ID %d", id)
+		}
+		limit, err := ParseLimit(limitStr)
+		if err != nil {
+			return nil, err
+		}
+		limits[types.DataSourceID(id)] = limit
+	}
+	return limits, nil
+}
+
+// limiter limits the concurrency and the rate of executions.
+type limiter struct {
+	sem     chan struct{} // Semaphore of the concurrent executions, nil if unlimited.
+	limiter *rate.Limiter // Token bucket of the execution rate, nil if unlimited.
+}
+
+func newLimiter(limit Limit) *limiter {
+	l := &limiter{}
+	if limit.Concurrency != 0 {
+		l.sem = make(chan struct{}, limit.Concurrency)
+	}
+	if limit.Rate != 0 {
+		// A bucket without burst would never allow any execution, so at least one is allowed.
+		l.limiter = rate.NewLimiter(rate.Limit(limit.Rate), max(limit.Burst, 1))
+	}
+	return l
+}
+
+// acquire waits for a concurrency slot until the context is done.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.sem == nil {
+		return nil
+	}
+	// A free slot is taken even if the context is already done.
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	default:
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release releases the concurrency slot acquired by acquire.
+func (l *limiter) release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+// wait waits for a token of the rate limit. It fails immediately if the token cannot be obtained before
+// the deadline of the context.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.limiter == nil || l.limiter.Allow() {
+		return nil
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// Throttler limits the executions of data sources both globally and per data source ID.
+type Throttler struct {
+	global      *limiter
+	defaultDS   Limit                           // Limit of the data sources without a specific limit.
+	overrides   map[types.DataSourceID]Limit    // Limits of specific data sources.
+	mtx         sync.Mutex                      // Protects dataSources.
+	dataSources map[types.DataSourceID]*limiter // Limiters of the data sources, created on first use.
+}
+
+// New creates a new Throttler with the global limit, the default limit of each data source and the limits
+// of specific data sources.
+func New(global Limit, defaultDS Limit, overrides map[types.DataSourceID]Limit) *Throttler {
+	return &Throttler{
+		global:      newLimiter(global),
+		defaultDS:   defaultDS,
+		overrides:   overrides,
+		dataSources: make(map[types.DataSourceID]*limiter),
+	}
+}
+
+// dataSource returns the limiter of the given data source ID.
+func (t *Throttler) dataSource(id types.DataSourceID) *limiter {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	l, ok := t.dataSources[id]
+	if !ok {
+		limit, ok := t.overrides[id]
+		if !ok {
+			limit = t.defaultDS
+		}
+		l = newLimiter(limit)
+		t.dataSources[id] = l
+	}
+	return l
+}
+
+// Acquire waits until an execution of the given data source is allowed by both the limits of the data source
+// and the global limits, or until the context is done. On success, it returns the function to release the
+// execution, which must be called once the execution finishes. The limits of the data source are waited for
+// before the global slot is taken, so that a rate-limited data source does not hold up the others.
+func (t *Throttler) Acquire(ctx context.Context, id types.DataSourceID) (release func(), err error) {
+	ds := t.dataSource(id)
+	if err := ds.acquire(ctx); err != nil {
+		return nil, err
+	}
+	if err := ds.wait(ctx); err != nil {
+		ds.release()
+		return nil, err
+	}
+
+	if err := t.global.acquire(ctx); err != nil {
+		ds.release()
+		return nil, err
+	}
+	release = func() {
+		t.global.release()
+		ds.release()
+	}
+	if err := t.global.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// Deadline returns the time at which a request expires, estimated from the request time, the number of blocks
+// until expiration and the expected interval between blocks.
+func Deadline(requestTime int64, expirationBlockCount uint64, blockInterval time.Duration) time.Time {
+	return time.Unix(requestTime, 0).Add(time.Duration(expirationBlockCount) * blockInterval)
+}
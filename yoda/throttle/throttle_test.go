@@ -0,0 +1,150 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("")
+	require.NoError(t, err)
+	require.Equal(t, Limit{}, limit)
+
+	limit, err = ParseLimit("4")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Concurrency: 4}, limit)
+
+	limit, err = ParseLimit("0:2.5:5")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Concurrency: 0, Rate: 2.5, Burst: 5}, limit)
+
+	_, err = ParseLimit("1:2:3:4")
+	require.EqualError(t, err, "invalid limit, must be in the form of concurrency:rate:burst: 1:2:3:4")
+
+	_, err = ParseLimit("1:-2")
+	require.EqualError(t, err, "invalid rate of limit 1:-2, must be a non-negative number")
+
+	_, err = ParseLimit("1:2:x")
+	require.EqualError(t, err, "invalid burst of limit 1:2:x, must be a non-negative integer")
+}
+
+func TestParseDataSourceLimits(t *testing.T) {
+	limits, err := ParseDataSourceLimits("1=2, 3=0:10:20")
+	require.NoError(t, err)
+	require.Equal(t, map[types.DataSourceID]Limit{
+		1: {Concurrency: 2},
+		3: {Rate: 10, Burst: 20},
+	}, limits)
+
+	_, err = ParseDataSourceLimits("1")
+	require.EqualError(t, err, "invalid data source limit, must be in the form of id=limit: 1")
+
+	_, err = ParseDataSourceLimits("1=2,1=3")
+	require.EqualError(t, err, "duplicate limit of data source ID 1")
+}
+
+func TestThrottlerConcurrency(t *testing.T) {
+	throttler := New(Limit{Concurrency: 2}, Limit{Concurrency: 1}, map[types.DataSourceID]Limit{3: {}})
+
+	release1, err := throttler.Acquire(context.Background(), 1)
+	require.NoError(t, err)
+
+	// data source 1 is limited to one execution at a time.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = throttler.Acquire(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// data source 3 has no limit of its own, but there is one global slot left.
+	release3, err := throttler.Acquire(context.Background(), 3)
+	require.NoError(t, err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = throttler.Acquire(ctx, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the failed acquisition of data source 2 does not hold its slot.
+	release1()
+	release2, err := throttler.Acquire(context.Background(), 2)
+	require.NoError(t, err)
+	release2()
+	release3()
+}
+
+func TestThrottlerRate(t *testing.T) {
+	throttler := New(Limit{}, Limit{Rate: 10, Burst: 2}, nil)
+
+	for i := 0; i < 2; i++ {
+		release, err := throttler.Acquire(context.Background(), 1)
+		require.NoError(t, err)
+		release()
+	}
+
+	// the burst is used up, and the next token is not available before the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := throttler.Acquire(ctx, 1)
+	require.Error(t, err)
+
+	// other data sources have their own buckets.
+	release, err := throttler.Acquire(context.Background(), 2)
+	require.NoError(t, err)
+	release()
+
+	start := time.Now()
+	release, err = throttler.Acquire(context.Background(), 1)
+	require.NoError(t, err)
+	release()
+	require.Greater(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestThrottlerRateNotHoldGlobalSlot(t *testing.T) {
+	throttler := New(Limit{Concurrency: 1}, Limit{}, map[types.DataSourceID]Limit{1: {Rate: 5, Burst: 1}})
+
+	release, err := throttler.Acquire(context.Background(), 1)
+	require.NoError(t, err)
+	release()
+
+	// data source 1 waits for its next token without taking the only global slot.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		release, err := throttler.Acquire(context.Background(), 1)
+		if err == nil {
+			release()
+		}
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	release, err = throttler.Acquire(ctx, 2)
+	require.NoError(t, err)
+	release()
+	<-done
+}
+
+func TestDeadline(t *testing.T) {
+	require.Equal(t, time.Unix(1000, 0).Add(300*time.Second), Deadline(1000, 100, 3*time.Second))
+}
+
+func TestThrottlerExpiredContext(t *testing.T) {
+	throttler := New(Limit{Concurrency: 1, Rate: 1, Burst: 1}, Limit{}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the execution is allowed without waiting, so the expired context does not matter.
+	release, err := throttler.Acquire(ctx, 1)
+	require.NoError(t, err)
+	release()
+
+	// the rate limit requires waiting, which fails.
+	_, err = throttler.Acquire(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}